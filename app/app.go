@@ -94,6 +94,7 @@ import (
 	ibckeeper "github.com/cosmos/ibc-go/v7/modules/core/keeper"
 	solomachine "github.com/cosmos/ibc-go/v7/modules/light-clients/06-solomachine"
 	ibctm "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 	evmante "github.com/evmos/ethermint/app/ante"
 	ethermintconfig "github.com/evmos/ethermint/server/config"
@@ -107,7 +108,13 @@ import (
 
 	"github.com/kava-labs/kava/app/ante"
 	kavaparams "github.com/kava-labs/kava/app/params"
-	_ "github.com/kava-labs/kava/precompile/registry" // Ensure precompiles are registered when using the app module
+	governanceprecompile "github.com/kava-labs/kava/precompile/contracts/governance"
+	hardprecompile "github.com/kava-labs/kava/precompile/contracts/hard"
+	pricefeedprecompile "github.com/kava-labs/kava/precompile/contracts/pricefeed"
+	stakingprecompile "github.com/kava-labs/kava/precompile/contracts/staking"
+	swapprecompile "github.com/kava-labs/kava/precompile/contracts/swap"
+	"github.com/kava-labs/kava/precompile/msgexec"
+	precompileregistry "github.com/kava-labs/kava/precompile/registry" // Also ensures precompiles are registered when using the app module
 	"github.com/kava-labs/kava/x/auction"
 	auctionkeeper "github.com/kava-labs/kava/x/auction/keeper"
 	auctiontypes "github.com/kava-labs/kava/x/auction/types"
@@ -194,11 +201,14 @@ var (
 			ibcclientclient.UpdateClientProposalHandler,
 			ibcclientclient.UpgradeProposalHandler,
 			kavadistclient.ProposalHandler,
+			kavadistclient.PaymentStreamProposalHandler,
+			kavadistclient.CancelPaymentStreamProposalHandler,
 			committeeclient.ProposalHandler,
 			earnclient.DepositProposalHandler,
 			earnclient.WithdrawProposalHandler,
 			communityclient.LendDepositProposalHandler,
 			communityclient.LendWithdrawProposalHandler,
+			communityclient.SwapExactForTokensProposalHandler,
 		}),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -259,6 +269,7 @@ var (
 		hardtypes.ModuleAccountName:     {authtypes.Minter},
 		savingstypes.ModuleAccountName:  nil,
 		liquidtypes.ModuleAccountName:   {authtypes.Minter, authtypes.Burner},
+		liquidtypes.BasketAccountName:   nil,
 		earntypes.ModuleAccountName:     nil,
 		kavadisttypes.FundModuleAccount: nil,
 		minttypes.ModuleName:            {authtypes.Minter},
@@ -397,6 +408,7 @@ func NewApp(
 		committeetypes.StoreKey, incentivetypes.StoreKey, evmutiltypes.StoreKey,
 		savingstypes.StoreKey, earntypes.StoreKey, minttypes.StoreKey,
 		consensusparamtypes.StoreKey, crisistypes.StoreKey, precisebanktypes.StoreKey,
+		liquidtypes.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey, evmtypes.TransientKey, feemarkettypes.TransientKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
@@ -549,6 +561,7 @@ func NewApp(
 		evmutilSubspace,
 		app.bankKeeper,
 		app.accountKeeper,
+		govAuthAddr,
 	)
 
 	app.precisebankKeeper = precisebankkeeper.NewKeeper(
@@ -556,6 +569,7 @@ func NewApp(
 		keys[precisebanktypes.StoreKey],
 		app.bankKeeper,
 		app.accountKeeper,
+		govAuthAddr,
 	)
 
 	app.evmKeeper = evmkeeper.NewKeeper(
@@ -571,6 +585,9 @@ func NewApp(
 	)
 
 	app.evmutilKeeper.SetEvmKeeper(app.evmKeeper)
+	// Converted cosmos coins are locked through x/precisebank so that
+	// registered extended denoms keep their full precision.
+	app.evmutilKeeper.SetCosmosCoinBankKeeper(app.precisebankKeeper)
 
 	// It's important to note that the PFM Keeper must be initialized before the Transfer Keeper
 	app.packetForwardKeeper = packetforwardkeeper.NewKeeper(
@@ -598,12 +615,43 @@ func NewApp(
 	app.packetForwardKeeper.SetTransferKeeper(app.transferKeeper)
 	transferModule := transfer.NewAppModule(app.transferKeeper)
 
+	// evmutil sends converted ERC20s over IBC and processes precompile requests in evm hooks,
+	// so the transfer keeper must be set before the evmutil keeper is copied into hooks or modules.
+	app.evmutilKeeper.SetTransferKeeper(app.transferKeeper)
+	stakingPrecompileAddress := common.HexToAddress(precompileregistry.StakingContractAddress)
+	governancePrecompileAddress := common.HexToAddress(precompileregistry.GovernanceContractAddress)
+	precompileMsgExecutor := msgexec.NewExecutor(app.MsgServiceRouter(), app.bankKeeper, &app.evmutilKeeper)
+	app.evmKeeper.SetHooks(evmkeeper.NewMultiEvmHooks(
+		app.evmutilKeeper.EvmHooks(),
+		stakingprecompile.NewEvmHooks(stakingPrecompileAddress, app.MsgServiceRouter(), app.stakingKeeper),
+		msgexec.NewEvmHooks(
+			"hard",
+			common.HexToAddress(precompileregistry.HardContractAddress),
+			hardprecompile.NewRequestFromLog,
+			precompileMsgExecutor,
+		),
+		msgexec.NewEvmHooks(
+			"swap",
+			common.HexToAddress(precompileregistry.SwapContractAddress),
+			swapprecompile.NewRequestFromLog,
+			precompileMsgExecutor,
+		),
+		msgexec.NewEvmHooks(
+			"governance",
+			governancePrecompileAddress,
+			governanceprecompile.NewRequestParser(appCodec),
+			precompileMsgExecutor,
+		),
+	))
+
 	// allow ibc packet forwarding for ibc transfers.
 	// transfer stack contains (from top to bottom):
 	// - Packet Forward Middleware
+	// - EVM Util (converts refunded ERC20 transfers back to ERC20s)
 	// - Transfer
 	var transferStack ibcporttypes.IBCModule
 	transferStack = transfer.NewIBCModule(app.transferKeeper)
+	transferStack = evmutil.NewIBCMiddleware(transferStack, app.evmutilKeeper)
 	transferStack = packetforward.NewIBCMiddleware(
 		transferStack,
 		app.packetForwardKeeper,
@@ -644,6 +692,11 @@ func NewApp(
 		keys[pricefeedtypes.StoreKey],
 		pricefeedSubspace,
 	)
+	app.pricefeedKeeper.SetHooks(pricefeedprecompile.NewPricefeedHooks(
+		common.HexToAddress(precompileregistry.PricefeedContractAddress),
+		app.evmKeeper,
+		app.pricefeedKeeper,
+	))
 	swapKeeper := swapkeeper.NewKeeper(
 		appCodec,
 		keys[swaptypes.StoreKey],
@@ -661,6 +714,16 @@ func NewApp(
 		app.accountKeeper,
 		mAccPerms,
 	)
+	app.liquidKeeper = liquidkeeper.NewDefaultKeeper(
+		appCodec,
+		keys[liquidtypes.StoreKey],
+		app.accountKeeper,
+		app.bankKeeper,
+		app.stakingKeeper,
+		&app.distrKeeper,
+		app.slashingKeeper,
+		govAuthAddr,
+	)
 	hardKeeper := hardkeeper.NewKeeper(
 		appCodec,
 		keys[hardtypes.StoreKey],
@@ -669,13 +732,7 @@ func NewApp(
 		app.bankKeeper,
 		app.pricefeedKeeper,
 		app.auctionKeeper,
-	)
-	app.liquidKeeper = liquidkeeper.NewDefaultKeeper(
-		appCodec,
-		app.accountKeeper,
-		app.bankKeeper,
-		app.stakingKeeper,
-		&app.distrKeeper,
+		app.liquidKeeper,
 	)
 	savingsKeeper := savingskeeper.NewKeeper(
 		appCodec,
@@ -684,6 +741,7 @@ func NewApp(
 		app.accountKeeper,
 		app.bankKeeper,
 		app.liquidKeeper,
+		&hardKeeper,
 	)
 	earnKeeper := earnkeeper.NewKeeper(
 		appCodec,
@@ -704,6 +762,10 @@ func NewApp(
 		app.bankKeeper,
 		app.accountKeeper,
 		app.distrKeeper,
+		&app.communityKeeper,
+		app.pricefeedKeeper,
+		&app.incentiveKeeper,
+		&app.earnKeeper,
 		app.loadBlockedMaccAddrs(),
 	)
 
@@ -717,7 +779,7 @@ func NewApp(
 		govAuthAddrStr,
 	)
 
-	// x/community's deposit/withdraw to lend proposals depend on hard keeper.
+	// x/community's deposit/withdraw to lend and swap proposals depend on hard and swap keepers.
 	app.communityKeeper = communitykeeper.NewKeeper(
 		appCodec,
 		keys[communitytypes.StoreKey],
@@ -729,6 +791,8 @@ func NewApp(
 		&app.mintKeeper,
 		&app.kavadistKeeper,
 		app.stakingKeeper,
+		&swapKeeper,
+		app.pricefeedKeeper,
 		govAuthAddr,
 	)
 
@@ -768,9 +832,11 @@ func NewApp(
 		appCodec,
 		keys[committeetypes.StoreKey],
 		committeeGovRouter,
+		app.MsgServiceRouter(),
 		app.paramsKeeper,
 		app.accountKeeper,
 		app.bankKeeper,
+		govAuthAddr,
 	)
 
 	// register the staking hooks
@@ -779,14 +845,27 @@ func NewApp(
 			app.distrKeeper.Hooks(),
 			app.slashingKeeper.Hooks(),
 			app.incentiveKeeper.Hooks(),
+			app.liquidKeeper.Hooks(),
+			stakingprecompile.NewStakingHooks(stakingPrecompileAddress, app.evmKeeper, app.stakingKeeper),
 		))
 
 	app.swapKeeper = *swapKeeper.SetHooks(app.incentiveKeeper.Hooks())
 	app.cdpKeeper = *cdpKeeper.SetHooks(cdptypes.NewMultiCDPHooks(app.incentiveKeeper.Hooks()))
 	app.hardKeeper = *hardKeeper.SetHooks(hardtypes.NewMultiHARDHooks(app.incentiveKeeper.Hooks()))
-	app.savingsKeeper = savingsKeeper // savings incentive hooks disabled
+	app.savingsKeeper = *savingsKeeper.SetHooks(savingstypes.NewMultiSavingsHooks(app.incentiveKeeper.Hooks()))
 	app.earnKeeper = *earnKeeper.SetHooks(app.incentiveKeeper.Hooks())
 
+	// count staked and liquid staked kava towards token committee votes, using the same logic as the gov tally handler
+	// NOTE this must be done before the committee keeper is copied into the gov router or the module manager
+	app.committeeKeeper.SetVotingPowerSource(NewCommitteeVotingPowerSource(
+		*app.stakingKeeper, app.savingsKeeper, app.earnKeeper, app.liquidKeeper, app.bankKeeper,
+	))
+	app.committeeKeeper.SetHooks(governanceprecompile.NewCommitteeHooks(
+		governancePrecompileAddress,
+		app.evmKeeper,
+		app.committeeKeeper,
+	))
+
 	// create gov keeper with router
 	// NOTE this must be done after any keepers referenced in the gov router (ie committee) are defined
 	govRouter := govv1beta1.NewRouter()
@@ -812,6 +891,9 @@ func NewApp(
 		govAuthAddrStr,
 	)
 	govKeeper.SetLegacyRouter(govRouter)
+	govKeeper.SetHooks(govtypes.NewMultiGovHooks(
+		governanceprecompile.NewGovHooks(governancePrecompileAddress, app.evmKeeper, govKeeper),
+	))
 	app.govKeeper = *govKeeper
 
 	// override x/gov tally handler with custom implementation
@@ -900,6 +982,9 @@ func NewApp(
 		issuancetypes.ModuleName,
 		incentivetypes.ModuleName,
 		ibcexported.ModuleName,
+		// Liquid begin blocker compounds basket staking rewards. It should run after distr and slashing so rewards
+		// and slashes of the previous block are applied first.
+		liquidtypes.ModuleName,
 		// Add all remaining modules with an empty begin blocker below since cosmos 0.45.0 requires it
 		swaptypes.ModuleName,
 		vestingtypes.ModuleName,
@@ -915,7 +1000,6 @@ func NewApp(
 		authz.ModuleName,
 		evmutiltypes.ModuleName,
 		savingstypes.ModuleName,
-		liquidtypes.ModuleName,
 		earntypes.ModuleName,
 		routertypes.ModuleName,
 		consensusparamtypes.ModuleName,
@@ -932,6 +1016,8 @@ func NewApp(
 		// fee market module must go after evm module in order to retrieve the block gas used.
 		feemarkettypes.ModuleName,
 		pricefeedtypes.ModuleName,
+		// liquid must go after staking so unbonding records are removed in the block their unbonding completes.
+		liquidtypes.ModuleName,
 		// Add all remaining modules with an empty end blocker below since cosmos 0.45.0 requires it
 		capabilitytypes.ModuleName,
 		incentivetypes.ModuleName,
@@ -958,7 +1044,6 @@ func NewApp(
 		authz.ModuleName,
 		evmutiltypes.ModuleName,
 		savingstypes.ModuleName,
-		liquidtypes.ModuleName,
 		earntypes.ModuleName,
 		routertypes.ModuleName,
 		minttypes.ModuleName,
@@ -1222,6 +1307,10 @@ func (app *App) loadBlockedMaccAddrs() map[string]bool {
 		app.accountKeeper.GetModuleAddress(earntypes.ModuleName).String(): true,
 		// liquid
 		app.accountKeeper.GetModuleAddress(liquidtypes.ModuleName).String(): true,
+		// liquid basket
+		app.accountKeeper.GetModuleAddress(liquidtypes.BasketAccountName).String(): true,
+		// savings
+		app.accountKeeper.GetModuleAddress(savingstypes.ModuleAccountName).String(): true,
 		// kavadist fund
 		app.accountKeeper.GetModuleAddress(kavadisttypes.FundModuleAccount).String(): true,
 		// community
@@ -3,27 +3,28 @@ package app
 import (
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	committeetypes "github.com/kava-labs/kava/x/committee/types"
 	earnkeeper "github.com/kava-labs/kava/x/earn/keeper"
 	liquidkeeper "github.com/kava-labs/kava/x/liquid/keeper"
 	liquidtypes "github.com/kava-labs/kava/x/liquid/types"
 	savingskeeper "github.com/kava-labs/kava/x/savings/keeper"
 )
 
-var _ govv1.TallyHandler = TallyHandler{}
+var (
+	_ govv1.TallyHandler               = TallyHandler{}
+	_ committeetypes.VotingPowerSource = TallyHandler{}
+)
 
 // TallyHandler is the tally handler for kava
 type TallyHandler struct {
-	gk  govkeeper.Keeper
-	stk stakingkeeper.Keeper
-	svk savingskeeper.Keeper
-	ek  earnkeeper.Keeper
-	lk  liquidkeeper.Keeper
-	bk  bankkeeper.Keeper
+	CommitteeVotingPowerSource
+	gk govkeeper.Keeper
 }
 
 // NewTallyHandler creates a new tally handler.
@@ -32,12 +33,8 @@ func NewTallyHandler(
 	ek earnkeeper.Keeper, lk liquidkeeper.Keeper, bk bankkeeper.Keeper,
 ) TallyHandler {
 	return TallyHandler{
-		gk:  gk,
-		stk: stk,
-		svk: svk,
-		ek:  ek,
-		lk:  lk,
-		bk:  bk,
+		CommitteeVotingPowerSource: NewCommitteeVotingPowerSource(stk, svk, ek, lk, bk),
+		gk:                         gk,
 	}
 }
 
@@ -104,9 +101,19 @@ func (th TallyHandler) Tally(
 			return false
 		})
 
-		// get voter bkava and update total voting power and results
-		addrBkava := th.getAddrBkava(ctx, voter).toCoins()
-		for _, coin := range addrBkava {
+		// get voter bkava and stkava and update total voting power and results
+		addrBkava := th.getAddrBkava(ctx, voter)
+		if basketAmount, found := addrBkava[liquidtypes.BasketDenom]; found {
+			delete(addrBkava, liquidtypes.BasketDenom)
+
+			votingPower := th.basketVotingPower(ctx, basketAmount, currValidators)
+			for _, option := range vote.Options {
+				subPower := votingPower.Mul(sdk.MustNewDecFromStr(option.Weight))
+				results[option.Option] = results[option.Option].Add(subPower)
+			}
+			totalVotingPower = totalVotingPower.Add(votingPower)
+		}
+		for _, coin := range addrBkava.toCoins() {
 			valAddr, err := liquidtypes.ParseLiquidStakingTokenDenom(coin.Denom)
 			if err != nil {
 				break
@@ -188,7 +195,140 @@ func (th TallyHandler) Tally(
 	return false, false, tallyResults
 }
 
-// bkavaByDenom a map of the bkava denom and the amount of bkava for that denom.
+// basketVotingPower returns the voting power of basket liquid staking tokens, which is their share of the basket's
+// delegations to bonded validators. The shares are deducted from the validators so a validator's vote does not also
+// count them.
+func (th TallyHandler) basketVotingPower(
+	ctx sdk.Context,
+	amount sdkmath.Int,
+	currValidators map[string]govv1.ValidatorGovInfo,
+) sdk.Dec {
+	supply := th.bk.GetSupply(ctx, liquidtypes.BasketDenom).Amount
+	if supply.IsZero() {
+		return sdk.ZeroDec()
+	}
+	fraction := sdk.NewDecFromInt(amount).QuoInt(supply)
+
+	votingPower := sdk.ZeroDec()
+	basketAddr := authtypes.NewModuleAddress(liquidtypes.BasketAccountName)
+	th.stk.IterateDelegations(ctx, basketAddr, func(index int64, delegation stakingtypes.DelegationI) (stop bool) {
+		valAddrStr := delegation.GetValidatorAddr().String()
+		val, ok := currValidators[valAddrStr]
+		if !ok {
+			return false
+		}
+
+		shares := delegation.GetShares().Mul(fraction)
+		val.DelegatorDeductions = val.DelegatorDeductions.Add(shares)
+		currValidators[valAddrStr] = val
+
+		votingPower = votingPower.Add(shares.MulInt(val.BondedTokens).Quo(val.DelegatorShares))
+		return false
+	})
+
+	return votingPower
+}
+
+// CommitteeVotingPowerSource counts token committee voting power from staking and
+// liquid staking, the same way the tally handler counts x/gov voting power.
+type CommitteeVotingPowerSource struct {
+	stk stakingkeeper.Keeper
+	svk savingskeeper.Keeper
+	ek  earnkeeper.Keeper
+	lk  liquidkeeper.Keeper
+	bk  bankkeeper.Keeper
+}
+
+// NewCommitteeVotingPowerSource creates a new committee voting power source.
+func NewCommitteeVotingPowerSource(
+	stk stakingkeeper.Keeper, svk savingskeeper.Keeper,
+	ek earnkeeper.Keeper, lk liquidkeeper.Keeper, bk bankkeeper.Keeper,
+) CommitteeVotingPowerSource {
+	return CommitteeVotingPowerSource{
+		stk: stk,
+		svk: svk,
+		ek:  ek,
+		lk:  lk,
+		bk:  bk,
+	}
+}
+
+// GetVotingPower returns the committee voting power of an address in the bond denom from
+// bonded delegations and bkava and stkava held in the wallet, x/savings and x/earn. The bkava
+// and stkava are counted as the amount of staked tokens they are redeemable for.
+func (vs CommitteeVotingPowerSource) GetVotingPower(ctx sdk.Context, addr sdk.AccAddress, tallyDenom string) []committeetypes.VotingPower {
+	if tallyDenom != vs.stk.BondDenom(ctx) {
+		return nil
+	}
+
+	staked := sdk.ZeroInt()
+	vs.stk.IterateDelegations(ctx, addr, func(index int64, delegation stakingtypes.DelegationI) (stop bool) {
+		validator, found := vs.stk.GetValidator(ctx, delegation.GetValidatorAddr())
+		if !found || !validator.IsBonded() {
+			return false
+		}
+		staked = staked.Add(validator.TokensFromShares(delegation.GetShares()).TruncateInt())
+		return false
+	})
+
+	powers := []committeetypes.VotingPower{
+		{Source: committeetypes.VotingPowerSourceStaked, Amount: staked},
+	}
+	for _, source := range []struct {
+		name  string
+		addFn func(ctx sdk.Context, addr sdk.AccAddress, bkava bkavaByDenom)
+	}{
+		{committeetypes.VotingPowerSourceLiquid, vs.addBkavaFromWallet},
+		{committeetypes.VotingPowerSourceSavings, vs.addBkavaFromSavings},
+		{committeetypes.VotingPowerSourceEarn, vs.addBkavaFromEarn},
+	} {
+		bkava := make(bkavaByDenom)
+		source.addFn(ctx, addr, bkava)
+		amount := sdk.ZeroInt()
+		for _, coin := range bkava.toCoins() {
+			amount = amount.Add(vs.getStakedTokens(ctx, coin))
+		}
+		powers = append(powers, committeetypes.VotingPower{Source: source.name, Amount: amount})
+	}
+
+	return powers
+}
+
+// GetTotalVotingPower returns the total committee voting power in the bond denom. Balances count
+// the supply outside of the staking pools, and bonded delegations and bkava count the bonded tokens
+// they are backed by. Tokens of unbonding and unbonded delegations do not count.
+func (vs CommitteeVotingPowerSource) GetTotalVotingPower(ctx sdk.Context, tallyDenom string) (sdkmath.Int, bool) {
+	bondDenom := vs.stk.BondDenom(ctx)
+	if tallyDenom != bondDenom {
+		return sdkmath.Int{}, false
+	}
+
+	bonded := vs.stk.TotalBondedTokens(ctx)
+	notBonded := vs.bk.GetBalance(ctx, vs.stk.GetNotBondedPool(ctx).GetAddress(), bondDenom).Amount
+	balances := vs.bk.GetSupply(ctx, bondDenom).Amount.Sub(bonded).Sub(notBonded)
+	return balances.Add(bonded), true
+}
+
+// getStakedTokens returns the amount of staked tokens bkava or stkava is redeemable for.
+func (vs CommitteeVotingPowerSource) getStakedTokens(ctx sdk.Context, coin sdk.Coin) sdkmath.Int {
+	if coin.Denom == liquidtypes.BasketDenom {
+		return vs.lk.GetBasketExchangeRate(ctx).MulInt(coin.Amount).TruncateInt()
+	}
+
+	stakedCoins, err := vs.lk.GetStakedTokensForDerivatives(ctx, sdk.NewCoins(coin))
+	if err != nil {
+		// error is returned only if the bkava denom is incorrect, which should never happen here.
+		panic(err)
+	}
+	return stakedCoins.Amount
+}
+
+// isLiquidStakingDenom returns true if the denom is a bkava denom or stkava.
+func (vs CommitteeVotingPowerSource) isLiquidStakingDenom(ctx sdk.Context, denom string) bool {
+	return denom == liquidtypes.BasketDenom || vs.lk.IsDerivativeDenom(ctx, denom)
+}
+
+// bkavaByDenom a map of the bkava and stkava denoms and the amount of each denom.
 type bkavaByDenom map[string]sdkmath.Int
 
 func (bkavaMap bkavaByDenom) add(coin sdk.Coin) {
@@ -207,48 +347,48 @@ func (bkavaMap bkavaByDenom) toCoins() sdk.Coins {
 	return coins.Sort()
 }
 
-// getAddrBkava returns a map of validator address & the amount of bkava
-// of the addr for each validator.
-func (th TallyHandler) getAddrBkava(ctx sdk.Context, addr sdk.AccAddress) bkavaByDenom {
+// getAddrBkava returns a map of the bkava and stkava denoms & the amount
+// of each held by the addr.
+func (vs CommitteeVotingPowerSource) getAddrBkava(ctx sdk.Context, addr sdk.AccAddress) bkavaByDenom {
 	results := make(bkavaByDenom)
-	th.addBkavaFromWallet(ctx, addr, results)
-	th.addBkavaFromSavings(ctx, addr, results)
-	th.addBkavaFromEarn(ctx, addr, results)
+	vs.addBkavaFromWallet(ctx, addr, results)
+	vs.addBkavaFromSavings(ctx, addr, results)
+	vs.addBkavaFromEarn(ctx, addr, results)
 	return results
 }
 
-// addBkavaFromWallet adds all addr balances of bkava in x/bank.
-func (th TallyHandler) addBkavaFromWallet(ctx sdk.Context, addr sdk.AccAddress, bkava bkavaByDenom) {
-	coins := th.bk.GetAllBalances(ctx, addr)
+// addBkavaFromWallet adds all addr balances of bkava and stkava in x/bank.
+func (vs CommitteeVotingPowerSource) addBkavaFromWallet(ctx sdk.Context, addr sdk.AccAddress, bkava bkavaByDenom) {
+	coins := vs.bk.GetAllBalances(ctx, addr)
 	for _, coin := range coins {
-		if th.lk.IsDerivativeDenom(ctx, coin.Denom) {
+		if vs.isLiquidStakingDenom(ctx, coin.Denom) {
 			bkava.add(coin)
 		}
 	}
 }
 
-// addBkavaFromSavings adds all addr deposits of bkava in x/savings.
-func (th TallyHandler) addBkavaFromSavings(ctx sdk.Context, addr sdk.AccAddress, bkava bkavaByDenom) {
-	deposit, found := th.svk.GetDeposit(ctx, addr)
+// addBkavaFromSavings adds all addr deposits of bkava and stkava in x/savings, valued by the current value of their shares.
+func (vs CommitteeVotingPowerSource) addBkavaFromSavings(ctx sdk.Context, addr sdk.AccAddress, bkava bkavaByDenom) {
+	deposit, found := vs.svk.GetSyncedDeposit(ctx, addr)
 	if !found {
 		return
 	}
 	for _, coin := range deposit.Amount {
-		if th.lk.IsDerivativeDenom(ctx, coin.Denom) {
+		if vs.isLiquidStakingDenom(ctx, coin.Denom) {
 			bkava.add(coin)
 		}
 	}
 }
 
-// addBkavaFromEarn adds all addr deposits of bkava in x/earn.
-func (th TallyHandler) addBkavaFromEarn(ctx sdk.Context, addr sdk.AccAddress, bkava bkavaByDenom) {
-	shares, found := th.ek.GetVaultAccountShares(ctx, addr)
+// addBkavaFromEarn adds all addr deposits of bkava and stkava in x/earn.
+func (vs CommitteeVotingPowerSource) addBkavaFromEarn(ctx sdk.Context, addr sdk.AccAddress, bkava bkavaByDenom) {
+	shares, found := vs.ek.GetVaultAccountShares(ctx, addr)
 	if !found {
 		return
 	}
 	for _, share := range shares {
-		if th.lk.IsDerivativeDenom(ctx, share.Denom) {
-			if coin, err := th.ek.ConvertToAssets(ctx, share); err == nil {
+		if vs.isLiquidStakingDenom(ctx, share.Denom) {
+			if coin, err := vs.ek.ConvertToAssets(ctx, share); err == nil {
 				bkava.add(coin)
 			}
 		}
@@ -17,8 +17,10 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/suite"
 
+	committeetypes "github.com/kava-labs/kava/x/committee/types"
 	earntypes "github.com/kava-labs/kava/x/earn/types"
 	liquidtypes "github.com/kava-labs/kava/x/liquid/types"
+	savingstypes "github.com/kava-labs/kava/x/savings/types"
 )
 
 // d is an alias for sdk.MustNewDecFromStr
@@ -123,6 +125,135 @@ func (suite *tallyHandlerSuite) TestVotePower_UserOverridesValidator() {
 	suite.Equal(sdk.ZeroInt().String(), results.AbstainCount)
 }
 
+func (suite *tallyHandlerSuite) TestVotePower_BasketCounted() {
+	user := suite.createAccount(suite.newBondCoin(sdkmath.NewInt(1e9)))
+
+	selfDelegated := sdkmath.NewInt(1e9)
+	validator := suite.createNewBondedValidator(selfDelegated)
+	basket := suite.mintBasket(user.GetAddress(), validator.GetOperator(), sdkmath.NewInt(500e6))
+
+	proposal := suite.createProposal()
+
+	// Validator votes, inheriting the basket's stake.
+	suite.voteOnProposal(validator.GetOperator().Bytes(), proposal.Id, govv1beta1.OptionYes)
+
+	readOnlyCtx, _ := suite.ctx.CacheContext()
+	_, _, results := suite.tallier.Tally(readOnlyCtx, proposal)
+	suite.Equal(selfDelegated.Add(basket.Amount).String(), results.YesCount)
+
+	// User votes with stkava, taking power away from validator.
+	suite.voteOnProposal(user.GetAddress(), proposal.Id, govv1beta1.OptionNo)
+
+	_, _, results = suite.tallier.Tally(suite.ctx, proposal)
+	suite.Equal(selfDelegated.String(), results.YesCount)
+	suite.Equal(basket.Amount.String(), results.NoCount)
+}
+
+func (suite *tallyHandlerSuite) TestCommitteeVotingPower_BasketCounted() {
+	user := suite.createAccount(suite.newBondCoin(sdkmath.NewInt(1e9)))
+
+	validator := suite.createNewBondedValidator(sdkmath.NewInt(1e9))
+	suite.mintBasket(user.GetAddress(), validator.GetOperator(), sdkmath.NewInt(500e6))
+
+	// increase the value of stkava
+	err := suite.app.GetBankKeeper().SendCoins(
+		suite.ctx,
+		user.GetAddress(),
+		suite.app.GetAccountKeeper().GetModuleAddress(liquidtypes.BasketAccountName),
+		sdk.NewCoins(suite.newBondCoin(sdkmath.NewInt(100e6))),
+	)
+	suite.Require().NoError(err)
+
+	powers := suite.tallier.GetVotingPower(suite.ctx, user.GetAddress(), "ukava")
+	suite.Equal([]committeetypes.VotingPower{
+		{Source: committeetypes.VotingPowerSourceStaked, Amount: sdk.ZeroInt()},
+		{Source: committeetypes.VotingPowerSourceLiquid, Amount: sdkmath.NewInt(600e6)},
+		{Source: committeetypes.VotingPowerSourceSavings, Amount: sdk.ZeroInt()},
+		{Source: committeetypes.VotingPowerSourceEarn, Amount: sdk.ZeroInt()},
+	}, powers)
+}
+
+func (suite *tallyHandlerSuite) TestCommitteeVotingPower_AllSourcesCounted() {
+	user := suite.createAccount(suite.newBondCoin(sdkmath.NewInt(1e9)))
+
+	validator := suite.delegateToNewBondedValidator(user.GetAddress(), sdkmath.NewInt(1e9))
+
+	derivatives := suite.mintDerivative(user.GetAddress(), validator.GetOperator(), sdkmath.NewInt(500e6))
+
+	suite.allowBKavaEarnDeposits()
+	suite.earnDeposit(
+		user.GetAddress(),
+		sdk.NewCoin(derivatives.Denom, sdkmath.NewInt(250e6)),
+	)
+
+	powers := suite.tallier.GetVotingPower(suite.ctx, user.GetAddress(), "ukava")
+	suite.Equal([]committeetypes.VotingPower{
+		{Source: committeetypes.VotingPowerSourceStaked, Amount: sdkmath.NewInt(500e6)},
+		{Source: committeetypes.VotingPowerSourceLiquid, Amount: sdkmath.NewInt(250e6)},
+		{Source: committeetypes.VotingPowerSourceSavings, Amount: sdk.ZeroInt()},
+		{Source: committeetypes.VotingPowerSourceEarn, Amount: sdkmath.NewInt(250e6)},
+	}, powers)
+
+	// only the bond denom has voting power from staking
+	suite.Empty(suite.tallier.GetVotingPower(suite.ctx, user.GetAddress(), "hard"))
+}
+
+func (suite *tallyHandlerSuite) TestCommitteeVotingPower_SavingsDepositsValued() {
+	user := suite.createAccount(suite.newBondCoin(sdkmath.NewInt(1e9)))
+
+	validator := suite.delegateToNewBondedValidator(user.GetAddress(), sdkmath.NewInt(1e9))
+
+	derivatives := suite.mintDerivative(user.GetAddress(), validator.GetOperator(), sdkmath.NewInt(500e6))
+
+	suite.allowBKavaEarnDeposits()
+	err := suite.app.GetSavingsKeeper().Deposit(
+		suite.ctx,
+		user.GetAddress(),
+		sdk.NewCoins(sdk.NewCoin(derivatives.Denom, sdkmath.NewInt(100e6))),
+	)
+	suite.Require().NoError(err)
+
+	// increase the value of the savings shares
+	err = suite.app.GetBankKeeper().SendCoins(
+		suite.ctx,
+		user.GetAddress(),
+		suite.app.GetAccountKeeper().GetModuleAddress(savingstypes.ModuleAccountName),
+		sdk.NewCoins(sdk.NewCoin(derivatives.Denom, sdkmath.NewInt(100e6))),
+	)
+	suite.Require().NoError(err)
+
+	powers := suite.tallier.GetVotingPower(suite.ctx, user.GetAddress(), "ukava")
+	suite.Equal([]committeetypes.VotingPower{
+		{Source: committeetypes.VotingPowerSourceStaked, Amount: sdkmath.NewInt(500e6)},
+		{Source: committeetypes.VotingPowerSourceLiquid, Amount: sdkmath.NewInt(300e6)},
+		{Source: committeetypes.VotingPowerSourceSavings, Amount: sdkmath.NewInt(200e6)},
+		{Source: committeetypes.VotingPowerSourceEarn, Amount: sdk.ZeroInt()},
+	}, powers)
+}
+
+func (suite *tallyHandlerSuite) TestCommitteeTotalVotingPower_ExcludesUnbonding() {
+	user := suite.createAccount(suite.newBondCoin(sdkmath.NewInt(1e9)))
+	validator := suite.delegateToNewBondedValidator(user.GetAddress(), sdkmath.NewInt(1e9))
+	suite.mintDerivative(user.GetAddress(), validator.GetOperator(), sdkmath.NewInt(500e6))
+
+	total, found := suite.tallier.GetTotalVotingPower(suite.ctx, "ukava")
+	suite.Require().True(found)
+	supply := suite.app.GetBankKeeper().GetSupply(suite.ctx, "ukava").Amount
+	suite.Equal(supply.String(), total.String())
+
+	// tokens of unbonding delegations have no voting power
+	stakingKeeper := suite.app.GetStakingKeeper()
+	_, err := stakingKeeper.Undelegate(suite.ctx, user.GetAddress(), validator.GetOperator(), sdk.NewDec(100e6))
+	suite.Require().NoError(err)
+	total, found = suite.tallier.GetTotalVotingPower(suite.ctx, "ukava")
+	suite.Require().True(found)
+	suite.Equal(supply.Sub(sdkmath.NewInt(100e6)).String(), total.String())
+
+	// the total of other denoms is the supply
+	_, found = suite.tallier.GetTotalVotingPower(suite.ctx, "hard")
+	suite.False(found)
+}
+
 func (suite *tallyHandlerSuite) TestTallyOutcomes() {
 	suite.Run("VotedPowerBelowQuorumFails", func() {
 		suite.SetupTest()
@@ -306,6 +437,22 @@ func (suite *tallyHandlerSuite) mintDerivative(owner sdk.AccAddress, validator s
 	return minted
 }
 
+func (suite *tallyHandlerSuite) mintBasket(owner sdk.AccAddress, validator sdk.ValAddress, amount sdkmath.Int) sdk.Coin {
+	lk := suite.app.GetLiquidKeeper()
+	lk.SetParams(suite.ctx, liquidtypes.NewParams(
+		liquidtypes.BASKET_WEIGHTING_GOVERNANCE,
+		liquidtypes.BasketValidators{liquidtypes.NewBasketValidator(validator, sdk.OneDec())},
+		0,
+		false,
+		liquidtypes.DefaultBasketCompoundInterval,
+	))
+
+	minted, err := lk.MintBasket(suite.ctx, owner, suite.newBondCoin(amount))
+	suite.Require().NoError(err)
+
+	return minted
+}
+
 func (suite *tallyHandlerSuite) delegateToNewBondedValidator(delegator sdk.AccAddress, amount sdkmath.Int) stakingtypes.ValidatorI {
 	valAcc := suite.createAccount(suite.newBondCoin(sdkmath.NewInt(1e9)))
 	validator, err := suite.staking.createUnbondedValidator(suite.ctx, valAcc.GetAddress().Bytes(), sdkmath.NewInt(1e9))
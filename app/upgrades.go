@@ -1,3 +1,98 @@
 package app
 
-func (app App) RegisterUpgradeHandlers() {}
+import (
+	"fmt"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	governanceprecompile "github.com/kava-labs/kava/precompile/contracts/governance"
+	pricefeedprecompile "github.com/kava-labs/kava/precompile/contracts/pricefeed"
+	stakingprecompile "github.com/kava-labs/kava/precompile/contracts/staking"
+	precompileregistry "github.com/kava-labs/kava/precompile/registry"
+	liquidtypes "github.com/kava-labs/kava/x/liquid/types"
+)
+
+const (
+	UpgradeName_Mainnet = "v0.27.0"
+	UpgradeName_Testnet = "v0.27.0-alpha.0"
+)
+
+// RegisterUpgradeHandlers registers the upgrade handlers for the app.
+func (app App) RegisterUpgradeHandlers() {
+	app.upgradeKeeper.SetUpgradeHandler(
+		UpgradeName_Mainnet,
+		upgradeHandler(app, UpgradeName_Mainnet),
+	)
+	app.upgradeKeeper.SetUpgradeHandler(
+		UpgradeName_Testnet,
+		upgradeHandler(app, UpgradeName_Testnet),
+	)
+
+	upgradeInfo, err := app.upgradeKeeper.ReadUpgradeInfoFromDisk()
+	if err != nil {
+		panic(err)
+	}
+
+	doUpgrade := upgradeInfo.Name == UpgradeName_Mainnet ||
+		upgradeInfo.Name == UpgradeName_Testnet
+
+	if doUpgrade && !app.upgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		storeUpgrades := storetypes.StoreUpgrades{
+			// x/liquid had no store before the basket liquid staking token
+			Added: []string{liquidtypes.StoreKey},
+		}
+
+		// configure store loader that checks if version == upgradeHeight and applies store upgrades
+		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
+	}
+}
+
+// upgradeHandler returns an UpgradeHandler for the given upgrade parameters.
+func upgradeHandler(
+	app App,
+	name string,
+) upgradetypes.UpgradeHandler {
+	return func(
+		ctx sdk.Context,
+		plan upgradetypes.Plan,
+		fromVM module.VersionMap,
+	) (module.VersionMap, error) {
+		app.Logger().Info(fmt.Sprintf("running %s upgrade handler", name))
+
+		toVM, err := app.mm.RunMigrations(ctx, app.configurator, fromVM)
+		if err != nil {
+			return toVM, err
+		}
+
+		MirrorPrecompileState(ctx, app)
+
+		return toVM, nil
+	}
+}
+
+// MirrorPrecompileState writes the existing module state read by the precompiles to their
+// storage, as the precompile hooks only mirror state changes made after they are enabled.
+func MirrorPrecompileState(ctx sdk.Context, app App) {
+	app.Logger().Info("mirroring delegations to the staking precompile")
+	stakingprecompile.NewStakingHooks(
+		common.HexToAddress(precompileregistry.StakingContractAddress),
+		app.evmKeeper,
+		app.stakingKeeper,
+	).MirrorAllDelegations(ctx)
+
+	app.Logger().Info("mirroring prices to the pricefeed precompile")
+	pricefeedprecompile.NewPricefeedHooks(
+		common.HexToAddress(precompileregistry.PricefeedContractAddress),
+		app.evmKeeper,
+		app.pricefeedKeeper,
+	).MirrorAllPrices(ctx)
+
+	app.Logger().Info("mirroring proposals to the governance precompile")
+	governanceAddress := common.HexToAddress(precompileregistry.GovernanceContractAddress)
+	governanceprecompile.NewGovHooks(governanceAddress, app.evmKeeper, app.govKeeper).MirrorAllProposals(ctx)
+	governanceprecompile.NewCommitteeHooks(governanceAddress, app.evmKeeper, app.committeeKeeper).MirrorAllProposals(ctx)
+}
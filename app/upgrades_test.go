@@ -0,0 +1,14 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterUpgradeHandlers(t *testing.T) {
+	tApp := NewTestApp()
+
+	require.True(t, tApp.upgradeKeeper.HasHandler(UpgradeName_Mainnet))
+	require.True(t, tApp.upgradeKeeper.HasHandler(UpgradeName_Testnet))
+}
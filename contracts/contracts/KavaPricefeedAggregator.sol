@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.18;
+
+import "./interfaces/AggregatorV3Interface.sol";
+import "./interfaces/IKavaPricefeed.sol";
+
+/// @title A Chainlink compatible price feed for a market of the Kava pricefeed.
+/// @notice Reads the current price of a market from the pricefeed precompile.
+///         The pricefeed does not keep price history, so the round ID is the
+///         time the price was last updated and only the latest round can be queried.
+/// @author Kava Labs, LLC
+/// @custom:security-contact security@kava.io
+contract KavaPricefeedAggregator is AggregatorV3Interface {
+    /// @notice The address of the pricefeed precompile.
+    IKavaPricefeed public constant PRICEFEED =
+        IKavaPricefeed(0x9000000000000000000000000000000000000005);
+
+    /// @notice The number of decimals of prices returned by the precompile.
+    uint8 public constant PRICEFEED_DECIMALS = 18;
+
+    /// @notice The market ID of the price feed, for example "kava:usd".
+    string public marketId;
+
+    /// @notice The description of the price feed, for example "KAVA / USD".
+    string public override description;
+
+    /// @notice The decimals of the answers of the price feed.
+    uint8 public immutable override decimals;
+
+    /// @notice Creates a price feed for a market of the Kava pricefeed.
+    /// @param marketId_ The market ID of the price feed.
+    /// @param description_ The description of the price feed.
+    /// @param decimals_ The decimals of the answers, at most 18.
+    constructor(
+        string memory marketId_,
+        string memory description_,
+        uint8 decimals_
+    ) {
+        require(
+            decimals_ <= PRICEFEED_DECIMALS,
+            "KavaPricefeedAggregator: too many decimals"
+        );
+        marketId = marketId_;
+        description = description_;
+        decimals = decimals_;
+    }
+
+    /// @notice The version of the price feed.
+    function version() external pure override returns (uint256) {
+        return 1;
+    }
+
+    /// @notice Query the data of a round. Only the latest round is available.
+    /// @param _roundId The round ID, as returned by latestRoundData.
+    function getRoundData(
+        uint80 _roundId
+    )
+        external
+        view
+        override
+        returns (
+            uint80 roundId,
+            int256 answer,
+            uint256 startedAt,
+            uint256 updatedAt,
+            uint80 answeredInRound
+        )
+    {
+        (
+            roundId,
+            answer,
+            startedAt,
+            updatedAt,
+            answeredInRound
+        ) = _latestRoundData();
+        require(
+            roundId == _roundId,
+            "KavaPricefeedAggregator: round not available"
+        );
+    }
+
+    /// @notice Query the latest price of the market.
+    function latestRoundData()
+        external
+        view
+        override
+        returns (
+            uint80 roundId,
+            int256 answer,
+            uint256 startedAt,
+            uint256 updatedAt,
+            uint80 answeredInRound
+        )
+    {
+        return _latestRoundData();
+    }
+
+    function _latestRoundData()
+        internal
+        view
+        returns (uint80, int256, uint256, uint256, uint80)
+    {
+        (uint256 price, uint256 updatedAt) = PRICEFEED.currentPrice(marketId);
+        int256 answer = int256(price / 10 ** (PRICEFEED_DECIMALS - decimals));
+        uint80 roundId = uint80(updatedAt);
+        return (roundId, answer, updatedAt, updatedAt, roundId);
+    }
+}
@@ -0,0 +1,34 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.18;
+
+/// @title The interface of the Kava pricefeed precompile.
+/// @notice Reads the prices of the markets of the x/pricefeed module. Prices
+///         have 18 decimals and times are unix seconds.
+/// @author Kava Labs, LLC
+/// @custom:security-contact security@kava.io
+interface IKavaPricefeed {
+    /// @notice Query the current price of a market, the median of the prices
+    ///         posted by its oracles. Reverts if the market has no valid price.
+    /// @param marketId The market ID, for example "kava:usd".
+    /// @return price The current price of the market.
+    /// @return updatedAt The time at which the current price was last updated.
+    function currentPrice(
+        string calldata marketId
+    ) external view returns (uint256 price, uint256 updatedAt);
+
+    /// @notice Query the raw prices posted by the oracles of a market.
+    /// @param marketId The market ID, for example "kava:usd".
+    /// @return oracles The oracles that posted each price.
+    /// @return prices The posted prices.
+    /// @return expiries The times at which the posted prices expire.
+    function postedPrices(
+        string calldata marketId
+    )
+        external
+        view
+        returns (
+            address[] memory oracles,
+            uint256[] memory prices,
+            uint256[] memory expiries
+        );
+}
@@ -0,0 +1,507 @@
+package governance
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/precompile/contract"
+
+	"github.com/kava-labs/kava/precompile/msgexec"
+	committeetypes "github.com/kava-labs/kava/x/committee/types"
+)
+
+// Gas charged for each method of the governance precompile. The gas of the
+// voting and proposal methods covers the x/gov and x/committee messages that
+// are executed after the EVM transaction succeeds.
+const (
+	VoteGas                    uint64 = 100_000
+	VoteWeightedGas            uint64 = 150_000
+	SubmitCommitteeProposalGas uint64 = 500_000
+	CommitteeVoteGas           uint64 = 150_000
+	QueryGas                   uint64 = 8_400
+)
+
+const (
+	voteMethod                    = "vote"
+	voteWeightedMethod            = "voteWeighted"
+	submitCommitteeProposalMethod = "submitCommitteeProposal"
+	committeeVoteMethod           = "committeeVote"
+	proposalMethod                = "proposal"
+	tallyMethod                   = "tally"
+	committeeProposalMethod       = "committeeProposal"
+	committeeTallyMethod          = "committeeTally"
+	proposalVoteMethod            = "proposalVote"
+
+	voteEvent                    = "Vote"
+	voteWeightedEvent            = "VoteWeighted"
+	submitCommitteeProposalEvent = "SubmitCommitteeProposal"
+	committeeVoteEvent           = "CommitteeVote"
+)
+
+// Statuses of committee proposals returned by committeeProposal.
+const (
+	CommitteeProposalStatusUnspecified uint8 = iota
+	CommitteeProposalStatusVoting
+	CommitteeProposalStatusPassed
+	CommitteeProposalStatusFailed
+	CommitteeProposalStatusInvalid
+	CommitteeProposalStatusVetoed
+)
+
+const rawABI = `[
+	{
+		"type": "function",
+		"name": "vote",
+		"stateMutability": "nonpayable",
+		"inputs": [
+			{"name": "proposalId", "type": "uint64"},
+			{"name": "option", "type": "uint8"}
+		],
+		"outputs": []
+	},
+	{
+		"type": "function",
+		"name": "voteWeighted",
+		"stateMutability": "nonpayable",
+		"inputs": [
+			{"name": "proposalId", "type": "uint64"},
+			{"name": "options", "type": "uint8[]"},
+			{"name": "weights", "type": "uint256[]"}
+		],
+		"outputs": []
+	},
+	{
+		"type": "function",
+		"name": "submitCommitteeProposal",
+		"stateMutability": "nonpayable",
+		"inputs": [
+			{"name": "committeeId", "type": "uint64"},
+			{"name": "content", "type": "string"}
+		],
+		"outputs": []
+	},
+	{
+		"type": "function",
+		"name": "committeeVote",
+		"stateMutability": "nonpayable",
+		"inputs": [
+			{"name": "proposalId", "type": "uint64"},
+			{"name": "voteType", "type": "uint8"}
+		],
+		"outputs": []
+	},
+	{
+		"type": "function",
+		"name": "proposal",
+		"stateMutability": "view",
+		"inputs": [
+			{"name": "proposalId", "type": "uint64"}
+		],
+		"outputs": [
+			{"name": "status", "type": "uint8"},
+			{"name": "votingStartTime", "type": "uint256"},
+			{"name": "votingEndTime", "type": "uint256"}
+		]
+	},
+	{
+		"type": "function",
+		"name": "tally",
+		"stateMutability": "view",
+		"inputs": [
+			{"name": "proposalId", "type": "uint64"}
+		],
+		"outputs": [
+			{"name": "yes", "type": "uint256"},
+			{"name": "abstain", "type": "uint256"},
+			{"name": "no", "type": "uint256"},
+			{"name": "noWithVeto", "type": "uint256"}
+		]
+	},
+	{
+		"type": "function",
+		"name": "committeeProposal",
+		"stateMutability": "view",
+		"inputs": [
+			{"name": "proposalId", "type": "uint64"}
+		],
+		"outputs": [
+			{"name": "committeeId", "type": "uint64"},
+			{"name": "status", "type": "uint8"},
+			{"name": "deadline", "type": "uint256"}
+		]
+	},
+	{
+		"type": "function",
+		"name": "committeeTally",
+		"stateMutability": "view",
+		"inputs": [
+			{"name": "proposalId", "type": "uint64"}
+		],
+		"outputs": [
+			{"name": "yesVotes", "type": "uint256"},
+			{"name": "noVotes", "type": "uint256"},
+			{"name": "currentVotes", "type": "uint256"},
+			{"name": "possibleVotes", "type": "uint256"}
+		]
+	},
+	{
+		"type": "function",
+		"name": "proposalVote",
+		"stateMutability": "view",
+		"inputs": [
+			{"name": "proposalId", "type": "uint64"},
+			{"name": "voter", "type": "address"}
+		],
+		"outputs": [
+			{"name": "yes", "type": "uint256"},
+			{"name": "abstain", "type": "uint256"},
+			{"name": "no", "type": "uint256"},
+			{"name": "noWithVeto", "type": "uint256"}
+		]
+	},
+	{
+		"type": "event",
+		"name": "Vote",
+		"anonymous": false,
+		"inputs": [
+			{"name": "voter", "type": "address", "indexed": true},
+			{"name": "proposalId", "type": "uint64", "indexed": false},
+			{"name": "option", "type": "uint8", "indexed": false}
+		]
+	},
+	{
+		"type": "event",
+		"name": "VoteWeighted",
+		"anonymous": false,
+		"inputs": [
+			{"name": "voter", "type": "address", "indexed": true},
+			{"name": "proposalId", "type": "uint64", "indexed": false},
+			{"name": "options", "type": "uint8[]", "indexed": false},
+			{"name": "weights", "type": "uint256[]", "indexed": false}
+		]
+	},
+	{
+		"type": "event",
+		"name": "SubmitCommitteeProposal",
+		"anonymous": false,
+		"inputs": [
+			{"name": "proposer", "type": "address", "indexed": true},
+			{"name": "committeeId", "type": "uint64", "indexed": false},
+			{"name": "content", "type": "string", "indexed": false}
+		]
+	},
+	{
+		"type": "event",
+		"name": "CommitteeVote",
+		"anonymous": false,
+		"inputs": [
+			{"name": "voter", "type": "address", "indexed": true},
+			{"name": "proposalId", "type": "uint64", "indexed": false},
+			{"name": "voteType", "type": "uint8", "indexed": false}
+		]
+	}
+]`
+
+// ABI is the interface of the governance precompile.
+var ABI = contract.MustParseABI(rawABI)
+
+// methodEvents maps the methods of the precompile that request messages to the events they emit.
+var methodEvents = map[string]string{
+	voteMethod:                    voteEvent,
+	voteWeightedMethod:            voteWeightedEvent,
+	submitCommitteeProposalMethod: submitCommitteeProposalEvent,
+	committeeVoteMethod:           committeeVoteEvent,
+}
+
+// Prefixes of the storage slots of the governance precompile.
+var (
+	proposalSlotPrefix          = []byte{0x01}
+	tallySlotPrefix             = []byte{0x02}
+	committeeProposalSlotPrefix = []byte{0x03}
+	committeeTallySlotPrefix    = []byte{0x04}
+	voteSlotPrefix              = []byte{0x05}
+)
+
+// ProposalSlot returns the first storage slot of a mirrored x/gov proposal.
+// The status, voting start time and voting end time of the proposal are stored
+// in this slot and the two that follow it.
+func ProposalSlot(proposalID uint64) common.Hash {
+	return idSlot(proposalSlotPrefix, proposalID)
+}
+
+// TallySlot returns the first storage slot of the final tally of a mirrored
+// x/gov proposal. The yes, abstain, no and no with veto counts are stored in
+// this slot and the three that follow it.
+func TallySlot(proposalID uint64) common.Hash {
+	return idSlot(tallySlotPrefix, proposalID)
+}
+
+// CommitteeProposalSlot returns the first storage slot of a mirrored x/committee
+// proposal. The committee ID, status and deadline of the proposal are stored in
+// this slot and the two that follow it.
+func CommitteeProposalSlot(proposalID uint64) common.Hash {
+	return idSlot(committeeProposalSlotPrefix, proposalID)
+}
+
+// CommitteeTallySlot returns the first storage slot of the tally of a mirrored
+// x/committee proposal. The yes, no, current and possible votes are stored in
+// this slot and the three that follow it.
+func CommitteeTallySlot(proposalID uint64) common.Hash {
+	return idSlot(committeeTallySlotPrefix, proposalID)
+}
+
+// VoteSlot returns the first storage slot of the vote of a voter on a mirrored
+// x/gov proposal. The weights of the yes, abstain, no and no with veto options
+// are stored with 18 decimals in this slot and the three that follow it.
+func VoteSlot(proposalID uint64, voter common.Address) common.Hash {
+	idBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(idBz, proposalID)
+	return crypto.Keccak256Hash(voteSlotPrefix, idBz, voter.Bytes())
+}
+
+func idSlot(prefix []byte, id uint64) common.Hash {
+	idBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(idBz, id)
+	return crypto.Keccak256Hash(prefix, idBz)
+}
+
+// offsetSlot returns the storage slot that is offset slots after the given slot.
+func offsetSlot(slot common.Hash, offset int64) common.Hash {
+	return common.BigToHash(new(big.Int).Add(slot.Big(), big.NewInt(offset)))
+}
+
+// NewContract returns a new governance stateful precompiled contract.
+//
+//	This contract lets EVM accounts and contracts, such as multisig wallets, vote on x/gov
+//	proposals and submit and vote on x/committee proposals as their own cosmos account. A
+//	successful call emits a log, which is executed as the equivalent message after the EVM
+//	transaction succeeds. The transaction is reverted if the message fails.
+//
+//	Proposals and tallies are mirrored to the precompile storage by the GovHooks and
+//	CommitteeHooks, so they can be queried by EVM contracts.
+func NewContract() (contract.StatefulPrecompiledContract, error) {
+	precompile, err := contract.NewStatefulPrecompileContract([]*contract.StatefulPrecompileFunction{
+		contract.NewStatefulPrecompileFunction(ABI.Methods[voteMethod].ID, newRecordFunction(voteMethod, VoteGas)),
+		contract.NewStatefulPrecompileFunction(ABI.Methods[voteWeightedMethod].ID, newRecordFunction(voteWeightedMethod, VoteWeightedGas)),
+		contract.NewStatefulPrecompileFunction(ABI.Methods[submitCommitteeProposalMethod].ID, newRecordFunction(submitCommitteeProposalMethod, SubmitCommitteeProposalGas)),
+		contract.NewStatefulPrecompileFunction(ABI.Methods[committeeVoteMethod].ID, newRecordFunction(committeeVoteMethod, CommitteeVoteGas)),
+		contract.NewStatefulPrecompileFunction(ABI.Methods[proposalMethod].ID, newQueryFunction(proposalMethod, idArgSlot(ProposalSlot), []int64{0, 1, 2})),
+		contract.NewStatefulPrecompileFunction(ABI.Methods[tallyMethod].ID, newQueryFunction(tallyMethod, idArgSlot(TallySlot), []int64{0, 1, 2, 3})),
+		contract.NewStatefulPrecompileFunction(ABI.Methods[committeeProposalMethod].ID, newQueryFunction(committeeProposalMethod, idArgSlot(CommitteeProposalSlot), []int64{0, 1, 2})),
+		contract.NewStatefulPrecompileFunction(ABI.Methods[committeeTallyMethod].ID, newQueryFunction(committeeTallyMethod, idArgSlot(CommitteeTallySlot), []int64{0, 1, 2, 3})),
+		contract.NewStatefulPrecompileFunction(ABI.Methods[proposalVoteMethod].ID, newQueryFunction(proposalVoteMethod, voteArgsSlot, []int64{0, 1, 2, 3})),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to instantiate governance precompile: %w", err)
+	}
+
+	return precompile, nil
+}
+
+// newRecordFunction returns a precompile function that validates the
+// arguments of a method and records them in the transaction logs for the caller.
+func newRecordFunction(methodName string, gas uint64) contract.RunStatefulPrecompileFunc {
+	return func(
+		accessibleState contract.AccessibleState,
+		caller common.Address,
+		addr common.Address,
+		input []byte,
+		suppliedGas uint64,
+		readOnly bool,
+	) ([]byte, uint64, error) {
+		remainingGas, err := contract.DeductGas(suppliedGas, gas)
+		if err != nil {
+			return nil, 0, err
+		}
+		if readOnly {
+			return nil, remainingGas, vm.ErrWriteProtection
+		}
+
+		values, err := ABI.Methods[methodName].Inputs.Unpack(input)
+		if err != nil {
+			return nil, remainingGas, fmt.Errorf("failed to unpack input: %w", err)
+		}
+		if err := validateArgs(methodName, values); err != nil {
+			return nil, remainingGas, err
+		}
+
+		event := ABI.Events[methodEvents[methodName]]
+		data, err := event.Inputs.NonIndexed().Pack(values...)
+		if err != nil {
+			return nil, remainingGas, fmt.Errorf("failed to pack event: %w", err)
+		}
+		accessibleState.GetStateDB().AddLog(&ethtypes.Log{
+			Address: addr,
+			Topics:  []common.Hash{event.ID, common.BytesToHash(caller.Bytes())},
+			Data:    data,
+		})
+
+		return nil, remainingGas, nil
+	}
+}
+
+// validateArgs validates the arguments of a method. The message is fully
+// validated when it is executed.
+func validateArgs(methodName string, values []interface{}) error {
+	switch methodName {
+	case voteMethod:
+		if !govv1.ValidVoteOption(govv1.VoteOption(values[1].(uint8))) {
+			return fmt.Errorf("invalid vote option %d", values[1])
+		}
+	case voteWeightedMethod:
+		options, weights := values[1].([]uint8), values[2].([]*big.Int)
+		if len(options) == 0 || len(options) != len(weights) {
+			return fmt.Errorf("options and weights must be non-empty and of equal length")
+		}
+		for _, option := range options {
+			if !govv1.ValidVoteOption(govv1.VoteOption(option)) {
+				return fmt.Errorf("invalid vote option %d", option)
+			}
+		}
+	case submitCommitteeProposalMethod:
+		if !json.Valid([]byte(values[1].(string))) {
+			return fmt.Errorf("proposal content must be json")
+		}
+	case committeeVoteMethod:
+		if err := committeetypes.VoteType(values[1].(uint8)).Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// idArgSlot returns the slot of the ID argument of a query method.
+func idArgSlot(slotFn func(uint64) common.Hash) func([]interface{}) common.Hash {
+	return func(values []interface{}) common.Hash {
+		return slotFn(values[0].(uint64))
+	}
+}
+
+// voteArgsSlot returns the slot of the proposal ID and voter arguments of a query method.
+func voteArgsSlot(values []interface{}) common.Hash {
+	return VoteSlot(values[0].(uint64), values[1].(common.Address))
+}
+
+// newQueryFunction returns a precompile function that returns the values
+// mirrored in the storage slots at the given offsets from the slot of its arguments.
+func newQueryFunction(methodName string, slotFn func([]interface{}) common.Hash, offsets []int64) contract.RunStatefulPrecompileFunc {
+	return func(
+		accessibleState contract.AccessibleState,
+		_ common.Address,
+		addr common.Address,
+		input []byte,
+		suppliedGas uint64,
+		_ bool,
+	) ([]byte, uint64, error) {
+		remainingGas, err := contract.DeductGas(suppliedGas, QueryGas)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		method := ABI.Methods[methodName]
+		values, err := method.Inputs.Unpack(input)
+		if err != nil {
+			return nil, remainingGas, fmt.Errorf("failed to unpack input: %w", err)
+		}
+
+		stateDB := accessibleState.GetStateDB()
+		slot := slotFn(values)
+		outputs := make([]interface{}, len(offsets))
+		for i, offset := range offsets {
+			outputs[i] = convertOutput(method.Outputs[i], stateDB.GetState(addr, offsetSlot(slot, offset)).Big())
+		}
+
+		ret, err := method.Outputs.Pack(outputs...)
+		if err != nil {
+			return nil, remainingGas, fmt.Errorf("failed to pack output: %w", err)
+		}
+
+		return ret, remainingGas, nil
+	}
+}
+
+// convertOutput converts a stored value to the go type of an abi output.
+func convertOutput(output abi.Argument, value *big.Int) interface{} {
+	switch output.Type.T {
+	case abi.UintTy:
+		switch output.Type.Size {
+		case 8:
+			return uint8(value.Uint64())
+		case 64:
+			return value.Uint64()
+		}
+	}
+	return value
+}
+
+// NewRequestParser returns a parser of the logs of the governance precompile
+// that decodes committee proposal contents with the given codec.
+func NewRequestParser(cdc codec.Codec) msgexec.RequestParser {
+	return func(log *ethtypes.Log) (msgexec.Request, bool, error) {
+		return newRequestFromLog(cdc, log)
+	}
+}
+
+func newRequestFromLog(cdc codec.Codec, log *ethtypes.Log) (msgexec.Request, bool, error) {
+	if len(log.Topics) != 2 {
+		return msgexec.Request{}, false, nil
+	}
+
+	var eventName string
+	for _, name := range methodEvents {
+		if log.Topics[0] == ABI.Events[name].ID {
+			eventName = name
+		}
+	}
+	if eventName == "" {
+		return msgexec.Request{}, false, nil
+	}
+
+	values, err := ABI.Events[eventName].Inputs.NonIndexed().Unpack(log.Data)
+	if err != nil {
+		return msgexec.Request{}, true, fmt.Errorf("failed to unpack log: %w", err)
+	}
+
+	account := common.BytesToAddress(log.Topics[1].Bytes())
+	sender := sdk.AccAddress(account.Bytes())
+	req := msgexec.Request{Account: account}
+
+	switch eventName {
+	case voteEvent:
+		req.Msg = govv1.NewMsgVote(sender, values[0].(uint64), govv1.VoteOption(values[1].(uint8)), "")
+	case voteWeightedEvent:
+		options, weights := values[1].([]uint8), values[2].([]*big.Int)
+		weightedOptions := make(govv1.WeightedVoteOptions, len(options))
+		for i, option := range options {
+			weight := sdk.NewDecFromBigIntWithPrec(weights[i], sdk.Precision)
+			weightedOptions[i] = govv1.NewWeightedVoteOption(govv1.VoteOption(option), weight)
+		}
+		req.Msg = govv1.NewMsgVoteWeighted(sender, values[0].(uint64), weightedOptions, "")
+	case submitCommitteeProposalEvent:
+		var content govv1beta1.Content
+		if err := cdc.UnmarshalInterfaceJSON([]byte(values[1].(string)), &content); err != nil {
+			return msgexec.Request{}, true, fmt.Errorf("failed to decode proposal content: %w", err)
+		}
+		msg, err := committeetypes.NewMsgSubmitProposal(content, sender, values[0].(uint64))
+		if err != nil {
+			return msgexec.Request{}, true, err
+		}
+		req.Msg = msg
+	default:
+		req.Msg = committeetypes.NewMsgVote(sender, values[0].(uint64), committeetypes.VoteType(values[1].(uint8)))
+	}
+
+	return req, true, nil
+}
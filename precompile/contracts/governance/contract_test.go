@@ -0,0 +1,337 @@
+package governance_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/precompile/contract"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/precompile/contracts/governance"
+	"github.com/kava-labs/kava/precompile/msgexec"
+	committeetypes "github.com/kava-labs/kava/x/committee/types"
+)
+
+// mockStateDB records logs and storage and panics on any other state access.
+type mockStateDB struct {
+	contract.StateDB
+	logs    []*ethtypes.Log
+	storage map[common.Hash]common.Hash
+}
+
+func newMockStateDB() *mockStateDB {
+	return &mockStateDB{storage: make(map[common.Hash]common.Hash)}
+}
+
+func (s *mockStateDB) AddLog(log *ethtypes.Log) {
+	s.logs = append(s.logs, log)
+}
+
+func (s *mockStateDB) GetState(_ common.Address, key common.Hash) common.Hash {
+	return s.storage[key]
+}
+
+type mockAccessibleState struct {
+	stateDB *mockStateDB
+}
+
+func (s mockAccessibleState) GetStateDB() contract.StateDB {
+	return s.stateDB
+}
+
+var (
+	precompileAddr = common.HexToAddress("0x9000000000000000000000000000000000000008")
+	caller         = common.HexToAddress("0x7Bbf300890857b8c241b219C6a489431669b3aFA")
+	callerAcc      = sdk.AccAddress(caller.Bytes())
+)
+
+// TestContractConstructor ensures we have a valid constructor. This will fail
+// if we attempt to define invalid or duplicate function selectors.
+func TestContractConstructor(t *testing.T) {
+	precompile, err := governance.NewContract()
+	require.NoError(t, err, "expected precompile not error when created")
+	assert.NotNil(t, precompile, "expected precompile contract to be defined")
+}
+
+func TestMethods(t *testing.T) {
+	cdc := app.MakeEncodingConfig().Marshaler
+
+	content := &govv1beta1.TextProposal{Title: "A Title", Description: "A description of this proposal."}
+	contentJSON, err := cdc.MarshalInterfaceJSON(content)
+	require.NoError(t, err)
+	submitProposal, err := committeetypes.NewMsgSubmitProposal(content, callerAcc, 1)
+	require.NoError(t, err)
+
+	halfWeight := sdk.MustNewDecFromStr("0.5")
+
+	testCases := []struct {
+		name        string
+		method      string
+		args        []interface{}
+		gas         uint64
+		readOnly    bool
+		expectedReq msgexec.Request
+		expectedErr string
+	}{
+		{
+			name:   "vote",
+			method: "vote",
+			args:   []interface{}{uint64(3), uint8(govv1.OptionYes)},
+			gas:    governance.VoteGas,
+			expectedReq: msgexec.Request{
+				Account: caller,
+				Msg:     govv1.NewMsgVote(callerAcc, 3, govv1.OptionYes, ""),
+			},
+		},
+		{
+			name:   "vote weighted",
+			method: "voteWeighted",
+			args: []interface{}{
+				uint64(3),
+				[]uint8{uint8(govv1.OptionYes), uint8(govv1.OptionNo)},
+				[]*big.Int{halfWeight.BigInt(), halfWeight.BigInt()},
+			},
+			gas: governance.VoteWeightedGas,
+			expectedReq: msgexec.Request{
+				Account: caller,
+				Msg: govv1.NewMsgVoteWeighted(callerAcc, 3, govv1.WeightedVoteOptions{
+					govv1.NewWeightedVoteOption(govv1.OptionYes, halfWeight),
+					govv1.NewWeightedVoteOption(govv1.OptionNo, halfWeight),
+				}, ""),
+			},
+		},
+		{
+			name:        "submit committee proposal",
+			method:      "submitCommitteeProposal",
+			args:        []interface{}{uint64(1), string(contentJSON)},
+			gas:         governance.SubmitCommitteeProposalGas,
+			expectedReq: msgexec.Request{Account: caller, Msg: submitProposal},
+		},
+		{
+			name:   "committee vote",
+			method: "committeeVote",
+			args:   []interface{}{uint64(2), uint8(committeetypes.VOTE_TYPE_NO)},
+			gas:    governance.CommitteeVoteGas,
+			expectedReq: msgexec.Request{
+				Account: caller,
+				Msg:     committeetypes.NewMsgVote(callerAcc, 2, committeetypes.VOTE_TYPE_NO),
+			},
+		},
+		{
+			name:        "out of gas",
+			method:      "vote",
+			args:        []interface{}{uint64(3), uint8(govv1.OptionYes)},
+			gas:         governance.VoteGas - 1,
+			expectedErr: "out of gas",
+		},
+		{
+			name:        "read only",
+			method:      "committeeVote",
+			args:        []interface{}{uint64(2), uint8(committeetypes.VOTE_TYPE_YES)},
+			gas:         governance.CommitteeVoteGas,
+			readOnly:    true,
+			expectedErr: vm.ErrWriteProtection.Error(),
+		},
+		{
+			name:        "invalid vote option",
+			method:      "vote",
+			args:        []interface{}{uint64(3), uint8(9)},
+			gas:         governance.VoteGas,
+			expectedErr: "invalid vote option 9",
+		},
+		{
+			name:        "mismatched weights",
+			method:      "voteWeighted",
+			args:        []interface{}{uint64(3), []uint8{uint8(govv1.OptionYes)}, []*big.Int{}},
+			gas:         governance.VoteWeightedGas,
+			expectedErr: "options and weights must be non-empty and of equal length",
+		},
+		{
+			name:        "invalid content",
+			method:      "submitCommitteeProposal",
+			args:        []interface{}{uint64(1), "not json"},
+			gas:         governance.SubmitCommitteeProposalGas,
+			expectedErr: "proposal content must be json",
+		},
+		{
+			name:        "invalid vote type",
+			method:      "committeeVote",
+			args:        []interface{}{uint64(2), uint8(0)},
+			gas:         governance.CommitteeVoteGas,
+			expectedErr: "invalid vote type",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			precompile, err := governance.NewContract()
+			require.NoError(t, err)
+
+			state := mockAccessibleState{stateDB: newMockStateDB()}
+			input, err := governance.ABI.Pack(tc.method, tc.args...)
+			require.NoError(t, err)
+			ret, remainingGas, err := precompile.Run(state, caller, precompileAddr, input, tc.gas, tc.readOnly)
+
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+				require.Empty(t, state.stateDB.logs)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Empty(t, ret)
+			require.Equal(t, uint64(0), remainingGas)
+			require.Len(t, state.stateDB.logs, 1)
+
+			req, found, err := governance.NewRequestParser(cdc)(state.stateDB.logs[0])
+			require.NoError(t, err)
+			require.True(t, found)
+			require.Equal(t, tc.expectedReq, req)
+		})
+	}
+}
+
+func TestNewRequestParser_InvalidContent(t *testing.T) {
+	event := governance.ABI.Events["SubmitCommitteeProposal"]
+	data, err := event.Inputs.NonIndexed().Pack(uint64(1), `{"@type": "/unknown.Proposal"}`)
+	require.NoError(t, err)
+
+	parser := governance.NewRequestParser(app.MakeEncodingConfig().Marshaler)
+	_, found, err := parser(&ethtypes.Log{
+		Topics: []common.Hash{event.ID, common.BytesToHash(caller.Bytes())},
+		Data:   data,
+	})
+	require.ErrorContains(t, err, "failed to decode proposal content")
+	require.True(t, found)
+}
+
+func TestNewRequestParser_OtherLogs(t *testing.T) {
+	parser := governance.NewRequestParser(app.MakeEncodingConfig().Marshaler)
+
+	_, found, err := parser(&ethtypes.Log{Topics: []common.Hash{{1}, {2}}})
+	require.NoError(t, err)
+	require.False(t, found)
+
+	_, found, err = parser(&ethtypes.Log{})
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func TestQueries(t *testing.T) {
+	precompile, err := governance.NewContract()
+	require.NoError(t, err)
+	state := mockAccessibleState{stateDB: newMockStateDB()}
+
+	setValues := func(slot common.Hash, values ...int64) {
+		for i, value := range values {
+			key := common.BigToHash(new(big.Int).Add(slot.Big(), big.NewInt(int64(i))))
+			state.stateDB.storage[key] = common.BigToHash(big.NewInt(value))
+		}
+	}
+	setValues(governance.ProposalSlot(3), int64(govv1.StatusPassed), 1700000000, 1700086400)
+	setValues(governance.TallySlot(3), 100, 20, 30, 4)
+	setValues(governance.CommitteeProposalSlot(2), 1, int64(governance.CommitteeProposalStatusVoting), 1700003600)
+	setValues(governance.CommitteeTallySlot(2), 1e18, 0, 1e18, 3e18)
+
+	testCases := []struct {
+		name     string
+		method   string
+		expected []interface{}
+	}{
+		{
+			name:   "proposal",
+			method: "proposal",
+			expected: []interface{}{
+				uint8(govv1.StatusPassed), big.NewInt(1700000000), big.NewInt(1700086400),
+			},
+		},
+		{
+			name:   "tally",
+			method: "tally",
+			expected: []interface{}{
+				big.NewInt(100), big.NewInt(20), big.NewInt(30), big.NewInt(4),
+			},
+		},
+		{
+			name:   "committee proposal",
+			method: "committeeProposal",
+			expected: []interface{}{
+				uint64(1), governance.CommitteeProposalStatusVoting, big.NewInt(1700003600),
+			},
+		},
+		{
+			name:   "committee tally",
+			method: "committeeTally",
+			expected: []interface{}{
+				big.NewInt(1e18), big.NewInt(0), big.NewInt(1e18), big.NewInt(3e18),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			id := uint64(3)
+			if tc.method == "committeeProposal" || tc.method == "committeeTally" {
+				id = 2
+			}
+			input, err := governance.ABI.Pack(tc.method, id)
+			require.NoError(t, err)
+
+			ret, remainingGas, err := precompile.Run(state, caller, precompileAddr, input, governance.QueryGas, true)
+			require.NoError(t, err)
+			require.Equal(t, uint64(0), remainingGas)
+
+			outputs, err := governance.ABI.Unpack(tc.method, ret)
+			require.NoError(t, err)
+			requireOutputs(t, tc.expected, outputs)
+		})
+	}
+
+	t.Run("proposal vote", func(t *testing.T) {
+		voter := common.HexToAddress("0x1000000000000000000000000000000000000001")
+		setValues(governance.VoteSlot(3, voter), 5e17, 0, 5e17, 0)
+
+		input, err := governance.ABI.Pack("proposalVote", uint64(3), voter)
+		require.NoError(t, err)
+
+		ret, remainingGas, err := precompile.Run(state, caller, precompileAddr, input, governance.QueryGas, true)
+		require.NoError(t, err)
+		require.Equal(t, uint64(0), remainingGas)
+
+		outputs, err := governance.ABI.Unpack("proposalVote", ret)
+		require.NoError(t, err)
+		requireOutputs(t, []interface{}{big.NewInt(5e17), big.NewInt(0), big.NewInt(5e17), big.NewInt(0)}, outputs)
+	})
+
+	t.Run("unknown proposal", func(t *testing.T) {
+		input, err := governance.ABI.Pack("proposal", uint64(99))
+		require.NoError(t, err)
+
+		ret, _, err := precompile.Run(state, caller, precompileAddr, input, governance.QueryGas, true)
+		require.NoError(t, err)
+
+		outputs, err := governance.ABI.Unpack("proposal", ret)
+		require.NoError(t, err)
+		requireOutputs(t, []interface{}{uint8(0), big.NewInt(0), big.NewInt(0)}, outputs)
+	})
+}
+
+// requireOutputs compares unpacked outputs, comparing big.Ints by value.
+func requireOutputs(t *testing.T, expected, actual []interface{}) {
+	require.Len(t, actual, len(expected))
+	for i := range expected {
+		if expectedInt, ok := expected[i].(*big.Int); ok {
+			require.Zero(t, expectedInt.Cmp(actual[i].(*big.Int)), "expected %s, got %s", expectedInt, actual[i])
+			continue
+		}
+		require.Equal(t, expected[i], actual[i])
+	}
+}
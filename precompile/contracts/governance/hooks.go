@@ -0,0 +1,281 @@
+package governance
+
+import (
+	"math/big"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/ethereum/go-ethereum/common"
+
+	committeetypes "github.com/kava-labs/kava/x/committee/types"
+)
+
+// GovKeeper defines the expected gov keeper.
+type GovKeeper interface {
+	GetProposal(ctx sdk.Context, proposalID uint64) (govv1.Proposal, bool)
+	IterateProposals(ctx sdk.Context, cb func(proposal govv1.Proposal) (stop bool))
+	GetVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress) (govv1.Vote, bool)
+	IterateVotes(ctx sdk.Context, proposalID uint64, cb func(vote govv1.Vote) (stop bool))
+}
+
+// CommitteeKeeper defines the expected committee keeper.
+type CommitteeKeeper interface {
+	GetProposal(ctx sdk.Context, proposalID uint64) (committeetypes.Proposal, bool)
+	GetProposalTallyResponse(ctx sdk.Context, proposalID uint64) (*committeetypes.QueryTallyResponse, bool)
+	IterateProposals(ctx sdk.Context, cb func(proposal committeetypes.Proposal) (stop bool))
+}
+
+// EvmKeeper defines the expected evm keeper.
+type EvmKeeper interface {
+	SetState(ctx sdk.Context, addr common.Address, key common.Hash, value []byte)
+}
+
+var _ govtypes.GovHooks = GovHooks{}
+
+// GovHooks mirrors the status, voting period, votes and final tally of x/gov
+// proposals to the storage of the governance precompile, so they can be
+// queried by EVM contracts.
+type GovHooks struct {
+	address   common.Address
+	evmKeeper EvmKeeper
+	govKeeper GovKeeper
+}
+
+// NewGovHooks returns the x/gov hooks of the governance precompile at the given address.
+func NewGovHooks(address common.Address, evmKeeper EvmKeeper, govKeeper GovKeeper) GovHooks {
+	return GovHooks{
+		address:   address,
+		evmKeeper: evmKeeper,
+		govKeeper: govKeeper,
+	}
+}
+
+// MirrorAllProposals writes all x/gov proposals and their votes to the precompile storage.
+// It must be run when the precompile is enabled on a chain with existing proposals.
+func (h GovHooks) MirrorAllProposals(ctx sdk.Context) {
+	h.govKeeper.IterateProposals(ctx, func(proposal govv1.Proposal) bool {
+		h.mirrorProposal(ctx, proposal.Id)
+		h.govKeeper.IterateVotes(ctx, proposal.Id, func(vote govv1.Vote) bool {
+			h.setVote(ctx, vote)
+			return false
+		})
+		return false
+	})
+}
+
+// AfterProposalSubmission writes the new proposal to the precompile storage.
+func (h GovHooks) AfterProposalSubmission(ctx sdk.Context, proposalID uint64) {
+	h.mirrorProposal(ctx, proposalID)
+}
+
+// AfterProposalDeposit writes the proposal to the precompile storage, as a
+// deposit may start its voting period.
+func (h GovHooks) AfterProposalDeposit(ctx sdk.Context, proposalID uint64, _ sdk.AccAddress) {
+	h.mirrorProposal(ctx, proposalID)
+}
+
+// AfterProposalVote writes the vote to the precompile storage. The tally is
+// only mirrored when voting ends, as x/gov only tallies votes then.
+func (h GovHooks) AfterProposalVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress) {
+	vote, found := h.govKeeper.GetVote(ctx, proposalID, voterAddr)
+	if !found {
+		return
+	}
+	h.setVote(ctx, vote)
+}
+
+// AfterProposalFailedMinDeposit clears the deleted proposal from the precompile storage.
+func (h GovHooks) AfterProposalFailedMinDeposit(ctx sdk.Context, proposalID uint64) {
+	h.mirrorProposal(ctx, proposalID)
+}
+
+// AfterProposalVotingPeriodEnded writes the final status and tally of the
+// proposal to the precompile storage.
+func (h GovHooks) AfterProposalVotingPeriodEnded(ctx sdk.Context, proposalID uint64) {
+	h.mirrorProposal(ctx, proposalID)
+}
+
+// mirrorProposal writes a proposal to the precompile storage, or clears it if
+// the proposal does not exist.
+func (h GovHooks) mirrorProposal(ctx sdk.Context, proposalID uint64) {
+	proposal, found := h.govKeeper.GetProposal(ctx, proposalID)
+	if !found {
+		h.setValues(ctx, ProposalSlot(proposalID), nil, nil, nil)
+		h.setValues(ctx, TallySlot(proposalID), nil, nil, nil, nil)
+		return
+	}
+
+	h.setValues(
+		ctx, ProposalSlot(proposalID),
+		big.NewInt(int64(proposal.Status)), unixTime(proposal.VotingStartTime), unixTime(proposal.VotingEndTime),
+	)
+
+	tally := proposal.FinalTallyResult
+	if tally == nil {
+		tally = &govv1.TallyResult{}
+	}
+	h.setValues(
+		ctx, TallySlot(proposalID),
+		parseCount(tally.YesCount), parseCount(tally.AbstainCount), parseCount(tally.NoCount), parseCount(tally.NoWithVetoCount),
+	)
+}
+
+// setVote writes the weight of each option of a vote to the precompile storage.
+// The weight of an option is stored at the offset of its position in the tally.
+func (h GovHooks) setVote(ctx sdk.Context, vote govv1.Vote) {
+	voter, err := sdk.AccAddressFromBech32(vote.Voter)
+	if err != nil {
+		return
+	}
+
+	weights := make([]*big.Int, 4)
+	for _, option := range vote.Options {
+		if option.Option < govv1.OptionYes || option.Option > govv1.OptionNoWithVeto {
+			continue
+		}
+		weight, err := sdk.NewDecFromStr(option.Weight)
+		if err != nil {
+			continue
+		}
+		weights[option.Option-govv1.OptionYes] = decValue(weight)
+	}
+	h.setValues(ctx, VoteSlot(vote.ProposalId, common.BytesToAddress(voter)), weights...)
+}
+
+// setValues writes values to consecutive storage slots, clearing the slots of nil values.
+func (h GovHooks) setValues(ctx sdk.Context, slot common.Hash, values ...*big.Int) {
+	setValues(ctx, h.evmKeeper, h.address, slot, values...)
+}
+
+var _ committeetypes.CommitteeHooks = CommitteeHooks{}
+
+// CommitteeHooks mirrors the status, deadline and tally of x/committee
+// proposals to the storage of the governance precompile, so they can be
+// queried by EVM contracts.
+type CommitteeHooks struct {
+	address         common.Address
+	evmKeeper       EvmKeeper
+	committeeKeeper CommitteeKeeper
+}
+
+// NewCommitteeHooks returns the x/committee hooks of the governance precompile at the given address.
+func NewCommitteeHooks(address common.Address, evmKeeper EvmKeeper, committeeKeeper CommitteeKeeper) CommitteeHooks {
+	return CommitteeHooks{
+		address:         address,
+		evmKeeper:       evmKeeper,
+		committeeKeeper: committeeKeeper,
+	}
+}
+
+// MirrorAllProposals writes all x/committee proposals to the precompile storage. It
+// must be run when the precompile is enabled on a chain with existing proposals.
+func (h CommitteeHooks) MirrorAllProposals(ctx sdk.Context) {
+	h.committeeKeeper.IterateProposals(ctx, func(proposal committeetypes.Proposal) bool {
+		h.mirrorProposal(ctx, proposal.ID)
+		return false
+	})
+}
+
+// AfterProposalSubmission writes the new proposal to the precompile storage.
+func (h CommitteeHooks) AfterProposalSubmission(ctx sdk.Context, proposalID uint64) {
+	h.mirrorProposal(ctx, proposalID)
+}
+
+// AfterProposalVote writes the updated tally of the proposal to the precompile storage.
+func (h CommitteeHooks) AfterProposalVote(ctx sdk.Context, proposalID uint64, _ sdk.AccAddress) {
+	h.mirrorProposal(ctx, proposalID)
+}
+
+// AfterProposalClosed writes the outcome and final tally of the deleted
+// proposal to the precompile storage.
+func (h CommitteeHooks) AfterProposalClosed(
+	ctx sdk.Context,
+	proposal committeetypes.Proposal,
+	outcome committeetypes.ProposalOutcome,
+	tally *committeetypes.QueryTallyResponse,
+) {
+	var status uint8
+	switch outcome {
+	case committeetypes.Passed:
+		status = CommitteeProposalStatusPassed
+	case committeetypes.Failed:
+		status = CommitteeProposalStatusFailed
+	case committeetypes.Vetoed:
+		status = CommitteeProposalStatusVetoed
+	default:
+		status = CommitteeProposalStatusInvalid
+	}
+	h.setProposal(ctx, proposal, status, tally)
+}
+
+// mirrorProposal writes an open proposal and its current tally to the precompile storage.
+func (h CommitteeHooks) mirrorProposal(ctx sdk.Context, proposalID uint64) {
+	proposal, found := h.committeeKeeper.GetProposal(ctx, proposalID)
+	if !found {
+		return
+	}
+	tally, found := h.committeeKeeper.GetProposalTallyResponse(ctx, proposalID)
+	if !found {
+		return
+	}
+	h.setProposal(ctx, proposal, CommitteeProposalStatusVoting, tally)
+}
+
+func (h CommitteeHooks) setProposal(
+	ctx sdk.Context,
+	proposal committeetypes.Proposal,
+	status uint8,
+	tally *committeetypes.QueryTallyResponse,
+) {
+	setValues(
+		ctx, h.evmKeeper, h.address, CommitteeProposalSlot(proposal.ID),
+		new(big.Int).SetUint64(proposal.CommitteeID), big.NewInt(int64(status)), big.NewInt(proposal.Deadline.Unix()),
+	)
+
+	if tally == nil {
+		tally = &committeetypes.QueryTallyResponse{}
+	}
+	setValues(
+		ctx, h.evmKeeper, h.address, CommitteeTallySlot(proposal.ID),
+		decValue(tally.YesVotes), decValue(tally.NoVotes), decValue(tally.CurrentVotes), decValue(tally.PossibleVotes),
+	)
+}
+
+// setValues writes values to consecutive storage slots, clearing the slots of nil values.
+func setValues(ctx sdk.Context, evmKeeper EvmKeeper, address common.Address, slot common.Hash, values ...*big.Int) {
+	for i, value := range values {
+		var bz []byte
+		if value != nil && value.Sign() != 0 {
+			bz = common.BigToHash(value).Bytes()
+		}
+		evmKeeper.SetState(ctx, address, offsetSlot(slot, int64(i)), bz)
+	}
+}
+
+// unixTime returns the unix seconds of a time, or zero if it is not set.
+func unixTime(t *time.Time) *big.Int {
+	if t == nil || t.IsZero() {
+		return big.NewInt(0)
+	}
+	return big.NewInt(t.Unix())
+}
+
+// parseCount returns the integer of a tally count, or zero if it is not valid.
+func parseCount(count string) *big.Int {
+	value, ok := sdkmath.NewIntFromString(count)
+	if !ok || value.IsNegative() {
+		return big.NewInt(0)
+	}
+	return value.BigInt()
+}
+
+// decValue returns a decimal with 18 decimals as an integer, or zero if it is not set.
+func decValue(dec sdk.Dec) *big.Int {
+	if dec.IsNil() || dec.IsNegative() {
+		return big.NewInt(0)
+	}
+	return dec.BigInt()
+}
@@ -0,0 +1,139 @@
+package governance_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/precompile/contracts/governance"
+	"github.com/kava-labs/kava/precompile/registry"
+	committeetypes "github.com/kava-labs/kava/x/committee/types"
+)
+
+type hooksTestSuite struct {
+	suite.Suite
+
+	App app.TestApp
+	Ctx sdk.Context
+
+	address common.Address
+	members []sdk.AccAddress
+}
+
+func TestHooksTestSuite(t *testing.T) {
+	suite.Run(t, new(hooksTestSuite))
+}
+
+func (suite *hooksTestSuite) SetupTest() {
+	suite.App = app.NewTestApp()
+	suite.App.InitializeFromGenesisStates()
+	suite.Ctx = suite.App.NewContext(true, tmproto.Header{Height: 1, Time: time.Unix(1700000000, 0).UTC()})
+
+	suite.address = common.HexToAddress(registry.GovernanceContractAddress)
+	suite.members = []sdk.AccAddress{app.RandomAddress(), app.RandomAddress()}
+}
+
+func (suite *hooksTestSuite) getState(slot common.Hash, offset int64) *big.Int {
+	key := common.BigToHash(new(big.Int).Add(slot.Big(), big.NewInt(offset)))
+	return suite.App.GetEvmKeeper().GetState(suite.Ctx, suite.address, key).Big()
+}
+
+func (suite *hooksTestSuite) TestGovProposal() {
+	govKeeper := suite.App.GetGovKeeper()
+	proposer := suite.members[0]
+
+	proposal, err := govKeeper.SubmitProposal(suite.Ctx, nil, "", "A Title", "A summary.", proposer)
+	suite.Require().NoError(err)
+	slot := governance.ProposalSlot(proposal.Id)
+
+	suite.Equal(int64(govv1.StatusDepositPeriod), suite.getState(slot, 0).Int64())
+	suite.Zero(suite.getState(slot, 1).Sign())
+
+	minDeposit := govKeeper.GetParams(suite.Ctx).MinDeposit
+	suite.Require().NoError(suite.App.FundAccount(suite.Ctx, proposer, minDeposit))
+	_, err = govKeeper.AddDeposit(suite.Ctx, proposal.Id, proposer, minDeposit)
+	suite.Require().NoError(err)
+
+	proposal, found := govKeeper.GetProposal(suite.Ctx, proposal.Id)
+	suite.Require().True(found)
+	suite.Equal(int64(govv1.StatusVotingPeriod), suite.getState(slot, 0).Int64())
+	suite.Equal(proposal.VotingStartTime.Unix(), suite.getState(slot, 1).Int64())
+	suite.Equal(proposal.VotingEndTime.Unix(), suite.getState(slot, 2).Int64())
+
+	voter := suite.members[1]
+	suite.Require().NoError(govKeeper.AddVote(suite.Ctx, proposal.Id, voter, govv1.WeightedVoteOptions{
+		govv1.NewWeightedVoteOption(govv1.OptionYes, sdk.MustNewDecFromStr("0.75")),
+		govv1.NewWeightedVoteOption(govv1.OptionNoWithVeto, sdk.MustNewDecFromStr("0.25")),
+	}, ""))
+	voteSlot := governance.VoteSlot(proposal.Id, common.BytesToAddress(voter))
+	for i, expected := range []sdk.Dec{sdk.MustNewDecFromStr("0.75"), sdk.ZeroDec(), sdk.ZeroDec(), sdk.MustNewDecFromStr("0.25")} {
+		suite.Zero(expected.BigInt().Cmp(suite.getState(voteSlot, int64(i))))
+	}
+
+	// end the voting period as the gov end blocker does
+	proposal.Status = govv1.StatusPassed
+	finalTally := govv1.TallyResult{YesCount: "100", AbstainCount: "20", NoCount: "30", NoWithVetoCount: "4"}
+	proposal.FinalTallyResult = &finalTally
+	govKeeper.SetProposal(suite.Ctx, proposal)
+	hooks := governance.NewGovHooks(suite.address, suite.App.GetEvmKeeper(), govKeeper)
+	hooks.AfterProposalVotingPeriodEnded(suite.Ctx, proposal.Id)
+
+	suite.Equal(int64(govv1.StatusPassed), suite.getState(slot, 0).Int64())
+	for i, expected := range []int64{100, 20, 30, 4} {
+		suite.Equal(expected, suite.getState(governance.TallySlot(proposal.Id), int64(i)).Int64())
+	}
+
+	// deleted proposals are cleared
+	govKeeper.DeleteProposal(suite.Ctx, proposal.Id)
+	hooks.AfterProposalFailedMinDeposit(suite.Ctx, proposal.Id)
+	for i := int64(0); i < 3; i++ {
+		suite.Zero(suite.getState(slot, i).Sign())
+	}
+	for i := int64(0); i < 4; i++ {
+		suite.Zero(suite.getState(governance.TallySlot(proposal.Id), i).Sign())
+	}
+}
+
+func (suite *hooksTestSuite) TestCommitteeProposal() {
+	committeeKeeper := suite.App.GetCommitteeKeeper()
+	committeeKeeper.SetCommittee(suite.Ctx, committeetypes.MustNewMemberCommittee(
+		1,
+		"This member committee is for testing.",
+		suite.members,
+		[]committeetypes.Permission{&committeetypes.TextPermission{}},
+		sdk.MustNewDecFromStr("0.5"),
+		time.Hour*24*7,
+		committeetypes.TALLY_OPTION_FIRST_PAST_THE_POST,
+	))
+
+	content := govv1beta1.NewTextProposal("A Title", "A description of this proposal.")
+	proposalID, err := committeeKeeper.SubmitProposal(suite.Ctx, suite.members[0], 1, content)
+	suite.Require().NoError(err)
+	slot := governance.CommitteeProposalSlot(proposalID)
+	tallySlot := governance.CommitteeTallySlot(proposalID)
+
+	suite.Equal(int64(1), suite.getState(slot, 0).Int64())
+	suite.Equal(int64(governance.CommitteeProposalStatusVoting), suite.getState(slot, 1).Int64())
+	suite.Equal(suite.Ctx.BlockTime().Add(time.Hour*24*7).Unix(), suite.getState(slot, 2).Int64())
+	suite.Zero(suite.getState(tallySlot, 0).Sign())
+	suite.Equal(sdk.NewDec(2).BigInt(), suite.getState(tallySlot, 3))
+
+	suite.Require().NoError(committeeKeeper.AddVote(suite.Ctx, proposalID, suite.members[0], committeetypes.VOTE_TYPE_YES))
+	suite.Equal(sdk.NewDec(1).BigInt(), suite.getState(tallySlot, 0))
+	suite.Equal(sdk.NewDec(1).BigInt(), suite.getState(tallySlot, 2))
+
+	proposal, found := committeeKeeper.GetProposal(suite.Ctx, proposalID)
+	suite.Require().True(found)
+	committeeKeeper.CloseProposal(suite.Ctx, proposal, committeetypes.Passed)
+
+	suite.Equal(int64(governance.CommitteeProposalStatusPassed), suite.getState(slot, 1).Int64())
+	suite.Equal(sdk.NewDec(1).BigInt(), suite.getState(tallySlot, 0))
+}
@@ -0,0 +1,252 @@
+package hard
+
+import (
+	"fmt"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/precompile/contract"
+
+	"github.com/kava-labs/kava/precompile/msgexec"
+	hardtypes "github.com/kava-labs/kava/x/hard/types"
+)
+
+// Gas charged for each method of the hard precompile. It covers the x/hard
+// message and the ERC20 conversions that are executed after the EVM
+// transaction succeeds.
+const (
+	DepositGas  uint64 = 300_000
+	WithdrawGas uint64 = 300_000
+	BorrowGas   uint64 = 300_000
+	RepayGas    uint64 = 300_000
+)
+
+const (
+	depositMethod  = "deposit"
+	withdrawMethod = "withdraw"
+	borrowMethod   = "borrow"
+	repayMethod    = "repay"
+
+	depositEvent  = "Deposit"
+	withdrawEvent = "Withdraw"
+	borrowEvent   = "Borrow"
+	repayEvent    = "Repay"
+)
+
+const rawABI = `[
+	{
+		"type": "function",
+		"name": "deposit",
+		"stateMutability": "nonpayable",
+		"inputs": [
+			{"name": "denom", "type": "string"},
+			{"name": "amount", "type": "uint256"}
+		],
+		"outputs": []
+	},
+	{
+		"type": "function",
+		"name": "withdraw",
+		"stateMutability": "nonpayable",
+		"inputs": [
+			{"name": "denom", "type": "string"},
+			{"name": "amount", "type": "uint256"}
+		],
+		"outputs": []
+	},
+	{
+		"type": "function",
+		"name": "borrow",
+		"stateMutability": "nonpayable",
+		"inputs": [
+			{"name": "denom", "type": "string"},
+			{"name": "amount", "type": "uint256"}
+		],
+		"outputs": []
+	},
+	{
+		"type": "function",
+		"name": "repay",
+		"stateMutability": "nonpayable",
+		"inputs": [
+			{"name": "denom", "type": "string"},
+			{"name": "amount", "type": "uint256"}
+		],
+		"outputs": []
+	},
+	{
+		"type": "event",
+		"name": "Deposit",
+		"anonymous": false,
+		"inputs": [
+			{"name": "account", "type": "address", "indexed": true},
+			{"name": "denom", "type": "string", "indexed": false},
+			{"name": "amount", "type": "uint256", "indexed": false}
+		]
+	},
+	{
+		"type": "event",
+		"name": "Withdraw",
+		"anonymous": false,
+		"inputs": [
+			{"name": "account", "type": "address", "indexed": true},
+			{"name": "denom", "type": "string", "indexed": false},
+			{"name": "amount", "type": "uint256", "indexed": false}
+		]
+	},
+	{
+		"type": "event",
+		"name": "Borrow",
+		"anonymous": false,
+		"inputs": [
+			{"name": "account", "type": "address", "indexed": true},
+			{"name": "denom", "type": "string", "indexed": false},
+			{"name": "amount", "type": "uint256", "indexed": false}
+		]
+	},
+	{
+		"type": "event",
+		"name": "Repay",
+		"anonymous": false,
+		"inputs": [
+			{"name": "account", "type": "address", "indexed": true},
+			{"name": "denom", "type": "string", "indexed": false},
+			{"name": "amount", "type": "uint256", "indexed": false}
+		]
+	}
+]`
+
+// ABI is the interface of the hard precompile.
+var ABI = contract.MustParseABI(rawABI)
+
+// methodEvents maps the methods of the precompile to the events they emit.
+var methodEvents = map[string]string{
+	depositMethod:  depositEvent,
+	withdrawMethod: withdrawEvent,
+	borrowMethod:   borrowEvent,
+	repayMethod:    repayEvent,
+}
+
+// NewContract returns a new hard stateful precompiled contract.
+//
+//	This contract lets EVM accounts and contracts deposit to, withdraw from, borrow from and repay
+//	the x/hard money market. A successful call emits a log, which is executed as the equivalent
+//	x/hard message for the caller after the EVM transaction succeeds. The transaction is reverted
+//	if the message fails.
+//
+//	Amounts are in the units of the sdk.Coin of the denom. Denoms of enabled x/evmutil conversion
+//	pairs are spent from and paid to the caller's ERC20 balance.
+func NewContract() (contract.StatefulPrecompiledContract, error) {
+	precompile, err := contract.NewStatefulPrecompileContract([]*contract.StatefulPrecompileFunction{
+		contract.NewStatefulPrecompileFunction(ABI.Methods[depositMethod].ID, newRecordFunction(depositMethod, DepositGas)),
+		contract.NewStatefulPrecompileFunction(ABI.Methods[withdrawMethod].ID, newRecordFunction(withdrawMethod, WithdrawGas)),
+		contract.NewStatefulPrecompileFunction(ABI.Methods[borrowMethod].ID, newRecordFunction(borrowMethod, BorrowGas)),
+		contract.NewStatefulPrecompileFunction(ABI.Methods[repayMethod].ID, newRecordFunction(repayMethod, RepayGas)),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to instantiate hard precompile: %w", err)
+	}
+
+	return precompile, nil
+}
+
+// newRecordFunction returns a precompile function that validates the coin of
+// a method and records it in the transaction logs for the caller.
+func newRecordFunction(methodName string, gas uint64) contract.RunStatefulPrecompileFunc {
+	return func(
+		accessibleState contract.AccessibleState,
+		caller common.Address,
+		addr common.Address,
+		input []byte,
+		suppliedGas uint64,
+		readOnly bool,
+	) ([]byte, uint64, error) {
+		remainingGas, err := contract.DeductGas(suppliedGas, gas)
+		if err != nil {
+			return nil, 0, err
+		}
+		if readOnly {
+			return nil, remainingGas, vm.ErrWriteProtection
+		}
+
+		values, err := ABI.Methods[methodName].Inputs.Unpack(input)
+		if err != nil {
+			return nil, remainingGas, fmt.Errorf("failed to unpack input: %w", err)
+		}
+		denom := values[0].(string)
+		amount := values[1].(*big.Int)
+
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return nil, remainingGas, err
+		}
+		if amount.Sign() <= 0 {
+			return nil, remainingGas, fmt.Errorf("amount must be positive")
+		}
+		if amount.BitLen() > sdkmath.MaxBitLen {
+			return nil, remainingGas, fmt.Errorf("amount is too large")
+		}
+
+		event := ABI.Events[methodEvents[methodName]]
+		data, err := event.Inputs.NonIndexed().Pack(denom, amount)
+		if err != nil {
+			return nil, remainingGas, fmt.Errorf("failed to pack event: %w", err)
+		}
+		accessibleState.GetStateDB().AddLog(&ethtypes.Log{
+			Address: addr,
+			Topics:  []common.Hash{event.ID, common.BytesToHash(caller.Bytes())},
+			Data:    data,
+		})
+
+		return nil, remainingGas, nil
+	}
+}
+
+// NewRequestFromLog returns the x/hard message requested by a log of the
+// precompile. It returns false if the log does not request a message.
+func NewRequestFromLog(log *ethtypes.Log) (msgexec.Request, bool, error) {
+	if len(log.Topics) != 2 {
+		return msgexec.Request{}, false, nil
+	}
+
+	var eventName string
+	for _, name := range methodEvents {
+		if log.Topics[0] == ABI.Events[name].ID {
+			eventName = name
+		}
+	}
+	if eventName == "" {
+		return msgexec.Request{}, false, nil
+	}
+
+	values, err := ABI.Events[eventName].Inputs.NonIndexed().Unpack(log.Data)
+	if err != nil {
+		return msgexec.Request{}, true, fmt.Errorf("failed to unpack log: %w", err)
+	}
+	coin := sdk.NewCoin(values[0].(string), sdkmath.NewIntFromBigInt(values[1].(*big.Int)))
+
+	account := common.BytesToAddress(log.Topics[1].Bytes())
+	sender := sdk.AccAddress(account.Bytes())
+	req := msgexec.Request{Account: account}
+
+	switch eventName {
+	case depositEvent:
+		msg := hardtypes.NewMsgDeposit(sender, sdk.NewCoins(coin))
+		req.Msg, req.Inputs = &msg, sdk.NewCoins(coin)
+	case withdrawEvent:
+		msg := hardtypes.NewMsgWithdraw(sender, sdk.NewCoins(coin))
+		req.Msg, req.OutputDenoms = &msg, []string{coin.Denom}
+	case borrowEvent:
+		msg := hardtypes.NewMsgBorrow(sender, sdk.NewCoins(coin))
+		req.Msg, req.OutputDenoms = &msg, []string{coin.Denom}
+	default:
+		msg := hardtypes.NewMsgRepay(sender, sender, sdk.NewCoins(coin))
+		req.Msg, req.Inputs = &msg, sdk.NewCoins(coin)
+	}
+
+	return req, true, nil
+}
@@ -0,0 +1,167 @@
+package hard_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/precompile/contract"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kava-labs/kava/precompile/contracts/hard"
+	"github.com/kava-labs/kava/precompile/msgexec"
+	hardtypes "github.com/kava-labs/kava/x/hard/types"
+)
+
+// mockStateDB records logs and panics on any other state access.
+type mockStateDB struct {
+	contract.StateDB
+	logs []*ethtypes.Log
+}
+
+func (s *mockStateDB) AddLog(log *ethtypes.Log) {
+	s.logs = append(s.logs, log)
+}
+
+type mockAccessibleState struct {
+	stateDB *mockStateDB
+}
+
+func (s mockAccessibleState) GetStateDB() contract.StateDB {
+	return s.stateDB
+}
+
+var (
+	precompileAddr = common.HexToAddress("0x9000000000000000000000000000000000000006")
+	caller         = common.HexToAddress("0x7Bbf300890857b8c241b219C6a489431669b3aFA")
+	callerAcc      = sdk.AccAddress(caller.Bytes())
+)
+
+// TestContractConstructor ensures we have a valid constructor. This will fail
+// if we attempt to define invalid or duplicate function selectors.
+func TestContractConstructor(t *testing.T) {
+	precompile, err := hard.NewContract()
+	require.NoError(t, err, "expected precompile not error when created")
+	assert.NotNil(t, precompile, "expected precompile contract to be defined")
+}
+
+func TestMethods(t *testing.T) {
+	coin := sdk.NewInt64Coin("erc20/usdc", 1e6)
+	deposit := hardtypes.NewMsgDeposit(callerAcc, sdk.NewCoins(coin))
+	withdraw := hardtypes.NewMsgWithdraw(callerAcc, sdk.NewCoins(coin))
+	borrow := hardtypes.NewMsgBorrow(callerAcc, sdk.NewCoins(coin))
+	repay := hardtypes.NewMsgRepay(callerAcc, callerAcc, sdk.NewCoins(coin))
+
+	testCases := []struct {
+		name        string
+		method      string
+		denom       string
+		amount      *big.Int
+		gas         uint64
+		readOnly    bool
+		expectedReq msgexec.Request
+		expectedErr string
+	}{
+		{
+			name:        "deposit",
+			method:      "deposit",
+			gas:         hard.DepositGas,
+			expectedReq: msgexec.Request{Account: caller, Msg: &deposit, Inputs: sdk.NewCoins(coin)},
+		},
+		{
+			name:        "withdraw",
+			method:      "withdraw",
+			gas:         hard.WithdrawGas,
+			expectedReq: msgexec.Request{Account: caller, Msg: &withdraw, OutputDenoms: []string{coin.Denom}},
+		},
+		{
+			name:        "borrow",
+			method:      "borrow",
+			gas:         hard.BorrowGas,
+			expectedReq: msgexec.Request{Account: caller, Msg: &borrow, OutputDenoms: []string{coin.Denom}},
+		},
+		{
+			name:        "repay",
+			method:      "repay",
+			gas:         hard.RepayGas,
+			expectedReq: msgexec.Request{Account: caller, Msg: &repay, Inputs: sdk.NewCoins(coin)},
+		},
+		{
+			name:        "out of gas",
+			method:      "deposit",
+			gas:         hard.DepositGas - 1,
+			expectedErr: "out of gas",
+		},
+		{
+			name:        "read only",
+			method:      "borrow",
+			gas:         hard.BorrowGas,
+			readOnly:    true,
+			expectedErr: vm.ErrWriteProtection.Error(),
+		},
+		{
+			name:        "invalid denom",
+			method:      "deposit",
+			denom:       "1usdc",
+			gas:         hard.DepositGas,
+			expectedErr: "invalid denom",
+		},
+		{
+			name:        "zero amount",
+			method:      "repay",
+			amount:      big.NewInt(0),
+			gas:         hard.RepayGas,
+			expectedErr: "amount must be positive",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			precompile, err := hard.NewContract()
+			require.NoError(t, err)
+
+			denom, amount := coin.Denom, coin.Amount.BigInt()
+			if tc.denom != "" {
+				denom = tc.denom
+			}
+			if tc.amount != nil {
+				amount = tc.amount
+			}
+
+			state := mockAccessibleState{stateDB: &mockStateDB{}}
+			input, err := hard.ABI.Pack(tc.method, denom, amount)
+			require.NoError(t, err)
+			ret, remainingGas, err := precompile.Run(state, caller, precompileAddr, input, tc.gas, tc.readOnly)
+
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+				require.Empty(t, state.stateDB.logs)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Empty(t, ret)
+			require.Equal(t, uint64(0), remainingGas)
+			require.Len(t, state.stateDB.logs, 1)
+
+			req, found, err := hard.NewRequestFromLog(state.stateDB.logs[0])
+			require.NoError(t, err)
+			require.True(t, found)
+			require.Equal(t, tc.expectedReq, req)
+		})
+	}
+}
+
+func TestNewRequestFromLog_OtherLogs(t *testing.T) {
+	_, found, err := hard.NewRequestFromLog(&ethtypes.Log{Topics: []common.Hash{{1}, {2}}})
+	require.NoError(t, err)
+	require.False(t, found)
+
+	_, found, err = hard.NewRequestFromLog(&ethtypes.Log{})
+	require.NoError(t, err)
+	require.False(t, found)
+}
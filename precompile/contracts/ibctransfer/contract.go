@@ -0,0 +1,189 @@
+package ibctransfer
+
+import (
+	"fmt"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/precompile/contract"
+
+	evmutiltypes "github.com/kava-labs/kava/x/evmutil/types"
+)
+
+// TransferERC20Gas is the gas charged for a call to transferERC20. It covers the
+// conversion of the ERC20 and the ICS-20 transfer that are executed after the
+// EVM transaction succeeds.
+const TransferERC20Gas uint64 = 200_000
+
+const (
+	transferERC20Method = "transferERC20"
+	transferERC20Event  = "IBCTransferERC20"
+)
+
+const rawABI = `[
+	{
+		"type": "function",
+		"name": "transferERC20",
+		"stateMutability": "nonpayable",
+		"inputs": [
+			{"name": "token", "type": "address"},
+			{"name": "amount", "type": "uint256"},
+			{"name": "sourceChannel", "type": "string"},
+			{"name": "receiver", "type": "string"},
+			{"name": "timeoutTimestamp", "type": "uint64"},
+			{"name": "memo", "type": "string"}
+		],
+		"outputs": []
+	},
+	{
+		"type": "event",
+		"name": "IBCTransferERC20",
+		"anonymous": false,
+		"inputs": [
+			{"name": "sender", "type": "address", "indexed": true},
+			{"name": "token", "type": "address", "indexed": true},
+			{"name": "amount", "type": "uint256", "indexed": false},
+			{"name": "sourceChannel", "type": "string", "indexed": false},
+			{"name": "receiver", "type": "string", "indexed": false},
+			{"name": "timeoutTimestamp", "type": "uint64", "indexed": false},
+			{"name": "memo", "type": "string", "indexed": false}
+		]
+	}
+]`
+
+// ABI is the interface of the ibc transfer precompile.
+var ABI = contract.MustParseABI(rawABI)
+
+// TransferERC20Args are the arguments of a transferERC20 call.
+type TransferERC20Args struct {
+	Token            common.Address
+	Amount           *big.Int
+	SourceChannel    string
+	Receiver         string
+	TimeoutTimestamp uint64
+	Memo             string
+}
+
+// NewContract returns a new ibc transfer stateful precompiled contract.
+//
+//	This contract lets EVM accounts and contracts send an EVM-native ERC20 of an enabled x/evmutil
+//	conversion pair over IBC. A successful call emits an IBCTransferERC20 log, which x/evmutil
+//	consumes after the EVM transaction succeeds to convert the ERC20 and send the ICS-20 transfer.
+//	The transaction is reverted if the conversion or transfer fails.
+func NewContract() (contract.StatefulPrecompiledContract, error) {
+	precompile, err := contract.NewStatefulPrecompileContract([]*contract.StatefulPrecompileFunction{
+		contract.NewStatefulPrecompileFunction(ABI.Methods[transferERC20Method].ID, transferERC20),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to instantiate ibc transfer precompile: %w", err)
+	}
+
+	return precompile, nil
+}
+
+// transferERC20 validates the transfer of the caller's ERC20 and records it in the transaction logs.
+func transferERC20(
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	addr common.Address,
+	input []byte,
+	suppliedGas uint64,
+	readOnly bool,
+) ([]byte, uint64, error) {
+	remainingGas, err := contract.DeductGas(suppliedGas, TransferERC20Gas)
+	if err != nil {
+		return nil, 0, err
+	}
+	if readOnly {
+		return nil, remainingGas, vm.ErrWriteProtection
+	}
+
+	method := ABI.Methods[transferERC20Method]
+	values, err := method.Inputs.Unpack(input)
+	if err != nil {
+		return nil, remainingGas, fmt.Errorf("failed to unpack input: %w", err)
+	}
+	var args TransferERC20Args
+	if err := method.Inputs.Copy(&args, values); err != nil {
+		return nil, remainingGas, fmt.Errorf("failed to unpack input: %w", err)
+	}
+
+	msg := NewMsgIBCTransferERC20(caller, args)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, remainingGas, err
+	}
+
+	event := ABI.Events[transferERC20Event]
+	data, err := event.Inputs.NonIndexed().Pack(
+		args.Amount,
+		args.SourceChannel,
+		args.Receiver,
+		args.TimeoutTimestamp,
+		args.Memo,
+	)
+	if err != nil {
+		return nil, remainingGas, fmt.Errorf("failed to pack event: %w", err)
+	}
+
+	accessibleState.GetStateDB().AddLog(&ethtypes.Log{
+		Address: addr,
+		Topics: []common.Hash{
+			event.ID,
+			common.BytesToHash(caller.Bytes()),
+			common.BytesToHash(args.Token.Bytes()),
+		},
+		Data: data,
+	})
+
+	return nil, remainingGas, nil
+}
+
+// UnpackIBCTransferERC20Log returns the sender and arguments of a transfer
+// recorded by the precompile in an IBCTransferERC20 log. It returns false if
+// the log is not an IBCTransferERC20 log.
+func UnpackIBCTransferERC20Log(log *ethtypes.Log) (common.Address, TransferERC20Args, bool, error) {
+	event := ABI.Events[transferERC20Event]
+	if len(log.Topics) != 3 || log.Topics[0] != event.ID {
+		return common.Address{}, TransferERC20Args{}, false, nil
+	}
+
+	values, err := event.Inputs.NonIndexed().Unpack(log.Data)
+	if err != nil {
+		return common.Address{}, TransferERC20Args{}, true, fmt.Errorf("failed to unpack log: %w", err)
+	}
+	var args TransferERC20Args
+	if err := event.Inputs.NonIndexed().Copy(&args, values); err != nil {
+		return common.Address{}, TransferERC20Args{}, true, fmt.Errorf("failed to unpack log: %w", err)
+	}
+	args.Token = common.BytesToAddress(log.Topics[2].Bytes())
+
+	return common.BytesToAddress(log.Topics[1].Bytes()), args, true, nil
+}
+
+// NewMsgIBCTransferERC20 returns the x/evmutil message equivalent to a
+// transferERC20 call from the given sender. Transfers are always sent over the
+// ICS-20 transfer port and use a timestamp timeout.
+func NewMsgIBCTransferERC20(sender common.Address, args TransferERC20Args) evmutiltypes.MsgIBCTransferERC20 {
+	amount := sdkmath.ZeroInt()
+	if args.Amount != nil && args.Amount.BitLen() <= sdkmath.MaxBitLen {
+		amount = sdkmath.NewIntFromBigInt(args.Amount)
+	}
+
+	return evmutiltypes.NewMsgIBCTransferERC20(
+		evmutiltypes.NewInternalEVMAddress(sender),
+		evmutiltypes.NewInternalEVMAddress(args.Token),
+		amount,
+		transfertypes.PortID,
+		args.SourceChannel,
+		args.Receiver,
+		clienttypes.ZeroHeight(),
+		args.TimeoutTimestamp,
+		args.Memo,
+	)
+}
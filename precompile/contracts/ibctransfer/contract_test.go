@@ -0,0 +1,155 @@
+package ibctransfer_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/precompile/contract"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kava-labs/kava/precompile/contracts/ibctransfer"
+)
+
+// mockStateDB records logs and panics on any other state access.
+type mockStateDB struct {
+	contract.StateDB
+	logs []*ethtypes.Log
+}
+
+func (s *mockStateDB) AddLog(log *ethtypes.Log) {
+	s.logs = append(s.logs, log)
+}
+
+type mockAccessibleState struct {
+	stateDB *mockStateDB
+}
+
+func (s mockAccessibleState) GetStateDB() contract.StateDB {
+	return s.stateDB
+}
+
+// TestContractConstructor ensures we have a valid constructor. This will fail
+// if we attempt to define invalid or duplicate function selectors.
+func TestContractConstructor(t *testing.T) {
+	precompile, err := ibctransfer.NewContract()
+	require.NoError(t, err, "expected precompile not error when created")
+	assert.NotNil(t, precompile, "expected precompile contract to be defined")
+}
+
+func TestTransferERC20(t *testing.T) {
+	precompileAddr := common.HexToAddress("0x9000000000000000000000000000000000000003")
+	caller := common.HexToAddress("0x7Bbf300890857b8c241b219C6a489431669b3aFA")
+	validArgs := ibctransfer.TransferERC20Args{
+		Token:            common.HexToAddress("0xeA7100edA2f805356291B0E55DaD448599a72C6d"),
+		Amount:           big.NewInt(1e18),
+		SourceChannel:    "channel-0",
+		Receiver:         "cosmos1receiver",
+		TimeoutTimestamp: 1e18,
+		Memo:             "memo",
+	}
+
+	testCases := []struct {
+		name        string
+		args        func() ibctransfer.TransferERC20Args
+		gas         uint64
+		readOnly    bool
+		expectedErr string
+	}{
+		{
+			name:     "valid transfer",
+			args:     func() ibctransfer.TransferERC20Args { return validArgs },
+			gas:      ibctransfer.TransferERC20Gas,
+			readOnly: false,
+		},
+		{
+			name:        "out of gas",
+			args:        func() ibctransfer.TransferERC20Args { return validArgs },
+			gas:         ibctransfer.TransferERC20Gas - 1,
+			expectedErr: "out of gas",
+		},
+		{
+			name:        "read only",
+			args:        func() ibctransfer.TransferERC20Args { return validArgs },
+			gas:         ibctransfer.TransferERC20Gas,
+			readOnly:    true,
+			expectedErr: vm.ErrWriteProtection.Error(),
+		},
+		{
+			name: "zero amount",
+			args: func() ibctransfer.TransferERC20Args {
+				args := validArgs
+				args.Amount = big.NewInt(0)
+				return args
+			},
+			gas:         ibctransfer.TransferERC20Gas,
+			expectedErr: "amount cannot be zero or less",
+		},
+		{
+			name: "invalid channel",
+			args: func() ibctransfer.TransferERC20Args {
+				args := validArgs
+				args.SourceChannel = "x"
+				return args
+			},
+			gas:         ibctransfer.TransferERC20Gas,
+			expectedErr: "invalid source channel ID",
+		},
+		{
+			name: "no timeout",
+			args: func() ibctransfer.TransferERC20Args {
+				args := validArgs
+				args.TimeoutTimestamp = 0
+				return args
+			},
+			gas:         ibctransfer.TransferERC20Gas,
+			expectedErr: "timeout height and timeout timestamp cannot both be zero",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			precompile, err := ibctransfer.NewContract()
+			require.NoError(t, err)
+
+			args := tc.args()
+			input, err := ibctransfer.ABI.Pack(
+				"transferERC20",
+				args.Token, args.Amount, args.SourceChannel, args.Receiver, args.TimeoutTimestamp, args.Memo,
+			)
+			require.NoError(t, err)
+
+			state := mockAccessibleState{stateDB: &mockStateDB{}}
+			ret, remainingGas, err := precompile.Run(state, caller, precompileAddr, input, tc.gas, tc.readOnly)
+
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+				require.Empty(t, state.stateDB.logs)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Empty(t, ret)
+			require.Equal(t, uint64(0), remainingGas)
+			require.Len(t, state.stateDB.logs, 1)
+			require.Equal(t, precompileAddr, state.stateDB.logs[0].Address)
+
+			sender, unpacked, found, err := ibctransfer.UnpackIBCTransferERC20Log(state.stateDB.logs[0])
+			require.NoError(t, err)
+			require.True(t, found)
+			require.Equal(t, caller, sender)
+			require.Equal(t, args, unpacked)
+		})
+	}
+}
+
+func TestUnpackIBCTransferERC20Log_OtherEvent(t *testing.T) {
+	_, _, found, err := ibctransfer.UnpackIBCTransferERC20Log(&ethtypes.Log{
+		Topics: []common.Hash{common.HexToHash("0x01")},
+	})
+	require.NoError(t, err)
+	require.False(t, found)
+}
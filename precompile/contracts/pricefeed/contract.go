@@ -0,0 +1,207 @@
+package pricefeed
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/precompile/contract"
+)
+
+// Gas charged for each method of the pricefeed precompile. postedPrices
+// charges PostedPriceGas for each returned posted price on top of QueryGas.
+const (
+	QueryGas       uint64 = 4_200
+	PostedPriceGas uint64 = 6_300
+)
+
+const (
+	currentPriceMethod = "currentPrice"
+	postedPricesMethod = "postedPrices"
+)
+
+const rawABI = `[
+	{
+		"type": "function",
+		"name": "currentPrice",
+		"stateMutability": "view",
+		"inputs": [
+			{"name": "marketId", "type": "string"}
+		],
+		"outputs": [
+			{"name": "price", "type": "uint256"},
+			{"name": "updatedAt", "type": "uint256"}
+		]
+	},
+	{
+		"type": "function",
+		"name": "postedPrices",
+		"stateMutability": "view",
+		"inputs": [
+			{"name": "marketId", "type": "string"}
+		],
+		"outputs": [
+			{"name": "oracles", "type": "address[]"},
+			{"name": "prices", "type": "uint256[]"},
+			{"name": "expiries", "type": "uint256[]"}
+		]
+	}
+]`
+
+// ABI is the interface of the pricefeed precompile.
+var ABI = contract.MustParseABI(rawABI)
+
+// Prefixes of the storage slots of the pricefeed precompile.
+var (
+	currentPriceSlotPrefix     = []byte{0x01}
+	updatedAtSlotPrefix        = []byte{0x02}
+	postedPriceCountSlotPrefix = []byte{0x03}
+	postedPriceSlotPrefix      = []byte{0x04}
+)
+
+// CurrentPriceSlot returns the storage slot of the precompile that mirrors the
+// current price of a market, with 18 decimals.
+func CurrentPriceSlot(marketID string) common.Hash {
+	return crypto.Keccak256Hash(currentPriceSlotPrefix, []byte(marketID))
+}
+
+// UpdatedAtSlot returns the storage slot of the precompile that records the
+// unix time at which the current price of a market was last updated.
+func UpdatedAtSlot(marketID string) common.Hash {
+	return crypto.Keccak256Hash(updatedAtSlotPrefix, []byte(marketID))
+}
+
+// PostedPriceCountSlot returns the storage slot of the precompile that records
+// the number of posted prices mirrored for a market.
+func PostedPriceCountSlot(marketID string) common.Hash {
+	return crypto.Keccak256Hash(postedPriceCountSlotPrefix, []byte(marketID))
+}
+
+// PostedPriceSlot returns the first storage slot of a posted price mirrored for
+// a market. The oracle, price and unix expiry of the posted price are stored in
+// this slot and the two that follow it.
+func PostedPriceSlot(marketID string, index uint64) common.Hash {
+	indexBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(indexBz, index)
+	return crypto.Keccak256Hash(postedPriceSlotPrefix, crypto.Keccak256([]byte(marketID)), indexBz)
+}
+
+// offsetSlot returns the storage slot that is offset slots after the given slot.
+func offsetSlot(slot common.Hash, offset int64) common.Hash {
+	return common.BigToHash(new(big.Int).Add(slot.Big(), big.NewInt(offset)))
+}
+
+// NewContract returns a new pricefeed stateful precompiled contract.
+//
+//	This contract lets EVM contracts read the current price of the markets of x/pricefeed, the
+//	time it was last updated, and the raw prices posted by each oracle. Prices have 18 decimals
+//	and times are unix seconds.
+//
+//	Prices are mirrored to the precompile storage by the PricefeedHooks whenever an oracle posts
+//	a price or the current prices are updated at the end of a block.
+func NewContract() (contract.StatefulPrecompiledContract, error) {
+	precompile, err := contract.NewStatefulPrecompileContract([]*contract.StatefulPrecompileFunction{
+		contract.NewStatefulPrecompileFunction(ABI.Methods[currentPriceMethod].ID, currentPrice),
+		contract.NewStatefulPrecompileFunction(ABI.Methods[postedPricesMethod].ID, postedPrices),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to instantiate pricefeed precompile: %w", err)
+	}
+
+	return precompile, nil
+}
+
+// currentPrice returns the current price of a market and the time it was last
+// updated. It reverts if the market has no valid price.
+func currentPrice(
+	accessibleState contract.AccessibleState,
+	_ common.Address,
+	addr common.Address,
+	input []byte,
+	suppliedGas uint64,
+	_ bool,
+) ([]byte, uint64, error) {
+	remainingGas, err := contract.DeductGas(suppliedGas, QueryGas)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	method := ABI.Methods[currentPriceMethod]
+	marketID, err := unpackMarketID(method, input)
+	if err != nil {
+		return nil, remainingGas, err
+	}
+
+	stateDB := accessibleState.GetStateDB()
+	price := stateDB.GetState(addr, CurrentPriceSlot(marketID)).Big()
+	if price.Sign() == 0 {
+		return nil, remainingGas, fmt.Errorf("no valid price for market %s", marketID)
+	}
+	updatedAt := stateDB.GetState(addr, UpdatedAtSlot(marketID)).Big()
+
+	ret, err := method.Outputs.Pack(price, updatedAt)
+	if err != nil {
+		return nil, remainingGas, fmt.Errorf("failed to pack output: %w", err)
+	}
+
+	return ret, remainingGas, nil
+}
+
+// postedPrices returns the oracles, prices and expiries of the raw prices
+// posted for a market.
+func postedPrices(
+	accessibleState contract.AccessibleState,
+	_ common.Address,
+	addr common.Address,
+	input []byte,
+	suppliedGas uint64,
+	_ bool,
+) ([]byte, uint64, error) {
+	remainingGas, err := contract.DeductGas(suppliedGas, QueryGas)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	method := ABI.Methods[postedPricesMethod]
+	marketID, err := unpackMarketID(method, input)
+	if err != nil {
+		return nil, remainingGas, err
+	}
+
+	stateDB := accessibleState.GetStateDB()
+	count := stateDB.GetState(addr, PostedPriceCountSlot(marketID)).Big().Uint64()
+
+	oracles := make([]common.Address, 0, count)
+	prices := make([]*big.Int, 0, count)
+	expiries := make([]*big.Int, 0, count)
+	for i := uint64(0); i < count; i++ {
+		remainingGas, err = contract.DeductGas(remainingGas, PostedPriceGas)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		slot := PostedPriceSlot(marketID, i)
+		oracles = append(oracles, common.BytesToAddress(stateDB.GetState(addr, slot).Bytes()))
+		prices = append(prices, stateDB.GetState(addr, offsetSlot(slot, 1)).Big())
+		expiries = append(expiries, stateDB.GetState(addr, offsetSlot(slot, 2)).Big())
+	}
+
+	ret, err := method.Outputs.Pack(oracles, prices, expiries)
+	if err != nil {
+		return nil, remainingGas, fmt.Errorf("failed to pack output: %w", err)
+	}
+
+	return ret, remainingGas, nil
+}
+
+func unpackMarketID(method abi.Method, input []byte) (string, error) {
+	values, err := method.Inputs.Unpack(input)
+	if err != nil {
+		return "", fmt.Errorf("failed to unpack input: %w", err)
+	}
+	return values[0].(string), nil
+}
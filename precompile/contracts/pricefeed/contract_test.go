@@ -0,0 +1,127 @@
+package pricefeed_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/precompile/contract"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kava-labs/kava/precompile/contracts/pricefeed"
+)
+
+// mockStateDB records storage and panics on any other state access.
+type mockStateDB struct {
+	contract.StateDB
+	storage map[common.Hash]common.Hash
+}
+
+func newMockStateDB() *mockStateDB {
+	return &mockStateDB{storage: make(map[common.Hash]common.Hash)}
+}
+
+func (s *mockStateDB) GetState(_ common.Address, key common.Hash) common.Hash {
+	return s.storage[key]
+}
+
+type mockAccessibleState struct {
+	stateDB *mockStateDB
+}
+
+func (s mockAccessibleState) GetStateDB() contract.StateDB {
+	return s.stateDB
+}
+
+var (
+	precompileAddr = common.HexToAddress("0x9000000000000000000000000000000000000005")
+	caller         = common.HexToAddress("0x7Bbf300890857b8c241b219C6a489431669b3aFA")
+	oracle1        = common.HexToAddress("0x0000000000000000000000000000000000000101")
+	oracle2        = common.HexToAddress("0x0000000000000000000000000000000000000102")
+)
+
+// TestContractConstructor ensures we have a valid constructor. This will fail
+// if we attempt to define invalid or duplicate function selectors.
+func TestContractConstructor(t *testing.T) {
+	precompile, err := pricefeed.NewContract()
+	require.NoError(t, err, "expected precompile not error when created")
+	assert.NotNil(t, precompile, "expected precompile contract to be defined")
+}
+
+func TestCurrentPrice(t *testing.T) {
+	precompile, err := pricefeed.NewContract()
+	require.NoError(t, err)
+	state := mockAccessibleState{stateDB: newMockStateDB()}
+
+	price := sdk.MustNewDecFromStr("0.75")
+	state.stateDB.storage[pricefeed.CurrentPriceSlot("kava:usd")] = common.BigToHash(price.BigInt())
+	state.stateDB.storage[pricefeed.UpdatedAtSlot("kava:usd")] = common.BigToHash(big.NewInt(1700000000))
+
+	method := pricefeed.ABI.Methods["currentPrice"]
+	input, err := pricefeed.ABI.Pack("currentPrice", "kava:usd")
+	require.NoError(t, err)
+	ret, remainingGas, err := precompile.Run(state, caller, precompileAddr, input, pricefeed.QueryGas+1, true)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), remainingGas)
+
+	values, err := method.Outputs.Unpack(ret)
+	require.NoError(t, err)
+	require.Equal(t, price, sdk.NewDecFromBigIntWithPrec(values[0].(*big.Int), sdk.Precision))
+	require.Equal(t, big.NewInt(1700000000), values[1].(*big.Int))
+
+	input, err = pricefeed.ABI.Pack("currentPrice", "btc:usd")
+	require.NoError(t, err)
+	_, _, err = precompile.Run(state, caller, precompileAddr, input, pricefeed.QueryGas, true)
+	require.ErrorContains(t, err, "no valid price for market btc:usd")
+
+	_, _, err = precompile.Run(state, caller, precompileAddr, input, pricefeed.QueryGas-1, true)
+	require.ErrorContains(t, err, "out of gas")
+}
+
+func TestPostedPrices(t *testing.T) {
+	precompile, err := pricefeed.NewContract()
+	require.NoError(t, err)
+	state := mockAccessibleState{stateDB: newMockStateDB()}
+
+	setPostedPrice := func(index uint64, oracle common.Address, price sdk.Dec, expiry int64) {
+		slot := pricefeed.PostedPriceSlot("kava:usd", index)
+		state.stateDB.storage[slot] = common.BytesToHash(oracle.Bytes())
+		state.stateDB.storage[common.BigToHash(new(big.Int).Add(slot.Big(), big.NewInt(1)))] = common.BigToHash(price.BigInt())
+		state.stateDB.storage[common.BigToHash(new(big.Int).Add(slot.Big(), big.NewInt(2)))] = common.BigToHash(big.NewInt(expiry))
+	}
+	setPostedPrice(0, oracle1, sdk.MustNewDecFromStr("0.74"), 1700000100)
+	setPostedPrice(1, oracle2, sdk.MustNewDecFromStr("0.76"), 1700000200)
+	state.stateDB.storage[pricefeed.PostedPriceCountSlot("kava:usd")] = common.BigToHash(big.NewInt(2))
+
+	method := pricefeed.ABI.Methods["postedPrices"]
+	input, err := pricefeed.ABI.Pack("postedPrices", "kava:usd")
+	require.NoError(t, err)
+	gas := pricefeed.QueryGas + 2*pricefeed.PostedPriceGas
+
+	_, _, err = precompile.Run(state, caller, precompileAddr, input, gas-1, true)
+	require.ErrorContains(t, err, "out of gas")
+
+	ret, remainingGas, err := precompile.Run(state, caller, precompileAddr, input, gas, true)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), remainingGas)
+
+	values, err := method.Outputs.Unpack(ret)
+	require.NoError(t, err)
+	require.Equal(t, []common.Address{oracle1, oracle2}, values[0].([]common.Address))
+	require.Equal(t, []*big.Int{
+		sdk.MustNewDecFromStr("0.74").BigInt(),
+		sdk.MustNewDecFromStr("0.76").BigInt(),
+	}, values[1].([]*big.Int))
+	require.Equal(t, []*big.Int{big.NewInt(1700000100), big.NewInt(1700000200)}, values[2].([]*big.Int))
+
+	// markets without posted prices return empty lists
+	input, err = pricefeed.ABI.Pack("postedPrices", "btc:usd")
+	require.NoError(t, err)
+	ret, _, err = precompile.Run(state, caller, precompileAddr, input, pricefeed.QueryGas, true)
+	require.NoError(t, err)
+	values, err = method.Outputs.Unpack(ret)
+	require.NoError(t, err)
+	require.Empty(t, values[0].([]common.Address))
+}
@@ -0,0 +1,106 @@
+package pricefeed
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// PricefeedKeeper defines the expected pricefeed keeper.
+type PricefeedKeeper interface {
+	GetMarkets(ctx sdk.Context) pricefeedtypes.Markets
+	GetCurrentPrice(ctx sdk.Context, marketID string) (pricefeedtypes.CurrentPrice, error)
+	GetRawPrices(ctx sdk.Context, marketID string) pricefeedtypes.PostedPrices
+}
+
+// EvmKeeper defines the expected evm keeper.
+type EvmKeeper interface {
+	GetState(ctx sdk.Context, addr common.Address, key common.Hash) common.Hash
+	SetState(ctx sdk.Context, addr common.Address, key common.Hash, value []byte)
+}
+
+var _ pricefeedtypes.PricefeedHooks = PricefeedHooks{}
+
+// PricefeedHooks mirrors the current and posted prices of x/pricefeed to the
+// storage of the pricefeed precompile, so they can be queried by EVM contracts.
+type PricefeedHooks struct {
+	address         common.Address
+	evmKeeper       EvmKeeper
+	pricefeedKeeper PricefeedKeeper
+}
+
+// NewPricefeedHooks returns the x/pricefeed hooks of the pricefeed precompile at the given address.
+func NewPricefeedHooks(address common.Address, evmKeeper EvmKeeper, pricefeedKeeper PricefeedKeeper) PricefeedHooks {
+	return PricefeedHooks{
+		address:         address,
+		evmKeeper:       evmKeeper,
+		pricefeedKeeper: pricefeedKeeper,
+	}
+}
+
+// MirrorAllPrices writes the current and posted prices of all markets to the
+// precompile storage. It must be run when the precompile is enabled on a chain
+// with existing prices.
+func (h PricefeedHooks) MirrorAllPrices(ctx sdk.Context) {
+	for _, market := range h.pricefeedKeeper.GetMarkets(ctx) {
+		h.mirrorPostedPrices(ctx, market.MarketID)
+
+		currentPrice, err := h.pricefeedKeeper.GetCurrentPrice(ctx, market.MarketID)
+		if err != nil {
+			currentPrice = pricefeedtypes.CurrentPrice{}
+		}
+		h.AfterCurrentPriceUpdated(ctx, market.MarketID, currentPrice)
+	}
+}
+
+// AfterPricePosted writes the posted prices of the market to the precompile storage.
+func (h PricefeedHooks) AfterPricePosted(ctx sdk.Context, postedPrice pricefeedtypes.PostedPrice) {
+	h.mirrorPostedPrices(ctx, postedPrice.MarketID)
+}
+
+// AfterCurrentPriceUpdated writes the current price of the market to the precompile storage,
+// along with the block time as the time the price was updated. Storage is left unchanged when
+// the price is already mirrored, so the update time is the time the price last changed. Both
+// are cleared when the market has no valid price.
+func (h PricefeedHooks) AfterCurrentPriceUpdated(ctx sdk.Context, marketID string, currentPrice pricefeedtypes.CurrentPrice) {
+	if currentPrice.Price.IsNil() || !currentPrice.Price.IsPositive() {
+		h.evmKeeper.SetState(ctx, h.address, CurrentPriceSlot(marketID), nil)
+		h.evmKeeper.SetState(ctx, h.address, UpdatedAtSlot(marketID), nil)
+		return
+	}
+
+	price := common.BigToHash(currentPrice.Price.BigInt())
+	if h.evmKeeper.GetState(ctx, h.address, CurrentPriceSlot(marketID)) == price {
+		return
+	}
+
+	updatedAt := big.NewInt(ctx.BlockTime().Unix())
+	h.evmKeeper.SetState(ctx, h.address, CurrentPriceSlot(marketID), price.Bytes())
+	h.evmKeeper.SetState(ctx, h.address, UpdatedAtSlot(marketID), common.BigToHash(updatedAt).Bytes())
+}
+
+// mirrorPostedPrices replaces the posted prices of a market in the precompile
+// storage with the raw prices of the market.
+func (h PricefeedHooks) mirrorPostedPrices(ctx sdk.Context, marketID string) {
+	countSlot := PostedPriceCountSlot(marketID)
+	prevCount := h.evmKeeper.GetState(ctx, h.address, countSlot).Big().Uint64()
+
+	postedPrices := h.pricefeedKeeper.GetRawPrices(ctx, marketID)
+	for i, postedPrice := range postedPrices {
+		slot := PostedPriceSlot(marketID, uint64(i))
+		h.evmKeeper.SetState(ctx, h.address, slot, common.BytesToHash(postedPrice.OracleAddress).Bytes())
+		h.evmKeeper.SetState(ctx, h.address, offsetSlot(slot, 1), common.BigToHash(postedPrice.Price.BigInt()).Bytes())
+		h.evmKeeper.SetState(ctx, h.address, offsetSlot(slot, 2), common.BigToHash(big.NewInt(postedPrice.Expiry.Unix())).Bytes())
+	}
+	for i := uint64(len(postedPrices)); i < prevCount; i++ {
+		slot := PostedPriceSlot(marketID, i)
+		for offset := int64(0); offset < 3; offset++ {
+			h.evmKeeper.SetState(ctx, h.address, offsetSlot(slot, offset), nil)
+		}
+	}
+
+	h.evmKeeper.SetState(ctx, h.address, countSlot, common.BigToHash(big.NewInt(int64(len(postedPrices)))).Bytes())
+}
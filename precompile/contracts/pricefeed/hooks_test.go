@@ -0,0 +1,115 @@
+package pricefeed_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/precompile/contracts/pricefeed"
+	"github.com/kava-labs/kava/precompile/registry"
+	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+type hooksTestSuite struct {
+	suite.Suite
+
+	App app.TestApp
+	Ctx sdk.Context
+
+	address common.Address
+	oracles []sdk.AccAddress
+}
+
+func TestHooksTestSuite(t *testing.T) {
+	suite.Run(t, new(hooksTestSuite))
+}
+
+func (suite *hooksTestSuite) SetupTest() {
+	suite.App = app.NewTestApp()
+	suite.App.InitializeFromGenesisStates()
+	suite.Ctx = suite.App.NewContext(true, tmproto.Header{Height: 1, Time: time.Unix(1700000000, 0).UTC()})
+
+	suite.address = common.HexToAddress(registry.PricefeedContractAddress)
+	suite.oracles = []sdk.AccAddress{app.RandomAddress(), app.RandomAddress()}
+
+	suite.App.GetPriceFeedKeeper().SetParams(suite.Ctx, pricefeedtypes.NewParams([]pricefeedtypes.Market{
+		pricefeedtypes.NewMarket("kava:usd", "kava", "usd", suite.oracles, true),
+	}))
+}
+
+func (suite *hooksTestSuite) postPrice(oracle sdk.AccAddress, price string, expiry time.Time) {
+	_, err := suite.App.GetPriceFeedKeeper().SetPrice(suite.Ctx, oracle, "kava:usd", sdk.MustNewDecFromStr(price), expiry)
+	suite.Require().NoError(err)
+}
+
+func (suite *hooksTestSuite) getState(slot common.Hash) *big.Int {
+	return suite.App.GetEvmKeeper().GetState(suite.Ctx, suite.address, slot).Big()
+}
+
+func (suite *hooksTestSuite) TestCurrentPrice() {
+	suite.postPrice(suite.oracles[0], "0.74", suite.Ctx.BlockTime().Add(time.Hour))
+	suite.postPrice(suite.oracles[1], "0.76", suite.Ctx.BlockTime().Add(time.Minute))
+	suite.App.GetPriceFeedKeeper().SetCurrentPricesForAllMarkets(suite.Ctx)
+
+	suite.Equal(sdk.MustNewDecFromStr("0.75").BigInt(), suite.getState(pricefeed.CurrentPriceSlot("kava:usd")))
+	suite.Equal(big.NewInt(1700000000), suite.getState(pricefeed.UpdatedAtSlot("kava:usd")))
+
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(2 * time.Minute))
+	suite.App.GetPriceFeedKeeper().SetCurrentPricesForAllMarkets(suite.Ctx)
+	suite.Equal(sdk.MustNewDecFromStr("0.74").BigInt(), suite.getState(pricefeed.CurrentPriceSlot("kava:usd")))
+	suite.Equal(big.NewInt(1700000120), suite.getState(pricefeed.UpdatedAtSlot("kava:usd")))
+
+	// the update time is kept while the price does not change
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(time.Minute))
+	suite.App.GetPriceFeedKeeper().SetCurrentPricesForAllMarkets(suite.Ctx)
+	suite.Equal(sdk.MustNewDecFromStr("0.74").BigInt(), suite.getState(pricefeed.CurrentPriceSlot("kava:usd")))
+	suite.Equal(big.NewInt(1700000120), suite.getState(pricefeed.UpdatedAtSlot("kava:usd")))
+
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(2 * time.Hour))
+	suite.App.GetPriceFeedKeeper().SetCurrentPricesForAllMarkets(suite.Ctx)
+	suite.Equal(0, suite.getState(pricefeed.CurrentPriceSlot("kava:usd")).Sign(), "expired prices should be cleared")
+	suite.Equal(0, suite.getState(pricefeed.UpdatedAtSlot("kava:usd")).Sign())
+}
+
+func (suite *hooksTestSuite) TestPostedPrices() {
+	expiry := suite.Ctx.BlockTime().Add(time.Hour)
+	suite.postPrice(suite.oracles[0], "0.74", expiry)
+	suite.postPrice(suite.oracles[1], "0.76", expiry)
+	suite.postPrice(suite.oracles[0], "0.75", expiry)
+
+	suite.Equal(big.NewInt(2), suite.getState(pricefeed.PostedPriceCountSlot("kava:usd")))
+
+	rawPrices := suite.App.GetPriceFeedKeeper().GetRawPrices(suite.Ctx, "kava:usd")
+	suite.Require().Len(rawPrices, 2)
+	for i, rawPrice := range rawPrices {
+		slot := pricefeed.PostedPriceSlot("kava:usd", uint64(i))
+		suite.Equal(common.BytesToHash(rawPrice.OracleAddress), suite.App.GetEvmKeeper().GetState(suite.Ctx, suite.address, slot))
+		suite.Equal(rawPrice.Price.BigInt(), suite.getState(common.BigToHash(new(big.Int).Add(slot.Big(), big.NewInt(1)))))
+		suite.Equal(big.NewInt(expiry.Unix()), suite.getState(common.BigToHash(new(big.Int).Add(slot.Big(), big.NewInt(2)))))
+	}
+}
+
+func (suite *hooksTestSuite) TestMirrorAllPrices() {
+	suite.postPrice(suite.oracles[0], "0.74", suite.Ctx.BlockTime().Add(time.Hour))
+	suite.App.GetPriceFeedKeeper().SetCurrentPricesForAllMarkets(suite.Ctx)
+
+	suite.App.GetEvmKeeper().SetState(suite.Ctx, suite.address, pricefeed.CurrentPriceSlot("kava:usd"), nil)
+	suite.App.GetEvmKeeper().SetState(suite.Ctx, suite.address, pricefeed.PostedPriceCountSlot("kava:usd"), nil)
+
+	hooks := pricefeed.NewPricefeedHooks(suite.address, suite.App.GetEvmKeeper(), suite.App.GetPriceFeedKeeper())
+	hooks.MirrorAllPrices(suite.Ctx)
+
+	suite.Equal(sdk.MustNewDecFromStr("0.74").BigInt(), suite.getState(pricefeed.CurrentPriceSlot("kava:usd")))
+	suite.Equal(big.NewInt(1), suite.getState(pricefeed.PostedPriceCountSlot("kava:usd")))
+
+	// mirroring an unchanged price keeps its update time
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(time.Minute))
+	hooks.MirrorAllPrices(suite.Ctx)
+	suite.Equal(big.NewInt(1700000000), suite.getState(pricefeed.UpdatedAtSlot("kava:usd")))
+}
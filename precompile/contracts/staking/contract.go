@@ -0,0 +1,625 @@
+package staking
+
+import (
+	"fmt"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/precompile/contract"
+)
+
+// Gas charged for each method of the staking precompile. The gas of delegate,
+// undelegate, redelegate and withdrawRewards covers the staking and
+// distribution messages that are executed after the EVM transaction succeeds.
+const (
+	DelegateGas        uint64 = 200_000
+	UndelegateGas      uint64 = 200_000
+	RedelegateGas      uint64 = 250_000
+	WithdrawRewardsGas uint64 = 150_000
+	ApproveGas         uint64 = 25_000
+	QueryGas           uint64 = 2_100
+)
+
+const (
+	delegateMethod        = "delegate"
+	undelegateMethod      = "undelegate"
+	redelegateMethod      = "redelegate"
+	withdrawRewardsMethod = "withdrawRewards"
+	approveMethod         = "approve"
+	isApprovedMethod      = "isApproved"
+	delegationMethod      = "delegation"
+
+	delegateEvent        = "Delegate"
+	unbondEvent          = "Unbond"
+	redelegateEvent      = "Redelegate"
+	withdrawRewardsEvent = "WithdrawRewards"
+	approvalEvent        = "Approval"
+)
+
+const rawABI = `[
+	{
+		"type": "function",
+		"name": "delegate",
+		"stateMutability": "nonpayable",
+		"inputs": [
+			{"name": "delegator", "type": "address"},
+			{"name": "validator", "type": "string"},
+			{"name": "amount", "type": "uint256"}
+		],
+		"outputs": []
+	},
+	{
+		"type": "function",
+		"name": "undelegate",
+		"stateMutability": "nonpayable",
+		"inputs": [
+			{"name": "delegator", "type": "address"},
+			{"name": "validator", "type": "string"},
+			{"name": "amount", "type": "uint256"}
+		],
+		"outputs": []
+	},
+	{
+		"type": "function",
+		"name": "redelegate",
+		"stateMutability": "nonpayable",
+		"inputs": [
+			{"name": "delegator", "type": "address"},
+			{"name": "srcValidator", "type": "string"},
+			{"name": "dstValidator", "type": "string"},
+			{"name": "amount", "type": "uint256"}
+		],
+		"outputs": []
+	},
+	{
+		"type": "function",
+		"name": "withdrawRewards",
+		"stateMutability": "nonpayable",
+		"inputs": [
+			{"name": "delegator", "type": "address"},
+			{"name": "validator", "type": "string"}
+		],
+		"outputs": []
+	},
+	{
+		"type": "function",
+		"name": "approve",
+		"stateMutability": "nonpayable",
+		"inputs": [
+			{"name": "spender", "type": "address"},
+			{"name": "approved", "type": "bool"}
+		],
+		"outputs": []
+	},
+	{
+		"type": "function",
+		"name": "isApproved",
+		"stateMutability": "view",
+		"inputs": [
+			{"name": "delegator", "type": "address"},
+			{"name": "spender", "type": "address"}
+		],
+		"outputs": [
+			{"name": "", "type": "bool"}
+		]
+	},
+	{
+		"type": "function",
+		"name": "delegation",
+		"stateMutability": "view",
+		"inputs": [
+			{"name": "delegator", "type": "address"},
+			{"name": "validator", "type": "string"}
+		],
+		"outputs": [
+			{"name": "amount", "type": "uint256"}
+		]
+	},
+	{
+		"type": "event",
+		"name": "Delegate",
+		"anonymous": false,
+		"inputs": [
+			{"name": "delegator", "type": "address", "indexed": true},
+			{"name": "validator", "type": "string", "indexed": false},
+			{"name": "amount", "type": "uint256", "indexed": false}
+		]
+	},
+	{
+		"type": "event",
+		"name": "Unbond",
+		"anonymous": false,
+		"inputs": [
+			{"name": "delegator", "type": "address", "indexed": true},
+			{"name": "validator", "type": "string", "indexed": false},
+			{"name": "amount", "type": "uint256", "indexed": false}
+		]
+	},
+	{
+		"type": "event",
+		"name": "Redelegate",
+		"anonymous": false,
+		"inputs": [
+			{"name": "delegator", "type": "address", "indexed": true},
+			{"name": "srcValidator", "type": "string", "indexed": false},
+			{"name": "dstValidator", "type": "string", "indexed": false},
+			{"name": "amount", "type": "uint256", "indexed": false}
+		]
+	},
+	{
+		"type": "event",
+		"name": "WithdrawRewards",
+		"anonymous": false,
+		"inputs": [
+			{"name": "delegator", "type": "address", "indexed": true},
+			{"name": "validator", "type": "string", "indexed": false}
+		]
+	},
+	{
+		"type": "event",
+		"name": "Approval",
+		"anonymous": false,
+		"inputs": [
+			{"name": "delegator", "type": "address", "indexed": true},
+			{"name": "spender", "type": "address", "indexed": true},
+			{"name": "approved", "type": "bool", "indexed": false}
+		]
+	}
+]`
+
+// ABI is the interface of the staking precompile.
+var ABI = contract.MustParseABI(rawABI)
+
+// Prefixes of the storage slots of the staking precompile.
+var (
+	approvalSlotPrefix        = []byte{0x01}
+	delegationSlotPrefix      = []byte{0x02}
+	validatorTokensSlotPrefix = []byte{0x03}
+	validatorSharesSlotPrefix = []byte{0x04}
+)
+
+// ApprovalSlot returns the storage slot of the precompile that records if the
+// spender may act on behalf of the delegator.
+func ApprovalSlot(delegator, spender common.Address) common.Hash {
+	return crypto.Keccak256Hash(approvalSlotPrefix, delegator.Bytes(), spender.Bytes())
+}
+
+// DelegationSlot returns the storage slot of the precompile that mirrors the
+// shares of a delegation.
+func DelegationSlot(delegator common.Address, validator sdk.ValAddress) common.Hash {
+	return crypto.Keccak256Hash(delegationSlotPrefix, delegator.Bytes(), validator.Bytes())
+}
+
+// ValidatorTokensSlot returns the storage slot of the precompile that mirrors the
+// tokens of a validator.
+func ValidatorTokensSlot(validator sdk.ValAddress) common.Hash {
+	return crypto.Keccak256Hash(validatorTokensSlotPrefix, validator.Bytes())
+}
+
+// ValidatorSharesSlot returns the storage slot of the precompile that mirrors the
+// delegator shares of a validator.
+func ValidatorSharesSlot(validator sdk.ValAddress) common.Hash {
+	return crypto.Keccak256Hash(validatorSharesSlotPrefix, validator.Bytes())
+}
+
+// NewContract returns a new staking stateful precompiled contract.
+//
+//	This contract lets EVM accounts and contracts delegate, undelegate and redelegate the bond denom
+//	and withdraw their staking rewards. A successful call emits a log, which is executed as the
+//	equivalent x/staking or x/distribution message after the EVM transaction succeeds. The
+//	transaction is reverted if the message fails.
+//
+//	Callers may only act on their own delegations, or on the delegations of an account that
+//	approved them with approve. Amounts are in the bond denom (ukava).
+func NewContract() (contract.StatefulPrecompiledContract, error) {
+	precompile, err := contract.NewStatefulPrecompileContract([]*contract.StatefulPrecompileFunction{
+		contract.NewStatefulPrecompileFunction(ABI.Methods[delegateMethod].ID, delegate),
+		contract.NewStatefulPrecompileFunction(ABI.Methods[undelegateMethod].ID, undelegate),
+		contract.NewStatefulPrecompileFunction(ABI.Methods[redelegateMethod].ID, redelegate),
+		contract.NewStatefulPrecompileFunction(ABI.Methods[withdrawRewardsMethod].ID, withdrawRewards),
+		contract.NewStatefulPrecompileFunction(ABI.Methods[approveMethod].ID, approve),
+		contract.NewStatefulPrecompileFunction(ABI.Methods[isApprovedMethod].ID, isApproved),
+		contract.NewStatefulPrecompileFunction(ABI.Methods[delegationMethod].ID, delegation),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to instantiate staking precompile: %w", err)
+	}
+
+	return precompile, nil
+}
+
+// delegate validates a delegation and records it in the transaction logs.
+func delegate(
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	addr common.Address,
+	input []byte,
+	suppliedGas uint64,
+	readOnly bool,
+) ([]byte, uint64, error) {
+	return recordDelegationChange(accessibleState, caller, addr, input, suppliedGas, readOnly, delegateMethod, DelegateGas, delegateEvent)
+}
+
+// undelegate validates an undelegation and records it in the transaction logs.
+func undelegate(
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	addr common.Address,
+	input []byte,
+	suppliedGas uint64,
+	readOnly bool,
+) ([]byte, uint64, error) {
+	return recordDelegationChange(accessibleState, caller, addr, input, suppliedGas, readOnly, undelegateMethod, UndelegateGas, unbondEvent)
+}
+
+// recordDelegationChange handles the delegate and undelegate methods, which
+// share the same arguments.
+func recordDelegationChange(
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	addr common.Address,
+	input []byte,
+	suppliedGas uint64,
+	readOnly bool,
+	methodName string,
+	gas uint64,
+	eventName string,
+) ([]byte, uint64, error) {
+	remainingGas, err := contract.DeductGas(suppliedGas, gas)
+	if err != nil {
+		return nil, 0, err
+	}
+	if readOnly {
+		return nil, remainingGas, vm.ErrWriteProtection
+	}
+
+	values, err := unpackInput(ABI.Methods[methodName], input)
+	if err != nil {
+		return nil, remainingGas, err
+	}
+	delegator := values[0].(common.Address)
+	validator := values[1].(string)
+	amount := values[2].(*big.Int)
+
+	if err := authorize(accessibleState.GetStateDB(), addr, caller, delegator); err != nil {
+		return nil, remainingGas, err
+	}
+	if err := validateValidator(validator); err != nil {
+		return nil, remainingGas, err
+	}
+	if err := validateAmount(amount); err != nil {
+		return nil, remainingGas, err
+	}
+
+	if err := addLog(accessibleState.GetStateDB(), addr, ABI.Events[eventName], delegator, validator, amount); err != nil {
+		return nil, remainingGas, err
+	}
+
+	return nil, remainingGas, nil
+}
+
+// redelegate validates a redelegation and records it in the transaction logs.
+func redelegate(
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	addr common.Address,
+	input []byte,
+	suppliedGas uint64,
+	readOnly bool,
+) ([]byte, uint64, error) {
+	remainingGas, err := contract.DeductGas(suppliedGas, RedelegateGas)
+	if err != nil {
+		return nil, 0, err
+	}
+	if readOnly {
+		return nil, remainingGas, vm.ErrWriteProtection
+	}
+
+	values, err := unpackInput(ABI.Methods[redelegateMethod], input)
+	if err != nil {
+		return nil, remainingGas, err
+	}
+	delegator := values[0].(common.Address)
+	srcValidator := values[1].(string)
+	dstValidator := values[2].(string)
+	amount := values[3].(*big.Int)
+
+	if err := authorize(accessibleState.GetStateDB(), addr, caller, delegator); err != nil {
+		return nil, remainingGas, err
+	}
+	if err := validateValidator(srcValidator); err != nil {
+		return nil, remainingGas, err
+	}
+	if err := validateValidator(dstValidator); err != nil {
+		return nil, remainingGas, err
+	}
+	if srcValidator == dstValidator {
+		return nil, remainingGas, fmt.Errorf("cannot redelegate to the same validator")
+	}
+	if err := validateAmount(amount); err != nil {
+		return nil, remainingGas, err
+	}
+
+	if err := addLog(accessibleState.GetStateDB(), addr, ABI.Events[redelegateEvent], delegator, srcValidator, dstValidator, amount); err != nil {
+		return nil, remainingGas, err
+	}
+
+	return nil, remainingGas, nil
+}
+
+// withdrawRewards validates a withdrawal of staking rewards and records it in the transaction logs.
+func withdrawRewards(
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	addr common.Address,
+	input []byte,
+	suppliedGas uint64,
+	readOnly bool,
+) ([]byte, uint64, error) {
+	remainingGas, err := contract.DeductGas(suppliedGas, WithdrawRewardsGas)
+	if err != nil {
+		return nil, 0, err
+	}
+	if readOnly {
+		return nil, remainingGas, vm.ErrWriteProtection
+	}
+
+	values, err := unpackInput(ABI.Methods[withdrawRewardsMethod], input)
+	if err != nil {
+		return nil, remainingGas, err
+	}
+	delegator := values[0].(common.Address)
+	validator := values[1].(string)
+
+	if err := authorize(accessibleState.GetStateDB(), addr, caller, delegator); err != nil {
+		return nil, remainingGas, err
+	}
+	if err := validateValidator(validator); err != nil {
+		return nil, remainingGas, err
+	}
+
+	if err := addLog(accessibleState.GetStateDB(), addr, ABI.Events[withdrawRewardsEvent], delegator, validator); err != nil {
+		return nil, remainingGas, err
+	}
+
+	return nil, remainingGas, nil
+}
+
+// approve allows or disallows the spender to act on the delegations of the caller.
+func approve(
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	addr common.Address,
+	input []byte,
+	suppliedGas uint64,
+	readOnly bool,
+) ([]byte, uint64, error) {
+	remainingGas, err := contract.DeductGas(suppliedGas, ApproveGas)
+	if err != nil {
+		return nil, 0, err
+	}
+	if readOnly {
+		return nil, remainingGas, vm.ErrWriteProtection
+	}
+
+	values, err := unpackInput(ABI.Methods[approveMethod], input)
+	if err != nil {
+		return nil, remainingGas, err
+	}
+	spender := values[0].(common.Address)
+	approved := values[1].(bool)
+
+	stateDB := accessibleState.GetStateDB()
+	value := common.Hash{}
+	if approved {
+		value = common.BigToHash(big.NewInt(1))
+	}
+	stateDB.SetState(addr, ApprovalSlot(caller, spender), value)
+
+	if err := addLog(stateDB, addr, ABI.Events[approvalEvent], caller, spender, approved); err != nil {
+		return nil, remainingGas, err
+	}
+
+	return nil, remainingGas, nil
+}
+
+// isApproved returns true if the spender may act on the delegations of the delegator.
+func isApproved(
+	accessibleState contract.AccessibleState,
+	_ common.Address,
+	addr common.Address,
+	input []byte,
+	suppliedGas uint64,
+	_ bool,
+) ([]byte, uint64, error) {
+	remainingGas, err := contract.DeductGas(suppliedGas, QueryGas)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	method := ABI.Methods[isApprovedMethod]
+	values, err := unpackInput(method, input)
+	if err != nil {
+		return nil, remainingGas, err
+	}
+	delegator := values[0].(common.Address)
+	spender := values[1].(common.Address)
+
+	approved := accessibleState.GetStateDB().GetState(addr, ApprovalSlot(delegator, spender)) != common.Hash{}
+	ret, err := method.Outputs.Pack(approved)
+	if err != nil {
+		return nil, remainingGas, fmt.Errorf("failed to pack output: %w", err)
+	}
+
+	return ret, remainingGas, nil
+}
+
+// delegation returns the amount of the bond denom backing a delegation. The
+// shares of delegations and the tokens and shares of validators are mirrored to
+// the precompile storage by the StakingHooks, and the amount is calculated from
+// them like x/staking's TokensFromShares, so it is reduced by slashes.
+func delegation(
+	accessibleState contract.AccessibleState,
+	_ common.Address,
+	addr common.Address,
+	input []byte,
+	suppliedGas uint64,
+	_ bool,
+) ([]byte, uint64, error) {
+	remainingGas, err := contract.DeductGas(suppliedGas, QueryGas)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	method := ABI.Methods[delegationMethod]
+	values, err := unpackInput(method, input)
+	if err != nil {
+		return nil, remainingGas, err
+	}
+	delegator := values[0].(common.Address)
+	validator, err := sdk.ValAddressFromBech32(values[1].(string))
+	if err != nil {
+		return nil, remainingGas, fmt.Errorf("invalid validator address: %w", err)
+	}
+
+	stateDB := accessibleState.GetStateDB()
+	shares := stateDB.GetState(addr, DelegationSlot(delegator, validator)).Big()
+	validatorTokens := stateDB.GetState(addr, ValidatorTokensSlot(validator)).Big()
+	validatorShares := stateDB.GetState(addr, ValidatorSharesSlot(validator)).Big()
+
+	amount := new(big.Int)
+	if validatorShares.Sign() > 0 {
+		amount.Mul(shares, validatorTokens).Quo(amount, validatorShares)
+	}
+
+	ret, err := method.Outputs.Pack(amount)
+	if err != nil {
+		return nil, remainingGas, fmt.Errorf("failed to pack output: %w", err)
+	}
+
+	return ret, remainingGas, nil
+}
+
+// authorize returns an error if the caller may not act on behalf of the delegator.
+func authorize(stateDB contract.StateDB, addr, caller, delegator common.Address) error {
+	if caller == delegator {
+		return nil
+	}
+	if stateDB.GetState(addr, ApprovalSlot(delegator, caller)) != (common.Hash{}) {
+		return nil
+	}
+	return fmt.Errorf("caller %s is not approved by delegator %s", caller, delegator)
+}
+
+func validateValidator(validator string) error {
+	if _, err := sdk.ValAddressFromBech32(validator); err != nil {
+		return fmt.Errorf("invalid validator address: %w", err)
+	}
+	return nil
+}
+
+func validateAmount(amount *big.Int) error {
+	if amount.Sign() <= 0 {
+		return fmt.Errorf("amount must be positive")
+	}
+	if amount.BitLen() > sdkmath.MaxBitLen {
+		return fmt.Errorf("amount is too large")
+	}
+	return nil
+}
+
+func unpackInput(method abi.Method, input []byte) ([]interface{}, error) {
+	values, err := method.Inputs.Unpack(input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unpack input: %w", err)
+	}
+	return values, nil
+}
+
+// addLog adds a log of the event, indexing the first value as an address and
+// packing the remaining values as data.
+func addLog(stateDB contract.StateDB, addr common.Address, event abi.Event, delegator common.Address, values ...interface{}) error {
+	topics := []common.Hash{event.ID, common.BytesToHash(delegator.Bytes())}
+
+	nonIndexed := event.Inputs.NonIndexed()
+	for _, value := range values[:len(values)-len(nonIndexed)] {
+		topics = append(topics, common.BytesToHash(value.(common.Address).Bytes()))
+	}
+
+	data, err := nonIndexed.Pack(values[len(values)-len(nonIndexed):]...)
+	if err != nil {
+		return fmt.Errorf("failed to pack event: %w", err)
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address: addr,
+		Topics:  topics,
+		Data:    data,
+	})
+	return nil
+}
+
+// NewMsgFromLog returns the x/staking or x/distribution message requested by a
+// log of the precompile, using the given bond denom for amounts. It returns
+// false if the log does not request a message.
+func NewMsgFromLog(log *ethtypes.Log, bondDenom string) (sdk.Msg, bool, error) {
+	if len(log.Topics) != 2 {
+		return nil, false, nil
+	}
+	delegator := sdk.AccAddress(common.BytesToAddress(log.Topics[1].Bytes()).Bytes())
+
+	var eventName string
+	for _, name := range []string{delegateEvent, unbondEvent, redelegateEvent, withdrawRewardsEvent} {
+		if log.Topics[0] == ABI.Events[name].ID {
+			eventName = name
+		}
+	}
+	if eventName == "" {
+		return nil, false, nil
+	}
+
+	values, err := ABI.Events[eventName].Inputs.NonIndexed().Unpack(log.Data)
+	if err != nil {
+		return nil, true, fmt.Errorf("failed to unpack log: %w", err)
+	}
+
+	coin := func(amount interface{}) sdk.Coin {
+		return sdk.NewCoin(bondDenom, sdkmath.NewIntFromBigInt(amount.(*big.Int)))
+	}
+
+	switch eventName {
+	case delegateEvent:
+		return &stakingtypes.MsgDelegate{
+			DelegatorAddress: delegator.String(),
+			ValidatorAddress: values[0].(string),
+			Amount:           coin(values[1]),
+		}, true, nil
+	case unbondEvent:
+		return &stakingtypes.MsgUndelegate{
+			DelegatorAddress: delegator.String(),
+			ValidatorAddress: values[0].(string),
+			Amount:           coin(values[1]),
+		}, true, nil
+	case redelegateEvent:
+		return &stakingtypes.MsgBeginRedelegate{
+			DelegatorAddress:    delegator.String(),
+			ValidatorSrcAddress: values[0].(string),
+			ValidatorDstAddress: values[1].(string),
+			Amount:              coin(values[2]),
+		}, true, nil
+	default:
+		return &distrtypes.MsgWithdrawDelegatorReward{
+			DelegatorAddress: delegator.String(),
+			ValidatorAddress: values[0].(string),
+		}, true, nil
+	}
+}
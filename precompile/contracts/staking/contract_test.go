@@ -0,0 +1,279 @@
+package staking_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/precompile/contract"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kava-labs/kava/precompile/contracts/staking"
+)
+
+// mockStateDB records logs and storage and panics on any other state access.
+type mockStateDB struct {
+	contract.StateDB
+	logs    []*ethtypes.Log
+	storage map[common.Hash]common.Hash
+}
+
+func newMockStateDB() *mockStateDB {
+	return &mockStateDB{storage: make(map[common.Hash]common.Hash)}
+}
+
+func (s *mockStateDB) AddLog(log *ethtypes.Log) {
+	s.logs = append(s.logs, log)
+}
+
+func (s *mockStateDB) GetState(_ common.Address, key common.Hash) common.Hash {
+	return s.storage[key]
+}
+
+func (s *mockStateDB) SetState(_ common.Address, key common.Hash, value common.Hash) {
+	s.storage[key] = value
+}
+
+type mockAccessibleState struct {
+	stateDB *mockStateDB
+}
+
+func (s mockAccessibleState) GetStateDB() contract.StateDB {
+	return s.stateDB
+}
+
+var (
+	precompileAddr = common.HexToAddress("0x9000000000000000000000000000000000000004")
+	delegator      = common.HexToAddress("0x7Bbf300890857b8c241b219C6a489431669b3aFA")
+	spender        = common.HexToAddress("0xeA7100edA2f805356291B0E55DaD448599a72C6d")
+	validator      = sdk.ValAddress(common.HexToAddress("0x0000000000000000000000000000000000000101").Bytes())
+	validator2     = sdk.ValAddress(common.HexToAddress("0x0000000000000000000000000000000000000102").Bytes())
+)
+
+// TestContractConstructor ensures we have a valid constructor. This will fail
+// if we attempt to define invalid or duplicate function selectors.
+func TestContractConstructor(t *testing.T) {
+	precompile, err := staking.NewContract()
+	require.NoError(t, err, "expected precompile not error when created")
+	assert.NotNil(t, precompile, "expected precompile contract to be defined")
+}
+
+func TestDelegationMethods(t *testing.T) {
+	testCases := []struct {
+		name        string
+		caller      common.Address
+		approved    bool
+		method      string
+		args        []interface{}
+		gas         uint64
+		readOnly    bool
+		expectedMsg sdk.Msg
+		expectedErr string
+	}{
+		{
+			name:   "delegate",
+			caller: delegator,
+			method: "delegate",
+			args:   []interface{}{delegator, validator.String(), big.NewInt(1e6)},
+			gas:    staking.DelegateGas,
+			expectedMsg: stakingtypes.NewMsgDelegate(
+				delegator.Bytes(), validator, sdk.NewInt64Coin("ukava", 1e6),
+			),
+		},
+		{
+			name:   "undelegate",
+			caller: delegator,
+			method: "undelegate",
+			args:   []interface{}{delegator, validator.String(), big.NewInt(1e6)},
+			gas:    staking.UndelegateGas,
+			expectedMsg: stakingtypes.NewMsgUndelegate(
+				delegator.Bytes(), validator, sdk.NewInt64Coin("ukava", 1e6),
+			),
+		},
+		{
+			name:   "redelegate",
+			caller: delegator,
+			method: "redelegate",
+			args:   []interface{}{delegator, validator.String(), validator2.String(), big.NewInt(1e6)},
+			gas:    staking.RedelegateGas,
+			expectedMsg: stakingtypes.NewMsgBeginRedelegate(
+				delegator.Bytes(), validator, validator2, sdk.NewInt64Coin("ukava", 1e6),
+			),
+		},
+		{
+			name:        "withdraw rewards",
+			caller:      delegator,
+			method:      "withdrawRewards",
+			args:        []interface{}{delegator, validator.String()},
+			gas:         staking.WithdrawRewardsGas,
+			expectedMsg: distrtypes.NewMsgWithdrawDelegatorReward(delegator.Bytes(), validator),
+		},
+		{
+			name:     "approved caller",
+			caller:   spender,
+			approved: true,
+			method:   "delegate",
+			args:     []interface{}{delegator, validator.String(), big.NewInt(1e6)},
+			gas:      staking.DelegateGas,
+			expectedMsg: stakingtypes.NewMsgDelegate(
+				delegator.Bytes(), validator, sdk.NewInt64Coin("ukava", 1e6),
+			),
+		},
+		{
+			name:        "unapproved caller",
+			caller:      spender,
+			method:      "delegate",
+			args:        []interface{}{delegator, validator.String(), big.NewInt(1e6)},
+			gas:         staking.DelegateGas,
+			expectedErr: "is not approved by delegator",
+		},
+		{
+			name:        "out of gas",
+			caller:      delegator,
+			method:      "delegate",
+			args:        []interface{}{delegator, validator.String(), big.NewInt(1e6)},
+			gas:         staking.DelegateGas - 1,
+			expectedErr: "out of gas",
+		},
+		{
+			name:        "read only",
+			caller:      delegator,
+			method:      "undelegate",
+			args:        []interface{}{delegator, validator.String(), big.NewInt(1e6)},
+			gas:         staking.UndelegateGas,
+			readOnly:    true,
+			expectedErr: vm.ErrWriteProtection.Error(),
+		},
+		{
+			name:        "zero amount",
+			caller:      delegator,
+			method:      "delegate",
+			args:        []interface{}{delegator, validator.String(), big.NewInt(0)},
+			gas:         staking.DelegateGas,
+			expectedErr: "amount must be positive",
+		},
+		{
+			name:        "invalid validator",
+			caller:      delegator,
+			method:      "withdrawRewards",
+			args:        []interface{}{delegator, "kava1invalid"},
+			gas:         staking.WithdrawRewardsGas,
+			expectedErr: "invalid validator address",
+		},
+		{
+			name:        "redelegate to same validator",
+			caller:      delegator,
+			method:      "redelegate",
+			args:        []interface{}{delegator, validator.String(), validator.String(), big.NewInt(1e6)},
+			gas:         staking.RedelegateGas,
+			expectedErr: "cannot redelegate to the same validator",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			precompile, err := staking.NewContract()
+			require.NoError(t, err)
+
+			state := mockAccessibleState{stateDB: newMockStateDB()}
+			if tc.approved {
+				state.stateDB.storage[staking.ApprovalSlot(delegator, tc.caller)] = common.BigToHash(big.NewInt(1))
+			}
+
+			input, err := staking.ABI.Pack(tc.method, tc.args...)
+			require.NoError(t, err)
+			ret, remainingGas, err := precompile.Run(state, tc.caller, precompileAddr, input, tc.gas, tc.readOnly)
+
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+				require.Empty(t, state.stateDB.logs)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Empty(t, ret)
+			require.Equal(t, uint64(0), remainingGas)
+			require.Len(t, state.stateDB.logs, 1)
+			require.Equal(t, precompileAddr, state.stateDB.logs[0].Address)
+
+			msg, found, err := staking.NewMsgFromLog(state.stateDB.logs[0], "ukava")
+			require.NoError(t, err)
+			require.True(t, found)
+			require.Equal(t, tc.expectedMsg, msg)
+		})
+	}
+}
+
+func TestApprove(t *testing.T) {
+	precompile, err := staking.NewContract()
+	require.NoError(t, err)
+	state := mockAccessibleState{stateDB: newMockStateDB()}
+
+	queryApproved := func() bool {
+		input, err := staking.ABI.Pack("isApproved", delegator, spender)
+		require.NoError(t, err)
+		ret, _, err := precompile.Run(state, spender, precompileAddr, input, staking.QueryGas, true)
+		require.NoError(t, err)
+		values, err := staking.ABI.Methods["isApproved"].Outputs.Unpack(ret)
+		require.NoError(t, err)
+		return values[0].(bool)
+	}
+
+	require.False(t, queryApproved())
+
+	for _, approved := range []bool{true, false} {
+		input, err := staking.ABI.Pack("approve", spender, approved)
+		require.NoError(t, err)
+		_, _, err = precompile.Run(state, delegator, precompileAddr, input, staking.ApproveGas, false)
+		require.NoError(t, err)
+		require.Equal(t, approved, queryApproved())
+	}
+
+	// approvals emit logs that do not request a message
+	require.Len(t, state.stateDB.logs, 2)
+	_, found, err := staking.NewMsgFromLog(state.stateDB.logs[0], "ukava")
+	require.NoError(t, err)
+	require.False(t, found)
+
+	input, err := staking.ABI.Pack("approve", spender, true)
+	require.NoError(t, err)
+	_, _, err = precompile.Run(state, delegator, precompileAddr, input, staking.ApproveGas, true)
+	require.ErrorIs(t, err, vm.ErrWriteProtection)
+}
+
+func TestDelegation(t *testing.T) {
+	precompile, err := staking.NewContract()
+	require.NoError(t, err)
+	state := mockAccessibleState{stateDB: newMockStateDB()}
+
+	// the validator has been slashed by half, so the delegation is backed by half its shares
+	shares := sdk.MustNewDecFromStr("1000000.5")
+	state.stateDB.storage[staking.DelegationSlot(delegator, validator)] = common.BigToHash(shares.BigInt())
+	state.stateDB.storage[staking.ValidatorTokensSlot(validator)] = common.BigToHash(big.NewInt(2e6))
+	state.stateDB.storage[staking.ValidatorSharesSlot(validator)] = common.BigToHash(sdk.NewDec(4e6).BigInt())
+
+	input, err := staking.ABI.Pack("delegation", delegator, validator.String())
+	require.NoError(t, err)
+	ret, remainingGas, err := precompile.Run(state, spender, precompileAddr, input, staking.QueryGas+1, true)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), remainingGas)
+
+	values, err := staking.ABI.Methods["delegation"].Outputs.Unpack(ret)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(500000), values[0].(*big.Int), "amount should be truncated")
+
+	input, err = staking.ABI.Pack("delegation", delegator, validator2.String())
+	require.NoError(t, err)
+	ret, _, err = precompile.Run(state, spender, precompileAddr, input, staking.QueryGas, true)
+	require.NoError(t, err)
+	values, err = staking.ABI.Methods["delegation"].Outputs.Unpack(ret)
+	require.NoError(t, err)
+	require.True(t, sdkmath.ZeroInt().Equal(sdkmath.NewIntFromBigInt(values[0].(*big.Int))))
+}
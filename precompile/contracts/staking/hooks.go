@@ -0,0 +1,200 @@
+package staking
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// MsgRouter routes sdk.Msgs to their handlers.
+type MsgRouter interface {
+	Handler(msg sdk.Msg) baseapp.MsgServiceHandler
+}
+
+// StakingKeeper defines the expected staking keeper.
+type StakingKeeper interface {
+	BondDenom(ctx sdk.Context) string
+	GetDelegation(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (stakingtypes.Delegation, bool)
+	GetValidator(ctx sdk.Context, addr sdk.ValAddress) (stakingtypes.Validator, bool)
+	GetAllValidators(ctx sdk.Context) []stakingtypes.Validator
+	IterateAllDelegations(ctx sdk.Context, cb func(delegation stakingtypes.Delegation) (stop bool))
+}
+
+// EvmKeeper defines the expected evm keeper.
+type EvmKeeper interface {
+	SetState(ctx sdk.Context, addr common.Address, key common.Hash, value []byte)
+}
+
+var _ evmtypes.EvmHooks = EvmHooks{}
+
+// EvmHooks executes the messages requested through the staking precompile
+// after an EVM transaction succeeds.
+type EvmHooks struct {
+	address       common.Address
+	router        MsgRouter
+	stakingKeeper StakingKeeper
+}
+
+// NewEvmHooks returns the x/evm hooks of the staking precompile at the given address.
+func NewEvmHooks(address common.Address, router MsgRouter, stakingKeeper StakingKeeper) EvmHooks {
+	return EvmHooks{
+		address:       address,
+		router:        router,
+		stakingKeeper: stakingKeeper,
+	}
+}
+
+// PostTxProcessing executes the staking and distribution messages recorded in
+// the logs of the precompile. Returning an error reverts the whole EVM transaction.
+func (h EvmHooks) PostTxProcessing(ctx sdk.Context, _ core.Message, receipt *ethtypes.Receipt) error {
+	for _, log := range receipt.Logs {
+		if log.Address != h.address {
+			continue
+		}
+
+		msg, found, err := NewMsgFromLog(log, h.stakingKeeper.BondDenom(ctx))
+		if err != nil {
+			return err
+		}
+		if !found {
+			continue
+		}
+
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+		handler := h.router.Handler(msg)
+		if handler == nil {
+			return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "no handler for %s", sdk.MsgTypeURL(msg))
+		}
+		res, err := handler(ctx, msg)
+		if err != nil {
+			return errorsmod.Wrapf(err, "failed to execute %s from staking precompile", sdk.MsgTypeURL(msg))
+		}
+		for _, event := range res.GetEvents() {
+			ctx.EventManager().EmitEvent(sdk.Event(event))
+		}
+	}
+
+	return nil
+}
+
+var _ stakingtypes.StakingHooks = StakingHooks{}
+
+// StakingHooks mirrors the shares of delegations, and the tokens and shares of
+// their validators, to the storage of the staking precompile, so the amount
+// backing a delegation can be queried by EVM contracts.
+type StakingHooks struct {
+	address       common.Address
+	evmKeeper     EvmKeeper
+	stakingKeeper StakingKeeper
+}
+
+// NewStakingHooks returns the x/staking hooks of the staking precompile at the given address.
+func NewStakingHooks(address common.Address, evmKeeper EvmKeeper, stakingKeeper StakingKeeper) StakingHooks {
+	return StakingHooks{
+		address:       address,
+		evmKeeper:     evmKeeper,
+		stakingKeeper: stakingKeeper,
+	}
+}
+
+// MirrorAllDelegations writes the shares of all existing delegations and the
+// tokens and shares of all validators to the precompile storage. It must be run
+// when the precompile is enabled on a chain with existing delegations.
+func (h StakingHooks) MirrorAllDelegations(ctx sdk.Context) {
+	for _, validator := range h.stakingKeeper.GetAllValidators(ctx) {
+		h.setValidator(ctx, validator.GetOperator(), validator.Tokens, validator.DelegatorShares)
+	}
+	h.stakingKeeper.IterateAllDelegations(ctx, func(delegation stakingtypes.Delegation) bool {
+		h.setShares(ctx, delegation)
+		return false
+	})
+}
+
+// AfterDelegationModified writes the shares of the delegation, and the tokens
+// and shares of its validator, to the precompile storage.
+func (h StakingHooks) AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	delegation, found := h.stakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	if found {
+		h.setShares(ctx, delegation)
+	}
+	// Unbonds remove the tokens and shares of the validator after this hook, but
+	// they are removed at the validator's exchange rate so the mirrored rate holds.
+	if validator, found := h.stakingKeeper.GetValidator(ctx, valAddr); found {
+		h.setValidator(ctx, valAddr, validator.Tokens, validator.DelegatorShares)
+	}
+	return nil
+}
+
+// BeforeDelegationRemoved clears the shares of the delegation from the precompile storage.
+func (h StakingHooks) BeforeDelegationRemoved(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	h.evmKeeper.SetState(ctx, h.address, DelegationSlot(common.BytesToAddress(delAddr), valAddr), nil)
+	return nil
+}
+
+func (h StakingHooks) setShares(ctx sdk.Context, delegation stakingtypes.Delegation) {
+	slot := DelegationSlot(common.BytesToAddress(delegation.GetDelegatorAddr()), delegation.GetValidatorAddr())
+	h.evmKeeper.SetState(ctx, h.address, slot, common.BigToHash(delegation.Shares.BigInt()).Bytes())
+}
+
+func (h StakingHooks) setValidator(ctx sdk.Context, valAddr sdk.ValAddress, tokens sdkmath.Int, shares sdk.Dec) {
+	h.evmKeeper.SetState(ctx, h.address, ValidatorTokensSlot(valAddr), common.BigToHash(tokens.BigInt()).Bytes())
+	h.evmKeeper.SetState(ctx, h.address, ValidatorSharesSlot(valAddr), common.BigToHash(shares.BigInt()).Bytes())
+}
+
+// AfterValidatorCreated is called after a validator is created.
+func (h StakingHooks) AfterValidatorCreated(sdk.Context, sdk.ValAddress) error { return nil }
+
+// BeforeValidatorModified is called before a validator is modified.
+func (h StakingHooks) BeforeValidatorModified(sdk.Context, sdk.ValAddress) error { return nil }
+
+// AfterValidatorRemoved clears the tokens and shares of the validator from the precompile storage.
+func (h StakingHooks) AfterValidatorRemoved(ctx sdk.Context, _ sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	h.evmKeeper.SetState(ctx, h.address, ValidatorTokensSlot(valAddr), nil)
+	h.evmKeeper.SetState(ctx, h.address, ValidatorSharesSlot(valAddr), nil)
+	return nil
+}
+
+// AfterValidatorBonded is called after a validator is bonded.
+func (h StakingHooks) AfterValidatorBonded(sdk.Context, sdk.ConsAddress, sdk.ValAddress) error {
+	return nil
+}
+
+// AfterValidatorBeginUnbonding is called after a validator begins unbonding.
+func (h StakingHooks) AfterValidatorBeginUnbonding(sdk.Context, sdk.ConsAddress, sdk.ValAddress) error {
+	return nil
+}
+
+// BeforeDelegationCreated is called before a delegation is created.
+func (h StakingHooks) BeforeDelegationCreated(sdk.Context, sdk.AccAddress, sdk.ValAddress) error {
+	return nil
+}
+
+// BeforeDelegationSharesModified is called before a delegation's shares are modified.
+func (h StakingHooks) BeforeDelegationSharesModified(sdk.Context, sdk.AccAddress, sdk.ValAddress) error {
+	return nil
+}
+
+// BeforeValidatorSlashed writes the tokens the validator will have after the slash to the
+// precompile storage. The fraction is the effective fraction of the validator's tokens
+// that are burned, rounded up, so truncating the burned tokens recovers the exact amount.
+func (h StakingHooks) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec) error {
+	validator, found := h.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return nil
+	}
+	burned := sdk.NewDecFromInt(validator.Tokens).Mul(fraction).TruncateInt()
+	h.setValidator(ctx, valAddr, validator.Tokens.Sub(burned), validator.DelegatorShares)
+	return nil
+}
+
+// AfterUnbondingInitiated is called after an unbonding operation is initiated.
+func (h StakingHooks) AfterUnbondingInitiated(sdk.Context, uint64) error { return nil }
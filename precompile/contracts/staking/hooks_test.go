@@ -0,0 +1,181 @@
+package staking_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtime "github.com/cometbft/cometbft/types/time"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/precompile/contracts/staking"
+	"github.com/kava-labs/kava/precompile/registry"
+)
+
+type hooksTestSuite struct {
+	suite.Suite
+
+	App app.TestApp
+	Ctx sdk.Context
+
+	address   common.Address
+	validator sdk.ValAddress
+	delegator common.Address
+}
+
+func TestHooksTestSuite(t *testing.T) {
+	suite.Run(t, new(hooksTestSuite))
+}
+
+func (suite *hooksTestSuite) SetupTest() {
+	suite.App = app.NewTestApp()
+	suite.App.InitializeFromGenesisStates()
+	suite.Ctx = suite.App.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+
+	suite.address = common.HexToAddress(registry.StakingContractAddress)
+	suite.delegator = common.BytesToAddress(app.RandomAddress())
+	suite.validator = sdk.ValAddress(app.RandomAddress())
+
+	err := suite.App.FundAccount(suite.Ctx, suite.delegator.Bytes(), sdk.NewCoins(sdk.NewInt64Coin("ukava", 1e10)))
+	suite.Require().NoError(err)
+	err = suite.App.FundAccount(suite.Ctx, suite.validator.Bytes(), sdk.NewCoins(sdk.NewInt64Coin("ukava", 1e10)))
+	suite.Require().NoError(err)
+
+	msg, err := stakingtypes.NewMsgCreateValidator(
+		suite.validator,
+		ed25519.GenPrivKey().PubKey(),
+		sdk.NewInt64Coin("ukava", 1e6),
+		stakingtypes.Description{},
+		stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
+		sdkmath.NewInt(1e6),
+	)
+	suite.Require().NoError(err)
+	_, err = stakingkeeper.NewMsgServerImpl(suite.App.GetStakingKeeper()).CreateValidator(sdk.WrapSDKContext(suite.Ctx), msg)
+	suite.Require().NoError(err)
+}
+
+// precompileLogs runs a precompile method called by the delegator and returns the emitted logs.
+func (suite *hooksTestSuite) precompileLogs(method string, args ...interface{}) []*ethtypes.Log {
+	precompile, err := staking.NewContract()
+	suite.Require().NoError(err)
+
+	input, err := staking.ABI.Pack(method, args...)
+	suite.Require().NoError(err)
+
+	state := mockAccessibleState{stateDB: newMockStateDB()}
+	_, _, err = precompile.Run(state, suite.delegator, suite.address, input, 1e6, false)
+	suite.Require().NoError(err)
+	return state.stateDB.logs
+}
+
+func (suite *hooksTestSuite) postTxProcessing(logs []*ethtypes.Log) error {
+	hooks := staking.NewEvmHooks(suite.address, suite.App.MsgServiceRouter(), suite.App.GetStakingKeeper())
+	return hooks.PostTxProcessing(suite.Ctx, nil, &ethtypes.Receipt{Logs: logs})
+}
+
+func (suite *hooksTestSuite) mirroredShares() *big.Int {
+	slot := staking.DelegationSlot(suite.delegator, suite.validator)
+	return suite.App.GetEvmKeeper().GetState(suite.Ctx, suite.address, slot).Big()
+}
+
+// mirroredAmount returns the amount backing the delegation calculated from the mirrored state.
+func (suite *hooksTestSuite) mirroredAmount() *big.Int {
+	evmKeeper := suite.App.GetEvmKeeper()
+	tokens := evmKeeper.GetState(suite.Ctx, suite.address, staking.ValidatorTokensSlot(suite.validator)).Big()
+	shares := evmKeeper.GetState(suite.Ctx, suite.address, staking.ValidatorSharesSlot(suite.validator)).Big()
+	amount := new(big.Int).Mul(suite.mirroredShares(), tokens)
+	return amount.Quo(amount, shares)
+}
+
+func (suite *hooksTestSuite) TestPostTxProcessing() {
+	logs := suite.precompileLogs("delegate", suite.delegator, suite.validator.String(), big.NewInt(1e8))
+	suite.Require().NoError(suite.postTxProcessing(logs))
+
+	delegation, found := suite.App.GetStakingKeeper().GetDelegation(suite.Ctx, suite.delegator.Bytes(), suite.validator)
+	suite.Require().True(found)
+	suite.Equal(sdk.NewDec(1e8), delegation.Shares)
+	suite.Equal(delegation.Shares.BigInt(), suite.mirroredShares(), "delegation shares should be mirrored")
+	suite.Equal(big.NewInt(1e8), suite.mirroredAmount())
+
+	logs = suite.precompileLogs("undelegate", suite.delegator, suite.validator.String(), big.NewInt(4e7))
+	suite.Require().NoError(suite.postTxProcessing(logs))
+	suite.Equal(sdk.NewDec(6e7).BigInt(), suite.mirroredShares())
+
+	logs = suite.precompileLogs("withdrawRewards", suite.delegator, suite.validator.String())
+	suite.Require().NoError(suite.postTxProcessing(logs))
+
+	logs = suite.precompileLogs("undelegate", suite.delegator, suite.validator.String(), big.NewInt(6e7))
+	suite.Require().NoError(suite.postTxProcessing(logs))
+	_, found = suite.App.GetStakingKeeper().GetDelegation(suite.Ctx, suite.delegator.Bytes(), suite.validator)
+	suite.False(found)
+	suite.Equal(0, suite.mirroredShares().Sign(), "removed delegations should be cleared")
+}
+
+func (suite *hooksTestSuite) TestPostTxProcessing_Error() {
+	logs := suite.precompileLogs("delegate", suite.delegator, suite.validator.String(), big.NewInt(1e11))
+	err := suite.postTxProcessing(logs)
+	suite.ErrorContains(err, "failed to execute /cosmos.staking.v1beta1.MsgDelegate from staking precompile")
+}
+
+func (suite *hooksTestSuite) TestPostTxProcessing_OtherAddress() {
+	logs := suite.precompileLogs("delegate", suite.delegator, suite.validator.String(), big.NewInt(1e8))
+	logs[0].Address = common.HexToAddress(registry.IBCTransferContractAddress)
+	suite.Require().NoError(suite.postTxProcessing(logs))
+
+	_, found := suite.App.GetStakingKeeper().GetDelegation(suite.Ctx, suite.delegator.Bytes(), suite.validator)
+	suite.False(found, "logs of other contracts should be ignored")
+}
+
+func (suite *hooksTestSuite) TestBeforeValidatorSlashed() {
+	logs := suite.precompileLogs("delegate", suite.delegator, suite.validator.String(), big.NewInt(1e8))
+	suite.Require().NoError(suite.postTxProcessing(logs))
+
+	stakingKeeper := suite.App.GetStakingKeeper()
+	_, err := stakingKeeper.ApplyAndReturnValidatorSetUpdates(suite.Ctx)
+	suite.Require().NoError(err)
+	validator, found := stakingKeeper.GetValidator(suite.Ctx, suite.validator)
+	suite.Require().True(found)
+	consAddr, err := validator.GetConsAddr()
+	suite.Require().NoError(err)
+
+	power := validator.ConsensusPower(stakingKeeper.PowerReduction(suite.Ctx))
+	stakingKeeper.Slash(suite.Ctx, consAddr, suite.Ctx.BlockHeight(), power, sdk.MustNewDecFromStr("0.1"))
+
+	validator, found = stakingKeeper.GetValidator(suite.Ctx, suite.validator)
+	suite.Require().True(found)
+	delegation, found := stakingKeeper.GetDelegation(suite.Ctx, suite.delegator.Bytes(), suite.validator)
+	suite.Require().True(found)
+	expected := validator.TokensFromShares(delegation.Shares).TruncateInt()
+	suite.Equal(sdkmath.NewInt(90_000_000), expected)
+	suite.Equal(expected.BigInt(), suite.mirroredAmount(), "slashed tokens should be mirrored")
+}
+
+func (suite *hooksTestSuite) TestMirrorAllDelegations() {
+	validatorSlot := staking.DelegationSlot(common.BytesToAddress(suite.validator), suite.validator)
+	suite.App.GetEvmKeeper().SetState(suite.Ctx, suite.address, validatorSlot, nil)
+	suite.App.GetEvmKeeper().SetState(suite.Ctx, suite.address, staking.ValidatorTokensSlot(suite.validator), nil)
+
+	hooks := staking.NewStakingHooks(suite.address, suite.App.GetEvmKeeper(), suite.App.GetStakingKeeper())
+	hooks.MirrorAllDelegations(suite.Ctx)
+
+	shares := suite.App.GetEvmKeeper().GetState(suite.Ctx, suite.address, validatorSlot).Big()
+	suite.Equal(sdk.NewDec(1e6).BigInt(), shares, "self delegation should be mirrored")
+	tokens := suite.App.GetEvmKeeper().GetState(suite.Ctx, suite.address, staking.ValidatorTokensSlot(suite.validator)).Big()
+	suite.Equal(big.NewInt(1e6), tokens, "validator tokens should be mirrored")
+}
+
+func (suite *hooksTestSuite) TestAfterValidatorRemoved() {
+	hooks := staking.NewStakingHooks(suite.address, suite.App.GetEvmKeeper(), suite.App.GetStakingKeeper())
+	suite.Require().NoError(hooks.AfterValidatorRemoved(suite.Ctx, nil, suite.validator))
+
+	tokens := suite.App.GetEvmKeeper().GetState(suite.Ctx, suite.address, staking.ValidatorTokensSlot(suite.validator))
+	suite.Equal(common.Hash{}, tokens, "removed validators should be cleared")
+}
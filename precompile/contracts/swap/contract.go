@@ -0,0 +1,290 @@
+package swap
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/precompile/contract"
+
+	"github.com/kava-labs/kava/precompile/msgexec"
+	swaptypes "github.com/kava-labs/kava/x/swap/types"
+)
+
+// Gas charged for each method of the swap precompile. It covers the x/swap
+// message and the ERC20 conversions that are executed after the EVM
+// transaction succeeds.
+const (
+	DepositGas            uint64 = 350_000
+	WithdrawGas           uint64 = 350_000
+	SwapExactForTokensGas uint64 = 300_000
+)
+
+const (
+	depositMethod            = "deposit"
+	withdrawMethod           = "withdraw"
+	swapExactForTokensMethod = "swapExactForTokens"
+
+	depositEvent            = "Deposit"
+	withdrawEvent           = "Withdraw"
+	swapExactForTokensEvent = "SwapExactForTokens"
+)
+
+const rawABI = `[
+	{
+		"type": "function",
+		"name": "deposit",
+		"stateMutability": "nonpayable",
+		"inputs": [
+			{"name": "denomA", "type": "string"},
+			{"name": "amountA", "type": "uint256"},
+			{"name": "denomB", "type": "string"},
+			{"name": "amountB", "type": "uint256"},
+			{"name": "slippage", "type": "uint256"},
+			{"name": "deadline", "type": "uint256"}
+		],
+		"outputs": []
+	},
+	{
+		"type": "function",
+		"name": "withdraw",
+		"stateMutability": "nonpayable",
+		"inputs": [
+			{"name": "shares", "type": "uint256"},
+			{"name": "denomA", "type": "string"},
+			{"name": "minAmountA", "type": "uint256"},
+			{"name": "denomB", "type": "string"},
+			{"name": "minAmountB", "type": "uint256"},
+			{"name": "deadline", "type": "uint256"}
+		],
+		"outputs": []
+	},
+	{
+		"type": "function",
+		"name": "swapExactForTokens",
+		"stateMutability": "nonpayable",
+		"inputs": [
+			{"name": "denomIn", "type": "string"},
+			{"name": "amountIn", "type": "uint256"},
+			{"name": "denomOut", "type": "string"},
+			{"name": "amountOut", "type": "uint256"},
+			{"name": "slippage", "type": "uint256"},
+			{"name": "deadline", "type": "uint256"}
+		],
+		"outputs": []
+	},
+	{
+		"type": "event",
+		"name": "Deposit",
+		"anonymous": false,
+		"inputs": [
+			{"name": "account", "type": "address", "indexed": true},
+			{"name": "denomA", "type": "string", "indexed": false},
+			{"name": "amountA", "type": "uint256", "indexed": false},
+			{"name": "denomB", "type": "string", "indexed": false},
+			{"name": "amountB", "type": "uint256", "indexed": false},
+			{"name": "slippage", "type": "uint256", "indexed": false},
+			{"name": "deadline", "type": "uint256", "indexed": false}
+		]
+	},
+	{
+		"type": "event",
+		"name": "Withdraw",
+		"anonymous": false,
+		"inputs": [
+			{"name": "account", "type": "address", "indexed": true},
+			{"name": "shares", "type": "uint256", "indexed": false},
+			{"name": "denomA", "type": "string", "indexed": false},
+			{"name": "minAmountA", "type": "uint256", "indexed": false},
+			{"name": "denomB", "type": "string", "indexed": false},
+			{"name": "minAmountB", "type": "uint256", "indexed": false},
+			{"name": "deadline", "type": "uint256", "indexed": false}
+		]
+	},
+	{
+		"type": "event",
+		"name": "SwapExactForTokens",
+		"anonymous": false,
+		"inputs": [
+			{"name": "account", "type": "address", "indexed": true},
+			{"name": "denomIn", "type": "string", "indexed": false},
+			{"name": "amountIn", "type": "uint256", "indexed": false},
+			{"name": "denomOut", "type": "string", "indexed": false},
+			{"name": "amountOut", "type": "uint256", "indexed": false},
+			{"name": "slippage", "type": "uint256", "indexed": false},
+			{"name": "deadline", "type": "uint256", "indexed": false}
+		]
+	}
+]`
+
+// ABI is the interface of the swap precompile.
+var ABI = contract.MustParseABI(rawABI)
+
+// methodEvents maps the methods of the precompile to the events they emit.
+var methodEvents = map[string]string{
+	depositMethod:            depositEvent,
+	withdrawMethod:           withdrawEvent,
+	swapExactForTokensMethod: swapExactForTokensEvent,
+}
+
+// NewContract returns a new swap stateful precompiled contract.
+//
+//	This contract lets EVM accounts and contracts add liquidity to, remove liquidity from and
+//	trade with the pools of x/swap. A successful call emits a log, which is executed as the
+//	equivalent x/swap message for the caller after the EVM transaction succeeds. The transaction
+//	is reverted if the message fails.
+//
+//	Amounts are in the units of the sdk.Coin of each denom, slippage has 18 decimals and deadlines
+//	are unix seconds. Denoms of enabled x/evmutil conversion pairs are spent from and paid to the
+//	caller's ERC20 balance.
+func NewContract() (contract.StatefulPrecompiledContract, error) {
+	precompile, err := contract.NewStatefulPrecompileContract([]*contract.StatefulPrecompileFunction{
+		contract.NewStatefulPrecompileFunction(ABI.Methods[depositMethod].ID, newRecordFunction(depositMethod, DepositGas)),
+		contract.NewStatefulPrecompileFunction(ABI.Methods[withdrawMethod].ID, newRecordFunction(withdrawMethod, WithdrawGas)),
+		contract.NewStatefulPrecompileFunction(ABI.Methods[swapExactForTokensMethod].ID, newRecordFunction(swapExactForTokensMethod, SwapExactForTokensGas)),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to instantiate swap precompile: %w", err)
+	}
+
+	return precompile, nil
+}
+
+// newRecordFunction returns a precompile function that validates the
+// arguments of a method and records them in the transaction logs for the caller.
+func newRecordFunction(methodName string, gas uint64) contract.RunStatefulPrecompileFunc {
+	return func(
+		accessibleState contract.AccessibleState,
+		caller common.Address,
+		addr common.Address,
+		input []byte,
+		suppliedGas uint64,
+		readOnly bool,
+	) ([]byte, uint64, error) {
+		remainingGas, err := contract.DeductGas(suppliedGas, gas)
+		if err != nil {
+			return nil, 0, err
+		}
+		if readOnly {
+			return nil, remainingGas, vm.ErrWriteProtection
+		}
+
+		method := ABI.Methods[methodName]
+		values, err := method.Inputs.Unpack(input)
+		if err != nil {
+			return nil, remainingGas, fmt.Errorf("failed to unpack input: %w", err)
+		}
+		if err := validateArgs(method.Inputs, values); err != nil {
+			return nil, remainingGas, err
+		}
+
+		event := ABI.Events[methodEvents[methodName]]
+		data, err := event.Inputs.NonIndexed().Pack(values...)
+		if err != nil {
+			return nil, remainingGas, fmt.Errorf("failed to pack event: %w", err)
+		}
+		accessibleState.GetStateDB().AddLog(&ethtypes.Log{
+			Address: addr,
+			Topics:  []common.Hash{event.ID, common.BytesToHash(caller.Bytes())},
+			Data:    data,
+		})
+
+		return nil, remainingGas, nil
+	}
+}
+
+// validateArgs validates the denoms and amounts of a method. The message is
+// fully validated when it is executed.
+func validateArgs(args abi.Arguments, values []interface{}) error {
+	denoms := make(map[string]bool)
+	for i, arg := range args {
+		switch value := values[i].(type) {
+		case string:
+			if err := sdk.ValidateDenom(value); err != nil {
+				return fmt.Errorf("invalid %s: %w", arg.Name, err)
+			}
+			if denoms[value] {
+				return fmt.Errorf("denoms must be different")
+			}
+			denoms[value] = true
+		case *big.Int:
+			if arg.Name == "deadline" {
+				if !value.IsInt64() {
+					return fmt.Errorf("deadline is too large")
+				}
+				continue
+			}
+			if value.BitLen() > sdkmath.MaxBitLen {
+				return fmt.Errorf("%s is too large", arg.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// NewRequestFromLog returns the x/swap message requested by a log of the
+// precompile. It returns false if the log does not request a message.
+func NewRequestFromLog(log *ethtypes.Log) (msgexec.Request, bool, error) {
+	if len(log.Topics) != 2 {
+		return msgexec.Request{}, false, nil
+	}
+
+	var eventName string
+	for _, name := range methodEvents {
+		if log.Topics[0] == ABI.Events[name].ID {
+			eventName = name
+		}
+	}
+	if eventName == "" {
+		return msgexec.Request{}, false, nil
+	}
+
+	values, err := ABI.Events[eventName].Inputs.NonIndexed().Unpack(log.Data)
+	if err != nil {
+		return msgexec.Request{}, true, fmt.Errorf("failed to unpack log: %w", err)
+	}
+
+	account := common.BytesToAddress(log.Topics[1].Bytes())
+	sender := sdk.AccAddress(account.Bytes()).String()
+	req := msgexec.Request{Account: account}
+
+	coin := func(denom, amount interface{}) sdk.Coin {
+		return sdk.NewCoin(denom.(string), sdkmath.NewIntFromBigInt(amount.(*big.Int)))
+	}
+	dec := func(amount interface{}) sdk.Dec {
+		return sdk.NewDecFromBigIntWithPrec(amount.(*big.Int), sdk.Precision)
+	}
+	deadline := func(value interface{}) int64 {
+		deadline := value.(*big.Int)
+		if !deadline.IsInt64() {
+			return math.MaxInt64
+		}
+		return deadline.Int64()
+	}
+
+	switch eventName {
+	case depositEvent:
+		tokenA, tokenB := coin(values[0], values[1]), coin(values[2], values[3])
+		req.Msg = swaptypes.NewMsgDeposit(sender, tokenA, tokenB, dec(values[4]), deadline(values[5]))
+		req.Inputs = sdk.NewCoins(tokenA, tokenB)
+	case withdrawEvent:
+		shares := sdkmath.NewIntFromBigInt(values[0].(*big.Int))
+		minTokenA, minTokenB := coin(values[1], values[2]), coin(values[3], values[4])
+		req.Msg = swaptypes.NewMsgWithdraw(sender, shares, minTokenA, minTokenB, deadline(values[5]))
+		req.OutputDenoms = []string{minTokenA.Denom, minTokenB.Denom}
+	default:
+		tokenIn, tokenOut := coin(values[0], values[1]), coin(values[2], values[3])
+		req.Msg = swaptypes.NewMsgSwapExactForTokens(sender, tokenIn, tokenOut, dec(values[4]), deadline(values[5]))
+		req.Inputs = sdk.NewCoins(tokenIn)
+		req.OutputDenoms = []string{tokenOut.Denom}
+	}
+
+	return req, true, nil
+}
@@ -0,0 +1,167 @@
+package swap_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/precompile/contract"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kava-labs/kava/precompile/contracts/swap"
+	"github.com/kava-labs/kava/precompile/msgexec"
+	swaptypes "github.com/kava-labs/kava/x/swap/types"
+)
+
+// mockStateDB records logs and panics on any other state access.
+type mockStateDB struct {
+	contract.StateDB
+	logs []*ethtypes.Log
+}
+
+func (s *mockStateDB) AddLog(log *ethtypes.Log) {
+	s.logs = append(s.logs, log)
+}
+
+type mockAccessibleState struct {
+	stateDB *mockStateDB
+}
+
+func (s mockAccessibleState) GetStateDB() contract.StateDB {
+	return s.stateDB
+}
+
+var (
+	precompileAddr = common.HexToAddress("0x9000000000000000000000000000000000000007")
+	caller         = common.HexToAddress("0x7Bbf300890857b8c241b219C6a489431669b3aFA")
+	callerAcc      = sdk.AccAddress(caller.Bytes()).String()
+)
+
+// TestContractConstructor ensures we have a valid constructor. This will fail
+// if we attempt to define invalid or duplicate function selectors.
+func TestContractConstructor(t *testing.T) {
+	precompile, err := swap.NewContract()
+	require.NoError(t, err, "expected precompile not error when created")
+	assert.NotNil(t, precompile, "expected precompile contract to be defined")
+}
+
+func TestMethods(t *testing.T) {
+	ukava := sdk.NewInt64Coin("ukava", 1e6)
+	usdc := sdk.NewInt64Coin("erc20/usdc", 2e6)
+	slippage := sdk.MustNewDecFromStr("0.01")
+	deadline := big.NewInt(1700000000)
+
+	testCases := []struct {
+		name        string
+		method      string
+		args        []interface{}
+		gas         uint64
+		readOnly    bool
+		expectedReq msgexec.Request
+		expectedErr string
+	}{
+		{
+			name:   "deposit",
+			method: "deposit",
+			args:   []interface{}{"ukava", ukava.Amount.BigInt(), "erc20/usdc", usdc.Amount.BigInt(), slippage.BigInt(), deadline},
+			gas:    swap.DepositGas,
+			expectedReq: msgexec.Request{
+				Account: caller,
+				Msg:     swaptypes.NewMsgDeposit(callerAcc, ukava, usdc, slippage, deadline.Int64()),
+				Inputs:  sdk.NewCoins(ukava, usdc),
+			},
+		},
+		{
+			name:   "withdraw",
+			method: "withdraw",
+			args:   []interface{}{big.NewInt(5e5), "ukava", ukava.Amount.BigInt(), "erc20/usdc", usdc.Amount.BigInt(), deadline},
+			gas:    swap.WithdrawGas,
+			expectedReq: msgexec.Request{
+				Account:      caller,
+				Msg:          swaptypes.NewMsgWithdraw(callerAcc, sdkmath.NewInt(5e5), ukava, usdc, deadline.Int64()),
+				OutputDenoms: []string{"ukava", "erc20/usdc"},
+			},
+		},
+		{
+			name:   "swap exact for tokens",
+			method: "swapExactForTokens",
+			args:   []interface{}{"erc20/usdc", usdc.Amount.BigInt(), "ukava", ukava.Amount.BigInt(), slippage.BigInt(), deadline},
+			gas:    swap.SwapExactForTokensGas,
+			expectedReq: msgexec.Request{
+				Account:      caller,
+				Msg:          swaptypes.NewMsgSwapExactForTokens(callerAcc, usdc, ukava, slippage, deadline.Int64()),
+				Inputs:       sdk.NewCoins(usdc),
+				OutputDenoms: []string{"ukava"},
+			},
+		},
+		{
+			name:        "out of gas",
+			method:      "swapExactForTokens",
+			args:        []interface{}{"erc20/usdc", usdc.Amount.BigInt(), "ukava", ukava.Amount.BigInt(), slippage.BigInt(), deadline},
+			gas:         swap.SwapExactForTokensGas - 1,
+			expectedErr: "out of gas",
+		},
+		{
+			name:        "read only",
+			method:      "deposit",
+			args:        []interface{}{"ukava", ukava.Amount.BigInt(), "erc20/usdc", usdc.Amount.BigInt(), slippage.BigInt(), deadline},
+			gas:         swap.DepositGas,
+			readOnly:    true,
+			expectedErr: vm.ErrWriteProtection.Error(),
+		},
+		{
+			name:        "invalid denom",
+			method:      "deposit",
+			args:        []interface{}{"ukava", ukava.Amount.BigInt(), "", usdc.Amount.BigInt(), slippage.BigInt(), deadline},
+			gas:         swap.DepositGas,
+			expectedErr: "invalid denomB",
+		},
+		{
+			name:        "same denoms",
+			method:      "swapExactForTokens",
+			args:        []interface{}{"ukava", ukava.Amount.BigInt(), "ukava", ukava.Amount.BigInt(), slippage.BigInt(), deadline},
+			gas:         swap.SwapExactForTokensGas,
+			expectedErr: "denoms must be different",
+		},
+		{
+			name:        "deadline too large",
+			method:      "withdraw",
+			args:        []interface{}{big.NewInt(5e5), "ukava", ukava.Amount.BigInt(), "erc20/usdc", usdc.Amount.BigInt(), new(big.Int).Lsh(big.NewInt(1), 64)},
+			gas:         swap.WithdrawGas,
+			expectedErr: "deadline is too large",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			precompile, err := swap.NewContract()
+			require.NoError(t, err)
+
+			state := mockAccessibleState{stateDB: &mockStateDB{}}
+			input, err := swap.ABI.Pack(tc.method, tc.args...)
+			require.NoError(t, err)
+			ret, remainingGas, err := precompile.Run(state, caller, precompileAddr, input, tc.gas, tc.readOnly)
+
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+				require.Empty(t, state.stateDB.logs)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Empty(t, ret)
+			require.Equal(t, uint64(0), remainingGas)
+			require.Len(t, state.stateDB.logs, 1)
+
+			req, found, err := swap.NewRequestFromLog(state.stateDB.logs[0])
+			require.NoError(t, err)
+			require.True(t, found)
+			require.Equal(t, tc.expectedReq, req)
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package msgexec
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	evmutiltypes "github.com/kava-labs/kava/x/evmutil/types"
+)
+
+// MsgRouter routes sdk.Msgs to their handlers.
+type MsgRouter interface {
+	Handler(msg sdk.Msg) baseapp.MsgServiceHandler
+}
+
+// BankKeeper defines the expected bank keeper.
+type BankKeeper interface {
+	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+}
+
+// EvmutilKeeper defines the expected evmutil keeper.
+type EvmutilKeeper interface {
+	GetEnabledConversionPairFromDenom(ctx sdk.Context, denom string) (evmutiltypes.ConversionPair, error)
+	ConvertERC20ToExactCoin(ctx sdk.Context, initiator evmutiltypes.InternalEVMAddress, receiver sdk.AccAddress, coin sdk.Coin) error
+	ConvertCoinToERC20(ctx sdk.Context, initiatorAccount sdk.AccAddress, receiverAccount evmutiltypes.InternalEVMAddress, coin sdk.Coin) error
+
+	GetDeployedCosmosCoinContract(ctx sdk.Context, cosmosDenom string) (evmutiltypes.InternalEVMAddress, bool)
+	ConvertCosmosCoinFromERC20(ctx sdk.Context, initiator evmutiltypes.InternalEVMAddress, receiver sdk.AccAddress, coin sdk.Coin) error
+	ConvertCosmosCoinToERC20(ctx sdk.Context, initiator sdk.AccAddress, receiver evmutiltypes.InternalEVMAddress, amount sdk.Coin) error
+}
+
+// Request is a message requested by an EVM account through a precompile.
+type Request struct {
+	// Account is the EVM account the message is executed for.
+	Account common.Address
+	// Msg is the message to execute, signed by the cosmos account of Account.
+	Msg sdk.Msg
+	// Inputs are the coins the message spends from the account.
+	Inputs sdk.Coins
+	// OutputDenoms are the denoms of the coins the message may pay to the account.
+	OutputDenoms []string
+}
+
+// Executor executes the messages requested by EVM accounts through precompiles.
+//
+// EVM accounts hold the coins of enabled x/evmutil conversion pairs and of
+// cosmos denoms with a deployed x/evmutil ERC20 as ERC20s, so inputs of these
+// denoms are converted from the account's ERC20 to coins before the message is
+// executed, and coins of these denoms paid to the account by the message are
+// converted back to the ERC20 afterwards. The cosmos account is left with the
+// same balance of these denoms as before the message. Other denoms, including
+// the ukava backing the EVM balance through x/precisebank, are spent from and
+// paid to the cosmos account directly.
+type Executor struct {
+	router        MsgRouter
+	bankKeeper    BankKeeper
+	evmutilKeeper EvmutilKeeper
+}
+
+// NewExecutor returns a new Executor.
+func NewExecutor(router MsgRouter, bankKeeper BankKeeper, evmutilKeeper EvmutilKeeper) Executor {
+	return Executor{
+		router:        router,
+		bankKeeper:    bankKeeper,
+		evmutilKeeper: evmutilKeeper,
+	}
+}
+
+// Execute converts the ERC20 inputs of the request, executes its message and
+// converts the coins of conversion pairs paid by the message back to ERC20s.
+func (e Executor) Execute(ctx sdk.Context, req Request) error {
+	if err := req.Msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	account := sdk.AccAddress(req.Account.Bytes())
+	evmAccount := evmutiltypes.NewInternalEVMAddress(req.Account)
+
+	// record the balances of converted denoms before the inputs are converted
+	var convertedDenoms []string
+	cosmosDenoms := make(map[string]bool)
+	balances := make(map[string]sdk.Coin)
+	for _, denom := range append(req.Inputs.Denoms(), req.OutputDenoms...) {
+		if _, seen := balances[denom]; seen {
+			continue
+		}
+		if _, err := e.evmutilKeeper.GetEnabledConversionPairFromDenom(ctx, denom); err != nil {
+			if _, found := e.evmutilKeeper.GetDeployedCosmosCoinContract(ctx, denom); !found {
+				continue
+			}
+			cosmosDenoms[denom] = true
+		}
+		convertedDenoms = append(convertedDenoms, denom)
+		balances[denom] = e.bankKeeper.GetBalance(ctx, account, denom)
+	}
+
+	for _, coin := range req.Inputs {
+		if _, found := balances[coin.Denom]; !found {
+			continue
+		}
+		if err := e.convertToCoin(ctx, evmAccount, account, coin, cosmosDenoms[coin.Denom]); err != nil {
+			return errorsmod.Wrapf(err, "failed to convert %s erc20 to coin", coin.Denom)
+		}
+	}
+
+	if err := e.executeMsg(ctx, req.Msg); err != nil {
+		return err
+	}
+
+	for _, denom := range convertedDenoms {
+		balance := e.bankKeeper.GetBalance(ctx, account, denom)
+		if !balances[denom].IsLT(balance) {
+			continue
+		}
+		if err := e.convertToERC20(ctx, account, evmAccount, balance.Sub(balances[denom]), cosmosDenoms[denom]); err != nil {
+			return errorsmod.Wrapf(err, "failed to convert %s coin to erc20", denom)
+		}
+	}
+
+	return nil
+}
+
+// convertToCoin converts the ERC20 of an EVM-native conversion pair or a cosmos denom to the coin.
+func (e Executor) convertToCoin(
+	ctx sdk.Context,
+	evmAccount evmutiltypes.InternalEVMAddress,
+	account sdk.AccAddress,
+	coin sdk.Coin,
+	cosmosDenom bool,
+) error {
+	if cosmosDenom {
+		return e.evmutilKeeper.ConvertCosmosCoinFromERC20(ctx, evmAccount, account, coin)
+	}
+	return e.evmutilKeeper.ConvertERC20ToExactCoin(ctx, evmAccount, account, coin)
+}
+
+// convertToERC20 converts the coin of an EVM-native conversion pair or a cosmos denom to the ERC20.
+func (e Executor) convertToERC20(
+	ctx sdk.Context,
+	account sdk.AccAddress,
+	evmAccount evmutiltypes.InternalEVMAddress,
+	coin sdk.Coin,
+	cosmosDenom bool,
+) error {
+	if cosmosDenom {
+		return e.evmutilKeeper.ConvertCosmosCoinToERC20(ctx, account, evmAccount, coin)
+	}
+	return e.evmutilKeeper.ConvertCoinToERC20(ctx, account, evmAccount, coin)
+}
+
+func (e Executor) executeMsg(ctx sdk.Context, msg sdk.Msg) error {
+	handler := e.router.Handler(msg)
+	if handler == nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "no handler for %s", sdk.MsgTypeURL(msg))
+	}
+	res, err := handler(ctx, msg)
+	if err != nil {
+		return errorsmod.Wrapf(err, "failed to execute %s", sdk.MsgTypeURL(msg))
+	}
+	for _, event := range res.GetEvents() {
+		ctx.EventManager().EmitEvent(sdk.Event(event))
+	}
+	return nil
+}
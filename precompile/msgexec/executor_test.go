@@ -0,0 +1,218 @@
+package msgexec_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/precompile/msgexec"
+	"github.com/kava-labs/kava/x/evmutil/testutil"
+	evmutiltypes "github.com/kava-labs/kava/x/evmutil/types"
+)
+
+type executorTestSuite struct {
+	testutil.Suite
+
+	contractAddr evmutiltypes.InternalEVMAddress
+	account      sdk.AccAddress
+	other        sdk.AccAddress
+}
+
+func TestExecutorTestSuite(t *testing.T) {
+	suite.Run(t, new(executorTestSuite))
+}
+
+func (suite *executorTestSuite) SetupTest() {
+	suite.Suite.SetupTest()
+
+	suite.contractAddr = suite.DeployERC20()
+	suite.account = sdk.AccAddress(suite.Key1Addr.Bytes())
+	suite.other = app.RandomAddress()
+
+	err := suite.Keeper.MintERC20(suite.Ctx, suite.contractAddr, suite.Key1Addr, big.NewInt(100))
+	suite.Require().NoError(err)
+}
+
+func (suite *executorTestSuite) executor() msgexec.Executor {
+	return msgexec.NewExecutor(suite.App.MsgServiceRouter(), suite.App.GetBankKeeper(), &suite.Keeper)
+}
+
+func (suite *executorTestSuite) erc20Balance() *big.Int {
+	return suite.GetERC20BalanceOf(evmutiltypes.ERC20MintableBurnableContract.ABI, suite.contractAddr, suite.Key1Addr)
+}
+
+func (suite *executorTestSuite) TestExecute_ConvertsInputs() {
+	coin := sdk.NewInt64Coin("erc20/usdc", 60)
+	err := suite.executor().Execute(suite.Ctx, msgexec.Request{
+		Account: suite.Key1Addr.Address,
+		Msg:     banktypes.NewMsgSend(suite.account, suite.other, sdk.NewCoins(coin)),
+		Inputs:  sdk.NewCoins(coin),
+	})
+	suite.Require().NoError(err)
+
+	suite.Equal(big.NewInt(40), suite.erc20Balance())
+	suite.True(suite.BankKeeper.GetBalance(suite.Ctx, suite.account, "erc20/usdc").IsZero())
+	suite.Equal(coin, suite.BankKeeper.GetBalance(suite.Ctx, suite.other, "erc20/usdc"))
+}
+
+func (suite *executorTestSuite) TestExecute_ConvertsOutputs() {
+	// existing coins of the account are not converted
+	err := suite.App.FundAccount(suite.Ctx, suite.account, sdk.NewCoins(sdk.NewInt64Coin("erc20/usdc", 5)))
+	suite.Require().NoError(err)
+	err = suite.App.FundAccount(suite.Ctx, suite.other, sdk.NewCoins(sdk.NewInt64Coin("erc20/usdc", 25)))
+	suite.Require().NoError(err)
+	// the module locks the erc20 backing the funded coins
+	err = suite.Keeper.MintERC20(suite.Ctx, suite.contractAddr, evmutiltypes.NewInternalEVMAddress(evmutiltypes.ModuleEVMAddress), big.NewInt(30))
+	suite.Require().NoError(err)
+
+	err = suite.executor().Execute(suite.Ctx, msgexec.Request{
+		Account:      suite.Key1Addr.Address,
+		Msg:          banktypes.NewMsgSend(suite.other, suite.account, sdk.NewCoins(sdk.NewInt64Coin("erc20/usdc", 25))),
+		OutputDenoms: []string{"erc20/usdc"},
+	})
+	suite.Require().NoError(err)
+
+	suite.Equal(big.NewInt(125), suite.erc20Balance())
+	suite.Equal(sdkmath.NewInt(5), suite.BankKeeper.GetBalance(suite.Ctx, suite.account, "erc20/usdc").Amount)
+}
+
+// setupCosmosDenom allows conversions of a cosmos denom and converts coins of the account to
+// the denom's deployed ERC20.
+func (suite *executorTestSuite) setupCosmosDenom(denom string, amount int64) evmutiltypes.InternalEVMAddress {
+	params := suite.Keeper.GetParams(suite.Ctx)
+	params.AllowedCosmosDenoms = evmutiltypes.NewAllowedCosmosCoinERC20Tokens(
+		evmutiltypes.NewAllowedCosmosCoinERC20Token(denom, "Kava EVM Hard", "HARD", 6),
+	)
+	suite.Keeper.SetParams(suite.Ctx, params)
+
+	err := suite.App.FundAccount(suite.Ctx, suite.account, sdk.NewCoins(sdk.NewInt64Coin(denom, amount)))
+	suite.Require().NoError(err)
+	err = suite.Keeper.ConvertCosmosCoinToERC20(suite.Ctx, suite.account, suite.Key1Addr, sdk.NewInt64Coin(denom, amount))
+	suite.Require().NoError(err)
+
+	contractAddr, found := suite.Keeper.GetDeployedCosmosCoinContract(suite.Ctx, denom)
+	suite.Require().True(found)
+	return contractAddr
+}
+
+func (suite *executorTestSuite) TestExecute_ConvertsCosmosDenomInputs() {
+	contractAddr := suite.setupCosmosDenom("hard", 100)
+
+	coin := sdk.NewInt64Coin("hard", 60)
+	err := suite.executor().Execute(suite.Ctx, msgexec.Request{
+		Account: suite.Key1Addr.Address,
+		Msg:     banktypes.NewMsgSend(suite.account, suite.other, sdk.NewCoins(coin)),
+		Inputs:  sdk.NewCoins(coin),
+	})
+	suite.Require().NoError(err)
+
+	suite.Equal(big.NewInt(40), suite.GetERC20BalanceOf(evmutiltypes.ERC20KavaWrappedCosmosCoinContract.ABI, contractAddr, suite.Key1Addr))
+	suite.True(suite.BankKeeper.GetBalance(suite.Ctx, suite.account, "hard").IsZero())
+	suite.Equal(coin, suite.BankKeeper.GetBalance(suite.Ctx, suite.other, "hard"))
+}
+
+func (suite *executorTestSuite) TestExecute_ConvertsCosmosDenomOutputs() {
+	contractAddr := suite.setupCosmosDenom("hard", 100)
+	err := suite.App.FundAccount(suite.Ctx, suite.other, sdk.NewCoins(sdk.NewInt64Coin("hard", 25)))
+	suite.Require().NoError(err)
+
+	err = suite.executor().Execute(suite.Ctx, msgexec.Request{
+		Account:      suite.Key1Addr.Address,
+		Msg:          banktypes.NewMsgSend(suite.other, suite.account, sdk.NewCoins(sdk.NewInt64Coin("hard", 25))),
+		OutputDenoms: []string{"hard"},
+	})
+	suite.Require().NoError(err)
+
+	suite.Equal(big.NewInt(125), suite.GetERC20BalanceOf(evmutiltypes.ERC20KavaWrappedCosmosCoinContract.ABI, contractAddr, suite.Key1Addr))
+	suite.True(suite.BankKeeper.GetBalance(suite.Ctx, suite.account, "hard").IsZero())
+}
+
+func (suite *executorTestSuite) TestExecute_OtherDenoms() {
+	coin := sdk.NewInt64Coin("ukava", 1e6)
+	err := suite.executor().Execute(suite.Ctx, msgexec.Request{
+		Account: suite.Key1Addr.Address,
+		Msg:     banktypes.NewMsgSend(suite.account, suite.other, sdk.NewCoins(coin)),
+		Inputs:  sdk.NewCoins(coin),
+	})
+	suite.Require().NoError(err)
+
+	suite.Equal(big.NewInt(100), suite.erc20Balance())
+	suite.Equal(coin, suite.BankKeeper.GetBalance(suite.Ctx, suite.other, "ukava"))
+}
+
+func (suite *executorTestSuite) TestExecute_Errors() {
+	coin := sdk.NewInt64Coin("erc20/usdc", 60)
+	err := suite.executor().Execute(suite.Ctx, msgexec.Request{
+		Account: suite.Key1Addr.Address,
+		Msg:     banktypes.NewMsgSend(suite.account, suite.other, sdk.NewCoins(coin.AddAmount(sdkmath.NewInt(1)))),
+		Inputs:  sdk.NewCoins(coin),
+	})
+	suite.ErrorContains(err, "failed to execute /cosmos.bank.v1beta1.MsgSend")
+
+	err = suite.executor().Execute(suite.Ctx, msgexec.Request{
+		Account: suite.Key1Addr.Address,
+		Msg:     banktypes.NewMsgSend(suite.account, suite.other, sdk.NewCoins(coin.AddAmount(sdkmath.NewInt(100)))),
+		Inputs:  sdk.NewCoins(coin.AddAmount(sdkmath.NewInt(100))),
+	})
+	suite.ErrorContains(err, "failed to convert erc20/usdc erc20 to coin")
+
+	err = suite.executor().Execute(suite.Ctx, msgexec.Request{
+		Account: suite.Key1Addr.Address,
+		Msg:     banktypes.NewMsgSend(suite.account, suite.other, sdk.Coins{}),
+	})
+	suite.ErrorContains(err, "invalid coins")
+}
+
+func (suite *executorTestSuite) TestEvmHooks() {
+	address := common.HexToAddress("0x9000000000000000000000000000000000000099")
+	coin := sdk.NewInt64Coin("erc20/usdc", 10)
+	parser := func(log *ethtypes.Log) (msgexec.Request, bool, error) {
+		if len(log.Topics) == 0 {
+			return msgexec.Request{}, false, nil
+		}
+		if len(log.Data) > 0 {
+			return msgexec.Request{}, true, errors.New("invalid log")
+		}
+		return msgexec.Request{
+			Account: suite.Key1Addr.Address,
+			Msg:     banktypes.NewMsgSend(suite.account, suite.other, sdk.NewCoins(coin)),
+			Inputs:  sdk.NewCoins(coin),
+		}, true, nil
+	}
+	hooks := msgexec.NewEvmHooks("test", address, parser, suite.executor())
+
+	err := hooks.PostTxProcessing(suite.Ctx, nil, &ethtypes.Receipt{Logs: []*ethtypes.Log{
+		{Address: address, Topics: []common.Hash{{}}},
+		{Address: address},
+		{Address: common.HexToAddress("0x01"), Topics: []common.Hash{{}}},
+	}})
+	suite.Require().NoError(err)
+	suite.Equal(coin, suite.BankKeeper.GetBalance(suite.Ctx, suite.other, "erc20/usdc"), "only requests of the precompile should be executed")
+
+	err = hooks.PostTxProcessing(suite.Ctx, nil, &ethtypes.Receipt{Logs: []*ethtypes.Log{
+		{Address: address, Topics: []common.Hash{{}}, Data: []byte{1}},
+	}})
+	suite.ErrorContains(err, "invalid log")
+
+	err = hooks.PostTxProcessing(suite.Ctx, nil, &ethtypes.Receipt{Logs: []*ethtypes.Log{
+		{Address: address, Topics: []common.Hash{{}}},
+		{Address: address, Topics: []common.Hash{{}}},
+		{Address: address, Topics: []common.Hash{{}}},
+		{Address: address, Topics: []common.Hash{{}}},
+		{Address: address, Topics: []common.Hash{{}}},
+		{Address: address, Topics: []common.Hash{{}}},
+		{Address: address, Topics: []common.Hash{{}}},
+		{Address: address, Topics: []common.Hash{{}}},
+		{Address: address, Topics: []common.Hash{{}}},
+		{Address: address, Topics: []common.Hash{{}}},
+	}})
+	suite.ErrorContains(err, "failed to execute request from test precompile")
+}
@@ -0,0 +1,59 @@
+package msgexec
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// RequestParser returns the request recorded in a log of a precompile. It
+// returns false if the log does not request a message.
+type RequestParser func(log *ethtypes.Log) (Request, bool, error)
+
+var _ evmtypes.EvmHooks = EvmHooks{}
+
+// EvmHooks executes the messages requested through a precompile after an EVM
+// transaction succeeds.
+type EvmHooks struct {
+	name     string
+	address  common.Address
+	parser   RequestParser
+	executor Executor
+}
+
+// NewEvmHooks returns the x/evm hooks of the named precompile at the given address.
+func NewEvmHooks(name string, address common.Address, parser RequestParser, executor Executor) EvmHooks {
+	return EvmHooks{
+		name:     name,
+		address:  address,
+		parser:   parser,
+		executor: executor,
+	}
+}
+
+// PostTxProcessing executes the requests recorded in the logs of the
+// precompile. Returning an error reverts the whole EVM transaction.
+func (h EvmHooks) PostTxProcessing(ctx sdk.Context, _ core.Message, receipt *ethtypes.Receipt) error {
+	for _, log := range receipt.Logs {
+		if log.Address != h.address {
+			continue
+		}
+
+		req, found, err := h.parser(log)
+		if err != nil {
+			return err
+		}
+		if !found {
+			continue
+		}
+
+		if err := h.executor.Execute(ctx, req); err != nil {
+			return errorsmod.Wrapf(err, "failed to execute request from %s precompile", h.name)
+		}
+	}
+
+	return nil
+}
@@ -7,7 +7,13 @@ import (
 	"github.com/ethereum/go-ethereum/precompile/contract"
 	"github.com/ethereum/go-ethereum/precompile/modules"
 
+	"github.com/kava-labs/kava/precompile/contracts/governance"
+	"github.com/kava-labs/kava/precompile/contracts/hard"
+	"github.com/kava-labs/kava/precompile/contracts/ibctransfer"
 	"github.com/kava-labs/kava/precompile/contracts/noop"
+	"github.com/kava-labs/kava/precompile/contracts/pricefeed"
+	"github.com/kava-labs/kava/precompile/contracts/staking"
+	"github.com/kava-labs/kava/precompile/contracts/swap"
 )
 
 const (
@@ -15,6 +21,18 @@ const (
 	NoopContractAddress = "0x9000000000000000000000000000000000000001"
 	// NoopContractAddress2 the secondary noop contract address for testing
 	NoopContractAddress2 = "0x9000000000000000000000000000000000000002"
+	// IBCTransferContractAddress the ibc transfer contract address for sending EVM-native ERC20s over IBC
+	IBCTransferContractAddress = "0x9000000000000000000000000000000000000003"
+	// StakingContractAddress the staking contract address for delegating and withdrawing staking rewards
+	StakingContractAddress = "0x9000000000000000000000000000000000000004"
+	// PricefeedContractAddress the pricefeed contract address for reading x/pricefeed prices
+	PricefeedContractAddress = "0x9000000000000000000000000000000000000005"
+	// HardContractAddress the hard contract address for lending and borrowing with the hard money market
+	HardContractAddress = "0x9000000000000000000000000000000000000006"
+	// SwapContractAddress the swap contract address for providing liquidity and trading with swap pools
+	SwapContractAddress = "0x9000000000000000000000000000000000000007"
+	// GovernanceContractAddress the governance contract address for voting on x/gov and x/committee proposals
+	GovernanceContractAddress = "0x9000000000000000000000000000000000000008"
 )
 
 // init registers stateful precompile contracts with the global precompile registry
@@ -22,6 +40,12 @@ const (
 func init() {
 	register(NoopContractAddress, noop.NewContract)
 	register(NoopContractAddress2, noop.NewContract)
+	register(IBCTransferContractAddress, ibctransfer.NewContract)
+	register(StakingContractAddress, staking.NewContract)
+	register(PricefeedContractAddress, pricefeed.NewContract)
+	register(HardContractAddress, hard.NewContract)
+	register(SwapContractAddress, swap.NewContract)
+	register(GovernanceContractAddress, governance.NewContract)
 }
 
 // register accepts a 0x address string and a stateful precompile contract constructor, instantiates the
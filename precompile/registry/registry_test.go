@@ -26,6 +26,12 @@ func TestRegisteredPrecompilesAddresses(t *testing.T) {
 		// 0x9 address space used for e2e & integration tests
 		"0x9000000000000000000000000000000000000001", // noop
 		"0x9000000000000000000000000000000000000002", // noop (duplicated for testing)
+		"0x9000000000000000000000000000000000000003", // ibc transfer
+		"0x9000000000000000000000000000000000000004", // staking
+		"0x9000000000000000000000000000000000000005", // pricefeed
+		"0x9000000000000000000000000000000000000006", // hard
+		"0x9000000000000000000000000000000000000007", // swap
+		"0x9000000000000000000000000000000000000008", // governance
 	}
 
 	assert.Equal(t, expectedPrecompiles, registeredPrecompiles,
@@ -16,5 +16,7 @@ import (
 func BeginBlocker(ctx sdk.Context, _ abci.RequestBeginBlock, k keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 
+	k.ProcessMemberTerms(ctx)
+	k.ProcessQueuedProposals(ctx)
 	k.ProcessProposals(ctx)
 }
@@ -32,8 +32,10 @@ func GetQueryCmd() *cobra.Command {
 		getCmdQueryNextProposalID(),
 		getCmdQueryProposal(),
 		getCmdQueryProposals(),
+		getCmdQueryQueuedProposals(),
 		// votes
 		getCmdQueryVotes(),
+		getCmdQueryVotingDelegation(),
 		// other
 		getCmdQueryProposer(),
 		getCmdQueryTally(),
@@ -130,6 +132,28 @@ func getCmdQueryNextProposalID() *cobra.Command {
 	}
 }
 
+// getCmdQueryQueuedProposals implements a query queued proposals command.
+func getCmdQueryQueuedProposals() *cobra.Command {
+	return &cobra.Command{
+		Use:     "queued-proposals",
+		Args:    cobra.NoArgs,
+		Short:   "Query all passed proposals waiting for their timelock to expire",
+		Example: fmt.Sprintf("%s query %s queued-proposals", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.QueuedProposals(context.Background(), &types.QueryQueuedProposalsRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
 // getCmdQueryProposal implements the query proposal command.
 func getCmdQueryProposal() *cobra.Command {
 	return &cobra.Command{
@@ -228,6 +252,29 @@ func getCmdQueryVotes() *cobra.Command {
 	}
 }
 
+func getCmdQueryVotingDelegation() *cobra.Command {
+	return &cobra.Command{
+		Use:     "voting-delegation [delegator]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Query the representative a delegator delegated token committee voting power to",
+		Example: fmt.Sprintf("%s query %s voting-delegation kava1...", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.VotingDelegation(context.Background(), &types.QueryVotingDelegationRequest{
+				Delegator: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
 // ------------------------------------------
 //				Other
 // ------------------------------------------
@@ -73,6 +73,10 @@ func GetTxCmd() *cobra.Command {
 
 	cmds := []*cobra.Command{
 		getCmdVote(),
+		getCmdRotateMember(),
+		getCmdResign(),
+		getCmdDelegateVotingPower(),
+		getCmdUndelegateVotingPower(),
 		getCmdSubmitProposal(),
 	}
 
@@ -197,6 +201,119 @@ func getCmdVote() *cobra.Command {
 }
 
 // GetGovCmdSubmitProposal returns a command to submit a proposal to the gov module. It is passed to the gov module for use on its command subtree.
+func getCmdRotateMember() *cobra.Command {
+	return &cobra.Command{
+		Use:     "rotate-member [committee-id] [new-address]",
+		Args:    cobra.ExactArgs(2),
+		Short:   "Replace your committee member address with a new address",
+		Long:    "Replace the from address as a member of the committee with id [committee-id] with [new-address], keeping its term and votes.",
+		Example: fmt.Sprintf("%s tx %s rotate-member 1 kava1... --from <member>", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// validate that the committee id is a uint
+			committeeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("committee-id %s not a valid int, please input a valid committee-id", args[0])
+			}
+
+			newMember, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRotateCommitteeMember(clientCtx.GetFromAddress(), committeeID, newMember)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
+func getCmdResign() *cobra.Command {
+	return &cobra.Command{
+		Use:     "resign [committee-id]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Resign from a committee",
+		Long:    "Remove the from address as a member of the committee with id [committee-id].",
+		Example: fmt.Sprintf("%s tx %s resign 1 --from <member>", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// validate that the committee id is a uint
+			committeeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("committee-id %s not a valid int, please input a valid committee-id", args[0])
+			}
+
+			msg := types.NewMsgResignCommitteeMember(clientCtx.GetFromAddress(), committeeID)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
+func getCmdDelegateVotingPower() *cobra.Command {
+	return &cobra.Command{
+		Use:     "delegate-voting-power [representative]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Delegate token committee voting power to a representative",
+		Long:    "Count the token committee voting power of the from address towards the votes of [representative] on proposals the from address does not vote on.",
+		Example: fmt.Sprintf("%s tx %s delegate-voting-power kava1... --from <delegator>", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			representative, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDelegateVotingPower(clientCtx.GetFromAddress(), representative)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
+func getCmdUndelegateVotingPower() *cobra.Command {
+	return &cobra.Command{
+		Use:     "undelegate-voting-power",
+		Args:    cobra.NoArgs,
+		Short:   "Remove the token committee voting power delegation of the from address",
+		Example: fmt.Sprintf("%s tx %s undelegate-voting-power --from <delegator>", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUndelegateVotingPower(clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
 func GetGovCmdSubmitProposal() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "committee [proposal-file] [deposit]",
@@ -26,6 +26,12 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, gs *types.GenesisState)
 	for _, v := range gs.Votes {
 		keeper.SetVote(ctx, v)
 	}
+	for _, qp := range gs.QueuedProposals {
+		keeper.SetQueuedProposal(ctx, qp)
+	}
+	for _, d := range gs.VotingDelegations {
+		keeper.SetVotingDelegation(ctx, d)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -38,10 +44,13 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 	proposals := keeper.GetProposals(ctx)
 	votes := keeper.GetVotes(ctx)
 
-	return types.NewGenesisState(
+	gs := types.NewGenesisState(
 		nextID,
 		committees,
 		proposals,
 		votes,
 	)
+	gs.QueuedProposals = keeper.GetQueuedProposals(ctx)
+	gs.VotingDelegations = keeper.GetVotingDelegations(ctx)
+	return gs
 }
@@ -186,6 +186,39 @@ func (s queryServer) RawParams(c context.Context, req *types.QueryRawParamsReque
 	return &types.QueryRawParamsResponse{RawData: string(rawParams)}, nil
 }
 
+// QueuedProposals implements the Query/QueuedProposals gRPC method
+func (s queryServer) QueuedProposals(c context.Context, req *types.QueryQueuedProposalsRequest) (*types.QueryQueuedProposalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryQueuedProposalsResponse{
+		QueuedProposals: s.keeper.GetQueuedProposals(ctx),
+	}, nil
+}
+
+// VotingDelegation implements the Query/VotingDelegation gRPC method
+func (s queryServer) VotingDelegation(c context.Context, req *types.QueryVotingDelegationRequest) (*types.QueryVotingDelegationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	delegator, err := sdk.AccAddressFromBech32(req.Delegator)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	delegation, found := s.keeper.GetVotingDelegation(ctx, delegator)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "voting delegation for %s not found", req.Delegator)
+	}
+
+	return &types.QueryVotingDelegationResponse{
+		Representative: delegation.Representative.String(),
+	}, nil
+}
+
 func (s queryServer) proposalResponseFromProposal(proposal types.Proposal) types.QueryProposalResponse {
 	return types.QueryProposalResponse{
 		PubProposal: proposal.Content,
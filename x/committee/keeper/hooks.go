@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/committee/types"
+)
+
+// Implements CommitteeHooks interface
+var _ types.CommitteeHooks = Keeper{}
+
+// AfterProposalSubmission - call hook if registered
+func (k Keeper) AfterProposalSubmission(ctx sdk.Context, proposalID uint64) {
+	if k.hooks != nil {
+		k.hooks.AfterProposalSubmission(ctx, proposalID)
+	}
+}
+
+// AfterProposalVote - call hook if registered
+func (k Keeper) AfterProposalVote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress) {
+	if k.hooks != nil {
+		k.hooks.AfterProposalVote(ctx, proposalID, voter)
+	}
+}
+
+// AfterProposalClosed - call hook if registered
+func (k Keeper) AfterProposalClosed(ctx sdk.Context, proposal types.Proposal, outcome types.ProposalOutcome, tally *types.QueryTallyResponse) {
+	if k.hooks != nil {
+		k.hooks.AfterProposalClosed(ctx, proposal, outcome, tally)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 
 	"github.com/kava-labs/kava/x/committee/types"
@@ -23,10 +24,20 @@ type Keeper struct {
 
 	// Proposal router
 	router govv1beta1.Router
+	// Msg service router used to execute the messages of MsgsProposals
+	msgRouter types.MsgRouter
+
+	// the address capable of vetoing queued proposals (usually the x/gov module account)
+	authority sdk.AccAddress
+
+	hooks types.CommitteeHooks
+
+	// counts token committee voting power in addition to balances of the tally denom
+	votingPowerSource types.VotingPowerSource
 }
 
-func NewKeeper(cdc codec.Codec, storeKey storetypes.StoreKey, router govv1beta1.Router,
-	paramKeeper types.ParamKeeper, ak types.AccountKeeper, sk types.BankKeeper,
+func NewKeeper(cdc codec.Codec, storeKey storetypes.StoreKey, router govv1beta1.Router, msgRouter types.MsgRouter,
+	paramKeeper types.ParamKeeper, ak types.AccountKeeper, sk types.BankKeeper, authority sdk.AccAddress,
 ) Keeper {
 	// Logic in the keeper methods assume the set of gov handlers is fixed.
 	// So the gov router must be sealed so no handlers can be added or removed after the keeper is created.
@@ -39,7 +50,33 @@ func NewKeeper(cdc codec.Codec, storeKey storetypes.StoreKey, router govv1beta1.
 		accountKeeper: ak,
 		bankKeeper:    sk,
 		router:        router,
+		msgRouter:     msgRouter,
+		authority:     authority,
+		hooks:         nil,
+	}
+}
+
+// SetHooks adds hooks to the keeper.
+func (k *Keeper) SetHooks(hooks types.CommitteeHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set committee hooks twice")
 	}
+	k.hooks = hooks
+	return k
+}
+
+// SetVotingPowerSource sets the source of token committee voting power in addition to balances of the tally denom.
+func (k *Keeper) SetVotingPowerSource(source types.VotingPowerSource) *Keeper {
+	if k.votingPowerSource != nil {
+		panic("cannot set committee voting power source twice")
+	}
+	k.votingPowerSource = source
+	return k
+}
+
+// GetAuthority returns the address capable of vetoing queued proposals.
+func (k Keeper) GetAuthority() sdk.AccAddress {
+	return k.authority
 }
 
 // ------------------------------------------
@@ -221,15 +258,70 @@ func (k Keeper) GetProposalsByCommittee(ctx sdk.Context, committeeID uint64) typ
 	return results
 }
 
-// DeleteProposalAndVotes removes a proposal and its associated votes.
+// DeleteProposalAndVotes removes a proposal, its associated votes and its entry in the execution queue.
 func (k Keeper) DeleteProposalAndVotes(ctx sdk.Context, proposalID uint64) {
 	votes := k.GetVotesByProposal(ctx, proposalID)
 	k.DeleteProposal(ctx, proposalID)
+	k.DeleteQueuedProposal(ctx, proposalID)
 	for _, v := range votes {
 		k.DeleteVote(ctx, v.ProposalID, v.Voter)
 	}
 }
 
+// ------------------------------------------
+//				Queued Proposals
+// ------------------------------------------
+
+// GetQueuedProposal gets the execution queue entry of a passed proposal from the store.
+func (k Keeper) GetQueuedProposal(ctx sdk.Context, proposalID uint64) (types.QueuedProposal, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.QueuedProposalKeyPrefix)
+	bz := store.Get(types.GetKeyFromID(proposalID))
+	if bz == nil {
+		return types.QueuedProposal{}, false
+	}
+	var queuedProposal types.QueuedProposal
+	k.cdc.MustUnmarshal(bz, &queuedProposal)
+	return queuedProposal, true
+}
+
+// SetQueuedProposal puts the execution queue entry of a passed proposal into the store.
+func (k Keeper) SetQueuedProposal(ctx sdk.Context, queuedProposal types.QueuedProposal) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.QueuedProposalKeyPrefix)
+	bz := k.cdc.MustMarshal(&queuedProposal)
+	store.Set(types.GetKeyFromID(queuedProposal.ProposalID), bz)
+}
+
+// DeleteQueuedProposal removes the execution queue entry of a proposal from the store.
+func (k Keeper) DeleteQueuedProposal(ctx sdk.Context, proposalID uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.QueuedProposalKeyPrefix)
+	store.Delete(types.GetKeyFromID(proposalID))
+}
+
+// IterateQueuedProposals provides an iterator over all queued proposals in order of proposal ID.
+// For each queued proposal, cb will be called. If cb returns true, the iterator will close and stop.
+func (k Keeper) IterateQueuedProposals(ctx sdk.Context, cb func(queuedProposal types.QueuedProposal) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.QueuedProposalKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var queuedProposal types.QueuedProposal
+		k.cdc.MustUnmarshal(iterator.Value(), &queuedProposal)
+		if cb(queuedProposal) {
+			break
+		}
+	}
+}
+
+// GetQueuedProposals returns all queued proposals.
+func (k Keeper) GetQueuedProposals(ctx sdk.Context) types.QueuedProposals {
+	results := types.QueuedProposals{}
+	k.IterateQueuedProposals(ctx, func(queuedProposal types.QueuedProposal) bool {
+		results = append(results, queuedProposal)
+		return false
+	})
+	return results
+}
+
 // ------------------------------------------
 //				Votes
 // ------------------------------------------
@@ -299,3 +391,83 @@ func (k Keeper) GetVotesByProposal(ctx sdk.Context, proposalID uint64) []types.V
 
 	return results
 }
+
+// ------------------------------------------
+//				Voting Delegations
+// ------------------------------------------
+
+// GetVotingDelegation gets the representative a delegator delegated its token committee voting power to.
+func (k Keeper) GetVotingDelegation(ctx sdk.Context, delegator sdk.AccAddress) (types.VotingDelegation, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VotingDelegationKeyPrefix)
+	bz := store.Get(delegator.Bytes())
+	if bz == nil {
+		return types.VotingDelegation{}, false
+	}
+	var delegation types.VotingDelegation
+	k.cdc.MustUnmarshal(bz, &delegation)
+	return delegation, true
+}
+
+// SetVotingDelegation puts a voting delegation into the store, replacing any existing delegation of the delegator.
+func (k Keeper) SetVotingDelegation(ctx sdk.Context, delegation types.VotingDelegation) {
+	k.DeleteVotingDelegation(ctx, delegation.Delegator)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VotingDelegationKeyPrefix)
+	bz := k.cdc.MustMarshal(&delegation)
+	store.Set(delegation.Delegator.Bytes(), bz)
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.VotingDelegationByRepresentativeKeyPrefix)
+	indexStore.Set(types.GetVotingDelegationByRepresentativeKey(delegation.Representative, delegation.Delegator), []byte{})
+}
+
+// DeleteVotingDelegation removes the voting delegation of a delegator from the store.
+func (k Keeper) DeleteVotingDelegation(ctx sdk.Context, delegator sdk.AccAddress) {
+	delegation, found := k.GetVotingDelegation(ctx, delegator)
+	if !found {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VotingDelegationKeyPrefix)
+	store.Delete(delegator.Bytes())
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.VotingDelegationByRepresentativeKeyPrefix)
+	indexStore.Delete(types.GetVotingDelegationByRepresentativeKey(delegation.Representative, delegator))
+}
+
+// IterateDelegatorsOfRepresentative provides an iterator over the delegators that delegated their
+// voting power to a representative. If cb returns true, the iterator will close and stop.
+func (k Keeper) IterateDelegatorsOfRepresentative(ctx sdk.Context, representative sdk.AccAddress, cb func(delegator sdk.AccAddress) (stop bool)) {
+	indexPrefix := append(types.VotingDelegationByRepresentativeKeyPrefix, address.MustLengthPrefix(representative)...)
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), indexPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(sdk.AccAddress(iterator.Key()[len(indexPrefix):])) {
+			break
+		}
+	}
+}
+
+// IterateVotingDelegations provides an iterator over all voting delegations.
+// For each delegation, cb will be called. If cb returns true, the iterator will close and stop.
+func (k Keeper) IterateVotingDelegations(ctx sdk.Context, cb func(delegation types.VotingDelegation) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.VotingDelegationKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var delegation types.VotingDelegation
+		k.cdc.MustUnmarshal(iterator.Value(), &delegation)
+		if cb(delegation) {
+			break
+		}
+	}
+}
+
+// GetVotingDelegations returns all voting delegations.
+func (k Keeper) GetVotingDelegations(ctx sdk.Context) []types.VotingDelegation {
+	results := []types.VotingDelegation{}
+	k.IterateVotingDelegations(ctx, func(delegation types.VotingDelegation) bool {
+		results = append(results, delegation)
+		return false
+	})
+	return results
+}
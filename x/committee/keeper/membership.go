@@ -0,0 +1,158 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/committee/types"
+)
+
+// AddMember adds a member to a committee, or updates the term of an existing member.
+// The member has no term if termExpiry is zero.
+func (k Keeper) AddMember(ctx sdk.Context, committeeID uint64, member sdk.AccAddress, termExpiry time.Time) error {
+	com, found := k.GetCommittee(ctx, committeeID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrUnknownCommittee, "%d", committeeID)
+	}
+	if !termExpiry.IsZero() && !termExpiry.After(ctx.BlockTime()) {
+		return errorsmod.Wrapf(types.ErrInvalidCommittee, "term expiry %s must be after block time", termExpiry)
+	}
+
+	com.AddMember(member, termExpiry)
+	if err := com.Validate(); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidCommittee, err.Error())
+	}
+	k.SetCommittee(ctx, com)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMemberAdd,
+			sdk.NewAttribute(types.AttributeKeyCommitteeID, fmt.Sprintf("%d", committeeID)),
+			sdk.NewAttribute(types.AttributeKeyMember, member.String()),
+			sdk.NewAttribute(types.AttributeKeyTermExpiry, formatTermExpiry(termExpiry)),
+		),
+	)
+	return nil
+}
+
+// RemoveMember removes a member from a committee. The reason is emitted in the removal event.
+func (k Keeper) RemoveMember(ctx sdk.Context, committeeID uint64, member sdk.AccAddress, reason string) error {
+	com, found := k.GetCommittee(ctx, committeeID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrUnknownCommittee, "%d", committeeID)
+	}
+	if !com.HasMember(member) {
+		return errorsmod.Wrapf(types.ErrUnknownMember, "%s", member)
+	}
+	if len(com.GetMembers()) == 1 {
+		return errorsmod.Wrap(types.ErrInvalidCommittee, "cannot remove the last member of a committee")
+	}
+
+	k.removeMember(ctx, com, member, reason)
+	return nil
+}
+
+// RotateMember replaces the address of a committee member with a new address. The term of the
+// member and its votes on the committee's proposals are moved to the new address.
+func (k Keeper) RotateMember(ctx sdk.Context, committeeID uint64, member, newMember sdk.AccAddress) error {
+	com, found := k.GetCommittee(ctx, committeeID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrUnknownCommittee, "%d", committeeID)
+	}
+	if !com.HasMember(member) {
+		return errorsmod.Wrapf(types.ErrUnknownMember, "%s", member)
+	}
+	if com.HasMember(newMember) {
+		return errorsmod.Wrapf(types.ErrMemberExists, "%s", newMember)
+	}
+
+	termExpiry, _ := com.GetMemberTerm(member)
+	com.RemoveMember(member)
+	com.AddMember(newMember, termExpiry)
+	k.SetCommittee(ctx, com)
+
+	// votes of token committees are weighted by the voter's balance, so only member committee votes are moved
+	if _, ok := com.(*types.MemberCommittee); ok {
+		for _, proposal := range k.GetProposalsByCommittee(ctx, committeeID) {
+			vote, found := k.GetVote(ctx, proposal.ID, member)
+			if !found {
+				continue
+			}
+			k.DeleteVote(ctx, proposal.ID, member)
+			k.SetVote(ctx, types.NewVote(proposal.ID, newMember, vote.VoteType))
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMemberRotate,
+			sdk.NewAttribute(types.AttributeKeyCommitteeID, fmt.Sprintf("%d", committeeID)),
+			sdk.NewAttribute(types.AttributeKeyMember, member.String()),
+			sdk.NewAttribute(types.AttributeKeyNewMember, newMember.String()),
+		),
+	)
+	return nil
+}
+
+// ProcessMemberTerms removes members whose term has expired from their committees.
+// Committees whose members have all expired are kept without members until x/gov replaces or deletes them.
+func (k Keeper) ProcessMemberTerms(ctx sdk.Context) {
+	for _, com := range k.GetCommittees(ctx) {
+		var expired []sdk.AccAddress
+		for _, term := range com.GetMemberTerms() {
+			if !term.TermExpiry.After(ctx.BlockTime()) {
+				expired = append(expired, term.Member)
+			}
+		}
+		if len(expired) == 0 {
+			continue
+		}
+
+		for _, member := range expired {
+			k.removeMember(ctx, com, member, types.AttributeValueExpired)
+		}
+
+		if len(com.GetMembers()) == 0 {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeCommitteeEmpty,
+					sdk.NewAttribute(types.AttributeKeyCommitteeID, fmt.Sprintf("%d", com.GetID())),
+					sdk.NewAttribute(types.AttributeKeyRemovalReason, types.AttributeValueExpired),
+				),
+			)
+		}
+	}
+}
+
+// removeMember removes a member from a committee, along with its votes on the committee's proposals.
+func (k Keeper) removeMember(ctx sdk.Context, com types.Committee, member sdk.AccAddress, reason string) {
+	com.RemoveMember(member)
+	k.SetCommittee(ctx, com)
+
+	// votes of token committees are weighted by the voter's balance, so only member committee votes are removed
+	if _, ok := com.(*types.MemberCommittee); ok {
+		for _, proposal := range k.GetProposalsByCommittee(ctx, com.GetID()) {
+			k.DeleteVote(ctx, proposal.ID, member)
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMemberRemove,
+			sdk.NewAttribute(types.AttributeKeyCommitteeID, fmt.Sprintf("%d", com.GetID())),
+			sdk.NewAttribute(types.AttributeKeyMember, member.String()),
+			sdk.NewAttribute(types.AttributeKeyRemovalReason, reason),
+		),
+	)
+}
+
+// formatTermExpiry returns the term expiry event attribute, which is empty for members without a term.
+func formatTermExpiry(termExpiry time.Time) string {
+	if termExpiry.IsZero() {
+		return ""
+	}
+	return termExpiry.String()
+}
@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/kava-labs/kava/x/committee/testutil"
+	"github.com/kava-labs/kava/x/committee/types"
+)
+
+func (suite *keeperTestSuite) TestProcessMemberTerms() {
+	blockTime := time.Date(1998, time.January, 1, 0, 0, 0, 0, time.UTC)
+	suite.Ctx = suite.Ctx.WithBlockTime(blockTime)
+	suite.Keeper.SetNextProposalID(suite.Ctx, 1)
+
+	newCommittee := func(id uint64, members []sdk.AccAddress) *types.MemberCommittee {
+		return types.MustNewMemberCommittee(
+			id,
+			"This committee is for testing.",
+			members,
+			[]types.Permission{&types.TextPermission{}},
+			testutil.D("0.5"),
+			time.Hour*24*7,
+			types.TALLY_OPTION_FIRST_PAST_THE_POST,
+		)
+	}
+	partlyExpiring := newCommittee(1, suite.Addresses[:3])
+	partlyExpiring.AddMember(suite.Addresses[0], blockTime.Add(time.Hour))
+	partlyExpiring.AddMember(suite.Addresses[1], blockTime.Add(2*time.Hour))
+	suite.Keeper.SetCommittee(suite.Ctx, partlyExpiring)
+
+	fullyExpiring := newCommittee(2, suite.Addresses[:1])
+	fullyExpiring.AddMember(suite.Addresses[0], blockTime.Add(time.Hour))
+	suite.Keeper.SetCommittee(suite.Ctx, fullyExpiring)
+
+	proposalID, err := suite.Keeper.SubmitProposal(suite.Ctx, suite.Addresses[2], 1, govv1beta1.NewTextProposal("A Title", "A description of this proposal."))
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.Keeper.AddVote(suite.Ctx, proposalID, suite.Addresses[0], types.VOTE_TYPE_YES))
+
+	// members are kept until their term expires
+	suite.Ctx = suite.Ctx.WithBlockTime(blockTime.Add(time.Hour - time.Second))
+	suite.Keeper.ProcessMemberTerms(suite.Ctx)
+	com, found := suite.Keeper.GetCommittee(suite.Ctx, 1)
+	suite.Require().True(found)
+	suite.Len(com.GetMembers(), 3)
+
+	// expired members are removed along with their votes, and committees without members are kept
+	suite.Ctx = suite.Ctx.WithBlockTime(blockTime.Add(time.Hour))
+	suite.Ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+	suite.Keeper.ProcessMemberTerms(suite.Ctx)
+
+	com, found = suite.Keeper.GetCommittee(suite.Ctx, 1)
+	suite.Require().True(found)
+	suite.Equal([]sdk.AccAddress{suite.Addresses[1], suite.Addresses[2]}, com.GetMembers())
+	suite.Equal([]types.MemberTerm{{Member: suite.Addresses[1], TermExpiry: blockTime.Add(2 * time.Hour)}}, com.GetMemberTerms())
+	suite.Empty(suite.Keeper.GetVotesByProposal(suite.Ctx, proposalID))
+
+	com, found = suite.Keeper.GetCommittee(suite.Ctx, 2)
+	suite.Require().True(found)
+	suite.Empty(com.GetMembers())
+	suite.Empty(com.GetMemberTerms())
+	suite.Contains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeCommitteeEmpty,
+		sdk.NewAttribute(types.AttributeKeyCommitteeID, "2"),
+		sdk.NewAttribute(types.AttributeKeyRemovalReason, types.AttributeValueExpired),
+	))
+	suite.False(suite.Keeper.GetMemberCommitteeProposalResult(suite.Ctx, proposalID, com))
+}
+
+func (suite *keeperTestSuite) TestMsgsProposal_MembershipOfOtherCommittee() {
+	suite.Keeper.SetNextProposalID(suite.Ctx, 1)
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+	com := types.MustNewMemberCommittee(
+		1,
+		"This committee is for testing.",
+		suite.Addresses[:2],
+		[]types.Permission{&types.AllowedMsgsPermission{
+			AllowedMsgs: []types.AllowedMsg{{TypeURL: sdk.MsgTypeURL(&types.MsgAddCommitteeMember{})}},
+		}},
+		testutil.D("0.5"),
+		time.Hour*24*7,
+		types.TALLY_OPTION_FIRST_PAST_THE_POST,
+	)
+	suite.Keeper.SetCommittee(suite.Ctx, com)
+	other := types.MustNewMemberCommittee(
+		2,
+		"This committee is for testing.",
+		suite.Addresses[2:4],
+		[]types.Permission{&types.TextPermission{}},
+		testutil.D("0.5"),
+		time.Hour*24*7,
+		types.TALLY_OPTION_FIRST_PAST_THE_POST,
+	)
+	suite.Keeper.SetCommittee(suite.Ctx, other)
+
+	// committees can only manage their own members
+	otherProposal := types.MustNewMsgsProposal("A Title", "A description of this proposal.", []sdk.Msg{
+		types.NewMsgAddCommitteeMember(govAddr, other.ID, suite.Addresses[4], time.Time{}),
+	})
+	_, err := suite.Keeper.SubmitProposal(suite.Ctx, suite.Addresses[0], com.ID, &otherProposal)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	proposal := types.MustNewMsgsProposal("A Title", "A description of this proposal.", []sdk.Msg{
+		types.NewMsgAddCommitteeMember(govAddr, com.ID, suite.Addresses[4], time.Time{}),
+	})
+	_, err = suite.Keeper.SubmitProposal(suite.Ctx, suite.Addresses[0], com.ID, &proposal)
+	suite.Require().NoError(err)
+}
@@ -3,7 +3,9 @@ package keeper
 import (
 	"context"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/kava-labs/kava/x/committee/types"
 )
@@ -68,3 +70,197 @@ func (m msgServer) Vote(goCtx context.Context, msg *types.MsgVote) (*types.MsgVo
 
 	return &types.MsgVoteResponse{}, nil
 }
+
+// VetoProposal handles MsgVetoProposal messages
+func (m msgServer) VetoProposal(goCtx context.Context, msg *types.MsgVetoProposal) (*types.MsgVetoProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := m.keeper.VetoProposal(ctx, msg.ProposalID); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Authority),
+		),
+	)
+
+	return &types.MsgVetoProposalResponse{}, nil
+}
+
+// AddCommitteeMember handles MsgAddCommitteeMember messages
+func (m msgServer) AddCommitteeMember(goCtx context.Context, msg *types.MsgAddCommitteeMember) (*types.MsgAddCommitteeMemberResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+	member, err := sdk.AccAddressFromBech32(msg.Member)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.keeper.AddMember(ctx, msg.CommitteeID, member, msg.TermExpiry); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Authority),
+		),
+	)
+
+	return &types.MsgAddCommitteeMemberResponse{}, nil
+}
+
+// RemoveCommitteeMember handles MsgRemoveCommitteeMember messages
+func (m msgServer) RemoveCommitteeMember(goCtx context.Context, msg *types.MsgRemoveCommitteeMember) (*types.MsgRemoveCommitteeMemberResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+	member, err := sdk.AccAddressFromBech32(msg.Member)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.keeper.RemoveMember(ctx, msg.CommitteeID, member, types.AttributeValueRemoved); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Authority),
+		),
+	)
+
+	return &types.MsgRemoveCommitteeMemberResponse{}, nil
+}
+
+// RotateCommitteeMember handles MsgRotateCommitteeMember messages
+func (m msgServer) RotateCommitteeMember(goCtx context.Context, msg *types.MsgRotateCommitteeMember) (*types.MsgRotateCommitteeMemberResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	member, err := sdk.AccAddressFromBech32(msg.Member)
+	if err != nil {
+		return nil, err
+	}
+	newMember, err := sdk.AccAddressFromBech32(msg.NewMember)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.keeper.RotateMember(ctx, msg.CommitteeID, member, newMember); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Member),
+		),
+	)
+
+	return &types.MsgRotateCommitteeMemberResponse{}, nil
+}
+
+// ResignCommitteeMember handles MsgResignCommitteeMember messages
+func (m msgServer) ResignCommitteeMember(goCtx context.Context, msg *types.MsgResignCommitteeMember) (*types.MsgResignCommitteeMemberResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	member, err := sdk.AccAddressFromBech32(msg.Member)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.keeper.RemoveMember(ctx, msg.CommitteeID, member, types.AttributeValueResigned); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Member),
+		),
+	)
+
+	return &types.MsgResignCommitteeMemberResponse{}, nil
+}
+
+// DelegateVotingPower handles MsgDelegateVotingPower messages
+func (m msgServer) DelegateVotingPower(goCtx context.Context, msg *types.MsgDelegateVotingPower) (*types.MsgDelegateVotingPowerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delegator, err := sdk.AccAddressFromBech32(msg.Delegator)
+	if err != nil {
+		return nil, err
+	}
+	representative, err := sdk.AccAddressFromBech32(msg.Representative)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.keeper.DelegateVotingPower(ctx, delegator, representative); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Delegator),
+		),
+	)
+
+	return &types.MsgDelegateVotingPowerResponse{}, nil
+}
+
+// UndelegateVotingPower handles MsgUndelegateVotingPower messages
+func (m msgServer) UndelegateVotingPower(goCtx context.Context, msg *types.MsgUndelegateVotingPower) (*types.MsgUndelegateVotingPowerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delegator, err := sdk.AccAddressFromBech32(msg.Delegator)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.keeper.UndelegateVotingPower(ctx, delegator); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Delegator),
+		),
+	)
+
+	return &types.MsgUndelegateVotingPowerResponse{}, nil
+}
+
+// validateAuthority checks a message is signed by the module authority. Committees execute these
+// messages with a MsgsProposal signed by the authority, limited to their own committee.
+func (m msgServer) validateAuthority(authority string) error {
+	addr, err := sdk.AccAddressFromBech32(authority)
+	if err != nil {
+		return err
+	}
+	if !addr.Equals(m.keeper.GetAuthority()) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "expected %s, got %s", m.keeper.GetAuthority(), authority)
+	}
+	return nil
+}
@@ -9,6 +9,10 @@ import (
 	sdkmath "cosmossdk.io/math"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	proposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
@@ -190,6 +194,69 @@ func (suite *MsgServerTestSuite) TestSubmitProposalMsgAndVote() {
 	suite.Require().NoError(err)
 }
 
+func (suite *MsgServerTestSuite) TestCommitteeMembershipMsgs() {
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+	termExpiry := suite.ctx.BlockTime().Add(time.Hour)
+
+	// membership can only be changed by the authority
+	for _, authority := range []sdk.AccAddress{suite.addresses[0], authtypes.NewModuleAddress(types.ModuleName)} {
+		_, err := suite.msgServer.AddCommitteeMember(ctx, types.NewMsgAddCommitteeMember(authority, 1, suite.addresses[3], termExpiry))
+		suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+		_, err = suite.msgServer.RemoveCommitteeMember(ctx, types.NewMsgRemoveCommitteeMember(authority, 1, suite.addresses[1]))
+		suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	}
+
+	_, err := suite.msgServer.AddCommitteeMember(ctx, types.NewMsgAddCommitteeMember(govAddr, 1, suite.addresses[3], suite.ctx.BlockTime()))
+	suite.Require().ErrorIs(err, types.ErrInvalidCommittee)
+	_, err = suite.msgServer.AddCommitteeMember(ctx, types.NewMsgAddCommitteeMember(govAddr, 2, suite.addresses[3], termExpiry))
+	suite.Require().ErrorIs(err, types.ErrUnknownCommittee)
+
+	_, err = suite.msgServer.AddCommitteeMember(ctx, types.NewMsgAddCommitteeMember(govAddr, 1, suite.addresses[3], termExpiry))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.RemoveCommitteeMember(ctx, types.NewMsgRemoveCommitteeMember(govAddr, 1, suite.addresses[1]))
+	suite.Require().NoError(err)
+
+	com, found := suite.keeper.GetCommittee(suite.ctx, 1)
+	suite.Require().True(found)
+	suite.Equal([]sdk.AccAddress{suite.addresses[0], suite.addresses[2], suite.addresses[3]}, com.GetMembers())
+	suite.Equal([]types.MemberTerm{{Member: suite.addresses[3], TermExpiry: termExpiry}}, com.GetMemberTerms())
+
+	// members can rotate their address, keeping their term and votes
+	proposalMsg, err := types.NewMsgSubmitProposal(govv1beta1.NewTextProposal("A Title", "A description of this proposal."), suite.addresses[3], 1)
+	suite.Require().NoError(err)
+	res, err := suite.msgServer.SubmitProposal(ctx, proposalMsg)
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Vote(ctx, types.NewMsgVote(suite.addresses[3], res.ProposalID, types.VOTE_TYPE_YES))
+	suite.Require().NoError(err)
+
+	_, err = suite.msgServer.RotateCommitteeMember(ctx, types.NewMsgRotateCommitteeMember(suite.addresses[3], 1, suite.addresses[0]))
+	suite.Require().ErrorIs(err, types.ErrMemberExists)
+	_, err = suite.msgServer.RotateCommitteeMember(ctx, types.NewMsgRotateCommitteeMember(suite.addresses[1], 1, suite.addresses[4]))
+	suite.Require().ErrorIs(err, types.ErrUnknownMember)
+	_, err = suite.msgServer.RotateCommitteeMember(ctx, types.NewMsgRotateCommitteeMember(suite.addresses[3], 1, suite.addresses[4]))
+	suite.Require().NoError(err)
+
+	com, _ = suite.keeper.GetCommittee(suite.ctx, 1)
+	suite.Equal([]sdk.AccAddress{suite.addresses[0], suite.addresses[2], suite.addresses[4]}, com.GetMembers())
+	suite.Equal([]types.MemberTerm{{Member: suite.addresses[4], TermExpiry: termExpiry}}, com.GetMemberTerms())
+	_, found = suite.keeper.GetVote(suite.ctx, res.ProposalID, suite.addresses[3])
+	suite.False(found)
+	_, found = suite.keeper.GetVote(suite.ctx, res.ProposalID, suite.addresses[4])
+	suite.True(found)
+
+	// members can resign, removing their votes, but the last member cannot leave
+	_, err = suite.msgServer.ResignCommitteeMember(ctx, types.NewMsgResignCommitteeMember(suite.addresses[4], 1))
+	suite.Require().NoError(err)
+	suite.Empty(suite.keeper.GetVotesByProposal(suite.ctx, res.ProposalID))
+	_, err = suite.msgServer.ResignCommitteeMember(ctx, types.NewMsgResignCommitteeMember(suite.addresses[4], 1))
+	suite.Require().ErrorIs(err, types.ErrUnknownMember)
+	_, err = suite.msgServer.ResignCommitteeMember(ctx, types.NewMsgResignCommitteeMember(suite.addresses[0], 1))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.ResignCommitteeMember(ctx, types.NewMsgResignCommitteeMember(suite.addresses[2], 1))
+	suite.Require().ErrorIs(err, types.ErrInvalidCommittee)
+}
+
 func TestMsgServerTestSuite(t *testing.T) {
 	suite.Run(t, new(MsgServerTestSuite))
 }
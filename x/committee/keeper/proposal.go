@@ -4,8 +4,10 @@ import (
 	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 
 	"github.com/kava-labs/kava/x/committee/types"
 )
@@ -25,6 +27,9 @@ func (k Keeper) SubmitProposal(ctx sdk.Context, proposer sdk.AccAddress, committ
 	if !com.HasPermissionsFor(ctx, k.cdc, k.paramKeeper, pubProposal) {
 		return 0, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "committee does not have permissions to enact proposal")
 	}
+	if err := k.validateMsgsProposalCommittee(ctx, pubProposal, committeeID); err != nil {
+		return 0, err
+	}
 
 	// Check proposal is valid
 	if err := k.ValidatePubProposal(ctx, pubProposal); err != nil {
@@ -37,6 +42,7 @@ func (k Keeper) SubmitProposal(ctx sdk.Context, proposer sdk.AccAddress, committ
 	if err != nil {
 		return 0, err
 	}
+	k.AfterProposalSubmission(ctx, proposalID)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -56,6 +62,9 @@ func (k Keeper) AddVote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress
 	if !found {
 		return errorsmod.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
 	}
+	if _, queued := k.GetQueuedProposal(ctx, proposalID); queued {
+		return errorsmod.Wrapf(types.ErrProposalQueued, "%d", proposalID)
+	}
 	if pr.HasExpiredBy(ctx.BlockTime()) {
 		return errorsmod.Wrapf(types.ErrProposalExpired, "%s ≥ %s", ctx.BlockTime(), pr.Deadline)
 	}
@@ -75,6 +84,7 @@ func (k Keeper) AddVote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress
 
 	// Store vote, overwriting any prior vote
 	k.SetVote(ctx, types.NewVote(proposalID, voter, voteType))
+	k.AfterProposalVote(ctx, proposalID, voter)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -97,13 +107,13 @@ func (k Keeper) ValidatePubProposal(ctx sdk.Context, pubProposal types.PubPropos
 		return err
 	}
 
-	if !k.router.HasRoute(pubProposal.ProposalRoute()) {
+	handler, found := k.getProposalHandler(pubProposal)
+	if !found {
 		return errorsmod.Wrapf(types.ErrNoProposalHandlerExists, "%T", pubProposal)
 	}
 
 	// Run the proposal's changes through the associated handler using a cached version of state to ensure changes are not permanent.
 	cacheCtx, _ := ctx.CacheContext()
-	handler := k.router.GetRoute(pubProposal.ProposalRoute())
 
 	// Handle an edge case where a param change proposal causes the proposal handler to panic.
 	// A param change proposal with a registered subspace value but unregistered key value will cause a panic in the param change proposal handler.
@@ -123,6 +133,11 @@ func (k Keeper) ValidatePubProposal(ctx sdk.Context, pubProposal types.PubPropos
 
 func (k Keeper) ProcessProposals(ctx sdk.Context) {
 	k.IterateProposals(ctx, func(proposal types.Proposal) bool {
+		// queued proposals have passed and are waiting for their timelock to expire
+		if _, queued := k.GetQueuedProposal(ctx, proposal.ID); queued {
+			return false
+		}
+
 		committee, found := k.GetCommittee(ctx, proposal.CommitteeID)
 		if !found {
 			k.CloseProposal(ctx, proposal, types.Failed)
@@ -133,22 +148,74 @@ func (k Keeper) ProcessProposals(ctx sdk.Context) {
 			if committee.GetTallyOption() == types.TALLY_OPTION_FIRST_PAST_THE_POST {
 				passed := k.GetProposalResult(ctx, proposal.ID, committee)
 				if passed {
-					outcome := k.attemptEnactProposal(ctx, proposal)
-					k.CloseProposal(ctx, proposal, outcome)
+					k.passProposal(ctx, proposal, committee)
 				}
 			}
 		} else {
 			passed := k.GetProposalResult(ctx, proposal.ID, committee)
-			outcome := types.Failed
 			if passed {
-				outcome = k.attemptEnactProposal(ctx, proposal)
+				k.passProposal(ctx, proposal, committee)
+			} else {
+				k.CloseProposal(ctx, proposal, types.Failed)
 			}
-			k.CloseProposal(ctx, proposal, outcome)
 		}
 		return false
 	})
 }
 
+// passProposal enacts and closes a passed proposal, or queues it for execution if the committee has a timelock.
+func (k Keeper) passProposal(ctx sdk.Context, proposal types.Proposal, committee types.Committee) {
+	if committee.GetTimelock() <= 0 {
+		outcome := k.attemptEnactProposal(ctx, proposal)
+		k.CloseProposal(ctx, proposal, outcome)
+		return
+	}
+
+	executionTime := ctx.BlockTime().Add(committee.GetTimelock())
+	k.SetQueuedProposal(ctx, types.NewQueuedProposal(proposal.ID, executionTime))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeProposalQueue,
+			sdk.NewAttribute(types.AttributeKeyCommitteeID, fmt.Sprintf("%d", proposal.CommitteeID)),
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ID)),
+			sdk.NewAttribute(types.AttributeKeyExecutionTime, executionTime.String()),
+		),
+	)
+}
+
+// ProcessQueuedProposals enacts and closes queued proposals whose timelock has expired.
+func (k Keeper) ProcessQueuedProposals(ctx sdk.Context) {
+	k.IterateQueuedProposals(ctx, func(queuedProposal types.QueuedProposal) bool {
+		if ctx.BlockTime().Before(queuedProposal.ExecutionTime) {
+			return false
+		}
+
+		proposal, found := k.GetProposal(ctx, queuedProposal.ProposalID)
+		if !found {
+			k.DeleteQueuedProposal(ctx, queuedProposal.ProposalID)
+			return false
+		}
+		outcome := k.attemptEnactProposal(ctx, proposal)
+		k.CloseProposal(ctx, proposal, outcome)
+		return false
+	})
+}
+
+// VetoProposal cancels a queued proposal before it is executed.
+func (k Keeper) VetoProposal(ctx sdk.Context, proposalID uint64) error {
+	proposal, found := k.GetProposal(ctx, proposalID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
+	}
+	if _, queued := k.GetQueuedProposal(ctx, proposalID); !queued {
+		return errorsmod.Wrapf(types.ErrProposalNotQueued, "%d", proposalID)
+	}
+
+	k.CloseProposal(ctx, proposal, types.Vetoed)
+	return nil
+}
+
 func (k Keeper) GetProposalResult(ctx sdk.Context, proposalID uint64, committee types.Committee) bool {
 	switch com := committee.(type) {
 	case *types.MemberCommittee:
@@ -164,6 +231,10 @@ func (k Keeper) GetProposalResult(ctx sdk.Context, proposalID uint64, committee
 func (k Keeper) GetMemberCommitteeProposalResult(ctx sdk.Context, proposalID uint64, committee types.Committee) bool {
 	currVotes := k.TallyMemberCommitteeVotes(ctx, proposalID)
 	possibleVotes := sdk.NewDec(int64(len(committee.GetMembers())))
+	// committees whose members have all expired cannot pass proposals
+	if possibleVotes.IsZero() {
+		return false
+	}
 	return currVotes.GTE(committee.GetVoteThreshold().Mul(possibleVotes)) // vote threshold requirements
 }
 
@@ -186,32 +257,79 @@ func (k Keeper) GetTokenCommitteeProposalResult(ctx sdk.Context, proposalID uint
 }
 
 // TallyTokenCommitteeVotes returns the polling status of a token committee vote. Returns yes votes,
-// total current votes, total possible votes (equal to token supply), vote threshold (yes vote ratio
-// required for proposal to pass), and quorum (votes tallied at this percentage).
+// total current votes, total possible votes (equal to the total voting power), vote threshold (yes vote
+// ratio required for proposal to pass), and quorum (votes tallied at this percentage).
 func (k Keeper) TallyTokenCommitteeVotes(ctx sdk.Context, proposalID uint64,
 	tallyDenom string,
 ) (yesVotes, noVotes, totalVotes, possibleVotes sdk.Dec) {
+	yesVotes, noVotes, totalVotes = k.TallyTokenCommitteeVotesBySource(ctx, proposalID, tallyDenom).Totals()
+
+	return yesVotes, noVotes, totalVotes, sdk.NewDecFromInt(k.GetTotalVotingPower(ctx, tallyDenom))
+}
+
+// GetTotalVotingPower returns the total token committee voting power of a tally denom, counted the same
+// way as the voting power of voters. It is the token supply if no other sources count voting power.
+func (k Keeper) GetTotalVotingPower(ctx sdk.Context, tallyDenom string) sdkmath.Int {
+	if k.votingPowerSource != nil {
+		if total, found := k.votingPowerSource.GetTotalVotingPower(ctx, tallyDenom); found {
+			return total
+		}
+	}
+	return k.bankKeeper.GetSupply(ctx, tallyDenom).Amount
+}
+
+// TallyTokenCommitteeVotesBySource returns the votes of a token committee proposal by the source of the voting power.
+// Voters that delegated their voting power and did not vote are counted towards the vote of their representative.
+func (k Keeper) TallyTokenCommitteeVotesBySource(ctx sdk.Context, proposalID uint64, tallyDenom string) types.TallySources {
 	votes := k.GetVotesByProposal(ctx, proposalID)
 
-	yesVotes = sdk.ZeroDec()
-	noVotes = sdk.ZeroDec()
-	totalVotes = sdk.ZeroDec()
+	sources := types.TallySources{}
+	voted := make(map[string]bool, len(votes))
 	for _, vote := range votes {
-		// 1 token = 1 vote
-		acc := k.accountKeeper.GetAccount(ctx, vote.Voter)
-		accNumCoins := k.bankKeeper.GetBalance(ctx, acc.GetAddress(), tallyDenom).Amount
-
-		// Add votes to counters
-		totalVotes = totalVotes.Add(sdk.NewDecFromInt(accNumCoins))
-		if vote.VoteType == types.VOTE_TYPE_YES {
-			yesVotes = yesVotes.Add(sdk.NewDecFromInt(accNumCoins))
-		} else if vote.VoteType == types.VOTE_TYPE_NO {
-			noVotes = noVotes.Add(sdk.NewDecFromInt(accNumCoins))
+		voted[vote.Voter.String()] = true
+		for _, power := range k.GetVotingPower(ctx, vote.Voter, tallyDenom) {
+			sources = sources.AddVote(power.Source, vote.VoteType, sdk.NewDecFromInt(power.Amount))
 		}
 	}
 
-	possibleVotesInt := k.bankKeeper.GetSupply(ctx, tallyDenom).Amount
-	return yesVotes, noVotes, totalVotes, sdk.NewDecFromInt(possibleVotesInt)
+	for _, vote := range votes {
+		k.IterateDelegatorsOfRepresentative(ctx, vote.Voter, func(delegator sdk.AccAddress) bool {
+			if voted[delegator.String()] {
+				return false
+			}
+
+			delegated := sdk.ZeroInt()
+			for _, power := range k.GetVotingPower(ctx, delegator, tallyDenom) {
+				delegated = delegated.Add(power.Amount)
+			}
+			if delegated.IsPositive() {
+				sources = sources.AddVote(types.VotingPowerSourceDelegated, vote.VoteType, sdk.NewDecFromInt(delegated))
+			}
+			return false
+		})
+	}
+
+	return sources
+}
+
+// GetVotingPower returns the token committee voting power of an address by source. 1 token = 1 vote.
+func (k Keeper) GetVotingPower(ctx sdk.Context, addr sdk.AccAddress, tallyDenom string) []types.VotingPower {
+	var powers []types.VotingPower
+	// proposals are tallied by the hooks on every vote, so voters without an account must not panic
+	if acc := k.accountKeeper.GetAccount(ctx, addr); acc != nil {
+		if balance := k.bankKeeper.GetBalance(ctx, acc.GetAddress(), tallyDenom).Amount; balance.IsPositive() {
+			powers = append(powers, types.VotingPower{Source: types.VotingPowerSourceBalance, Amount: balance})
+		}
+	}
+	if k.votingPowerSource == nil {
+		return powers
+	}
+	for _, power := range k.votingPowerSource.GetVotingPower(ctx, addr, tallyDenom) {
+		if power.Amount.IsPositive() {
+			powers = append(powers, power)
+		}
+	}
+	return powers
 }
 
 func (k Keeper) attemptEnactProposal(ctx sdk.Context, proposal types.Proposal) types.ProposalOutcome {
@@ -233,13 +351,16 @@ func (k Keeper) enactProposal(ctx sdk.Context, proposal types.Proposal) error {
 	if !com.HasPermissionsFor(ctx, k.cdc, k.paramKeeper, proposal.GetContent()) {
 		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "committee does not have permissions to enact proposal")
 	}
+	if err := k.validateMsgsProposalCommittee(ctx, proposal.GetContent(), proposal.CommitteeID); err != nil {
+		return err
+	}
 
 	if err := k.ValidatePubProposal(ctx, proposal.GetContent()); err != nil {
 		return err
 	}
 
 	// enact the proposal
-	handler := k.router.GetRoute(proposal.GetContent().ProposalRoute())
+	handler, _ := k.getProposalHandler(proposal.GetContent())
 	if err := handler(ctx, proposal.GetContent()); err != nil {
 		// the handler should not error as it was checked in ValidatePubProposal
 		panic(fmt.Sprintf("unexpected handler error: %s", err))
@@ -247,6 +368,98 @@ func (k Keeper) enactProposal(ctx sdk.Context, proposal types.Proposal) error {
 	return nil
 }
 
+// getProposalHandler returns the handler that enacts a pubproposal. MsgsProposals are
+// enacted by executing their messages, other proposals are routed by the gov router.
+func (k Keeper) getProposalHandler(pubProposal types.PubProposal) (govv1beta1.Handler, bool) {
+	if _, ok := pubProposal.(*types.MsgsProposal); ok {
+		return k.handleMsgsProposal, true
+	}
+	if !k.router.HasRoute(pubProposal.ProposalRoute()) {
+		return nil, false
+	}
+	return k.router.GetRoute(pubProposal.ProposalRoute()), true
+}
+
+// handleMsgsProposal executes the messages of a MsgsProposal with the msg service router.
+// The messages are signed by the committee module account or the x/gov module account, which
+// is checked in ValidateBasic, and are limited to those allowed by the committee's permissions.
+func (k Keeper) handleMsgsProposal(ctx sdk.Context, content govv1beta1.Content) error {
+	proposal, ok := content.(*types.MsgsProposal)
+	if !ok {
+		return errorsmod.Wrapf(types.ErrInvalidPubProposal, "unrecognized committee proposal content type: %T", content)
+	}
+	msgs, err := proposal.GetMsgs()
+	if err != nil {
+		return errorsmod.Wrap(types.ErrInvalidPubProposal, err.Error())
+	}
+
+	for i, msg := range msgs {
+		handler := k.msgRouter.Handler(msg)
+		if handler == nil {
+			return errorsmod.Wrapf(types.ErrNoProposalHandlerExists, "msg %d: %s", i, sdk.MsgTypeURL(msg))
+		}
+		res, err := handler(ctx, msg)
+		if err != nil {
+			return errorsmod.Wrapf(err, "msg %d: %s", i, sdk.MsgTypeURL(msg))
+		}
+		for _, event := range res.GetEvents() {
+			ctx.EventManager().EmitEvent(sdk.Event(event))
+		}
+	}
+	return nil
+}
+
+// validateMsgsProposalCommittee checks the messages of a MsgsProposal that act on a committee
+// only act on the committee of the proposal, or veto the proposals of a committee it is a
+// guardian of. These messages are signed by the x/gov module account, so without this check a
+// committee could act on any other committee.
+func (k Keeper) validateMsgsProposalCommittee(ctx sdk.Context, pubProposal types.PubProposal, committeeID uint64) error {
+	proposal, ok := pubProposal.(*types.MsgsProposal)
+	if !ok {
+		return nil
+	}
+	msgs, err := proposal.GetMsgs()
+	if err != nil {
+		return errorsmod.Wrap(types.ErrInvalidPubProposal, err.Error())
+	}
+
+	for i, msg := range msgs {
+		targetID, found := k.getMsgCommitteeID(ctx, msg)
+		if !found || targetID == committeeID {
+			continue
+		}
+		if _, ok := msg.(*types.MsgVetoProposal); ok && k.isGuardianCommittee(ctx, targetID, committeeID) {
+			continue
+		}
+		return errorsmod.Wrapf(
+			sdkerrors.ErrUnauthorized,
+			"msg %d: committee %d cannot act on committee %d", i, committeeID, targetID,
+		)
+	}
+	return nil
+}
+
+// isGuardianCommittee returns true if a committee can veto the queued proposals of another committee.
+func (k Keeper) isGuardianCommittee(ctx sdk.Context, committeeID, guardianID uint64) bool {
+	com, found := k.GetCommittee(ctx, committeeID)
+	return found && com.HasGuardianCommittee(guardianID)
+}
+
+// getMsgCommitteeID returns the ID of the committee a committee message acts on.
+func (k Keeper) getMsgCommitteeID(ctx sdk.Context, msg sdk.Msg) (uint64, bool) {
+	switch msg := msg.(type) {
+	case *types.MsgAddCommitteeMember:
+		return msg.CommitteeID, true
+	case *types.MsgRemoveCommitteeMember:
+		return msg.CommitteeID, true
+	case *types.MsgVetoProposal:
+		proposal, found := k.GetProposal(ctx, msg.ProposalID)
+		return proposal.CommitteeID, found
+	default:
+		return 0, false
+	}
+}
+
 // GetProposalTallyResponse returns the tally results of a proposal.
 func (k Keeper) GetProposalTallyResponse(ctx sdk.Context, proposalID uint64) (*types.QueryTallyResponse, bool) {
 	proposal, found := k.GetProposal(ctx, proposalID)
@@ -272,7 +485,9 @@ func (k Keeper) GetProposalTallyResponse(ctx sdk.Context, proposalID uint64) (*t
 			Quorum:        sdk.ZeroDec(),
 		}
 	case *types.TokenCommittee:
-		yesVotes, noVotes, currVotes, possibleVotes := k.TallyTokenCommitteeVotes(ctx, proposal.ID, com.TallyDenom)
+		sources := k.TallyTokenCommitteeVotesBySource(ctx, proposal.ID, com.TallyDenom)
+		yesVotes, noVotes, currVotes := sources.Totals()
+		possibleVotes := sdk.NewDecFromInt(k.GetTotalVotingPower(ctx, com.TallyDenom))
 		proposalTally = types.QueryTallyResponse{
 			ProposalID:    proposal.ID,
 			YesVotes:      yesVotes,
@@ -281,6 +496,7 @@ func (k Keeper) GetProposalTallyResponse(ctx sdk.Context, proposalID uint64) (*t
 			PossibleVotes: possibleVotes,
 			VoteThreshold: com.VoteThreshold,
 			Quorum:        com.Quorum,
+			Sources:       sources,
 		}
 	}
 	return &proposalTally, true
@@ -290,6 +506,7 @@ func (k Keeper) GetProposalTallyResponse(ctx sdk.Context, proposalID uint64) (*t
 func (k Keeper) CloseProposal(ctx sdk.Context, proposal types.Proposal, outcome types.ProposalOutcome) {
 	tally, _ := k.GetProposalTallyResponse(ctx, proposal.ID)
 	k.DeleteProposalAndVotes(ctx, proposal.ID)
+	k.AfterProposalClosed(ctx, proposal, outcome, tally)
 
 	bz, err := k.cdc.MarshalJSON(tally)
 	if err != nil {
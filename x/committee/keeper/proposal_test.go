@@ -5,12 +5,16 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
 	"github.com/kava-labs/kava/app"
+	committeekeeper "github.com/kava-labs/kava/x/committee/keeper"
 	// bep3types "github.com/kava-labs/kava/x/bep3/types"
 	// cdptypes "github.com/kava-labs/kava/x/cdp/types"
 
@@ -837,6 +841,214 @@ func (suite *keeperTestSuite) TestCloseProposal() {
 	suite.False(found)
 }
 
+func (suite *keeperTestSuite) TestMsgsProposal() {
+	committeeAddr := authtypes.NewModuleAddress(types.ModuleName)
+	recipient := suite.Addresses[9]
+	amount := sdk.NewCoins(sdk.NewInt64Coin("ukava", 1e6))
+
+	memberCom := types.MustNewMemberCommittee(
+		12,
+		"This committee is for testing.",
+		suite.Addresses[:2],
+		[]types.Permission{&types.AllowedMsgsPermission{
+			AllowedMsgs: []types.AllowedMsg{
+				{TypeURL: sdk.MsgTypeURL(&banktypes.MsgSend{})},
+				{TypeURL: sdk.MsgTypeURL(&banktypes.MsgSetSendEnabled{})},
+			},
+		}},
+		testutil.D("0.5"),
+		time.Hour*24*7,
+		types.TALLY_OPTION_FIRST_PAST_THE_POST,
+	)
+
+	tApp := app.NewTestApp()
+	keeper := tApp.GetCommitteeKeeper()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: time.Date(1998, time.January, 1, 1, 0, 0, 0, time.UTC)})
+	tApp.InitializeFromGenesisStates(
+		committeeGenState(tApp.AppCodec(), []types.Committee{memberCom}, []types.Proposal{}, []types.Vote{}),
+	)
+	suite.Require().NoError(tApp.FundAccount(ctx, committeeAddr, amount))
+
+	// messages must be signed by the committee module account
+	invalidProposal := types.MustNewMsgsProposal("A Title", "A description of this proposal.", []sdk.Msg{
+		banktypes.NewMsgSend(suite.Addresses[0], recipient, amount),
+	})
+	_, err := keeper.SubmitProposal(ctx, suite.Addresses[0], memberCom.ID, &invalidProposal)
+	suite.Require().ErrorIs(err, types.ErrInvalidPubProposal)
+
+	// messages must be allowed by the committee permissions
+	unpermittedProposal := types.MustNewMsgsProposal("A Title", "A description of this proposal.", []sdk.Msg{
+		banktypes.NewMsgMultiSend(
+			[]banktypes.Input{banktypes.NewInput(committeeAddr, amount)},
+			[]banktypes.Output{banktypes.NewOutput(recipient, amount)},
+		),
+	})
+	_, err = keeper.SubmitProposal(ctx, suite.Addresses[0], memberCom.ID, &unpermittedProposal)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// messages that fail to execute are rejected on submission
+	overspendProposal := types.MustNewMsgsProposal("A Title", "A description of this proposal.", []sdk.Msg{
+		banktypes.NewMsgSend(committeeAddr, recipient, amount.Add(amount...)),
+	})
+	_, err = keeper.SubmitProposal(ctx, suite.Addresses[0], memberCom.ID, &overspendProposal)
+	suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	proposal := types.MustNewMsgsProposal("A Title", "A description of this proposal.", []sdk.Msg{
+		banktypes.NewMsgSend(committeeAddr, recipient, amount),
+	})
+	proposalID, err := keeper.SubmitProposal(ctx, suite.Addresses[0], memberCom.ID, &proposal)
+	suite.Require().NoError(err)
+	suite.Require().NoError(keeper.AddVote(ctx, proposalID, suite.Addresses[0], types.VOTE_TYPE_YES))
+
+	keeper.ProcessProposals(ctx)
+
+	_, found := keeper.GetProposal(ctx, proposalID)
+	suite.False(found)
+	suite.Equal(amount, tApp.GetBankKeeper().GetAllBalances(ctx, recipient))
+	suite.True(tApp.GetBankKeeper().GetAllBalances(ctx, committeeAddr).IsZero())
+
+	// messages that require a module's authority are signed by the x/gov module account
+	authorityProposal := types.MustNewMsgsProposal("A Title", "A description of this proposal.", []sdk.Msg{
+		banktypes.NewMsgSetSendEnabled(
+			authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+			[]*banktypes.SendEnabled{banktypes.NewSendEnabled("ukava", false)},
+			nil,
+		),
+	})
+	proposalID, err = keeper.SubmitProposal(ctx, suite.Addresses[0], memberCom.ID, &authorityProposal)
+	suite.Require().NoError(err)
+	suite.Require().NoError(keeper.AddVote(ctx, proposalID, suite.Addresses[0], types.VOTE_TYPE_YES))
+
+	keeper.ProcessProposals(ctx)
+
+	_, found = keeper.GetProposal(ctx, proposalID)
+	suite.False(found)
+	suite.False(tApp.GetBankKeeper().IsSendEnabledDenom(ctx, "ukava"))
+}
+
+func (suite *keeperTestSuite) TestTimelockProposal() {
+	committeeAddr := authtypes.NewModuleAddress(types.ModuleName)
+	recipient := suite.Addresses[9]
+	amount := sdk.NewCoins(sdk.NewInt64Coin("ukava", 1e6))
+	timelock := time.Hour * 24
+
+	memberCom := types.MustNewMemberCommittee(
+		12,
+		"This committee is for testing.",
+		suite.Addresses[:2],
+		[]types.Permission{&types.AllowedMsgsPermission{
+			AllowedMsgs: []types.AllowedMsg{{TypeURL: sdk.MsgTypeURL(&banktypes.MsgSend{})}},
+		}},
+		testutil.D("0.5"),
+		time.Hour*24*7,
+		types.TALLY_OPTION_FIRST_PAST_THE_POST,
+	)
+	memberCom.SetTimelock(timelock)
+
+	tApp := app.NewTestApp()
+	keeper := tApp.GetCommitteeKeeper()
+	msgServer := committeekeeper.NewMsgServerImpl(keeper)
+	blockTime := time.Date(1998, time.January, 1, 1, 0, 0, 0, time.UTC)
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: blockTime})
+	tApp.InitializeFromGenesisStates(
+		committeeGenState(tApp.AppCodec(), []types.Committee{memberCom}, []types.Proposal{}, []types.Vote{}),
+	)
+	suite.Require().NoError(tApp.FundAccount(ctx, committeeAddr, amount))
+
+	submitPassedProposal := func() uint64 {
+		proposal := types.MustNewMsgsProposal("A Title", "A description of this proposal.", []sdk.Msg{
+			banktypes.NewMsgSend(committeeAddr, recipient, amount),
+		})
+		proposalID, err := keeper.SubmitProposal(ctx, suite.Addresses[0], memberCom.ID, &proposal)
+		suite.Require().NoError(err)
+		suite.Require().NoError(keeper.AddVote(ctx, proposalID, suite.Addresses[0], types.VOTE_TYPE_YES))
+		return proposalID
+	}
+
+	// passed proposals are queued until the timelock expires
+	proposalID := submitPassedProposal()
+	_, err := msgServer.VetoProposal(ctx, types.NewMsgVetoProposal(authtypes.NewModuleAddress(govtypes.ModuleName), proposalID))
+	suite.Require().ErrorIs(err, types.ErrProposalNotQueued)
+
+	keeper.ProcessProposals(ctx)
+	suite.Equal(
+		types.QueuedProposals{types.NewQueuedProposal(proposalID, blockTime.Add(timelock))},
+		keeper.GetQueuedProposals(ctx),
+	)
+	_, found := keeper.GetProposal(ctx, proposalID)
+	suite.True(found)
+	suite.True(tApp.GetBankKeeper().GetAllBalances(ctx, recipient).IsZero())
+
+	err = keeper.AddVote(ctx, proposalID, suite.Addresses[1], types.VOTE_TYPE_YES)
+	suite.Require().ErrorIs(err, types.ErrProposalQueued)
+
+	ctx = ctx.WithBlockTime(blockTime.Add(timelock - time.Second))
+	keeper.ProcessQueuedProposals(ctx)
+	keeper.ProcessProposals(ctx)
+	suite.Len(keeper.GetQueuedProposals(ctx), 1)
+
+	// queued proposals are executed once the timelock expires
+	ctx = ctx.WithBlockTime(blockTime.Add(timelock))
+	keeper.ProcessQueuedProposals(ctx)
+	suite.Empty(keeper.GetQueuedProposals(ctx))
+	_, found = keeper.GetProposal(ctx, proposalID)
+	suite.False(found)
+	suite.Equal(amount, tApp.GetBankKeeper().GetAllBalances(ctx, recipient))
+
+	// queued proposals can be vetoed by the authority
+	suite.Require().NoError(tApp.FundAccount(ctx, committeeAddr, amount))
+	proposalID = submitPassedProposal()
+	keeper.ProcessProposals(ctx)
+
+	for _, authority := range []sdk.AccAddress{suite.Addresses[0], committeeAddr} {
+		_, err := msgServer.VetoProposal(ctx, types.NewMsgVetoProposal(authority, proposalID))
+		suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	}
+
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+	_, err = msgServer.VetoProposal(ctx, types.NewMsgVetoProposal(govAddr, proposalID))
+	suite.Require().NoError(err)
+	suite.Empty(keeper.GetQueuedProposals(ctx))
+	_, found = keeper.GetProposal(ctx, proposalID)
+	suite.False(found)
+	suite.Equal(amount, tApp.GetBankKeeper().GetAllBalances(ctx, committeeAddr))
+
+	// committees can only veto their own proposals, or those of committees they are a guardian of
+	proposalID = submitPassedProposal()
+	keeper.ProcessProposals(ctx)
+
+	vetoCom := types.MustNewMemberCommittee(
+		13,
+		"This committee is for testing.",
+		suite.Addresses[:2],
+		[]types.Permission{&types.AllowedMsgsPermission{
+			AllowedMsgs: []types.AllowedMsg{{TypeURL: sdk.MsgTypeURL(&types.MsgVetoProposal{})}},
+		}},
+		testutil.D("0.5"),
+		time.Hour*24*7,
+		types.TALLY_OPTION_FIRST_PAST_THE_POST,
+	)
+	keeper.SetCommittee(ctx, vetoCom)
+	vetoProposal := types.MustNewMsgsProposal("A Title", "A description of this proposal.", []sdk.Msg{
+		types.NewMsgVetoProposal(govAddr, proposalID),
+	})
+	_, err = keeper.SubmitProposal(ctx, suite.Addresses[0], vetoCom.ID, &vetoProposal)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	suite.Len(keeper.GetQueuedProposals(ctx), 1)
+
+	memberCom.SetGuardianCommitteeIDs([]uint64{vetoCom.ID})
+	keeper.SetCommittee(ctx, memberCom)
+	vetoProposalID, err := keeper.SubmitProposal(ctx, suite.Addresses[0], vetoCom.ID, &vetoProposal)
+	suite.Require().NoError(err)
+	suite.Require().NoError(keeper.AddVote(ctx, vetoProposalID, suite.Addresses[0], types.VOTE_TYPE_YES))
+	keeper.ProcessProposals(ctx)
+
+	suite.Empty(keeper.GetQueuedProposals(ctx))
+	_, found = keeper.GetProposal(ctx, proposalID)
+	suite.False(found, "guardian committee should veto the queued proposal")
+	suite.Equal(amount, tApp.GetBankKeeper().GetAllBalances(ctx, committeeAddr))
+}
+
 func committeeGenState(cdc codec.Codec, committees []types.Committee, proposals []types.Proposal, votes []types.Vote) app.GenesisState {
 	gs := types.NewGenesisState(
 		uint64(len(proposals)+1),
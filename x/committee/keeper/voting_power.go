@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/committee/types"
+)
+
+// DelegateVotingPower delegates the token committee voting power of a delegator to a representative,
+// replacing any existing delegation. Delegations are not transitive: the voting power of a delegator
+// is only counted when the representative votes themselves.
+func (k Keeper) DelegateVotingPower(ctx sdk.Context, delegator, representative sdk.AccAddress) error {
+	delegation := types.NewVotingDelegation(delegator, representative)
+	if err := delegation.Validate(); err != nil {
+		return err
+	}
+	k.SetVotingDelegation(ctx, delegation)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVotingPowerDelegate,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delegator.String()),
+			sdk.NewAttribute(types.AttributeKeyRepresentative, representative.String()),
+		),
+	)
+	return nil
+}
+
+// UndelegateVotingPower removes the voting power delegation of a delegator.
+func (k Keeper) UndelegateVotingPower(ctx sdk.Context, delegator sdk.AccAddress) error {
+	delegation, found := k.GetVotingDelegation(ctx, delegator)
+	if !found {
+		return errorsmod.Wrapf(types.ErrUnknownVotingDelegation, "%s", delegator)
+	}
+	k.DeleteVotingDelegation(ctx, delegator)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVotingPowerUndelegate,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delegator.String()),
+			sdk.NewAttribute(types.AttributeKeyRepresentative, delegation.Representative.String()),
+		),
+	)
+	return nil
+}
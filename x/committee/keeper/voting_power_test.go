@@ -0,0 +1,78 @@
+package keeper_test
+
+import (
+	"time"
+
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/kava-labs/kava/x/committee/testutil"
+	"github.com/kava-labs/kava/x/committee/types"
+)
+
+func (suite *keeperTestSuite) TestTallyTokenCommitteeVotesBySource() {
+	suite.Keeper.SetNextProposalID(suite.Ctx, 1)
+	tokenCom := types.MustNewTokenCommittee(
+		1,
+		"This committee is for testing.",
+		suite.Addresses[:1],
+		[]types.Permission{&types.TextPermission{}},
+		testutil.D("0.667"),
+		time.Hour*24*7,
+		types.TALLY_OPTION_DEADLINE,
+		testutil.D("0.4"),
+		"hard",
+	)
+	suite.Keeper.SetCommittee(suite.Ctx, tokenCom)
+
+	for i, amount := range []int64{10, 20, 30, 40} {
+		suite.Require().NoError(suite.App.FundAccount(suite.Ctx, suite.Addresses[i], testutil.Cs(testutil.C("hard", amount))))
+	}
+	representative, voter, delegator, otherDelegator := suite.Addresses[0], suite.Addresses[1], suite.Addresses[2], suite.Addresses[3]
+
+	proposalID, err := suite.Keeper.SubmitProposal(suite.Ctx, representative, 1, govv1beta1.NewTextProposal("A Title", "A description of this proposal."))
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.Keeper.DelegateVotingPower(suite.Ctx, delegator, representative))
+	suite.Require().NoError(suite.Keeper.DelegateVotingPower(suite.Ctx, otherDelegator, representative))
+	suite.Require().Error(suite.Keeper.DelegateVotingPower(suite.Ctx, representative, representative))
+
+	// delegations are only counted once the representative votes
+	suite.Require().NoError(suite.Keeper.AddVote(suite.Ctx, proposalID, voter, types.VOTE_TYPE_NO))
+	suite.Equal(types.TallySources{
+		{Source: types.VotingPowerSourceBalance, YesVotes: testutil.D("0"), NoVotes: testutil.D("20"), CurrentVotes: testutil.D("20")},
+	}, suite.Keeper.TallyTokenCommitteeVotesBySource(suite.Ctx, proposalID, "hard"))
+
+	// delegators that vote override their representative
+	suite.Require().NoError(suite.Keeper.AddVote(suite.Ctx, proposalID, representative, types.VOTE_TYPE_YES))
+	suite.Require().NoError(suite.Keeper.AddVote(suite.Ctx, proposalID, otherDelegator, types.VOTE_TYPE_ABSTAIN))
+	suite.Equal(types.TallySources{
+		{Source: types.VotingPowerSourceBalance, YesVotes: testutil.D("10"), NoVotes: testutil.D("20"), CurrentVotes: testutil.D("70")},
+		{Source: types.VotingPowerSourceDelegated, YesVotes: testutil.D("30"), NoVotes: testutil.D("0"), CurrentVotes: testutil.D("30")},
+	}, suite.Keeper.TallyTokenCommitteeVotesBySource(suite.Ctx, proposalID, "hard"))
+
+	yesVotes, noVotes, currVotes, _ := suite.Keeper.TallyTokenCommitteeVotes(suite.Ctx, proposalID, "hard")
+	suite.Equal(testutil.D("40"), yesVotes)
+	suite.Equal(testutil.D("20"), noVotes)
+	suite.Equal(testutil.D("100"), currVotes)
+
+	tally, found := suite.Keeper.GetProposalTallyResponse(suite.Ctx, proposalID)
+	suite.Require().True(found)
+	suite.Equal(suite.Keeper.TallyTokenCommitteeVotesBySource(suite.Ctx, proposalID, "hard"), types.TallySources(tally.Sources))
+
+	// undelegated voting power is no longer counted
+	suite.Require().NoError(suite.Keeper.UndelegateVotingPower(suite.Ctx, delegator))
+	suite.ErrorIs(suite.Keeper.UndelegateVotingPower(suite.Ctx, delegator), types.ErrUnknownVotingDelegation)
+	_, _, currVotes, _ = suite.Keeper.TallyTokenCommitteeVotes(suite.Ctx, proposalID, "hard")
+	suite.Equal(testutil.D("70"), currVotes)
+	suite.Equal([]types.VotingDelegation{types.NewVotingDelegation(otherDelegator, representative)}, suite.Keeper.GetVotingDelegations(suite.Ctx))
+
+	// delegations moved to another representative are counted towards its vote
+	suite.Require().NoError(suite.Keeper.DelegateVotingPower(suite.Ctx, delegator, voter))
+	suite.Equal(types.TallySources{
+		{Source: types.VotingPowerSourceBalance, YesVotes: testutil.D("10"), NoVotes: testutil.D("20"), CurrentVotes: testutil.D("70")},
+		{Source: types.VotingPowerSourceDelegated, YesVotes: testutil.D("0"), NoVotes: testutil.D("30"), CurrentVotes: testutil.D("30")},
+	}, suite.Keeper.TallyTokenCommitteeVotesBySource(suite.Ctx, proposalID, "hard"))
+
+	// the total voting power of denoms without other sources is the supply
+	suite.Equal(suite.App.GetBankKeeper().GetSupply(suite.Ctx, "hard").Amount, suite.Keeper.GetTotalVotingPower(suite.Ctx, "hard"))
+}
@@ -61,6 +61,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterInterface((*PubProposal)(nil), nil)
 	cdc.RegisterConcrete(CommitteeChangeProposal{}, "kava/CommitteeChangeProposal", nil)
 	cdc.RegisterConcrete(CommitteeDeleteProposal{}, "kava/CommitteeDeleteProposal", nil)
+	cdc.RegisterConcrete(MsgsProposal{}, "kava/MsgsProposal", nil)
 
 	// Committees
 	cdc.RegisterInterface((*Committee)(nil), nil)
@@ -77,10 +78,18 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(CommunityCDPRepayDebtPermission{}, "kava/CommunityCDPRepayDebtPermission", nil)
 	cdc.RegisterConcrete(CommunityCDPWithdrawCollateralPermission{}, "kava/CommunityCDPWithdrawCollateralPermission", nil)
 	cdc.RegisterConcrete(CommunityPoolLendWithdrawPermission{}, "kava/CommunityPoolLendWithdrawPermission", nil)
+	cdc.RegisterConcrete(AllowedMsgsPermission{}, "kava/AllowedMsgsPermission", nil)
 
 	// Msgs
 	legacy.RegisterAminoMsg(cdc, &MsgSubmitProposal{}, "kava/MsgSubmitProposal")
 	legacy.RegisterAminoMsg(cdc, &MsgVote{}, "kava/MsgVote")
+	legacy.RegisterAminoMsg(cdc, &MsgVetoProposal{}, "kava/MsgVetoProposal")
+	legacy.RegisterAminoMsg(cdc, &MsgAddCommitteeMember{}, "kava/MsgAddCommitteeMember")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveCommitteeMember{}, "kava/MsgRemoveCommitteeMember")
+	legacy.RegisterAminoMsg(cdc, &MsgRotateCommitteeMember{}, "kava/MsgRotateCommitteeMember")
+	legacy.RegisterAminoMsg(cdc, &MsgResignCommitteeMember{}, "kava/MsgResignCommitteeMember")
+	legacy.RegisterAminoMsg(cdc, &MsgDelegateVotingPower{}, "kava/MsgDelegateVotingPower")
+	legacy.RegisterAminoMsg(cdc, &MsgUndelegateVotingPower{}, "kava/MsgUndelegateVotingPower")
 }
 
 // RegisterProposalTypeCodec allows external modules to register their own pubproposal types on the
@@ -93,6 +102,13 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSubmitProposal{},
 		&MsgVote{},
+		&MsgVetoProposal{},
+		&MsgAddCommitteeMember{},
+		&MsgRemoveCommitteeMember{},
+		&MsgRotateCommitteeMember{},
+		&MsgResignCommitteeMember{},
+		&MsgDelegateVotingPower{},
+		&MsgUndelegateVotingPower{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -115,6 +131,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&CommunityCDPRepayDebtPermission{},
 		&CommunityCDPWithdrawCollateralPermission{},
 		&CommunityPoolLendWithdrawPermission{},
+		&AllowedMsgsPermission{},
 	)
 
 	// Need to register PubProposal here since we use this as alias for the x/gov Content interface for all the proposal implementations used in this module.
@@ -132,11 +149,13 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&communitytypes.CommunityCDPRepayDebtProposal{},
 		&communitytypes.CommunityCDPWithdrawCollateralProposal{},
 		&communitytypes.CommunityPoolLendWithdrawProposal{},
+		&MsgsProposal{},
 	)
 
 	registry.RegisterImplementations(
 		(*govv1beta1.Content)(nil),
 		&CommitteeChangeProposal{},
 		&CommitteeDeleteProposal{},
+		&MsgsProposal{},
 	)
 }
@@ -44,6 +44,10 @@ type Committee interface {
 	GetMembers() []sdk.AccAddress
 	SetMembers([]sdk.AccAddress)
 	HasMember(addr sdk.AccAddress) bool
+	AddMember(addr sdk.AccAddress, termExpiry time.Time)
+	RemoveMember(addr sdk.AccAddress)
+	GetMemberTerm(addr sdk.AccAddress) (time.Time, bool)
+	GetMemberTerms() []MemberTerm
 
 	GetPermissions() []Permission
 	SetPermissions([]Permission)
@@ -55,8 +59,16 @@ type Committee interface {
 	GetVoteThreshold() sdk.Dec
 	SetVoteThreshold(sdk.Dec)
 
+	GetTimelock() time.Duration
+	SetTimelock(time.Duration)
+
+	GetGuardianCommitteeIDs() []uint64
+	SetGuardianCommitteeIDs([]uint64)
+	HasGuardianCommittee(committeeID uint64) bool
+
 	GetTallyOption() TallyOption
 	Validate() error
+	ValidateState() error
 
 	String() string
 }
@@ -103,6 +115,57 @@ func (c *BaseCommittee) HasMember(addr sdk.AccAddress) bool {
 	return false
 }
 
+// AddMember adds a member to the committee, or updates the term of an existing member.
+// The member has no term if termExpiry is zero.
+func (c *BaseCommittee) AddMember(addr sdk.AccAddress, termExpiry time.Time) {
+	if !c.HasMember(addr) {
+		c.Members = append(c.Members, addr)
+	}
+
+	terms := make([]MemberTerm, 0, len(c.MemberTerms)+1)
+	for _, term := range c.MemberTerms {
+		if !term.Member.Equals(addr) {
+			terms = append(terms, term)
+		}
+	}
+	if !termExpiry.IsZero() {
+		terms = append(terms, MemberTerm{Member: addr, TermExpiry: termExpiry})
+	}
+	c.MemberTerms = terms
+}
+
+// RemoveMember removes a member and its term from the committee
+func (c *BaseCommittee) RemoveMember(addr sdk.AccAddress) {
+	members := make([]sdk.AccAddress, 0, len(c.Members))
+	for _, m := range c.Members {
+		if !m.Equals(addr) {
+			members = append(members, m)
+		}
+	}
+	c.Members = members
+
+	terms := make([]MemberTerm, 0, len(c.MemberTerms))
+	for _, term := range c.MemberTerms {
+		if !term.Member.Equals(addr) {
+			terms = append(terms, term)
+		}
+	}
+	c.MemberTerms = terms
+}
+
+// GetMemberTerms is a getter for committee member terms
+func (c BaseCommittee) GetMemberTerms() []MemberTerm { return c.MemberTerms }
+
+// GetMemberTerm returns the term expiry of a member, and false if the member has no term
+func (c BaseCommittee) GetMemberTerm(addr sdk.AccAddress) (time.Time, bool) {
+	for _, term := range c.MemberTerms {
+		if term.Member.Equals(addr) {
+			return term.TermExpiry, true
+		}
+	}
+	return time.Time{}, false
+}
+
 // GetPermissions is a getter for committee permissions
 func (c *BaseCommittee) GetPermissions() []Permission {
 	permissions, err := UnpackPermissions(c.Permissions)
@@ -143,10 +206,14 @@ func (c BaseCommittee) String() string {
   	Permissions:               			%s
   	VoteThreshold:            		  %s
 	ProposalDuration:        						%s
-	TallyOption:   						%s`,
+	TallyOption:   						%s
+	Timelock:   						%s
+	MemberTerms:   						%v
+	GuardianCommitteeIDs:   						%v`,
 		c.ID, c.Description, c.GetMembers(), c.Permissions,
 		c.VoteThreshold.String(), c.ProposalDuration.String(),
-		c.TallyOption.String(),
+		c.TallyOption.String(), c.Timelock.String(), c.MemberTerms,
+		c.GuardianCommitteeIDs,
 	)
 }
 
@@ -166,6 +233,32 @@ func (c *BaseCommittee) SetProposalDuration(proposalDuration time.Duration) {
 	c.ProposalDuration = proposalDuration
 }
 
+// GetTimelock is a getter for committee Timelock
+func (c BaseCommittee) GetTimelock() time.Duration { return c.Timelock }
+
+// SetTimelock is a setter for committee Timelock
+func (c *BaseCommittee) SetTimelock(timelock time.Duration) {
+	c.Timelock = timelock
+}
+
+// GetGuardianCommitteeIDs is a getter for committee GuardianCommitteeIDs
+func (c BaseCommittee) GetGuardianCommitteeIDs() []uint64 { return c.GuardianCommitteeIDs }
+
+// SetGuardianCommitteeIDs is a setter for committee GuardianCommitteeIDs
+func (c *BaseCommittee) SetGuardianCommitteeIDs(committeeIDs []uint64) {
+	c.GuardianCommitteeIDs = committeeIDs
+}
+
+// HasGuardianCommittee returns true if the committee can veto the queued proposals of this committee
+func (c BaseCommittee) HasGuardianCommittee(committeeID uint64) bool {
+	for _, id := range c.GuardianCommitteeIDs {
+		if id == committeeID {
+			return true
+		}
+	}
+	return false
+}
+
 // GetTallyOption is a getter for committee TallyOption
 func (c BaseCommittee) GetTallyOption() TallyOption { return c.TallyOption }
 
@@ -181,15 +274,20 @@ func (c BaseCommittee) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	return nil
 }
 
-// Validate validates BaseCommittee fields
+// Validate validates BaseCommittee fields. New committees must have members.
 func (c BaseCommittee) Validate() error {
-	if len(c.Description) > MaxCommitteeDescriptionLength {
-		return fmt.Errorf("description length %d longer than max allowed %d", len(c.Description), MaxCommitteeDescriptionLength)
-	}
-
 	if len(c.Members) <= 0 {
 		return fmt.Errorf("committee must have members")
 	}
+	return c.ValidateState()
+}
+
+// ValidateState validates the BaseCommittee fields of a committee in state, which has
+// no members if the terms of all its members have expired.
+func (c BaseCommittee) ValidateState() error {
+	if len(c.Description) > MaxCommitteeDescriptionLength {
+		return fmt.Errorf("description length %d longer than max allowed %d", len(c.Description), MaxCommitteeDescriptionLength)
+	}
 
 	addressMap := make(map[string]bool, len(c.Members))
 	for _, m := range c.Members {
@@ -204,6 +302,21 @@ func (c BaseCommittee) Validate() error {
 		addressMap[m.String()] = true
 	}
 
+	termMap := make(map[string]bool, len(c.MemberTerms))
+	for _, term := range c.MemberTerms {
+		// check terms belong to members and there are no duplicate terms
+		if !addressMap[term.Member.String()] {
+			return fmt.Errorf("committee cannot have a term for a non member, %s", term.Member)
+		}
+		if termMap[term.Member.String()] {
+			return fmt.Errorf("committee cannot have duplicate member terms, %s", term.Member)
+		}
+		if term.TermExpiry.IsZero() {
+			return fmt.Errorf("member term expiry cannot be zero, %s", term.Member)
+		}
+		termMap[term.Member.String()] = true
+	}
+
 	// validate permissions
 	permissions, err := UnpackPermissions(c.Permissions)
 	if err != nil {
@@ -219,6 +332,21 @@ func (c BaseCommittee) Validate() error {
 		return fmt.Errorf("invalid proposal duration: %s", c.ProposalDuration)
 	}
 
+	if c.Timelock < 0 {
+		return fmt.Errorf("invalid timelock: %s", c.Timelock)
+	}
+
+	guardianMap := make(map[uint64]bool, len(c.GuardianCommitteeIDs))
+	for _, id := range c.GuardianCommitteeIDs {
+		if id == c.ID {
+			return fmt.Errorf("committee cannot be its own guardian committee")
+		}
+		if guardianMap[id] {
+			return fmt.Errorf("committee cannot have duplicate guardian committees, %d", id)
+		}
+		guardianMap[id] = true
+	}
+
 	// threshold must be in the range [0, 1]
 	if c.VoteThreshold.IsNil() || c.VoteThreshold.LTE(sdk.ZeroDec()) || c.VoteThreshold.GT(sdk.NewDec(1)) {
 		return fmt.Errorf("invalid threshold: %s", c.VoteThreshold)
@@ -311,6 +439,21 @@ func (c TokenCommittee) GetTallyDenom() string { return c.TallyDenom }
 
 // Validate validates the committee's fields
 func (c TokenCommittee) Validate() error {
+	if err := c.validateTally(); err != nil {
+		return err
+	}
+	return c.BaseCommittee.Validate()
+}
+
+// ValidateState validates the fields of a committee in state
+func (c TokenCommittee) ValidateState() error {
+	if err := c.validateTally(); err != nil {
+		return err
+	}
+	return c.BaseCommittee.ValidateState()
+}
+
+func (c TokenCommittee) validateTally() error {
 	if c.TallyDenom == BondDenom {
 		return fmt.Errorf("invalid tally denom: %s", c.TallyDenom)
 	}
@@ -323,8 +466,7 @@ func (c TokenCommittee) Validate() error {
 	if c.Quorum.IsNil() || c.Quorum.IsNegative() || c.Quorum.GT(sdk.NewDec(1)) {
 		return fmt.Errorf("invalid quorum: %s", c.Quorum)
 	}
-
-	return c.BaseCommittee.Validate()
+	return nil
 }
 
 // ------------------------------------------
@@ -447,6 +589,25 @@ func (p Proposal) HasExpiredBy(time time.Time) bool {
 	return !time.Before(p.Deadline)
 }
 
+// QueuedProposals is a slice of QueuedProposal
+type QueuedProposals []QueuedProposal
+
+// NewQueuedProposal instantiates a new instance of QueuedProposal
+func NewQueuedProposal(proposalID uint64, executionTime time.Time) QueuedProposal {
+	return QueuedProposal{
+		ProposalID:    proposalID,
+		ExecutionTime: executionTime,
+	}
+}
+
+// Validate validates QueuedProposal fields
+func (qp QueuedProposal) Validate() error {
+	if qp.ExecutionTime.IsZero() {
+		return fmt.Errorf("queued proposal %d execution time cannot be zero", qp.ProposalID)
+	}
+	return nil
+}
+
 // NewVote instantiates a new instance of Vote
 func NewVote(proposalID uint64, voter sdk.AccAddress, voteType VoteType) Vote {
 	return Vote{
@@ -12,6 +12,7 @@ import (
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -73,6 +74,14 @@ type BaseCommittee struct {
 	// The length of time a proposal remains active for. Proposals will close earlier if they get enough votes.
 	ProposalDuration time.Duration `protobuf:"bytes,6,opt,name=proposal_duration,json=proposalDuration,proto3,stdduration" json:"proposal_duration"`
 	TallyOption      TallyOption   `protobuf:"varint,7,opt,name=tally_option,json=tallyOption,proto3,enum=kava.committee.v1beta1.TallyOption" json:"tally_option,omitempty"`
+	// The length of time a passed proposal is queued for before it is enacted. Queued proposals can be vetoed.
+	// Proposals are enacted as soon as they pass if the timelock is zero.
+	Timelock time.Duration `protobuf:"bytes,8,opt,name=timelock,proto3,stdduration" json:"timelock"`
+	// The terms of members that are removed from the committee once their term expires.
+	// Members without a term remain members until they are removed or resign.
+	MemberTerms []MemberTerm `protobuf:"bytes,9,rep,name=member_terms,json=memberTerms,proto3" json:"member_terms"`
+	// The committees that can veto the queued proposals of this committee with a MsgsProposal.
+	GuardianCommitteeIDs []uint64 `protobuf:"varint,10,rep,packed,name=guardian_committee_ids,json=guardianCommitteeIds,proto3" json:"guardian_committee_ids,omitempty"`
 }
 
 func (m *BaseCommittee) Reset()      { *m = BaseCommittee{} }
@@ -107,6 +116,45 @@ func (m *BaseCommittee) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BaseCommittee proto.InternalMessageInfo
 
+// MemberTerm defines the time at which a committee member is removed from the committee
+type MemberTerm struct {
+	Member     github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=member,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"member,omitempty"`
+	TermExpiry time.Time                                     `protobuf:"bytes,2,opt,name=term_expiry,json=termExpiry,proto3,stdtime" json:"term_expiry"`
+}
+
+func (m *MemberTerm) Reset()         { *m = MemberTerm{} }
+func (m *MemberTerm) String() string { return proto.CompactTextString(m) }
+func (*MemberTerm) ProtoMessage()    {}
+func (*MemberTerm) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2549fd9d70ca349, []int{1}
+}
+func (m *MemberTerm) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MemberTerm) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MemberTerm.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MemberTerm) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MemberTerm.Merge(m, src)
+}
+func (m *MemberTerm) XXX_Size() int {
+	return m.Size()
+}
+func (m *MemberTerm) XXX_DiscardUnknown() {
+	xxx_messageInfo_MemberTerm.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MemberTerm proto.InternalMessageInfo
+
 // MemberCommittee is an alias of BaseCommittee
 type MemberCommittee struct {
 	*BaseCommittee `protobuf:"bytes,1,opt,name=base_committee,json=baseCommittee,proto3,embedded=base_committee" json:"base_committee,omitempty"`
@@ -115,7 +163,7 @@ type MemberCommittee struct {
 func (m *MemberCommittee) Reset()      { *m = MemberCommittee{} }
 func (*MemberCommittee) ProtoMessage() {}
 func (*MemberCommittee) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a2549fd9d70ca349, []int{1}
+	return fileDescriptor_a2549fd9d70ca349, []int{2}
 }
 func (m *MemberCommittee) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -154,7 +202,7 @@ type TokenCommittee struct {
 func (m *TokenCommittee) Reset()      { *m = TokenCommittee{} }
 func (*TokenCommittee) ProtoMessage() {}
 func (*TokenCommittee) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a2549fd9d70ca349, []int{2}
+	return fileDescriptor_a2549fd9d70ca349, []int{3}
 }
 func (m *TokenCommittee) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -186,6 +234,7 @@ var xxx_messageInfo_TokenCommittee proto.InternalMessageInfo
 func init() {
 	proto.RegisterEnum("kava.committee.v1beta1.TallyOption", TallyOption_name, TallyOption_value)
 	proto.RegisterType((*BaseCommittee)(nil), "kava.committee.v1beta1.BaseCommittee")
+	proto.RegisterType((*MemberTerm)(nil), "kava.committee.v1beta1.MemberTerm")
 	proto.RegisterType((*MemberCommittee)(nil), "kava.committee.v1beta1.MemberCommittee")
 	proto.RegisterType((*TokenCommittee)(nil), "kava.committee.v1beta1.TokenCommittee")
 }
@@ -195,48 +244,57 @@ func init() {
 }
 
 var fileDescriptor_a2549fd9d70ca349 = []byte{
-	// 649 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xb6, 0x93, 0x90, 0xd2, 0x75, 0x1b, 0xd2, 0xa5, 0x54, 0x4e, 0x85, 0x6c, 0xab, 0x40, 0x15,
-	0x81, 0x62, 0xab, 0xe1, 0xc6, 0x2d, 0xae, 0x13, 0xd5, 0x52, 0x69, 0x22, 0xc7, 0x3d, 0xc0, 0xc5,
-	0xb2, 0xe3, 0x25, 0xb5, 0x1a, 0x67, 0x83, 0x77, 0x53, 0x35, 0x6f, 0xc0, 0x91, 0x63, 0x8f, 0x48,
-	0xbc, 0x42, 0x1f, 0xa2, 0xea, 0xa9, 0xe2, 0x84, 0x38, 0x84, 0x92, 0x3e, 0x05, 0x9c, 0x90, 0xff,
-	0x1a, 0x17, 0x8a, 0x04, 0x07, 0x4e, 0xde, 0xfd, 0xe6, 0x9b, 0x99, 0xfd, 0x66, 0x3e, 0x19, 0x6c,
-	0x1e, 0xda, 0x47, 0xb6, 0xd2, 0xc3, 0xbe, 0xef, 0x51, 0x8a, 0x90, 0x72, 0xb4, 0xe5, 0x20, 0x6a,
-	0x6f, 0xcd, 0x11, 0x79, 0x14, 0x60, 0x8a, 0xe1, 0x5a, 0xc8, 0x93, 0xe7, 0x68, 0xc2, 0x5b, 0xaf,
-	0xf4, 0x30, 0xf1, 0x31, 0xb1, 0x22, 0x96, 0x12, 0x5f, 0xe2, 0x94, 0xf5, 0xd5, 0x3e, 0xee, 0xe3,
-	0x18, 0x0f, 0x4f, 0x09, 0x5a, 0xe9, 0x63, 0xdc, 0x1f, 0x20, 0x25, 0xba, 0x39, 0xe3, 0x37, 0x8a,
-	0x3d, 0x9c, 0x24, 0x21, 0xe1, 0xd7, 0x90, 0x3b, 0x0e, 0x6c, 0xea, 0xe1, 0x61, 0x1c, 0xdf, 0xf8,
-	0x9e, 0x07, 0xcb, 0xaa, 0x4d, 0xd0, 0x76, 0xfa, 0x0a, 0xb8, 0x06, 0x72, 0x9e, 0xcb, 0xb3, 0x12,
-	0x5b, 0x2d, 0xa8, 0xc5, 0xd9, 0x54, 0xcc, 0xe9, 0x9a, 0x91, 0xf3, 0x5c, 0x28, 0x01, 0xce, 0x45,
-	0xa4, 0x17, 0x78, 0xa3, 0x30, 0x9d, 0xcf, 0x49, 0x6c, 0x75, 0xd1, 0xc8, 0x42, 0xd0, 0x01, 0x0b,
-	0x3e, 0xf2, 0x1d, 0x14, 0x10, 0x3e, 0x2f, 0xe5, 0xab, 0x4b, 0xea, 0xce, 0x8f, 0xa9, 0x58, 0xeb,
-	0x7b, 0xf4, 0x60, 0xec, 0x84, 0x32, 0x13, 0x29, 0xc9, 0xa7, 0x46, 0xdc, 0x43, 0x85, 0x4e, 0x46,
-	0x88, 0xc8, 0x8d, 0x5e, 0xaf, 0xe1, 0xba, 0x01, 0x22, 0xe4, 0xd3, 0x69, 0xed, 0x7e, 0x22, 0x38,
-	0x41, 0xd4, 0x09, 0x45, 0xc4, 0x48, 0x0b, 0xc3, 0x16, 0xe0, 0x46, 0x28, 0xf0, 0x3d, 0x42, 0x3c,
-	0x3c, 0x24, 0x7c, 0x41, 0xca, 0x57, 0xb9, 0xfa, 0xaa, 0x1c, 0xab, 0x94, 0x53, 0x95, 0x72, 0x63,
-	0x38, 0x51, 0x4b, 0xe7, 0xa7, 0x35, 0xd0, 0xb9, 0x26, 0x1b, 0xd9, 0x44, 0xb8, 0x0f, 0x4a, 0x47,
-	0x98, 0x22, 0x8b, 0x1e, 0x04, 0x88, 0x1c, 0xe0, 0x81, 0xcb, 0xdf, 0x09, 0x05, 0xa9, 0xf2, 0xd9,
-	0x54, 0x64, 0xbe, 0x4c, 0xc5, 0xcd, 0xbf, 0x78, 0xb6, 0x86, 0x7a, 0xc6, 0x72, 0x58, 0xc5, 0x4c,
-	0x8b, 0xc0, 0x0e, 0x58, 0x19, 0x05, 0x78, 0x84, 0x89, 0x3d, 0xb0, 0xd2, 0x49, 0xf3, 0x45, 0x89,
-	0xad, 0x72, 0xf5, 0xca, 0x6f, 0x8f, 0xd4, 0x12, 0x82, 0x7a, 0x37, 0x6c, 0x7a, 0xf2, 0x55, 0x64,
-	0x8d, 0x72, 0x9a, 0x9d, 0xc6, 0x60, 0x0b, 0x2c, 0x51, 0x7b, 0x30, 0x98, 0x58, 0x38, 0x9e, 0xfb,
-	0x82, 0xc4, 0x56, 0x4b, 0xf5, 0x47, 0xf2, 0xed, 0xde, 0x91, 0xcd, 0x90, 0xdb, 0x8e, 0xa8, 0x06,
-	0x47, 0xe7, 0x97, 0x17, 0x2b, 0x27, 0x1f, 0x44, 0xe6, 0xfc, 0xb4, 0xb6, 0x78, 0xbd, 0xe9, 0x8d,
-	0x63, 0x70, 0xef, 0x65, 0x34, 0xd6, 0xf9, 0xf2, 0x0d, 0x50, 0x72, 0x6c, 0x82, 0xac, 0xeb, 0xc2,
-	0x91, 0x11, 0xb8, 0xfa, 0x93, 0x3f, 0xf5, 0xbb, 0xe1, 0x1d, 0xb5, 0x70, 0x31, 0x15, 0x59, 0x63,
-	0xd9, 0xc9, 0x82, 0xb7, 0x75, 0xbe, 0x64, 0x41, 0xc9, 0xc4, 0x87, 0x68, 0xf8, 0x5f, 0x3b, 0xc3,
-	0x16, 0x28, 0xbe, 0x1d, 0xe3, 0x60, 0xec, 0xc7, 0x6e, 0xfd, 0xe7, 0xe5, 0x26, 0xd9, 0x50, 0x04,
-	0xf1, 0x28, 0x2d, 0x17, 0x0d, 0xb1, 0xcf, 0xe7, 0x23, 0xeb, 0x83, 0x08, 0xd2, 0x42, 0xe4, 0x16,
-	0x89, 0x4f, 0x03, 0xc0, 0x65, 0x76, 0x01, 0x1f, 0x02, 0xde, 0x6c, 0xec, 0xee, 0xbe, 0xb2, 0xda,
-	0x1d, 0x53, 0x6f, 0xef, 0x59, 0xfb, 0x7b, 0xdd, 0x4e, 0x73, 0x5b, 0x6f, 0xe9, 0x4d, 0xad, 0xcc,
-	0xc0, 0xc7, 0x40, 0xba, 0x11, 0x6d, 0xe9, 0x46, 0xd7, 0xb4, 0x3a, 0x8d, 0xae, 0x69, 0x99, 0x3b,
-	0x4d, 0xab, 0xd3, 0xee, 0x9a, 0x65, 0x16, 0x56, 0xc0, 0x83, 0x1b, 0x2c, 0xad, 0xd9, 0xd0, 0x76,
-	0xf5, 0xbd, 0x66, 0x39, 0xb7, 0x5e, 0x78, 0xf7, 0x51, 0x60, 0x54, 0xfd, 0xec, 0x9b, 0xc0, 0x9c,
-	0xcd, 0x04, 0xf6, 0x62, 0x26, 0xb0, 0x97, 0x33, 0x81, 0x7d, 0x7f, 0x25, 0x30, 0x17, 0x57, 0x02,
-	0xf3, 0xf9, 0x4a, 0x60, 0x5e, 0x3f, 0xcb, 0xa8, 0x0e, 0x67, 0x5a, 0x1b, 0xd8, 0x0e, 0x89, 0x4e,
-	0xca, 0x71, 0xe6, 0x6f, 0x15, 0xc9, 0x77, 0x8a, 0x91, 0x4b, 0x9f, 0xff, 0x0c, 0x00, 0x00, 0xff,
-	0xff, 0x0d, 0x0b, 0x28, 0x7d, 0xcc, 0x04, 0x00, 0x00,
+	// 795 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcd, 0x6e, 0xe2, 0x56,
+	0x14, 0xb6, 0x81, 0x90, 0xe4, 0x3a, 0xa1, 0xe4, 0x96, 0x46, 0x06, 0x55, 0xb6, 0x45, 0xdb, 0x08,
+	0xb5, 0xc2, 0x28, 0x74, 0xd7, 0x4d, 0x85, 0x63, 0x68, 0xac, 0xa6, 0x80, 0x8c, 0xb3, 0x68, 0x37,
+	0xae, 0x8d, 0x6f, 0x89, 0x05, 0xe6, 0xba, 0xbe, 0x26, 0x0a, 0x6f, 0xd0, 0x65, 0x96, 0x59, 0x56,
+	0xea, 0x2b, 0xa4, 0xef, 0x10, 0x65, 0x15, 0x75, 0xd3, 0xd1, 0x2c, 0x98, 0x0c, 0x79, 0x8b, 0x59,
+	0x8d, 0xfc, 0x07, 0xe4, 0x4f, 0x8a, 0x46, 0x33, 0x2b, 0x7c, 0xbf, 0xf3, 0x9d, 0xe3, 0xf3, 0x7d,
+	0xf7, 0x1c, 0x0c, 0xf6, 0x86, 0xc6, 0xa9, 0x51, 0xeb, 0x63, 0xc7, 0xb1, 0x7d, 0x1f, 0xa1, 0xda,
+	0xe9, 0xbe, 0x89, 0x7c, 0x63, 0x7f, 0x89, 0x88, 0xae, 0x87, 0x7d, 0x0c, 0x77, 0x03, 0x9e, 0xb8,
+	0x44, 0x63, 0x5e, 0xa9, 0xd8, 0xc7, 0xc4, 0xc1, 0x44, 0x0f, 0x59, 0xb5, 0xe8, 0x10, 0xa5, 0x94,
+	0x0a, 0x03, 0x3c, 0xc0, 0x11, 0x1e, 0x3c, 0xc5, 0x68, 0x71, 0x80, 0xf1, 0x60, 0x84, 0x6a, 0xe1,
+	0xc9, 0x9c, 0xfc, 0x51, 0x33, 0xc6, 0xd3, 0x38, 0xc4, 0x3d, 0x0c, 0x59, 0x13, 0xcf, 0xf0, 0x6d,
+	0x3c, 0x8e, 0xe3, 0xfc, 0xc3, 0xb8, 0x6f, 0x3b, 0x88, 0xf8, 0x86, 0xe3, 0x46, 0x84, 0xf2, 0xff,
+	0x6b, 0x60, 0x5b, 0x32, 0x08, 0x3a, 0x48, 0xda, 0x84, 0xbb, 0x20, 0x65, 0x5b, 0x2c, 0x2d, 0xd0,
+	0x95, 0x8c, 0x94, 0x9d, 0xcf, 0xf8, 0x94, 0x22, 0xab, 0x29, 0xdb, 0x82, 0x02, 0x60, 0x2c, 0x44,
+	0xfa, 0x9e, 0xed, 0x06, 0xf5, 0xd9, 0x94, 0x40, 0x57, 0x36, 0xd5, 0x55, 0x08, 0x9a, 0x60, 0xdd,
+	0x41, 0x8e, 0x89, 0x3c, 0xc2, 0xa6, 0x85, 0x74, 0x65, 0x4b, 0x3a, 0x7c, 0x37, 0xe3, 0xab, 0x03,
+	0xdb, 0x3f, 0x99, 0x98, 0x81, 0x0f, 0xb1, 0xd6, 0xf8, 0xa7, 0x4a, 0xac, 0x61, 0xcd, 0x9f, 0xba,
+	0x88, 0x88, 0x8d, 0x7e, 0xbf, 0x61, 0x59, 0x1e, 0x22, 0xe4, 0xbf, 0xcb, 0xea, 0xe7, 0xb1, 0x23,
+	0x31, 0x22, 0x4d, 0x7d, 0x44, 0xd4, 0xa4, 0x30, 0x6c, 0x01, 0xc6, 0x45, 0x9e, 0x63, 0x13, 0x62,
+	0xe3, 0x31, 0x61, 0x33, 0x42, 0xba, 0xc2, 0xd4, 0x0b, 0x62, 0x24, 0x53, 0x4c, 0x64, 0x8a, 0x8d,
+	0xf1, 0x54, 0xca, 0x5d, 0x5f, 0x56, 0x41, 0x77, 0x41, 0x56, 0x57, 0x13, 0xe1, 0x31, 0xc8, 0x9d,
+	0x62, 0x1f, 0xe9, 0xfe, 0x89, 0x87, 0xc8, 0x09, 0x1e, 0x59, 0xec, 0x5a, 0x20, 0x48, 0x12, 0xaf,
+	0x66, 0x3c, 0xf5, 0x7a, 0xc6, 0xef, 0xbd, 0xa0, 0x6d, 0x19, 0xf5, 0xd5, 0xed, 0xa0, 0x8a, 0x96,
+	0x14, 0x81, 0x5d, 0xb0, 0xe3, 0x7a, 0xd8, 0xc5, 0xc4, 0x18, 0xe9, 0xc9, 0x55, 0xb0, 0x59, 0x81,
+	0xae, 0x30, 0xf5, 0xe2, 0xa3, 0x26, 0xe5, 0x98, 0x20, 0x6d, 0x04, 0x2f, 0xbd, 0x78, 0xc3, 0xd3,
+	0x6a, 0x3e, 0xc9, 0x4e, 0x62, 0xb0, 0x05, 0xb6, 0x7c, 0x63, 0x34, 0x9a, 0xea, 0x38, 0xf2, 0x7d,
+	0x5d, 0xa0, 0x2b, 0xb9, 0xfa, 0x57, 0xe2, 0xd3, 0xc3, 0x25, 0x6a, 0x01, 0xb7, 0x13, 0x52, 0x55,
+	0xc6, 0x5f, 0x1e, 0xe0, 0x8f, 0x60, 0x23, 0xb8, 0xfb, 0x11, 0xee, 0x0f, 0xd9, 0x8d, 0x97, 0x37,
+	0xb4, 0x48, 0x82, 0x3f, 0x83, 0xad, 0xe8, 0x12, 0x74, 0x1f, 0x79, 0x0e, 0x61, 0x37, 0x43, 0xeb,
+	0xcb, 0xcf, 0x35, 0xf2, 0x4b, 0xc8, 0xd5, 0x90, 0xe7, 0x48, 0x99, 0xa0, 0x9a, 0xca, 0x38, 0x0b,
+	0x84, 0xc0, 0x36, 0xd8, 0x1d, 0x4c, 0x0c, 0xcf, 0xb2, 0x8d, 0xb1, 0xbe, 0xc8, 0xd5, 0x6d, 0x8b,
+	0xb0, 0x40, 0x48, 0x57, 0x32, 0x12, 0x3b, 0x9f, 0xf1, 0x85, 0x9f, 0x62, 0xc6, 0x62, 0x36, 0x15,
+	0x99, 0xa8, 0x85, 0xc1, 0x23, 0xd4, 0x22, 0x3f, 0xec, 0x5c, 0xfc, 0xcd, 0x53, 0xd7, 0x97, 0xd5,
+	0xcd, 0x05, 0x5a, 0xfe, 0x97, 0x06, 0x60, 0xd9, 0x04, 0xfc, 0x1d, 0x64, 0xa3, 0x06, 0xc2, 0xd1,
+	0xfe, 0x98, 0xb3, 0x19, 0xd7, 0x85, 0x4d, 0xc0, 0x04, 0xce, 0xe8, 0xe8, 0xcc, 0xb5, 0xbd, 0x69,
+	0xb8, 0x20, 0x4c, 0xbd, 0xf4, 0xc8, 0x64, 0x2d, 0xd9, 0xc0, 0xc8, 0xe5, 0xf3, 0xc0, 0x65, 0x10,
+	0x24, 0x36, 0xc3, 0xbc, 0xf2, 0x19, 0xf8, 0x2c, 0x6a, 0x7b, 0xb9, 0x92, 0x2a, 0xc8, 0x99, 0x06,
+	0x41, 0x4b, 0xa7, 0x42, 0x0d, 0x4c, 0xfd, 0x9b, 0xe7, 0xcc, 0xbf, 0xb7, 0xd1, 0x52, 0xe6, 0x66,
+	0xc6, 0xd3, 0xea, 0xb6, 0xb9, 0x0a, 0x3e, 0xe5, 0xd8, 0x2d, 0x0d, 0x72, 0x1a, 0x1e, 0xa2, 0xf1,
+	0x27, 0x7d, 0x33, 0x6c, 0x81, 0xec, 0x9f, 0x13, 0xec, 0x4d, 0x1c, 0x36, 0xf5, 0x41, 0x2b, 0x17,
+	0x67, 0x43, 0x1e, 0x44, 0x03, 0xae, 0x5b, 0x68, 0x8c, 0x1d, 0x36, 0x1d, 0xfe, 0x21, 0x81, 0x10,
+	0x92, 0x03, 0xe4, 0x09, 0x89, 0xdf, 0x7a, 0x80, 0x59, 0xd9, 0x10, 0xf8, 0x25, 0x60, 0xb5, 0xc6,
+	0xd1, 0xd1, 0xaf, 0x7a, 0xa7, 0xab, 0x29, 0x9d, 0xb6, 0x7e, 0xdc, 0xee, 0x75, 0x9b, 0x07, 0x4a,
+	0x4b, 0x69, 0xca, 0x79, 0x0a, 0x7e, 0x0d, 0x84, 0x7b, 0xd1, 0x96, 0xa2, 0xf6, 0x34, 0xbd, 0xdb,
+	0xe8, 0x69, 0xba, 0x76, 0xd8, 0xd4, 0xbb, 0x9d, 0x9e, 0x96, 0xa7, 0x61, 0x11, 0x7c, 0x71, 0x8f,
+	0x25, 0x37, 0x1b, 0xf2, 0x91, 0xd2, 0x6e, 0xe6, 0x53, 0xa5, 0xcc, 0x5f, 0xff, 0x70, 0x94, 0xa4,
+	0x5c, 0xbd, 0xe5, 0xa8, 0xab, 0x39, 0x47, 0xdf, 0xcc, 0x39, 0xfa, 0x76, 0xce, 0xd1, 0xe7, 0x77,
+	0x1c, 0x75, 0x73, 0xc7, 0x51, 0xaf, 0xee, 0x38, 0xea, 0xb7, 0xef, 0x56, 0x54, 0x07, 0x9e, 0x56,
+	0x47, 0x86, 0x49, 0xc2, 0xa7, 0xda, 0xd9, 0xca, 0x47, 0x26, 0x94, 0x6f, 0x66, 0xc3, 0x29, 0xfa,
+	0xfe, 0xfd, 0x00, 0x42, 0x7c, 0xf6, 0xae, 0x83, 0x06, 0x00, 0x00,
 }
 
 func (m *BaseCommittee) Marshal() (dAtA []byte, err error) {
@@ -259,17 +317,57 @@ func (m *BaseCommittee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.GuardianCommitteeIDs) > 0 {
+		dAtA2 := make([]byte, len(m.GuardianCommitteeIDs)*10)
+		var j1 int
+		for _, num := range m.GuardianCommitteeIDs {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintCommittee(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.MemberTerms) > 0 {
+		for iNdEx := len(m.MemberTerms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MemberTerms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCommittee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Timelock, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Timelock):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintCommittee(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x42
 	if m.TallyOption != 0 {
 		i = encodeVarintCommittee(dAtA, i, uint64(m.TallyOption))
 		i--
 		dAtA[i] = 0x38
 	}
-	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ProposalDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ProposalDuration):])
-	if err1 != nil {
-		return 0, err1
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ProposalDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ProposalDuration):])
+	if err4 != nil {
+		return 0, err4
 	}
-	i -= n1
-	i = encodeVarintCommittee(dAtA, i, uint64(n1))
+	i -= n4
+	i = encodeVarintCommittee(dAtA, i, uint64(n4))
 	i--
 	dAtA[i] = 0x32
 	{
@@ -320,6 +418,44 @@ func (m *BaseCommittee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MemberTerm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MemberTerm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MemberTerm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.TermExpiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.TermExpiry):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintCommittee(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Member) > 0 {
+		i -= len(m.Member)
+		copy(dAtA[i:], m.Member)
+		i = encodeVarintCommittee(dAtA, i, uint64(len(m.Member)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MemberCommittee) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -450,6 +586,36 @@ func (m *BaseCommittee) Size() (n int) {
 	if m.TallyOption != 0 {
 		n += 1 + sovCommittee(uint64(m.TallyOption))
 	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Timelock)
+	n += 1 + l + sovCommittee(uint64(l))
+	if len(m.MemberTerms) > 0 {
+		for _, e := range m.MemberTerms {
+			l = e.Size()
+			n += 1 + l + sovCommittee(uint64(l))
+		}
+	}
+	if len(m.GuardianCommitteeIDs) > 0 {
+		l = 0
+		for _, e := range m.GuardianCommitteeIDs {
+			l += sovCommittee(uint64(e))
+		}
+		n += 1 + sovCommittee(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MemberTerm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Member)
+	if l > 0 {
+		n += 1 + l + sovCommittee(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.TermExpiry)
+	n += 1 + l + sovCommittee(uint64(l))
 	return n
 }
 
@@ -723,6 +889,266 @@ func (m *BaseCommittee) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timelock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommittee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommittee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommittee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Timelock, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberTerms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommittee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommittee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommittee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MemberTerms = append(m.MemberTerms, MemberTerm{})
+			if err := m.MemberTerms[len(m.MemberTerms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCommittee
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.GuardianCommitteeIDs = append(m.GuardianCommitteeIDs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCommittee
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthCommittee
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthCommittee
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.GuardianCommitteeIDs) == 0 {
+					m.GuardianCommitteeIDs = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCommittee
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.GuardianCommitteeIDs = append(m.GuardianCommitteeIDs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field GuardianCommitteeIDs", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommittee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCommittee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MemberTerm) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommittee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MemberTerm: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MemberTerm: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommittee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCommittee
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommittee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Member = append(m.Member[:0], dAtA[iNdEx:postIndex]...)
+			if m.Member == nil {
+				m.Member = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TermExpiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommittee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommittee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommittee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.TermExpiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCommittee(dAtA[iNdEx:])
@@ -124,6 +124,102 @@ func TestBaseCommittee(t *testing.T) {
 			},
 			expectPass: false,
 		},
+		{
+			name: "negative timelock",
+			createCommittee: func() (*types.MemberCommittee, error) {
+				com, err := types.NewMemberCommittee(
+					1,
+					"This base committee is for testing.",
+					addresses[:3],
+					[]types.Permission{&types.GodPermission{}},
+					testutil.D("0.667"),
+					time.Hour*24*7,
+					types.TALLY_OPTION_FIRST_PAST_THE_POST,
+				)
+				if err == nil {
+					com.SetTimelock(-time.Hour)
+				}
+				return com, err
+			},
+			expectPass: false,
+		},
+		{
+			name: "own guardian committee",
+			createCommittee: func() (*types.MemberCommittee, error) {
+				com, err := types.NewMemberCommittee(
+					1,
+					"This base committee is for testing.",
+					addresses[:3],
+					[]types.Permission{&types.GodPermission{}},
+					testutil.D("0.667"),
+					time.Hour*24*7,
+					types.TALLY_OPTION_FIRST_PAST_THE_POST,
+				)
+				if err == nil {
+					com.SetGuardianCommitteeIDs([]uint64{1})
+				}
+				return com, err
+			},
+			expectPass: false,
+		},
+		{
+			name: "duplicate guardian committees",
+			createCommittee: func() (*types.MemberCommittee, error) {
+				com, err := types.NewMemberCommittee(
+					1,
+					"This base committee is for testing.",
+					addresses[:3],
+					[]types.Permission{&types.GodPermission{}},
+					testutil.D("0.667"),
+					time.Hour*24*7,
+					types.TALLY_OPTION_FIRST_PAST_THE_POST,
+				)
+				if err == nil {
+					com.SetGuardianCommitteeIDs([]uint64{2, 2})
+				}
+				return com, err
+			},
+			expectPass: false,
+		},
+		{
+			name: "member term for non member",
+			createCommittee: func() (*types.MemberCommittee, error) {
+				com, err := types.NewMemberCommittee(
+					1,
+					"This base committee is for testing.",
+					addresses[:2],
+					[]types.Permission{&types.GodPermission{}},
+					testutil.D("0.667"),
+					time.Hour*24*7,
+					types.TALLY_OPTION_FIRST_PAST_THE_POST,
+				)
+				if err == nil {
+					com.MemberTerms = []types.MemberTerm{{Member: addresses[2], TermExpiry: time.Unix(1, 0)}}
+				}
+				return com, err
+			},
+			expectPass: false,
+		},
+		{
+			name: "duplicate member terms",
+			createCommittee: func() (*types.MemberCommittee, error) {
+				com, err := types.NewMemberCommittee(
+					1,
+					"This base committee is for testing.",
+					addresses[:2],
+					[]types.Permission{&types.GodPermission{}},
+					testutil.D("0.667"),
+					time.Hour*24*7,
+					types.TALLY_OPTION_FIRST_PAST_THE_POST,
+				)
+				if err == nil {
+					term := types.MemberTerm{Member: addresses[0], TermExpiry: time.Unix(1, 0)}
+					com.MemberTerms = []types.MemberTerm{term, term}
+				}
+				return com, err
+			},
+			expectPass: false,
+		},
 		{
 			name: "vote threshold is nil",
 			createCommittee: func() (*types.MemberCommittee, error) {
@@ -14,4 +14,9 @@ var (
 	ErrUnknownSubspace         = errorsmod.Register(ModuleName, 10, "subspace not found")
 	ErrInvalidVoteType         = errorsmod.Register(ModuleName, 11, "invalid vote type")
 	ErrNotFoundProposalTally   = errorsmod.Register(ModuleName, 12, "proposal tally not found")
+	ErrProposalQueued          = errorsmod.Register(ModuleName, 13, "proposal is queued for execution")
+	ErrProposalNotQueued       = errorsmod.Register(ModuleName, 14, "proposal is not queued for execution")
+	ErrUnknownMember           = errorsmod.Register(ModuleName, 15, "committee member not found")
+	ErrMemberExists            = errorsmod.Register(ModuleName, 16, "committee member already exists")
+	ErrUnknownVotingDelegation = errorsmod.Register(ModuleName, 17, "voting delegation not found")
 )
@@ -5,14 +5,33 @@ const (
 	EventTypeProposalSubmit = "proposal_submit"
 	EventTypeProposalClose  = "proposal_close"
 	EventTypeProposalVote   = "proposal_vote"
+	EventTypeProposalQueue  = "proposal_queue"
+	EventTypeMemberAdd      = "committee_member_add"
+	EventTypeMemberRemove   = "committee_member_remove"
+	EventTypeMemberRotate   = "committee_member_rotate"
+	EventTypeCommitteeEmpty = "committee_empty"
+
+	EventTypeVotingPowerDelegate   = "voting_power_delegate"
+	EventTypeVotingPowerUndelegate = "voting_power_undelegate"
 
 	AttributeValueCategory          = "committee"
 	AttributeKeyCommitteeID         = "committee_id"
 	AttributeKeyProposalID          = "proposal_id"
 	AttributeKeyDeadline            = "deadline"
+	AttributeKeyExecutionTime       = "execution_time"
 	AttributeKeyProposalCloseStatus = "status"
 	AttributeKeyVoter               = "voter"
 	AttributeKeyVote                = "vote"
 	AttributeKeyProposalOutcome     = "proposal_outcome"
 	AttributeKeyProposalTally       = "proposal_tally"
+	AttributeKeyMember              = "member"
+	AttributeKeyNewMember           = "new_member"
+	AttributeKeyTermExpiry          = "term_expiry"
+	AttributeKeyRemovalReason       = "reason"
+	AttributeKeyDelegator           = "delegator"
+	AttributeKeyRepresentative      = "representative"
+
+	AttributeValueRemoved  = "removed"
+	AttributeValueResigned = "resigned"
+	AttributeValueExpired  = "expired"
 )
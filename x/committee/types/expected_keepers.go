@@ -1,11 +1,18 @@
 package types
 
 import (
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
+// MsgRouter defines the expected msg service router
+type MsgRouter interface {
+	Handler(msg sdk.Msg) baseapp.MsgServiceHandler
+}
+
 type ParamKeeper interface {
 	GetSubspace(string) (paramstypes.Subspace, bool)
 }
@@ -20,3 +27,19 @@ type BankKeeper interface {
 	GetSupply(ctx sdk.Context, denom string) sdk.Coin
 	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
 }
+
+// VotingPowerSource defines the expected interface for counting token committee voting power in addition to the
+// voter's balance of the tally denom, such as staked or liquid staked tokens.
+type VotingPowerSource interface {
+	GetVotingPower(ctx sdk.Context, voter sdk.AccAddress, tallyDenom string) []VotingPower
+	// GetTotalVotingPower returns the total voting power of all balances and sources of a tally denom,
+	// or false if the source does not count voting power for the denom.
+	GetTotalVotingPower(ctx sdk.Context, tallyDenom string) (sdkmath.Int, bool)
+}
+
+// CommitteeHooks event hooks for other keepers to run code in response to committee proposal changes
+type CommitteeHooks interface {
+	AfterProposalSubmission(ctx sdk.Context, proposalID uint64)
+	AfterProposalVote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress)
+	AfterProposalClosed(ctx sdk.Context, proposal Proposal, outcome ProposalOutcome, tally *QueryTallyResponse)
+}
@@ -75,7 +75,7 @@ func (gs GenesisState) Validate() error {
 		committeeMap[com.GetID()] = true
 
 		// validate committee
-		if err := com.Validate(); err != nil {
+		if err := com.ValidateState(); err != nil {
 			return err
 		}
 	}
@@ -105,6 +105,39 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	// validate queued proposals
+	queuedMap := make(map[uint64]bool, len(gs.QueuedProposals))
+	for _, qp := range gs.QueuedProposals {
+		if err := qp.Validate(); err != nil {
+			return err
+		}
+
+		// check there are no duplicate IDs
+		if queuedMap[qp.ProposalID] {
+			return fmt.Errorf("duplicate queued proposal ID found in genesis state; id: %d", qp.ProposalID)
+		}
+		queuedMap[qp.ProposalID] = true
+
+		// check proposal exists
+		if !proposalMap[qp.ProposalID] {
+			return fmt.Errorf("queued proposal refers to non existent proposal; id: %d", qp.ProposalID)
+		}
+	}
+
+	// validate voting delegations
+	delegatorMap := make(map[string]bool, len(gs.VotingDelegations))
+	for _, d := range gs.VotingDelegations {
+		if err := d.Validate(); err != nil {
+			return err
+		}
+
+		// check there are no duplicate delegators
+		if delegatorMap[d.Delegator.String()] {
+			return fmt.Errorf("duplicate voting delegation found in genesis state; delegator: %s", d.Delegator)
+		}
+		delegatorMap[d.Delegator.String()] = true
+	}
+
 	// validate votes
 	for _, v := range gs.Votes {
 		// validate committee
@@ -67,6 +67,17 @@ func TestGenesisState_Validate(t *testing.T) {
 		},
 	)
 
+	withQueuedProposals := func(queuedProposals ...types.QueuedProposal) *types.GenesisState {
+		gs := *testGenesis
+		gs.QueuedProposals = queuedProposals
+		return &gs
+	}
+	withVotingDelegations := func(delegations ...types.VotingDelegation) *types.GenesisState {
+		gs := *testGenesis
+		gs.VotingDelegations = delegations
+		return &gs
+	}
+
 	testCases := []struct {
 		name       string
 		genState   *types.GenesisState
@@ -102,6 +113,24 @@ func TestGenesisState_Validate(t *testing.T) {
 			),
 			expectPass: false,
 		},
+		{
+			name: "committee without members",
+			genState: types.NewGenesisState(
+				testGenesis.NextProposalID,
+				append(testGenesis.GetCommittees(), types.MustNewMemberCommittee(
+					4,
+					"The terms of all members of this committee have expired.",
+					nil,
+					nil,
+					testutil.D("0.667"),
+					time.Hour*24*7,
+					types.TALLY_OPTION_FIRST_PAST_THE_POST,
+				)),
+				testGenesis.Proposals,
+				testGenesis.Votes,
+			),
+			expectPass: true,
+		},
 		{
 			name: "duplicate proposal IDs",
 			genState: types.NewGenesisState(
@@ -160,6 +189,52 @@ func TestGenesisState_Validate(t *testing.T) {
 			),
 			expectPass: false,
 		},
+		{
+			name:       "queued proposal",
+			genState:   withQueuedProposals(types.NewQueuedProposal(1, testTime.Add(24*time.Hour))),
+			expectPass: true,
+		},
+		{
+			name: "duplicate queued proposal IDs",
+			genState: withQueuedProposals(
+				types.NewQueuedProposal(1, testTime.Add(24*time.Hour)),
+				types.NewQueuedProposal(1, testTime.Add(48*time.Hour)),
+			),
+			expectPass: false,
+		},
+		{
+			name:       "queued proposal without proposal",
+			genState:   withQueuedProposals(types.NewQueuedProposal(2, testTime.Add(24*time.Hour))),
+			expectPass: false,
+		},
+		{
+			name:       "queued proposal without execution time",
+			genState:   withQueuedProposals(types.QueuedProposal{ProposalID: 1}),
+			expectPass: false,
+		},
+		{
+			name:       "voting delegation",
+			genState:   withVotingDelegations(types.NewVotingDelegation(addresses[3], addresses[0])),
+			expectPass: true,
+		},
+		{
+			name: "duplicate voting delegators",
+			genState: withVotingDelegations(
+				types.NewVotingDelegation(addresses[3], addresses[0]),
+				types.NewVotingDelegation(addresses[3], addresses[1]),
+			),
+			expectPass: false,
+		},
+		{
+			name:       "voting delegation to self",
+			genState:   withVotingDelegations(types.NewVotingDelegation(addresses[3], addresses[3])),
+			expectPass: false,
+		},
+		{
+			name:       "voting delegation without representative",
+			genState:   withVotingDelegations(types.VotingDelegation{Delegator: addresses[3]}),
+			expectPass: false,
+		},
 		{
 			name: "invalid vote",
 			genState: types.NewGenesisState(
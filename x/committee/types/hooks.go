@@ -0,0 +1,32 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// MultiCommitteeHooks combine multiple committee hooks, all hook functions are run in array sequence
+type MultiCommitteeHooks []CommitteeHooks
+
+// NewMultiCommitteeHooks returns a new MultiCommitteeHooks
+func NewMultiCommitteeHooks(hooks ...CommitteeHooks) MultiCommitteeHooks {
+	return hooks
+}
+
+// AfterProposalSubmission runs after a proposal is submitted
+func (h MultiCommitteeHooks) AfterProposalSubmission(ctx sdk.Context, proposalID uint64) {
+	for i := range h {
+		h[i].AfterProposalSubmission(ctx, proposalID)
+	}
+}
+
+// AfterProposalVote runs after a vote on a proposal is cast
+func (h MultiCommitteeHooks) AfterProposalVote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress) {
+	for i := range h {
+		h[i].AfterProposalVote(ctx, proposalID, voter)
+	}
+}
+
+// AfterProposalClosed runs after a proposal and its votes are deleted
+func (h MultiCommitteeHooks) AfterProposalClosed(ctx sdk.Context, proposal Proposal, outcome ProposalOutcome, tally *QueryTallyResponse) {
+	for i := range h {
+		h[i].AfterProposalClosed(ctx, proposal, outcome, tally)
+	}
+}
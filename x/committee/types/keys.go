@@ -4,6 +4,7 @@ import (
 	"encoding/binary"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -27,6 +28,11 @@ var (
 	VoteKeyPrefix      = []byte{0x02} // prefix for keys that store votes
 
 	NextProposalIDKey = []byte{0x03} // key for the next proposal id
+
+	QueuedProposalKeyPrefix   = []byte{0x04} // prefix for keys that store passed proposals queued for execution
+	VotingDelegationKeyPrefix = []byte{0x05} // prefix for keys that store token committee voting power delegations
+
+	VotingDelegationByRepresentativeKeyPrefix = []byte{0x06} // prefix for keys that index voting power delegations by representative
 )
 
 // GetKeyFromID returns the bytes to use as a key for a uint64 id
@@ -38,6 +44,12 @@ func GetVoteKey(proposalID uint64, voter sdk.AccAddress) []byte {
 	return append(GetKeyFromID(proposalID), voter.Bytes()...)
 }
 
+// GetVotingDelegationByRepresentativeKey returns the key that indexes the voting power delegation
+// of a delegator by its representative.
+func GetVotingDelegationByRepresentativeKey(representative, delegator sdk.AccAddress) []byte {
+	return append(address.MustLengthPrefix(representative), delegator...)
+}
+
 // Uint64ToBytes converts a uint64 into fixed length bytes for use in store keys.
 func uint64ToBytes(id uint64) []byte {
 	bz := make([]byte, 8)
@@ -2,21 +2,34 @@ package types
 
 import (
 	fmt "fmt"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/gogoproto/proto"
 )
 
 const (
 	TypeMsgSubmitProposal = "commmittee_submit_proposal" // 'committee' prefix appended to avoid potential conflicts with gov msg types
 	TypeMsgVote           = "committee_vote"
+	TypeMsgVetoProposal   = "committee_veto_proposal"
+
+	TypeMsgAddCommitteeMember    = "committee_add_member"
+	TypeMsgRemoveCommitteeMember = "committee_remove_member"
+	TypeMsgRotateCommitteeMember = "committee_rotate_member"
+	TypeMsgResignCommitteeMember = "committee_resign_member"
+
+	TypeMsgDelegateVotingPower   = "committee_delegate_voting_power"
+	TypeMsgUndelegateVotingPower = "committee_undelegate_voting_power"
 )
 
 var (
-	_, _ sdk.Msg                       = &MsgSubmitProposal{}, &MsgVote{}
-	_    types.UnpackInterfacesMessage = &MsgSubmitProposal{}
+	_, _, _    sdk.Msg                       = &MsgSubmitProposal{}, &MsgVote{}, &MsgVetoProposal{}
+	_, _, _, _ sdk.Msg                       = &MsgAddCommitteeMember{}, &MsgRemoveCommitteeMember{}, &MsgRotateCommitteeMember{}, &MsgResignCommitteeMember{}
+	_, _       sdk.Msg                       = &MsgDelegateVotingPower{}, &MsgUndelegateVotingPower{}
+	_          types.UnpackInterfacesMessage = &MsgSubmitProposal{}
 )
 
 // NewMsgSubmitProposal creates a new MsgSubmitProposal instance
@@ -151,3 +164,253 @@ func (msg MsgVote) GetVoter() sdk.AccAddress {
 	}
 	return address
 }
+
+// NewMsgVetoProposal creates a message to cancel a queued proposal before it is executed
+func NewMsgVetoProposal(authority sdk.AccAddress, proposalID uint64) *MsgVetoProposal {
+	return &MsgVetoProposal{Authority: authority.String(), ProposalID: proposalID}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgVetoProposal) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within events.
+func (msg MsgVetoProposal) Type() string { return TypeMsgVetoProposal }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgVetoProposal) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	return err
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgVetoProposal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgVetoProposal) GetSigners() []sdk.AccAddress {
+	return mustAccAddresses(msg.Authority)
+}
+
+// NewMsgAddCommitteeMember creates a message to add a member to a committee, or update the term of a member
+func NewMsgAddCommitteeMember(authority sdk.AccAddress, committeeID uint64, member sdk.AccAddress, termExpiry time.Time) *MsgAddCommitteeMember {
+	return &MsgAddCommitteeMember{
+		Authority:   authority.String(),
+		CommitteeID: committeeID,
+		Member:      member.String(),
+		TermExpiry:  termExpiry,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgAddCommitteeMember) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within events.
+func (msg MsgAddCommitteeMember) Type() string { return TypeMsgAddCommitteeMember }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgAddCommitteeMember) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return err
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Member)
+	return err
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgAddCommitteeMember) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgAddCommitteeMember) GetSigners() []sdk.AccAddress {
+	return mustAccAddresses(msg.Authority)
+}
+
+// NewMsgRemoveCommitteeMember creates a message to remove a member from a committee
+func NewMsgRemoveCommitteeMember(authority sdk.AccAddress, committeeID uint64, member sdk.AccAddress) *MsgRemoveCommitteeMember {
+	return &MsgRemoveCommitteeMember{
+		Authority:   authority.String(),
+		CommitteeID: committeeID,
+		Member:      member.String(),
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgRemoveCommitteeMember) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within events.
+func (msg MsgRemoveCommitteeMember) Type() string { return TypeMsgRemoveCommitteeMember }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgRemoveCommitteeMember) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return err
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Member)
+	return err
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgRemoveCommitteeMember) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgRemoveCommitteeMember) GetSigners() []sdk.AccAddress {
+	return mustAccAddresses(msg.Authority)
+}
+
+// NewMsgRotateCommitteeMember creates a message for a member to replace its address with a new address
+func NewMsgRotateCommitteeMember(member sdk.AccAddress, committeeID uint64, newMember sdk.AccAddress) *MsgRotateCommitteeMember {
+	return &MsgRotateCommitteeMember{
+		Member:      member.String(),
+		CommitteeID: committeeID,
+		NewMember:   newMember.String(),
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgRotateCommitteeMember) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within events.
+func (msg MsgRotateCommitteeMember) Type() string { return TypeMsgRotateCommitteeMember }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgRotateCommitteeMember) ValidateBasic() error {
+	member, err := sdk.AccAddressFromBech32(msg.Member)
+	if err != nil {
+		return err
+	}
+	newMember, err := sdk.AccAddressFromBech32(msg.NewMember)
+	if err != nil {
+		return err
+	}
+	if member.Equals(newMember) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "new member address must differ from the member address")
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgRotateCommitteeMember) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgRotateCommitteeMember) GetSigners() []sdk.AccAddress {
+	return mustAccAddresses(msg.Member)
+}
+
+// NewMsgResignCommitteeMember creates a message for a member to leave a committee
+func NewMsgResignCommitteeMember(member sdk.AccAddress, committeeID uint64) *MsgResignCommitteeMember {
+	return &MsgResignCommitteeMember{
+		Member:      member.String(),
+		CommitteeID: committeeID,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgResignCommitteeMember) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within events.
+func (msg MsgResignCommitteeMember) Type() string { return TypeMsgResignCommitteeMember }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgResignCommitteeMember) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Member)
+	return err
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgResignCommitteeMember) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgResignCommitteeMember) GetSigners() []sdk.AccAddress {
+	return mustAccAddresses(msg.Member)
+}
+
+// NewMsgDelegateVotingPower creates a message to delegate token committee voting power to a representative
+func NewMsgDelegateVotingPower(delegator, representative sdk.AccAddress) *MsgDelegateVotingPower {
+	return &MsgDelegateVotingPower{
+		Delegator:      delegator.String(),
+		Representative: representative.String(),
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgDelegateVotingPower) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within events.
+func (msg MsgDelegateVotingPower) Type() string { return TypeMsgDelegateVotingPower }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgDelegateVotingPower) ValidateBasic() error {
+	delegator, err := sdk.AccAddressFromBech32(msg.Delegator)
+	if err != nil {
+		return err
+	}
+	representative, err := sdk.AccAddressFromBech32(msg.Representative)
+	if err != nil {
+		return err
+	}
+	if delegator.Equals(representative) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "representative address must differ from the delegator address")
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgDelegateVotingPower) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgDelegateVotingPower) GetSigners() []sdk.AccAddress {
+	return mustAccAddresses(msg.Delegator)
+}
+
+// NewMsgUndelegateVotingPower creates a message to remove a token committee voting power delegation
+func NewMsgUndelegateVotingPower(delegator sdk.AccAddress) *MsgUndelegateVotingPower {
+	return &MsgUndelegateVotingPower{Delegator: delegator.String()}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgUndelegateVotingPower) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within events.
+func (msg MsgUndelegateVotingPower) Type() string { return TypeMsgUndelegateVotingPower }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgUndelegateVotingPower) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Delegator)
+	return err
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgUndelegateVotingPower) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgUndelegateVotingPower) GetSigners() []sdk.AccAddress {
+	return mustAccAddresses(msg.Delegator)
+}
+
+// mustAccAddresses returns the signer of a message, or no signers if the address is invalid
+func mustAccAddresses(address string) []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return []sdk.AccAddress{}
+	}
+	return []sdk.AccAddress{addr}
+}
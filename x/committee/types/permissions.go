@@ -6,6 +6,7 @@ import (
 	"reflect"
 	"strings"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
@@ -57,6 +58,7 @@ var (
 	_ Permission = CommunityCDPRepayDebtPermission{}
 	_ Permission = CommunityPoolLendWithdrawPermission{}
 	_ Permission = CommunityCDPWithdrawCollateralPermission{}
+	_ Permission = AllowedMsgsPermission{}
 )
 
 // Allows implement permission interface for GodPermission.
@@ -312,3 +314,78 @@ func (allowed AllowedParamsChange) allowsParamChange(ctx sdk.Context, paramsChan
 
 	return allowed.allowsSingleParamsChange(currentValue, changeValue)
 }
+
+// Allows implement permission interface for AllowedMsgsPermission.
+func (perm AllowedMsgsPermission) Allows(_ sdk.Context, _ ParamKeeper, p PubProposal) bool {
+	proposal, ok := p.(*MsgsProposal)
+	if !ok {
+		return false
+	}
+	msgs, err := proposal.GetMsgs()
+	if err != nil {
+		return false
+	}
+
+	// Check if all proposal messages are allowed by this permission.
+	for _, msg := range msgs {
+		// We allow the message if any of the AllowedMsgs with a matching type url allows it.
+		allowed := false
+		for _, am := range perm.AllowedMsgs {
+			if am.allowsMsg(msg) {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return false
+		}
+	}
+
+	return true
+}
+
+// allowsMsg returns true if the message has the type url of the AllowedMsg and meets all its field requirements.
+func (allowed AllowedMsg) allowsMsg(msg sdk.Msg) bool {
+	if sdk.MsgTypeURL(msg) != allowed.TypeURL {
+		return false
+	}
+	if len(allowed.FieldRequirements) == 0 {
+		return true
+	}
+
+	bz, err := codec.ProtoMarshalJSON(msg, nil)
+	if err != nil {
+		return false
+	}
+	var msgJSON map[string]interface{}
+	if err := json.Unmarshal(bz, &msgJSON); err != nil {
+		return false
+	}
+
+	for _, req := range allowed.FieldRequirements {
+		if !req.isMetBy(msgJSON) {
+			return false
+		}
+	}
+	return true
+}
+
+// isMetBy returns true if the field of the requirement has the required value in the JSON of a message.
+func (req MsgFieldRequirement) isMetBy(msgJSON map[string]interface{}) bool {
+	var value interface{} = msgJSON
+	for _, key := range strings.Split(req.Field, ".") {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		if value, ok = object[key]; !ok {
+			return false
+		}
+	}
+
+	var required interface{}
+	if err := json.Unmarshal([]byte(req.Value), &required); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(value, required)
+}
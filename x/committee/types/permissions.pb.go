@@ -430,6 +430,161 @@ func (m *SubparamRequirement) GetAllowedSubparamAttrChanges() []string {
 	return nil
 }
 
+// AllowedMsgsPermission allows MsgsProposals that only contain allowed messages.
+type AllowedMsgsPermission struct {
+	AllowedMsgs []AllowedMsg `protobuf:"bytes,1,rep,name=allowed_msgs,json=allowedMsgs,proto3" json:"allowed_msgs"`
+}
+
+func (m *AllowedMsgsPermission) Reset()         { *m = AllowedMsgsPermission{} }
+func (m *AllowedMsgsPermission) String() string { return proto.CompactTextString(m) }
+func (*AllowedMsgsPermission) ProtoMessage()    {}
+func (*AllowedMsgsPermission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bdfaf7be16465ae4, []int{9}
+}
+func (m *AllowedMsgsPermission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllowedMsgsPermission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllowedMsgsPermission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AllowedMsgsPermission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllowedMsgsPermission.Merge(m, src)
+}
+func (m *AllowedMsgsPermission) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllowedMsgsPermission) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllowedMsgsPermission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllowedMsgsPermission proto.InternalMessageInfo
+
+func (m *AllowedMsgsPermission) GetAllowedMsgs() []AllowedMsg {
+	if m != nil {
+		return m.AllowedMsgs
+	}
+	return nil
+}
+
+// AllowedMsg contains the type url of an allowed message and the requirements on its fields.
+type AllowedMsg struct {
+	// The type url of the message, eg /kava.community.v1beta1.MsgUpdateParams.
+	TypeURL string `protobuf:"bytes,1,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty"`
+	// Requirements on the fields of the message. A message is allowed if it meets all the requirements.
+	FieldRequirements []MsgFieldRequirement `protobuf:"bytes,2,rep,name=field_requirements,json=fieldRequirements,proto3" json:"field_requirements"`
+}
+
+func (m *AllowedMsg) Reset()         { *m = AllowedMsg{} }
+func (m *AllowedMsg) String() string { return proto.CompactTextString(m) }
+func (*AllowedMsg) ProtoMessage()    {}
+func (*AllowedMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bdfaf7be16465ae4, []int{10}
+}
+func (m *AllowedMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllowedMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllowedMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AllowedMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllowedMsg.Merge(m, src)
+}
+func (m *AllowedMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllowedMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllowedMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllowedMsg proto.InternalMessageInfo
+
+func (m *AllowedMsg) GetTypeURL() string {
+	if m != nil {
+		return m.TypeURL
+	}
+	return ""
+}
+
+func (m *AllowedMsg) GetFieldRequirements() []MsgFieldRequirement {
+	if m != nil {
+		return m.FieldRequirements
+	}
+	return nil
+}
+
+// MsgFieldRequirement requires a field of a message to have a value.
+type MsgFieldRequirement struct {
+	// The dot separated path of the field in the proto JSON of the message, eg params.staking_rewards_per_second.
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	// The required JSON value of the field.
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *MsgFieldRequirement) Reset()         { *m = MsgFieldRequirement{} }
+func (m *MsgFieldRequirement) String() string { return proto.CompactTextString(m) }
+func (*MsgFieldRequirement) ProtoMessage()    {}
+func (*MsgFieldRequirement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bdfaf7be16465ae4, []int{11}
+}
+func (m *MsgFieldRequirement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFieldRequirement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFieldRequirement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFieldRequirement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFieldRequirement.Merge(m, src)
+}
+func (m *MsgFieldRequirement) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFieldRequirement) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFieldRequirement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFieldRequirement proto.InternalMessageInfo
+
+func (m *MsgFieldRequirement) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *MsgFieldRequirement) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GodPermission)(nil), "kava.committee.v1beta1.GodPermission")
 	proto.RegisterType((*SoftwareUpgradePermission)(nil), "kava.committee.v1beta1.SoftwareUpgradePermission")
@@ -440,6 +595,9 @@ func init() {
 	proto.RegisterType((*ParamsChangePermission)(nil), "kava.committee.v1beta1.ParamsChangePermission")
 	proto.RegisterType((*AllowedParamsChange)(nil), "kava.committee.v1beta1.AllowedParamsChange")
 	proto.RegisterType((*SubparamRequirement)(nil), "kava.committee.v1beta1.SubparamRequirement")
+	proto.RegisterType((*AllowedMsgsPermission)(nil), "kava.committee.v1beta1.AllowedMsgsPermission")
+	proto.RegisterType((*AllowedMsg)(nil), "kava.committee.v1beta1.AllowedMsg")
+	proto.RegisterType((*MsgFieldRequirement)(nil), "kava.committee.v1beta1.MsgFieldRequirement")
 }
 
 func init() {
@@ -447,39 +605,47 @@ func init() {
 }
 
 var fileDescriptor_bdfaf7be16465ae4 = []byte{
-	// 507 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x4f, 0x8b, 0xd3, 0x40,
-	0x18, 0x87, 0x1b, 0xb3, 0x88, 0x3b, 0xe2, 0xb2, 0x64, 0x4b, 0xc9, 0x86, 0x35, 0x2d, 0xf5, 0x12,
-	0x28, 0x9b, 0x50, 0xc5, 0xcb, 0xde, 0xda, 0xae, 0x78, 0xf1, 0x50, 0xb2, 0x8a, 0xe0, 0x25, 0x4c,
-	0x9a, 0x31, 0x0d, 0x3b, 0xc9, 0xc4, 0x79, 0x27, 0xed, 0x16, 0x04, 0xbf, 0x82, 0x5f, 0x43, 0xcf,
-	0x7e, 0x88, 0xc5, 0xd3, 0x1e, 0x3d, 0xa9, 0xb4, 0x1f, 0xc3, 0x8b, 0xe4, 0x6f, 0x03, 0x1b, 0x72,
-	0x9b, 0x79, 0xf3, 0xfc, 0xde, 0xc9, 0x33, 0x2f, 0x83, 0x8c, 0x6b, 0xbc, 0xc2, 0xd6, 0x82, 0x85,
-	0x61, 0x20, 0x04, 0x21, 0xd6, 0x6a, 0xec, 0x12, 0x81, 0xc7, 0x56, 0x4c, 0x78, 0x18, 0x00, 0x04,
-	0x2c, 0x02, 0x33, 0xe6, 0x4c, 0x30, 0xa5, 0x97, 0x92, 0x66, 0x45, 0x9a, 0x05, 0xa9, 0x9d, 0x2e,
-	0x18, 0x84, 0x0c, 0x9c, 0x8c, 0xb2, 0xf2, 0x4d, 0x1e, 0xd1, 0xba, 0x3e, 0xf3, 0x59, 0x5e, 0x4f,
-	0x57, 0x79, 0x75, 0xd8, 0x47, 0x4f, 0x5e, 0x33, 0x6f, 0x5e, 0x1d, 0x70, 0x71, 0xf4, 0xf3, 0xc7,
-	0x39, 0xda, 0xef, 0x87, 0x23, 0x74, 0x7a, 0xc5, 0x3e, 0x8a, 0x35, 0xe6, 0xe4, 0x5d, 0xec, 0x73,
-	0xec, 0x91, 0x16, 0x78, 0x80, 0x8e, 0xde, 0x92, 0x1b, 0xd1, 0x42, 0x8c, 0x51, 0x7f, 0xc6, 0xc2,
-	0x30, 0x89, 0x02, 0xb1, 0x99, 0x5d, 0xce, 0x6d, 0x12, 0xe3, 0xcd, 0x25, 0x71, 0xdb, 0x22, 0x17,
-	0xc8, 0xa8, 0x47, 0xde, 0x07, 0x62, 0xe9, 0x71, 0xbc, 0x9e, 0x31, 0x4a, 0xb1, 0x20, 0x1c, 0xd3,
-	0x96, 0xec, 0x4b, 0xf4, 0xac, 0xca, 0xce, 0x19, 0xa3, 0x6f, 0x48, 0xe4, 0x95, 0x0d, 0x5a, 0x62,
-	0xdf, 0x24, 0xd4, 0x9b, 0x63, 0x8e, 0x43, 0x98, 0x2d, 0x71, 0xe4, 0xd7, 0x94, 0x95, 0x2f, 0xa8,
-	0x87, 0x29, 0x65, 0x6b, 0xe2, 0x39, 0x71, 0x46, 0x38, 0x8b, 0x0c, 0x01, 0x55, 0x1a, 0xc8, 0xc6,
-	0xe3, 0xe7, 0x23, 0xb3, 0x79, 0x34, 0xe6, 0x24, 0x4f, 0xd5, 0xdb, 0x4e, 0xcf, 0x6e, 0x7f, 0xf7,
-	0x3b, 0xdf, 0xff, 0xf4, 0xbb, 0x0d, 0x1f, 0xc1, 0xee, 0xe2, 0x86, 0xea, 0xbd, 0x7f, 0xfd, 0x27,
-	0xa1, 0x93, 0x86, 0xb8, 0xa2, 0xa1, 0x47, 0x90, 0xb8, 0x10, 0xe3, 0x05, 0x51, 0xa5, 0x81, 0x64,
-	0x1c, 0xda, 0xd5, 0x5e, 0x39, 0x46, 0xf2, 0x35, 0xd9, 0xa8, 0x0f, 0xb2, 0x72, 0xba, 0x54, 0x26,
-	0xe8, 0x29, 0x04, 0x91, 0x4f, 0x89, 0x03, 0x89, 0x9b, 0x89, 0x39, 0xa5, 0x26, 0x16, 0x82, 0x83,
-	0x2a, 0x0f, 0x64, 0xe3, 0xd0, 0xd6, 0x72, 0xe8, 0xaa, 0x60, 0x8a, 0x73, 0x27, 0x29, 0xa1, 0x00,
-	0x3a, 0x0b, 0x13, 0x2a, 0x82, 0xaa, 0x03, 0x38, 0x9c, 0x7c, 0x4a, 0x02, 0x4e, 0x42, 0x12, 0x09,
-	0x50, 0x0f, 0xda, 0xef, 0xa7, 0xec, 0x69, 0xef, 0x33, 0xd3, 0x83, 0xf4, 0x7e, 0x6c, 0x2d, 0x6b,
-	0x5b, 0x7e, 0x87, 0x1a, 0x00, 0xc3, 0xcf, 0xe8, 0xa4, 0x21, 0x58, 0x0a, 0x4a, 0x7b, 0xc1, 0x63,
-	0x24, 0xaf, 0x30, 0x2d, 0x95, 0x57, 0x98, 0xa6, 0xca, 0xa5, 0xe2, 0xde, 0x59, 0x08, 0x5e, 0x0d,
-	0xb4, 0x50, 0x2e, 0xa0, 0xca, 0x59, 0x08, 0x5e, 0xcc, 0x62, 0xfa, 0xea, 0x76, 0xab, 0x4b, 0x77,
-	0x5b, 0x5d, 0xfa, 0xbb, 0xd5, 0xa5, 0xaf, 0x3b, 0xbd, 0x73, 0xb7, 0xd3, 0x3b, 0xbf, 0x76, 0x7a,
-	0xe7, 0xc3, 0xc8, 0x0f, 0xc4, 0x32, 0x71, 0x53, 0x4f, 0x2b, 0x15, 0x3e, 0xa7, 0xd8, 0x85, 0x6c,
-	0x65, 0xdd, 0xd4, 0x5e, 0xb8, 0xd8, 0xc4, 0x04, 0xdc, 0x87, 0xd9, 0x5b, 0x7c, 0xf1, 0x3f, 0x00,
-	0x00, 0xff, 0xff, 0x64, 0xe8, 0xa8, 0x0a, 0x00, 0x04, 0x00, 0x00,
+	// 626 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0x4f, 0x4f, 0x13, 0x41,
+	0x18, 0xc6, 0xbb, 0x14, 0x05, 0x5e, 0x94, 0xe0, 0x52, 0x49, 0x69, 0xb0, 0x6d, 0x6a, 0x62, 0x9a,
+	0x10, 0xda, 0xa0, 0xf1, 0xc2, 0xad, 0x2d, 0xea, 0x41, 0x48, 0x9a, 0x05, 0x62, 0xe2, 0x65, 0x9d,
+	0x6d, 0x87, 0x65, 0xc3, 0x6c, 0x67, 0x9d, 0x77, 0xb6, 0xd0, 0xc4, 0xc4, 0xaf, 0xe0, 0xc9, 0xef,
+	0xa0, 0x67, 0x3f, 0x04, 0xf1, 0xc4, 0xd1, 0x13, 0x9a, 0xf2, 0x31, 0xbc, 0x98, 0xd9, 0x7f, 0x5d,
+	0x65, 0xb3, 0xde, 0xe6, 0x7d, 0xe7, 0xf7, 0xbc, 0x3b, 0xcf, 0x3c, 0x93, 0x85, 0xe6, 0x19, 0x19,
+	0x93, 0xf6, 0x80, 0xbb, 0xae, 0x23, 0x25, 0xa5, 0xed, 0xf1, 0x8e, 0x45, 0x25, 0xd9, 0x69, 0x7b,
+	0x54, 0xb8, 0x0e, 0xa2, 0xc3, 0x47, 0xd8, 0xf2, 0x04, 0x97, 0x5c, 0x5f, 0x57, 0x64, 0x2b, 0x21,
+	0x5b, 0x11, 0x59, 0xd9, 0x18, 0x70, 0x74, 0x39, 0x9a, 0x01, 0xd5, 0x0e, 0x8b, 0x50, 0x52, 0x29,
+	0xd9, 0xdc, 0xe6, 0x61, 0x5f, 0xad, 0xc2, 0x6e, 0xa3, 0x06, 0xf7, 0x5f, 0xf1, 0x61, 0x3f, 0xf9,
+	0xc0, 0xee, 0xca, 0xf7, 0x6f, 0xdb, 0x30, 0xab, 0x1b, 0x5b, 0xb0, 0x71, 0xc8, 0x4f, 0xe4, 0x39,
+	0x11, 0xf4, 0xd8, 0xb3, 0x05, 0x19, 0xd2, 0x1c, 0xb8, 0x0e, 0x2b, 0x47, 0xf4, 0x42, 0xe6, 0x10,
+	0x3b, 0x50, 0xeb, 0x71, 0xd7, 0xf5, 0x47, 0x8e, 0x9c, 0xf4, 0xf6, 0xfa, 0x06, 0xf5, 0xc8, 0x64,
+	0x8f, 0x5a, 0x79, 0x92, 0x5d, 0x68, 0xa6, 0x25, 0x6f, 0x1c, 0x79, 0x3a, 0x14, 0xe4, 0xbc, 0xc7,
+	0x19, 0x23, 0x92, 0x0a, 0xc2, 0x72, 0xb4, 0xcf, 0xe1, 0x71, 0xa2, 0xed, 0x73, 0xce, 0xf6, 0xe9,
+	0x68, 0x18, 0x0f, 0xc8, 0x91, 0x7d, 0xd1, 0x60, 0xbd, 0x4f, 0x04, 0x71, 0xb1, 0x77, 0x4a, 0x46,
+	0x76, 0xca, 0xb2, 0xfe, 0x11, 0xd6, 0x09, 0x63, 0xfc, 0x9c, 0x0e, 0x4d, 0x2f, 0x20, 0xcc, 0x41,
+	0x80, 0x60, 0x59, 0xab, 0x17, 0x9b, 0xcb, 0x4f, 0xb7, 0x5a, 0xd9, 0xd1, 0xb4, 0x3a, 0xa1, 0x2a,
+	0x3d, 0xb6, 0xbb, 0x79, 0x79, 0x5d, 0x2b, 0x7c, 0xfd, 0x59, 0x2b, 0x65, 0x6c, 0xa2, 0x51, 0x22,
+	0x19, 0xdd, 0x5b, 0x67, 0xfd, 0xad, 0xc1, 0x5a, 0x86, 0x5c, 0xaf, 0xc0, 0x22, 0xfa, 0x16, 0x7a,
+	0x64, 0x40, 0xcb, 0x5a, 0x5d, 0x6b, 0x2e, 0x19, 0x49, 0xad, 0xaf, 0x42, 0xf1, 0x8c, 0x4e, 0xca,
+	0x73, 0x41, 0x5b, 0x2d, 0xf5, 0x0e, 0x3c, 0x42, 0x67, 0x64, 0x33, 0x6a, 0xa2, 0x6f, 0x05, 0xc6,
+	0xcc, 0xd8, 0x26, 0x91, 0x52, 0x60, 0xb9, 0x58, 0x2f, 0x36, 0x97, 0x8c, 0x4a, 0x08, 0x1d, 0x46,
+	0x4c, 0xf4, 0xdd, 0x8e, 0x22, 0x74, 0x84, 0x4d, 0xd7, 0x67, 0xd2, 0x49, 0x26, 0xa0, 0x29, 0xe8,
+	0x7b, 0xdf, 0x11, 0xd4, 0xa5, 0x23, 0x89, 0xe5, 0xf9, 0xfc, 0xfb, 0x89, 0x67, 0x1a, 0x33, 0x4d,
+	0x77, 0x5e, 0xdd, 0x8f, 0x51, 0x09, 0xc6, 0xc6, 0xfb, 0x98, 0x02, 0xb0, 0xf1, 0x01, 0xd6, 0x32,
+	0x84, 0xb1, 0x41, 0x6d, 0x66, 0x70, 0x15, 0x8a, 0x63, 0xc2, 0x62, 0xcb, 0x63, 0xc2, 0x94, 0xe5,
+	0xd8, 0xe2, 0xcc, 0xb3, 0x94, 0x22, 0x09, 0x34, 0xb2, 0x1c, 0x41, 0x89, 0x67, 0x29, 0x45, 0x94,
+	0x45, 0x43, 0xc2, 0xc3, 0xe8, 0x0a, 0x0e, 0xd0, 0xc6, 0xd4, 0x2b, 0x79, 0x0d, 0xf7, 0xe2, 0xd9,
+	0x2e, 0xda, 0xf1, 0xdb, 0x68, 0xfc, 0xe7, 0x6d, 0x1c, 0xa0, 0x1d, 0x59, 0x5e, 0x26, 0xb3, 0xb1,
+	0xb7, 0x12, 0xff, 0xac, 0x01, 0xcc, 0x14, 0xfa, 0x13, 0x58, 0x94, 0x13, 0x8f, 0x9a, 0xbe, 0x60,
+	0xa1, 0xe1, 0xee, 0xf2, 0xf4, 0xba, 0xb6, 0x70, 0x34, 0xf1, 0xe8, 0xb1, 0xb1, 0x6f, 0x2c, 0xa8,
+	0xcd, 0x63, 0xc1, 0xf4, 0x77, 0xa0, 0x9f, 0x38, 0x94, 0x0d, 0xff, 0x4e, 0x65, 0x2e, 0x3f, 0x95,
+	0x03, 0xb4, 0x5f, 0x2a, 0xd1, 0xed, 0x54, 0x1e, 0x9c, 0xfc, 0xd3, 0xc7, 0x46, 0x07, 0xd6, 0x32,
+	0x78, 0xbd, 0x04, 0x77, 0x02, 0x36, 0x8a, 0x23, 0x2c, 0x54, 0x77, 0x4c, 0x98, 0x4f, 0xa3, 0x48,
+	0xc2, 0xa2, 0xfb, 0xe2, 0x72, 0x5a, 0xd5, 0xae, 0xa6, 0x55, 0xed, 0xd7, 0xb4, 0xaa, 0x7d, 0xba,
+	0xa9, 0x16, 0xae, 0x6e, 0xaa, 0x85, 0x1f, 0x37, 0xd5, 0xc2, 0xdb, 0x2d, 0xdb, 0x91, 0xa7, 0xbe,
+	0xa5, 0xce, 0xd8, 0x56, 0x87, 0xdd, 0x66, 0xc4, 0xc2, 0x60, 0xd5, 0xbe, 0x48, 0xfd, 0x33, 0x95,
+	0x5b, 0xb4, 0xee, 0x06, 0x7f, 0xb7, 0x67, 0x7f, 0x06, 0x00, 0x22, 0xbc, 0x81, 0xe7, 0x52, 0x05,
+	0x00, 0x00,
 }
 
 func (m *GodPermission) Marshal() (dAtA []byte, err error) {
@@ -763,6 +929,124 @@ func (m *SubparamRequirement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AllowedMsgsPermission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllowedMsgsPermission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllowedMsgsPermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedMsgs) > 0 {
+		for iNdEx := len(m.AllowedMsgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllowedMsgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPermissions(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AllowedMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllowedMsg) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllowedMsg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FieldRequirements) > 0 {
+		for iNdEx := len(m.FieldRequirements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FieldRequirements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPermissions(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.TypeURL) > 0 {
+		i -= len(m.TypeURL)
+		copy(dAtA[i:], m.TypeURL)
+		i = encodeVarintPermissions(dAtA, i, uint64(len(m.TypeURL)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFieldRequirement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFieldRequirement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFieldRequirement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintPermissions(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintPermissions(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintPermissions(dAtA []byte, offset int, v uint64) int {
 	offset -= sovPermissions(v)
 	base := offset
@@ -895,6 +1179,57 @@ func (m *SubparamRequirement) Size() (n int) {
 	return n
 }
 
+func (m *AllowedMsgsPermission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedMsgs) > 0 {
+		for _, e := range m.AllowedMsgs {
+			l = e.Size()
+			n += 1 + l + sovPermissions(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AllowedMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TypeURL)
+	if l > 0 {
+		n += 1 + l + sovPermissions(uint64(l))
+	}
+	if len(m.FieldRequirements) > 0 {
+		for _, e := range m.FieldRequirements {
+			l = e.Size()
+			n += 1 + l + sovPermissions(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgFieldRequirement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovPermissions(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovPermissions(uint64(l))
+	}
+	return n
+}
+
 func sovPermissions(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1611,6 +1946,320 @@ func (m *SubparamRequirement) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AllowedMsgsPermission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPermissions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllowedMsgsPermission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllowedMsgsPermission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMsgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedMsgs = append(m.AllowedMsgs, AllowedMsg{})
+			if err := m.AllowedMsgs[len(m.AllowedMsgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPermissions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllowedMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPermissions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllowedMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllowedMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldRequirements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FieldRequirements = append(m.FieldRequirements, MsgFieldRequirement{})
+			if err := m.FieldRequirements[len(m.FieldRequirements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPermissions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFieldRequirement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPermissions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFieldRequirement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFieldRequirement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPermissions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipPermissions(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
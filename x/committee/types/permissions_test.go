@@ -1,12 +1,15 @@
 package types_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	paramsproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 
@@ -293,6 +296,78 @@ func TestParamsChangePermission_SimpleParamsChange_Allows(t *testing.T) {
 	}
 }
 
+func TestAllowedMsgsPermission_Allows(t *testing.T) {
+	committeeAddr := authtypes.NewModuleAddress(types.ModuleName)
+	recipient := sdk.AccAddress("recipient___________")
+	send := banktypes.NewMsgSend(committeeAddr, recipient, sdk.NewCoins(sdk.NewInt64Coin("ukava", 1e6)))
+	otherSend := banktypes.NewMsgSend(committeeAddr, sdk.AccAddress("other_______________"), sdk.NewCoins(sdk.NewInt64Coin("ukava", 1e6)))
+	multiSend := banktypes.NewMsgMultiSend(
+		[]banktypes.Input{banktypes.NewInput(committeeAddr, send.Amount)},
+		[]banktypes.Output{banktypes.NewOutput(recipient, send.Amount)},
+	)
+
+	permission := types.AllowedMsgsPermission{
+		AllowedMsgs: []types.AllowedMsg{
+			{
+				TypeURL: sdk.MsgTypeURL(send),
+				FieldRequirements: []types.MsgFieldRequirement{
+					{Field: "to_address", Value: fmt.Sprintf("%q", recipient.String())},
+					{Field: "amount", Value: `[{"denom": "ukava", "amount": "1000000"}]`},
+				},
+			},
+			{TypeURL: sdk.MsgTypeURL(&banktypes.MsgUpdateParams{})},
+		},
+	}
+
+	testcases := []struct {
+		name     string
+		proposal types.PubProposal
+		allowed  bool
+	}{
+		{
+			name:     "allowed for msg meeting field requirements",
+			proposal: newTestMsgsProposal(send),
+			allowed:  true,
+		},
+		{
+			name:     "allowed for msg without field requirements",
+			proposal: newTestMsgsProposal(send, &banktypes.MsgUpdateParams{Authority: committeeAddr.String()}),
+			allowed:  true,
+		},
+		{
+			name:     "fails for msg not meeting field requirements",
+			proposal: newTestMsgsProposal(otherSend),
+			allowed:  false,
+		},
+		{
+			name:     "fails if any msg is not allowed",
+			proposal: newTestMsgsProposal(send, multiSend),
+			allowed:  false,
+		},
+		{
+			name:     "fails for nil proposal",
+			proposal: nil,
+			allowed:  false,
+		},
+		{
+			name:     "fails for wrong proposal",
+			proposal: govv1beta1.NewTextProposal("A Title", "A description of this proposal."),
+			allowed:  false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.allowed, permission.Allows(sdk.Context{}, nil, tc.proposal))
+		})
+	}
+}
+
+func newTestMsgsProposal(msgs ...sdk.Msg) types.PubProposal {
+	proposal := types.MustNewMsgsProposal("A Title", "A description of this proposal.", msgs)
+	return &proposal
+}
+
 func newTestParamsChangeProposalWithChanges(changes []paramsproposal.ParamChange) types.PubProposal {
 	return paramsproposal.NewParameterChangeProposal(
 		"A Title",
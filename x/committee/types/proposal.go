@@ -3,12 +3,17 @@ package types
 import (
 	errorsmod "cosmossdk.io/errors"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 )
 
 const (
 	ProposalTypeCommitteeChange = "CommitteeChange"
 	ProposalTypeCommitteeDelete = "CommitteeDelete"
+	ProposalTypeMsgs            = "Msgs"
 )
 
 // ProposalOutcome indicates the status of a proposal when it's closed and deleted from the store
@@ -21,12 +26,15 @@ const (
 	Failed
 	// Invalid indicates that proposal passed but an error occurred when attempting to enact it
 	Invalid
+	// Vetoed indicates that the proposal passed but was vetoed while queued for execution
+	Vetoed
 )
 
 var toString = map[ProposalOutcome]string{
 	Passed:  "Passed",
 	Failed:  "Failed",
 	Invalid: "Invalid",
+	Vetoed:  "Vetoed",
 }
 
 func (p ProposalOutcome) String() string {
@@ -34,16 +42,17 @@ func (p ProposalOutcome) String() string {
 }
 
 // ensure proposal types fulfill the PubProposal interface and the gov Content interface.
-var _, _ govv1beta1.Content = &CommitteeChangeProposal{}, &CommitteeDeleteProposal{}
-var _, _ PubProposal = &CommitteeChangeProposal{}, &CommitteeDeleteProposal{}
+var _, _, _ govv1beta1.Content = &CommitteeChangeProposal{}, &CommitteeDeleteProposal{}, &MsgsProposal{}
+var _, _, _ PubProposal = &CommitteeChangeProposal{}, &CommitteeDeleteProposal{}, &MsgsProposal{}
 
-// ensure CommitteeChangeProposal fulfill the codectypes.UnpackInterfacesMessage interface
-var _ codectypes.UnpackInterfacesMessage = &CommitteeChangeProposal{}
+// ensure CommitteeChangeProposal and MsgsProposal fulfill the codectypes.UnpackInterfacesMessage interface
+var _, _ codectypes.UnpackInterfacesMessage = &CommitteeChangeProposal{}, &MsgsProposal{}
 
 func init() {
 	// Gov proposals need to be registered on gov's ModuleCdc so MsgSubmitProposal can be encoded.
 	govv1beta1.RegisterProposalType(ProposalTypeCommitteeChange)
 	govv1beta1.RegisterProposalType(ProposalTypeCommitteeDelete)
+	govv1beta1.RegisterProposalType(ProposalTypeMsgs)
 }
 
 func NewCommitteeChangeProposal(title string, description string, newCommittee Committee) (CommitteeChangeProposal, error) {
@@ -132,3 +141,78 @@ func (cdp CommitteeDeleteProposal) ProposalType() string { return ProposalTypeCo
 func (cdp CommitteeDeleteProposal) ValidateBasic() error {
 	return govv1beta1.ValidateAbstract(&cdp)
 }
+
+func NewMsgsProposal(title string, description string, msgs []sdk.Msg) (MsgsProposal, error) {
+	msgsAny, err := sdktx.SetMsgs(msgs)
+	if err != nil {
+		return MsgsProposal{}, err
+	}
+	return MsgsProposal{
+		Title:       title,
+		Description: description,
+		Messages:    msgsAny,
+	}, nil
+}
+
+func MustNewMsgsProposal(title string, description string, msgs []sdk.Msg) MsgsProposal {
+	proposal, err := NewMsgsProposal(title, description, msgs)
+	if err != nil {
+		panic(err)
+	}
+	return proposal
+}
+
+// GetTitle returns the title of the proposal.
+func (mp MsgsProposal) GetTitle() string { return mp.Title }
+
+// GetDescription returns the description of the proposal.
+func (mp MsgsProposal) GetDescription() string { return mp.Description }
+
+// ProposalRoute returns the routing key of the proposal.
+func (mp MsgsProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal.
+func (mp MsgsProposal) ProposalType() string { return ProposalTypeMsgs }
+
+// GetMsgs returns the messages of the proposal.
+func (mp MsgsProposal) GetMsgs() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(mp.Messages, "committee msgs proposal")
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (mp MsgsProposal) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, mp.Messages)
+}
+
+// ValidateBasic runs basic stateless validity checks. All messages must be
+// valid and signed only by the committee module account, or by the x/gov module
+// account to execute messages that require the authority of a module.
+func (mp MsgsProposal) ValidateBasic() error {
+	if err := govv1beta1.ValidateAbstract(&mp); err != nil {
+		return err
+	}
+	if len(mp.Messages) == 0 {
+		return errorsmod.Wrap(ErrInvalidPubProposal, "proposal must contain at least one message")
+	}
+	msgs, err := mp.GetMsgs()
+	if err != nil {
+		return errorsmod.Wrap(ErrInvalidPubProposal, err.Error())
+	}
+
+	moduleAddress := authtypes.NewModuleAddress(ModuleName)
+	govAddress := authtypes.NewModuleAddress(govtypes.ModuleName)
+	for i, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(ErrInvalidPubProposal, "msg %d: %s", i, err)
+		}
+		signers := msg.GetSigners()
+		if len(signers) != 1 || !(signers[0].Equals(moduleAddress) || signers[0].Equals(govAddress)) {
+			return errorsmod.Wrapf(
+				ErrInvalidPubProposal,
+				"msg %d: expected committee module account %s or x/gov module account %s as the only signer",
+				i, moduleAddress, govAddress,
+			)
+		}
+	}
+	return nil
+}
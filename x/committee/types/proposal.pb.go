@@ -105,9 +105,50 @@ func (m *CommitteeDeleteProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CommitteeDeleteProposal proto.InternalMessageInfo
 
+// MsgsProposal is a committee proposal that executes a list of messages signed by the committee module account.
+type MsgsProposal struct {
+	Title       string       `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string       `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Messages    []*types.Any `protobuf:"bytes,3,rep,name=messages,proto3" json:"messages,omitempty"`
+}
+
+func (m *MsgsProposal) Reset()         { *m = MsgsProposal{} }
+func (m *MsgsProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgsProposal) ProtoMessage()    {}
+func (*MsgsProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4886de4a6c720e57, []int{2}
+}
+func (m *MsgsProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgsProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgsProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgsProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgsProposal.Merge(m, src)
+}
+func (m *MsgsProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgsProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgsProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgsProposal proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*CommitteeChangeProposal)(nil), "kava.committee.v1beta1.CommitteeChangeProposal")
 	proto.RegisterType((*CommitteeDeleteProposal)(nil), "kava.committee.v1beta1.CommitteeDeleteProposal")
+	proto.RegisterType((*MsgsProposal)(nil), "kava.committee.v1beta1.MsgsProposal")
 }
 
 func init() {
@@ -115,29 +156,32 @@ func init() {
 }
 
 var fileDescriptor_4886de4a6c720e57 = []byte{
-	// 348 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0xbf, 0x4e, 0x02, 0x41,
-	0x10, 0xc6, 0xef, 0xfc, 0x97, 0x70, 0x07, 0x31, 0xb9, 0x10, 0x05, 0x8a, 0x95, 0x90, 0x98, 0x90,
-	0x18, 0x76, 0x03, 0x76, 0x76, 0x02, 0x85, 0x74, 0x86, 0xd2, 0x86, 0xec, 0xc1, 0xb8, 0x5c, 0x3c,
-	0x76, 0x2e, 0xdc, 0x02, 0xf2, 0x16, 0xbe, 0x84, 0x6f, 0x40, 0xe7, 0x0b, 0x10, 0x2a, 0x4a, 0x2b,
-	0xa3, 0xc7, 0x8b, 0x98, 0xfb, 0xc3, 0x86, 0xce, 0xc2, 0x6e, 0xbe, 0x6f, 0xbe, 0xcb, 0xfc, 0x6e,
-	0x66, 0xad, 0xeb, 0x17, 0x3e, 0xe7, 0x6c, 0x88, 0x93, 0x89, 0xa7, 0x14, 0x00, 0x9b, 0x37, 0x5d,
-	0x50, 0xbc, 0xc9, 0x82, 0x29, 0x06, 0x18, 0x72, 0x9f, 0x06, 0x53, 0x54, 0xe8, 0x5c, 0xc4, 0x31,
-	0xaa, 0x63, 0x34, 0x8b, 0x55, 0xca, 0x43, 0x0c, 0x27, 0x18, 0x0e, 0x92, 0x14, 0x4b, 0x45, 0xfa,
-	0x49, 0xa5, 0x28, 0x50, 0x60, 0xea, 0xc7, 0x55, 0xe6, 0x96, 0x05, 0xa2, 0xf0, 0x81, 0x25, 0xca,
-	0x9d, 0x3d, 0x33, 0x2e, 0x97, 0x69, 0xab, 0xf6, 0x61, 0x5a, 0x97, 0x9d, 0xfd, 0x84, 0xce, 0x98,
-	0x4b, 0x01, 0x8f, 0x19, 0x85, 0x53, 0xb4, 0x4e, 0x95, 0xa7, 0x7c, 0x28, 0x99, 0x55, 0xb3, 0x9e,
-	0xeb, 0xa7, 0xc2, 0xa9, 0x5a, 0xf6, 0x08, 0xc2, 0xe1, 0xd4, 0x0b, 0x94, 0x87, 0xb2, 0x74, 0x94,
-	0xf4, 0x0e, 0x2d, 0xe7, 0xc1, 0x2a, 0x48, 0x58, 0x0c, 0x34, 0x78, 0xe9, 0xb8, 0x6a, 0xd6, 0xed,
-	0x56, 0x91, 0xa6, 0x18, 0x74, 0x8f, 0x41, 0xef, 0xe5, 0xb2, 0x5d, 0xd8, 0xac, 0x1a, 0x39, 0x4d,
-	0xd0, 0xcf, 0x4b, 0x58, 0x68, 0x75, 0x47, 0x36, 0xab, 0x46, 0x25, 0xfb, 0x41, 0x81, 0xf3, 0xfd,
-	0x06, 0x68, 0x07, 0xa5, 0x02, 0xa9, 0x6a, 0xef, 0x87, 0xf4, 0x5d, 0xf0, 0x41, 0xfd, 0x9f, 0xbe,
-	0x65, 0xe5, 0x35, 0xf9, 0xc0, 0x1b, 0x25, 0xf0, 0x27, 0xed, 0xf3, 0xe8, 0xeb, 0xca, 0xd6, 0xa3,
-	0x7a, 0xdd, 0xbe, 0xad, 0x43, 0xbd, 0xd1, 0x5f, 0x9c, 0xed, 0xde, 0xfa, 0x87, 0x18, 0xeb, 0x88,
-	0x98, 0xdb, 0x88, 0x98, 0xdf, 0x11, 0x31, 0xdf, 0x76, 0xc4, 0xd8, 0xee, 0x88, 0xf1, 0xb9, 0x23,
-	0xc6, 0xd3, 0x8d, 0xf0, 0xd4, 0x78, 0xe6, 0xc6, 0x97, 0x66, 0xf1, 0xc9, 0x1b, 0x3e, 0x77, 0xc3,
-	0xa4, 0x62, 0xaf, 0x07, 0xaf, 0x44, 0x2d, 0x03, 0x08, 0xdd, 0xb3, 0x64, 0x7b, 0xb7, 0xbf, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0x58, 0x7f, 0x45, 0x9a, 0x44, 0x02, 0x00, 0x00,
+	// 390 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0xcd, 0xee, 0xd2, 0x40,
+	0x14, 0xc5, 0x3b, 0xa2, 0xc6, 0xff, 0x14, 0x62, 0xd2, 0x10, 0x29, 0x98, 0x8c, 0x0d, 0x89, 0x09,
+	0x89, 0xe9, 0x4c, 0xc0, 0x9d, 0x3b, 0x81, 0x85, 0x2c, 0x48, 0x4c, 0x97, 0x6e, 0xc8, 0x14, 0xc6,
+	0xa1, 0xb1, 0x9d, 0x69, 0x98, 0x01, 0xe4, 0x2d, 0x7c, 0x09, 0xdf, 0x00, 0x57, 0xbe, 0x00, 0x61,
+	0xc5, 0xd2, 0x95, 0xd1, 0xf2, 0x22, 0xa6, 0x1f, 0x4c, 0xd8, 0x18, 0x16, 0xec, 0xee, 0xb9, 0xf7,
+	0xb4, 0xf7, 0x37, 0x37, 0x07, 0xbe, 0xfe, 0x42, 0x37, 0x94, 0xcc, 0x65, 0x92, 0x44, 0x5a, 0x33,
+	0x46, 0x36, 0xfd, 0x90, 0x69, 0xda, 0x27, 0xe9, 0x4a, 0xa6, 0x52, 0xd1, 0x18, 0xa7, 0x2b, 0xa9,
+	0xa5, 0xf3, 0x22, 0xb7, 0x61, 0x63, 0xc3, 0x95, 0xad, 0xd3, 0x9e, 0x4b, 0x95, 0x48, 0x35, 0x2b,
+	0x5c, 0xa4, 0x14, 0xe5, 0x27, 0x9d, 0x26, 0x97, 0x5c, 0x96, 0xfd, 0xbc, 0xaa, 0xba, 0x6d, 0x2e,
+	0x25, 0x8f, 0x19, 0x29, 0x54, 0xb8, 0xfe, 0x4c, 0xa8, 0xd8, 0x95, 0xa3, 0xee, 0x4f, 0x00, 0x5b,
+	0xa3, 0xcb, 0x86, 0xd1, 0x92, 0x0a, 0xce, 0x3e, 0x56, 0x14, 0x4e, 0x13, 0x3e, 0xd1, 0x91, 0x8e,
+	0x99, 0x0b, 0x3c, 0xd0, 0x7b, 0x08, 0x4a, 0xe1, 0x78, 0xd0, 0x5e, 0x30, 0x35, 0x5f, 0x45, 0xa9,
+	0x8e, 0xa4, 0x70, 0x1f, 0x15, 0xb3, 0xeb, 0x96, 0xf3, 0x01, 0x36, 0x04, 0xdb, 0xce, 0x0c, 0xb8,
+	0x5b, 0xf3, 0x40, 0xcf, 0x1e, 0x34, 0x71, 0x89, 0x81, 0x2f, 0x18, 0xf8, 0xbd, 0xd8, 0x0d, 0x1b,
+	0xc7, 0xbd, 0xff, 0x60, 0x08, 0x82, 0xba, 0x60, 0x5b, 0xa3, 0xde, 0xa1, 0xe3, 0xde, 0xef, 0x54,
+	0x0f, 0xe4, 0x72, 0x73, 0xb9, 0x00, 0x1e, 0x49, 0xa1, 0x99, 0xd0, 0xdd, 0xef, 0xd7, 0xf4, 0x63,
+	0x16, 0x33, 0x7d, 0x3f, 0xfd, 0x00, 0xd6, 0x0d, 0xf9, 0x2c, 0x5a, 0x14, 0xf0, 0x8f, 0x87, 0xcf,
+	0xb3, 0xdf, 0xaf, 0x6c, 0xb3, 0x6a, 0x32, 0x0e, 0x6c, 0x63, 0x9a, 0x2c, 0x6e, 0x72, 0xfe, 0x00,
+	0xb0, 0x3e, 0x55, 0x5c, 0xdd, 0x0d, 0x37, 0x85, 0xcf, 0x12, 0xa6, 0x14, 0xe5, 0x4c, 0xb9, 0x35,
+	0xaf, 0xf6, 0xdf, 0xab, 0xbe, 0x3c, 0xee, 0xfd, 0x56, 0x05, 0x14, 0x52, 0x65, 0xb2, 0x83, 0xa7,
+	0x8a, 0x07, 0xe6, 0x17, 0xb7, 0xb8, 0x87, 0x93, 0xc3, 0x5f, 0x64, 0x1d, 0x32, 0x04, 0x4e, 0x19,
+	0x02, 0x7f, 0x32, 0x04, 0xbe, 0x9d, 0x91, 0x75, 0x3a, 0x23, 0xeb, 0xd7, 0x19, 0x59, 0x9f, 0xde,
+	0xf0, 0x48, 0x2f, 0xd7, 0x61, 0x9e, 0x50, 0x92, 0x47, 0xd5, 0x8f, 0x69, 0xa8, 0x8a, 0x8a, 0x7c,
+	0xbd, 0x4a, 0xb7, 0xde, 0xa5, 0x4c, 0x85, 0x4f, 0x0b, 0xbe, 0xb7, 0xff, 0x06, 0x00, 0x8f, 0xc1,
+	0x2a, 0x76, 0xfc, 0x02, 0x00, 0x00,
 }
 
 func (m *CommitteeChangeProposal) Marshal() (dAtA []byte, err error) {
@@ -231,6 +275,57 @@ func (m *CommitteeDeleteProposal) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgsProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgsProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgsProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProposal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintProposal(dAtA []byte, offset int, v uint64) int {
 	offset -= sovProposal(v)
 	base := offset
@@ -283,6 +378,29 @@ func (m *CommitteeDeleteProposal) Size() (n int) {
 	return n
 }
 
+func (m *MsgsProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovProposal(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -572,6 +690,154 @@ func (m *CommitteeDeleteProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgsProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgsProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &types.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipProposal(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
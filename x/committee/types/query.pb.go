@@ -343,6 +343,81 @@ func (m *QueryProposalResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryProposalResponse proto.InternalMessageInfo
 
+// QueryQueuedProposalsRequest defines the request type for querying x/committee queued proposals.
+type QueryQueuedProposalsRequest struct {
+}
+
+func (m *QueryQueuedProposalsRequest) Reset()         { *m = QueryQueuedProposalsRequest{} }
+func (m *QueryQueuedProposalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryQueuedProposalsRequest) ProtoMessage()    {}
+func (*QueryQueuedProposalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b81d271efeb6eee5, []int{8}
+}
+func (m *QueryQueuedProposalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQueuedProposalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQueuedProposalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQueuedProposalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQueuedProposalsRequest.Merge(m, src)
+}
+func (m *QueryQueuedProposalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQueuedProposalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQueuedProposalsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQueuedProposalsRequest proto.InternalMessageInfo
+
+// QueryQueuedProposalsResponse defines the response type for querying x/committee queued proposals.
+type QueryQueuedProposalsResponse struct {
+	QueuedProposals []QueuedProposal `protobuf:"bytes,1,rep,name=queued_proposals,json=queuedProposals,proto3" json:"queued_proposals"`
+}
+
+func (m *QueryQueuedProposalsResponse) Reset()         { *m = QueryQueuedProposalsResponse{} }
+func (m *QueryQueuedProposalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryQueuedProposalsResponse) ProtoMessage()    {}
+func (*QueryQueuedProposalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b81d271efeb6eee5, []int{9}
+}
+func (m *QueryQueuedProposalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQueuedProposalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQueuedProposalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQueuedProposalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQueuedProposalsResponse.Merge(m, src)
+}
+func (m *QueryQueuedProposalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQueuedProposalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQueuedProposalsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQueuedProposalsResponse proto.InternalMessageInfo
+
 // QueryNextProposalIDRequest defines the request type for querying x/committee NextProposalID.
 type QueryNextProposalIDRequest struct {
 }
@@ -351,7 +426,7 @@ func (m *QueryNextProposalIDRequest) Reset()         { *m = QueryNextProposalIDR
 func (m *QueryNextProposalIDRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryNextProposalIDRequest) ProtoMessage()    {}
 func (*QueryNextProposalIDRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b81d271efeb6eee5, []int{8}
+	return fileDescriptor_b81d271efeb6eee5, []int{10}
 }
 func (m *QueryNextProposalIDRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -389,7 +464,7 @@ func (m *QueryNextProposalIDResponse) Reset()         { *m = QueryNextProposalID
 func (m *QueryNextProposalIDResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryNextProposalIDResponse) ProtoMessage()    {}
 func (*QueryNextProposalIDResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b81d271efeb6eee5, []int{9}
+	return fileDescriptor_b81d271efeb6eee5, []int{11}
 }
 func (m *QueryNextProposalIDResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -428,7 +503,7 @@ func (m *QueryVotesRequest) Reset()         { *m = QueryVotesRequest{} }
 func (m *QueryVotesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryVotesRequest) ProtoMessage()    {}
 func (*QueryVotesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b81d271efeb6eee5, []int{10}
+	return fileDescriptor_b81d271efeb6eee5, []int{12}
 }
 func (m *QueryVotesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -469,7 +544,7 @@ func (m *QueryVotesResponse) Reset()         { *m = QueryVotesResponse{} }
 func (m *QueryVotesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryVotesResponse) ProtoMessage()    {}
 func (*QueryVotesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b81d271efeb6eee5, []int{11}
+	return fileDescriptor_b81d271efeb6eee5, []int{13}
 }
 func (m *QueryVotesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -508,7 +583,7 @@ func (m *QueryVoteRequest) Reset()         { *m = QueryVoteRequest{} }
 func (m *QueryVoteRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryVoteRequest) ProtoMessage()    {}
 func (*QueryVoteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b81d271efeb6eee5, []int{12}
+	return fileDescriptor_b81d271efeb6eee5, []int{14}
 }
 func (m *QueryVoteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -548,7 +623,7 @@ func (m *QueryVoteResponse) Reset()         { *m = QueryVoteResponse{} }
 func (m *QueryVoteResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryVoteResponse) ProtoMessage()    {}
 func (*QueryVoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b81d271efeb6eee5, []int{13}
+	return fileDescriptor_b81d271efeb6eee5, []int{15}
 }
 func (m *QueryVoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -586,7 +661,7 @@ func (m *QueryTallyRequest) Reset()         { *m = QueryTallyRequest{} }
 func (m *QueryTallyRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTallyRequest) ProtoMessage()    {}
 func (*QueryTallyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b81d271efeb6eee5, []int{14}
+	return fileDescriptor_b81d271efeb6eee5, []int{16}
 }
 func (m *QueryTallyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -624,13 +699,15 @@ type QueryTallyResponse struct {
 	PossibleVotes github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=possible_votes,json=possibleVotes,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"possible_votes"`
 	VoteThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=vote_threshold,json=voteThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"vote_threshold"`
 	Quorum        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=quorum,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quorum"`
+	// The votes of token committee proposals by the source of the voting power
+	Sources []TallySource `protobuf:"bytes,8,rep,name=sources,proto3" json:"sources"`
 }
 
 func (m *QueryTallyResponse) Reset()         { *m = QueryTallyResponse{} }
 func (m *QueryTallyResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTallyResponse) ProtoMessage()    {}
 func (*QueryTallyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b81d271efeb6eee5, []int{15}
+	return fileDescriptor_b81d271efeb6eee5, []int{17}
 }
 func (m *QueryTallyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -659,6 +736,124 @@ func (m *QueryTallyResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryTallyResponse proto.InternalMessageInfo
 
+// TallySource defines the votes of a token committee proposal from a single source of voting power,
+// such as the tally denom balance, staked tokens or voting power delegated to the voter.
+type TallySource struct {
+	Source       string                                 `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	YesVotes     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=yes_votes,json=yesVotes,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"yes_votes"`
+	NoVotes      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=no_votes,json=noVotes,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"no_votes"`
+	CurrentVotes github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=current_votes,json=currentVotes,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"current_votes"`
+}
+
+func (m *TallySource) Reset()         { *m = TallySource{} }
+func (m *TallySource) String() string { return proto.CompactTextString(m) }
+func (*TallySource) ProtoMessage()    {}
+func (*TallySource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b81d271efeb6eee5, []int{18}
+}
+func (m *TallySource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TallySource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TallySource.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TallySource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TallySource.Merge(m, src)
+}
+func (m *TallySource) XXX_Size() int {
+	return m.Size()
+}
+func (m *TallySource) XXX_DiscardUnknown() {
+	xxx_messageInfo_TallySource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TallySource proto.InternalMessageInfo
+
+// QueryVotingDelegationRequest defines the request type for querying the representative of a delegator.
+type QueryVotingDelegationRequest struct {
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+}
+
+func (m *QueryVotingDelegationRequest) Reset()         { *m = QueryVotingDelegationRequest{} }
+func (m *QueryVotingDelegationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVotingDelegationRequest) ProtoMessage()    {}
+func (*QueryVotingDelegationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b81d271efeb6eee5, []int{19}
+}
+func (m *QueryVotingDelegationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVotingDelegationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVotingDelegationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVotingDelegationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVotingDelegationRequest.Merge(m, src)
+}
+func (m *QueryVotingDelegationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVotingDelegationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVotingDelegationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVotingDelegationRequest proto.InternalMessageInfo
+
+// QueryVotingDelegationResponse defines the response type for querying the representative of a delegator.
+type QueryVotingDelegationResponse struct {
+	Representative string `protobuf:"bytes,1,opt,name=representative,proto3" json:"representative,omitempty"`
+}
+
+func (m *QueryVotingDelegationResponse) Reset()         { *m = QueryVotingDelegationResponse{} }
+func (m *QueryVotingDelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVotingDelegationResponse) ProtoMessage()    {}
+func (*QueryVotingDelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b81d271efeb6eee5, []int{20}
+}
+func (m *QueryVotingDelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVotingDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVotingDelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVotingDelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVotingDelegationResponse.Merge(m, src)
+}
+func (m *QueryVotingDelegationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVotingDelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVotingDelegationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVotingDelegationResponse proto.InternalMessageInfo
+
 // QueryRawParamsRequest defines the request type for querying x/committee raw params.
 type QueryRawParamsRequest struct {
 	Subspace string `protobuf:"bytes,1,opt,name=subspace,proto3" json:"subspace,omitempty"`
@@ -669,7 +864,7 @@ func (m *QueryRawParamsRequest) Reset()         { *m = QueryRawParamsRequest{} }
 func (m *QueryRawParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRawParamsRequest) ProtoMessage()    {}
 func (*QueryRawParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b81d271efeb6eee5, []int{16}
+	return fileDescriptor_b81d271efeb6eee5, []int{21}
 }
 func (m *QueryRawParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -707,7 +902,7 @@ func (m *QueryRawParamsResponse) Reset()         { *m = QueryRawParamsResponse{}
 func (m *QueryRawParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryRawParamsResponse) ProtoMessage()    {}
 func (*QueryRawParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b81d271efeb6eee5, []int{17}
+	return fileDescriptor_b81d271efeb6eee5, []int{22}
 }
 func (m *QueryRawParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -745,6 +940,8 @@ func init() {
 	proto.RegisterType((*QueryProposalsResponse)(nil), "kava.committee.v1beta1.QueryProposalsResponse")
 	proto.RegisterType((*QueryProposalRequest)(nil), "kava.committee.v1beta1.QueryProposalRequest")
 	proto.RegisterType((*QueryProposalResponse)(nil), "kava.committee.v1beta1.QueryProposalResponse")
+	proto.RegisterType((*QueryQueuedProposalsRequest)(nil), "kava.committee.v1beta1.QueryQueuedProposalsRequest")
+	proto.RegisterType((*QueryQueuedProposalsResponse)(nil), "kava.committee.v1beta1.QueryQueuedProposalsResponse")
 	proto.RegisterType((*QueryNextProposalIDRequest)(nil), "kava.committee.v1beta1.QueryNextProposalIDRequest")
 	proto.RegisterType((*QueryNextProposalIDResponse)(nil), "kava.committee.v1beta1.QueryNextProposalIDResponse")
 	proto.RegisterType((*QueryVotesRequest)(nil), "kava.committee.v1beta1.QueryVotesRequest")
@@ -753,6 +950,9 @@ func init() {
 	proto.RegisterType((*QueryVoteResponse)(nil), "kava.committee.v1beta1.QueryVoteResponse")
 	proto.RegisterType((*QueryTallyRequest)(nil), "kava.committee.v1beta1.QueryTallyRequest")
 	proto.RegisterType((*QueryTallyResponse)(nil), "kava.committee.v1beta1.QueryTallyResponse")
+	proto.RegisterType((*TallySource)(nil), "kava.committee.v1beta1.TallySource")
+	proto.RegisterType((*QueryVotingDelegationRequest)(nil), "kava.committee.v1beta1.QueryVotingDelegationRequest")
+	proto.RegisterType((*QueryVotingDelegationResponse)(nil), "kava.committee.v1beta1.QueryVotingDelegationResponse")
 	proto.RegisterType((*QueryRawParamsRequest)(nil), "kava.committee.v1beta1.QueryRawParamsRequest")
 	proto.RegisterType((*QueryRawParamsResponse)(nil), "kava.committee.v1beta1.QueryRawParamsResponse")
 }
@@ -762,83 +962,97 @@ func init() {
 }
 
 var fileDescriptor_b81d271efeb6eee5 = []byte{
-	// 1211 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x96, 0xc1, 0x6f, 0xe3, 0xc4,
-	0x17, 0xc7, 0xeb, 0x34, 0xed, 0x26, 0x2f, 0xdb, 0xfe, 0xfa, 0x1b, 0x95, 0x92, 0x86, 0x55, 0xd2,
-	0x35, 0xab, 0xa5, 0x5b, 0x88, 0x4d, 0x53, 0x50, 0x05, 0xa2, 0x82, 0x4d, 0xdb, 0x45, 0x11, 0x12,
-	0xea, 0x9a, 0xc2, 0x81, 0x95, 0x88, 0x26, 0xf5, 0x6c, 0x6a, 0x35, 0xb1, 0x5d, 0x8f, 0xd3, 0x36,
-	0x2a, 0xbd, 0x70, 0x47, 0x5a, 0x09, 0x81, 0xb4, 0x07, 0x24, 0x84, 0x40, 0x42, 0xe2, 0x86, 0xf6,
-	0x8f, 0xa8, 0xf6, 0xb4, 0x12, 0x17, 0xc4, 0x21, 0x40, 0xca, 0x1f, 0x82, 0x3c, 0x33, 0x9e, 0xb8,
-	0x49, 0xdb, 0xb8, 0x39, 0x25, 0xb6, 0xdf, 0xfb, 0xce, 0x67, 0xde, 0xbc, 0x79, 0xef, 0x81, 0xba,
-	0x87, 0x0f, 0xb0, 0xbe, 0xe3, 0x34, 0x9b, 0x96, 0xef, 0x13, 0xa2, 0x1f, 0x2c, 0xd7, 0x88, 0x8f,
-	0x97, 0xf5, 0xfd, 0x16, 0xf1, 0xda, 0x9a, 0xeb, 0x39, 0xbe, 0x83, 0xe6, 0x02, 0x1b, 0x4d, 0xda,
-	0x68, 0xc2, 0x26, 0xb7, 0xb4, 0xe3, 0xd0, 0xa6, 0x43, 0xf5, 0x1a, 0xa6, 0x84, 0x3b, 0x48, 0x77,
-	0x17, 0xd7, 0x2d, 0x1b, 0xfb, 0x96, 0x63, 0x73, 0x8d, 0xdc, 0x3c, 0xb7, 0xad, 0xb2, 0x27, 0x9d,
-	0x3f, 0x88, 0x4f, 0xb3, 0x75, 0xa7, 0xee, 0xf0, 0xf7, 0xc1, 0x3f, 0xf1, 0xf6, 0x56, 0xdd, 0x71,
-	0xea, 0x0d, 0xa2, 0x63, 0xd7, 0xd2, 0xb1, 0x6d, 0x3b, 0x3e, 0x53, 0x0b, 0x7d, 0xe6, 0xc5, 0x57,
-	0xf6, 0x54, 0x6b, 0x3d, 0xd6, 0xb1, 0x2d, 0x68, 0x73, 0x85, 0xfe, 0x4f, 0xbe, 0xd5, 0x24, 0xd4,
-	0xc7, 0x4d, 0x57, 0x18, 0xdc, 0xb9, 0x64, 0xcb, 0x75, 0x62, 0x13, 0x6a, 0x89, 0x15, 0xd4, 0x2c,
-	0xcc, 0x3d, 0x0c, 0xb6, 0xb4, 0x1e, 0xda, 0x51, 0x83, 0xec, 0xb7, 0x08, 0xf5, 0xd5, 0x2f, 0xe0,
-	0xe5, 0x81, 0x2f, 0xd4, 0x75, 0x6c, 0x4a, 0xd0, 0x3a, 0x80, 0xd4, 0xa5, 0x59, 0x65, 0x61, 0x7c,
-	0x31, 0x53, 0x9a, 0xd5, 0x38, 0x90, 0x16, 0x02, 0x69, 0xf7, 0xed, 0x76, 0x79, 0xea, 0xf9, 0xb3,
-	0x62, 0x5a, 0x2a, 0x18, 0x11, 0x37, 0xf5, 0x5d, 0x78, 0xe9, 0xbc, 0xbe, 0x58, 0x18, 0xdd, 0x86,
-	0x9b, 0xd2, 0xac, 0x6a, 0x99, 0x59, 0x65, 0x41, 0x59, 0x4c, 0x1a, 0x19, 0xf9, 0xae, 0x62, 0xaa,
-	0x8f, 0xfa, 0xa9, 0x25, 0xda, 0x7d, 0x48, 0x4b, 0x43, 0xe6, 0x19, 0x93, 0xac, 0xe7, 0x25, 0xc1,
-	0xb6, 0x3c, 0xc7, 0x75, 0x28, 0x6e, 0xd0, 0x6b, 0x80, 0xed, 0x09, 0xb0, 0x88, 0xaf, 0x00, 0x7b,
-	0x08, 0x69, 0x37, 0x7c, 0x29, 0x42, 0x56, 0xd4, 0x2e, 0xce, 0x38, 0xed, 0x9c, 0x44, 0xa8, 0x50,
-	0x4e, 0x9e, 0x76, 0x0a, 0x63, 0x46, 0x4f, 0x45, 0x5d, 0x85, 0xd9, 0x3e, 0x4b, 0xce, 0x59, 0x80,
-	0x4c, 0x68, 0xd4, 0xc3, 0x84, 0xf0, 0x55, 0xc5, 0x54, 0xbf, 0x4e, 0xf4, 0x6d, 0x51, 0x52, 0x3e,
-	0x86, 0x9b, 0x6e, 0xab, 0x56, 0x0d, 0x6d, 0xaf, 0x8c, 0x60, 0xb1, 0xdb, 0x29, 0x64, 0xb6, 0x5a,
-	0xb5, 0x50, 0xe4, 0xf9, 0xb3, 0x62, 0x4e, 0x64, 0x7c, 0xdd, 0x39, 0x90, 0x9b, 0x59, 0x77, 0x6c,
-	0x9f, 0xd8, 0xbe, 0x91, 0x71, 0x7b, 0xa6, 0x68, 0x0e, 0x12, 0x96, 0x99, 0x4d, 0x04, 0x64, 0xe5,
-	0xc9, 0x6e, 0xa7, 0x90, 0xa8, 0x6c, 0x18, 0x09, 0xcb, 0x44, 0xa5, 0xbe, 0x10, 0x8f, 0x33, 0x8b,
-	0xff, 0x05, 0x2b, 0xc9, 0xb3, 0xaa, 0x6c, 0x9c, 0x8b, 0x39, 0xfa, 0x00, 0x52, 0x26, 0xc1, 0x66,
-	0xc3, 0xb2, 0x49, 0x36, 0xc9, 0x78, 0x73, 0x03, 0xbc, 0xdb, 0xe1, 0xe5, 0x28, 0xa7, 0x82, 0x28,
-	0x3e, 0xf9, 0xab, 0xa0, 0x18, 0xd2, 0x4b, 0xbd, 0x05, 0x39, 0x16, 0x8e, 0x8f, 0xc9, 0x91, 0x1f,
-	0x22, 0x56, 0x36, 0xc2, 0x8b, 0xf0, 0x08, 0x5e, 0xb9, 0xf0, 0xab, 0x08, 0xd9, 0x7b, 0x30, 0x63,
-	0x93, 0x23, 0xbf, 0x3a, 0x10, 0xf2, 0x32, 0xea, 0x76, 0x0a, 0xd3, 0x7d, 0x5e, 0xd3, 0x76, 0xf4,
-	0xd9, 0x54, 0xbf, 0x84, 0xff, 0x33, 0xf1, 0xcf, 0x1c, 0x5f, 0x5e, 0xbd, 0xa1, 0x07, 0x88, 0x1e,
-	0x00, 0xf4, 0x4a, 0x0f, 0x0b, 0x63, 0xa6, 0x74, 0x57, 0x13, 0xc1, 0x0f, 0xea, 0x94, 0xc6, 0x0b,
-	0x5b, 0x78, 0x06, 0x5b, 0xb8, 0x1e, 0x5e, 0x2f, 0x23, 0xe2, 0xa9, 0xfe, 0xa4, 0x00, 0x8a, 0x2e,
-	0x2f, 0xb6, 0xb4, 0x09, 0x13, 0x07, 0xc1, 0x0b, 0x91, 0xa7, 0xf7, 0xae, 0xcc, 0xd3, 0xc0, 0xb5,
-	0x2f, 0x47, 0xb9, 0x37, 0xfa, 0xf0, 0x02, 0xca, 0xd7, 0x86, 0x52, 0x72, 0xa5, 0x73, 0x98, 0x15,
-	0x98, 0x89, 0x2c, 0x15, 0x33, 0x46, 0xb3, 0x7c, 0x13, 0x1e, 0x5b, 0x38, 0xcd, 0x99, 0x3c, 0xf5,
-	0xa9, 0x12, 0x09, 0xb8, 0xdc, 0xb0, 0x7e, 0x81, 0x58, 0x79, 0xba, 0xdb, 0x29, 0x40, 0xe4, 0xe8,
-	0x86, 0x8a, 0xa3, 0x35, 0x48, 0x07, 0x7f, 0xaa, 0x7e, 0xdb, 0x25, 0x2c, 0x75, 0xa7, 0x4b, 0x0b,
-	0x97, 0xc5, 0x2e, 0x58, 0x7f, 0xbb, 0xed, 0x12, 0x23, 0x75, 0x20, 0xfe, 0xa9, 0x6f, 0x09, 0xb4,
-	0x6d, 0xdc, 0x68, 0xb4, 0x63, 0x5f, 0xe6, 0x5f, 0x92, 0xe2, 0x0c, 0x85, 0xdb, 0xa8, 0x5b, 0xfa,
-	0x08, 0xd2, 0x6d, 0x42, 0xab, 0xfc, 0xe0, 0xd9, 0xb6, 0xca, 0x5a, 0x70, 0x9a, 0x7f, 0x76, 0x0a,
-	0x77, 0xeb, 0x96, 0xbf, 0xdb, 0xaa, 0x05, 0xbb, 0x10, 0x3d, 0x4d, 0xfc, 0x14, 0xa9, 0xb9, 0xa7,
-	0x07, 0xbb, 0xa5, 0xda, 0x06, 0xd9, 0x31, 0x52, 0x6d, 0x42, 0x59, 0x26, 0xa1, 0x0a, 0xa4, 0x6c,
-	0x47, 0x68, 0x8d, 0x8f, 0xa4, 0x75, 0xc3, 0x76, 0xb8, 0xd4, 0x27, 0x30, 0xb5, 0xd3, 0xf2, 0x3c,
-	0x62, 0xfb, 0x42, 0x2f, 0x39, 0x92, 0xde, 0x4d, 0x21, 0xc2, 0x45, 0x3f, 0x85, 0x69, 0xd7, 0xa1,
-	0xd4, 0xaa, 0x35, 0x88, 0x50, 0x9d, 0x18, 0x49, 0x75, 0x2a, 0x54, 0x91, 0xb2, 0x3c, 0x01, 0x76,
-	0x3d, 0x42, 0x77, 0x9d, 0x86, 0x99, 0x9d, 0x1c, 0x4d, 0x96, 0xe5, 0x44, 0x28, 0x82, 0x1e, 0xc0,
-	0xe4, 0x7e, 0xcb, 0xf1, 0x5a, 0xcd, 0xec, 0x8d, 0x91, 0xe4, 0x84, 0xb7, 0xba, 0x29, 0xca, 0xbe,
-	0x81, 0x0f, 0xb7, 0xb0, 0x87, 0x9b, 0xb2, 0xe0, 0xe4, 0x20, 0x45, 0x5b, 0x35, 0xea, 0xe2, 0x1d,
-	0xde, 0x34, 0xd3, 0x86, 0x7c, 0x46, 0x33, 0x30, 0xbe, 0x47, 0xda, 0x22, 0xd1, 0x83, 0xbf, 0xea,
-	0x8a, 0x68, 0x72, 0x11, 0x19, 0x91, 0x74, 0xf3, 0x90, 0xf2, 0xf0, 0x61, 0xd5, 0xc4, 0x3e, 0x16,
-	0x3a, 0x37, 0x3c, 0x7c, 0xb8, 0x81, 0x7d, 0x5c, 0xfa, 0x2d, 0x03, 0x13, 0xcc, 0x0b, 0x3d, 0x55,
-	0x00, 0x7a, 0x43, 0x05, 0xd2, 0xae, 0xac, 0x2e, 0x03, 0x73, 0x49, 0x4e, 0x8f, 0x6d, 0xcf, 0xa1,
-	0xd4, 0xa5, 0xaf, 0x7e, 0xff, 0xf7, 0x9b, 0xc4, 0x1d, 0xa4, 0xea, 0x97, 0x4c, 0x44, 0xbd, 0xa1,
-	0x04, 0xfd, 0xac, 0x40, 0x6f, 0x28, 0x40, 0xc5, 0x78, 0x4b, 0x85, 0x64, 0x5a, 0x5c, 0x73, 0x01,
-	0xf6, 0x0e, 0x03, 0x5b, 0x41, 0xcb, 0xc3, 0xc1, 0xf4, 0xe3, 0x68, 0x5b, 0x3c, 0x41, 0xdf, 0x2a,
-	0x90, 0x96, 0x33, 0x06, 0x8a, 0x37, 0x48, 0xd0, 0x78, 0x9c, 0x03, 0xa3, 0x8b, 0x7a, 0x8f, 0x71,
-	0xbe, 0x8a, 0x6e, 0x5f, 0xc6, 0x29, 0x47, 0x12, 0xf4, 0x83, 0x02, 0x29, 0xd9, 0xe4, 0xdf, 0x88,
-	0x39, 0xdf, 0x70, 0xaa, 0xeb, 0x4d, 0x43, 0xea, 0x2a, 0x83, 0x5a, 0x46, 0xfa, 0x50, 0x28, 0xfd,
-	0x38, 0x52, 0x08, 0x4f, 0xd0, 0xaf, 0x0a, 0xf4, 0x35, 0x65, 0x54, 0xba, 0x72, 0xe9, 0x0b, 0xa7,
-	0x82, 0xdc, 0xca, 0xb5, 0x7c, 0x04, 0xf4, 0x9b, 0x0c, 0x7a, 0x09, 0x2d, 0x5e, 0x06, 0x1d, 0x4c,
-	0x07, 0xc5, 0x10, 0xb7, 0x68, 0x99, 0xe8, 0x7b, 0x05, 0x26, 0x78, 0x6d, 0x19, 0xde, 0x85, 0xe5,
-	0x01, 0x2f, 0xc5, 0x31, 0x15, 0x48, 0x6b, 0x0c, 0x69, 0x15, 0xbd, 0x7d, 0xcd, 0x38, 0xea, 0xbc,
-	0xc7, 0xff, 0xa8, 0x40, 0x32, 0x10, 0x44, 0x8b, 0x31, 0x86, 0x04, 0x4e, 0x17, 0x7f, 0x9c, 0x50,
-	0x37, 0x19, 0xdc, 0xfb, 0x68, 0x6d, 0x24, 0x38, 0xfd, 0x98, 0xb5, 0xe5, 0x13, 0x16, 0x44, 0xd6,
-	0x1d, 0x87, 0x04, 0x31, 0xda, 0x78, 0x87, 0x04, 0xf1, 0x5c, 0xb3, 0x1d, 0x3d, 0x88, 0x3e, 0xa3,
-	0xfa, 0x4e, 0x81, 0xb4, 0x2c, 0xa6, 0x43, 0x6e, 0x73, 0x7f, 0xed, 0x1e, 0x72, 0x9b, 0x07, 0x6a,
-	0xf4, 0xf0, 0x72, 0xe8, 0xe1, 0xc3, 0xa2, 0xcb, 0x7c, 0xca, 0x95, 0xd3, 0x7f, 0xf2, 0x63, 0xa7,
-	0xdd, 0xbc, 0xf2, 0xa2, 0x9b, 0x57, 0xfe, 0xee, 0xe6, 0x95, 0x27, 0x67, 0xf9, 0xb1, 0x17, 0x67,
-	0xf9, 0xb1, 0x3f, 0xce, 0xf2, 0x63, 0x9f, 0xbf, 0x1e, 0x69, 0x3f, 0x81, 0x56, 0xb1, 0x81, 0x6b,
-	0x94, 0xab, 0x1e, 0x45, 0x74, 0x59, 0x1f, 0xaa, 0x4d, 0xb2, 0x59, 0x7c, 0xe5, 0xbf, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xb4, 0xd1, 0x58, 0x0c, 0x8a, 0x0f, 0x00, 0x00,
+	// 1429 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x97, 0xcd, 0x6f, 0x1b, 0xc5,
+	0x1b, 0xc7, 0xb3, 0xce, 0x9b, 0xfd, 0xa4, 0x75, 0xf3, 0x1b, 0xa5, 0xf9, 0xb9, 0x6e, 0x6b, 0xb7,
+	0xdb, 0xaa, 0xa4, 0x01, 0xef, 0x92, 0xa4, 0x2f, 0x80, 0x28, 0xb4, 0x4e, 0x5a, 0x64, 0x21, 0xa1,
+	0x74, 0x5b, 0x8a, 0x44, 0x25, 0xac, 0x71, 0x76, 0xea, 0xae, 0x62, 0xef, 0x6e, 0x76, 0x76, 0x9d,
+	0x5a, 0x21, 0x17, 0xee, 0x48, 0x15, 0x08, 0xa4, 0x1e, 0x90, 0x10, 0xa2, 0x27, 0x24, 0x4e, 0xbd,
+	0x73, 0xad, 0x7a, 0xaa, 0xe0, 0x82, 0x38, 0x04, 0x70, 0xb8, 0xf2, 0x3f, 0xa0, 0x9d, 0x99, 0x5d,
+	0x6f, 0x36, 0x76, 0xbc, 0x31, 0x47, 0x4e, 0xf6, 0xcc, 0x3c, 0xcf, 0x77, 0x3e, 0xf3, 0xcc, 0x33,
+	0x33, 0xcf, 0x82, 0xbc, 0x8e, 0x5b, 0x58, 0x5d, 0xb3, 0x9a, 0x4d, 0xc3, 0x75, 0x09, 0x51, 0x5b,
+	0x0b, 0x35, 0xe2, 0xe2, 0x05, 0x75, 0xc3, 0x23, 0x4e, 0x5b, 0xb1, 0x1d, 0xcb, 0xb5, 0xd0, 0xac,
+	0x6f, 0xa3, 0x84, 0x36, 0x8a, 0xb0, 0xc9, 0xcf, 0xaf, 0x59, 0xb4, 0x69, 0x51, 0xb5, 0x86, 0x29,
+	0xe1, 0x0e, 0xa1, 0xbb, 0x8d, 0xeb, 0x86, 0x89, 0x5d, 0xc3, 0x32, 0xb9, 0x46, 0xfe, 0x04, 0xb7,
+	0xad, 0xb2, 0x96, 0xca, 0x1b, 0x62, 0x68, 0xa6, 0x6e, 0xd5, 0x2d, 0xde, 0xef, 0xff, 0x13, 0xbd,
+	0xa7, 0xea, 0x96, 0x55, 0x6f, 0x10, 0x15, 0xdb, 0x86, 0x8a, 0x4d, 0xd3, 0x72, 0x99, 0x5a, 0xe0,
+	0x73, 0x42, 0x8c, 0xb2, 0x56, 0xcd, 0x7b, 0xa0, 0x62, 0x53, 0xd0, 0xe6, 0x8b, 0xf1, 0x21, 0xd7,
+	0x68, 0x12, 0xea, 0xe2, 0xa6, 0x2d, 0x0c, 0xce, 0xf7, 0x59, 0x72, 0x9d, 0x98, 0x84, 0x1a, 0x62,
+	0x06, 0x39, 0x07, 0xb3, 0xb7, 0xfd, 0x25, 0x2d, 0x07, 0x76, 0x54, 0x23, 0x1b, 0x1e, 0xa1, 0xae,
+	0xfc, 0x09, 0xfc, 0x7f, 0xdf, 0x08, 0xb5, 0x2d, 0x93, 0x12, 0xb4, 0x0c, 0x10, 0xea, 0xd2, 0x9c,
+	0x74, 0x66, 0x74, 0x6e, 0x6a, 0x71, 0x46, 0xe1, 0x40, 0x4a, 0x00, 0xa4, 0xdc, 0x30, 0xdb, 0xe5,
+	0xa3, 0x2f, 0x9e, 0x95, 0x32, 0xa1, 0x82, 0x16, 0x71, 0x93, 0xdf, 0x82, 0xe3, 0x7b, 0xf5, 0xc5,
+	0xc4, 0xe8, 0x2c, 0x1c, 0x09, 0xcd, 0xaa, 0x86, 0x9e, 0x93, 0xce, 0x48, 0x73, 0x63, 0xda, 0x54,
+	0xd8, 0x57, 0xd1, 0xe5, 0xfb, 0x71, 0xea, 0x10, 0xed, 0x06, 0x64, 0x42, 0x43, 0xe6, 0x99, 0x90,
+	0xac, 0xeb, 0x15, 0x82, 0xad, 0x3a, 0x96, 0x6d, 0x51, 0xdc, 0xa0, 0x87, 0x00, 0x5b, 0x87, 0xd9,
+	0xb8, 0xaf, 0x00, 0xbb, 0x0d, 0x19, 0x3b, 0xe8, 0x14, 0x21, 0x2b, 0x29, 0xbd, 0x33, 0x4e, 0xd9,
+	0x23, 0x11, 0x28, 0x94, 0xc7, 0x9e, 0xef, 0x14, 0x47, 0xb4, 0xae, 0x8a, 0x7c, 0x15, 0x66, 0x62,
+	0x96, 0x9c, 0xb3, 0x08, 0x53, 0x81, 0x51, 0x17, 0x13, 0x82, 0xae, 0x8a, 0x2e, 0x7f, 0x9e, 0x82,
+	0xe3, 0x3d, 0xe7, 0x40, 0x0f, 0xe0, 0x88, 0xed, 0xd5, 0xaa, 0x81, 0xed, 0x81, 0x11, 0x2c, 0x75,
+	0x76, 0x8a, 0x53, 0xab, 0x5e, 0x2d, 0x10, 0x79, 0xf1, 0xac, 0x94, 0x17, 0x19, 0x5f, 0xb7, 0x5a,
+	0xe1, 0x62, 0x96, 0x2d, 0xd3, 0x25, 0xa6, 0xab, 0x4d, 0xd9, 0x5d, 0x53, 0x34, 0x0b, 0x29, 0x43,
+	0xcf, 0xa5, 0x7c, 0xb2, 0xf2, 0x44, 0x67, 0xa7, 0x98, 0xaa, 0xac, 0x68, 0x29, 0x43, 0x47, 0x8b,
+	0xb1, 0x10, 0x8f, 0x32, 0x8b, 0x63, 0xfe, 0x4c, 0xe1, 0x5e, 0x55, 0x56, 0xf6, 0xc4, 0x1c, 0x5d,
+	0x87, 0xb4, 0x4e, 0xb0, 0xde, 0x30, 0x4c, 0x92, 0x1b, 0x63, 0xbc, 0xf9, 0x7d, 0xbc, 0x77, 0x83,
+	0xc3, 0x51, 0x4e, 0xfb, 0x51, 0x7c, 0xfc, 0x7b, 0x51, 0xd2, 0x42, 0x2f, 0xf9, 0x34, 0x9c, 0x64,
+	0xe1, 0xb8, 0xed, 0x11, 0x8f, 0xe8, 0xf1, 0x7d, 0x97, 0x37, 0xe1, 0x54, 0xef, 0x61, 0x11, 0xb4,
+	0x8f, 0x60, 0x7a, 0x83, 0x0d, 0x55, 0xe3, 0x3b, 0x7c, 0xe1, 0x80, 0x1d, 0x8e, 0x48, 0x89, 0xad,
+	0x3d, 0xb6, 0xb1, 0x77, 0x02, 0xf9, 0x14, 0xe4, 0xd9, 0xc4, 0x1f, 0x90, 0x47, 0x6e, 0xd0, 0x5b,
+	0x59, 0x09, 0xb0, 0xee, 0xc3, 0xc9, 0x9e, 0xa3, 0x82, 0xea, 0x6d, 0x98, 0x36, 0xc9, 0x23, 0xb7,
+	0xba, 0x2f, 0x15, 0xca, 0xa8, 0xb3, 0x53, 0xcc, 0xc6, 0xbc, 0xb2, 0x66, 0xb4, 0xad, 0xcb, 0x9f,
+	0xc2, 0xff, 0x98, 0xf8, 0x3d, 0xcb, 0x25, 0x34, 0x69, 0x62, 0xa1, 0x5b, 0x00, 0xdd, 0x2b, 0x91,
+	0x6d, 0xaf, 0x1f, 0x03, 0x91, 0x14, 0xfe, 0xfd, 0xa9, 0xf0, 0x0b, 0x37, 0x08, 0xc3, 0x2a, 0xae,
+	0x07, 0xc7, 0x5e, 0x8b, 0x78, 0xca, 0xdf, 0x4b, 0x80, 0xa2, 0xd3, 0x8b, 0x25, 0xdd, 0x84, 0xf1,
+	0x96, 0xdf, 0x21, 0xa2, 0x7b, 0xf1, 0xc0, 0xf3, 0xe3, 0xbb, 0xc6, 0xce, 0x0e, 0xf7, 0x46, 0xef,
+	0xf5, 0xa0, 0x7c, 0x65, 0x20, 0x25, 0x57, 0xda, 0x83, 0x59, 0x81, 0xe9, 0xc8, 0x54, 0x09, 0x63,
+	0x34, 0xc3, 0x17, 0xe1, 0xb0, 0x89, 0x33, 0x9c, 0xc9, 0x91, 0x9f, 0x48, 0x91, 0x80, 0x87, 0x0b,
+	0x56, 0x7b, 0x88, 0x95, 0xb3, 0x9d, 0x9d, 0x22, 0x44, 0xb6, 0x6e, 0xa0, 0x38, 0xba, 0x06, 0x19,
+	0xff, 0x4f, 0xd5, 0x6d, 0xdb, 0x84, 0x1d, 0xa9, 0xec, 0xe2, 0x99, 0x7e, 0xb1, 0xf3, 0xe7, 0xbf,
+	0xdb, 0xb6, 0x89, 0x96, 0x6e, 0x89, 0x7f, 0xf2, 0x25, 0x81, 0x76, 0x17, 0x37, 0x1a, 0xed, 0xc4,
+	0x97, 0xcc, 0xdf, 0x63, 0x80, 0xa2, 0x6e, 0xc3, 0x2e, 0xe9, 0x7d, 0xc8, 0xb4, 0x09, 0xad, 0xf2,
+	0x8d, 0x67, 0xcb, 0x2a, 0x2b, 0xfe, 0x6e, 0xfe, 0xb6, 0x53, 0xbc, 0x50, 0x37, 0xdc, 0x87, 0x5e,
+	0xcd, 0x5f, 0x85, 0x78, 0x6b, 0xc5, 0x4f, 0x89, 0xea, 0xeb, 0xaa, 0xbf, 0x5a, 0xaa, 0xac, 0x90,
+	0x35, 0x2d, 0xdd, 0x26, 0x94, 0x65, 0x12, 0xaa, 0x40, 0xda, 0xb4, 0x84, 0xd6, 0xe8, 0x50, 0x5a,
+	0x93, 0xa6, 0xc5, 0xa5, 0xee, 0xc0, 0xd1, 0x35, 0xcf, 0x71, 0x88, 0xe9, 0x0a, 0xbd, 0xb1, 0xa1,
+	0xf4, 0x8e, 0x08, 0x11, 0x2e, 0xfa, 0x21, 0x64, 0x6d, 0x8b, 0x52, 0xa3, 0xd6, 0x20, 0x42, 0x75,
+	0x7c, 0x28, 0xd5, 0xa3, 0x81, 0x4a, 0x28, 0xcb, 0x13, 0xe0, 0xa1, 0x43, 0xe8, 0x43, 0xab, 0xa1,
+	0xe7, 0x26, 0x86, 0x93, 0x65, 0x39, 0x11, 0x88, 0xa0, 0x5b, 0x30, 0xb1, 0xe1, 0x59, 0x8e, 0xd7,
+	0xcc, 0x4d, 0x0e, 0x25, 0x27, 0xbc, 0xd1, 0x32, 0x4c, 0x52, 0xcb, 0x73, 0xd6, 0x08, 0xcd, 0xa5,
+	0xd9, 0xc9, 0x3e, 0xd7, 0x2f, 0x3b, 0x59, 0x2e, 0xdd, 0x61, 0xb6, 0xe2, 0x4c, 0x07, 0x9e, 0xf2,
+	0x17, 0x29, 0x98, 0x8a, 0x0c, 0xa3, 0x59, 0x98, 0xe0, 0x43, 0x2c, 0xc7, 0x32, 0x9a, 0x68, 0xfd,
+	0xa7, 0xf2, 0x49, 0xbe, 0x27, 0x9e, 0xae, 0x7b, 0x96, 0x6b, 0x98, 0xf5, 0x15, 0xd2, 0x20, 0x75,
+	0x76, 0x75, 0x05, 0xa7, 0xf8, 0x0a, 0x64, 0x74, 0xde, 0x69, 0x39, 0x3c, 0x4e, 0xe5, 0xdc, 0xcf,
+	0xcf, 0x4a, 0x33, 0xe2, 0x32, 0xbc, 0xa1, 0xeb, 0x0e, 0xa1, 0xf4, 0x8e, 0xeb, 0x18, 0x66, 0x5d,
+	0xeb, 0x9a, 0xca, 0x18, 0x4e, 0xf7, 0xd1, 0x15, 0xc7, 0xfc, 0x3a, 0x64, 0x1d, 0x62, 0x3b, 0x84,
+	0x12, 0xd3, 0xc5, 0xae, 0xd1, 0x22, 0x03, 0xd5, 0x63, 0xf6, 0xf2, 0x4d, 0x51, 0xa3, 0x68, 0x78,
+	0x73, 0x15, 0x3b, 0xb8, 0x19, 0xbe, 0x42, 0x79, 0x48, 0x53, 0xaf, 0x46, 0x6d, 0x1c, 0x6e, 0x6d,
+	0xd8, 0x46, 0xd3, 0x30, 0xba, 0x4e, 0xda, 0xe2, 0xf6, 0xf3, 0xff, 0xca, 0x4b, 0x30, 0x1b, 0x97,
+	0x11, 0x88, 0x27, 0x20, 0xed, 0xe0, 0xcd, 0xaa, 0x8e, 0x5d, 0x2c, 0x74, 0x26, 0x1d, 0xbc, 0xb9,
+	0x82, 0x5d, 0xbc, 0xf8, 0x34, 0x0b, 0xe3, 0xcc, 0x0b, 0x3d, 0x91, 0x00, 0xba, 0x15, 0x30, 0x52,
+	0x0e, 0x7c, 0x72, 0xf6, 0x15, 0xd1, 0x79, 0x35, 0xb1, 0x3d, 0x87, 0x92, 0xe7, 0x3f, 0xfb, 0xe5,
+	0xaf, 0x2f, 0x53, 0xe7, 0x91, 0xac, 0xf6, 0x29, 0xdf, 0xd7, 0xba, 0x30, 0x4f, 0x25, 0xe8, 0x56,
+	0xb0, 0xa8, 0x94, 0x6c, 0xaa, 0x80, 0x4c, 0x49, 0x6a, 0x2e, 0xc0, 0xde, 0x64, 0x60, 0x4b, 0x68,
+	0x61, 0x30, 0x98, 0xba, 0x15, 0xad, 0xe1, 0xb6, 0xd1, 0x57, 0x12, 0x64, 0xc2, 0xa2, 0x06, 0x25,
+	0xab, 0x7a, 0x69, 0x32, 0xce, 0x7d, 0xc5, 0x98, 0x7c, 0x91, 0x71, 0x9e, 0x43, 0x67, 0xfb, 0x71,
+	0x86, 0x35, 0x1a, 0xfa, 0x56, 0x82, 0x74, 0x58, 0x91, 0xbe, 0x96, 0xb0, 0x18, 0xe7, 0x54, 0x87,
+	0x2b, 0xdd, 0xe5, 0xab, 0x0c, 0x6a, 0x01, 0xa9, 0x03, 0xa1, 0xd4, 0xad, 0xc8, 0xeb, 0xb8, 0x8d,
+	0x7e, 0x90, 0x20, 0x56, 0xa9, 0xa1, 0xc5, 0x03, 0xa7, 0xee, 0x59, 0x2a, 0xe6, 0x97, 0x0e, 0xe5,
+	0x23, 0xa0, 0x5f, 0x67, 0xd0, 0xf3, 0x68, 0xae, 0x1f, 0xb4, 0x5f, 0x32, 0x96, 0x02, 0xdc, 0x92,
+	0xa1, 0xa3, 0x6f, 0x24, 0x18, 0xe7, 0x97, 0xd9, 0xe0, 0xd2, 0x2c, 0xdc, 0xe0, 0xf9, 0x24, 0xa6,
+	0x02, 0xe9, 0x1a, 0x43, 0xba, 0x8a, 0x2e, 0x1f, 0x32, 0x8e, 0x2a, 0x2f, 0xfc, 0xbe, 0x93, 0x60,
+	0xcc, 0x17, 0x44, 0x73, 0x09, 0x2a, 0x47, 0x4e, 0x97, 0xbc, 0xc6, 0x94, 0x6f, 0x32, 0xb8, 0x77,
+	0xd1, 0xb5, 0xa1, 0xe0, 0xd4, 0x2d, 0xff, 0xc7, 0xd9, 0x66, 0x41, 0x64, 0xef, 0xd8, 0x80, 0x20,
+	0x46, 0xab, 0xb1, 0xfc, 0x7c, 0x12, 0xd3, 0x7f, 0x1b, 0x44, 0x97, 0x51, 0xfd, 0x28, 0xc1, 0xb1,
+	0xd8, 0x97, 0x10, 0x3a, 0x38, 0xbf, 0x7a, 0x7f, 0x56, 0xe5, 0x2f, 0x1d, 0xce, 0x29, 0x69, 0x56,
+	0xf2, 0x8f, 0xa8, 0x52, 0xf7, 0x98, 0xff, 0x24, 0xc1, 0x74, 0xfc, 0x9d, 0x42, 0x97, 0x06, 0xed,
+	0x6b, 0xaf, 0xe7, 0x32, 0x7f, 0xf9, 0x90, 0x5e, 0x82, 0xf9, 0x1d, 0xc6, 0xfc, 0x06, 0xba, 0xd2,
+	0x8f, 0xb9, 0xc5, 0x3c, 0x4b, 0x7a, 0xe8, 0x4a, 0xd5, 0xad, 0xf0, 0xb1, 0xdd, 0x46, 0x5f, 0x4b,
+	0x90, 0x09, 0xdf, 0xaf, 0x01, 0x17, 0x68, 0xfc, 0xb9, 0xcc, 0x2b, 0x49, 0xcd, 0x93, 0xbe, 0x40,
+	0x0e, 0xde, 0x2c, 0xd9, 0xcc, 0xa7, 0x5c, 0x79, 0xfe, 0x67, 0x61, 0xe4, 0x79, 0xa7, 0x20, 0xbd,
+	0xec, 0x14, 0xa4, 0x3f, 0x3a, 0x05, 0xe9, 0xf1, 0x6e, 0x61, 0xe4, 0xe5, 0x6e, 0x61, 0xe4, 0xd7,
+	0xdd, 0xc2, 0xc8, 0xc7, 0xaf, 0x46, 0x4a, 0x16, 0x5f, 0xab, 0xd4, 0xc0, 0x35, 0xca, 0x55, 0x1f,
+	0x45, 0x74, 0x59, 0xed, 0x52, 0x9b, 0x60, 0xdf, 0xea, 0x4b, 0xff, 0x0c, 0x00, 0x3a, 0xba, 0x67,
+	0x8d, 0xaa, 0x13, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -869,6 +1083,10 @@ type QueryClient interface {
 	Vote(ctx context.Context, in *QueryVoteRequest, opts ...grpc.CallOption) (*QueryVoteResponse, error)
 	// Tally queries the tally of a single proposal ID.
 	Tally(ctx context.Context, in *QueryTallyRequest, opts ...grpc.CallOption) (*QueryTallyResponse, error)
+	// QueuedProposals queries the passed proposals that are queued for execution.
+	QueuedProposals(ctx context.Context, in *QueryQueuedProposalsRequest, opts ...grpc.CallOption) (*QueryQueuedProposalsResponse, error)
+	// VotingDelegation queries the representative token committee voting power is delegated to.
+	VotingDelegation(ctx context.Context, in *QueryVotingDelegationRequest, opts ...grpc.CallOption) (*QueryVotingDelegationResponse, error)
 	// RawParams queries the raw params data of any subspace and key.
 	RawParams(ctx context.Context, in *QueryRawParamsRequest, opts ...grpc.CallOption) (*QueryRawParamsResponse, error)
 }
@@ -953,6 +1171,24 @@ func (c *queryClient) Tally(ctx context.Context, in *QueryTallyRequest, opts ...
 	return out, nil
 }
 
+func (c *queryClient) QueuedProposals(ctx context.Context, in *QueryQueuedProposalsRequest, opts ...grpc.CallOption) (*QueryQueuedProposalsResponse, error) {
+	out := new(QueryQueuedProposalsResponse)
+	err := c.cc.Invoke(ctx, "/kava.committee.v1beta1.Query/QueuedProposals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VotingDelegation(ctx context.Context, in *QueryVotingDelegationRequest, opts ...grpc.CallOption) (*QueryVotingDelegationResponse, error) {
+	out := new(QueryVotingDelegationResponse)
+	err := c.cc.Invoke(ctx, "/kava.committee.v1beta1.Query/VotingDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) RawParams(ctx context.Context, in *QueryRawParamsRequest, opts ...grpc.CallOption) (*QueryRawParamsResponse, error) {
 	out := new(QueryRawParamsResponse)
 	err := c.cc.Invoke(ctx, "/kava.committee.v1beta1.Query/RawParams", in, out, opts...)
@@ -980,6 +1216,10 @@ type QueryServer interface {
 	Vote(context.Context, *QueryVoteRequest) (*QueryVoteResponse, error)
 	// Tally queries the tally of a single proposal ID.
 	Tally(context.Context, *QueryTallyRequest) (*QueryTallyResponse, error)
+	// QueuedProposals queries the passed proposals that are queued for execution.
+	QueuedProposals(context.Context, *QueryQueuedProposalsRequest) (*QueryQueuedProposalsResponse, error)
+	// VotingDelegation queries the representative token committee voting power is delegated to.
+	VotingDelegation(context.Context, *QueryVotingDelegationRequest) (*QueryVotingDelegationResponse, error)
 	// RawParams queries the raw params data of any subspace and key.
 	RawParams(context.Context, *QueryRawParamsRequest) (*QueryRawParamsResponse, error)
 }
@@ -1012,6 +1252,12 @@ func (*UnimplementedQueryServer) Vote(ctx context.Context, req *QueryVoteRequest
 func (*UnimplementedQueryServer) Tally(ctx context.Context, req *QueryTallyRequest) (*QueryTallyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Tally not implemented")
 }
+func (*UnimplementedQueryServer) QueuedProposals(ctx context.Context, req *QueryQueuedProposalsRequest) (*QueryQueuedProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueuedProposals not implemented")
+}
+func (*UnimplementedQueryServer) VotingDelegation(ctx context.Context, req *QueryVotingDelegationRequest) (*QueryVotingDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VotingDelegation not implemented")
+}
 func (*UnimplementedQueryServer) RawParams(ctx context.Context, req *QueryRawParamsRequest) (*QueryRawParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RawParams not implemented")
 }
@@ -1164,6 +1410,42 @@ func _Query_Tally_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_QueuedProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQueuedProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueuedProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.committee.v1beta1.Query/QueuedProposals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueuedProposals(ctx, req.(*QueryQueuedProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VotingDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVotingDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VotingDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.committee.v1beta1.Query/VotingDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VotingDelegation(ctx, req.(*QueryVotingDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_RawParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryRawParamsRequest)
 	if err := dec(in); err != nil {
@@ -1218,6 +1500,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Tally",
 			Handler:    _Query_Tally_Handler,
 		},
+		{
+			MethodName: "QueuedProposals",
+			Handler:    _Query_QueuedProposals_Handler,
+		},
+		{
+			MethodName: "VotingDelegation",
+			Handler:    _Query_VotingDelegation_Handler,
+		},
 		{
 			MethodName: "RawParams",
 			Handler:    _Query_RawParams_Handler,
@@ -1496,6 +1786,66 @@ func (m *QueryProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryQueuedProposalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQueuedProposalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQueuedProposalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQueuedProposalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQueuedProposalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQueuedProposalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.QueuedProposals) > 0 {
+		for iNdEx := len(m.QueuedProposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueuedProposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryNextProposalIDRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1759,6 +2109,20 @@ func (m *QueryTallyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Sources) > 0 {
+		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	{
 		size := m.Quorum.Size()
 		i -= size
@@ -1827,7 +2191,7 @@ func (m *QueryTallyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryRawParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *TallySource) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1837,33 +2201,153 @@ func (m *QueryRawParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryRawParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *TallySource) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryRawParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TallySource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Subspace) > 0 {
-		i -= len(m.Subspace)
-		copy(dAtA[i:], m.Subspace)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Subspace)))
-		i--
-		dAtA[i] = 0xa
+	{
+		size := m.CurrentVotes.Size()
+		i -= size
+		if _, err := m.CurrentVotes.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return len(dAtA) - i, nil
-}
-
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.NoVotes.Size()
+		i -= size
+		if _, err := m.NoVotes.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.YesVotes.Size()
+		i -= size
+		if _, err := m.YesVotes.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVotingDelegationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVotingDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVotingDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVotingDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVotingDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVotingDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Representative) > 0 {
+		i -= len(m.Representative)
+		copy(dAtA[i:], m.Representative)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Representative)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRawParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRawParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRawParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Subspace) > 0 {
+		i -= len(m.Subspace)
+		copy(dAtA[i:], m.Subspace)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Subspace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryRawParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2014,6 +2498,30 @@ func (m *QueryProposalResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryQueuedProposalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryQueuedProposalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.QueuedProposals) > 0 {
+		for _, e := range m.QueuedProposals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *QueryNextProposalIDRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2138,6 +2646,57 @@ func (m *QueryTallyResponse) Size() (n int) {
 	n += 1 + l + sovQuery(uint64(l))
 	l = m.Quorum.Size()
 	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Sources) > 0 {
+		for _, e := range m.Sources {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TallySource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.YesVotes.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.NoVotes.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CurrentVotes.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryVotingDelegationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVotingDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Representative)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -2845,7 +3404,7 @@ func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNextProposalIDRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryQueuedProposalsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2868,10 +3427,10 @@ func (m *QueryNextProposalIDRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNextProposalIDRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryQueuedProposalsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNextProposalIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryQueuedProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2895,7 +3454,7 @@ func (m *QueryNextProposalIDRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNextProposalIDResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryQueuedProposalsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2918,17 +3477,17 @@ func (m *QueryNextProposalIDResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNextProposalIDResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryQueuedProposalsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNextProposalIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryQueuedProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextProposalID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedProposals", wireType)
 			}
-			m.NextProposalID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2938,11 +3497,26 @@ func (m *QueryNextProposalIDResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NextProposalID |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueuedProposals = append(m.QueuedProposals, QueuedProposal{})
+			if err := m.QueuedProposals[len(m.QueuedProposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2964,7 +3538,7 @@ func (m *QueryNextProposalIDResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVotesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryNextProposalIDRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2987,58 +3561,177 @@ func (m *QueryVotesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVotesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNextProposalIDRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVotesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNextProposalIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
-			}
-			m.ProposalId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNextProposalIDResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNextProposalIDResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNextProposalIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextProposalID", wireType)
+			}
+			m.NextProposalID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextProposalID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVotesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVotesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVotesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
@@ -3731,6 +4424,388 @@ func (m *QueryTallyResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sources = append(m.Sources, TallySource{})
+			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TallySource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TallySource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TallySource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YesVotes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.YesVotes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoVotes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NoVotes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentVotes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentVotes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVotingDelegationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVotingDelegationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVotingDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVotingDelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVotingDelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVotingDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Representative", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Representative = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -0,0 +1,85 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Sources of token committee voting power
+const (
+	VotingPowerSourceBalance   = "balance"   // balance of the tally denom
+	VotingPowerSourceStaked    = "staked"    // tokens delegated to validators
+	VotingPowerSourceLiquid    = "liquid"    // staked tokens of liquid staking derivatives
+	VotingPowerSourceSavings   = "savings"   // staked tokens of liquid staking derivatives deposited in x/savings
+	VotingPowerSourceEarn      = "earn"      // staked tokens of liquid staking derivatives deposited in x/earn vaults
+	VotingPowerSourceDelegated = "delegated" // voting power delegated to the voter by delegators that did not vote
+)
+
+// VotingPower is the token committee voting power of an address from a single source.
+type VotingPower struct {
+	Source string
+	Amount sdkmath.Int
+}
+
+// NewVotingDelegation returns a new VotingDelegation
+func NewVotingDelegation(delegator, representative sdk.AccAddress) VotingDelegation {
+	return VotingDelegation{
+		Delegator:      delegator,
+		Representative: representative,
+	}
+}
+
+// Validate validates a voting delegation
+func (d VotingDelegation) Validate() error {
+	if d.Delegator.Empty() {
+		return fmt.Errorf("voting delegation delegator cannot be empty")
+	}
+	if d.Representative.Empty() {
+		return fmt.Errorf("voting delegation representative cannot be empty")
+	}
+	if d.Delegator.Equals(d.Representative) {
+		return fmt.Errorf("voting delegation representative cannot be the delegator, %s", d.Delegator)
+	}
+	return nil
+}
+
+// TallySources is a slice of TallySource
+type TallySources []TallySource
+
+// AddVote adds voting power to the votes of a source.
+func (ts TallySources) AddVote(source string, voteType VoteType, power sdk.Dec) TallySources {
+	i := sort.Search(len(ts), func(i int) bool { return ts[i].Source >= source })
+	if i == len(ts) || ts[i].Source != source {
+		ts = append(ts, TallySource{})
+		copy(ts[i+1:], ts[i:])
+		ts[i] = TallySource{
+			Source:       source,
+			YesVotes:     sdk.ZeroDec(),
+			NoVotes:      sdk.ZeroDec(),
+			CurrentVotes: sdk.ZeroDec(),
+		}
+	}
+
+	ts[i].CurrentVotes = ts[i].CurrentVotes.Add(power)
+	switch voteType {
+	case VOTE_TYPE_YES:
+		ts[i].YesVotes = ts[i].YesVotes.Add(power)
+	case VOTE_TYPE_NO:
+		ts[i].NoVotes = ts[i].NoVotes.Add(power)
+	}
+	return ts
+}
+
+// Totals returns the yes, no and total votes of all sources.
+func (ts TallySources) Totals() (yesVotes, noVotes, currentVotes sdk.Dec) {
+	yesVotes, noVotes, currentVotes = sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()
+	for _, s := range ts {
+		yesVotes = yesVotes.Add(s.YesVotes)
+		noVotes = noVotes.Add(s.NoVotes)
+		currentVotes = currentVotes.Add(s.CurrentVotes)
+	}
+	return yesVotes, noVotes, currentVotes
+}
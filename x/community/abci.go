@@ -16,5 +16,6 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 
 	// This exact call order is required to allow payout on the upgrade block
 	k.CheckAndDisableMintAndKavaDistInflation(ctx)
+	k.UpdateStakingRewardsPerSecond(ctx)
 	k.PayoutAccumulatedStakingRewards(ctx)
 }
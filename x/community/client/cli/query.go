@@ -25,6 +25,8 @@ func GetQueryCmd() *cobra.Command {
 		getCmdQueryParams(),
 		getCmdQueryBalance(),
 		getCmdQueryAnnualizedRewards(),
+		getCmdQueryTreasuryPositions(),
+		getCmdQueryStakingRewardsProjection(),
 	}
 
 	for _, cmd := range commands {
@@ -105,3 +107,49 @@ func getCmdQueryAnnualizedRewards() *cobra.Command {
 		},
 	}
 }
+
+// getCmdQueryTreasuryPositions implements a command to return the positions held by the community module account.
+func getCmdQueryTreasuryPositions() *cobra.Command {
+	return &cobra.Command{
+		Use:   "treasury-positions",
+		Short: "Query the hard, cdp, and swap positions held by the community module account with their USD value",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TreasuryPositions(cmd.Context(), &types.QueryTreasuryPositionsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+// getCmdQueryStakingRewardsProjection implements a command to return how long the community pool can sustain the current staking rewards.
+func getCmdQueryStakingRewardsProjection() *cobra.Command {
+	return &cobra.Command{
+		Use:   "staking-rewards-projection",
+		Short: "Query how long the community pool can sustain the current staking rewards per second",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.StakingRewardsProjection(cmd.Context(), &types.QueryStakingRewardsProjectionRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
@@ -193,6 +193,70 @@ Where proposal.json contains:
 	return cmd
 }
 
+// NewCmdSubmitCommunityPoolSwapExactForTokensProposal implements the command to submit a community-pool swap proposal
+func NewCmdSubmitCommunityPoolSwapExactForTokensProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "community-pool-swap-exact-for-tokens [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a community pool swap exact for tokens proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a community pool swap exact for tokens proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file.
+The swap fails when executed if the output differs from token_b by more than the slippage limit.
+Note that --deposit below is the initial proposal deposit submitted along with the proposal.
+Example:
+$ %s tx gov submit-proposal community-pool-swap-exact-for-tokens <path/to/proposal.json> --deposit 1000000000ukava --from=<key_or_address>
+Where proposal.json contains:
+{
+  "title": "Community Pool Swap",
+  "description": "Swap some KAVA from community pool for USDX!",
+  "exact_token_a": {
+    "denom": "ukava",
+    "amount": "100000000000"
+  },
+  "token_b": {
+    "denom": "usdx",
+    "amount": "50000000000"
+  },
+  "slippage": "0.010000000000000000"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			// parse proposal
+			proposal, err := utils.ParseCommunityPoolSwapExactForTokensProposal(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := parseInitialDeposit(cmd)
+			if err != nil {
+				return err
+			}
+			from := clientCtx.GetFromAddress()
+			msg, err := govv1beta1.NewMsgSubmitProposal(&proposal, deposit, from)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagDeposit, "", "Initial deposit for the proposal")
+
+	return cmd
+}
+
 func parseInitialDeposit(cmd *cobra.Command) (sdk.Coins, error) {
 	// parse initial deposit
 	depositStr, err := cmd.Flags().GetString(flagDeposit)
@@ -6,7 +6,7 @@ import (
 	"github.com/kava-labs/kava/x/community/client/cli"
 )
 
-// community-pool deposit/withdraw lend proposal handlers
+// community-pool deposit/withdraw lend and swap proposal handlers
 var (
 	LendDepositProposalHandler = govclient.NewProposalHandler(
 		cli.NewCmdSubmitCommunityPoolLendDepositProposal,
@@ -14,4 +14,7 @@ var (
 	LendWithdrawProposalHandler = govclient.NewProposalHandler(
 		cli.NewCmdSubmitCommunityPoolLendWithdrawProposal,
 	)
+	SwapExactForTokensProposalHandler = govclient.NewProposalHandler(
+		cli.NewCmdSubmitCommunityPoolSwapExactForTokensProposal,
+	)
 )
@@ -37,3 +37,18 @@ func ParseCommunityPoolLendWithdrawProposal(
 	err = cdc.UnmarshalJSON(contents, &proposal)
 	return proposal, err
 }
+
+// ParseCommunityPoolSwapExactForTokensProposal reads a JSON file and parses it to a CommunityPoolSwapExactForTokensProposal
+func ParseCommunityPoolSwapExactForTokensProposal(
+	cdc codec.JSONCodec,
+	proposalFile string,
+) (types.CommunityPoolSwapExactForTokensProposal, error) {
+	proposal := types.CommunityPoolSwapExactForTokensProposal{}
+	contents, err := os.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	err = cdc.UnmarshalJSON(contents, &proposal)
+	return proposal, err
+}
@@ -62,6 +62,33 @@ func TestParseWithdrawProposal(t *testing.T) {
 	require.Equal(t, expectedAmount, proposal.Amount)
 }
 
+func TestParseSwapExactForTokensProposal(t *testing.T) {
+	cdc := codec.NewAminoCodec(codec.NewLegacyAmino())
+	okJSON := testutil.WriteToNewTempFile(t, `
+{
+  "title": "Community Pool Swap",
+  "description": "Swap some KAVA from community pool for USDX!",
+  "exact_token_a": {
+    "denom": "ukava",
+    "amount": "100000000000"
+  },
+  "token_b": {
+    "denom": "usdx",
+    "amount": "50000000000"
+  },
+  "slippage": "0.010000000000000000"
+}
+`)
+	proposal, err := utils.ParseCommunityPoolSwapExactForTokensProposal(cdc, okJSON.Name())
+	require.NoError(t, err)
+
+	require.Equal(t, "Community Pool Swap", proposal.Title)
+	require.Equal(t, "Swap some KAVA from community pool for USDX!", proposal.Description)
+	require.Equal(t, sdk.NewInt64Coin("ukava", 100000000000), proposal.ExactTokenA)
+	require.Equal(t, sdk.NewInt64Coin("usdx", 50000000000), proposal.TokenB)
+	require.Equal(t, sdk.MustNewDecFromStr("0.01"), proposal.Slippage)
+}
+
 func TestParseFileNoExists(t *testing.T) {
 	cdc := codec.NewAminoCodec(codec.NewLegacyAmino())
 	_, err := utils.ParseCommunityPoolLendDepositProposal(cdc, "not-a-file.json")
@@ -22,6 +22,8 @@ func NewCommunityPoolProposalHandler(k keeper.Keeper) govv1beta1.Handler {
 			return keeper.HandleCommunityPoolLendDepositProposal(ctx, k, c)
 		case *types.CommunityPoolLendWithdrawProposal:
 			return keeper.HandleCommunityPoolLendWithdrawProposal(ctx, k, c)
+		case *types.CommunityPoolSwapExactForTokensProposal:
+			return keeper.HandleCommunityPoolSwapExactForTokensProposal(ctx, k, c)
 		default:
 			return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized community proposal content type: %T", c)
 		}
@@ -90,6 +90,39 @@ func (s queryServer) AnnualizedRewards(
 	}, nil
 }
 
+// TreasuryPositions returns the hard, cdp, and swap positions held by the x/community module account.
+func (s queryServer) TreasuryPositions(
+	c context.Context,
+	_ *types.QueryTreasuryPositionsRequest,
+) (*types.QueryTreasuryPositionsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	positions := s.keeper.GetTreasuryPositions(ctx)
+
+	return &types.QueryTreasuryPositionsResponse{
+		Positions:   positions,
+		NetUSDValue: positions.NetUSDValue(),
+	}, nil
+}
+
+// StakingRewardsProjection projects how long the community pool can sustain the current staking rewards per second.
+func (s queryServer) StakingRewardsProjection(
+	c context.Context,
+	_ *types.QueryStakingRewardsProjectionRequest,
+) (*types.QueryStakingRewardsProjectionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	params := s.keeper.mustGetParams(ctx)
+	bondDenom := s.keeper.stakingKeeper.BondDenom(ctx)
+	balance := s.keeper.bankKeeper.GetBalance(ctx, s.keeper.moduleAddress, bondDenom)
+
+	return &types.QueryStakingRewardsProjectionResponse{
+		StakingRewardsPerSecond: params.StakingRewardsPerSecond,
+		Balance:                 balance,
+		RunwaySeconds:           CalculateStakingRewardsRunway(balance.Amount, params.StakingRewardsPerSecond),
+	}, nil
+}
+
 // convertDecToLegacyDec is a helper method for converting between new and old Dec types
 // current version of cosmos-sdk in this repo uses sdk.Dec
 // this module uses sdkmath.LegacyDec in its parameters
@@ -14,6 +14,7 @@ import (
 	"github.com/kava-labs/kava/x/community/keeper"
 	"github.com/kava-labs/kava/x/community/testutil"
 	"github.com/kava-labs/kava/x/community/types"
+	swaptypes "github.com/kava-labs/kava/x/swap/types"
 )
 
 type grpcQueryTestSuite struct {
@@ -87,6 +88,37 @@ func (suite *grpcQueryTestSuite) TestGrpcQueryBalance() {
 	}
 }
 
+func (suite *grpcQueryTestSuite) TestGrpcQueryTreasuryPositions() {
+	suite.Run("handles response with no positions", func() {
+		suite.SetupTest()
+		res, err := suite.queryClient.TreasuryPositions(context.Background(), &types.QueryTreasuryPositionsRequest{})
+		suite.Require().NoError(err)
+		suite.Empty(res.Positions)
+		suite.Equal(sdk.ZeroDec(), res.NetUSDValue)
+	})
+
+	suite.Run("handles response with unpriced positions", func() {
+		suite.SetupTest()
+
+		// deposit community funds into a swap pool without pricefeed markets
+		liquidity := sdk.NewCoins(sdk.NewInt64Coin("other-denom", 1e6), sdk.NewInt64Coin("ukava", 1e6))
+		suite.Require().NoError(suite.App.FundModuleAccount(suite.Ctx, types.ModuleName, liquidity))
+		swapKeeper := suite.App.GetSwapKeeper()
+		swapKeeper.SetParams(suite.Ctx, swaptypes.NewParams(
+			swaptypes.NewAllowedPools(swaptypes.NewAllowedPool("other-denom", "ukava")),
+			sdk.ZeroDec(),
+		))
+		suite.Require().NoError(swapKeeper.Deposit(suite.Ctx, suite.MaccAddress, liquidity[0], liquidity[1], sdk.OneDec()))
+
+		res, err := suite.queryClient.TreasuryPositions(context.Background(), &types.QueryTreasuryPositionsRequest{})
+		suite.Require().NoError(err)
+		suite.Equal(types.TreasuryPositions{
+			types.NewTreasuryPosition(types.TREASURY_POSITION_TYPE_SWAP_LIQUIDITY, "other-denom:ukava", liquidity, sdk.ZeroDec()),
+		}, res.Positions)
+		suite.Equal(sdk.ZeroDec(), res.NetUSDValue)
+	})
+}
+
 func (suite *grpcQueryTestSuite) TestGrpcQueryTotalBalance() {
 	var expCoins sdk.DecCoins
 
@@ -166,6 +198,57 @@ func (suite *grpcQueryTestSuite) TestGrpcQueryTotalBalance() {
 	}
 }
 
+func (suite *grpcQueryTestSuite) TestGrpcQueryStakingRewardsProjection() {
+	testCases := []struct {
+		name           string
+		balance        sdkmath.Int
+		rewardsPerSec  sdkmath.LegacyDec
+		expectedRunway sdkmath.Int
+	}{
+		{
+			name:           "no rewards => zero runway",
+			balance:        sdkmath.NewInt(1e6),
+			rewardsPerSec:  sdkmath.LegacyZeroDec(),
+			expectedRunway: sdkmath.ZeroInt(),
+		},
+		{
+			name:           "empty pool => zero runway",
+			balance:        sdkmath.ZeroInt(),
+			rewardsPerSec:  sdkmath.LegacyNewDec(10),
+			expectedRunway: sdkmath.ZeroInt(),
+		},
+		{
+			name:           "balance sustains rewards",
+			balance:        sdkmath.NewInt(1e6),
+			rewardsPerSec:  sdkmath.LegacyMustNewDecFromStr("0.3"),
+			expectedRunway: sdkmath.NewInt(3333333),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			bondDenom := suite.App.GetStakingKeeper().BondDenom(suite.Ctx)
+			if tc.balance.IsPositive() {
+				err := suite.App.FundModuleAccount(suite.Ctx, types.ModuleAccountName, sdk.NewCoins(sdk.NewCoin(bondDenom, tc.balance)))
+				suite.Require().NoError(err)
+			}
+
+			params, _ := suite.Keeper.GetParams(suite.Ctx)
+			params.StakingRewardsPerSecond = tc.rewardsPerSec
+			suite.Keeper.SetParams(suite.Ctx, params)
+
+			res, err := suite.queryClient.StakingRewardsProjection(context.Background(), &types.QueryStakingRewardsProjectionRequest{})
+			suite.Require().NoError(err)
+			suite.Equal(tc.rewardsPerSec, res.StakingRewardsPerSecond)
+			suite.Equal(bondDenom, res.Balance.Denom)
+			suite.Equal(tc.balance.Int64(), res.Balance.Amount.Int64())
+			suite.Equal(tc.expectedRunway.Int64(), res.RunwaySeconds.Int64())
+		})
+	}
+}
+
 // NOTE: this test makes use of the fact that there is always an initial 1e6 bonded tokens
 // To adjust the bonded ratio, it adjusts the total supply by minting tokens.
 func (suite *grpcQueryTestSuite) TestGrpcQueryAnnualizedRewards() {
@@ -16,15 +16,17 @@ type Keeper struct {
 	key storetypes.StoreKey
 	cdc codec.Codec
 
-	accountKeeper  types.AccountKeeper
-	bankKeeper     types.BankKeeper
-	cdpKeeper      types.CdpKeeper
-	distrKeeper    types.DistributionKeeper
-	hardKeeper     types.HardKeeper
-	moduleAddress  sdk.AccAddress
-	mintKeeper     types.MintKeeper
-	kavadistKeeper types.KavadistKeeper
-	stakingKeeper  types.StakingKeeper
+	accountKeeper   types.AccountKeeper
+	bankKeeper      types.BankKeeper
+	cdpKeeper       types.CdpKeeper
+	distrKeeper     types.DistributionKeeper
+	hardKeeper      types.HardKeeper
+	moduleAddress   sdk.AccAddress
+	mintKeeper      types.MintKeeper
+	kavadistKeeper  types.KavadistKeeper
+	stakingKeeper   types.StakingKeeper
+	swapKeeper      types.SwapKeeper
+	pricefeedKeeper types.PricefeedKeeper
 
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
@@ -45,6 +47,8 @@ func NewKeeper(
 	mk types.MintKeeper,
 	kk types.KavadistKeeper,
 	sk types.StakingKeeper,
+	swk types.SwapKeeper,
+	pk types.PricefeedKeeper,
 	authority sdk.AccAddress,
 ) Keeper {
 	// ensure community module account is set
@@ -64,15 +68,17 @@ func NewKeeper(
 		key: key,
 		cdc: cdc,
 
-		accountKeeper:  ak,
-		bankKeeper:     bk,
-		cdpKeeper:      ck,
-		distrKeeper:    dk,
-		hardKeeper:     hk,
-		mintKeeper:     mk,
-		kavadistKeeper: kk,
-		stakingKeeper:  sk,
-		moduleAddress:  addr,
+		accountKeeper:   ak,
+		bankKeeper:      bk,
+		cdpKeeper:       ck,
+		distrKeeper:     dk,
+		hardKeeper:      hk,
+		mintKeeper:      mk,
+		kavadistKeeper:  kk,
+		stakingKeeper:   sk,
+		swapKeeper:      swk,
+		pricefeedKeeper: pk,
+		moduleAddress:   addr,
 
 		authority:                  authority,
 		legacyCommunityPoolAddress: legacyAddr,
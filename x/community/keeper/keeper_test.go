@@ -120,6 +120,7 @@ func (suite *KeeperTestSuite) TestGetAuthority_Any() {
 
 	for _, tc := range tests {
 		suite.Run(tc.name, func() {
+			swapKeeper := suite.App.GetSwapKeeper()
 			suite.NotPanics(func() {
 				suite.Keeper = keeper.NewKeeper(
 					suite.App.AppCodec(),
@@ -132,6 +133,8 @@ func (suite *KeeperTestSuite) TestGetAuthority_Any() {
 					suite.App.GetMintKeeper(),
 					suite.App.GetKavadistKeeper(),
 					suite.App.GetStakingKeeper(),
+					&swapKeeper,
+					suite.App.GetPriceFeedKeeper(),
 					tc.authority,
 				)
 			})
@@ -166,6 +169,7 @@ func (suite *KeeperTestSuite) TestNewKeeper_InvalidAuthority() {
 
 	for _, tc := range tests {
 		suite.Run(tc.name, func() {
+			swapKeeper := suite.App.GetSwapKeeper()
 			suite.PanicsWithValue(
 				tc.panicStr,
 				func() {
@@ -180,6 +184,8 @@ func (suite *KeeperTestSuite) TestNewKeeper_InvalidAuthority() {
 						suite.App.GetMintKeeper(),
 						suite.App.GetKavadistKeeper(),
 						suite.App.GetStakingKeeper(),
+						&swapKeeper,
+						suite.App.GetPriceFeedKeeper(),
 						tc.authority,
 					)
 				})
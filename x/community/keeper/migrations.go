@@ -3,6 +3,7 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	v2 "github.com/kava-labs/kava/x/community/migrations/v2"
+	v3 "github.com/kava-labs/kava/x/community/migrations/v3"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -25,3 +26,12 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 		m.keeper.cdc,
 	)
 }
+
+// Migrate2to3 migrates from version 2 to 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v3.Migrate(
+		ctx,
+		ctx.KVStore(m.keeper.key),
+		m.keeper.cdc,
+	)
+}
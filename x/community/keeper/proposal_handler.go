@@ -39,3 +39,14 @@ func HandleCommunityCDPWithdrawCollateralProposal(
 	// withdraw collateral
 	return k.cdpKeeper.WithdrawCollateral(ctx, k.moduleAddress, k.moduleAddress, p.Collateral, p.CollateralType)
 }
+
+// HandleCommunityPoolSwapExactForTokensProposal is a handler for executing a passed
+// community pool swap exact for tokens proposal.
+func HandleCommunityPoolSwapExactForTokensProposal(
+	ctx sdk.Context,
+	k Keeper,
+	p *types.CommunityPoolSwapExactForTokensProposal,
+) error {
+	// swap community module account funds, the output is returned to the community module account
+	return k.swapKeeper.SwapExactForTokens(ctx, k.moduleAddress, p.ExactTokenA, p.TokenB, p.Slippage)
+}
@@ -20,6 +20,7 @@ import (
 	hardkeeper "github.com/kava-labs/kava/x/hard/keeper"
 	hardtypes "github.com/kava-labs/kava/x/hard/types"
 	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
+	swaptypes "github.com/kava-labs/kava/x/swap/types"
 )
 
 const chainID = app.TestChainId
@@ -560,3 +561,103 @@ func (suite *proposalTestSuite) TestCommunityCDPWithdrawCollateralProposal() {
 		})
 	}
 }
+
+// expectation: community module funds are swapped through x/swap and the output is
+// returned to the community module.
+func (suite *proposalTestSuite) TestCommunityPoolSwapExactForTokensProposal() {
+	testcases := []struct {
+		name        string
+		proposal    *types.CommunityPoolSwapExactForTokensProposal
+		expectedErr string
+	}{
+		{
+			name: "valid - swap within slippage",
+			proposal: types.NewCommunityPoolSwapExactForTokensProposal(
+				"diversify treasury",
+				"swap some kava for usdx",
+				c("ukava", 1e8),
+				c("usdx", 1e8),
+				sdk.MustNewDecFromStr("0.02"),
+			),
+			expectedErr: "",
+		},
+		{
+			name: "invalid - slippage exceeded",
+			proposal: types.NewCommunityPoolSwapExactForTokensProposal(
+				"diversify treasury",
+				"swap some kava for usdx",
+				c("ukava", 1e8),
+				c("usdx", 1e8),
+				sdk.MustNewDecFromStr("0.001"),
+			),
+			expectedErr: "slippage exceeded",
+		},
+		{
+			name: "invalid - pool does not exist",
+			proposal: types.NewCommunityPoolSwapExactForTokensProposal(
+				"diversify treasury",
+				"swap some other-denom for usdx",
+				c("other-denom", 1e8),
+				c("usdx", 1e8),
+				sdk.MustNewDecFromStr("0.02"),
+			),
+			expectedErr: "invalid pool",
+		},
+		{
+			name: "invalid - insufficient funds",
+			proposal: types.NewCommunityPoolSwapExactForTokensProposal(
+				"diversify treasury",
+				"swap more kava than the community pool holds",
+				c("ukava", 3e10),
+				c("usdx", 1e9),
+				sdk.OneDec(),
+			),
+			expectedErr: "insufficient funds",
+		},
+	}
+
+	for _, tc := range testcases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.CreateSwapPool(c("ukava", 1e10), c("usdx", 1e10))
+
+			// setup the community module with some initial funds
+			err := suite.App.FundModuleAccount(suite.Ctx, types.ModuleAccountName, ukava(2e10).Add(otherdenom(1e9)...))
+			suite.NoError(err, "failed to initially fund module account for swap")
+
+			balanceBefore := suite.Keeper.GetModuleAccountBalance(suite.Ctx)
+
+			err = keeper.HandleCommunityPoolSwapExactForTokensProposal(suite.Ctx, suite.Keeper, tc.proposal)
+			balanceAfter := suite.Keeper.GetModuleAccountBalance(suite.Ctx)
+
+			if tc.expectedErr != "" {
+				suite.ErrorContains(err, tc.expectedErr)
+				suite.True(balanceBefore.IsEqual(balanceAfter), "module balance changed unexpectedly")
+				return
+			}
+			suite.NoError(err)
+
+			// exact token a is sold and at least the slippage adjusted token b is received
+			tokenA := tc.proposal.ExactTokenA
+			tokenB := tc.proposal.TokenB
+			suite.Equal(balanceBefore.AmountOf(tokenA.Denom).Sub(tokenA.Amount), balanceAfter.AmountOf(tokenA.Denom))
+
+			received := balanceAfter.AmountOf(tokenB.Denom).Sub(balanceBefore.AmountOf(tokenB.Denom))
+			minReceived := sdk.OneDec().Sub(tc.proposal.Slippage).MulInt(tokenB.Amount).TruncateInt()
+			suite.True(received.GTE(minReceived), "received %s, expected at least %s", received, minReceived)
+		})
+	}
+}
+
+// CreateSwapPool allows the pool in x/swap params and seeds it with liquidity from a new account.
+func (suite *proposalTestSuite) CreateSwapPool(tokenA, tokenB sdk.Coin) {
+	swapKeeper := suite.App.GetSwapKeeper()
+	swapKeeper.SetParams(suite.Ctx, swaptypes.NewParams(
+		swaptypes.NewAllowedPools(swaptypes.NewAllowedPool(tokenA.Denom, tokenB.Denom)),
+		sdk.ZeroDec(),
+	))
+
+	depositor := app.RandomAddress()
+	suite.NoError(suite.App.FundAccount(suite.Ctx, depositor, sdk.NewCoins(tokenA, tokenB)))
+	suite.NoError(swapKeeper.Deposit(suite.Ctx, depositor, tokenA, tokenB, sdk.OneDec()))
+}
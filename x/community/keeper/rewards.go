@@ -25,3 +25,52 @@ func CalculateStakingAnnualPercentage(totalSupply, totalBonded sdkmath.Int, infl
 	// divide by total bonded tokens to get the percent return
 	return amountGivenPerYear.QuoInt(totalBonded)
 }
+
+// CalculateTargetStakingRewardsPerSecond returns the rewards per second the community module must pay to keep
+// the annualized staking reward rate within [minAPY, maxAPY]. The current rate is kept while it results in an
+// APY within the band, otherwise the rate is moved to the nearest bound, accounting for rewards provided by inflation.
+func CalculateTargetStakingRewardsPerSecond(
+	totalSupply, totalBonded sdkmath.Int,
+	inflationRate, communityTax, rewardsPerSecond, minAPY, maxAPY sdkmath.LegacyDec,
+) sdkmath.LegacyDec {
+	// no rewards are needed if no tokens are bonded
+	if totalBonded.IsZero() {
+		return sdkmath.LegacyZeroDec()
+	}
+
+	targetAPY := CalculateStakingAnnualPercentage(totalSupply, totalBonded, inflationRate, communityTax, rewardsPerSecond)
+	switch {
+	case targetAPY.LT(minAPY):
+		targetAPY = minAPY
+	case targetAPY.GT(maxAPY):
+		targetAPY = maxAPY
+	default:
+		return rewardsPerSecond
+	}
+
+	// the total amount of tokens distributed to stakers in a year by the mint & distribution modules
+	amountProvidedByInflation := inflationRate.MulInt(totalSupply).Mul(sdkmath.LegacyOneDec().Sub(communityTax))
+	// the remaining amount must be provided by the community module
+	amountRequiredPerYear := targetAPY.MulInt(totalBonded).Sub(amountProvidedByInflation)
+	if amountRequiredPerYear.IsNegative() {
+		return sdkmath.LegacyZeroDec()
+	}
+
+	return amountRequiredPerYear.QuoInt64(SecondsPerYear)
+}
+
+// CalculateMaxStakingRewardsPerSecond returns the highest rewards per second that pays out no more than
+// maxAnnualPoolSpend of the community pool balance over a year.
+func CalculateMaxStakingRewardsPerSecond(communityPoolBalance sdkmath.Int, maxAnnualPoolSpend sdkmath.LegacyDec) sdkmath.LegacyDec {
+	return maxAnnualPoolSpend.MulInt(communityPoolBalance).QuoInt64(SecondsPerYear)
+}
+
+// CalculateStakingRewardsRunway returns the number of whole seconds the community pool balance can sustain
+// the rewards per second. It returns zero when no rewards are paid.
+func CalculateStakingRewardsRunway(communityPoolBalance sdkmath.Int, rewardsPerSecond sdkmath.LegacyDec) sdkmath.Int {
+	if !rewardsPerSecond.IsPositive() {
+		return sdkmath.ZeroInt()
+	}
+
+	return sdkmath.LegacyNewDecFromInt(communityPoolBalance).Quo(rewardsPerSecond).TruncateInt()
+}
@@ -187,3 +187,144 @@ func TestStakingRewardsCalculator(t *testing.T) {
 		})
 	}
 }
+
+func TestTargetStakingRewardsCalculator(t *testing.T) {
+	minAPY := sdkmath.LegacyMustNewDecFromStr("0.1")
+	maxAPY := sdkmath.LegacyMustNewDecFromStr("0.2")
+
+	testCases := []struct {
+		name         string
+		totalSupply  sdkmath.Int
+		totalBonded  sdkmath.Int
+		inflation    sdkmath.LegacyDec
+		communityTax sdkmath.LegacyDec
+		perSecReward sdkmath.LegacyDec
+		expectedRate sdkmath.LegacyDec
+	}{
+		{
+			name:         "no bonded tokens -> no rewards",
+			totalSupply:  sdk.NewInt(1000e6),
+			totalBonded:  sdkmath.ZeroInt(),
+			inflation:    sdkmath.LegacyZeroDec(),
+			communityTax: sdkmath.LegacyZeroDec(),
+			perSecReward: sdkmath.LegacyOneDec(),
+			expectedRate: sdkmath.LegacyZeroDec(),
+		},
+		{
+			name:         "apy within band -> rate unchanged",
+			totalSupply:  sdk.NewInt(1000e6),
+			totalBonded:  sdkmath.NewInt(100e6),
+			inflation:    sdkmath.LegacyZeroDec(),
+			communityTax: sdkmath.LegacyZeroDec(),
+			perSecReward: sdkmath.LegacyMustNewDecFromStr("0.5"), // 15.768% apy
+			expectedRate: sdkmath.LegacyMustNewDecFromStr("0.5"),
+		},
+		{
+			name:         "apy below band -> rate raised to min apy",
+			totalSupply:  sdk.NewInt(1000e6),
+			totalBonded:  sdkmath.NewInt(100e6),
+			inflation:    sdkmath.LegacyZeroDec(),
+			communityTax: sdkmath.LegacyZeroDec(),
+			perSecReward: sdkmath.LegacyZeroDec(),
+			expectedRate: sdkmath.LegacyMustNewDecFromStr("0.317097919837645865"), // 10e6 / seconds per year
+		},
+		{
+			name:         "apy above band -> rate lowered to max apy",
+			totalSupply:  sdk.NewInt(1000e6),
+			totalBonded:  sdkmath.NewInt(100e6),
+			inflation:    sdkmath.LegacyZeroDec(),
+			communityTax: sdkmath.LegacyZeroDec(),
+			perSecReward: sdkmath.LegacyOneDec(),                                  // 31.536% apy
+			expectedRate: sdkmath.LegacyMustNewDecFromStr("0.634195839675291730"), // 20e6 / seconds per year
+		},
+		{
+			name:         "inflation below band -> community pays the remainder",
+			totalSupply:  sdk.NewInt(1000e6),
+			totalBonded:  sdkmath.NewInt(100e6),
+			inflation:    sdkmath.LegacyMustNewDecFromStr("0.005"),
+			communityTax: sdkmath.LegacyMustNewDecFromStr("0.2"),
+			perSecReward: sdkmath.LegacyZeroDec(),
+			expectedRate: sdkmath.LegacyMustNewDecFromStr("0.190258751902587519"), // (10e6 - 4e6) / seconds per year
+		},
+		{
+			name:         "inflation above band -> no rewards",
+			totalSupply:  sdk.NewInt(1000e6),
+			totalBonded:  sdkmath.NewInt(100e6),
+			inflation:    sdkmath.LegacyMustNewDecFromStr("0.05"),
+			communityTax: sdkmath.LegacyZeroDec(),
+			perSecReward: sdkmath.LegacyOneDec(),
+			expectedRate: sdkmath.LegacyZeroDec(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rewardsPerSecond := keeper.CalculateTargetStakingRewardsPerSecond(
+				tc.totalSupply,
+				tc.totalBonded,
+				tc.inflation,
+				tc.communityTax,
+				tc.perSecReward,
+				minAPY,
+				maxAPY,
+			)
+			require.Equal(t, tc.expectedRate, rewardsPerSecond)
+		})
+	}
+}
+
+func TestMaxStakingRewardsCalculator(t *testing.T) {
+	require.Equal(t,
+		sdkmath.LegacyNewDec(500000),
+		keeper.CalculateMaxStakingRewardsPerSecond(sdkmath.NewInt(31536000e6), sdkmath.LegacyMustNewDecFromStr("0.5")),
+	)
+	require.Equal(t,
+		sdkmath.LegacyMustNewDecFromStr("3170.979198376458650431"),
+		keeper.CalculateMaxStakingRewardsPerSecond(sdkmath.NewInt(1e12), sdkmath.LegacyMustNewDecFromStr("0.1")),
+	)
+	require.Equal(t,
+		sdkmath.LegacyZeroDec(),
+		keeper.CalculateMaxStakingRewardsPerSecond(sdkmath.ZeroInt(), sdkmath.LegacyOneDec()),
+	)
+}
+
+func TestStakingRewardsRunwayCalculator(t *testing.T) {
+	testCases := []struct {
+		name           string
+		balance        sdkmath.Int
+		perSecReward   sdkmath.LegacyDec
+		expectedRunway sdkmath.Int
+	}{
+		{
+			name:           "no rewards -> zero runway",
+			balance:        sdkmath.NewInt(1000),
+			perSecReward:   sdkmath.LegacyZeroDec(),
+			expectedRunway: sdkmath.ZeroInt(),
+		},
+		{
+			name:           "empty pool -> zero runway",
+			balance:        sdkmath.ZeroInt(),
+			perSecReward:   sdkmath.LegacyOneDec(),
+			expectedRunway: sdkmath.ZeroInt(),
+		},
+		{
+			name:           "partial seconds are truncated",
+			balance:        sdkmath.NewInt(1000),
+			perSecReward:   sdkmath.LegacyNewDec(3),
+			expectedRunway: sdkmath.NewInt(333),
+		},
+		{
+			name:           "fractional rewards per second",
+			balance:        sdkmath.NewInt(1000),
+			perSecReward:   sdkmath.LegacyMustNewDecFromStr("0.1"),
+			expectedRunway: sdkmath.NewInt(10000),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			runway := keeper.CalculateStakingRewardsRunway(tc.balance, tc.perSecReward)
+			require.Equal(t, tc.expectedRunway.Int64(), runway.Int64())
+		})
+	}
+}
@@ -71,6 +71,53 @@ func (k Keeper) PayoutAccumulatedStakingRewards(ctx sdk.Context) {
 	k.SetStakingRewardsState(ctx, state)
 }
 
+// UpdateStakingRewardsPerSecond recomputes the staking rewards per second from the total bonded tokens
+// when the staking rewards schedule is in target apy mode. The rate is capped by the community pool balance.
+func (k Keeper) UpdateStakingRewardsPerSecond(ctx sdk.Context) {
+	params := k.mustGetParams(ctx)
+	schedule := params.StakingRewardsSchedule
+	if schedule.Mode != types.STAKING_REWARDS_MODE_TARGET_APY {
+		return
+	}
+
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	totalSupply := k.bankKeeper.GetSupply(ctx, bondDenom).Amount
+	totalBonded := k.stakingKeeper.TotalBondedTokens(ctx)
+	inflationRate := convertDecToLegacyDec(k.mintKeeper.GetMinter(ctx).Inflation)
+	communityTax := convertDecToLegacyDec(k.distrKeeper.GetCommunityTax(ctx))
+
+	rewardsPerSecond := CalculateTargetStakingRewardsPerSecond(
+		totalSupply,
+		totalBonded,
+		inflationRate,
+		communityTax,
+		params.StakingRewardsPerSecond,
+		schedule.MinAPY,
+		schedule.MaxAPY,
+	)
+
+	// cap the rate to the allowed spend of the community pool balance
+	communityPoolBalance := k.bankKeeper.GetBalance(ctx, k.moduleAddress, bondDenom).Amount
+	maxRewardsPerSecond := CalculateMaxStakingRewardsPerSecond(communityPoolBalance, schedule.MaxAnnualPoolSpend)
+	if rewardsPerSecond.GT(maxRewardsPerSecond) {
+		rewardsPerSecond = maxRewardsPerSecond
+	}
+
+	if rewardsPerSecond.Equal(params.StakingRewardsPerSecond) {
+		return
+	}
+
+	params.StakingRewardsPerSecond = rewardsPerSecond
+	k.SetParams(ctx, params)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeStakingRewardsRateUpdated,
+			sdk.NewAttribute(types.AttributeKeyStakingRewardsPerSecond, rewardsPerSecond.String()),
+		),
+	)
+}
+
 // calculateStakingRewards takes the currentBlockTime, state of last accumulation, rewards per second, and the community pool balance
 // in order to calculate the total payout since the last accumulation time.  It returns the truncated payout amount and the truncation error.
 func calculateStakingRewards(currentBlockTime, lastAccumulationTime time.Time, lastTruncationError, stakingRewardsPerSecond, communityPoolBalance sdkmath.LegacyDec) (sdkmath.Int, sdkmath.LegacyDec) {
@@ -3,11 +3,13 @@ package keeper_test
 import (
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/kava-labs/kava/x/community/keeper"
 	"github.com/kava-labs/kava/x/community/testutil"
+	"github.com/kava-labs/kava/x/community/types"
 )
 
 func TestKeeperPayoutAccumulatedStakingRewards(t *testing.T) {
@@ -16,3 +18,114 @@ func TestKeeperPayoutAccumulatedStakingRewards(t *testing.T) {
 	}
 	suite.Run(t, testutil.NewStakingRewardsTestSuite(testFunc))
 }
+
+type stakingRewardsScheduleTestSuite struct {
+	testutil.Suite
+}
+
+func TestStakingRewardsScheduleTestSuite(t *testing.T) {
+	suite.Run(t, new(stakingRewardsScheduleTestSuite))
+}
+
+// NOTE: this test makes use of the fact that there is always an initial 1e6 bonded tokens
+func (suite *stakingRewardsScheduleTestSuite) TestUpdateStakingRewardsPerSecond() {
+	targetSchedule := types.NewStakingRewardsSchedule(
+		types.STAKING_REWARDS_MODE_TARGET_APY,
+		sdkmath.LegacyMustNewDecFromStr("0.1"),
+		sdkmath.LegacyMustNewDecFromStr("0.2"),
+		sdkmath.LegacyOneDec(),
+	)
+
+	testCases := []struct {
+		name          string
+		schedule      types.StakingRewardsSchedule
+		poolBalance   sdkmath.Int
+		rewardsPerSec sdkmath.LegacyDec
+		expectedRate  sdkmath.LegacyDec
+	}{
+		{
+			name:          "fixed mode does not change the rate",
+			schedule:      types.DefaultStakingRewardsSchedule,
+			poolBalance:   sdkmath.NewInt(1e12),
+			rewardsPerSec: sdkmath.LegacyNewDec(1000),
+			expectedRate:  sdkmath.LegacyNewDec(1000),
+		},
+		{
+			name:          "target mode keeps a rate within the apy band",
+			schedule:      targetSchedule,
+			poolBalance:   sdkmath.NewInt(1e12),
+			rewardsPerSec: sdkmath.LegacyMustNewDecFromStr("0.005"), // 15.768% apy
+			expectedRate:  sdkmath.LegacyMustNewDecFromStr("0.005"),
+		},
+		{
+			name:          "target mode raises the rate to the min apy",
+			schedule:      targetSchedule,
+			poolBalance:   sdkmath.NewInt(1e12),
+			rewardsPerSec: sdkmath.LegacyZeroDec(),
+			expectedRate:  sdkmath.LegacyNewDec(1e5).QuoInt64(keeper.SecondsPerYear),
+		},
+		{
+			name:          "target mode lowers the rate to the max apy",
+			schedule:      targetSchedule,
+			poolBalance:   sdkmath.NewInt(1e12),
+			rewardsPerSec: sdkmath.LegacyNewDec(1000),
+			expectedRate:  sdkmath.LegacyNewDec(2e5).QuoInt64(keeper.SecondsPerYear),
+		},
+		{
+			name: "target mode caps the rate to the max annual pool spend",
+			schedule: types.NewStakingRewardsSchedule(
+				types.STAKING_REWARDS_MODE_TARGET_APY,
+				sdkmath.LegacyMustNewDecFromStr("0.1"),
+				sdkmath.LegacyMustNewDecFromStr("0.2"),
+				sdkmath.LegacyMustNewDecFromStr("0.5"),
+			),
+			poolBalance:   sdkmath.NewInt(1e5),
+			rewardsPerSec: sdkmath.LegacyZeroDec(),
+			expectedRate:  sdkmath.LegacyNewDec(5e4).QuoInt64(keeper.SecondsPerYear),
+		},
+		{
+			name:          "target mode pays nothing from an empty pool",
+			schedule:      targetSchedule,
+			poolBalance:   sdkmath.ZeroInt(),
+			rewardsPerSec: sdkmath.LegacyNewDec(1000),
+			expectedRate:  sdkmath.LegacyZeroDec(),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			// disable rewards from inflation so only the community module pays staking rewards
+			mk := suite.App.GetMintKeeper()
+			minter := mk.GetMinter(suite.Ctx)
+			minter.Inflation = sdk.ZeroDec()
+			mk.SetMinter(suite.Ctx, minter)
+
+			bondDenom := suite.App.GetStakingKeeper().BondDenom(suite.Ctx)
+			if tc.poolBalance.IsPositive() {
+				err := suite.App.FundModuleAccount(suite.Ctx, types.ModuleAccountName, sdk.NewCoins(sdk.NewCoin(bondDenom, tc.poolBalance)))
+				suite.Require().NoError(err)
+			}
+
+			params, found := suite.Keeper.GetParams(suite.Ctx)
+			suite.Require().True(found)
+			params.StakingRewardsPerSecond = tc.rewardsPerSec
+			params.StakingRewardsSchedule = tc.schedule
+			suite.Keeper.SetParams(suite.Ctx, params)
+
+			suite.Keeper.UpdateStakingRewardsPerSecond(suite.Ctx)
+
+			params, _ = suite.Keeper.GetParams(suite.Ctx)
+			suite.Equal(tc.expectedRate, params.StakingRewardsPerSecond)
+
+			updated := false
+			for _, event := range suite.Ctx.EventManager().Events() {
+				if event.Type == types.EventTypeStakingRewardsRateUpdated {
+					updated = true
+				}
+			}
+			suite.Equal(!tc.rewardsPerSec.Equal(tc.expectedRate), updated, "rate updated event should only be emitted on change")
+		})
+	}
+}
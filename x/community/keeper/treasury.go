@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/community/types"
+	swaptypes "github.com/kava-labs/kava/x/swap/types"
+)
+
+// GetTreasuryPositions returns all hard, cdp, and swap positions held by the community module account.
+func (k Keeper) GetTreasuryPositions(ctx sdk.Context) types.TreasuryPositions {
+	positions := types.TreasuryPositions{}
+
+	if deposit, found := k.hardKeeper.GetSyncedDeposit(ctx, k.moduleAddress); found && !deposit.Amount.IsZero() {
+		positions = append(positions, k.newTreasuryPosition(ctx, types.TREASURY_POSITION_TYPE_HARD_DEPOSIT, "", deposit.Amount))
+	}
+	if borrow, found := k.hardKeeper.GetSyncedBorrow(ctx, k.moduleAddress); found && !borrow.Amount.IsZero() {
+		positions = append(positions, k.newTreasuryPosition(ctx, types.TREASURY_POSITION_TYPE_HARD_BORROW, "", borrow.Amount))
+	}
+
+	for _, cp := range k.cdpKeeper.GetParams(ctx).CollateralParams {
+		cdp, found := k.cdpKeeper.GetCdpByOwnerAndCollateralType(ctx, k.moduleAddress, cp.Type)
+		if !found {
+			continue
+		}
+		positions = append(positions,
+			k.newTreasuryPosition(ctx, types.TREASURY_POSITION_TYPE_CDP_COLLATERAL, cp.Type, sdk.NewCoins(cdp.Collateral)),
+			k.newTreasuryPosition(ctx, types.TREASURY_POSITION_TYPE_CDP_DEBT, cp.Type, sdk.NewCoins(cdp.GetTotalPrincipal())),
+		)
+	}
+
+	for _, record := range k.swapKeeper.GetAllDepositorSharesByOwner(ctx, k.moduleAddress) {
+		poolRecord, found := k.swapKeeper.GetPool(ctx, record.PoolID)
+		if !found {
+			continue
+		}
+		pool, err := swaptypes.NewDenominatedPoolWithExistingShares(poolRecord.Reserves(), poolRecord.TotalShares)
+		if err != nil {
+			continue
+		}
+		positions = append(positions,
+			k.newTreasuryPosition(ctx, types.TREASURY_POSITION_TYPE_SWAP_LIQUIDITY, record.PoolID, pool.ShareValue(record.SharesOwned)),
+		)
+	}
+
+	return positions
+}
+
+func (k Keeper) newTreasuryPosition(
+	ctx sdk.Context,
+	positionType types.TreasuryPositionType,
+	id string,
+	amount sdk.Coins,
+) types.TreasuryPosition {
+	usdValue := sdk.ZeroDec()
+	for _, coin := range amount {
+		usdValue = usdValue.Add(k.getCoinUSDValue(ctx, coin))
+	}
+	return types.NewTreasuryPosition(positionType, id, amount, usdValue)
+}
+
+// getCoinUSDValue values a coin using the pricefeed market and conversion factor of the hard money market
+// for its denom, falling back to the cdp collateral params. The cdp debt denom is valued at its $1 target
+// when it has no money market. Coins without a market or a current price are valued at zero.
+func (k Keeper) getCoinUSDValue(ctx sdk.Context, coin sdk.Coin) sdk.Dec {
+	if mm, found := k.hardKeeper.GetMoneyMarket(ctx, coin.Denom); found {
+		price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, mm.SpotMarketID)
+		if err != nil {
+			return sdk.ZeroDec()
+		}
+		return sdk.NewDecFromInt(coin.Amount).Quo(sdk.NewDecFromInt(mm.ConversionFactor)).Mul(price.Price)
+	}
+
+	cdpParams := k.cdpKeeper.GetParams(ctx)
+	for _, cp := range cdpParams.CollateralParams {
+		if cp.Denom != coin.Denom {
+			continue
+		}
+		price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, cp.SpotMarketID)
+		if err != nil {
+			return sdk.ZeroDec()
+		}
+		return toBaseUnits(coin.Amount, cp.ConversionFactor).Mul(price.Price)
+	}
+	if cdpParams.DebtParam.Denom == coin.Denom {
+		return toBaseUnits(coin.Amount, cdpParams.DebtParam.ConversionFactor)
+	}
+
+	return sdk.ZeroDec()
+}
+
+// toBaseUnits converts an amount to base units using a cdp style conversion factor (ie multiplies by 10^(-conversionFactor))
+func toBaseUnits(amount sdkmath.Int, conversionFactor sdkmath.Int) sdk.Dec {
+	return sdk.NewDecFromInt(amount).Mul(sdk.NewDecFromIntWithPrec(sdk.OneInt(), conversionFactor.Int64()))
+}
@@ -0,0 +1,57 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/community/keeper"
+	"github.com/kava-labs/kava/x/community/types"
+	swaptypes "github.com/kava-labs/kava/x/swap/types"
+)
+
+func (suite *proposalTestSuite) TestGetTreasuryPositions() {
+	// no positions are held by default
+	positions := suite.Keeper.GetTreasuryPositions(suite.Ctx)
+	suite.Empty(positions)
+	suite.Equal(sdk.ZeroDec(), positions.NetUSDValue())
+
+	err := suite.App.FundModuleAccount(suite.Ctx, types.ModuleAccountName, ukava(3e10).Add(usdx(1e9)...).Add(otherdenom(1e9)...))
+	suite.Require().NoError(err)
+
+	// hard deposit and borrow
+	err = keeper.HandleCommunityPoolLendDepositProposal(suite.Ctx, suite.Keeper,
+		types.NewCommunityPoolLendDepositProposal("lend", "deposit to lend", ukava(1e9)),
+	)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.hardKeeper.Borrow(suite.Ctx, suite.MaccAddress, ukava(1e8)))
+
+	// cdp collateral and debt
+	err = suite.cdpKeeper.AddCdp(suite.Ctx, suite.MaccAddress, c("ukava", 1e10), c("usdx", 1e9), "kava-a")
+	suite.Require().NoError(err)
+
+	// swap liquidity, including a pool with a denom that has no pricefeed market
+	suite.CreateSwapPool(c("ukava", 1e9), c("usdx", 1e9))
+	swapKeeper := suite.App.GetSwapKeeper()
+	swapKeeper.SetParams(suite.Ctx, swaptypes.NewParams(
+		swaptypes.NewAllowedPools(
+			swaptypes.NewAllowedPool("ukava", "usdx"),
+			swaptypes.NewAllowedPool("other-denom", "ukava"),
+		),
+		sdk.ZeroDec(),
+	))
+	suite.Require().NoError(swapKeeper.Deposit(suite.Ctx, suite.MaccAddress, c("ukava", 1e9), c("usdx", 1e9), sdk.OneDec()))
+	suite.Require().NoError(swapKeeper.Deposit(suite.Ctx, suite.MaccAddress, c("other-denom", 1e9), c("ukava", 1e9), sdk.OneDec()))
+
+	// all markets are priced at $1 with a 10^6 conversion factor
+	expected := types.TreasuryPositions{
+		types.NewTreasuryPosition(types.TREASURY_POSITION_TYPE_HARD_DEPOSIT, "", ukava(1e9), sdk.NewDec(1000)),
+		types.NewTreasuryPosition(types.TREASURY_POSITION_TYPE_HARD_BORROW, "", ukava(1e8), sdk.NewDec(100)),
+		types.NewTreasuryPosition(types.TREASURY_POSITION_TYPE_CDP_COLLATERAL, "kava-a", ukava(1e10), sdk.NewDec(10000)),
+		types.NewTreasuryPosition(types.TREASURY_POSITION_TYPE_CDP_DEBT, "kava-a", usdx(1e9), sdk.NewDec(1000)),
+		types.NewTreasuryPosition(types.TREASURY_POSITION_TYPE_SWAP_LIQUIDITY, "other-denom:ukava", otherdenom(1e9).Add(ukava(1e9)...), sdk.NewDec(1000)),
+		types.NewTreasuryPosition(types.TREASURY_POSITION_TYPE_SWAP_LIQUIDITY, "ukava:usdx", ukava(1e9).Add(usdx(1e9)...), sdk.NewDec(2000)),
+	}
+
+	positions = suite.Keeper.GetTreasuryPositions(suite.Ctx)
+	suite.Equal(expected, positions)
+	suite.Equal(sdk.NewDec(12900), positions.NetUSDValue())
+}
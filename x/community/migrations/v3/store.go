@@ -0,0 +1,37 @@
+package v3
+
+import (
+	"fmt"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/kava-labs/kava/x/community/types"
+)
+
+// Migrate migrates the x/community module state from the consensus version 2 to
+// version 3. Specifically, sets the default staking rewards schedule on the existing parameters.
+func Migrate(
+	ctx sdk.Context,
+	store storetypes.KVStore,
+	cdc codec.BinaryCodec,
+) error {
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return fmt.Errorf("params not found in store")
+	}
+
+	var params types.Params
+	cdc.MustUnmarshal(bz, &params)
+
+	params.StakingRewardsSchedule = types.DefaultStakingRewardsSchedule
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store.Set(types.ParamsKey, cdc.MustMarshal(&params))
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package v3_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/kava-labs/kava/app"
+	v3 "github.com/kava-labs/kava/x/community/migrations/v3"
+	"github.com/kava-labs/kava/x/community/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	tApp := app.NewTestApp()
+	cdc := tApp.AppCodec()
+	storeKey := sdk.NewKVStoreKey("community")
+	ctx := testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(storeKey)
+
+	require.Error(t, v3.Migrate(ctx, store, cdc), "migration should fail without existing params")
+
+	// params stored before the staking rewards schedule was added
+	upgradeTime := time.Date(2023, 11, 1, 0, 0, 0, 0, time.UTC)
+	oldParams := types.Params{
+		UpgradeTimeDisableInflation:           upgradeTime,
+		StakingRewardsPerSecond:               sdkmath.LegacyNewDec(1000),
+		UpgradeTimeSetStakingRewardsPerSecond: sdkmath.LegacyNewDec(500),
+	}
+	store.Set(types.ParamsKey, cdc.MustMarshal(&oldParams))
+
+	require.NoError(t, v3.Migrate(ctx, store, cdc))
+
+	var params types.Params
+	cdc.MustUnmarshal(store.Get(types.ParamsKey), &params)
+
+	require.Equal(
+		t,
+		types.NewParams(
+			upgradeTime,
+			sdkmath.LegacyNewDec(1000),
+			sdkmath.LegacyNewDec(500),
+		),
+		params,
+		"params should be correct after migration",
+	)
+}
@@ -2,11 +2,13 @@ package types
 
 // Community module event types
 const (
-	EventTypeInflationStop      = "inflation_stop"
-	EventTypeStakingRewardsPaid = "staking_rewards_paid"
+	EventTypeInflationStop             = "inflation_stop"
+	EventTypeStakingRewardsPaid        = "staking_rewards_paid"
+	EventTypeStakingRewardsRateUpdated = "staking_rewards_rate_updated"
 
-	AttributeKeyStakingRewardAmount  = "staking_reward_amount"
-	AttributeKeyInflationDisableTime = "inflation_disable_time"
+	AttributeKeyStakingRewardAmount     = "staking_reward_amount"
+	AttributeKeyInflationDisableTime    = "inflation_disable_time"
+	AttributeKeyStakingRewardsPerSecond = "staking_rewards_per_second"
 
 	AttributeValueFundCommunityPool = "fund_community_pool"
 	AttributeValueCategory          = ModuleName
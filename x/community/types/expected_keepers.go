@@ -6,7 +6,12 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	cdptypes "github.com/kava-labs/kava/x/cdp/types"
+	hardtypes "github.com/kava-labs/kava/x/hard/types"
 	kavadisttypes "github.com/kava-labs/kava/x/kavadist/types"
+	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
+	swaptypes "github.com/kava-labs/kava/x/swap/types"
 )
 
 // AccountKeeper defines the contract required for account APIs.
@@ -31,12 +36,29 @@ type BankKeeper interface {
 type CdpKeeper interface {
 	RepayPrincipal(ctx sdk.Context, owner sdk.AccAddress, collateralType string, payment sdk.Coin) error
 	WithdrawCollateral(ctx sdk.Context, owner, depositor sdk.AccAddress, collateral sdk.Coin, collateralType string) error
+	GetParams(ctx sdk.Context) cdptypes.Params
+	GetCdpByOwnerAndCollateralType(ctx sdk.Context, owner sdk.AccAddress, collateralType string) (cdptypes.CDP, bool)
 }
 
 // HardKeeper defines the contract needed to be fulfilled for Kava Lend dependencies.
 type HardKeeper interface {
 	Deposit(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coins) error
 	Withdraw(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coins) error
+	GetSyncedDeposit(ctx sdk.Context, depositor sdk.AccAddress) (hardtypes.Deposit, bool)
+	GetSyncedBorrow(ctx sdk.Context, borrower sdk.AccAddress) (hardtypes.Borrow, bool)
+	GetMoneyMarket(ctx sdk.Context, denom string) (hardtypes.MoneyMarket, bool)
+}
+
+// SwapKeeper defines the contract needed to be fulfilled for Kava Swap dependencies.
+type SwapKeeper interface {
+	SwapExactForTokens(ctx sdk.Context, requester sdk.AccAddress, exactCoinA, coinB sdk.Coin, slippageLimit sdk.Dec) error
+	GetAllDepositorSharesByOwner(ctx sdk.Context, owner sdk.AccAddress) swaptypes.ShareRecords
+	GetPool(ctx sdk.Context, poolID string) (swaptypes.PoolRecord, bool)
+}
+
+// PricefeedKeeper defines the contract needed to be fulfilled for pricefeed dependencies.
+type PricefeedKeeper interface {
+	GetCurrentPrice(ctx sdk.Context, marketID string) (pricefeedtypes.CurrentPrice, error)
 }
 
 // DistributionKeeper defines the contract needed to be fulfilled for distribution dependencies.
@@ -13,9 +13,16 @@ var (
 	DefaultStakingRewardsPerSecond = sdkmath.LegacyNewDec(0)
 	// DefaultStakingRewardsPerSecond is zero and should be set by genesis or upgrade
 	DefaultUpgradeTimeSetStakingRewardsPerSecond = sdkmath.LegacyNewDec(0)
+	// DefaultStakingRewardsSchedule pays the fixed staking_rewards_per_second
+	DefaultStakingRewardsSchedule = NewStakingRewardsSchedule(
+		STAKING_REWARDS_MODE_FIXED,
+		sdkmath.LegacyNewDec(0),
+		sdkmath.LegacyNewDec(0),
+		sdkmath.LegacyNewDec(0),
+	)
 )
 
-// NewParams returns a new params object
+// NewParams returns a new params object with the default fixed staking rewards schedule
 func NewParams(
 	upgradeTime time.Time,
 	stakingRewardsPerSecond sdkmath.LegacyDec,
@@ -25,6 +32,7 @@ func NewParams(
 		UpgradeTimeDisableInflation:           upgradeTime,
 		StakingRewardsPerSecond:               stakingRewardsPerSecond,
 		UpgradeTimeSetStakingRewardsPerSecond: upgradeTimeSetstakingRewardsPerSecond,
+		StakingRewardsSchedule:                DefaultStakingRewardsSchedule,
 	}
 }
 
@@ -49,6 +57,63 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := p.StakingRewardsSchedule.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// NewStakingRewardsSchedule returns a new StakingRewardsSchedule
+func NewStakingRewardsSchedule(
+	mode StakingRewardsMode,
+	minAPY sdkmath.LegacyDec,
+	maxAPY sdkmath.LegacyDec,
+	maxAnnualPoolSpend sdkmath.LegacyDec,
+) StakingRewardsSchedule {
+	return StakingRewardsSchedule{
+		Mode:               mode,
+		MinAPY:             minAPY,
+		MaxAPY:             maxAPY,
+		MaxAnnualPoolSpend: maxAnnualPoolSpend,
+	}
+}
+
+// Validate checks the staking rewards schedule is valid
+func (s StakingRewardsSchedule) Validate() error {
+	if _, found := StakingRewardsMode_name[int32(s.Mode)]; !found {
+		return fmt.Errorf("invalid staking rewards mode: %d", s.Mode)
+	}
+
+	// an unset schedule pays the fixed staking rewards per second
+	if s.Mode == STAKING_REWARDS_MODE_FIXED && s.MinAPY.IsNil() && s.MaxAPY.IsNil() && s.MaxAnnualPoolSpend.IsNil() {
+		return nil
+	}
+
+	if err := validateDecNotNilNonNegative(s.MinAPY, "MinAPY"); err != nil {
+		return err
+	}
+
+	if err := validateDecNotNilNonNegative(s.MaxAPY, "MaxAPY"); err != nil {
+		return err
+	}
+
+	if err := validateDecNotNilNonNegative(s.MaxAnnualPoolSpend, "MaxAnnualPoolSpend"); err != nil {
+		return err
+	}
+
+	if s.MinAPY.GT(s.MaxAPY) {
+		return fmt.Errorf("MinAPY %s should not be greater than MaxAPY %s", s.MinAPY, s.MaxAPY)
+	}
+
+	if s.MaxAnnualPoolSpend.GT(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("MaxAnnualPoolSpend should not be greater than 1: %s", s.MaxAnnualPoolSpend)
+	}
+
+	if s.Mode == STAKING_REWARDS_MODE_TARGET_APY && s.MaxAnnualPoolSpend.IsZero() {
+		return fmt.Errorf("MaxAnnualPoolSpend should be positive in target apy mode")
+	}
+
 	return nil
 }
 
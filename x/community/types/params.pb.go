@@ -29,6 +29,35 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// StakingRewardsMode defines how staking_rewards_per_second is set
+type StakingRewardsMode int32
+
+const (
+	// STAKING_REWARDS_MODE_FIXED pays the staking_rewards_per_second set by governance
+	STAKING_REWARDS_MODE_FIXED StakingRewardsMode = 0
+	// STAKING_REWARDS_MODE_TARGET_APY recomputes staking_rewards_per_second each block from the
+	// total bonded tokens to keep the staking APY within a target band
+	STAKING_REWARDS_MODE_TARGET_APY StakingRewardsMode = 1
+)
+
+var StakingRewardsMode_name = map[int32]string{
+	0: "STAKING_REWARDS_MODE_FIXED",
+	1: "STAKING_REWARDS_MODE_TARGET_APY",
+}
+
+var StakingRewardsMode_value = map[string]int32{
+	"STAKING_REWARDS_MODE_FIXED":      0,
+	"STAKING_REWARDS_MODE_TARGET_APY": 1,
+}
+
+func (x StakingRewardsMode) String() string {
+	return proto.EnumName(StakingRewardsMode_name, int32(x))
+}
+
+func (StakingRewardsMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0a48475520900507, []int{0}
+}
+
 // Params defines the parameters of the community module.
 type Params struct {
 	// upgrade_time_disable_inflation is the time at which to disable mint and kavadist module inflation.
@@ -39,6 +68,8 @@ type Params struct {
 	// upgrade_time_set_staking_rewards_per_second is the initial staking_rewards_per_second to set
 	// and use when the disable inflation time is reached
 	UpgradeTimeSetStakingRewardsPerSecond cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=upgrade_time_set_staking_rewards_per_second,json=upgradeTimeSetStakingRewardsPerSecond,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"upgrade_time_set_staking_rewards_per_second"`
+	// staking_rewards_schedule defines how staking_rewards_per_second is set
+	StakingRewardsSchedule StakingRewardsSchedule `protobuf:"bytes,4,opt,name=staking_rewards_schedule,json=stakingRewardsSchedule,proto3" json:"staking_rewards_schedule"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -81,8 +112,70 @@ func (m *Params) GetUpgradeTimeDisableInflation() time.Time {
 	return time.Time{}
 }
 
+func (m *Params) GetStakingRewardsSchedule() StakingRewardsSchedule {
+	if m != nil {
+		return m.StakingRewardsSchedule
+	}
+	return StakingRewardsSchedule{}
+}
+
+// StakingRewardsSchedule defines how staking rewards paid from the community pool are calculated.
+type StakingRewardsSchedule struct {
+	// mode defines how staking_rewards_per_second is set
+	Mode StakingRewardsMode `protobuf:"varint,1,opt,name=mode,proto3,enum=kava.community.v1beta1.StakingRewardsMode" json:"mode,omitempty"`
+	// min_apy is the lowest annualized staking reward rate targeted in target apy mode
+	MinAPY cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=min_apy,json=minApy,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_apy"`
+	// max_apy is the highest annualized staking reward rate targeted in target apy mode
+	MaxAPY cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=max_apy,json=maxApy,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_apy"`
+	// max_annual_pool_spend caps staking_rewards_per_second in target apy mode to this fraction
+	// of the community pool balance paid out over a year
+	MaxAnnualPoolSpend cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=max_annual_pool_spend,json=maxAnnualPoolSpend,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_annual_pool_spend"`
+}
+
+func (m *StakingRewardsSchedule) Reset()         { *m = StakingRewardsSchedule{} }
+func (m *StakingRewardsSchedule) String() string { return proto.CompactTextString(m) }
+func (*StakingRewardsSchedule) ProtoMessage()    {}
+func (*StakingRewardsSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a48475520900507, []int{1}
+}
+func (m *StakingRewardsSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StakingRewardsSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StakingRewardsSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StakingRewardsSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakingRewardsSchedule.Merge(m, src)
+}
+func (m *StakingRewardsSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *StakingRewardsSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakingRewardsSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakingRewardsSchedule proto.InternalMessageInfo
+
+func (m *StakingRewardsSchedule) GetMode() StakingRewardsMode {
+	if m != nil {
+		return m.Mode
+	}
+	return STAKING_REWARDS_MODE_FIXED
+}
+
 func init() {
+	proto.RegisterEnum("kava.community.v1beta1.StakingRewardsMode", StakingRewardsMode_name, StakingRewardsMode_value)
 	proto.RegisterType((*Params)(nil), "kava.community.v1beta1.Params")
+	proto.RegisterType((*StakingRewardsSchedule)(nil), "kava.community.v1beta1.StakingRewardsSchedule")
 }
 
 func init() {
@@ -90,32 +183,44 @@ func init() {
 }
 
 var fileDescriptor_0a48475520900507 = []byte{
-	// 386 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0x41, 0x4b, 0xe3, 0x40,
-	0x14, 0x80, 0x33, 0xdd, 0xa5, 0xec, 0x66, 0x6f, 0x61, 0xd9, 0xad, 0x29, 0x24, 0x45, 0x11, 0x0a,
-	0xa5, 0x33, 0x54, 0x6f, 0x1e, 0x4b, 0x3d, 0x08, 0x1e, 0x4a, 0xeb, 0xc9, 0xcb, 0x30, 0x49, 0xa6,
-	0xd3, 0xa1, 0x49, 0x26, 0x64, 0x26, 0xd5, 0xfe, 0x08, 0xa1, 0x3f, 0xc1, 0x1f, 0xe1, 0x8f, 0xe8,
-	0xb1, 0x78, 0x12, 0x0f, 0x55, 0xda, 0x8b, 0x7f, 0xc1, 0x9b, 0x24, 0x93, 0x4a, 0x05, 0xf1, 0xe0,
-	0xed, 0xbd, 0x97, 0x2f, 0xdf, 0xbc, 0x37, 0xf3, 0xcc, 0x83, 0x09, 0x99, 0x12, 0xe4, 0x8b, 0x28,
-	0xca, 0x62, 0xae, 0x66, 0x68, 0xda, 0xf1, 0xa8, 0x22, 0x1d, 0x94, 0x90, 0x94, 0x44, 0x12, 0x26,
-	0xa9, 0x50, 0xc2, 0xfa, 0x97, 0x43, 0xf0, 0x1d, 0x82, 0x25, 0x64, 0xef, 0xf9, 0x42, 0x46, 0x42,
-	0xe2, 0x82, 0x42, 0x3a, 0xd1, 0xbf, 0xd8, 0x7f, 0x99, 0x60, 0x42, 0xd7, 0xf3, 0xa8, 0xac, 0xba,
-	0x4c, 0x08, 0x16, 0x52, 0x54, 0x64, 0x5e, 0x36, 0x42, 0x8a, 0x47, 0x54, 0x2a, 0x12, 0x25, 0x1a,
-	0xd8, 0x7f, 0xad, 0x98, 0xd5, 0x7e, 0x71, 0xb4, 0xc5, 0x4d, 0x27, 0x4b, 0x58, 0x4a, 0x02, 0x8a,
-	0x73, 0x0a, 0x07, 0x5c, 0x12, 0x2f, 0xa4, 0x98, 0xc7, 0xa3, 0x90, 0x28, 0x2e, 0xe2, 0x1a, 0x68,
-	0x80, 0xe6, 0x9f, 0x23, 0x1b, 0x6a, 0x29, 0xdc, 0x4a, 0xe1, 0xc5, 0x56, 0xda, 0xfd, 0xb5, 0x58,
-	0xb9, 0xc6, 0xfc, 0xc9, 0x05, 0x83, 0x7a, 0xe9, 0xca, 0xbf, 0xf5, 0xb4, 0xe9, 0x6c, 0x2b, 0xb2,
-	0x62, 0xd3, 0x96, 0x8a, 0x4c, 0x78, 0xcc, 0x70, 0x4a, 0xaf, 0x48, 0x1a, 0x48, 0x9c, 0xd0, 0x14,
-	0x4b, 0xea, 0x8b, 0x38, 0xa8, 0x55, 0x1a, 0xa0, 0xf9, 0xbb, 0xdb, 0xc9, 0x55, 0x8f, 0x2b, 0xb7,
-	0xae, 0xc7, 0x94, 0xc1, 0x04, 0x72, 0x81, 0x22, 0xa2, 0xc6, 0xf0, 0x9c, 0x32, 0xe2, 0xcf, 0x7a,
-	0xd4, 0xbf, 0xbf, 0x6b, 0x9b, 0xe5, 0x2d, 0xf4, 0xa8, 0x3f, 0xf8, 0x5f, 0x4a, 0x07, 0xda, 0xd9,
-	0xa7, 0xe9, 0xb0, 0x30, 0x5a, 0x37, 0xc0, 0x6c, 0x7d, 0x98, 0x4d, 0x52, 0x85, 0xbf, 0xe8, 0xe0,
-	0xc7, 0x77, 0x3b, 0x38, 0xdc, 0x99, 0x7a, 0x48, 0xd5, 0xf0, 0xf3, 0x7e, 0x4e, 0x7e, 0xbe, 0xdc,
-	0xba, 0xa0, 0x7b, 0xba, 0x58, 0x3b, 0x60, 0xb9, 0x76, 0xc0, 0xf3, 0xda, 0x01, 0xf3, 0x8d, 0x63,
-	0x2c, 0x37, 0x8e, 0xf1, 0xb0, 0x71, 0x8c, 0xcb, 0x16, 0xe3, 0x6a, 0x9c, 0x79, 0xf9, 0x06, 0xa0,
-	0x7c, 0x15, 0xda, 0x21, 0xf1, 0x64, 0x11, 0xa1, 0xeb, 0x9d, 0xdd, 0x51, 0xb3, 0x84, 0x4a, 0xaf,
-	0x5a, 0xbc, 0xc3, 0xf1, 0x5b, 0x00, 0x00, 0x00, 0xff, 0xff, 0x19, 0x03, 0x78, 0xe5, 0x5a, 0x02,
-	0x00, 0x00,
+	// 584 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x41, 0x6b, 0xd4, 0x40,
+	0x14, 0xc7, 0x93, 0x36, 0xac, 0x3a, 0x82, 0x94, 0xa0, 0x75, 0x4d, 0x21, 0x29, 0x2d, 0x42, 0x69,
+	0x69, 0x42, 0xeb, 0x4d, 0x41, 0xc8, 0x92, 0xb5, 0x14, 0xad, 0x2e, 0xc9, 0x82, 0xd6, 0xcb, 0x30,
+	0x49, 0xa6, 0xe9, 0xd0, 0x64, 0x26, 0x64, 0x26, 0x75, 0xf7, 0x1b, 0x78, 0x11, 0xfa, 0x11, 0x04,
+	0xbf, 0x82, 0x1f, 0xa2, 0xc7, 0xe2, 0x49, 0x3c, 0x54, 0xd9, 0xbd, 0xe8, 0xcd, 0x8f, 0x20, 0x99,
+	0x64, 0xa5, 0xad, 0xab, 0xd4, 0xde, 0x66, 0xe6, 0xfd, 0xdf, 0xef, 0xbd, 0xf7, 0x7f, 0xbb, 0x01,
+	0xcb, 0x07, 0xe8, 0x10, 0x39, 0x11, 0xcb, 0xb2, 0x92, 0x12, 0x31, 0x74, 0x0e, 0x37, 0x42, 0x2c,
+	0xd0, 0x86, 0x93, 0xa3, 0x02, 0x65, 0xdc, 0xce, 0x0b, 0x26, 0x98, 0x3e, 0x5f, 0x89, 0xec, 0xdf,
+	0x22, 0xbb, 0x11, 0x19, 0xf7, 0x22, 0xc6, 0x33, 0xc6, 0xa1, 0x54, 0x39, 0xf5, 0xa5, 0x4e, 0x31,
+	0x6e, 0x27, 0x2c, 0x61, 0xf5, 0x7b, 0x75, 0x6a, 0x5e, 0xad, 0x84, 0xb1, 0x24, 0xc5, 0x8e, 0xbc,
+	0x85, 0xe5, 0x9e, 0x23, 0x48, 0x86, 0xb9, 0x40, 0x59, 0x5e, 0x0b, 0x96, 0x7e, 0xcc, 0x82, 0x56,
+	0x4f, 0x96, 0xd6, 0x09, 0x30, 0xcb, 0x3c, 0x29, 0x50, 0x8c, 0x61, 0xa5, 0x82, 0x31, 0xe1, 0x28,
+	0x4c, 0x31, 0x24, 0x74, 0x2f, 0x45, 0x82, 0x30, 0xda, 0x56, 0x17, 0xd5, 0x95, 0x9b, 0x9b, 0x86,
+	0x5d, 0x43, 0xed, 0x09, 0xd4, 0xee, 0x4f, 0xa0, 0x9d, 0xeb, 0xc7, 0xa7, 0x96, 0x72, 0xf4, 0xd5,
+	0x52, 0xfd, 0x85, 0x86, 0x55, 0xc5, 0xbc, 0x9a, 0xb4, 0x3d, 0x01, 0xe9, 0x14, 0x18, 0x5c, 0xa0,
+	0x03, 0x42, 0x13, 0x58, 0xe0, 0x37, 0xa8, 0x88, 0x39, 0xcc, 0x71, 0x01, 0x39, 0x8e, 0x18, 0x8d,
+	0xdb, 0x33, 0x8b, 0xea, 0xca, 0x8d, 0xce, 0x46, 0x85, 0xfa, 0x72, 0x6a, 0x2d, 0xd4, 0x63, 0xf2,
+	0xf8, 0xc0, 0x26, 0xcc, 0xc9, 0x90, 0xd8, 0xb7, 0x9f, 0xe1, 0x04, 0x45, 0x43, 0x0f, 0x47, 0x9f,
+	0x3e, 0xae, 0x83, 0xc6, 0x05, 0x0f, 0x47, 0xfe, 0xdd, 0x06, 0xea, 0xd7, 0xcc, 0x1e, 0x2e, 0x02,
+	0x49, 0xd4, 0xdf, 0xa9, 0x60, 0xed, 0xdc, 0x6c, 0x1c, 0x0b, 0xf8, 0x8f, 0x0e, 0x66, 0xaf, 0xda,
+	0xc1, 0xfd, 0x33, 0x53, 0x07, 0x58, 0x04, 0x7f, 0xe9, 0x87, 0x82, 0xf6, 0xc5, 0xea, 0x3c, 0xda,
+	0xc7, 0x71, 0x99, 0xe2, 0xb6, 0x26, 0x4d, 0xb6, 0xed, 0xe9, 0x3f, 0x01, 0xfb, 0x3c, 0x32, 0x68,
+	0xb2, 0x3a, 0x5a, 0xd5, 0xab, 0x3f, 0xcf, 0xa7, 0x46, 0x1f, 0x6a, 0xdf, 0xdf, 0x5b, 0xea, 0xd2,
+	0xcf, 0x19, 0x30, 0x3f, 0x3d, 0x5d, 0x7f, 0x0c, 0xb4, 0x8c, 0xc5, 0x58, 0x6e, 0xf8, 0xd6, 0xe6,
+	0xea, 0xe5, 0x8a, 0xef, 0xb0, 0x18, 0xfb, 0x32, 0x4f, 0xef, 0x83, 0x6b, 0x19, 0xa1, 0x10, 0xe5,
+	0xc3, 0x66, 0x7b, 0x8f, 0x2e, 0xe1, 0xdd, 0xe8, 0xd4, 0x6a, 0xed, 0x10, 0xea, 0xf6, 0x76, 0x2f,
+	0xb8, 0xd8, 0xca, 0x08, 0x75, 0xf3, 0xa1, 0xa4, 0xa2, 0x81, 0xa4, 0xce, 0xfe, 0x1f, 0x15, 0x0d,
+	0xa6, 0x51, 0xd1, 0xa0, 0xa2, 0xc6, 0xe0, 0x8e, 0xa4, 0x52, 0x5a, 0xa2, 0x14, 0xe6, 0x8c, 0xa5,
+	0x90, 0xe7, 0x98, 0xc6, 0x6d, 0xed, 0xaa, 0x5b, 0xd7, 0x2b, 0xb2, 0xc4, 0xf5, 0x18, 0x4b, 0x83,
+	0x0a, 0x56, 0x5b, 0xbe, 0x0a, 0x81, 0xfe, 0xa7, 0x67, 0xba, 0x09, 0x8c, 0xa0, 0xef, 0x3e, 0xdd,
+	0x7e, 0xbe, 0x05, 0xfd, 0xee, 0x4b, 0xd7, 0xf7, 0x02, 0xb8, 0xf3, 0xc2, 0xeb, 0xc2, 0x27, 0xdb,
+	0xaf, 0xba, 0xde, 0x9c, 0xa2, 0x2f, 0x03, 0x6b, 0x6a, 0xbc, 0xef, 0xfa, 0x5b, 0xdd, 0x3e, 0x74,
+	0x7b, 0xbb, 0x73, 0xaa, 0xa1, 0xbd, 0xfd, 0x60, 0x2a, 0x9d, 0xee, 0xf1, 0xc8, 0x54, 0x4f, 0x46,
+	0xa6, 0xfa, 0x6d, 0x64, 0xaa, 0x47, 0x63, 0x53, 0x39, 0x19, 0x9b, 0xca, 0xe7, 0xb1, 0xa9, 0xbc,
+	0x5e, 0x4b, 0x88, 0xd8, 0x2f, 0xc3, 0x6a, 0x8b, 0x4e, 0xb5, 0xce, 0xf5, 0x14, 0x85, 0x5c, 0x9e,
+	0x9c, 0xc1, 0x99, 0xef, 0x8f, 0x18, 0xe6, 0x98, 0x87, 0x2d, 0xf9, 0x5f, 0x7e, 0xf0, 0x6b, 0x00,
+	0x79, 0x3e, 0x77, 0xec, 0x9e, 0x04, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -146,6 +251,42 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.UpgradeTimeSetStakingRewardsPerSecond.Equal(that1.UpgradeTimeSetStakingRewardsPerSecond) {
 		return false
 	}
+	if !this.StakingRewardsSchedule.Equal(&that1.StakingRewardsSchedule) {
+		return false
+	}
+	return true
+}
+func (this *StakingRewardsSchedule) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StakingRewardsSchedule)
+	if !ok {
+		that2, ok := that.(StakingRewardsSchedule)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Mode != that1.Mode {
+		return false
+	}
+	if !this.MinAPY.Equal(that1.MinAPY) {
+		return false
+	}
+	if !this.MaxAPY.Equal(that1.MaxAPY) {
+		return false
+	}
+	if !this.MaxAnnualPoolSpend.Equal(that1.MaxAnnualPoolSpend) {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -168,6 +309,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.StakingRewardsSchedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	{
 		size := m.UpgradeTimeSetStakingRewardsPerSecond.Size()
 		i -= size
@@ -188,17 +339,75 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x12
-	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UpgradeTimeDisableInflation, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpgradeTimeDisableInflation):])
-	if err1 != nil {
-		return 0, err1
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UpgradeTimeDisableInflation, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpgradeTimeDisableInflation):])
+	if err2 != nil {
+		return 0, err2
 	}
-	i -= n1
-	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i -= n2
+	i = encodeVarintParams(dAtA, i, uint64(n2))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
+func (m *StakingRewardsSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StakingRewardsSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StakingRewardsSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxAnnualPoolSpend.Size()
+		i -= size
+		if _, err := m.MaxAnnualPoolSpend.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MaxAPY.Size()
+		i -= size
+		if _, err := m.MaxAPY.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MinAPY.Size()
+		i -= size
+		if _, err := m.MinAPY.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Mode != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -222,6 +431,26 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovParams(uint64(l))
 	l = m.UpgradeTimeSetStakingRewardsPerSecond.Size()
 	n += 1 + l + sovParams(uint64(l))
+	l = m.StakingRewardsSchedule.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *StakingRewardsSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Mode != 0 {
+		n += 1 + sovParams(uint64(m.Mode))
+	}
+	l = m.MinAPY.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxAPY.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxAnnualPoolSpend.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -361,6 +590,210 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingRewardsSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StakingRewardsSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StakingRewardsSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StakingRewardsSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StakingRewardsSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= StakingRewardsMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAPY", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinAPY.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAPY", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxAPY.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAnnualPoolSpend", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxAnnualPoolSpend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
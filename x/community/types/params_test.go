@@ -85,6 +85,66 @@ var paramTestCases = []paramTestCase{
 		},
 		expectedErr: "UpgradeTimeSetStakingRewardsPerSecond should not be negative",
 	},
+	{
+		name:        "valid target apy schedule",
+		params:      paramsWithSchedule(types.STAKING_REWARDS_MODE_TARGET_APY, "0.1", "0.2", "0.5"),
+		expectedErr: "",
+	},
+	{
+		name:        "target apy schedule allows equal min and max apy",
+		params:      paramsWithSchedule(types.STAKING_REWARDS_MODE_TARGET_APY, "0.15", "0.15", "1"),
+		expectedErr: "",
+	},
+	{
+		name:        "fixed schedule allows zero max annual pool spend",
+		params:      paramsWithSchedule(types.STAKING_REWARDS_MODE_FIXED, "0", "0", "0"),
+		expectedErr: "",
+	},
+	{
+		name:        "invalid staking rewards mode",
+		params:      paramsWithSchedule(types.StakingRewardsMode(2), "0.1", "0.2", "0.5"),
+		expectedErr: "invalid staking rewards mode: 2",
+	},
+	{
+		name: "nil min apy",
+		params: func() types.Params {
+			p := paramsWithSchedule(types.STAKING_REWARDS_MODE_TARGET_APY, "0.1", "0.2", "0.5")
+			p.StakingRewardsSchedule.MinAPY = sdkmath.LegacyDec{}
+			return p
+		}(),
+		expectedErr: "MinAPY should not be nil",
+	},
+	{
+		name:        "negative min apy",
+		params:      paramsWithSchedule(types.STAKING_REWARDS_MODE_TARGET_APY, "-0.1", "0.2", "0.5"),
+		expectedErr: "MinAPY should not be negative",
+	},
+	{
+		name:        "min apy greater than max apy",
+		params:      paramsWithSchedule(types.STAKING_REWARDS_MODE_TARGET_APY, "0.3", "0.2", "0.5"),
+		expectedErr: "MinAPY 0.300000000000000000 should not be greater than MaxAPY 0.200000000000000000",
+	},
+	{
+		name:        "max annual pool spend greater than one",
+		params:      paramsWithSchedule(types.STAKING_REWARDS_MODE_TARGET_APY, "0.1", "0.2", "1.1"),
+		expectedErr: "MaxAnnualPoolSpend should not be greater than 1",
+	},
+	{
+		name:        "target apy schedule requires max annual pool spend",
+		params:      paramsWithSchedule(types.STAKING_REWARDS_MODE_TARGET_APY, "0.1", "0.2", "0"),
+		expectedErr: "MaxAnnualPoolSpend should be positive in target apy mode",
+	},
+}
+
+func paramsWithSchedule(mode types.StakingRewardsMode, minAPY, maxAPY, maxAnnualPoolSpend string) types.Params {
+	params := types.DefaultParams()
+	params.StakingRewardsSchedule = types.NewStakingRewardsSchedule(
+		mode,
+		sdkmath.LegacyMustNewDecFromStr(minAPY),
+		sdkmath.LegacyMustNewDecFromStr(maxAPY),
+		sdkmath.LegacyMustNewDecFromStr(maxAnnualPoolSpend),
+	)
+	return params
 }
 
 func TestParamsValidate(t *testing.T) {
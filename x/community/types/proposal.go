@@ -21,6 +21,8 @@ const (
 	ProposalTypeCommunityCDPRepayDebt = "CommunityCDPRepayDebt"
 	// ProposalTypeCommunityCDPWithdrawCollateral defines the type for a CommunityCDPWithdrawCollateralProposal
 	ProposalTypeCommunityCDPWithdrawCollateral = "CommunityCDPWithdrawCollateral"
+	// ProposalTypeCommunityPoolSwapExactForTokens defines the type for a CommunityPoolSwapExactForTokensProposal
+	ProposalTypeCommunityPoolSwapExactForTokens = "CommunityPoolSwapExactForTokens"
 )
 
 // Assert CommunityPoolLendDepositProposal implements govtypes.Content at compile-time
@@ -29,6 +31,7 @@ var (
 	_ govv1beta1.Content = &CommunityPoolLendWithdrawProposal{}
 	_ govv1beta1.Content = &CommunityCDPRepayDebtProposal{}
 	_ govv1beta1.Content = &CommunityCDPWithdrawCollateralProposal{}
+	_ govv1beta1.Content = &CommunityPoolSwapExactForTokensProposal{}
 )
 
 func init() {
@@ -40,6 +43,8 @@ func init() {
 	govcodec.ModuleCdc.Amino.RegisterConcrete(&CommunityCDPRepayDebtProposal{}, "kava/CommunityCDPRepayDebtProposal", nil)
 	govv1beta1.RegisterProposalType(ProposalTypeCommunityCDPWithdrawCollateral)
 	govcodec.ModuleCdc.Amino.RegisterConcrete(&CommunityCDPWithdrawCollateralProposal{}, "kava/CommunityCDPWithdrawCollateralProposal", nil)
+	govv1beta1.RegisterProposalType(ProposalTypeCommunityPoolSwapExactForTokens)
+	govcodec.ModuleCdc.Amino.RegisterConcrete(&CommunityPoolSwapExactForTokensProposal{}, "kava/CommunityPoolSwapExactForTokensProposal", nil)
 }
 
 //////////////////
@@ -257,3 +262,78 @@ func (p *CommunityCDPWithdrawCollateralProposal) ValidateBasic() error {
 	}
 	return nil
 }
+
+//////////////////
+// Swap Proposals
+//////////////////
+
+// NewCommunityPoolSwapExactForTokensProposal creates a new community pool swap exact for tokens proposal.
+func NewCommunityPoolSwapExactForTokensProposal(
+	title string,
+	description string,
+	exactTokenA sdk.Coin,
+	tokenB sdk.Coin,
+	slippage sdk.Dec,
+) *CommunityPoolSwapExactForTokensProposal {
+	return &CommunityPoolSwapExactForTokensProposal{
+		Title:       title,
+		Description: description,
+		ExactTokenA: exactTokenA,
+		TokenB:      tokenB,
+		Slippage:    slippage,
+	}
+}
+
+// GetTitle returns the title of the proposal.
+func (p *CommunityPoolSwapExactForTokensProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of the proposal.
+func (p *CommunityPoolSwapExactForTokensProposal) GetDescription() string { return p.Description }
+
+// GetDescription returns the routing key of the proposal.
+func (p *CommunityPoolSwapExactForTokensProposal) ProposalRoute() string { return ModuleName }
+
+// ProposalType returns the type of the proposal.
+func (p *CommunityPoolSwapExactForTokensProposal) ProposalType() string {
+	return ProposalTypeCommunityPoolSwapExactForTokens
+}
+
+// String implements fmt.Stringer
+func (p *CommunityPoolSwapExactForTokensProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Community Pool Swap Exact For Tokens Proposal:
+  Title:         %s
+  Description:   %s
+  Exact Token A: %s
+  Token B:       %s
+  Slippage:      %s
+`, p.Title, p.Description, p.ExactTokenA, p.TokenB, p.Slippage))
+	return b.String()
+}
+
+// ValidateBasic stateless validation of the proposal.
+func (p *CommunityPoolSwapExactForTokensProposal) ValidateBasic() error {
+	if err := govv1beta1.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	// ensure the proposal has valid swap amounts
+	if !p.ExactTokenA.IsValid() || p.ExactTokenA.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "exact token a amount %s", p.ExactTokenA)
+	}
+	if !p.TokenB.IsValid() || p.TokenB.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "token b amount %s", p.TokenB)
+	}
+	if p.ExactTokenA.Denom == p.TokenB.Denom {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "denominations can not be equal")
+	}
+
+	// ensure the slippage limit is set
+	if p.Slippage.IsNil() {
+		return errors.New("slippage must be set")
+	}
+	if p.Slippage.IsNegative() {
+		return errors.New("slippage can not be negative")
+	}
+	return nil
+}
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -187,11 +188,59 @@ func (m *CommunityCDPWithdrawCollateralProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CommunityCDPWithdrawCollateralProposal proto.InternalMessageInfo
 
+// CommunityPoolSwapExactForTokensProposal swaps an exact amount of a community pool asset
+// for another asset through x/swap, failing if the price moves beyond the slippage limit.
+type CommunityPoolSwapExactForTokensProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// exact_token_a is the exact amount of the community pool asset to sell
+	ExactTokenA types.Coin `protobuf:"bytes,3,opt,name=exact_token_a,json=exactTokenA,proto3" json:"exact_token_a"`
+	// token_b is the expected amount of the asset to receive
+	TokenB types.Coin `protobuf:"bytes,4,opt,name=token_b,json=tokenB,proto3" json:"token_b"`
+	// slippage is the maximum decimal percentage change from token_b allowed
+	Slippage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=slippage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slippage"`
+}
+
+func (m *CommunityPoolSwapExactForTokensProposal) Reset() {
+	*m = CommunityPoolSwapExactForTokensProposal{}
+}
+func (*CommunityPoolSwapExactForTokensProposal) ProtoMessage() {}
+func (*CommunityPoolSwapExactForTokensProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64aa83b2ed448ec1, []int{4}
+}
+func (m *CommunityPoolSwapExactForTokensProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommunityPoolSwapExactForTokensProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommunityPoolSwapExactForTokensProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommunityPoolSwapExactForTokensProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityPoolSwapExactForTokensProposal.Merge(m, src)
+}
+func (m *CommunityPoolSwapExactForTokensProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommunityPoolSwapExactForTokensProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityPoolSwapExactForTokensProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityPoolSwapExactForTokensProposal proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*CommunityPoolLendDepositProposal)(nil), "kava.community.v1beta1.CommunityPoolLendDepositProposal")
 	proto.RegisterType((*CommunityPoolLendWithdrawProposal)(nil), "kava.community.v1beta1.CommunityPoolLendWithdrawProposal")
 	proto.RegisterType((*CommunityCDPRepayDebtProposal)(nil), "kava.community.v1beta1.CommunityCDPRepayDebtProposal")
 	proto.RegisterType((*CommunityCDPWithdrawCollateralProposal)(nil), "kava.community.v1beta1.CommunityCDPWithdrawCollateralProposal")
+	proto.RegisterType((*CommunityPoolSwapExactForTokensProposal)(nil), "kava.community.v1beta1.CommunityPoolSwapExactForTokensProposal")
 }
 
 func init() {
@@ -199,34 +248,40 @@ func init() {
 }
 
 var fileDescriptor_64aa83b2ed448ec1 = []byte{
-	// 419 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x93, 0x3f, 0x0b, 0xd3, 0x40,
-	0x18, 0xc6, 0x73, 0xb6, 0x56, 0xbd, 0x82, 0x42, 0x28, 0x12, 0x0b, 0x26, 0xb1, 0xa0, 0x16, 0xa4,
-	0x39, 0xab, 0x93, 0x2e, 0x42, 0x53, 0x37, 0x87, 0x12, 0x04, 0xc1, 0x45, 0x2e, 0xc9, 0xd1, 0x1e,
-	0x4d, 0xf2, 0x1e, 0xb9, 0x6b, 0x35, 0xdf, 0xc0, 0xd1, 0xd1, 0xb1, 0xb3, 0xdf, 0x43, 0xa8, 0x4e,
-	0x1d, 0x1c, 0x9c, 0x54, 0xda, 0x2f, 0x22, 0xf9, 0xdb, 0x80, 0x20, 0x82, 0x20, 0x38, 0xe5, 0xcd,
-	0x7b, 0xcf, 0x7b, 0xf7, 0xfc, 0x78, 0x78, 0xf1, 0xed, 0x35, 0xdd, 0x52, 0x12, 0x40, 0x1c, 0x6f,
-	0x12, 0xae, 0x32, 0xb2, 0x9d, 0xfa, 0x4c, 0xd1, 0x29, 0x11, 0x29, 0x08, 0x90, 0x34, 0x72, 0x44,
-	0x0a, 0x0a, 0xf4, 0xeb, 0xb9, 0xcc, 0x69, 0x64, 0x4e, 0x25, 0x1b, 0x9a, 0x01, 0xc8, 0x18, 0x24,
-	0xf1, 0xa9, 0x64, 0xcd, 0x6c, 0x00, 0x3c, 0x29, 0xe7, 0x86, 0x83, 0x25, 0x2c, 0xa1, 0x28, 0x49,
-	0x5e, 0x95, 0xdd, 0xd1, 0x27, 0x84, 0x6d, 0xb7, 0xbe, 0x6b, 0x01, 0x10, 0x3d, 0x63, 0x49, 0x38,
-	0x67, 0x02, 0x24, 0x57, 0x8b, 0xea, 0x61, 0x7d, 0x80, 0x2f, 0x2a, 0xae, 0x22, 0x66, 0x20, 0x1b,
-	0x8d, 0xaf, 0x78, 0xe5, 0x8f, 0x6e, 0xe3, 0x7e, 0xc8, 0x64, 0x90, 0x72, 0xa1, 0x38, 0x24, 0xc6,
-	0x85, 0xe2, 0xac, 0xdd, 0xd2, 0x03, 0xdc, 0xa3, 0x31, 0x6c, 0x12, 0x65, 0x74, 0xec, 0xce, 0xb8,
-	0xff, 0xe0, 0x86, 0x53, 0x7a, 0x74, 0x72, 0x8f, 0xb5, 0x71, 0xc7, 0x05, 0x9e, 0xcc, 0xee, 0xef,
-	0xbf, 0x59, 0xda, 0x87, 0xef, 0xd6, 0x78, 0xc9, 0xd5, 0x6a, 0xe3, 0xe7, 0x7c, 0xa4, 0x02, 0x2a,
-	0x3f, 0x13, 0x19, 0xae, 0x89, 0xca, 0x04, 0x93, 0xc5, 0x80, 0xf4, 0xaa, 0xab, 0x1f, 0x5f, 0x7e,
-	0xbb, 0xb3, 0xb4, 0xf7, 0x3b, 0x4b, 0x1b, 0x7d, 0x46, 0xf8, 0xd6, 0x2f, 0x2c, 0x2f, 0xb8, 0x5a,
-	0x85, 0x29, 0x7d, 0xfd, 0xbf, 0xc1, 0x7c, 0x44, 0xf8, 0x66, 0x03, 0xe3, 0xce, 0x17, 0x1e, 0x13,
-	0x34, 0x9b, 0x33, 0xff, 0xef, 0x53, 0xb9, 0x8b, 0xaf, 0x05, 0x10, 0x45, 0x54, 0xb1, 0x94, 0x46,
-	0xaf, 0x72, 0x17, 0x46, 0xa7, 0x50, 0x5d, 0x3d, 0xb7, 0x9f, 0x67, 0x82, 0xe9, 0x8f, 0xf0, 0x25,
-	0x41, 0xb3, 0x98, 0x25, 0xca, 0xe8, 0xda, 0xe8, 0xf7, 0xc8, 0xdd, 0x1c, 0xd9, 0xab, 0xf5, 0x2d,
-	0x8e, 0x2f, 0x08, 0xdf, 0x69, 0x73, 0xd4, 0x79, 0xb8, 0xcd, 0x5b, 0xff, 0x0e, 0xe8, 0x09, 0xc6,
-	0xe7, 0xce, 0x9f, 0x32, 0xb5, 0x46, 0xce, 0x58, 0xb3, 0xa7, 0xfb, 0xa3, 0x89, 0x0e, 0x47, 0x13,
-	0xfd, 0x38, 0x9a, 0xe8, 0xdd, 0xc9, 0xd4, 0x0e, 0x27, 0x53, 0xfb, 0x7a, 0x32, 0xb5, 0x97, 0xf7,
-	0x5a, 0xa1, 0xe7, 0xab, 0x3a, 0x89, 0xa8, 0x2f, 0x8b, 0x8a, 0xbc, 0x69, 0x6d, 0x77, 0x91, 0xbe,
-	0xdf, 0x2b, 0xb6, 0xf0, 0xe1, 0xcf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xef, 0x58, 0xb9, 0x21, 0xfc,
-	0x03, 0x00, 0x00,
+	// 526 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x94, 0x41, 0x6b, 0xd4, 0x40,
+	0x14, 0xc7, 0x33, 0xdd, 0x76, 0x5b, 0x67, 0x51, 0x21, 0x14, 0x49, 0x0b, 0x26, 0xb1, 0x60, 0xbb,
+	0x20, 0x9b, 0x58, 0xbd, 0xa8, 0x08, 0xe2, 0x26, 0xf5, 0xe4, 0x61, 0x89, 0x05, 0xc5, 0xcb, 0x32,
+	0xc9, 0x0e, 0xdb, 0x61, 0x93, 0xcc, 0x90, 0x99, 0x6d, 0x9b, 0x6f, 0xe0, 0xd1, 0xa3, 0xc7, 0x9e,
+	0x3d, 0xfb, 0x11, 0x14, 0xaa, 0xa7, 0x22, 0x1e, 0xc4, 0x43, 0x95, 0xdd, 0x2f, 0x22, 0x33, 0xc9,
+	0x66, 0x23, 0x82, 0x2c, 0x14, 0x84, 0x9e, 0x32, 0xf3, 0xde, 0xfb, 0xbf, 0xf7, 0xff, 0x65, 0x86,
+	0x81, 0xb7, 0x47, 0xe8, 0x10, 0xb9, 0x11, 0x4d, 0x92, 0x71, 0x4a, 0x44, 0xee, 0x1e, 0xee, 0x86,
+	0x58, 0xa0, 0x5d, 0x97, 0x65, 0x94, 0x51, 0x8e, 0x62, 0x87, 0x65, 0x54, 0x50, 0xfd, 0x86, 0x2c,
+	0x73, 0xaa, 0x32, 0xa7, 0x2c, 0xdb, 0x34, 0x23, 0xca, 0x13, 0xca, 0xdd, 0x10, 0x71, 0x5c, 0x69,
+	0x23, 0x4a, 0xd2, 0x42, 0xb7, 0xb9, 0x51, 0xe4, 0xfb, 0x6a, 0xe7, 0x16, 0x9b, 0x32, 0xb5, 0x3e,
+	0xa4, 0x43, 0x5a, 0xc4, 0xe5, 0xaa, 0x88, 0x6e, 0x7d, 0x06, 0xd0, 0xf6, 0x66, 0x63, 0x7a, 0x94,
+	0xc6, 0xcf, 0x71, 0x3a, 0xf0, 0x31, 0xa3, 0x9c, 0x88, 0x5e, 0xe9, 0x49, 0x5f, 0x87, 0x2b, 0x82,
+	0x88, 0x18, 0x1b, 0xc0, 0x06, 0xed, 0x2b, 0x41, 0xb1, 0xd1, 0x6d, 0xd8, 0x1a, 0x60, 0x1e, 0x65,
+	0x84, 0x09, 0x42, 0x53, 0x63, 0x49, 0xe5, 0xea, 0x21, 0x3d, 0x82, 0x4d, 0x94, 0xd0, 0x71, 0x2a,
+	0x8c, 0x86, 0xdd, 0x68, 0xb7, 0xee, 0x6d, 0x38, 0xa5, 0x23, 0x69, 0x7f, 0xc6, 0xe4, 0x78, 0x94,
+	0xa4, 0xdd, 0xbb, 0xa7, 0xe7, 0x96, 0xf6, 0xfe, 0xa7, 0xd5, 0x1e, 0x12, 0x71, 0x30, 0x0e, 0x25,
+	0x7a, 0x69, 0xbf, 0xfc, 0x74, 0xf8, 0x60, 0xe4, 0x8a, 0x9c, 0x61, 0xae, 0x04, 0x3c, 0x28, 0x5b,
+	0x3f, 0x5a, 0x7b, 0x73, 0x62, 0x69, 0xef, 0x4e, 0x2c, 0x6d, 0xeb, 0x0b, 0x80, 0xb7, 0xfe, 0x62,
+	0x79, 0x49, 0xc4, 0xc1, 0x20, 0x43, 0x47, 0x97, 0x0d, 0xe6, 0x13, 0x80, 0x37, 0x2b, 0x18, 0xcf,
+	0xef, 0x05, 0x98, 0xa1, 0xdc, 0xc7, 0xe1, 0xc5, 0x4f, 0x65, 0x07, 0x5e, 0x8f, 0x68, 0x1c, 0x23,
+	0x81, 0x33, 0x14, 0xf7, 0xa5, 0x0b, 0xa3, 0xa1, 0xaa, 0xae, 0xcd, 0xc3, 0xfb, 0x39, 0xc3, 0xfa,
+	0x43, 0xb8, 0xca, 0x50, 0x9e, 0xe0, 0x54, 0x18, 0xcb, 0x36, 0xf8, 0x37, 0xf2, 0xb2, 0x44, 0x0e,
+	0x66, 0xf5, 0x35, 0x8e, 0x6f, 0x00, 0x6e, 0xd7, 0x39, 0x66, 0xe7, 0xe1, 0x55, 0xb3, 0xfe, 0x1f,
+	0xd0, 0x13, 0x08, 0xe7, 0x91, 0x45, 0x99, 0x6a, 0x92, 0x1a, 0xd6, 0xc7, 0x25, 0xb8, 0xf3, 0xc7,
+	0x5d, 0x7b, 0x71, 0x84, 0xd8, 0xde, 0x31, 0x8a, 0xc4, 0x33, 0x9a, 0xed, 0xd3, 0x11, 0x4e, 0xf9,
+	0x85, 0xb9, 0x3c, 0x78, 0x15, 0xcb, 0x8e, 0x7d, 0x21, 0xfb, 0xf5, 0x91, 0xd1, 0x58, 0xcc, 0x71,
+	0x4b, 0xa9, 0x94, 0x89, 0xa7, 0xfa, 0x03, 0xb8, 0x5a, 0xc8, 0xc3, 0x45, 0x81, 0x9b, 0xaa, 0xbe,
+	0xab, 0xbf, 0x82, 0x6b, 0x3c, 0x26, 0x8c, 0xa1, 0x21, 0x36, 0x56, 0xa4, 0xbb, 0xee, 0x63, 0x99,
+	0xff, 0x71, 0x6e, 0x6d, 0x2f, 0x70, 0xaf, 0x7d, 0x1c, 0x7d, 0xfd, 0xd0, 0x81, 0xe5, 0x2c, 0x1f,
+	0x47, 0x41, 0xd5, 0x6d, 0xfe, 0x1b, 0xbb, 0x7b, 0xa7, 0x13, 0x13, 0x9c, 0x4d, 0x4c, 0xf0, 0x6b,
+	0x62, 0x82, 0xb7, 0x53, 0x53, 0x3b, 0x9b, 0x9a, 0xda, 0xf7, 0xa9, 0xa9, 0xbd, 0xbe, 0x53, 0x9b,
+	0x21, 0x1f, 0xc3, 0x4e, 0x8c, 0x42, 0xae, 0x56, 0xee, 0x71, 0xed, 0xfd, 0x54, 0xc3, 0xc2, 0xa6,
+	0x7a, 0xcc, 0xee, 0xff, 0x1e, 0x00, 0x23, 0x83, 0x91, 0xb1, 0x5e, 0x05, 0x00, 0x00,
 }
 
 func (m *CommunityPoolLendDepositProposal) Marshal() (dAtA []byte, err error) {
@@ -439,6 +494,73 @@ func (m *CommunityCDPWithdrawCollateralProposal) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
+func (m *CommunityPoolSwapExactForTokensProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommunityPoolSwapExactForTokensProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommunityPoolSwapExactForTokensProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Slippage.Size()
+		i -= size
+		if _, err := m.Slippage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintProposal(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.TokenB.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProposal(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.ExactTokenA.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProposal(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintProposal(dAtA []byte, offset int, v uint64) int {
 	offset -= sovProposal(v)
 	base := offset
@@ -542,6 +664,29 @@ func (m *CommunityCDPWithdrawCollateralProposal) Size() (n int) {
 	return n
 }
 
+func (m *CommunityPoolSwapExactForTokensProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = m.ExactTokenA.Size()
+	n += 1 + l + sovProposal(uint64(l))
+	l = m.TokenB.Size()
+	n += 1 + l + sovProposal(uint64(l))
+	l = m.Slippage.Size()
+	n += 1 + l + sovProposal(uint64(l))
+	return n
+}
+
 func sovProposal(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1202,6 +1347,220 @@ func (m *CommunityCDPWithdrawCollateralProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CommunityPoolSwapExactForTokensProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommunityPoolSwapExactForTokensProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommunityPoolSwapExactForTokensProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExactTokenA", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExactTokenA.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenB", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slippage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Slippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipProposal(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -328,3 +328,131 @@ func TestCommunityCDPWithdrawCollateralProposal_Stringer(t *testing.T) {
   Collateral:      42ukava
 `, proposal.String())
 }
+
+func TestCommunityPoolSwapExactForTokensProposal_ValidateBasic(t *testing.T) {
+	type proposalData struct {
+		Title       string
+		Description string
+		ExactTokenA sdk.Coin
+		TokenB      sdk.Coin
+		Slippage    sdk.Dec
+	}
+	testCases := []struct {
+		name        string
+		proposal    proposalData
+		expectedErr string
+	}{
+		{
+			name: "valid proposal",
+			proposal: proposalData{
+				Title:       "diversify the treasury",
+				Description: "I interact with swap",
+				ExactTokenA: sdk.NewInt64Coin("ukava", 1e6),
+				TokenB:      sdk.NewInt64Coin("usdx", 1e6),
+				Slippage:    sdk.MustNewDecFromStr("0.01"),
+			},
+			expectedErr: "",
+		},
+		{
+			name: "invalid - fails gov validation",
+			proposal: proposalData{
+				Description: "I have no title.",
+			},
+			expectedErr: "invalid proposal content",
+		},
+		{
+			name: "invalid - zero exact token a",
+			proposal: proposalData{
+				Title:       "Error profoundly",
+				Description: "My input is zero",
+				ExactTokenA: sdk.NewInt64Coin("ukava", 0),
+				TokenB:      sdk.NewInt64Coin("usdx", 1e6),
+				Slippage:    sdk.MustNewDecFromStr("0.01"),
+			},
+			expectedErr: "invalid coins",
+		},
+		{
+			name: "invalid - empty token b",
+			proposal: proposalData{
+				Title:       "Error profoundly",
+				Description: "My output is empty",
+				ExactTokenA: sdk.NewInt64Coin("ukava", 1e6),
+				TokenB:      sdk.Coin{},
+				Slippage:    sdk.MustNewDecFromStr("0.01"),
+			},
+			expectedErr: "invalid coins",
+		},
+		{
+			name: "invalid - equal denoms",
+			proposal: proposalData{
+				Title:       "Error profoundly",
+				Description: "I swap with myself",
+				ExactTokenA: sdk.NewInt64Coin("ukava", 1e6),
+				TokenB:      sdk.NewInt64Coin("ukava", 1e6),
+				Slippage:    sdk.MustNewDecFromStr("0.01"),
+			},
+			expectedErr: "denominations can not be equal",
+		},
+		{
+			name: "invalid - nil slippage",
+			proposal: proposalData{
+				Title:       "Error profoundly",
+				Description: "I have no slippage",
+				ExactTokenA: sdk.NewInt64Coin("ukava", 1e6),
+				TokenB:      sdk.NewInt64Coin("usdx", 1e6),
+			},
+			expectedErr: "slippage must be set",
+		},
+		{
+			name: "invalid - negative slippage",
+			proposal: proposalData{
+				Title:       "Error profoundly",
+				Description: "My slippage is negative",
+				ExactTokenA: sdk.NewInt64Coin("ukava", 1e6),
+				TokenB:      sdk.NewInt64Coin("usdx", 1e6),
+				Slippage:    sdk.MustNewDecFromStr("-0.01"),
+			},
+			expectedErr: "slippage can not be negative",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			swap := types.NewCommunityPoolSwapExactForTokensProposal(
+				tc.proposal.Title,
+				tc.proposal.Description,
+				tc.proposal.ExactTokenA,
+				tc.proposal.TokenB,
+				tc.proposal.Slippage,
+			)
+			err := swap.ValidateBasic()
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, swap.Title, swap.GetTitle())
+			require.Equal(t, swap.Description, swap.GetDescription())
+			require.Equal(t, types.ModuleName, swap.ProposalRoute())
+			require.Equal(t, types.ProposalTypeCommunityPoolSwapExactForTokens, swap.ProposalType())
+		})
+	}
+}
+
+func TestCommunityPoolSwapExactForTokensProposal_Stringer(t *testing.T) {
+	proposal := types.NewCommunityPoolSwapExactForTokensProposal(
+		"title",
+		"description",
+		sdk.NewInt64Coin("ukava", 42),
+		sdk.NewInt64Coin("usdx", 21),
+		sdk.MustNewDecFromStr("0.01"),
+	)
+	require.Equal(t, `Community Pool Swap Exact For Tokens Proposal:
+  Title:         title
+  Description:   description
+  Exact Token A: 42ukava
+  Token B:       21usdx
+  Slippage:      0.010000000000000000
+`, proposal.String())
+}
@@ -358,6 +358,179 @@ func (m *QueryAnnualizedRewardsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryAnnualizedRewardsResponse proto.InternalMessageInfo
 
+// QueryTreasuryPositionsRequest defines the request type for querying the community treasury positions.
+type QueryTreasuryPositionsRequest struct {
+}
+
+func (m *QueryTreasuryPositionsRequest) Reset()         { *m = QueryTreasuryPositionsRequest{} }
+func (m *QueryTreasuryPositionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTreasuryPositionsRequest) ProtoMessage()    {}
+func (*QueryTreasuryPositionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f236f06c43149273, []int{8}
+}
+func (m *QueryTreasuryPositionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTreasuryPositionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTreasuryPositionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTreasuryPositionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTreasuryPositionsRequest.Merge(m, src)
+}
+func (m *QueryTreasuryPositionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTreasuryPositionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTreasuryPositionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTreasuryPositionsRequest proto.InternalMessageInfo
+
+// QueryTreasuryPositionsResponse defines the response type for querying the community treasury positions.
+type QueryTreasuryPositionsResponse struct {
+	// positions lists every hard, cdp, and swap position held by the x/community module account
+	Positions TreasuryPositions `protobuf:"bytes,1,rep,name=positions,proto3,castrepeated=TreasuryPositions" json:"positions"`
+	// net_usd_value is the USD value of all asset positions minus the USD value of all debt positions
+	NetUSDValue github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=net_usd_value,json=netUsdValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"net_usd_value"`
+}
+
+func (m *QueryTreasuryPositionsResponse) Reset()         { *m = QueryTreasuryPositionsResponse{} }
+func (m *QueryTreasuryPositionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTreasuryPositionsResponse) ProtoMessage()    {}
+func (*QueryTreasuryPositionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f236f06c43149273, []int{9}
+}
+func (m *QueryTreasuryPositionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTreasuryPositionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTreasuryPositionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTreasuryPositionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTreasuryPositionsResponse.Merge(m, src)
+}
+func (m *QueryTreasuryPositionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTreasuryPositionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTreasuryPositionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTreasuryPositionsResponse proto.InternalMessageInfo
+
+func (m *QueryTreasuryPositionsResponse) GetPositions() TreasuryPositions {
+	if m != nil {
+		return m.Positions
+	}
+	return nil
+}
+
+// QueryStakingRewardsProjectionRequest defines the request type for querying the staking rewards projection.
+type QueryStakingRewardsProjectionRequest struct {
+}
+
+func (m *QueryStakingRewardsProjectionRequest) Reset()         { *m = QueryStakingRewardsProjectionRequest{} }
+func (m *QueryStakingRewardsProjectionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStakingRewardsProjectionRequest) ProtoMessage()    {}
+func (*QueryStakingRewardsProjectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f236f06c43149273, []int{10}
+}
+func (m *QueryStakingRewardsProjectionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStakingRewardsProjectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStakingRewardsProjectionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStakingRewardsProjectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStakingRewardsProjectionRequest.Merge(m, src)
+}
+func (m *QueryStakingRewardsProjectionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStakingRewardsProjectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStakingRewardsProjectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStakingRewardsProjectionRequest proto.InternalMessageInfo
+
+// QueryStakingRewardsProjectionResponse defines the response type for querying the staking rewards projection.
+type QueryStakingRewardsProjectionResponse struct {
+	// staking_rewards_per_second is the current amount paid out to delegators each second
+	StakingRewardsPerSecond cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=staking_rewards_per_second,json=stakingRewardsPerSecond,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"staking_rewards_per_second"`
+	// balance is the community pool balance of the staking denom available to pay rewards
+	Balance types.Coin `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance"`
+	// runway_seconds is the number of seconds the balance can sustain the current staking_rewards_per_second.
+	// It is zero when no staking rewards are paid, since the balance is not being depleted.
+	RunwaySeconds cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=runway_seconds,json=runwaySeconds,proto3,customtype=cosmossdk.io/math.Int" json:"runway_seconds"`
+}
+
+func (m *QueryStakingRewardsProjectionResponse) Reset()         { *m = QueryStakingRewardsProjectionResponse{} }
+func (m *QueryStakingRewardsProjectionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStakingRewardsProjectionResponse) ProtoMessage()    {}
+func (*QueryStakingRewardsProjectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f236f06c43149273, []int{11}
+}
+func (m *QueryStakingRewardsProjectionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStakingRewardsProjectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStakingRewardsProjectionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStakingRewardsProjectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStakingRewardsProjectionResponse.Merge(m, src)
+}
+func (m *QueryStakingRewardsProjectionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStakingRewardsProjectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStakingRewardsProjectionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStakingRewardsProjectionResponse proto.InternalMessageInfo
+
+func (m *QueryStakingRewardsProjectionResponse) GetBalance() types.Coin {
+	if m != nil {
+		return m.Balance
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "kava.community.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "kava.community.v1beta1.QueryParamsResponse")
@@ -367,6 +540,10 @@ func init() {
 	proto.RegisterType((*QueryTotalBalanceResponse)(nil), "kava.community.v1beta1.QueryTotalBalanceResponse")
 	proto.RegisterType((*QueryAnnualizedRewardsRequest)(nil), "kava.community.v1beta1.QueryAnnualizedRewardsRequest")
 	proto.RegisterType((*QueryAnnualizedRewardsResponse)(nil), "kava.community.v1beta1.QueryAnnualizedRewardsResponse")
+	proto.RegisterType((*QueryTreasuryPositionsRequest)(nil), "kava.community.v1beta1.QueryTreasuryPositionsRequest")
+	proto.RegisterType((*QueryTreasuryPositionsResponse)(nil), "kava.community.v1beta1.QueryTreasuryPositionsResponse")
+	proto.RegisterType((*QueryStakingRewardsProjectionRequest)(nil), "kava.community.v1beta1.QueryStakingRewardsProjectionRequest")
+	proto.RegisterType((*QueryStakingRewardsProjectionResponse)(nil), "kava.community.v1beta1.QueryStakingRewardsProjectionResponse")
 }
 
 func init() {
@@ -374,45 +551,62 @@ func init() {
 }
 
 var fileDescriptor_f236f06c43149273 = []byte{
-	// 606 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x63, 0x68, 0x8b, 0xb8, 0x22, 0x10, 0x47, 0x40, 0x8d, 0x29, 0x4e, 0x31, 0x82, 0x46,
-	0x6d, 0x63, 0x37, 0xa9, 0x60, 0x62, 0x21, 0x84, 0x8d, 0x01, 0x0c, 0x53, 0x97, 0xe8, 0xec, 0x9c,
-	0x5c, 0x2b, 0x8e, 0xcf, 0xcd, 0x5d, 0x0a, 0x41, 0x2c, 0x74, 0x63, 0x40, 0x42, 0xe2, 0x1b, 0x30,
-	0x32, 0x23, 0x3e, 0x43, 0xc7, 0x0a, 0x16, 0xc4, 0x50, 0x50, 0xc2, 0x07, 0x41, 0x77, 0xf7, 0x12,
-	0x25, 0x34, 0x8e, 0xd2, 0x29, 0xf1, 0xbb, 0xf7, 0x7f, 0xff, 0xdf, 0xbd, 0xf7, 0x6c, 0x64, 0xb7,
-	0xc8, 0x01, 0x71, 0x03, 0xd6, 0x6e, 0x77, 0x93, 0x48, 0xf4, 0xdc, 0x83, 0x8a, 0x4f, 0x05, 0xa9,
-	0xb8, 0xfb, 0x5d, 0xda, 0xe9, 0x39, 0x69, 0x87, 0x09, 0x86, 0x6f, 0xc8, 0x1c, 0x67, 0x94, 0xe3,
-	0x40, 0x8e, 0x69, 0x05, 0x8c, 0xb7, 0x19, 0x77, 0x7d, 0xc2, 0xe9, 0x48, 0x18, 0xb0, 0x28, 0xd1,
-	0x3a, 0xb3, 0xa0, 0xcf, 0x1b, 0xea, 0xc9, 0xd5, 0x0f, 0x70, 0x94, 0x0f, 0x59, 0xc8, 0x74, 0x5c,
-	0xfe, 0x83, 0xe8, 0x6a, 0xc8, 0x58, 0x18, 0x53, 0x97, 0xa4, 0x91, 0x4b, 0x92, 0x84, 0x09, 0x22,
-	0x22, 0x96, 0x0c, 0x35, 0x77, 0x32, 0x50, 0x53, 0xd2, 0x21, 0x6d, 0x48, 0xb2, 0xf3, 0x08, 0x3f,
-	0x97, 0xe8, 0xcf, 0x54, 0xd0, 0xa3, 0xfb, 0x5d, 0xca, 0x85, 0xfd, 0x02, 0x5d, 0x9b, 0x88, 0xf2,
-	0x94, 0x25, 0x9c, 0xe2, 0x87, 0x68, 0x49, 0x8b, 0x57, 0x8c, 0x35, 0xa3, 0xb4, 0x5c, 0xb5, 0x9c,
-	0xe9, 0x37, 0x75, 0xb4, 0xae, 0xb6, 0x70, 0x74, 0x52, 0xcc, 0x79, 0xa0, 0xb1, 0xaf, 0x43, 0xd1,
-	0x1a, 0x89, 0x49, 0x12, 0xd0, 0xa1, 0x57, 0x0f, 0xe5, 0x27, 0xc3, 0x60, 0x46, 0xd0, 0xa2, 0xec,
-	0x8d, 0xf4, 0x3a, 0x5f, 0x5a, 0xae, 0x16, 0x1c, 0x68, 0x88, 0xec, 0xde, 0xc8, 0xe8, 0x31, 0x8b,
-	0x92, 0xda, 0xb6, 0xb4, 0xf9, 0xf2, 0xbb, 0x58, 0x0a, 0x23, 0xb1, 0xd7, 0xf5, 0x25, 0x0f, 0x74,
-	0x0f, 0x7e, 0xca, 0xbc, 0xd9, 0x72, 0x45, 0x2f, 0xa5, 0x5c, 0x09, 0xb8, 0xa7, 0x2b, 0xdb, 0x26,
-	0x5a, 0x51, 0xd6, 0x2f, 0x99, 0x20, 0xf1, 0x7f, 0x58, 0x87, 0x06, 0x2a, 0x4c, 0x39, 0x04, 0x38,
-	0x8a, 0x16, 0x52, 0xc6, 0x62, 0x60, 0x5b, 0x9d, 0xca, 0x56, 0xa7, 0x81, 0xc2, 0xdb, 0x01, 0xbc,
-	0xcd, 0x39, 0xf0, 0x40, 0xc3, 0x3d, 0x55, 0xde, 0x2e, 0xa2, 0x5b, 0x8a, 0xe1, 0x51, 0x92, 0x74,
-	0x49, 0x1c, 0xbd, 0xa1, 0x4d, 0x8f, 0xbe, 0x22, 0x9d, 0xe6, 0x68, 0x50, 0x6f, 0x91, 0x95, 0x95,
-	0x00, 0xa4, 0xbb, 0xe8, 0x0a, 0x17, 0xa4, 0x15, 0x25, 0x61, 0xa3, 0xa3, 0x8f, 0xd4, 0xf0, 0x2e,
-	0xd6, 0x2a, 0x12, 0xeb, 0xd7, 0x49, 0xf1, 0xa6, 0x86, 0xe0, 0xcd, 0x96, 0x13, 0x31, 0xb7, 0x4d,
-	0xc4, 0x9e, 0xf3, 0x94, 0x86, 0x24, 0xe8, 0xd5, 0x69, 0xf0, 0xfd, 0x6b, 0x19, 0xc1, 0xd5, 0xea,
-	0x34, 0xf0, 0x2e, 0x43, 0x25, 0xf0, 0xa8, 0xbe, 0x5b, 0x44, 0x8b, 0xca, 0x1e, 0xbf, 0x37, 0xd0,
-	0x92, 0x1e, 0x3a, 0xde, 0xc8, 0x5a, 0x8a, 0xd3, 0x7b, 0x66, 0x6e, 0xce, 0x95, 0xab, 0x6f, 0x62,
-	0xdf, 0x3b, 0xfc, 0xf1, 0xf7, 0xd3, 0xb9, 0x35, 0x6c, 0xb9, 0x33, 0x17, 0x1b, 0x7f, 0x30, 0xd0,
-	0x05, 0x98, 0x17, 0x9e, 0x6d, 0x30, 0x39, 0x72, 0x73, 0x6b, 0xbe, 0x64, 0xc0, 0x59, 0x57, 0x38,
-	0xb7, 0x71, 0x31, 0x0b, 0xc7, 0x07, 0x86, 0xcf, 0x06, 0xba, 0x34, 0xbe, 0x44, 0x78, 0x7b, 0xa6,
-	0xcf, 0x94, 0x65, 0x34, 0x2b, 0x67, 0x50, 0x00, 0x5e, 0x59, 0xe1, 0xad, 0xe3, 0xbb, 0x59, 0x78,
-	0x42, 0xaa, 0x1a, 0x43, 0xc8, 0x6f, 0x06, 0xba, 0x7a, 0x6a, 0x89, 0xf0, 0xfd, 0x99, 0xbe, 0x59,
-	0x5b, 0x69, 0x3e, 0x38, 0xab, 0x0c, 0x98, 0xab, 0x8a, 0x79, 0x0b, 0x6f, 0x64, 0x31, 0x93, 0x91,
-	0x74, 0xb8, 0xcc, 0xb5, 0x27, 0x47, 0x7d, 0xcb, 0x38, 0xee, 0x5b, 0xc6, 0x9f, 0xbe, 0x65, 0x7c,
-	0x1c, 0x58, 0xb9, 0xe3, 0x81, 0x95, 0xfb, 0x39, 0xb0, 0x72, 0xbb, 0xe3, 0xef, 0x9b, 0xac, 0x57,
-	0x8e, 0x89, 0xcf, 0x75, 0xe5, 0xd7, 0x63, 0xb5, 0xd5, 0x8b, 0xe7, 0x2f, 0xa9, 0xcf, 0xe1, 0xce,
-	0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2e, 0xb3, 0x12, 0x9f, 0xe0, 0x05, 0x00, 0x00,
+	// 867 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x96, 0xcf, 0x72, 0xdb, 0x44,
+	0x1c, 0xc7, 0xad, 0xb4, 0x4d, 0xe9, 0x9a, 0x96, 0xe9, 0x92, 0x82, 0x2d, 0x8a, 0x5c, 0x04, 0x49,
+	0x3d, 0x4d, 0x2d, 0xd5, 0x2e, 0x74, 0x06, 0xa6, 0x1c, 0x30, 0xe6, 0xd0, 0x81, 0x61, 0x8c, 0xdc,
+	0x70, 0xc8, 0x45, 0xb3, 0x96, 0x77, 0x1c, 0x61, 0x7b, 0x57, 0xd1, 0xae, 0x12, 0xcc, 0x70, 0xca,
+	0x8d, 0x03, 0x33, 0x99, 0xe1, 0x0d, 0x38, 0x70, 0xe0, 0xcc, 0xf0, 0x0c, 0x39, 0x66, 0xc8, 0x85,
+	0xe1, 0x10, 0x18, 0x87, 0x47, 0xe0, 0x01, 0x18, 0xed, 0xae, 0x14, 0xff, 0x93, 0xe3, 0xe4, 0x94,
+	0x68, 0xf7, 0xf7, 0xe7, 0xb3, 0xdf, 0xdf, 0xea, 0x2b, 0x03, 0xb3, 0x87, 0xf6, 0x90, 0xed, 0xd1,
+	0xc1, 0x20, 0x22, 0x3e, 0x1f, 0xda, 0x7b, 0xd5, 0x36, 0xe6, 0xa8, 0x6a, 0xef, 0x46, 0x38, 0x1c,
+	0x5a, 0x41, 0x48, 0x39, 0x85, 0x6f, 0xc4, 0x31, 0x56, 0x1a, 0x63, 0xa9, 0x18, 0xdd, 0xf0, 0x28,
+	0x1b, 0x50, 0x66, 0xb7, 0x11, 0xc3, 0x69, 0xa2, 0x47, 0x7d, 0x22, 0xf3, 0xf4, 0xa2, 0xdc, 0x77,
+	0xc5, 0x93, 0x2d, 0x1f, 0xd4, 0xd6, 0x5a, 0x97, 0x76, 0xa9, 0x5c, 0x8f, 0xff, 0x53, 0xab, 0xf7,
+	0xbb, 0x94, 0x76, 0xfb, 0xd8, 0x46, 0x81, 0x6f, 0x23, 0x42, 0x28, 0x47, 0xdc, 0xa7, 0x24, 0xc9,
+	0x79, 0x37, 0x03, 0x35, 0x40, 0x21, 0x1a, 0x24, 0x41, 0xeb, 0x19, 0x41, 0x3c, 0xc4, 0x88, 0x45,
+	0xc9, 0x91, 0xcc, 0x35, 0x00, 0xbf, 0x8a, 0x4f, 0xd8, 0x14, 0xb9, 0x0e, 0xde, 0x8d, 0x30, 0xe3,
+	0x66, 0x0b, 0xbc, 0x3e, 0xb1, 0xca, 0x02, 0x4a, 0x18, 0x86, 0xcf, 0xc1, 0xaa, 0xec, 0x51, 0xd0,
+	0x1e, 0x68, 0xe5, 0x7c, 0xcd, 0xb0, 0xe6, 0x0b, 0x62, 0xc9, 0xbc, 0xfa, 0xf5, 0xa3, 0xd3, 0x52,
+	0xce, 0x51, 0x39, 0xe6, 0x3d, 0x55, 0xb4, 0x8e, 0xfa, 0x88, 0x78, 0x38, 0xe9, 0x35, 0x04, 0x6b,
+	0x93, 0xcb, 0xaa, 0x19, 0x02, 0x37, 0x62, 0x09, 0xe3, 0x5e, 0xd7, 0xca, 0xf9, 0x5a, 0xd1, 0x52,
+	0xba, 0xc5, 0x22, 0xa7, 0x8d, 0x3e, 0xa5, 0x3e, 0xa9, 0x3f, 0x89, 0xdb, 0xfc, 0xfa, 0x77, 0xa9,
+	0xdc, 0xf5, 0xf9, 0x4e, 0xd4, 0x8e, 0x79, 0x94, 0xc8, 0xea, 0x4f, 0x85, 0x75, 0x7a, 0x36, 0x1f,
+	0x06, 0x98, 0x89, 0x04, 0xe6, 0xc8, 0xca, 0xa6, 0x0e, 0x0a, 0xa2, 0xf5, 0x4b, 0xca, 0x51, 0x7f,
+	0x0a, 0xeb, 0x40, 0x03, 0xc5, 0x39, 0x9b, 0x0a, 0x0e, 0x83, 0xeb, 0x01, 0xa5, 0x7d, 0xc5, 0x76,
+	0x7f, 0x2e, 0x5b, 0x03, 0x7b, 0x02, 0xef, 0xa9, 0xc2, 0xdb, 0x5c, 0x02, 0x4f, 0xe5, 0x30, 0x47,
+	0x94, 0x37, 0x4b, 0xe0, 0x6d, 0xc1, 0xf0, 0x09, 0x21, 0x11, 0xea, 0xfb, 0xdf, 0xe1, 0x8e, 0x83,
+	0xf7, 0x51, 0xd8, 0x49, 0x07, 0xf5, 0x3d, 0x30, 0xb2, 0x02, 0x14, 0xe9, 0x36, 0x78, 0x8d, 0x71,
+	0xd4, 0xf3, 0x49, 0xd7, 0x0d, 0xe5, 0x96, 0x18, 0xde, 0xad, 0x7a, 0x35, 0xc6, 0xfa, 0xeb, 0xb4,
+	0xf4, 0x96, 0x84, 0x60, 0x9d, 0x9e, 0xe5, 0x53, 0x7b, 0x80, 0xf8, 0x8e, 0xf5, 0x05, 0xee, 0x22,
+	0x6f, 0xd8, 0xc0, 0xde, 0x1f, 0xbf, 0x55, 0x80, 0x3a, 0x5a, 0x03, 0x7b, 0xce, 0x1d, 0x55, 0x49,
+	0xf5, 0x48, 0xf1, 0x5e, 0xaa, 0x3b, 0xd5, 0xa4, 0xcc, 0x17, 0x17, 0x35, 0xc1, 0xfb, 0x4f, 0x03,
+	0x46, 0x56, 0x44, 0x3a, 0xe6, 0x5b, 0x41, 0xb2, 0xa8, 0xe4, 0x2c, 0x67, 0x5d, 0xab, 0xe9, 0x2a,
+	0xf5, 0xa2, 0x92, 0xf6, 0xee, 0x6c, 0xfd, 0xf3, 0xaa, 0x90, 0x82, 0xdb, 0x04, 0x73, 0x37, 0x62,
+	0x1d, 0x77, 0x0f, 0xf5, 0x23, 0x5c, 0x58, 0x11, 0x02, 0x7c, 0xae, 0x04, 0xd8, 0x58, 0x6e, 0x2e,
+	0xa3, 0xd3, 0x52, 0xfe, 0x4b, 0xcc, 0xb7, 0x5a, 0x8d, 0xaf, 0xe3, 0x22, 0x53, 0xd2, 0xe4, 0x09,
+	0xe6, 0x5b, 0xac, 0x23, 0xb6, 0xcc, 0x0d, 0xf0, 0x9e, 0x38, 0x75, 0x6b, 0x42, 0xae, 0x66, 0x48,
+	0xbf, 0xc1, 0x5e, 0x8c, 0x94, 0xc8, 0xf3, 0xcb, 0x0a, 0x58, 0xbf, 0x20, 0x50, 0xa9, 0x44, 0x80,
+	0x3e, 0x35, 0x45, 0x37, 0xc0, 0xa1, 0xcb, 0xb0, 0x47, 0x49, 0xe7, 0xea, 0x03, 0x7d, 0x73, 0x72,
+	0xa0, 0x4d, 0x1c, 0xb6, 0x44, 0x45, 0xf8, 0x21, 0xb8, 0xd9, 0x96, 0x57, 0x5e, 0x88, 0xb5, 0xf0,
+	0xf5, 0x93, 0x6f, 0x79, 0x12, 0x0f, 0x1d, 0x70, 0x27, 0x8c, 0xc8, 0x3e, 0x1a, 0x2a, 0x3a, 0x56,
+	0xb8, 0x26, 0xf0, 0x36, 0x15, 0xde, 0xbd, 0x59, 0xbc, 0x17, 0x84, 0x8f, 0x81, 0xbd, 0x20, 0xdc,
+	0xb9, 0x2d, 0x4b, 0x48, 0x1a, 0x56, 0x3b, 0x7c, 0x05, 0xdc, 0x10, 0x42, 0xc1, 0x1f, 0x34, 0xb0,
+	0x2a, 0xdd, 0x05, 0x3e, 0xca, 0xba, 0x26, 0xb3, 0x86, 0xa6, 0x6f, 0x2e, 0x15, 0x2b, 0xc5, 0x36,
+	0x37, 0x0e, 0x4e, 0xfe, 0xfd, 0x69, 0xe5, 0x01, 0x34, 0xec, 0x85, 0x46, 0x0b, 0x7f, 0xd4, 0xc0,
+	0x4d, 0x65, 0x0c, 0x70, 0x71, 0x83, 0x49, 0x6f, 0xd1, 0x1f, 0x2f, 0x17, 0xac, 0x70, 0x1e, 0x0a,
+	0x9c, 0x77, 0x60, 0x29, 0x0b, 0x27, 0x51, 0xfe, 0x67, 0x0d, 0xbc, 0x3a, 0xee, 0x56, 0xf0, 0xc9,
+	0xc2, 0x3e, 0x73, 0x5c, 0x4f, 0xaf, 0x5e, 0x22, 0x43, 0xe1, 0x55, 0x04, 0xde, 0x43, 0xb8, 0x9e,
+	0x85, 0xc7, 0xe3, 0x2c, 0x37, 0x81, 0xfc, 0x5d, 0x03, 0x77, 0x67, 0xdc, 0x0a, 0x7e, 0xb0, 0xb0,
+	0x6f, 0x96, 0xfd, 0xe9, 0xcf, 0x2e, 0x9b, 0xa6, 0x98, 0x6b, 0x82, 0xf9, 0x31, 0x7c, 0x94, 0xc5,
+	0x8c, 0xd2, 0xd4, 0xe4, 0x7d, 0x13, 0xe0, 0x33, 0x36, 0x73, 0x01, 0x78, 0x96, 0x31, 0xea, 0xcf,
+	0x2e, 0x9b, 0xb6, 0x2c, 0x78, 0xf2, 0x79, 0x77, 0xcf, 0xed, 0xef, 0x44, 0x03, 0x85, 0x2c, 0x83,
+	0x81, 0xcf, 0x17, 0x82, 0x5c, 0x60, 0x60, 0xfa, 0xc7, 0x57, 0xcc, 0x56, 0xa7, 0xf9, 0x48, 0x9c,
+	0xe6, 0x7d, 0x58, 0xcb, 0x3a, 0xcd, 0x8c, 0xe7, 0xa5, 0x35, 0xea, 0x9f, 0x1d, 0x8d, 0x0c, 0xed,
+	0x78, 0x64, 0x68, 0xff, 0x8c, 0x0c, 0xed, 0xf0, 0xcc, 0xc8, 0x1d, 0x9f, 0x19, 0xb9, 0x3f, 0xcf,
+	0x8c, 0xdc, 0xf6, 0xf8, 0x77, 0x36, 0xae, 0x5b, 0xe9, 0xa3, 0x36, 0x93, 0x1d, 0xbe, 0x1d, 0xeb,
+	0x21, 0x8c, 0xbd, 0xbd, 0x2a, 0x7e, 0x06, 0x3d, 0xfd, 0x7f, 0x00, 0x2e, 0x98, 0x38, 0x17, 0xff,
+	0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -437,6 +631,12 @@ type QueryClient interface {
 	// AnnualizedRewards calculates and returns the current annualized reward percentages,
 	// like staking rewards, for the chain.
 	AnnualizedRewards(ctx context.Context, in *QueryAnnualizedRewardsRequest, opts ...grpc.CallOption) (*QueryAnnualizedRewardsResponse, error)
+	// TreasuryPositions queries the hard, cdp, and swap positions held by the x/community
+	// module account along with their current USD value.
+	TreasuryPositions(ctx context.Context, in *QueryTreasuryPositionsRequest, opts ...grpc.CallOption) (*QueryTreasuryPositionsResponse, error)
+	// StakingRewardsProjection projects how long the community pool can sustain the current
+	// staking rewards per second.
+	StakingRewardsProjection(ctx context.Context, in *QueryStakingRewardsProjectionRequest, opts ...grpc.CallOption) (*QueryStakingRewardsProjectionResponse, error)
 }
 
 type queryClient struct {
@@ -483,6 +683,24 @@ func (c *queryClient) AnnualizedRewards(ctx context.Context, in *QueryAnnualized
 	return out, nil
 }
 
+func (c *queryClient) TreasuryPositions(ctx context.Context, in *QueryTreasuryPositionsRequest, opts ...grpc.CallOption) (*QueryTreasuryPositionsResponse, error) {
+	out := new(QueryTreasuryPositionsResponse)
+	err := c.cc.Invoke(ctx, "/kava.community.v1beta1.Query/TreasuryPositions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StakingRewardsProjection(ctx context.Context, in *QueryStakingRewardsProjectionRequest, opts ...grpc.CallOption) (*QueryStakingRewardsProjectionResponse, error) {
+	out := new(QueryStakingRewardsProjectionResponse)
+	err := c.cc.Invoke(ctx, "/kava.community.v1beta1.Query/StakingRewardsProjection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queires the module params.
@@ -495,6 +713,12 @@ type QueryServer interface {
 	// AnnualizedRewards calculates and returns the current annualized reward percentages,
 	// like staking rewards, for the chain.
 	AnnualizedRewards(context.Context, *QueryAnnualizedRewardsRequest) (*QueryAnnualizedRewardsResponse, error)
+	// TreasuryPositions queries the hard, cdp, and swap positions held by the x/community
+	// module account along with their current USD value.
+	TreasuryPositions(context.Context, *QueryTreasuryPositionsRequest) (*QueryTreasuryPositionsResponse, error)
+	// StakingRewardsProjection projects how long the community pool can sustain the current
+	// staking rewards per second.
+	StakingRewardsProjection(context.Context, *QueryStakingRewardsProjectionRequest) (*QueryStakingRewardsProjectionResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -513,6 +737,12 @@ func (*UnimplementedQueryServer) TotalBalance(ctx context.Context, req *QueryTot
 func (*UnimplementedQueryServer) AnnualizedRewards(ctx context.Context, req *QueryAnnualizedRewardsRequest) (*QueryAnnualizedRewardsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AnnualizedRewards not implemented")
 }
+func (*UnimplementedQueryServer) TreasuryPositions(ctx context.Context, req *QueryTreasuryPositionsRequest) (*QueryTreasuryPositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TreasuryPositions not implemented")
+}
+func (*UnimplementedQueryServer) StakingRewardsProjection(ctx context.Context, req *QueryStakingRewardsProjectionRequest) (*QueryStakingRewardsProjectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StakingRewardsProjection not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -590,6 +820,42 @@ func _Query_AnnualizedRewards_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TreasuryPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTreasuryPositionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TreasuryPositions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.community.v1beta1.Query/TreasuryPositions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TreasuryPositions(ctx, req.(*QueryTreasuryPositionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StakingRewardsProjection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStakingRewardsProjectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StakingRewardsProjection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.community.v1beta1.Query/StakingRewardsProjection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StakingRewardsProjection(ctx, req.(*QueryStakingRewardsProjectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kava.community.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -610,6 +876,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AnnualizedRewards",
 			Handler:    _Query_AnnualizedRewards_Handler,
 		},
+		{
+			MethodName: "TreasuryPositions",
+			Handler:    _Query_TreasuryPositions_Handler,
+		},
+		{
+			MethodName: "StakingRewardsProjection",
+			Handler:    _Query_StakingRewardsProjection_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kava/community/v1beta1/query.proto",
@@ -847,112 +1121,575 @@ func (m *QueryAnnualizedRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryTreasuryPositionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryTreasuryPositionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTreasuryPositionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTreasuryPositionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTreasuryPositionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTreasuryPositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	{
+		size := m.NetUSDValue.Size()
+		i -= size
+		if _, err := m.NetUSDValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Positions) > 0 {
+		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBalanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryStakingRewardsProjectionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakingRewardsProjectionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakingRewardsProjectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBalanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryStakingRewardsProjectionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakingRewardsProjectionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakingRewardsProjectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.RunwaySeconds.Size()
+		i -= size
+		if _, err := m.RunwaySeconds.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.StakingRewardsPerSecond.Size()
+		i -= size
+		if _, err := m.StakingRewardsPerSecond.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTotalBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTotalBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pool) > 0 {
+		for _, e := range m.Pool {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAnnualizedRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAnnualizedRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.StakingRewards.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTreasuryPositionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTreasuryPositionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.NetUSDValue.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryStakingRewardsProjectionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryStakingRewardsProjectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.StakingRewardsPerSecond.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Balance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RunwaySeconds.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBalanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBalanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
-	}
-	return n
-}
-
-func (m *QueryTotalBalanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *QueryTotalBalanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Pool) > 0 {
-		for _, e := range m.Pool {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *QueryAnnualizedRewardsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryAnnualizedRewardsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.StakingRewards.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTotalBalanceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -975,10 +1712,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTotalBalanceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTotalBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1002,7 +1739,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTotalBalanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1025,15 +1762,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTotalBalanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTotalBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1060,7 +1797,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Pool = append(m.Pool, types.DecCoin{})
+			if err := m.Pool[len(m.Pool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1085,7 +1823,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAnnualizedRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1108,10 +1846,10 @@ func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBalanceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAnnualizedRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAnnualizedRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1135,7 +1873,7 @@ func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAnnualizedRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1158,17 +1896,17 @@ func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBalanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAnnualizedRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAnnualizedRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingRewards", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1178,23 +1916,23 @@ func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coins = append(m.Coins, types.Coin{})
-			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StakingRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1219,7 +1957,7 @@ func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalBalanceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTreasuryPositionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1242,10 +1980,10 @@ func (m *QueryTotalBalanceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalBalanceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTreasuryPositionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTreasuryPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1269,7 +2007,7 @@ func (m *QueryTotalBalanceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalBalanceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTreasuryPositionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1292,15 +2030,15 @@ func (m *QueryTotalBalanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalBalanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTreasuryPositionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTreasuryPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1327,8 +2065,42 @@ func (m *QueryTotalBalanceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pool = append(m.Pool, types.DecCoin{})
-			if err := m.Pool[len(m.Pool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Positions = append(m.Positions, TreasuryPosition{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetUSDValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetUSDValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1353,7 +2125,7 @@ func (m *QueryTotalBalanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAnnualizedRewardsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryStakingRewardsProjectionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1376,10 +2148,10 @@ func (m *QueryAnnualizedRewardsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAnnualizedRewardsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStakingRewardsProjectionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAnnualizedRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStakingRewardsProjectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1403,7 +2175,7 @@ func (m *QueryAnnualizedRewardsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAnnualizedRewardsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStakingRewardsProjectionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1426,15 +2198,15 @@ func (m *QueryAnnualizedRewardsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAnnualizedRewardsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStakingRewardsProjectionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAnnualizedRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStakingRewardsProjectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingRewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingRewardsPerSecond", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1462,7 +2234,74 @@ func (m *QueryAnnualizedRewardsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StakingRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StakingRewardsPerSecond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunwaySeconds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RunwaySeconds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,36 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewTreasuryPosition returns a new TreasuryPosition.
+func NewTreasuryPosition(positionType TreasuryPositionType, id string, amount sdk.Coins, usdValue sdk.Dec) TreasuryPosition {
+	return TreasuryPosition{
+		Type:     positionType,
+		ID:       id,
+		Amount:   amount,
+		USDValue: usdValue,
+	}
+}
+
+// IsDebt returns true if the position is owed by the community treasury rather than owned by it.
+func (t TreasuryPositionType) IsDebt() bool {
+	return t == TREASURY_POSITION_TYPE_HARD_BORROW || t == TREASURY_POSITION_TYPE_CDP_DEBT
+}
+
+// TreasuryPositions is a slice of TreasuryPosition
+type TreasuryPositions []TreasuryPosition
+
+// NetUSDValue returns the USD value of all asset positions minus the USD value of all debt positions.
+func (tps TreasuryPositions) NetUSDValue() sdk.Dec {
+	net := sdk.ZeroDec()
+	for _, tp := range tps {
+		if tp.Type.IsDebt() {
+			net = net.Sub(tp.USDValue)
+		} else {
+			net = net.Add(tp.USDValue)
+		}
+	}
+	return net
+}
@@ -0,0 +1,537 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: kava/community/v1beta1/treasury.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TreasuryPositionType is the kind of position held by the community treasury
+type TreasuryPositionType int32
+
+const (
+	// TREASURY_POSITION_TYPE_UNSPECIFIED represents an unspecified position type
+	TREASURY_POSITION_TYPE_UNSPECIFIED TreasuryPositionType = 0
+	// TREASURY_POSITION_TYPE_HARD_DEPOSIT represents coins supplied to x/hard
+	TREASURY_POSITION_TYPE_HARD_DEPOSIT TreasuryPositionType = 1
+	// TREASURY_POSITION_TYPE_HARD_BORROW represents coins borrowed from x/hard
+	TREASURY_POSITION_TYPE_HARD_BORROW TreasuryPositionType = 2
+	// TREASURY_POSITION_TYPE_CDP_COLLATERAL represents collateral locked in a x/cdp position
+	TREASURY_POSITION_TYPE_CDP_COLLATERAL TreasuryPositionType = 3
+	// TREASURY_POSITION_TYPE_CDP_DEBT represents the principal and fees owed on a x/cdp position
+	TREASURY_POSITION_TYPE_CDP_DEBT TreasuryPositionType = 4
+	// TREASURY_POSITION_TYPE_SWAP_LIQUIDITY represents the reserves owned through x/swap pool shares
+	TREASURY_POSITION_TYPE_SWAP_LIQUIDITY TreasuryPositionType = 5
+)
+
+var TreasuryPositionType_name = map[int32]string{
+	0: "TREASURY_POSITION_TYPE_UNSPECIFIED",
+	1: "TREASURY_POSITION_TYPE_HARD_DEPOSIT",
+	2: "TREASURY_POSITION_TYPE_HARD_BORROW",
+	3: "TREASURY_POSITION_TYPE_CDP_COLLATERAL",
+	4: "TREASURY_POSITION_TYPE_CDP_DEBT",
+	5: "TREASURY_POSITION_TYPE_SWAP_LIQUIDITY",
+}
+
+var TreasuryPositionType_value = map[string]int32{
+	"TREASURY_POSITION_TYPE_UNSPECIFIED":    0,
+	"TREASURY_POSITION_TYPE_HARD_DEPOSIT":   1,
+	"TREASURY_POSITION_TYPE_HARD_BORROW":    2,
+	"TREASURY_POSITION_TYPE_CDP_COLLATERAL": 3,
+	"TREASURY_POSITION_TYPE_CDP_DEBT":       4,
+	"TREASURY_POSITION_TYPE_SWAP_LIQUIDITY": 5,
+}
+
+func (x TreasuryPositionType) String() string {
+	return proto.EnumName(TreasuryPositionType_name, int32(x))
+}
+
+func (TreasuryPositionType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_fddb011da4848af8, []int{0}
+}
+
+// TreasuryPosition is a single hard, cdp, or swap position held by the community treasury
+type TreasuryPosition struct {
+	// type is the kind of position
+	Type TreasuryPositionType `protobuf:"varint,1,opt,name=type,proto3,enum=kava.community.v1beta1.TreasuryPositionType" json:"type,omitempty"`
+	// id identifies the position within its module: the cdp collateral type or the swap pool id.
+	// It is empty for hard positions.
+	ID string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// amount is the current value of the position in its underlying coins
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// usd_value is the value of amount using current pricefeed prices. Coins without
+	// a pricefeed market are not included.
+	USDValue github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=usd_value,json=usdValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"usd_value"`
+}
+
+func (m *TreasuryPosition) Reset()         { *m = TreasuryPosition{} }
+func (m *TreasuryPosition) String() string { return proto.CompactTextString(m) }
+func (*TreasuryPosition) ProtoMessage()    {}
+func (*TreasuryPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fddb011da4848af8, []int{0}
+}
+func (m *TreasuryPosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TreasuryPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TreasuryPosition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TreasuryPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TreasuryPosition.Merge(m, src)
+}
+func (m *TreasuryPosition) XXX_Size() int {
+	return m.Size()
+}
+func (m *TreasuryPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_TreasuryPosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TreasuryPosition proto.InternalMessageInfo
+
+func (m *TreasuryPosition) GetType() TreasuryPositionType {
+	if m != nil {
+		return m.Type
+	}
+	return TREASURY_POSITION_TYPE_UNSPECIFIED
+}
+
+func (m *TreasuryPosition) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *TreasuryPosition) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("kava.community.v1beta1.TreasuryPositionType", TreasuryPositionType_name, TreasuryPositionType_value)
+	proto.RegisterType((*TreasuryPosition)(nil), "kava.community.v1beta1.TreasuryPosition")
+}
+
+func init() {
+	proto.RegisterFile("kava/community/v1beta1/treasury.proto", fileDescriptor_fddb011da4848af8)
+}
+
+var fileDescriptor_fddb011da4848af8 = []byte{
+	// 489 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x4d, 0x6e, 0x9b, 0x40,
+	0x18, 0x05, 0xe2, 0x5a, 0xc9, 0x54, 0xaa, 0x10, 0x8a, 0x22, 0xc7, 0x0b, 0xb0, 0x12, 0x25, 0x75,
+	0x7f, 0x0c, 0x4d, 0x7a, 0x81, 0x82, 0x87, 0x2a, 0x48, 0x56, 0xa0, 0x03, 0x6e, 0xe4, 0x6e, 0x10,
+	0x3f, 0x23, 0x17, 0x25, 0x66, 0x2c, 0x0f, 0x58, 0xf5, 0x0d, 0xba, 0xcc, 0x1d, 0xba, 0xeb, 0xba,
+	0x8b, 0x1e, 0x21, 0xcb, 0xa8, 0xab, 0xaa, 0x0b, 0xb7, 0xc2, 0x17, 0xa9, 0x18, 0x90, 0x15, 0x55,
+	0x89, 0xd5, 0x15, 0x33, 0x6f, 0xde, 0x7b, 0xdf, 0x9b, 0x8f, 0x6f, 0xc0, 0xd1, 0x65, 0x30, 0x0f,
+	0xb4, 0x88, 0x4c, 0x26, 0x79, 0x9a, 0x64, 0x0b, 0x6d, 0x7e, 0x12, 0xe2, 0x2c, 0x38, 0xd1, 0xb2,
+	0x19, 0x0e, 0x68, 0x3e, 0x5b, 0xa8, 0xd3, 0x19, 0xc9, 0x88, 0xb4, 0x57, 0xd2, 0xd4, 0x35, 0x4d,
+	0xad, 0x69, 0x6d, 0x39, 0x22, 0x74, 0x42, 0xa8, 0x16, 0x06, 0x14, 0xaf, 0xb5, 0x11, 0x49, 0xd2,
+	0x4a, 0xd7, 0xde, 0xaf, 0xce, 0x7d, 0xb6, 0xd3, 0xaa, 0x4d, 0x7d, 0xb4, 0x3b, 0x26, 0x63, 0x52,
+	0xe1, 0xe5, 0xaa, 0x42, 0x0f, 0xbe, 0x0b, 0x40, 0xf4, 0xea, 0xda, 0x0e, 0xa1, 0x49, 0x96, 0x90,
+	0x54, 0x7a, 0x03, 0x1a, 0xd9, 0x62, 0x8a, 0x5b, 0x7c, 0x87, 0xef, 0x3e, 0x39, 0x7d, 0xa9, 0xde,
+	0x1f, 0x46, 0xfd, 0x57, 0xe7, 0x2d, 0xa6, 0x18, 0x31, 0xa5, 0xb4, 0x07, 0x84, 0x24, 0x6e, 0x09,
+	0x1d, 0xbe, 0xbb, 0x63, 0x34, 0x8b, 0xa5, 0x22, 0x58, 0x10, 0x09, 0x49, 0x2c, 0x45, 0xa0, 0x19,
+	0x4c, 0x48, 0x9e, 0x66, 0xad, 0xad, 0xce, 0x56, 0xf7, 0xf1, 0xe9, 0xbe, 0x5a, 0x67, 0x2c, 0x2f,
+	0xb4, 0x36, 0xee, 0x93, 0x24, 0x35, 0x5e, 0xdd, 0x2c, 0x15, 0xee, 0xeb, 0x6f, 0xa5, 0x3b, 0x4e,
+	0xb2, 0x8f, 0x79, 0x58, 0xd6, 0xaf, 0x2f, 0x54, 0x7f, 0x7a, 0x34, 0xbe, 0xd4, 0xca, 0x6a, 0x94,
+	0x09, 0x28, 0xaa, 0xad, 0x25, 0x0c, 0x76, 0x72, 0x1a, 0xfb, 0xf3, 0xe0, 0x2a, 0xc7, 0xad, 0x06,
+	0xcb, 0x70, 0x56, 0x9a, 0xfd, 0x5a, 0x2a, 0xc7, 0xff, 0x61, 0x06, 0x71, 0x54, 0x2c, 0x95, 0xed,
+	0xa1, 0x0b, 0xdf, 0x97, 0x0e, 0x3f, 0xbe, 0xf5, 0x40, 0x1d, 0x12, 0xe2, 0x08, 0x6d, 0xe7, 0x34,
+	0x66, 0xf8, 0xf3, 0x6b, 0x01, 0xec, 0xde, 0xd7, 0x02, 0xe9, 0x18, 0x1c, 0x78, 0xc8, 0xd4, 0xdd,
+	0x21, 0x1a, 0xf9, 0x8e, 0xed, 0x5a, 0x9e, 0x65, 0x9f, 0xfb, 0xde, 0xc8, 0x31, 0xfd, 0xe1, 0xb9,
+	0xeb, 0x98, 0x7d, 0xeb, 0xad, 0x65, 0x42, 0x91, 0x93, 0x9e, 0x82, 0xc3, 0x07, 0x78, 0x67, 0x3a,
+	0x82, 0x3e, 0x34, 0x19, 0x28, 0xf2, 0x1b, 0x0c, 0x19, 0xd1, 0xb0, 0x11, 0xb2, 0x2f, 0x44, 0x41,
+	0x7a, 0x06, 0x8e, 0x1e, 0xe0, 0xf5, 0xa1, 0xe3, 0xf7, 0xed, 0xc1, 0x40, 0xf7, 0x4c, 0xa4, 0x0f,
+	0xc4, 0x2d, 0xe9, 0x10, 0x28, 0x1b, 0xa8, 0xd0, 0x34, 0x3c, 0xb1, 0xb1, 0xc1, 0xcf, 0xbd, 0xd0,
+	0x1d, 0x7f, 0x60, 0xbd, 0x1b, 0x5a, 0xd0, 0xf2, 0x46, 0xe2, 0xa3, 0x76, 0xe3, 0xf3, 0x17, 0x99,
+	0x33, 0xcc, 0x9b, 0x42, 0xe6, 0x6f, 0x0b, 0x99, 0xff, 0x53, 0xc8, 0xfc, 0xf5, 0x4a, 0xe6, 0x6e,
+	0x57, 0x32, 0xf7, 0x73, 0x25, 0x73, 0x1f, 0x5e, 0xdc, 0x69, 0x7c, 0x39, 0x4e, 0xbd, 0xab, 0x20,
+	0xa4, 0x6c, 0xa5, 0x7d, 0xba, 0xf3, 0x1c, 0xd8, 0x1f, 0x08, 0x9b, 0x6c, 0x36, 0x5f, 0xff, 0x1d,
+	0x00, 0xaf, 0xf2, 0x9c, 0xc3, 0x2d, 0x03, 0x00, 0x00,
+}
+
+func (m *TreasuryPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TreasuryPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TreasuryPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.USDValue.Size()
+		i -= size
+		if _, err := m.USDValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTreasury(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTreasury(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintTreasury(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintTreasury(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTreasury(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTreasury(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TreasuryPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovTreasury(uint64(m.Type))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovTreasury(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTreasury(uint64(l))
+		}
+	}
+	l = m.USDValue.Size()
+	n += 1 + l + sovTreasury(uint64(l))
+	return n
+}
+
+func sovTreasury(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTreasury(x uint64) (n int) {
+	return sovTreasury(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TreasuryPosition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTreasury
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TreasuryPosition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TreasuryPosition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= TreasuryPositionType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field USDValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.USDValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTreasury(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTreasury(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTreasury
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTreasury
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTreasury
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTreasury
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTreasury        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTreasury          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTreasury = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,49 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kava-labs/kava/x/community/types"
+)
+
+func TestTreasuryPositions_NetUSDValue(t *testing.T) {
+	testCases := []struct {
+		name      string
+		positions types.TreasuryPositions
+		expected  sdk.Dec
+	}{
+		{
+			name:      "no positions",
+			positions: types.TreasuryPositions{},
+			expected:  sdk.ZeroDec(),
+		},
+		{
+			name: "assets only",
+			positions: types.TreasuryPositions{
+				types.NewTreasuryPosition(types.TREASURY_POSITION_TYPE_HARD_DEPOSIT, "", nil, sdk.NewDec(10)),
+				types.NewTreasuryPosition(types.TREASURY_POSITION_TYPE_CDP_COLLATERAL, "kava-a", nil, sdk.NewDec(20)),
+				types.NewTreasuryPosition(types.TREASURY_POSITION_TYPE_SWAP_LIQUIDITY, "ukava:usdx", nil, sdk.NewDec(30)),
+			},
+			expected: sdk.NewDec(60),
+		},
+		{
+			name: "debts are subtracted",
+			positions: types.TreasuryPositions{
+				types.NewTreasuryPosition(types.TREASURY_POSITION_TYPE_HARD_DEPOSIT, "", nil, sdk.NewDec(10)),
+				types.NewTreasuryPosition(types.TREASURY_POSITION_TYPE_HARD_BORROW, "", nil, sdk.NewDec(4)),
+				types.NewTreasuryPosition(types.TREASURY_POSITION_TYPE_CDP_COLLATERAL, "kava-a", nil, sdk.NewDec(20)),
+				types.NewTreasuryPosition(types.TREASURY_POSITION_TYPE_CDP_DEBT, "kava-a", nil, sdk.NewDec(30)),
+			},
+			expected: sdk.NewDec(-4),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.positions.NetUSDValue())
+		})
+	}
+}
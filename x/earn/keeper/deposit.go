@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/kava-labs/kava/x/earn/types"
@@ -26,6 +27,10 @@ func (k *Keeper) Deposit(
 		return types.ErrInsufficientAmount
 	}
 
+	if !k.liquidKeeper.IsDerivativeCollateralEnabled(ctx, amount.Denom) {
+		return errorsmod.Wrapf(types.ErrInvalidVaultDenom, "%s is disabled as collateral", amount.Denom)
+	}
+
 	// Check if deposit strategy is supported by vault
 	if !allowedVault.IsStrategyAllowed(depositStrategy) {
 		return types.ErrInvalidVaultStrategy
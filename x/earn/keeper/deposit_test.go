@@ -4,8 +4,10 @@ import (
 	"os"
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/staking"
 
 	"github.com/kava-labs/kava/app"
 	"github.com/kava-labs/kava/x/earn/testutil"
@@ -191,3 +193,31 @@ func (suite *depositTestSuite) TestDeposit_bKava() {
 		"should be able to deposit bkava derivative denom in bkava vault",
 	)
 }
+
+func (suite *depositTestSuite) TestDeposit_bKava_TombstonedValidator() {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	valAccAddr := addrs[0]
+	valAddr := sdk.ValAddress(valAccAddr)
+	derivativeDenom := suite.App.GetLiquidKeeper().GetLiquidStakingTokenDenom(valAddr)
+
+	suite.App.FundAccount(suite.Ctx, valAccAddr, sdk.NewCoins(suite.NewBondCoin(sdkmath.NewInt(1e9))))
+	suite.CreateNewUnbondedValidator(valAddr, sdkmath.NewInt(1e9))
+	staking.EndBlocker(suite.Ctx, suite.App.GetStakingKeeper())
+
+	acc1 := suite.CreateAccount(sdk.NewCoins(sdk.NewInt64Coin(derivativeDenom, 1000)), 0)
+	suite.CreateVault("bkava", types.StrategyTypes{types.STRATEGY_TYPE_SAVINGS}, false, []sdk.AccAddress{})
+
+	validator, found := suite.App.GetStakingKeeper().GetValidator(suite.Ctx, valAddr)
+	suite.Require().True(found)
+	consAddr, err := validator.GetConsAddr()
+	suite.Require().NoError(err)
+	suite.App.GetSlashingKeeper().Tombstone(suite.Ctx, consAddr)
+
+	liquidKeeper := suite.App.GetLiquidKeeper()
+	liquidParams := liquidKeeper.GetParams(suite.Ctx)
+	liquidParams.DisableTombstonedCollateral = true
+	liquidKeeper.SetParams(suite.Ctx, liquidParams)
+
+	err = suite.Keeper.Deposit(suite.Ctx, acc1.GetAddress(), sdk.NewInt64Coin(derivativeDenom, 100), types.STRATEGY_TYPE_SAVINGS)
+	suite.Require().ErrorIs(err, types.ErrInvalidVaultDenom)
+}
@@ -236,9 +236,6 @@ func (suite *grpcQueryTestSuite) TestDeposits() {
 		sdk.NewCoin("ukava", initialUkavaBalance),
 		sdk.NewInt64Coin(vault1Denom, 1000),
 		sdk.NewInt64Coin(vault2Denom, 1000),
-		// Bkava isn't actually minted via x/liquid
-		sdk.NewInt64Coin(vault3Denom, 1000),
-		sdk.NewInt64Coin(vault4Denom, 1000),
 	)
 
 	delegateAmount := sdkmath.NewInt(100e6)
@@ -254,6 +251,11 @@ func (suite *grpcQueryTestSuite) TestDeposits() {
 
 	staking.EndBlocker(suite.Ctx, suite.App.GetStakingKeeper())
 
+	_, err := suite.App.GetLiquidKeeper().MintDerivative(suite.Ctx, delegator, valAddr1, suite.NewBondCoin(sdkmath.NewInt(1000)))
+	suite.Require().NoError(err)
+	_, err = suite.App.GetLiquidKeeper().MintDerivative(suite.Ctx, delegator, valAddr2, suite.NewBondCoin(sdkmath.NewInt(1000)))
+	suite.Require().NoError(err)
+
 	savingsParams := suite.SavingsKeeper.GetParams(suite.Ctx)
 	savingsParams.SupportedDenoms = append(savingsParams.SupportedDenoms, "bkava")
 	suite.SavingsKeeper.SetParams(suite.Ctx, savingsParams)
@@ -275,7 +277,7 @@ func (suite *grpcQueryTestSuite) TestDeposits() {
 	// Deposit into each vault from each account - 4 total deposits
 	// Acc 1: usdx + busd
 	// Acc 2: usdx + bkava-1 + bkava-2
-	err := suite.Keeper.Deposit(suite.Ctx, acc1, deposit1Amount, types.STRATEGY_TYPE_HARD)
+	err = suite.Keeper.Deposit(suite.Ctx, acc1, deposit1Amount, types.STRATEGY_TYPE_HARD)
 	suite.Require().NoError(err)
 	err = suite.Keeper.Deposit(suite.Ctx, acc1, deposit2Amount, types.STRATEGY_TYPE_HARD)
 	suite.Require().NoError(err)
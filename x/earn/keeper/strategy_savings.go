@@ -19,7 +19,7 @@ func (s *SavingsStrategy) GetStrategyType() types.StrategyType {
 // in savings.
 func (s *SavingsStrategy) GetEstimatedTotalAssets(ctx sdk.Context, denom string) (sdk.Coin, error) {
 	macc := s.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
-	deposit, found := s.savingsKeeper.GetDeposit(ctx, macc.GetAddress())
+	deposit, found := s.savingsKeeper.GetSyncedDeposit(ctx, macc.GetAddress())
 	if !found {
 		// Return 0 if no deposit exists for module account
 		return sdk.NewCoin(denom, sdk.ZeroInt()), nil
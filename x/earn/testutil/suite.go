@@ -162,8 +162,12 @@ func (suite *Suite) SetupTest() {
 				TestBkavaDenoms[1],
 				TestBkavaDenoms[2],
 			},
+			nil,
+			nil,
 		),
 		nil,
+		nil,
+		savingstypes.DefaultNextLockupID,
 	)
 
 	stakingParams := stakingtypes.DefaultParams()
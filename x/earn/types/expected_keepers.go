@@ -38,6 +38,7 @@ type DistributionKeeper interface {
 type LiquidKeeper interface {
 	GetStakedTokensForDerivatives(ctx sdk.Context, derivatives sdk.Coins) (sdk.Coin, error)
 	IsDerivativeDenom(ctx sdk.Context, denom string) bool
+	IsDerivativeCollateralEnabled(ctx sdk.Context, denom string) bool
 }
 
 // HardKeeper defines the expected interface needed for the hard strategy.
@@ -53,7 +54,7 @@ type SavingsKeeper interface {
 	Deposit(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coins) error
 	Withdraw(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coins) error
 
-	GetDeposit(ctx sdk.Context, depositor sdk.AccAddress) (savingstypes.Deposit, bool)
+	GetSyncedDeposit(ctx sdk.Context, depositor sdk.AccAddress) (savingstypes.Deposit, bool)
 }
 
 // EarnHooks are event hooks called when a user's deposit to a earn vault changes.
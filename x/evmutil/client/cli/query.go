@@ -26,6 +26,8 @@ func GetQueryCmd() *cobra.Command {
 	cmds := []*cobra.Command{
 		QueryParamsCmd(),
 		QueryDeployedCosmosCoinContractsCmd(),
+		QueryRegisteredConversionPairsCmd(),
+		QueryConversionUtilizationCmd(),
 	}
 
 	for _, cmd := range cmds {
@@ -103,3 +105,71 @@ func QueryDeployedCosmosCoinContractsCmd() *cobra.Command {
 
 	return cmd
 }
+
+// QueryRegisteredConversionPairsCmd queries the permissionlessly registered ERC20 conversion pairs
+func QueryRegisteredConversionPairsCmd() *cobra.Command {
+	cmdName := "registered-conversion-pairs"
+	cmd := &cobra.Command{
+		Use:   cmdName,
+		Short: "Query for ERC20 conversion pairs registered without a governance proposal",
+		Example: fmt.Sprintf(
+			"%[1]s q %[2]s %[3]s",
+			version.AppName, types.ModuleName, cmdName,
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			page, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.RegisteredConversionPairs(context.Background(), &types.QueryRegisteredConversionPairsRequest{
+				Pagination: page,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmdName)
+
+	return cmd
+}
+
+// QueryConversionUtilizationCmd queries the conversion rate limit of a denom and its utilization
+func QueryConversionUtilizationCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "conversion-utilization [denom]",
+		Short: "Query the conversion rate limit of a denom and its utilization in each direction",
+		Example: fmt.Sprintf(
+			"%[1]s q %[2]s conversion-utilization erc20/usdc",
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ConversionUtilization(context.Background(), &types.QueryConversionUtilizationRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 
@@ -13,6 +14,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
 
 	"github.com/kava-labs/kava/x/evmutil/types"
 )
@@ -32,6 +35,8 @@ func GetTxCmd() *cobra.Command {
 		getCmdConvertEvmERC20ToCoin(),
 		getCmdMsgConvertCosmosCoinToERC20(),
 		getCmdMsgConvertCosmosCoinFromERC20(),
+		getCmdMsgRegisterERC20ConversionPair(),
+		getCmdMsgIBCTransferERC20(),
 	}
 
 	for _, cmd := range cmds {
@@ -199,3 +204,120 @@ func getCmdMsgConvertCosmosCoinFromERC20() *cobra.Command {
 		},
 	}
 }
+
+func getCmdMsgRegisterERC20ConversionPair() *cobra.Command {
+	return &cobra.Command{
+		Use:   "register-erc20-conversion-pair [Kava ERC20 address] [flags]",
+		Short: "EVM-native asset: registers an ERC20 to be converted to and from an erc20/0x... coin on Cosmos co-chain",
+		Long: `Registers an EVM-native ERC20 as a conversion pair without a governance proposal.
+If the signer is not the owner of the ERC20 contract, the erc20_registration_deposit param is burned from the signer.`,
+		Example: fmt.Sprintf(
+			`%s tx %s register-erc20-conversion-pair 0xeA7100edA2f805356291B0E55DaD448599a72C6d --from <key> --gas 2000000`,
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractAddr, err := types.NewInternalEVMAddressFromString(args[0])
+			if err != nil {
+				return fmt.Errorf("contractAddr '%s' is not a hex address", args[0])
+			}
+
+			signer := clientCtx.GetFromAddress()
+			msg := types.NewMsgRegisterERC20ConversionPair(signer.String(), contractAddr)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+const (
+	flagPacketTimeoutHeight    = "packet-timeout-height"
+	flagPacketTimeoutTimestamp = "packet-timeout-timestamp"
+	flagMemo                   = "memo"
+)
+
+func getCmdMsgIBCTransferERC20() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ibc-transfer-erc20 [src-channel] [receiver] [Kava ERC20 address] [amount] [flags]",
+		Short: "EVM-native asset: converts an ERC20 on EVM co-chain to a coin and sends it over IBC",
+		Long: `Converts an EVM-native ERC20 of an enabled conversion pair to its sdk.Coin and sends it with an ICS-20 transfer.
+If the transfer fails or times out, the refunded coin is converted back to the ERC20 of the signer.
+Timeout timestamps are relative to the local time, timeout heights are absolute.`,
+		Example: fmt.Sprintf(
+			`%s tx %s ibc-transfer-erc20 channel-0 cosmos1q0dkky0505r555etn6u2nz4h4kjcg5y8hpfejr 0xeA7100edA2f805356291B0E55DaD448599a72C6d 1000000000000000 --from <key> --gas 2000000`,
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractAddr, err := types.NewInternalEVMAddressFromString(args[2])
+			if err != nil {
+				return fmt.Errorf("contractAddr '%s' is not a hex address", args[2])
+			}
+
+			amount, ok := sdkmath.NewIntFromString(args[3])
+			if !ok {
+				return fmt.Errorf("amount '%s' is invalid", args[3])
+			}
+
+			timeoutHeightStr, err := cmd.Flags().GetString(flagPacketTimeoutHeight)
+			if err != nil {
+				return err
+			}
+			timeoutHeight, err := clienttypes.ParseHeight(timeoutHeightStr)
+			if err != nil {
+				return err
+			}
+
+			timeoutTimestamp, err := cmd.Flags().GetUint64(flagPacketTimeoutTimestamp)
+			if err != nil {
+				return err
+			}
+			if timeoutTimestamp != 0 {
+				timeoutTimestamp += uint64(time.Now().UnixNano())
+			}
+
+			memo, err := cmd.Flags().GetString(flagMemo)
+			if err != nil {
+				return err
+			}
+
+			signer := clientCtx.GetFromAddress()
+			initiator := types.BytesToInternalEVMAddress(signer.Bytes())
+			msg := types.NewMsgIBCTransferERC20(
+				initiator,
+				contractAddr,
+				amount,
+				transfertypes.PortID,
+				args[0],
+				args[1],
+				timeoutHeight,
+				timeoutTimestamp,
+				memo,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(flagPacketTimeoutHeight, "0-0", "Absolute timeout block height. The timeout is disabled when set to 0-0.")
+	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, transfertypes.DefaultRelativePacketTimeoutTimestamp, "Timeout timestamp in nanoseconds relative to the local time. The timeout is disabled when set to 0.")
+	cmd.Flags().String(flagMemo, "", "Memo to be sent along with the packet.")
+
+	return cmd
+}
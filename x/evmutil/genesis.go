@@ -25,10 +25,22 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, gs *types.GenesisState,
 	for _, account := range gs.Accounts {
 		keeper.SetAccount(ctx, account)
 	}
+
+	for _, pair := range gs.RegisteredConversionPairs {
+		if err := keeper.SetRegisteredConversionPair(ctx, pair); err != nil {
+			panic(fmt.Sprintf("failed to set registered conversion pair: %s", err))
+		}
+	}
+
+	for _, paused := range gs.PausedConversionDirections {
+		keeper.SetConversionDirectionPaused(ctx, paused.Denom, paused.Direction, true)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 	accounts := keeper.GetAllAccounts(ctx)
-	return types.NewGenesisState(accounts, keeper.GetParams(ctx))
+	gs := types.NewGenesisState(accounts, keeper.GetParams(ctx), keeper.GetAllRegisteredConversionPairs(ctx))
+	gs.PausedConversionDirections = keeper.GetPausedConversionDirections(ctx)
+	return gs
 }
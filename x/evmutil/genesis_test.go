@@ -2,6 +2,7 @@ package evmutil_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -26,6 +27,7 @@ func (s *genesisTestSuite) TestInitGenesis_SetAccounts() {
 			{Address: s.Addrs[0], Balance: sdkmath.NewInt(100)},
 		},
 		types.DefaultParams(),
+		types.ConversionPairs{},
 	)
 	accounts := s.Keeper.GetAllAccounts(s.Ctx)
 	s.Require().Len(accounts, 0)
@@ -47,6 +49,7 @@ func (s *genesisTestSuite) TestInitGenesis_SetParams() {
 	gs := types.NewGenesisState(
 		[]types.Account{},
 		params,
+		types.ConversionPairs{},
 	)
 	evmutil.InitGenesis(s.Ctx, s.Keeper, gs, s.AccountKeeper)
 	params = s.Keeper.GetParams(s.Ctx)
@@ -60,6 +63,7 @@ func (s *genesisTestSuite) TestInitGenesis_ValidateFail() {
 			{Address: s.Addrs[0], Balance: sdkmath.NewInt(-100)},
 		},
 		types.DefaultParams(),
+		types.ConversionPairs{},
 	)
 	s.Require().Panics(func() {
 		evmutil.InitGenesis(s.Ctx, s.Keeper, gs, s.AccountKeeper)
@@ -70,6 +74,7 @@ func (s *genesisTestSuite) TestInitGenesis_ModuleAccount() {
 	gs := types.NewGenesisState(
 		[]types.Account{},
 		types.DefaultParams(),
+		types.ConversionPairs{},
 	)
 	s.Require().NotPanics(func() {
 		evmutil.InitGenesis(s.Ctx, s.Keeper, gs, s.AccountKeeper)
@@ -103,10 +108,41 @@ func (s *genesisTestSuite) TestExportGenesis() {
 			Decimals:    6,
 		},
 	}
+	params.ConversionRateLimits = types.NewConversionRateLimits(
+		types.NewConversionRateLimit("weth", true, sdkmath.NewInt(1e18), 24*time.Hour),
+	)
 	s.Keeper.SetParams(s.Ctx, params)
+	s.Keeper.SetConversionDirectionPaused(s.Ctx, "weth", types.CONVERSION_DIRECTION_FROM_ERC20, true)
+	registeredPair := types.NewRegisteredConversionPair(
+		testutil.MustNewInternalEVMAddressFromString("0x6B175474E89094C44Da98b954EedeAC495271d0F"),
+		18,
+	)
+	s.Require().NoError(s.Keeper.SetRegisteredConversionPair(s.Ctx, registeredPair))
 	gs := evmutil.ExportGenesis(s.Ctx, s.Keeper)
 	s.Require().Equal(gs.Accounts, accounts)
 	s.Require().Equal(params, gs.Params)
+	s.Require().Equal(types.ConversionPairs{registeredPair}, gs.RegisteredConversionPairs)
+	s.Require().Equal(
+		[]types.PausedConversionDirection{types.NewPausedConversionDirection("weth", types.CONVERSION_DIRECTION_FROM_ERC20)},
+		gs.PausedConversionDirections,
+	)
+}
+
+func (s *genesisTestSuite) TestInitGenesis_SetRegisteredConversionPairs() {
+	registeredPair := types.NewRegisteredConversionPair(
+		testutil.MustNewInternalEVMAddressFromString("0x6B175474E89094C44Da98b954EedeAC495271d0F"),
+		18,
+	)
+	gs := types.NewGenesisState(
+		[]types.Account{},
+		types.DefaultParams(),
+		types.ConversionPairs{registeredPair},
+	)
+	evmutil.InitGenesis(s.Ctx, s.Keeper, gs, s.AccountKeeper)
+
+	pair, err := s.Keeper.GetEnabledConversionPairFromDenom(s.Ctx, registeredPair.Denom)
+	s.Require().NoError(err)
+	s.Require().Equal(registeredPair, pair)
 }
 
 func TestGenesisTestSuite(t *testing.T) {
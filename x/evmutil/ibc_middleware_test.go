@@ -0,0 +1,227 @@
+package evmutil_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/kava-labs/kava/x/evmutil"
+	"github.com/kava-labs/kava/x/evmutil/testutil"
+	"github.com/kava-labs/kava/x/evmutil/types"
+)
+
+// mockIBCModule stands in for the transfer application, which has already
+// refunded the sender when the middleware callbacks run.
+type mockIBCModule struct {
+	porttypes.IBCModule
+	err    error
+	onRecv func(ctx sdk.Context) ibcexported.Acknowledgement
+}
+
+func (m mockIBCModule) OnRecvPacket(ctx sdk.Context, _ channeltypes.Packet, _ sdk.AccAddress) ibcexported.Acknowledgement {
+	return m.onRecv(ctx)
+}
+
+func (m mockIBCModule) OnAcknowledgementPacket(sdk.Context, channeltypes.Packet, []byte, sdk.AccAddress) error {
+	return m.err
+}
+
+func (m mockIBCModule) OnTimeoutPacket(sdk.Context, channeltypes.Packet, sdk.AccAddress) error {
+	return m.err
+}
+
+type ibcMiddlewareTestSuite struct {
+	testutil.Suite
+
+	contractAddr types.InternalEVMAddress
+	packet       channeltypes.Packet
+}
+
+func TestIBCMiddlewareTestSuite(t *testing.T) {
+	suite.Run(t, new(ibcMiddlewareTestSuite))
+}
+
+func (suite *ibcMiddlewareTestSuite) SetupTest() {
+	suite.Suite.SetupTest()
+
+	// first deployed contract is enabled via params
+	suite.contractAddr = suite.DeployERC20()
+	err := suite.Keeper.MintERC20(suite.Ctx, suite.contractAddr, suite.Key1Addr, big.NewInt(1e6))
+	suite.Require().NoError(err)
+
+	// convert to the sender account, as if the transfer had been sent and refunded
+	sender := sdk.AccAddress(suite.Key1Addr.Bytes())
+	err = suite.Keeper.ConvertERC20ToCoin(suite.Ctx, suite.Key1Addr, sender, suite.contractAddr, sdkmath.NewInt(1e6))
+	suite.Require().NoError(err)
+
+	suite.packet = channeltypes.Packet{Sequence: 1, SourcePort: transfertypes.PortID, SourceChannel: "channel-0"}
+	suite.Keeper.SetERC20IBCTransfer(suite.Ctx, transfertypes.PortID, "channel-0", 1, types.ERC20IBCTransfer{
+		Initiator: suite.Key1Addr.Bytes(),
+		Amount:    sdk.NewInt64Coin("erc20/usdc", 1e6),
+	})
+}
+
+func (suite *ibcMiddlewareTestSuite) erc20Balance() *big.Int {
+	return suite.GetERC20BalanceOf(types.ERC20MintableBurnableContract.ABI, suite.contractAddr, suite.Key1Addr)
+}
+
+func (suite *ibcMiddlewareTestSuite) transferFound() bool {
+	_, found := suite.Keeper.GetERC20IBCTransfer(suite.Ctx, transfertypes.PortID, "channel-0", 1)
+	return found
+}
+
+func (suite *ibcMiddlewareTestSuite) TestOnAcknowledgementPacket_Success() {
+	im := evmutil.NewIBCMiddleware(mockIBCModule{}, suite.Keeper)
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+
+	err := im.OnAcknowledgementPacket(suite.Ctx, suite.packet, ack.Acknowledgement(), nil)
+	suite.Require().NoError(err)
+
+	suite.Require().False(suite.transferFound())
+	suite.BigIntsEqual(big.NewInt(0), suite.erc20Balance(), "successful transfers should not be converted")
+}
+
+func (suite *ibcMiddlewareTestSuite) TestOnAcknowledgementPacket_Error() {
+	im := evmutil.NewIBCMiddleware(mockIBCModule{}, suite.Keeper)
+	ack := channeltypes.NewErrorAcknowledgement(errors.New("failed"))
+
+	err := im.OnAcknowledgementPacket(suite.Ctx, suite.packet, ack.Acknowledgement(), nil)
+	suite.Require().NoError(err)
+
+	suite.Require().False(suite.transferFound())
+	suite.BigIntsEqual(big.NewInt(1e6), suite.erc20Balance(), "refund should be converted to erc20")
+}
+
+func (suite *ibcMiddlewareTestSuite) TestOnAcknowledgementPacket_AppError() {
+	im := evmutil.NewIBCMiddleware(mockIBCModule{err: errors.New("app error")}, suite.Keeper)
+	ack := channeltypes.NewErrorAcknowledgement(errors.New("failed"))
+
+	err := im.OnAcknowledgementPacket(suite.Ctx, suite.packet, ack.Acknowledgement(), nil)
+	suite.Require().ErrorContains(err, "app error")
+
+	suite.Require().True(suite.transferFound())
+	suite.BigIntsEqual(big.NewInt(0), suite.erc20Balance(), "refund should not be converted")
+}
+
+func (suite *ibcMiddlewareTestSuite) TestOnTimeoutPacket() {
+	im := evmutil.NewIBCMiddleware(mockIBCModule{}, suite.Keeper)
+
+	err := im.OnTimeoutPacket(suite.Ctx, suite.packet, nil)
+	suite.Require().NoError(err)
+
+	suite.Require().False(suite.transferFound())
+	suite.BigIntsEqual(big.NewInt(1e6), suite.erc20Balance(), "refund should be converted to erc20")
+}
+
+// recvPacket returns a packet sent from channel-0 of the counterparty to
+// channel-1 of this chain.
+func recvPacket(denom string, receiver sdk.AccAddress, memo string) channeltypes.Packet {
+	data := transfertypes.NewFungibleTokenPacketData(denom, "1000000", "cosmos1sender", receiver.String(), memo)
+	return channeltypes.Packet{
+		Sequence:           1,
+		SourcePort:         transfertypes.PortID,
+		SourceChannel:      "channel-0",
+		DestinationPort:    transfertypes.PortID,
+		DestinationChannel: "channel-1",
+		Data:               data.GetBytes(),
+	}
+}
+
+// receiveCoins returns a mock transfer application that credits the receiver.
+func (suite *ibcMiddlewareTestSuite) receiveCoins(receiver sdk.AccAddress, coin sdk.Coin) mockIBCModule {
+	return mockIBCModule{onRecv: func(ctx sdk.Context) ibcexported.Acknowledgement {
+		suite.Require().NoError(suite.App.FundAccount(ctx, receiver, sdk.NewCoins(coin)))
+		return channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	}}
+}
+
+func (suite *ibcMiddlewareTestSuite) TestOnRecvPacket_NoMemo() {
+	receiver := suite.Addrs[0]
+	coin := sdk.NewInt64Coin("erc20/usdc", 1e6)
+	im := evmutil.NewIBCMiddleware(suite.receiveCoins(receiver, coin), suite.Keeper)
+
+	ack := im.OnRecvPacket(suite.Ctx, recvPacket("transfer/channel-0/erc20/usdc", receiver, `{"forward":{}}`), nil)
+	suite.Require().True(ack.Success())
+
+	suite.Require().Equal(coin, suite.BankKeeper.GetBalance(suite.Ctx, receiver, coin.Denom))
+}
+
+func (suite *ibcMiddlewareTestSuite) TestOnRecvPacket_ConversionPair() {
+	receiver := suite.Addrs[0]
+	evmReceiver := types.BytesToInternalEVMAddress(suite.Addrs[1].Bytes())
+	coin := sdk.NewInt64Coin("erc20/usdc", 1e6)
+	im := evmutil.NewIBCMiddleware(suite.receiveCoins(receiver, coin), suite.Keeper)
+
+	memo := `{"evm":{"receiver":"` + evmReceiver.Hex() + `"}}`
+	ack := im.OnRecvPacket(suite.Ctx, recvPacket("transfer/channel-0/erc20/usdc", receiver, memo), nil)
+	suite.Require().True(ack.Success())
+
+	suite.Require().True(suite.BankKeeper.GetBalance(suite.Ctx, receiver, coin.Denom).IsZero())
+	balance := suite.GetERC20BalanceOf(types.ERC20MintableBurnableContract.ABI, suite.contractAddr, evmReceiver)
+	suite.BigIntsEqual(big.NewInt(1e6), balance, "received coins should be converted to the evm-native erc20")
+}
+
+func (suite *ibcMiddlewareTestSuite) TestOnRecvPacket_CosmosCoin() {
+	receiver := suite.Addrs[0]
+	evmReceiver := types.BytesToInternalEVMAddress(suite.Addrs[1].Bytes())
+	denom := transfertypes.ParseDenomTrace("transfer/channel-1/uatom").IBCDenom()
+	coin := sdk.NewInt64Coin(denom, 1e6)
+
+	params := suite.Keeper.GetParams(suite.Ctx)
+	params.AllowedCosmosDenoms = append(
+		params.AllowedCosmosDenoms,
+		types.NewAllowedCosmosCoinERC20Token(denom, "Kava EVM Atom", "ATOM", 6),
+	)
+	suite.Keeper.SetParams(suite.Ctx, params)
+
+	im := evmutil.NewIBCMiddleware(suite.receiveCoins(receiver, coin), suite.Keeper)
+
+	memo := `{"evm":{"receiver":"` + evmReceiver.Hex() + `"}}`
+	ack := im.OnRecvPacket(suite.Ctx, recvPacket("uatom", receiver, memo), nil)
+	suite.Require().True(ack.Success())
+
+	suite.Require().True(suite.BankKeeper.GetBalance(suite.Ctx, receiver, denom).IsZero())
+	contractAddr, found := suite.Keeper.GetDeployedCosmosCoinContract(suite.Ctx, denom)
+	suite.Require().True(found, "contract should be deployed on first conversion")
+	balance := suite.GetERC20BalanceOf(types.ERC20KavaWrappedCosmosCoinContract.ABI, contractAddr, evmReceiver)
+	suite.BigIntsEqual(big.NewInt(1e6), balance, "received coins should be converted to the cosmos coin erc20")
+}
+
+func (suite *ibcMiddlewareTestSuite) TestOnRecvPacket_NotConvertible() {
+	receiver := suite.Addrs[0]
+	evmReceiver := types.BytesToInternalEVMAddress(suite.Addrs[1].Bytes())
+	denom := transfertypes.ParseDenomTrace("transfer/channel-1/uatom").IBCDenom()
+	im := evmutil.NewIBCMiddleware(suite.receiveCoins(receiver, sdk.NewInt64Coin(denom, 1e6)), suite.Keeper)
+
+	memo := `{"evm":{"receiver":"` + evmReceiver.Hex() + `"}}`
+	ack := im.OnRecvPacket(suite.Ctx, recvPacket("uatom", receiver, memo), nil)
+	suite.Require().False(ack.Success(), "unconvertible coins should be refunded")
+}
+
+func (suite *ibcMiddlewareTestSuite) TestOnRecvPacket_InvalidMemo() {
+	// the transfer application must not be called
+	im := evmutil.NewIBCMiddleware(mockIBCModule{}, suite.Keeper)
+
+	ack := im.OnRecvPacket(suite.Ctx, recvPacket("uatom", suite.Addrs[0], `{"evm":{"receiver":"kava1abc"}}`), nil)
+	suite.Require().False(ack.Success())
+}
+
+func (suite *ibcMiddlewareTestSuite) TestOnRecvPacket_AppError() {
+	appAck := channeltypes.NewErrorAcknowledgement(errors.New("failed"))
+	im := evmutil.NewIBCMiddleware(mockIBCModule{onRecv: func(sdk.Context) ibcexported.Acknowledgement {
+		return appAck
+	}}, suite.Keeper)
+
+	evmReceiver := types.BytesToInternalEVMAddress(suite.Addrs[1].Bytes())
+	memo := `{"evm":{"receiver":"` + evmReceiver.Hex() + `"}}`
+	ack := im.OnRecvPacket(suite.Ctx, recvPacket("uatom", suite.Addrs[0], memo), nil)
+	suite.Require().Equal(appAck, ack)
+}
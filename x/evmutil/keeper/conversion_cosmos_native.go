@@ -25,10 +25,13 @@ func (k *Keeper) ConvertCosmosCoinToERC20(
 	if !allowed {
 		return errorsmod.Wrapf(types.ErrSDKConversionNotEnabled, amount.Denom)
 	}
+	if err := k.TrackConversion(ctx, amount, types.CONVERSION_DIRECTION_TO_ERC20); err != nil {
+		return err
+	}
 
 	// send coins from initiator to the module account
 	// do this before possible contract deploy to prevent unnecessary store interactions
-	err := k.bankKeeper.SendCoinsFromAccountToModule(
+	err := k.cosmosCoinBank().SendCoinsFromAccountToModule(
 		ctx, initiator, types.ModuleName, sdk.NewCoins(amount),
 	)
 	if err != nil {
@@ -66,6 +69,10 @@ func (k *Keeper) ConvertCosmosCoinFromERC20(
 	receiver sdk.AccAddress,
 	coin sdk.Coin,
 ) error {
+	if err := k.TrackConversion(ctx, coin, types.CONVERSION_DIRECTION_FROM_ERC20); err != nil {
+		return err
+	}
+
 	amount := coin.Amount.BigInt()
 	// get deployed contract
 	contractAddress, found := k.GetDeployedCosmosCoinContract(ctx, coin.Denom)
@@ -90,7 +97,7 @@ func (k *Keeper) ConvertCosmosCoinFromERC20(
 	}
 
 	// send sdk coins to receiver, unlocking them from the module account
-	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, sdk.NewCoins(coin))
+	err = k.cosmosCoinBank().SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, sdk.NewCoins(coin))
 	if err != nil {
 		return err
 	}
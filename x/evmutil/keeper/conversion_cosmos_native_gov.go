@@ -0,0 +1,159 @@
+package keeper
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/kava-labs/kava/x/evmutil/types"
+)
+
+// ERC20KavaWrappedCosmosCoin uses the storage layout of the OpenZeppelin v4.8.3
+// ERC20: _balances (0), _allowances (1), _totalSupply (2), _name (3) and
+// _symbol (4), followed by the Ownable _owner (5). Decimals are immutable and
+// part of the deployed bytecode, so they can only be changed by a migration.
+var (
+	erc20NameSlot   = common.BigToHash(big.NewInt(3))
+	erc20SymbolSlot = common.BigToHash(big.NewInt(4))
+)
+
+// UpdateCosmosCoinERC20Metadata updates the name and symbol of the deployed ERC20
+// representing a cosmos denom. The metadata in the AllowedCosmosDenoms param is
+// updated to match, so a migrated contract keeps the new metadata.
+func (k Keeper) UpdateCosmosCoinERC20Metadata(ctx sdk.Context, cosmosDenom, name, symbol string) error {
+	contractAddress, found := k.GetDeployedCosmosCoinContract(ctx, cosmosDenom)
+	if !found {
+		return errorsmod.Wrapf(types.ErrInvalidCosmosDenom, "no erc20 contract found for %s", cosmosDenom)
+	}
+
+	k.setContractString(ctx, contractAddress.Address, erc20NameSlot, name)
+	k.setContractString(ctx, contractAddress.Address, erc20SymbolSlot, symbol)
+
+	params := k.GetParams(ctx)
+	for i, token := range params.AllowedCosmosDenoms {
+		if token.CosmosDenom == cosmosDenom {
+			params.AllowedCosmosDenoms[i].Name = name
+			params.AllowedCosmosDenoms[i].Symbol = symbol
+		}
+	}
+	k.SetParams(ctx, params)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUpdateCosmosCoinERC20Metadata,
+		sdk.NewAttribute(types.AttributeKeyDenom, cosmosDenom),
+		sdk.NewAttribute(types.AttributeKeyERC20Address, contractAddress.Hex()),
+		sdk.NewAttribute(types.AttributeKeyName, name),
+		sdk.NewAttribute(types.AttributeKeySymbol, symbol),
+	))
+
+	return nil
+}
+
+// SetCosmosCoinConversionPaused pauses or unpauses conversions of a cosmos denom
+// in both directions, without removing it from the AllowedCosmosDenoms param.
+func (k Keeper) SetCosmosCoinConversionPaused(ctx sdk.Context, cosmosDenom string, paused bool) {
+	k.SetConversionDirectionPaused(ctx, cosmosDenom, types.CONVERSION_DIRECTION_TO_ERC20, paused)
+	k.SetConversionDirectionPaused(ctx, cosmosDenom, types.CONVERSION_DIRECTION_FROM_ERC20, paused)
+}
+
+// MigrateCosmosCoinERC20 deploys a new ERC20 contract for a cosmos denom with the
+// current contract bytecode and the metadata in the AllowedCosmosDenoms param.
+// All balances, allowances and the total supply are moved from the previous
+// contract to the new one, and the previous contract is left without any
+// tokens. The new contract must keep the storage layout of the previous one.
+func (k Keeper) MigrateCosmosCoinERC20(ctx sdk.Context, cosmosDenom string) (types.InternalEVMAddress, error) {
+	previousAddress, found := k.GetDeployedCosmosCoinContract(ctx, cosmosDenom)
+	if !found {
+		return types.InternalEVMAddress{}, errorsmod.Wrapf(types.ErrInvalidCosmosDenom, "no erc20 contract found for %s", cosmosDenom)
+	}
+
+	tokenInfo, allowed := k.GetAllowedTokenMetadata(ctx, cosmosDenom)
+	if !allowed {
+		return types.InternalEVMAddress{}, errorsmod.Wrapf(
+			types.ErrSDKConversionNotEnabled,
+			"%s must be in the allowed cosmos denoms to deploy a new contract", cosmosDenom,
+		)
+	}
+
+	contractAddress, err := k.DeployKavaWrappedCosmosCoinERC20Contract(ctx, tokenInfo)
+	if err != nil {
+		return types.InternalEVMAddress{}, err
+	}
+
+	// collect the storage before modifying it, as the store cannot be written while iterating
+	var keys, values []common.Hash
+	k.evmKeeper.ForEachStorage(ctx, previousAddress.Address, func(key, value common.Hash) bool {
+		keys = append(keys, key)
+		values = append(values, value)
+		return true
+	})
+	for i, key := range keys {
+		k.setContractState(ctx, contractAddress.Address, key, values[i])
+		k.setContractState(ctx, previousAddress.Address, key, common.Hash{})
+	}
+
+	// the copied storage contains the previous metadata
+	k.setContractString(ctx, contractAddress.Address, erc20NameSlot, tokenInfo.Name)
+	k.setContractString(ctx, contractAddress.Address, erc20SymbolSlot, tokenInfo.Symbol)
+
+	if err := k.SetDeployedCosmosCoinContract(ctx, cosmosDenom, contractAddress); err != nil {
+		return types.InternalEVMAddress{}, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeMigrateCosmosCoinERC20,
+		sdk.NewAttribute(types.AttributeKeyDenom, cosmosDenom),
+		sdk.NewAttribute(types.AttributeKeyPreviousERC20Address, previousAddress.Hex()),
+		sdk.NewAttribute(types.AttributeKeyERC20Address, contractAddress.Hex()),
+	))
+
+	return contractAddress, nil
+}
+
+// setContractString writes a solidity string to a contract storage slot. Strings
+// shorter than 32 bytes are stored in the slot with the length in the lowest
+// byte. Longer strings store the length in the slot and the data in consecutive
+// slots starting at keccak256(slot).
+func (k Keeper) setContractString(ctx sdk.Context, addr common.Address, slot common.Hash, value string) {
+	dataSlot := crypto.Keccak256Hash(slot.Bytes()).Big()
+	dataWordSlot := func(i int) common.Hash {
+		return common.BigToHash(new(big.Int).Add(dataSlot, big.NewInt(int64(i))))
+	}
+
+	// clear the data of a previous long string
+	previous := k.evmKeeper.GetState(ctx, addr, slot)
+	if previous[common.HashLength-1]&1 == 1 {
+		previousLength := new(big.Int).Rsh(previous.Big(), 1).Int64()
+		for i := 0; int64(i)*common.HashLength < previousLength; i++ {
+			k.setContractState(ctx, addr, dataWordSlot(i), common.Hash{})
+		}
+	}
+
+	data := []byte(value)
+	if len(data) < common.HashLength {
+		var word common.Hash
+		copy(word[:], data)
+		word[common.HashLength-1] = byte(len(data) * 2)
+		k.setContractState(ctx, addr, slot, word)
+		return
+	}
+
+	k.setContractState(ctx, addr, slot, common.BigToHash(big.NewInt(int64(len(data)*2+1))))
+	for i := 0; i*common.HashLength < len(data); i++ {
+		var word common.Hash
+		copy(word[:], data[i*common.HashLength:min((i+1)*common.HashLength, len(data))])
+		k.setContractState(ctx, addr, dataWordSlot(i), word)
+	}
+}
+
+// setContractState writes a contract storage slot, deleting it when the value is zero.
+func (k Keeper) setContractState(ctx sdk.Context, addr common.Address, key, value common.Hash) {
+	if value == (common.Hash{}) {
+		k.evmKeeper.SetState(ctx, addr, key, nil)
+		return
+	}
+	k.evmKeeper.SetState(ctx, addr, key, value.Bytes())
+}
@@ -0,0 +1,203 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/evmutil/keeper"
+	"github.com/kava-labs/kava/x/evmutil/testutil"
+	"github.com/kava-labs/kava/x/evmutil/types"
+)
+
+type cosmosCoinGovSuite struct {
+	testutil.Suite
+
+	denom        string
+	initiator    sdk.AccAddress
+	receiver     types.InternalEVMAddress
+	contractAddr types.InternalEVMAddress
+}
+
+func TestCosmosCoinGovSuite(t *testing.T) {
+	suite.Run(t, new(cosmosCoinGovSuite))
+}
+
+func (suite *cosmosCoinGovSuite) SetupTest() {
+	suite.Suite.SetupTest()
+
+	suite.denom = "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+	suite.initiator = app.RandomAddress()
+	suite.receiver = testutil.RandomInternalEVMAddress()
+
+	params := suite.Keeper.GetParams(suite.Ctx)
+	params.AllowedCosmosDenoms = types.NewAllowedCosmosCoinERC20Tokens(
+		types.NewAllowedCosmosCoinERC20Token(suite.denom, "Kava EVM Atom", "ATOM", 6),
+	)
+	suite.Keeper.SetParams(suite.Ctx, params)
+
+	err := suite.App.FundAccount(suite.Ctx, suite.initiator, sdk.NewCoins(sdk.NewInt64Coin(suite.denom, 1e10)))
+	suite.Require().NoError(err)
+	err = suite.Keeper.ConvertCosmosCoinToERC20(suite.Ctx, suite.initiator, suite.receiver, sdk.NewInt64Coin(suite.denom, 1e6))
+	suite.Require().NoError(err)
+
+	var found bool
+	suite.contractAddr, found = suite.Keeper.GetDeployedCosmosCoinContract(suite.Ctx, suite.denom)
+	suite.Require().True(found)
+}
+
+func (suite *cosmosCoinGovSuite) query(contractAddr types.InternalEVMAddress, method string, args ...interface{}) interface{} {
+	caller, key := testutil.RandomEvmAccount()
+	res, err := suite.QueryContract(types.ERC20KavaWrappedCosmosCoinContract.ABI, caller, key, contractAddr, method, args...)
+	suite.Require().NoError(err)
+	suite.Require().Len(res, 1)
+	return res[0]
+}
+
+func (suite *cosmosCoinGovSuite) TestUpdateCosmosCoinERC20Metadata() {
+	longName := "Kava EVM Atom bridged from the Cosmos Hub over IBC"
+
+	testCases := []struct {
+		name   string
+		symbol string
+	}{
+		{"Cosmos Hub Atom", "hATOM"},
+		{longName, "ATOM.ibc"},
+		{"Atom", "ATOM"}, // short name after a long name clears the previous data
+	}
+	for _, tc := range testCases {
+		err := suite.Keeper.UpdateCosmosCoinERC20Metadata(suite.Ctx, suite.denom, tc.name, tc.symbol)
+		suite.Require().NoError(err)
+
+		suite.Equal(tc.name, suite.query(suite.contractAddr, "name"))
+		suite.Equal(tc.symbol, suite.query(suite.contractAddr, "symbol"))
+		suite.Equal(uint8(6), suite.query(suite.contractAddr, "decimals"))
+		suite.BigIntsEqual(big.NewInt(1e6), suite.query(suite.contractAddr, "balanceOf", suite.receiver.Address).(*big.Int), "balances should not change")
+
+		token, found := suite.Keeper.GetAllowedTokenMetadata(suite.Ctx, suite.denom)
+		suite.Require().True(found)
+		suite.Equal(tc.name, token.Name)
+		suite.Equal(tc.symbol, token.Symbol)
+	}
+
+	err := suite.Keeper.UpdateCosmosCoinERC20Metadata(suite.Ctx, "undeployed", "Name", "SYM")
+	suite.ErrorIs(err, types.ErrInvalidCosmosDenom)
+}
+
+func (suite *cosmosCoinGovSuite) TestSetCosmosCoinConversionPaused() {
+	coin := sdk.NewInt64Coin(suite.denom, 1e5)
+	receiver := app.RandomAddress()
+
+	suite.Keeper.SetCosmosCoinConversionPaused(suite.Ctx, suite.denom, true)
+	suite.ElementsMatch(
+		[]types.PausedConversionDirection{
+			types.NewPausedConversionDirection(suite.denom, types.CONVERSION_DIRECTION_TO_ERC20),
+			types.NewPausedConversionDirection(suite.denom, types.CONVERSION_DIRECTION_FROM_ERC20),
+		},
+		suite.Keeper.GetPausedConversionDirections(suite.Ctx),
+	)
+
+	err := suite.Keeper.ConvertCosmosCoinToERC20(suite.Ctx, suite.initiator, suite.receiver, coin)
+	suite.ErrorIs(err, types.ErrConversionPaused)
+	err = suite.Keeper.ConvertCosmosCoinFromERC20(suite.Ctx, suite.receiver, receiver, coin)
+	suite.ErrorIs(err, types.ErrConversionPaused)
+
+	suite.Keeper.SetCosmosCoinConversionPaused(suite.Ctx, suite.denom, false)
+	suite.Empty(suite.Keeper.GetPausedConversionDirections(suite.Ctx))
+
+	err = suite.Keeper.ConvertCosmosCoinToERC20(suite.Ctx, suite.initiator, suite.receiver, coin)
+	suite.NoError(err)
+	err = suite.Keeper.ConvertCosmosCoinFromERC20(suite.Ctx, suite.receiver, receiver, coin)
+	suite.NoError(err)
+}
+
+func (suite *cosmosCoinGovSuite) TestMigrateCosmosCoinERC20() {
+	params := suite.Keeper.GetParams(suite.Ctx)
+	params.AllowedCosmosDenoms[0].Name = "Cosmos Hub Atom"
+	params.AllowedCosmosDenoms[0].Decimals = 18
+	suite.Keeper.SetParams(suite.Ctx, params)
+
+	newAddr, err := suite.Keeper.MigrateCosmosCoinERC20(suite.Ctx, suite.denom)
+	suite.Require().NoError(err)
+	suite.NotEqual(suite.contractAddr, newAddr)
+
+	deployed, found := suite.Keeper.GetDeployedCosmosCoinContract(suite.Ctx, suite.denom)
+	suite.Require().True(found)
+	suite.Equal(newAddr, deployed)
+
+	// tokens are moved to the new contract
+	suite.BigIntsEqual(big.NewInt(1e6), suite.query(newAddr, "balanceOf", suite.receiver.Address).(*big.Int), "balance should be migrated")
+	suite.BigIntsEqual(big.NewInt(1e6), suite.query(newAddr, "totalSupply").(*big.Int), "total supply should be migrated")
+	suite.BigIntsEqual(big.NewInt(0), suite.query(suite.contractAddr, "balanceOf", suite.receiver.Address).(*big.Int), "previous balance should be cleared")
+	suite.BigIntsEqual(big.NewInt(0), suite.query(suite.contractAddr, "totalSupply").(*big.Int), "previous total supply should be cleared")
+
+	// new contract uses the current metadata
+	suite.Equal("Cosmos Hub Atom", suite.query(newAddr, "name"))
+	suite.Equal("ATOM", suite.query(newAddr, "symbol"))
+	suite.Equal(uint8(18), suite.query(newAddr, "decimals"))
+
+	_, broken := keeper.CosmosCoinsFullyBackedInvariant(suite.BankKeeper, suite.Keeper)(suite.Ctx)
+	suite.False(broken)
+
+	// the module still owns the new contract
+	err = suite.Keeper.ConvertCosmosCoinToERC20(suite.Ctx, suite.initiator, suite.receiver, sdk.NewInt64Coin(suite.denom, 1e5))
+	suite.Require().NoError(err)
+	err = suite.Keeper.ConvertCosmosCoinFromERC20(suite.Ctx, suite.receiver, app.RandomAddress(), sdk.NewInt64Coin(suite.denom, 11e5))
+	suite.Require().NoError(err)
+	suite.BigIntsEqual(big.NewInt(0), suite.query(newAddr, "totalSupply").(*big.Int), "all tokens should be converted")
+}
+
+func (suite *cosmosCoinGovSuite) TestMigrateCosmosCoinERC20_NotAllowed() {
+	params := suite.Keeper.GetParams(suite.Ctx)
+	params.AllowedCosmosDenoms = types.NewAllowedCosmosCoinERC20Tokens()
+	suite.Keeper.SetParams(suite.Ctx, params)
+
+	_, err := suite.Keeper.MigrateCosmosCoinERC20(suite.Ctx, suite.denom)
+	suite.ErrorIs(err, types.ErrSDKConversionNotEnabled)
+
+	_, err = suite.Keeper.MigrateCosmosCoinERC20(suite.Ctx, "undeployed")
+	suite.ErrorIs(err, types.ErrInvalidCosmosDenom)
+}
+
+func (suite *cosmosCoinGovSuite) TestMsgServer_Authority() {
+	msgServer := keeper.NewMsgServerImpl(suite.Keeper)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	other := app.RandomAddress().String()
+
+	msgPause := types.NewMsgSetCosmosCoinConversionPaused(other, suite.denom, true)
+	_, err := msgServer.SetCosmosCoinConversionPaused(sdk.WrapSDKContext(suite.Ctx), &msgPause)
+	suite.ErrorIs(err, govtypes.ErrInvalidSigner)
+	msgPause.Authority = authority
+	_, err = msgServer.SetCosmosCoinConversionPaused(sdk.WrapSDKContext(suite.Ctx), &msgPause)
+	suite.NoError(err)
+	suite.True(suite.Keeper.IsConversionDirectionPaused(suite.Ctx, suite.denom, types.CONVERSION_DIRECTION_TO_ERC20))
+	suite.True(suite.Keeper.IsConversionDirectionPaused(suite.Ctx, suite.denom, types.CONVERSION_DIRECTION_FROM_ERC20))
+
+	msgUpdate := types.NewMsgUpdateCosmosCoinERC20Metadata(other, suite.denom, "Atom", "ATOM")
+	_, err = msgServer.UpdateCosmosCoinERC20Metadata(sdk.WrapSDKContext(suite.Ctx), &msgUpdate)
+	suite.ErrorIs(err, govtypes.ErrInvalidSigner)
+	msgUpdate.Authority = authority
+	_, err = msgServer.UpdateCosmosCoinERC20Metadata(sdk.WrapSDKContext(suite.Ctx), &msgUpdate)
+	suite.NoError(err)
+
+	msgMigrate := types.NewMsgMigrateCosmosCoinERC20(other, suite.denom)
+	_, err = msgServer.MigrateCosmosCoinERC20(sdk.WrapSDKContext(suite.Ctx), &msgMigrate)
+	suite.ErrorIs(err, govtypes.ErrInvalidSigner)
+	msgMigrate.Authority = authority
+	res, err := msgServer.MigrateCosmosCoinERC20(sdk.WrapSDKContext(suite.Ctx), &msgMigrate)
+	suite.NoError(err)
+	suite.Equal(testutil.MustNewInternalEVMAddressFromString(res.ContractAddress), suite.mustDeployed())
+	suite.True(sdkmath.NewInt(1e6).Equal(sdkmath.NewIntFromBigInt(suite.query(suite.mustDeployed(), "totalSupply").(*big.Int))))
+}
+
+func (suite *cosmosCoinGovSuite) mustDeployed() types.InternalEVMAddress {
+	addr, found := suite.Keeper.GetDeployedCosmosCoinContract(suite.Ctx, suite.denom)
+	suite.Require().True(found)
+	return addr
+}
@@ -11,8 +11,10 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/evmutil/keeper"
 	"github.com/kava-labs/kava/x/evmutil/testutil"
 	"github.com/kava-labs/kava/x/evmutil/types"
+	precisebanktypes "github.com/kava-labs/kava/x/precisebank/types"
 )
 
 type convertCosmosCoinToERC20Suite struct {
@@ -172,6 +174,50 @@ func (suite *convertCosmosCoinToERC20Suite) TestConvertCosmosCoinToERC20() {
 	})
 }
 
+func (suite *convertCosmosCoinToERC20Suite) TestConvertCosmosCoin_ExtendedDenom() {
+	precisebankKeeper := suite.App.GetPrecisebankKeeper()
+	catExt := precisebanktypes.NewDenomExtension("ucat", "acat", sdkmath.NewInt(1e12))
+	precisebankKeeper.SetParams(suite.Ctx, precisebanktypes.NewParams(precisebanktypes.DenomExtensions{catExt}))
+
+	params := suite.Keeper.GetParams(suite.Ctx)
+	params.AllowedCosmosDenoms = types.NewAllowedCosmosCoinERC20Tokens(
+		types.NewAllowedCosmosCoinERC20Token("acat", "Kava EVM Cat", "CAT", 18),
+	)
+	suite.Keeper.SetParams(suite.Ctx, params)
+
+	initiator := app.RandomAddress()
+	receiver := types.BytesToInternalEVMAddress(app.RandomAddress().Bytes())
+	err := suite.App.FundAccount(suite.Ctx, initiator, sdk.NewCoins(sdk.NewInt64Coin("ucat", 2)))
+	suite.Require().NoError(err)
+
+	// convert an amount with a fractional ucat part
+	amount := sdk.NewCoin("acat", sdkmath.NewInt(1_500_000_000_001))
+	err = suite.Keeper.ConvertCosmosCoinToERC20(suite.Ctx, initiator, receiver, amount)
+	suite.Require().NoError(err)
+
+	contractAddress := suite.denomContractRegistered("acat")
+	balance, err := suite.Keeper.QueryERC20BalanceOf(suite.Ctx, contractAddress, receiver)
+	suite.Require().NoError(err)
+	suite.BigIntsEqual(amount.Amount.BigInt(), balance, "unexpected erc20 balance")
+
+	moduleAddr := suite.AccountKeeper.GetModuleAddress(types.ModuleName)
+	suite.Equal(amount, precisebankKeeper.GetBalance(suite.Ctx, moduleAddr, "acat"))
+	suite.Equal(
+		sdk.NewCoin("acat", sdkmath.NewInt(2e12).Sub(amount.Amount)),
+		precisebankKeeper.GetBalance(suite.Ctx, initiator, "acat"),
+	)
+
+	// convert back the full amount
+	err = suite.Keeper.ConvertCosmosCoinFromERC20(suite.Ctx, receiver, initiator, amount)
+	suite.Require().NoError(err)
+
+	suite.Equal(sdk.NewInt64Coin("acat", 0), precisebankKeeper.GetBalance(suite.Ctx, moduleAddr, "acat"))
+	suite.Equal(sdk.NewInt64Coin("acat", 2e12), precisebankKeeper.GetBalance(suite.Ctx, initiator, "acat"))
+
+	_, broken := keeper.CosmosCoinsFullyBackedInvariant(suite.BankKeeper, suite.Keeper)(suite.Ctx)
+	suite.False(broken)
+}
+
 type convertCosmosCoinFromERC20Suite struct {
 	testutil.Suite
 
@@ -67,6 +67,10 @@ func (k Keeper) ConvertCoinToERC20(
 		return err
 	}
 
+	if err := k.TrackConversion(ctx, coin, types.CONVERSION_DIRECTION_TO_ERC20); err != nil {
+		return err
+	}
+
 	if err := k.BurnConversionPairCoin(ctx, pair, coin, initiatorAccount); err != nil {
 		return err
 	}
@@ -103,11 +107,57 @@ func (k Keeper) ConvertERC20ToCoin(
 	contractAddr types.InternalEVMAddress,
 	amount sdkmath.Int,
 ) error {
+	_, err := k.convertERC20ToCoin(ctx, initiator, receiver, contractAddr, amount)
+	return err
+}
+
+// ConvertERC20ToExactCoin converts the ERC20 of an enabled conversion pair from
+// the originating account to exactly the given sdk.Coin to the receiver account,
+// scaling the amount of bep3 ERC20s to their 18 decimals.
+func (k Keeper) ConvertERC20ToExactCoin(
+	ctx sdk.Context,
+	initiator types.InternalEVMAddress,
+	receiver sdk.AccAddress,
+	coin sdk.Coin,
+) error {
+	pair, err := k.GetEnabledConversionPairFromDenom(ctx, coin.Denom)
+	if err != nil {
+		return err
+	}
+
+	amount := coin.Amount.BigInt()
+	if isBep3Asset(pair.Denom) {
+		amount = convertBep3CoinAmountToERC20Amount(amount)
+	}
+
+	minted, err := k.convertERC20ToCoin(ctx, initiator, receiver, pair.GetAddress(), sdkmath.NewIntFromBigInt(amount))
+	if err != nil {
+		return err
+	}
+	if !minted.IsEqual(coin) {
+		return errorsmod.Wrapf(
+			types.ErrBalanceInvariance,
+			"invalid converted amount - expected: %s, actual: %s",
+			coin, minted,
+		)
+	}
+	return nil
+}
+
+// convertERC20ToCoin converts an ERC20 coin from the originating account to an
+// sdk.Coin to the receiver account and returns the minted sdk.Coin.
+func (k Keeper) convertERC20ToCoin(
+	ctx sdk.Context,
+	initiator types.InternalEVMAddress,
+	receiver sdk.AccAddress,
+	contractAddr types.InternalEVMAddress,
+	amount sdkmath.Int,
+) (sdk.Coin, error) {
 	// Check that the contract is enabled to convert to coin
 	pair, err := k.GetEnabledConversionPairFromERC20Address(ctx, contractAddr)
 	if err != nil {
 		// contract not in enabled conversion pair list
-		return err
+		return sdk.Coin{}, err
 	}
 
 	amountToLock := amount.BigInt()
@@ -116,19 +166,30 @@ func (k Keeper) ConvertERC20ToCoin(
 	if isBep3Asset(pair.Denom) {
 		amountToMint, amountToLock, err = bep3ERC20AmountToCoinMintAndERC20LockAmount(amount.BigInt())
 		if err != nil {
-			return err
+			return sdk.Coin{}, err
 		}
 	}
 
+	mintCoin := sdk.NewCoin(pair.Denom, sdkmath.NewIntFromBigInt(amountToMint))
+	if err := k.TrackConversion(ctx, mintCoin, types.CONVERSION_DIRECTION_FROM_ERC20); err != nil {
+		return sdk.Coin{}, err
+	}
+
 	// lock erc20 tokens
-	if err := k.LockERC20Tokens(ctx, pair, amountToLock, initiator); err != nil {
-		return err
+	amountLocked, err := k.LockERC20Tokens(ctx, pair, amountToLock, initiator)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	// registered ERC20s are arbitrary contracts that may take a fee on transfer,
+	// so only the amount received by the module is minted
+	if types.IsRegisteredERC20Denom(pair.Denom) {
+		amountToMint = amountLocked
 	}
 
 	// mint conversion pair coin
 	coin, err := k.MintConversionPairCoin(ctx, pair, amountToMint, receiver)
 	if err != nil {
-		return err
+		return sdk.Coin{}, err
 	}
 
 	ctx.EventManager().EmitEvent(sdk.NewEvent(
@@ -139,7 +200,7 @@ func (k Keeper) ConvertERC20ToCoin(
 		sdk.NewAttribute(types.AttributeKeyAmount, coin.String()),
 	))
 
-	return nil
+	return coin, nil
 }
 
 // UnlockERC20Tokens transfers the given amount of a conversion pair ERC20 token
@@ -192,17 +253,30 @@ func (k Keeper) UnlockERC20Tokens(
 }
 
 // LockERC20Tokens transfers the given amount of a conversion pair ERC20 token
-// from the initiator account to the module account.
+// from the initiator account to the module account and returns the amount
+// received by the module account. For registered conversion pairs, the amount
+// received may be less than the amount transferred but never more.
 func (k Keeper) LockERC20Tokens(
 	ctx sdk.Context,
 	pair types.ConversionPair,
 	amount *big.Int,
 	initiator types.InternalEVMAddress,
-) error {
+) (*big.Int, error) {
 	contractAddr := pair.GetAddress()
+	moduleAddr := types.NewInternalEVMAddress(types.ModuleEVMAddress)
 	initiatorStartBal, err := k.QueryERC20BalanceOf(ctx, contractAddr, initiator)
 	if err != nil {
-		return errorsmod.Wrapf(types.ErrEVMCall, "failed to retrieve balance: %s", err.Error())
+		return nil, errorsmod.Wrapf(types.ErrEVMCall, "failed to retrieve balance: %s", err.Error())
+	}
+	// registered ERC20s are arbitrary contracts, so the amount received by the
+	// module is measured rather than assumed
+	registered := types.IsRegisteredERC20Denom(pair.Denom)
+	moduleStartBal := big.NewInt(0)
+	if registered {
+		moduleStartBal, err = k.QueryERC20BalanceOf(ctx, contractAddr, moduleAddr)
+		if err != nil {
+			return nil, errorsmod.Wrapf(types.ErrEVMCall, "failed to retrieve balance: %s", err.Error())
+		}
 	}
 
 	res, err := k.CallEVM(
@@ -216,27 +290,43 @@ func (k Keeper) LockERC20Tokens(
 		amount,
 	)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// validate end bal
 	initiatorEndBal, err := k.QueryERC20BalanceOf(ctx, contractAddr, initiator)
 	if err != nil {
-		return errorsmod.Wrapf(types.ErrEVMCall, "failed to retrieve balance %s", err.Error())
+		return nil, errorsmod.Wrapf(types.ErrEVMCall, "failed to retrieve balance %s", err.Error())
 	}
 	expectedEndBal := big.NewInt(0).Sub(initiatorStartBal, amount)
 	if expectedEndBal.Cmp(initiatorEndBal) != 0 {
-		return errorsmod.Wrapf(
+		return nil, errorsmod.Wrapf(
 			types.ErrBalanceInvariance,
 			"invalid token balance - expected: %v, actual: %v",
 			expectedEndBal, initiatorEndBal,
 		)
 	}
 
+	received := amount
+	if registered {
+		moduleEndBal, err := k.QueryERC20BalanceOf(ctx, contractAddr, moduleAddr)
+		if err != nil {
+			return nil, errorsmod.Wrapf(types.ErrEVMCall, "failed to retrieve balance %s", err.Error())
+		}
+		received = big.NewInt(0).Sub(moduleEndBal, moduleStartBal)
+		if received.Sign() <= 0 || received.Cmp(amount) > 0 {
+			return nil, errorsmod.Wrapf(
+				types.ErrBalanceInvariance,
+				"invalid module token balance change - expected at most: %v, actual: %v",
+				amount, received,
+			)
+		}
+	}
+
 	// Check for unexpected `Approval` event in logs
 	if err := k.monitorApprovalEvent(res); err != nil {
-		return err
+		return nil, err
 	}
 
-	return err
+	return received, nil
 }
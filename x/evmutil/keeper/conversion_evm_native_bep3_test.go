@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"fmt"
+	"math/big"
 	"testing"
 
 	sdkmath "cosmossdk.io/math"
@@ -36,6 +37,37 @@ func (suite *Bep3ConversionTestSuite) TestConvertERC20ToCoin_Bep3() {
 	}
 }
 
+func (suite *Bep3ConversionTestSuite) TestConvertERC20ToExactCoin_Bep3() {
+	invoker := testutil.MustNewInternalEVMAddressFromString("0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2")
+	invokerCosmosAddr := sdk.AccAddress(invoker.Bytes())
+
+	for _, denom := range append(bep3Denoms, "xrp") {
+		suite.Run(denom, func() {
+			suite.SetupTest()
+			contractAddr := suite.DeployERC20()
+			suite.setEnabledConversionPairDenom(denom)
+
+			err := suite.Keeper.MintERC20(suite.Ctx, contractAddr, invoker, big.NewInt(2e18))
+			suite.Require().NoError(err)
+			err = suite.App.FundAccount(suite.Ctx, invokerCosmosAddr, sdk.NewCoins(sdk.NewCoin(denom, sdk.ZeroInt())))
+			suite.Require().NoError(err)
+
+			coin := sdk.NewInt64Coin(denom, 1.5e8)
+			err = suite.Keeper.ConvertERC20ToExactCoin(suite.Ctx, invoker, invokerCosmosAddr, coin)
+			suite.Require().NoError(err)
+
+			expectedErc20Balance := big.NewInt(2e18 - 1.5e8)
+			if denom != "xrp" {
+				expectedErc20Balance = big.NewInt(0.5e18)
+			}
+			bal := suite.GetERC20BalanceOf(types.ERC20MintableBurnableContract.ABI, contractAddr, invoker)
+			suite.Require().Equal(expectedErc20Balance, bal, "user erc20 balance is invalid")
+			coinBal := suite.App.GetBankKeeper().GetBalance(suite.Ctx, invokerCosmosAddr, denom)
+			suite.Require().Equal(coin, coinBal, "user coin balance is invalid")
+		})
+	}
+}
+
 func (suite *Bep3ConversionTestSuite) setEnabledConversionPairDenom(denom string) {
 	params := suite.Keeper.GetParams(suite.Ctx)
 	params.EnabledConversionPairs[0].Denom = denom
@@ -0,0 +1,176 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+
+	"github.com/kava-labs/kava/x/evmutil/types"
+)
+
+// IBCTransferERC20 converts an EVM-native ERC20 of an enabled conversion pair
+// to its sdk.Coin and sends the coin over IBC with an ICS-20 transfer from the
+// initiator's account. The transfer is tracked until it is acknowledged so
+// that refunded coins can be converted back to the ERC20.
+func (k Keeper) IBCTransferERC20(
+	ctx sdk.Context,
+	initiator types.InternalEVMAddress,
+	contractAddr types.InternalEVMAddress,
+	amount sdkmath.Int,
+	sourcePort, sourceChannel, receiver string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	memo string,
+) (uint64, error) {
+	if k.transferKeeper == nil {
+		return 0, types.ErrIBCTransferNotEnabled
+	}
+
+	// the initiator's cosmos account holds the converted coin and sends the transfer,
+	// so refunds from the transfer module are returned to the same account
+	sender := sdk.AccAddress(initiator.Bytes())
+
+	coin, err := k.convertERC20ToCoin(ctx, initiator, sender, contractAddr, amount)
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := k.transferKeeper.Transfer(sdk.WrapSDKContext(ctx), transfertypes.NewMsgTransfer(
+		sourcePort,
+		sourceChannel,
+		coin,
+		sender.String(),
+		receiver,
+		timeoutHeight,
+		timeoutTimestamp,
+		memo,
+	))
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "failed to send ibc transfer")
+	}
+
+	k.SetERC20IBCTransfer(ctx, sourcePort, sourceChannel, res.Sequence, types.ERC20IBCTransfer{
+		Initiator: initiator.Bytes(),
+		Amount:    coin,
+	})
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeIBCTransferERC20,
+		sdk.NewAttribute(types.AttributeKeyInitiator, initiator.String()),
+		sdk.NewAttribute(types.AttributeKeyERC20Address, contractAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyReceiver, receiver),
+		sdk.NewAttribute(types.AttributeKeyAmount, coin.String()),
+		sdk.NewAttribute(types.AttributeKeySourcePort, sourcePort),
+		sdk.NewAttribute(types.AttributeKeySourceChannel, sourceChannel),
+		sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", res.Sequence)),
+	))
+
+	return res.Sequence, nil
+}
+
+// OnERC20IBCTransferCompleted removes the tracked ICS-20 transfer of a
+// converted ERC20 once the packet is successfully acknowledged.
+func (k Keeper) OnERC20IBCTransferCompleted(ctx sdk.Context, sourcePort, sourceChannel string, sequence uint64) {
+	k.DeleteERC20IBCTransfer(ctx, sourcePort, sourceChannel, sequence)
+}
+
+// OnERC20IBCTransferRefunded converts the coins refunded by the transfer module
+// for a failed or timed out ICS-20 transfer back to the ERC20 of the initiator.
+// This must be called after the transfer module has refunded the packet.
+//
+// Failing to convert the refund does not return an error, as that would revert
+// the refund itself. The refunded coins are left in the initiator's account.
+func (k Keeper) OnERC20IBCTransferRefunded(ctx sdk.Context, sourcePort, sourceChannel string, sequence uint64) {
+	transfer, found := k.GetERC20IBCTransfer(ctx, sourcePort, sourceChannel, sequence)
+	if !found {
+		return
+	}
+	k.DeleteERC20IBCTransfer(ctx, sourcePort, sourceChannel, sequence)
+
+	initiator := types.BytesToInternalEVMAddress(transfer.Initiator)
+	sender := sdk.AccAddress(transfer.Initiator)
+
+	cacheCtx, write := ctx.CacheContext()
+	err := k.ConvertCoinToERC20(cacheCtx, sender, initiator, transfer.Amount)
+	if err == nil {
+		write()
+	} else {
+		k.Logger(ctx).Error(
+			"failed to convert refunded ibc transfer to erc20",
+			"initiator", initiator.String(),
+			"amount", transfer.Amount.String(),
+			"error", err.Error(),
+		)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeIBCTransferERC20Refund,
+		sdk.NewAttribute(types.AttributeKeyInitiator, initiator.String()),
+		sdk.NewAttribute(types.AttributeKeyAmount, transfer.Amount.String()),
+		sdk.NewAttribute(types.AttributeKeySourcePort, sourcePort),
+		sdk.NewAttribute(types.AttributeKeySourceChannel, sourceChannel),
+		sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", sequence)),
+		sdk.NewAttribute(types.AttributeKeySuccess, fmt.Sprintf("%t", err == nil)),
+	))
+}
+
+// SetERC20IBCTransfer stores an in-flight ICS-20 transfer of a converted ERC20.
+func (k Keeper) SetERC20IBCTransfer(
+	ctx sdk.Context,
+	sourcePort, sourceChannel string,
+	sequence uint64,
+	transfer types.ERC20IBCTransfer,
+) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&transfer)
+	store.Set(types.ERC20IBCTransferKey(sourcePort, sourceChannel, sequence), bz)
+}
+
+// GetERC20IBCTransfer returns the in-flight ICS-20 transfer of a converted
+// ERC20 and a bool indicating if it was found.
+func (k Keeper) GetERC20IBCTransfer(
+	ctx sdk.Context,
+	sourcePort, sourceChannel string,
+	sequence uint64,
+) (types.ERC20IBCTransfer, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ERC20IBCTransferKey(sourcePort, sourceChannel, sequence))
+	if bz == nil {
+		return types.ERC20IBCTransfer{}, false
+	}
+
+	var transfer types.ERC20IBCTransfer
+	k.cdc.MustUnmarshal(bz, &transfer)
+	return transfer, true
+}
+
+// DeleteERC20IBCTransfer removes an in-flight ICS-20 transfer of a converted ERC20.
+func (k Keeper) DeleteERC20IBCTransfer(ctx sdk.Context, sourcePort, sourceChannel string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ERC20IBCTransferKey(sourcePort, sourceChannel, sequence))
+}
+
+// ConvertReceivedIBCCoinToERC20 converts a coin received by an ICS-20 transfer
+// to an ERC20 sent to the EVM receiver requested in the transfer memo.
+// Coins of enabled conversion pairs are converted back to their EVM-native
+// ERC20, while allowed cosmos denoms are converted to their Kava-wrapped ERC20,
+// deploying the contract if needed.
+func (k Keeper) ConvertReceivedIBCCoinToERC20(
+	ctx sdk.Context,
+	receiver sdk.AccAddress,
+	evmReceiver types.InternalEVMAddress,
+	coin sdk.Coin,
+) error {
+	if _, err := k.GetEnabledConversionPairFromDenom(ctx, coin.Denom); err == nil {
+		return k.ConvertCoinToERC20(ctx, receiver, evmReceiver, coin)
+	}
+	if _, allowed := k.GetAllowedTokenMetadata(ctx, coin.Denom); allowed {
+		return k.ConvertCosmosCoinToERC20(ctx, receiver, evmReceiver, coin)
+	}
+
+	return errorsmod.Wrapf(types.ErrSDKConversionNotEnabled, "%s cannot be converted to an erc20", coin.Denom)
+}
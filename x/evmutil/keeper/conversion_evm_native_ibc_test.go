@@ -0,0 +1,278 @@
+package keeper_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/precompile/contracts/ibctransfer"
+	"github.com/kava-labs/kava/precompile/registry"
+	"github.com/kava-labs/kava/x/evmutil/testutil"
+	"github.com/kava-labs/kava/x/evmutil/types"
+)
+
+// mockTransferKeeper escrows transferred coins without sending an IBC packet.
+type mockTransferKeeper struct {
+	bankKeeper types.BankKeeper
+	escrow     sdk.AccAddress
+	sequence   uint64
+	transfers  []*transfertypes.MsgTransfer
+}
+
+func (tk *mockTransferKeeper) Transfer(goCtx context.Context, msg *transfertypes.MsgTransfer) (*transfertypes.MsgTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	if err := tk.bankKeeper.SendCoins(ctx, sender, tk.escrow, sdk.NewCoins(msg.Token)); err != nil {
+		return nil, err
+	}
+
+	tk.sequence++
+	tk.transfers = append(tk.transfers, msg)
+	return &transfertypes.MsgTransferResponse{Sequence: tk.sequence}, nil
+}
+
+// refund returns escrowed coins to the sender, as the transfer module does for failed packets.
+func (tk *mockTransferKeeper) refund(ctx sdk.Context, msg *transfertypes.MsgTransfer) error {
+	return tk.bankKeeper.SendCoins(ctx, tk.escrow, sdk.MustAccAddressFromBech32(msg.Sender), sdk.NewCoins(msg.Token))
+}
+
+type IBCTransferTestSuite struct {
+	testutil.Suite
+
+	contractAddr   types.InternalEVMAddress
+	transferKeeper *mockTransferKeeper
+}
+
+func TestIBCTransferTestSuite(t *testing.T) {
+	suite.Run(t, new(IBCTransferTestSuite))
+}
+
+func (suite *IBCTransferTestSuite) SetupTest() {
+	suite.Suite.SetupTest()
+
+	// first deployed contract is enabled via params
+	suite.contractAddr = suite.DeployERC20()
+	err := suite.Keeper.MintERC20(suite.Ctx, suite.contractAddr, suite.Key1Addr, big.NewInt(1e10))
+	suite.Require().NoError(err)
+
+	suite.transferKeeper = &mockTransferKeeper{
+		bankKeeper: suite.BankKeeper,
+		escrow:     app.RandomAddress(),
+	}
+	suite.Keeper.SetTransferKeeper(suite.transferKeeper)
+}
+
+func (suite *IBCTransferTestSuite) transfer(amount int64) uint64 {
+	sequence, err := suite.Keeper.IBCTransferERC20(
+		suite.Ctx,
+		suite.Key1Addr,
+		suite.contractAddr,
+		sdkmath.NewInt(amount),
+		transfertypes.PortID,
+		"channel-0",
+		"cosmos1receiver",
+		clienttypes.ZeroHeight(),
+		1e18,
+		"",
+	)
+	suite.Require().NoError(err)
+	return sequence
+}
+
+func (suite *IBCTransferTestSuite) TestIBCTransferERC20() {
+	sequence := suite.transfer(1e6)
+	suite.Require().Equal(uint64(1), sequence)
+
+	sender := sdk.AccAddress(suite.Key1Addr.Bytes())
+	suite.Require().Len(suite.transferKeeper.transfers, 1)
+	msg := suite.transferKeeper.transfers[0]
+	suite.Require().Equal(sender.String(), msg.Sender)
+	suite.Require().Equal(sdk.NewInt64Coin("erc20/usdc", 1e6), msg.Token)
+	suite.Require().Equal("channel-0", msg.SourceChannel)
+	suite.Require().Equal("cosmos1receiver", msg.Receiver)
+
+	suite.Require().True(suite.BankKeeper.GetBalance(suite.Ctx, sender, "erc20/usdc").IsZero())
+	suite.Require().Equal(
+		sdk.NewInt64Coin("erc20/usdc", 1e6),
+		suite.BankKeeper.GetBalance(suite.Ctx, suite.transferKeeper.escrow, "erc20/usdc"),
+	)
+	suite.BigIntsEqual(big.NewInt(1e10-1e6), suite.GetERC20BalanceOf(
+		types.ERC20MintableBurnableContract.ABI, suite.contractAddr, suite.Key1Addr,
+	), "initiator erc20 balance should decrease")
+
+	transfer, found := suite.Keeper.GetERC20IBCTransfer(suite.Ctx, transfertypes.PortID, "channel-0", sequence)
+	suite.Require().True(found)
+	suite.Require().Equal(types.HexBytes(suite.Key1Addr.Bytes()), transfer.Initiator)
+	suite.Require().Equal(sdk.NewInt64Coin("erc20/usdc", 1e6), transfer.Amount)
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		types.EventTypeIBCTransferERC20,
+		sdk.NewAttribute(types.AttributeKeyInitiator, suite.Key1Addr.String()),
+		sdk.NewAttribute(types.AttributeKeyERC20Address, suite.contractAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyReceiver, "cosmos1receiver"),
+		sdk.NewAttribute(types.AttributeKeyAmount, "1000000erc20/usdc"),
+		sdk.NewAttribute(types.AttributeKeySourcePort, transfertypes.PortID),
+		sdk.NewAttribute(types.AttributeKeySourceChannel, "channel-0"),
+		sdk.NewAttribute(types.AttributeKeySequence, "1"),
+	))
+}
+
+func (suite *IBCTransferTestSuite) TestIBCTransferERC20_Errors() {
+	// not an enabled conversion pair
+	_, err := suite.Keeper.IBCTransferERC20(
+		suite.Ctx, suite.Key1Addr, testutil.RandomInternalEVMAddress(), sdkmath.NewInt(1),
+		transfertypes.PortID, "channel-0", "cosmos1receiver", clienttypes.ZeroHeight(), 1e18, "",
+	)
+	suite.Require().ErrorIs(err, types.ErrEVMConversionNotEnabled)
+
+	// insufficient erc20 balance
+	_, err = suite.Keeper.IBCTransferERC20(
+		suite.Ctx, suite.Key1Addr, suite.contractAddr, sdkmath.NewInt(1e11),
+		transfertypes.PortID, "channel-0", "cosmos1receiver", clienttypes.ZeroHeight(), 1e18, "",
+	)
+	suite.Require().Error(err)
+
+	// transfer keeper not set
+	suite.Keeper.SetTransferKeeper(nil)
+	_, err = suite.Keeper.IBCTransferERC20(
+		suite.Ctx, suite.Key1Addr, suite.contractAddr, sdkmath.NewInt(1),
+		transfertypes.PortID, "channel-0", "cosmos1receiver", clienttypes.ZeroHeight(), 1e18, "",
+	)
+	suite.Require().ErrorIs(err, types.ErrIBCTransferNotEnabled)
+
+	suite.Require().Empty(suite.transferKeeper.transfers)
+}
+
+func (suite *IBCTransferTestSuite) TestOnERC20IBCTransferCompleted() {
+	sequence := suite.transfer(1e6)
+
+	suite.Keeper.OnERC20IBCTransferCompleted(suite.Ctx, transfertypes.PortID, "channel-0", sequence)
+
+	_, found := suite.Keeper.GetERC20IBCTransfer(suite.Ctx, transfertypes.PortID, "channel-0", sequence)
+	suite.Require().False(found)
+	suite.BigIntsEqual(big.NewInt(1e10-1e6), suite.GetERC20BalanceOf(
+		types.ERC20MintableBurnableContract.ABI, suite.contractAddr, suite.Key1Addr,
+	), "initiator erc20 balance should not be refunded")
+}
+
+func (suite *IBCTransferTestSuite) TestOnERC20IBCTransferRefunded() {
+	sequence := suite.transfer(1e6)
+	suite.Require().NoError(suite.transferKeeper.refund(suite.Ctx, suite.transferKeeper.transfers[0]))
+
+	suite.Keeper.OnERC20IBCTransferRefunded(suite.Ctx, transfertypes.PortID, "channel-0", sequence)
+
+	_, found := suite.Keeper.GetERC20IBCTransfer(suite.Ctx, transfertypes.PortID, "channel-0", sequence)
+	suite.Require().False(found)
+	sender := sdk.AccAddress(suite.Key1Addr.Bytes())
+	suite.Require().True(suite.BankKeeper.GetBalance(suite.Ctx, sender, "erc20/usdc").IsZero())
+	suite.BigIntsEqual(big.NewInt(1e10), suite.GetERC20BalanceOf(
+		types.ERC20MintableBurnableContract.ABI, suite.contractAddr, suite.Key1Addr,
+	), "initiator erc20 balance should be refunded")
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		types.EventTypeIBCTransferERC20Refund,
+		sdk.NewAttribute(types.AttributeKeyInitiator, suite.Key1Addr.String()),
+		sdk.NewAttribute(types.AttributeKeyAmount, "1000000erc20/usdc"),
+		sdk.NewAttribute(types.AttributeKeySourcePort, transfertypes.PortID),
+		sdk.NewAttribute(types.AttributeKeySourceChannel, "channel-0"),
+		sdk.NewAttribute(types.AttributeKeySequence, "1"),
+		sdk.NewAttribute(types.AttributeKeySuccess, "true"),
+	))
+}
+
+func (suite *IBCTransferTestSuite) TestOnERC20IBCTransferRefunded_ConversionFails() {
+	sequence := suite.transfer(1e6)
+	suite.Require().NoError(suite.transferKeeper.refund(suite.Ctx, suite.transferKeeper.transfers[0]))
+
+	// disable the conversion pair so the refund cannot be converted
+	params := suite.Keeper.GetParams(suite.Ctx)
+	params.EnabledConversionPairs = types.NewConversionPairs()
+	suite.Keeper.SetParams(suite.Ctx, params)
+
+	suite.Keeper.OnERC20IBCTransferRefunded(suite.Ctx, transfertypes.PortID, "channel-0", sequence)
+
+	_, found := suite.Keeper.GetERC20IBCTransfer(suite.Ctx, transfertypes.PortID, "channel-0", sequence)
+	suite.Require().False(found)
+	sender := sdk.AccAddress(suite.Key1Addr.Bytes())
+	suite.Require().Equal(
+		sdk.NewInt64Coin("erc20/usdc", 1e6),
+		suite.BankKeeper.GetBalance(suite.Ctx, sender, "erc20/usdc"),
+		"refunded coins should be left in the initiator account",
+	)
+}
+
+func (suite *IBCTransferTestSuite) TestOnERC20IBCTransferRefunded_NotFound() {
+	suite.Keeper.OnERC20IBCTransferRefunded(suite.Ctx, transfertypes.PortID, "channel-0", 1)
+	suite.EventsDoNotContain(suite.GetEvents(), types.EventTypeIBCTransferERC20Refund)
+}
+
+func (suite *IBCTransferTestSuite) precompileLog(sender common.Address, args ibctransfer.TransferERC20Args) *ethtypes.Log {
+	event := ibctransfer.ABI.Events["IBCTransferERC20"]
+	data, err := event.Inputs.NonIndexed().Pack(args.Amount, args.SourceChannel, args.Receiver, args.TimeoutTimestamp, args.Memo)
+	suite.Require().NoError(err)
+
+	return &ethtypes.Log{
+		Address: common.HexToAddress(registry.IBCTransferContractAddress),
+		Topics:  []common.Hash{event.ID, common.BytesToHash(sender.Bytes()), common.BytesToHash(args.Token.Bytes())},
+		Data:    data,
+	}
+}
+
+func (suite *IBCTransferTestSuite) TestEvmHooks_PostTxProcessing() {
+	args := ibctransfer.TransferERC20Args{
+		Token:            suite.contractAddr.Address,
+		Amount:           big.NewInt(1e6),
+		SourceChannel:    "channel-0",
+		Receiver:         "cosmos1receiver",
+		TimeoutTimestamp: 1e18,
+		Memo:             "memo",
+	}
+	log := suite.precompileLog(suite.Key1Addr.Address, args)
+
+	// logs with the same signature from other contracts are ignored
+	spoofed := *log
+	spoofed.Address = suite.contractAddr.Address
+
+	err := suite.Keeper.EvmHooks().PostTxProcessing(suite.Ctx, nil, &ethtypes.Receipt{
+		Logs: []*ethtypes.Log{&spoofed, log},
+	})
+	suite.Require().NoError(err)
+
+	suite.Require().Len(suite.transferKeeper.transfers, 1)
+	msg := suite.transferKeeper.transfers[0]
+	suite.Require().Equal(sdk.AccAddress(suite.Key1Addr.Bytes()).String(), msg.Sender)
+	suite.Require().Equal(sdk.NewInt64Coin("erc20/usdc", 1e6), msg.Token)
+	suite.Require().Equal(transfertypes.PortID, msg.SourcePort)
+	suite.Require().Equal("memo", msg.Memo)
+
+	_, found := suite.Keeper.GetERC20IBCTransfer(suite.Ctx, transfertypes.PortID, "channel-0", 1)
+	suite.Require().True(found)
+}
+
+func (suite *IBCTransferTestSuite) TestEvmHooks_PostTxProcessing_Error() {
+	args := ibctransfer.TransferERC20Args{
+		Token:            suite.contractAddr.Address,
+		Amount:           big.NewInt(1e11), // more than the balance
+		SourceChannel:    "channel-0",
+		Receiver:         "cosmos1receiver",
+		TimeoutTimestamp: 1e18,
+	}
+
+	err := suite.Keeper.EvmHooks().PostTxProcessing(suite.Ctx, nil, &ethtypes.Receipt{
+		Logs: []*ethtypes.Log{suite.precompileLog(suite.Key1Addr.Address, args)},
+	})
+	suite.Require().ErrorContains(err, "failed to transfer erc20 from ibc transfer precompile")
+	suite.Require().Empty(suite.transferKeeper.transfers)
+}
@@ -0,0 +1,190 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/kava-labs/kava/x/evmutil/types"
+)
+
+// RegisterERC20ConversionPair permissionlessly registers an EVM-native ERC20
+// as a conversion pair with an `erc20/0x...` sdk.Coin denom.
+// If the initiator is not the owner of the ERC20 contract, the
+// ERC20RegistrationDeposit param is burned from the initiator's account.
+func (k Keeper) RegisterERC20ConversionPair(
+	ctx sdk.Context,
+	initiator sdk.AccAddress,
+	contractAddr types.InternalEVMAddress,
+) (types.ConversionPair, error) {
+	denom := types.RegisteredERC20Denom(contractAddr)
+
+	if _, err := k.GetEnabledConversionPairFromERC20Address(ctx, contractAddr); err == nil {
+		return types.ConversionPair{}, errorsmod.Wrapf(types.ErrConversionPairExists, "erc20 %s is already enabled", contractAddr)
+	}
+	if _, found := k.GetDeployedCosmosCoinContractDenom(ctx, contractAddr); found {
+		return types.ConversionPair{}, errorsmod.Wrapf(
+			types.ErrConversionPairExists,
+			"erc20 %s represents a cosmos coin and cannot be registered", contractAddr,
+		)
+	}
+	if k.bankKeeper.HasSupply(ctx, denom) {
+		return types.ConversionPair{}, errorsmod.Wrapf(types.ErrConversionPairExists, "denom %s already has supply", denom)
+	}
+
+	metadata, decimals, err := k.queryERC20DenomMetadata(ctx, contractAddr, denom)
+	if err != nil {
+		return types.ConversionPair{}, err
+	}
+	pair := types.NewRegisteredConversionPair(contractAddr, uint32(decimals))
+
+	deposit := sdk.NewCoins()
+	owner, err := k.QueryERC20Owner(ctx, contractAddr)
+	if err != nil || !owner.Equal(types.BytesToInternalEVMAddress(initiator.Bytes())) {
+		// non-owners, including initiators of non-Ownable contracts, must pay the deposit
+		deposit = k.GetParams(ctx).ERC20RegistrationDeposit
+	}
+	if !deposit.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, initiator, types.ModuleName, deposit); err != nil {
+			return types.ConversionPair{}, errorsmod.Wrap(err, "failed to pay erc20 registration deposit")
+		}
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, deposit); err != nil {
+			return types.ConversionPair{}, err
+		}
+	}
+
+	if err := k.SetRegisteredConversionPair(ctx, pair); err != nil {
+		return types.ConversionPair{}, err
+	}
+	k.bankKeeper.SetDenomMetaData(ctx, metadata)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRegisterERC20ConversionPair,
+		sdk.NewAttribute(types.AttributeKeyInitiator, initiator.String()),
+		sdk.NewAttribute(types.AttributeKeyERC20Address, contractAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyDenom, pair.Denom),
+		sdk.NewAttribute(types.AttributeKeyDeposit, deposit.String()),
+	))
+
+	return pair, nil
+}
+
+// queryERC20DenomMetadata fetches and validates the name, symbol and decimals
+// of an ERC20 contract and returns the bank metadata for its registered denom
+// along with its decimals.
+func (k Keeper) queryERC20DenomMetadata(
+	ctx sdk.Context,
+	contractAddr types.InternalEVMAddress,
+	denom string,
+) (banktypes.Metadata, uint8, error) {
+	name, err := k.QueryERC20Name(ctx, contractAddr)
+	if err != nil {
+		return banktypes.Metadata{}, 0, errorsmod.Wrapf(types.ErrInvalidERC20Metadata, "failed to query name: %s", err)
+	}
+	symbol, err := k.QueryERC20Symbol(ctx, contractAddr)
+	if err != nil {
+		return banktypes.Metadata{}, 0, errorsmod.Wrapf(types.ErrInvalidERC20Metadata, "failed to query symbol: %s", err)
+	}
+	decimals, err := k.QueryERC20Decimals(ctx, contractAddr)
+	if err != nil {
+		return banktypes.Metadata{}, 0, errorsmod.Wrapf(types.ErrInvalidERC20Metadata, "failed to query decimals: %s", err)
+	}
+
+	if decimals > types.MaxRegisteredERC20Decimals {
+		return banktypes.Metadata{}, 0, errorsmod.Wrapf(
+			types.ErrInvalidERC20Metadata,
+			"decimals must be at most %d, found %d", types.MaxRegisteredERC20Decimals, decimals,
+		)
+	}
+
+	metadata := banktypes.Metadata{
+		Description: fmt.Sprintf("Kava EVM ERC20 %s (%s)", name, contractAddr.Hex()),
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: denom, Exponent: 0},
+		},
+		Base:    denom,
+		Display: denom,
+		Name:    name,
+		Symbol:  symbol,
+	}
+	if err := metadata.Validate(); err != nil {
+		return banktypes.Metadata{}, 0, errorsmod.Wrap(types.ErrInvalidERC20Metadata, err.Error())
+	}
+	if name == "" || symbol == "" {
+		return banktypes.Metadata{}, 0, errorsmod.Wrap(types.ErrInvalidERC20Metadata, "name and symbol cannot be empty")
+	}
+
+	return metadata, decimals, nil
+}
+
+// SetRegisteredConversionPair stores a permissionlessly registered conversion pair.
+func (k Keeper) SetRegisteredConversionPair(ctx sdk.Context, pair types.ConversionPair) error {
+	if err := pair.ValidateRegistered(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&pair)
+	store.Set(types.RegisteredConversionPairKey(pair.GetAddress()), bz)
+	return nil
+}
+
+// GetRegisteredConversionPair returns the registered conversion pair for the
+// given ERC20 contract address and a bool indicating if it was found.
+func (k Keeper) GetRegisteredConversionPair(
+	ctx sdk.Context,
+	contractAddr types.InternalEVMAddress,
+) (types.ConversionPair, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.RegisteredConversionPairKey(contractAddr))
+	if bz == nil {
+		return types.ConversionPair{}, false
+	}
+
+	var pair types.ConversionPair
+	k.cdc.MustUnmarshal(bz, &pair)
+	return pair, true
+}
+
+// IterateRegisteredConversionPairs iterates over all registered conversion
+// pairs. If true is returned from the callback, iteration is halted.
+func (k Keeper) IterateRegisteredConversionPairs(ctx sdk.Context, cb func(types.ConversionPair) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.RegisteredConversionPairKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var pair types.ConversionPair
+		k.cdc.MustUnmarshal(iterator.Value(), &pair)
+		if cb(pair) {
+			break
+		}
+	}
+}
+
+// GetAllRegisteredConversionPairs returns all registered conversion pairs.
+func (k Keeper) GetAllRegisteredConversionPairs(ctx sdk.Context) types.ConversionPairs {
+	pairs := types.ConversionPairs{}
+	k.IterateRegisteredConversionPairs(ctx, func(pair types.ConversionPair) bool {
+		pairs = append(pairs, pair)
+		return false
+	})
+	return pairs
+}
+
+// GetDeployedCosmosCoinContractDenom returns the cosmos denom of a deployed
+// ERC20KavaWrappedCosmosCoin contract and a bool indicating if it was found.
+func (k Keeper) GetDeployedCosmosCoinContractDenom(
+	ctx sdk.Context,
+	contractAddr types.InternalEVMAddress,
+) (denom string, found bool) {
+	k.IterateAllDeployedCosmosCoinContracts(ctx, func(c types.DeployedCosmosCoinContract) bool {
+		if c.Address.Equal(contractAddr) {
+			denom, found = c.CosmosDenom, true
+		}
+		return found
+	})
+	return denom, found
+}
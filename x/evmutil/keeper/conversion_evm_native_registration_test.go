@@ -0,0 +1,199 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/kava-labs/kava/x/evmutil/testutil"
+	"github.com/kava-labs/kava/x/evmutil/types"
+)
+
+type RegistrationTestSuite struct {
+	testutil.Suite
+
+	contractAddr types.InternalEVMAddress
+}
+
+func TestRegistrationTestSuite(t *testing.T) {
+	suite.Run(t, new(RegistrationTestSuite))
+}
+
+func (suite *RegistrationTestSuite) SetupTest() {
+	suite.Suite.SetupTest()
+
+	// the first deployed contract is enabled via params, deploy a second one to register
+	suite.DeployERC20()
+	contractAddr, err := suite.Keeper.DeployTestMintableERC20Contract(suite.Ctx, "Community Token", "COMM", 18)
+	suite.Require().NoError(err)
+	suite.contractAddr = contractAddr
+}
+
+func (suite *RegistrationTestSuite) transferOwnership(newOwner sdk.AccAddress) {
+	_, err := suite.Keeper.CallEVM(
+		suite.Ctx,
+		types.ERC20MintableBurnableContract.ABI,
+		types.ModuleEVMAddress,
+		suite.contractAddr,
+		"transferOwnership",
+		common.BytesToAddress(newOwner),
+	)
+	suite.Require().NoError(err)
+}
+
+func (suite *RegistrationTestSuite) TestRegister_Owner() {
+	owner := suite.Addrs[0]
+	suite.transferOwnership(owner)
+
+	pair, err := suite.Keeper.RegisterERC20ConversionPair(suite.Ctx, owner, suite.contractAddr)
+	suite.Require().NoError(err)
+	suite.Require().Equal("erc20/"+suite.contractAddr.Hex(), pair.Denom)
+	suite.Require().Equal(uint32(18), pair.Decimals)
+
+	stored, found := suite.Keeper.GetRegisteredConversionPair(suite.Ctx, suite.contractAddr)
+	suite.Require().True(found)
+	suite.Require().Equal(pair, stored)
+
+	metadata, found := suite.BankKeeper.GetDenomMetaData(suite.Ctx, pair.Denom)
+	suite.Require().True(found)
+	suite.Require().Equal("Community Token", metadata.Name)
+	suite.Require().Equal("COMM", metadata.Symbol)
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		types.EventTypeRegisterERC20ConversionPair,
+		sdk.NewAttribute(types.AttributeKeyInitiator, owner.String()),
+		sdk.NewAttribute(types.AttributeKeyERC20Address, suite.contractAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyDenom, pair.Denom),
+		sdk.NewAttribute(types.AttributeKeyDeposit, ""),
+	))
+}
+
+func (suite *RegistrationTestSuite) TestRegister_NonOwnerPaysDeposit() {
+	initiator := suite.Addrs[1]
+	deposit := suite.Keeper.GetParams(suite.Ctx).ERC20RegistrationDeposit
+	suite.Require().NoError(suite.App.FundAccount(suite.Ctx, initiator, deposit))
+	supplyBefore := suite.BankKeeper.GetSupply(suite.Ctx, "ukava")
+
+	_, err := suite.Keeper.RegisterERC20ConversionPair(suite.Ctx, initiator, suite.contractAddr)
+	suite.Require().NoError(err)
+
+	suite.Require().True(suite.BankKeeper.GetBalance(suite.Ctx, initiator, "ukava").IsZero())
+	supplyAfter := suite.BankKeeper.GetSupply(suite.Ctx, "ukava")
+	suite.Require().Equal(supplyBefore.Sub(deposit[0]), supplyAfter, "deposit should be burned")
+}
+
+func (suite *RegistrationTestSuite) TestRegister_NonOwnerInsufficientDeposit() {
+	_, err := suite.Keeper.RegisterERC20ConversionPair(suite.Ctx, suite.Addrs[1], suite.contractAddr)
+	suite.Require().ErrorContains(err, "failed to pay erc20 registration deposit")
+
+	_, found := suite.Keeper.GetRegisteredConversionPair(suite.Ctx, suite.contractAddr)
+	suite.Require().False(found)
+}
+
+func (suite *RegistrationTestSuite) TestRegister_AlreadyRegistered() {
+	owner := suite.Addrs[0]
+	suite.transferOwnership(owner)
+
+	_, err := suite.Keeper.RegisterERC20ConversionPair(suite.Ctx, owner, suite.contractAddr)
+	suite.Require().NoError(err)
+
+	_, err = suite.Keeper.RegisterERC20ConversionPair(suite.Ctx, owner, suite.contractAddr)
+	suite.Require().ErrorIs(err, types.ErrConversionPairExists)
+}
+
+func (suite *RegistrationTestSuite) TestRegister_AlreadyEnabled() {
+	enabledPair := suite.Keeper.GetParams(suite.Ctx).EnabledConversionPairs[0]
+
+	_, err := suite.Keeper.RegisterERC20ConversionPair(suite.Ctx, suite.Addrs[0], enabledPair.GetAddress())
+	suite.Require().ErrorIs(err, types.ErrConversionPairExists)
+}
+
+func (suite *RegistrationTestSuite) TestRegister_CosmosCoinContract() {
+	token := types.NewAllowedCosmosCoinERC20Token("magic", "Magic coin", "MAGIC", 6)
+	params := suite.Keeper.GetParams(suite.Ctx)
+	params.AllowedCosmosDenoms = append(params.AllowedCosmosDenoms, token)
+	suite.Keeper.SetParams(suite.Ctx, params)
+	contractAddr, err := suite.Keeper.GetOrDeployCosmosCoinERC20Contract(suite.Ctx, token)
+	suite.Require().NoError(err)
+
+	_, err = suite.Keeper.RegisterERC20ConversionPair(suite.Ctx, suite.Addrs[0], contractAddr)
+	suite.Require().ErrorIs(err, types.ErrConversionPairExists)
+}
+
+func (suite *RegistrationTestSuite) TestRegister_InvalidDecimals() {
+	contractAddr, err := suite.Keeper.DeployTestMintableERC20Contract(suite.Ctx, "Too Precise", "PRECISE", 24)
+	suite.Require().NoError(err)
+
+	_, err = suite.Keeper.RegisterERC20ConversionPair(suite.Ctx, suite.Addrs[0], contractAddr)
+	suite.Require().ErrorIs(err, types.ErrInvalidERC20Metadata)
+}
+
+func (suite *RegistrationTestSuite) TestRegister_NotAContract() {
+	_, err := suite.Keeper.RegisterERC20ConversionPair(suite.Ctx, suite.Addrs[0], testutil.RandomInternalEVMAddress())
+	suite.Require().ErrorIs(err, types.ErrInvalidERC20Metadata)
+}
+
+func (suite *RegistrationTestSuite) TestRegister_ConvertRoundTrip() {
+	err := suite.Keeper.MintERC20(suite.Ctx, suite.contractAddr, suite.Key1Addr, big.NewInt(1000))
+	suite.Require().NoError(err)
+
+	owner := suite.Addrs[0]
+	suite.transferOwnership(owner)
+
+	pair, err := suite.Keeper.RegisterERC20ConversionPair(suite.Ctx, owner, suite.contractAddr)
+	suite.Require().NoError(err)
+
+	err = suite.Keeper.ConvertERC20ToCoin(suite.Ctx, suite.Key1Addr, suite.Addrs[2], suite.contractAddr, sdkmath.NewInt(600))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdkmath.NewInt(600), suite.BankKeeper.GetBalance(suite.Ctx, suite.Addrs[2], pair.Denom).Amount)
+
+	err = suite.Keeper.ConvertCoinToERC20(suite.Ctx, suite.Addrs[2], suite.Key1Addr, sdk.NewInt64Coin(pair.Denom, 200))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdkmath.NewInt(400), suite.BankKeeper.GetBalance(suite.Ctx, suite.Addrs[2], pair.Denom).Amount)
+
+	bal := suite.GetERC20BalanceOf(types.ERC20MintableBurnableContract.ABI, suite.contractAddr, suite.Key1Addr)
+	suite.Require().Equal(big.NewInt(600), bal)
+}
+
+// feeOnTransferERC20Bin is the creation bytecode of a minimal ERC20 with balanceOf, transfer and an
+// unrestricted mint, that burns a tenth of every transfer:
+//
+//	selector := calldata[0:4]
+//	balanceOf(a): return sload(a)
+//	transfer(to, amt): require(sload(caller) >= amt); sload(caller) -= amt; sload(to) += amt - amt/10; return true
+//	mint(to, amt): sload(to) += amt
+const feeOnTransferERC20Bin = "606e600c600039606e6000f360003560e01c806370a08231146029578063a9059cbb14603657806340c10f1914" +
+	"6060575b600080fd5b6004355460005260206000f35b33546024358181116024578082033355600a81048103600435" +
+	"805482019055600160005260206000f35b60243560043580548201905500"
+
+func (suite *RegistrationTestSuite) deployFeeOnTransferERC20() types.InternalEVMAddress {
+	nonce, err := suite.AccountKeeper.GetSequence(suite.Ctx, types.ModuleEVMAddress.Bytes())
+	suite.Require().NoError(err)
+	_, err = suite.Keeper.CallEVMWithData(suite.Ctx, types.ModuleEVMAddress, nil, common.FromHex(feeOnTransferERC20Bin))
+	suite.Require().NoError(err)
+	return types.NewInternalEVMAddress(crypto.CreateAddress(types.ModuleEVMAddress, nonce))
+}
+
+func (suite *RegistrationTestSuite) TestRegister_ConvertFeeOnTransfer() {
+	contractAddr := suite.deployFeeOnTransferERC20()
+	pair := types.NewRegisteredConversionPair(contractAddr, 18)
+	suite.Require().NoError(suite.Keeper.SetRegisteredConversionPair(suite.Ctx, pair))
+	suite.Require().NoError(suite.Keeper.MintERC20(suite.Ctx, contractAddr, suite.Key1Addr, big.NewInt(2000)))
+
+	// only the amount received by the module is minted
+	err := suite.Keeper.ConvertERC20ToCoin(suite.Ctx, suite.Key1Addr, suite.Addrs[2], contractAddr, sdkmath.NewInt(1000))
+	suite.Require().NoError(err)
+	suite.Equal(sdkmath.NewInt(900), suite.BankKeeper.GetBalance(suite.Ctx, suite.Addrs[2], pair.Denom).Amount)
+	moduleBal, err := suite.Keeper.QueryERC20BalanceOf(suite.Ctx, contractAddr, types.NewInternalEVMAddress(types.ModuleEVMAddress))
+	suite.Require().NoError(err)
+	suite.Equal(big.NewInt(900), moduleBal)
+
+	// exact conversions fail when the module receives less than the requested coin
+	err = suite.Keeper.ConvertERC20ToExactCoin(suite.Ctx, suite.Key1Addr, suite.Addrs[2], sdk.NewInt64Coin(pair.Denom, 1000))
+	suite.Require().ErrorIs(err, types.ErrBalanceInvariance)
+}
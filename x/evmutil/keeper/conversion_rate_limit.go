@@ -0,0 +1,196 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/evmutil/types"
+)
+
+// TrackConversion returns an error if conversions of the coin's denom in the direction are
+// paused or if converting the coin would exceed the rate limit of its denom. Otherwise the
+// converted amount is added to the volume of the current rate limit window.
+func (k Keeper) TrackConversion(ctx sdk.Context, coin sdk.Coin, direction types.ConversionDirection) error {
+	if k.IsConversionDirectionPaused(ctx, coin.Denom, direction) {
+		return errorsmod.Wrapf(types.ErrConversionPaused, "conversions of %s %s are paused", coin.Denom, direction)
+	}
+
+	limit, found := k.GetConversionRateLimit(ctx, coin.Denom)
+	if !found || !limit.Active {
+		return nil
+	}
+
+	volume := k.GetCurrentConversionVolume(ctx, limit, direction)
+	rollingAmount := rollingConversionVolume(ctx, limit, volume)
+	if rollingAmount.Add(coin.Amount).GT(limit.Limit) {
+		return errorsmod.Wrapf(
+			types.ErrExceedsConversionRateLimit,
+			"converting %s would exceed the limit of %s%s per %s, %s%s already converted",
+			coin, limit.Limit, coin.Denom, limit.TimePeriod, rollingAmount, coin.Denom,
+		)
+	}
+
+	volume.Amount = volume.Amount.Add(coin.Amount)
+	k.SetConversionVolume(ctx, coin.Denom, direction, volume)
+	return nil
+}
+
+// GetCurrentConversionVolume returns the volumes converted in the direction during the current
+// and previous windows of the rate limit. Windows are consecutive time periods starting with the
+// first tracked conversion.
+func (k Keeper) GetCurrentConversionVolume(
+	ctx sdk.Context,
+	limit types.ConversionRateLimit,
+	direction types.ConversionDirection,
+) types.ConversionVolume {
+	volume, found := k.GetConversionVolume(ctx, limit.Denom, direction)
+	if !found {
+		return types.ConversionVolume{
+			Amount:         sdkmath.ZeroInt(),
+			WindowStart:    ctx.BlockTime(),
+			PreviousAmount: sdkmath.ZeroInt(),
+		}
+	}
+	if volume.PreviousAmount.IsNil() {
+		volume.PreviousAmount = sdkmath.ZeroInt()
+	}
+
+	elapsedWindows := ctx.BlockTime().Sub(volume.WindowStart) / limit.TimePeriod
+	if elapsedWindows <= 0 {
+		return volume
+	}
+
+	previousAmount := sdkmath.ZeroInt()
+	if elapsedWindows == 1 {
+		previousAmount = volume.Amount
+	}
+	return types.ConversionVolume{
+		Amount:         sdkmath.ZeroInt(),
+		WindowStart:    volume.WindowStart.Add(elapsedWindows * limit.TimePeriod),
+		PreviousAmount: previousAmount,
+	}
+}
+
+// rollingConversionVolume returns the volume converted within one time period of the block time.
+// The previous window's volume is weighted by the part of it that is still within the rolling
+// window, rounding up so the limit is never exceeded.
+func rollingConversionVolume(
+	ctx sdk.Context,
+	limit types.ConversionRateLimit,
+	volume types.ConversionVolume,
+) sdkmath.Int {
+	remaining := volume.WindowStart.Add(limit.TimePeriod).Sub(ctx.BlockTime())
+	if remaining <= 0 {
+		return volume.Amount
+	}
+
+	previousWeight := sdk.NewDec(int64(remaining)).QuoInt64(int64(limit.TimePeriod))
+	previous := sdk.NewDecFromInt(volume.PreviousAmount).Mul(previousWeight).Ceil().TruncateInt()
+	return volume.Amount.Add(previous)
+}
+
+// GetConversionUtilization returns the rate limit utilization of a denom in the direction.
+func (k Keeper) GetConversionUtilization(
+	ctx sdk.Context,
+	limit types.ConversionRateLimit,
+	direction types.ConversionDirection,
+) types.ConversionUtilization {
+	volume := k.GetCurrentConversionVolume(ctx, limit, direction)
+	rollingAmount := rollingConversionVolume(ctx, limit, volume)
+
+	remaining := limit.Limit.Sub(rollingAmount)
+	if remaining.IsNegative() {
+		// the limit was lowered after the volume was converted
+		remaining = sdkmath.ZeroInt()
+	}
+
+	return types.ConversionUtilization{
+		Direction: direction,
+		Paused:    k.IsConversionDirectionPaused(ctx, limit.Denom, direction),
+		Volume:    rollingAmount,
+		Remaining: remaining,
+		WindowEnd: volume.WindowStart.Add(limit.TimePeriod),
+	}
+}
+
+// SetConversionVolume stores the volume of a denom converted in the direction.
+func (k Keeper) SetConversionVolume(
+	ctx sdk.Context,
+	denom string,
+	direction types.ConversionDirection,
+	volume types.ConversionVolume,
+) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&volume)
+	store.Set(types.ConversionVolumeKey(denom, direction), bz)
+}
+
+// GetConversionVolume returns the stored volume of a denom converted in the
+// direction and a bool indicating if it was found.
+func (k Keeper) GetConversionVolume(
+	ctx sdk.Context,
+	denom string,
+	direction types.ConversionDirection,
+) (types.ConversionVolume, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConversionVolumeKey(denom, direction))
+	if bz == nil {
+		return types.ConversionVolume{}, false
+	}
+
+	var volume types.ConversionVolume
+	k.cdc.MustUnmarshal(bz, &volume)
+	return volume, true
+}
+
+// SetConversionDirectionPaused pauses or unpauses the conversions of a denom in a direction.
+func (k Keeper) SetConversionDirectionPaused(
+	ctx sdk.Context,
+	denom string,
+	direction types.ConversionDirection,
+	paused bool,
+) {
+	store := ctx.KVStore(k.storeKey)
+	if paused {
+		pausedDirection := types.NewPausedConversionDirection(denom, direction)
+		store.Set(types.PausedConversionDirectionKey(denom, direction), k.cdc.MustMarshal(&pausedDirection))
+	} else {
+		store.Delete(types.PausedConversionDirectionKey(denom, direction))
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSetConversionDirectionPaused,
+		sdk.NewAttribute(types.AttributeKeyDenom, denom),
+		sdk.NewAttribute(types.AttributeKeyDirection, direction.String()),
+		sdk.NewAttribute(types.AttributeKeyPaused, fmt.Sprintf("%t", paused)),
+	))
+}
+
+// IsConversionDirectionPaused returns true if conversions of the denom in the direction are paused.
+func (k Keeper) IsConversionDirectionPaused(
+	ctx sdk.Context,
+	denom string,
+	direction types.ConversionDirection,
+) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.PausedConversionDirectionKey(denom, direction))
+}
+
+// GetPausedConversionDirections returns all denoms and directions with paused conversions.
+func (k Keeper) GetPausedConversionDirections(ctx sdk.Context) []types.PausedConversionDirection {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PausedConversionDirectionKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	paused := []types.PausedConversionDirection{}
+	for ; iterator.Valid(); iterator.Next() {
+		var pausedDirection types.PausedConversionDirection
+		k.cdc.MustUnmarshal(iterator.Value(), &pausedDirection)
+		paused = append(paused, pausedDirection)
+	}
+	return paused
+}
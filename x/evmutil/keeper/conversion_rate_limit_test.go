@@ -0,0 +1,167 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/evmutil/keeper"
+	"github.com/kava-labs/kava/x/evmutil/testutil"
+	"github.com/kava-labs/kava/x/evmutil/types"
+)
+
+type rateLimitSuite struct {
+	testutil.Suite
+
+	denom     string
+	initiator sdk.AccAddress
+	receiver  types.InternalEVMAddress
+}
+
+func TestRateLimitSuite(t *testing.T) {
+	suite.Run(t, new(rateLimitSuite))
+}
+
+func (suite *rateLimitSuite) SetupTest() {
+	suite.Suite.SetupTest()
+
+	suite.denom = "hard"
+	suite.initiator = app.RandomAddress()
+	suite.receiver = testutil.RandomInternalEVMAddress()
+
+	params := suite.Keeper.GetParams(suite.Ctx)
+	params.AllowedCosmosDenoms = types.NewAllowedCosmosCoinERC20Tokens(
+		types.NewAllowedCosmosCoinERC20Token(suite.denom, "Kava EVM Hard", "HARD", 6),
+	)
+	params.ConversionRateLimits = types.NewConversionRateLimits(
+		types.NewConversionRateLimit(suite.denom, true, sdkmath.NewInt(1e6), time.Hour),
+	)
+	suite.Keeper.SetParams(suite.Ctx, params)
+
+	err := suite.App.FundAccount(suite.Ctx, suite.initiator, sdk.NewCoins(sdk.NewInt64Coin(suite.denom, 1e10)))
+	suite.Require().NoError(err)
+}
+
+func (suite *rateLimitSuite) convertToERC20(amount int64) error {
+	return suite.Keeper.ConvertCosmosCoinToERC20(suite.Ctx, suite.initiator, suite.receiver, sdk.NewInt64Coin(suite.denom, amount))
+}
+
+func (suite *rateLimitSuite) convertFromERC20(amount int64) error {
+	return suite.Keeper.ConvertCosmosCoinFromERC20(suite.Ctx, suite.receiver, suite.initiator, sdk.NewInt64Coin(suite.denom, amount))
+}
+
+func (suite *rateLimitSuite) TestRateLimit() {
+	startTime := suite.Ctx.BlockTime()
+
+	suite.Require().NoError(suite.convertToERC20(6e5))
+	suite.Require().NoError(suite.convertToERC20(4e5))
+	suite.ErrorIs(suite.convertToERC20(1), types.ErrExceedsConversionRateLimit)
+
+	// directions are limited separately
+	suite.Require().NoError(suite.convertFromERC20(1e6))
+	suite.ErrorIs(suite.convertFromERC20(1), types.ErrExceedsConversionRateLimit)
+
+	utilization := suite.Keeper.GetConversionUtilization(suite.Ctx, suite.mustRateLimit(), types.CONVERSION_DIRECTION_TO_ERC20)
+	suite.True(sdkmath.NewInt(1e6).Equal(utilization.Volume))
+	suite.True(sdkmath.ZeroInt().Equal(utilization.Remaining))
+	suite.Equal(startTime.Add(time.Hour), utilization.WindowEnd)
+
+	// the volume of the previous window is still within the rolling window when the window ends
+	suite.Ctx = suite.Ctx.WithBlockTime(startTime.Add(time.Hour))
+	suite.ErrorIs(suite.convertToERC20(1), types.ErrExceedsConversionRateLimit)
+
+	// half of the previous window's volume has rolled out of the rolling window
+	suite.Ctx = suite.Ctx.WithBlockTime(startTime.Add(90 * time.Minute))
+	suite.Require().NoError(suite.convertToERC20(4e5))
+	suite.ErrorIs(suite.convertToERC20(1e5+1), types.ErrExceedsConversionRateLimit)
+
+	utilization = suite.Keeper.GetConversionUtilization(suite.Ctx, suite.mustRateLimit(), types.CONVERSION_DIRECTION_TO_ERC20)
+	suite.True(sdkmath.NewInt(9e5).Equal(utilization.Volume))
+	suite.True(sdkmath.NewInt(1e5).Equal(utilization.Remaining))
+	suite.Equal(startTime.Add(2*time.Hour), utilization.WindowEnd)
+
+	// the volume is reset once a full time period passes without conversions
+	suite.Ctx = suite.Ctx.WithBlockTime(startTime.Add(3 * time.Hour))
+	utilization = suite.Keeper.GetConversionUtilization(suite.Ctx, suite.mustRateLimit(), types.CONVERSION_DIRECTION_TO_ERC20)
+	suite.True(sdkmath.ZeroInt().Equal(utilization.Volume))
+	suite.True(sdkmath.NewInt(1e6).Equal(utilization.Remaining))
+	suite.Equal(startTime.Add(4*time.Hour), utilization.WindowEnd)
+	suite.Require().NoError(suite.convertToERC20(1e6))
+}
+
+func (suite *rateLimitSuite) TestRateLimit_Inactive() {
+	params := suite.Keeper.GetParams(suite.Ctx)
+	params.ConversionRateLimits[0].Active = false
+	suite.Keeper.SetParams(suite.Ctx, params)
+
+	suite.Require().NoError(suite.convertToERC20(2e6))
+	_, found := suite.Keeper.GetConversionVolume(suite.Ctx, suite.denom, types.CONVERSION_DIRECTION_TO_ERC20)
+	suite.False(found, "inactive rate limits should not track volume")
+}
+
+func (suite *rateLimitSuite) TestSetConversionDirectionPaused() {
+	suite.Require().NoError(suite.convertToERC20(1e5))
+
+	suite.Keeper.SetConversionDirectionPaused(suite.Ctx, suite.denom, types.CONVERSION_DIRECTION_TO_ERC20, true)
+	suite.True(suite.Keeper.IsConversionDirectionPaused(suite.Ctx, suite.denom, types.CONVERSION_DIRECTION_TO_ERC20))
+	suite.False(suite.Keeper.IsConversionDirectionPaused(suite.Ctx, "other", types.CONVERSION_DIRECTION_TO_ERC20), "other denoms should not be paused")
+	suite.Equal(
+		[]types.PausedConversionDirection{types.NewPausedConversionDirection(suite.denom, types.CONVERSION_DIRECTION_TO_ERC20)},
+		suite.Keeper.GetPausedConversionDirections(suite.Ctx),
+	)
+
+	suite.ErrorIs(suite.convertToERC20(1e5), types.ErrConversionPaused)
+	suite.NoError(suite.convertFromERC20(1e5), "other direction should not be paused")
+
+	suite.Keeper.SetConversionDirectionPaused(suite.Ctx, suite.denom, types.CONVERSION_DIRECTION_TO_ERC20, false)
+	suite.Empty(suite.Keeper.GetPausedConversionDirections(suite.Ctx))
+	suite.NoError(suite.convertToERC20(1e5))
+}
+
+func (suite *rateLimitSuite) TestMsgServer_SetConversionDirectionPaused() {
+	msgServer := keeper.NewMsgServerImpl(suite.Keeper)
+
+	msg := types.NewMsgSetConversionDirectionPaused(app.RandomAddress().String(), suite.denom, types.CONVERSION_DIRECTION_FROM_ERC20, true)
+	_, err := msgServer.SetConversionDirectionPaused(sdk.WrapSDKContext(suite.Ctx), &msg)
+	suite.ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	msg.Authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	_, err = msgServer.SetConversionDirectionPaused(sdk.WrapSDKContext(suite.Ctx), &msg)
+	suite.Require().NoError(err)
+	suite.True(suite.Keeper.IsConversionDirectionPaused(suite.Ctx, suite.denom, types.CONVERSION_DIRECTION_FROM_ERC20))
+}
+
+func (suite *rateLimitSuite) TestQueryConversionUtilization() {
+	suite.Require().NoError(suite.convertToERC20(3e5))
+	suite.Keeper.SetConversionDirectionPaused(suite.Ctx, suite.denom, types.CONVERSION_DIRECTION_FROM_ERC20, true)
+
+	queryServer := keeper.NewQueryServerImpl(suite.Keeper)
+	res, err := queryServer.ConversionUtilization(sdk.WrapSDKContext(suite.Ctx), &types.QueryConversionUtilizationRequest{Denom: suite.denom})
+	suite.Require().NoError(err)
+	suite.Equal(suite.mustRateLimit(), res.RateLimit)
+	suite.Require().Len(res.Utilizations, 2)
+
+	suite.Equal(types.CONVERSION_DIRECTION_TO_ERC20, res.Utilizations[0].Direction)
+	suite.False(res.Utilizations[0].Paused)
+	suite.True(sdkmath.NewInt(3e5).Equal(res.Utilizations[0].Volume))
+	suite.True(sdkmath.NewInt(7e5).Equal(res.Utilizations[0].Remaining))
+
+	suite.Equal(types.CONVERSION_DIRECTION_FROM_ERC20, res.Utilizations[1].Direction)
+	suite.True(res.Utilizations[1].Paused)
+	suite.True(sdkmath.ZeroInt().Equal(res.Utilizations[1].Volume))
+
+	_, err = queryServer.ConversionUtilization(sdk.WrapSDKContext(suite.Ctx), &types.QueryConversionUtilizationRequest{Denom: "unlimited"})
+	suite.ErrorContains(err, "no conversion rate limit found")
+}
+
+func (suite *rateLimitSuite) mustRateLimit() types.ConversionRateLimit {
+	limit, found := suite.Keeper.GetConversionRateLimit(suite.Ctx, suite.denom)
+	suite.Require().True(found)
+	return limit
+}
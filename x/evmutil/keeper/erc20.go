@@ -7,6 +7,7 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
@@ -19,7 +20,11 @@ import (
 const (
 	erc20BalanceOfMethod   = "balanceOf"
 	erc20BurnMethod        = "burn"
+	erc20DecimalsMethod    = "decimals"
 	erc20MintMethod        = "mint"
+	erc20NameMethod        = "name"
+	erc20OwnerMethod       = "owner"
+	erc20SymbolMethod      = "symbol"
 	erc20TotalSupplyMethod = "totalSupply"
 )
 
@@ -226,23 +231,120 @@ func (k Keeper) QueryERC20TotalSupply(
 	return unpackERC20ResToBigInt(res, erc20TotalSupplyMethod)
 }
 
+// QueryERC20Name makes a contract call to the name method of the ERC20 contract to get the
+// name of the token.
+func (k Keeper) QueryERC20Name(
+	ctx sdk.Context,
+	contractAddr types.InternalEVMAddress,
+) (string, error) {
+	res, err := k.CallEVM(
+		ctx,
+		types.ERC20MintableBurnableContract.ABI,
+		types.ModuleEVMAddress,
+		contractAddr,
+		erc20NameMethod,
+		// name takes no args
+	)
+	if err != nil {
+		return "", err
+	}
+
+	return unpackERC20ResTo[string](res, erc20NameMethod)
+}
+
+// QueryERC20Symbol makes a contract call to the symbol method of the ERC20 contract to get the
+// symbol of the token.
+func (k Keeper) QueryERC20Symbol(
+	ctx sdk.Context,
+	contractAddr types.InternalEVMAddress,
+) (string, error) {
+	res, err := k.CallEVM(
+		ctx,
+		types.ERC20MintableBurnableContract.ABI,
+		types.ModuleEVMAddress,
+		contractAddr,
+		erc20SymbolMethod,
+		// symbol takes no args
+	)
+	if err != nil {
+		return "", err
+	}
+
+	return unpackERC20ResTo[string](res, erc20SymbolMethod)
+}
+
+// QueryERC20Decimals makes a contract call to the decimals method of the ERC20 contract to get
+// the number of decimals of the token.
+func (k Keeper) QueryERC20Decimals(
+	ctx sdk.Context,
+	contractAddr types.InternalEVMAddress,
+) (uint8, error) {
+	res, err := k.CallEVM(
+		ctx,
+		types.ERC20MintableBurnableContract.ABI,
+		types.ModuleEVMAddress,
+		contractAddr,
+		erc20DecimalsMethod,
+		// decimals takes no args
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return unpackERC20ResTo[uint8](res, erc20DecimalsMethod)
+}
+
+// QueryERC20Owner makes a contract call to the owner method of an Ownable ERC20 contract to get
+// the owner of the token. Contracts that are not Ownable return an error.
+func (k Keeper) QueryERC20Owner(
+	ctx sdk.Context,
+	contractAddr types.InternalEVMAddress,
+) (types.InternalEVMAddress, error) {
+	res, err := k.CallEVM(
+		ctx,
+		types.ERC20MintableBurnableContract.ABI,
+		types.ModuleEVMAddress,
+		contractAddr,
+		erc20OwnerMethod,
+		// owner takes no args
+	)
+	if err != nil {
+		return types.InternalEVMAddress{}, err
+	}
+
+	owner, err := unpackERC20ResTo[common.Address](res, erc20OwnerMethod)
+	if err != nil {
+		return types.InternalEVMAddress{}, err
+	}
+
+	return types.NewInternalEVMAddress(owner), nil
+}
+
 func unpackERC20ResToBigInt(res *evmtypes.MsgEthereumTxResponse, methodName string) (*big.Int, error) {
+	return unpackERC20ResTo[*big.Int](res, methodName)
+}
+
+// unpackERC20ResTo unpacks the single return value of an ERC20 method call
+// into the expected type T.
+func unpackERC20ResTo[T any](res *evmtypes.MsgEthereumTxResponse, methodName string) (T, error) {
+	var zero T
+
 	if res.Failed() {
 		if res.VmError == vm.ErrExecutionReverted.Error() {
 			// Unpacks revert
-			return nil, evmtypes.NewExecErrorWithReason(res.Ret)
+			return zero, evmtypes.NewExecErrorWithReason(res.Ret)
 		}
 
-		return nil, status.Error(codes.Internal, res.VmError)
+		return zero, status.Error(codes.Internal, res.VmError)
 	}
 
 	if len(res.Ret) == 0 {
-		return nil, fmt.Errorf("failed to unpack method %s: expected response to be big.Int but found nil", methodName)
+		return zero, fmt.Errorf("failed to unpack method %s: expected response to be %T but found nil", methodName, zero)
 	}
 
 	anyOutput, err := types.ERC20MintableBurnableContract.ABI.Unpack(methodName, res.Ret)
 	if err != nil {
-		return nil, fmt.Errorf(
+		return zero, fmt.Errorf(
 			"failed to unpack method %v response: %w",
 			methodName,
 			err,
@@ -250,7 +352,7 @@ func unpackERC20ResToBigInt(res *evmtypes.MsgEthereumTxResponse, methodName stri
 	}
 
 	if len(anyOutput) != 1 {
-		return nil, fmt.Errorf(
+		return zero, fmt.Errorf(
 			"invalid ERC20 %v call return outputs %v, expected %v",
 			methodName,
 			len(anyOutput),
@@ -258,14 +360,14 @@ func unpackERC20ResToBigInt(res *evmtypes.MsgEthereumTxResponse, methodName stri
 		)
 	}
 
-	bal, ok := anyOutput[0].(*big.Int)
+	out, ok := anyOutput[0].(T)
 	if !ok {
-		return nil, fmt.Errorf(
+		return zero, fmt.Errorf(
 			"invalid ERC20 return type %T, expected %T",
 			anyOutput[0],
-			&big.Int{},
+			zero,
 		)
 	}
 
-	return bal, nil
+	return out, nil
 }
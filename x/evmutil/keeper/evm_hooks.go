@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+
+	"github.com/kava-labs/kava/precompile/contracts/ibctransfer"
+	"github.com/kava-labs/kava/precompile/registry"
+)
+
+var _ evmtypes.EvmHooks = EvmHooks{}
+
+// EvmHooks processes the logs of successful EVM transactions that request
+// x/evmutil actions through precompiles.
+type EvmHooks struct {
+	k Keeper
+}
+
+// EvmHooks returns the x/evm hooks of the evmutil keeper.
+func (k Keeper) EvmHooks() EvmHooks {
+	return EvmHooks{k}
+}
+
+// PostTxProcessing sends the ICS-20 transfers requested through the ibc
+// transfer precompile. Returning an error reverts the whole EVM transaction.
+func (h EvmHooks) PostTxProcessing(ctx sdk.Context, _ core.Message, receipt *ethtypes.Receipt) error {
+	precompileAddress := common.HexToAddress(registry.IBCTransferContractAddress)
+
+	for _, log := range receipt.Logs {
+		if log.Address != precompileAddress {
+			continue
+		}
+
+		sender, args, found, err := ibctransfer.UnpackIBCTransferERC20Log(log)
+		if err != nil {
+			return err
+		}
+		if !found {
+			continue
+		}
+
+		msg := ibctransfer.NewMsgIBCTransferERC20(sender, args)
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+		if _, err := NewMsgServerImpl(h.k).IBCTransferERC20(sdk.WrapSDKContext(ctx), &msg); err != nil {
+			return errorsmod.Wrap(err, "failed to transfer erc20 from ibc transfer precompile")
+		}
+	}
+
+	return nil
+}
@@ -57,6 +57,40 @@ func (s queryServer) DeployedCosmosCoinContracts(
 	return res, err
 }
 
+// RegisteredConversionPairs gets the ERC20 conversion pairs registered via Msg/RegisterERC20ConversionPair
+func (s queryServer) RegisteredConversionPairs(
+	goCtx context.Context,
+	req *types.QueryRegisteredConversionPairsRequest,
+) (*types.QueryRegisteredConversionPairsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	pairs := make([]types.ConversionPair, 0)
+	pairStore := prefix.NewStore(
+		ctx.KVStore(s.keeper.storeKey),
+		types.RegisteredConversionPairKeyPrefix,
+	)
+
+	pageRes, err := query.Paginate(pairStore, req.Pagination, func(_ []byte, value []byte) error {
+		var pair types.ConversionPair
+		if err := s.keeper.cdc.Unmarshal(value, &pair); err != nil {
+			return err
+		}
+		pairs = append(pairs, pair)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRegisteredConversionPairsResponse{
+		RegisteredConversionPairs: pairs,
+		Pagination:                pageRes,
+	}, nil
+}
+
 // getAllDeployedCosmosCoinContractsPage gets a page of deployed contracts (no filtering)
 func getAllDeployedCosmosCoinContractsPage(
 	k *Keeper, ctx sdk.Context, pagination *query.PageRequest,
@@ -90,6 +124,32 @@ func getAllDeployedCosmosCoinContractsPage(
 	}, nil
 }
 
+// ConversionUtilization returns the rate limit of a denom and its utilization in each conversion direction
+func (s queryServer) ConversionUtilization(
+	ctx context.Context,
+	req *types.QueryConversionUtilizationRequest,
+) (*types.QueryConversionUtilizationResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	limit, found := s.keeper.GetConversionRateLimit(sdkCtx, req.Denom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no conversion rate limit found for %s", req.Denom)
+	}
+
+	utilizations := make([]types.ConversionUtilization, 0, len(types.AllConversionDirections()))
+	for _, direction := range types.AllConversionDirections() {
+		utilizations = append(utilizations, s.keeper.GetConversionUtilization(sdkCtx, limit, direction))
+	}
+
+	return &types.QueryConversionUtilizationResponse{
+		RateLimit:    limit,
+		Utilizations: utilizations,
+	}, nil
+}
+
 func getDeployedCosmosCoinContractsByDenoms(
 	k *Keeper, ctx sdk.Context, denoms []string,
 ) (*types.QueryDeployedCosmosCoinContractsResponse, error) {
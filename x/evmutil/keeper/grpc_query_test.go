@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
+	sdkmath "cosmossdk.io/math"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -52,6 +54,9 @@ func (suite *grpcQueryTestSuite) TestQueryParams() {
 		types.NewConversionPair(testutil.RandomInternalEVMAddress(), "evm-denom"),
 		types.NewConversionPair(testutil.RandomInternalEVMAddress(), "evm-denom2"),
 	)
+	expectedParams.ConversionRateLimits = types.NewConversionRateLimits(
+		types.NewConversionRateLimit("evm-denom", true, sdkmath.NewInt(1e6), time.Hour),
+	)
 	suite.Keeper.SetParams(suite.Ctx, expectedParams)
 
 	params, err := suite.QueryClient.Params(
@@ -186,3 +191,34 @@ func (suite *grpcQueryTestSuite) TestQueryDeployedCosmosCoinContracts() {
 		suite.ErrorContains(err, "maximum of 100 denoms allowed per request")
 	})
 }
+
+func (suite *grpcQueryTestSuite) TestQueryRegisteredConversionPairs() {
+	res, err := suite.QueryClient.RegisteredConversionPairs(
+		context.Background(),
+		&types.QueryRegisteredConversionPairsRequest{},
+	)
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.RegisteredConversionPairs)
+
+	expected := make([]types.ConversionPair, 0, 3)
+	for i := 0; i < 3; i++ {
+		pair := types.NewRegisteredConversionPair(testutil.RandomInternalEVMAddress(), 18)
+		suite.Require().NoError(suite.Keeper.SetRegisteredConversionPair(suite.Ctx, pair))
+		expected = append(expected, pair)
+	}
+
+	res, err = suite.QueryClient.RegisteredConversionPairs(
+		context.Background(),
+		&types.QueryRegisteredConversionPairsRequest{},
+	)
+	suite.Require().NoError(err)
+	suite.Require().ElementsMatch(expected, res.RegisteredConversionPairs)
+
+	res, err = suite.QueryClient.RegisteredConversionPairs(
+		context.Background(),
+		&types.QueryRegisteredConversionPairsRequest{Pagination: &query.PageRequest{Limit: 2}},
+	)
+	suite.Require().NoError(err)
+	suite.Require().Len(res.RegisteredConversionPairs, 2)
+	suite.Require().NotNil(res.Pagination.NextKey)
+}
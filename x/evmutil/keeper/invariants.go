@@ -84,7 +84,7 @@ func CosmosCoinsFullyBackedInvariant(bankK types.BankKeeper, k Keeper) sdk.Invar
 
 	return func(ctx sdk.Context) (string, bool) {
 		k.IterateAllDeployedCosmosCoinContracts(ctx, func(c types.DeployedCosmosCoinContract) bool {
-			moduleBalance := bankK.GetBalance(ctx, maccAddress, c.CosmosDenom).Amount
+			moduleBalance := k.cosmosCoinBank().GetBalance(ctx, maccAddress, c.CosmosDenom).Amount
 			totalSupply, err := k.QueryERC20TotalSupply(ctx, *c.Address)
 			if err != nil {
 				panic(fmt.Sprintf("failed to query total supply for %+v", c))
@@ -5,6 +5,7 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
+	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -17,12 +18,16 @@ import (
 // Keeper of the evmutil store.
 // This keeper stores additional data related to evm accounts.
 type Keeper struct {
-	cdc           codec.Codec
-	storeKey      storetypes.StoreKey
-	paramSubspace paramtypes.Subspace
-	bankKeeper    types.BankKeeper
-	evmKeeper     types.EvmKeeper
-	accountKeeper types.AccountKeeper
+	cdc            codec.Codec
+	storeKey       storetypes.StoreKey
+	paramSubspace  paramtypes.Subspace
+	bankKeeper     types.BankKeeper
+	evmKeeper      types.EvmKeeper
+	accountKeeper  types.AccountKeeper
+	transferKeeper types.TransferKeeper
+	authority      sdk.AccAddress
+
+	cosmosCoinBankKeeper types.CosmosCoinBankKeeper
 }
 
 // NewKeeper creates an evmutil keeper.
@@ -32,10 +37,14 @@ func NewKeeper(
 	params paramtypes.Subspace,
 	bk types.BankKeeper,
 	ak types.AccountKeeper,
+	authority sdk.AccAddress,
 ) Keeper {
 	if !params.HasKeyTable() {
 		params = params.WithKeyTable(types.ParamKeyTable())
 	}
+	if err := sdk.VerifyAddressFormat(authority); err != nil {
+		panic(fmt.Sprintf("invalid authority address: %s", err))
+	}
 
 	return Keeper{
 		cdc:           cdc,
@@ -43,13 +52,44 @@ func NewKeeper(
 		paramSubspace: params,
 		bankKeeper:    bk,
 		accountKeeper: ak,
+		authority:     authority,
 	}
 }
 
+// GetAuthority returns the x/evmutil module's authority.
+func (k Keeper) GetAuthority() sdk.AccAddress {
+	return k.authority
+}
+
 func (k *Keeper) SetEvmKeeper(evmKeeper types.EvmKeeper) {
 	k.evmKeeper = evmKeeper
 }
 
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// SetTransferKeeper sets the IBC transfer keeper used to send converted ERC20s over IBC.
+func (k *Keeper) SetTransferKeeper(transferKeeper types.TransferKeeper) {
+	k.transferKeeper = transferKeeper
+}
+
+// SetCosmosCoinBankKeeper sets the keeper used to lock and unlock cosmos coins
+// converted to ERC20s. x/bank is used if it is not set.
+func (k *Keeper) SetCosmosCoinBankKeeper(cosmosCoinBankKeeper types.CosmosCoinBankKeeper) {
+	k.cosmosCoinBankKeeper = cosmosCoinBankKeeper
+}
+
+// cosmosCoinBank returns the keeper used to lock and unlock cosmos coins
+// converted to ERC20s.
+func (k Keeper) cosmosCoinBank() types.CosmosCoinBankKeeper {
+	if k.cosmosCoinBankKeeper == nil {
+		return k.bankKeeper
+	}
+	return k.cosmosCoinBankKeeper
+}
+
 // GetAllAccounts returns all accounts.
 func (k Keeper) GetAllAccounts(ctx sdk.Context) (accounts []types.Account) {
 	k.IterateAllAccounts(ctx, func(account types.Account) bool {
@@ -3,6 +3,8 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	v2 "github.com/kava-labs/kava/x/evmutil/migrations/v2"
+	v3 "github.com/kava-labs/kava/x/evmutil/migrations/v3"
+	v4 "github.com/kava-labs/kava/x/evmutil/migrations/v4"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -21,3 +23,13 @@ func NewMigrator(keeper Keeper) Migrator {
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	return v2.MigrateStore(ctx, m.keeper.paramSubspace)
 }
+
+// Migrate2to3 migrates from version 2 to 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v3.MigrateStore(ctx, m.keeper.paramSubspace)
+}
+
+// Migrate3to4 migrates from version 3 to 4.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v4.MigrateStore(ctx, m.keeper.paramSubspace)
+}
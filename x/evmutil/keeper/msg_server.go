@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/kava-labs/kava/x/evmutil/types"
 )
@@ -187,3 +189,212 @@ func (s msgServer) ConvertCosmosCoinFromERC20(
 
 	return &types.MsgConvertCosmosCoinFromERC20Response{}, nil
 }
+
+////////////////////////////
+// Permissionless EVM-native asset registration
+////////////////////////////
+
+// RegisterERC20ConversionPair registers an EVM-native ERC20 as a conversion
+// pair without a governance proposal.
+func (s msgServer) RegisterERC20ConversionPair(
+	goCtx context.Context,
+	msg *types.MsgRegisterERC20ConversionPair,
+) (*types.MsgRegisterERC20ConversionPairResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	initiator, err := sdk.AccAddressFromBech32(msg.Initiator)
+	if err != nil {
+		return nil, fmt.Errorf("invalid initiator address: %w", err)
+	}
+
+	contractAddr, err := types.NewInternalEVMAddressFromString(msg.KavaERC20Address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid contract address: %w", err)
+	}
+
+	pair, err := s.keeper.RegisterERC20ConversionPair(ctx, initiator, contractAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Initiator),
+		),
+	)
+
+	return &types.MsgRegisterERC20ConversionPairResponse{Denom: pair.Denom}, nil
+}
+
+////////////////////////////
+// EVM-native assets -> IBC
+////////////////////////////
+
+// IBCTransferERC20 handles a MsgIBCTransferERC20 message to convert Kava EVM
+// tokens to sdk.Coin and send them over IBC.
+func (s msgServer) IBCTransferERC20(
+	goCtx context.Context,
+	msg *types.MsgIBCTransferERC20,
+) (*types.MsgIBCTransferERC20Response, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	initiator, err := types.NewInternalEVMAddressFromString(msg.Initiator)
+	if err != nil {
+		return nil, fmt.Errorf("invalid initiator address: %w", err)
+	}
+
+	contractAddr, err := types.NewInternalEVMAddressFromString(msg.KavaERC20Address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid contract address: %w", err)
+	}
+
+	sequence, err := s.keeper.IBCTransferERC20(
+		ctx,
+		initiator,
+		contractAddr,
+		msg.Amount,
+		msg.SourcePort,
+		msg.SourceChannel,
+		msg.Receiver,
+		msg.TimeoutHeight,
+		msg.TimeoutTimestamp,
+		msg.Memo,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Initiator),
+		),
+	)
+
+	return &types.MsgIBCTransferERC20Response{Sequence: sequence}, nil
+}
+
+////////////////////////////
+// Governance of Cosmos-native asset ERC20s
+////////////////////////////
+
+// UpdateCosmosCoinERC20Metadata handles a MsgUpdateCosmosCoinERC20Metadata message
+// to update the name and symbol of a deployed cosmos coin ERC20.
+func (s msgServer) UpdateCosmosCoinERC20Metadata(
+	goCtx context.Context,
+	msg *types.MsgUpdateCosmosCoinERC20Metadata,
+) (*types.MsgUpdateCosmosCoinERC20MetadataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := s.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := s.keeper.UpdateCosmosCoinERC20Metadata(ctx, msg.CosmosDenom, msg.Name, msg.Symbol); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Authority),
+		),
+	)
+
+	return &types.MsgUpdateCosmosCoinERC20MetadataResponse{}, nil
+}
+
+// SetCosmosCoinConversionPaused handles a MsgSetCosmosCoinConversionPaused message
+// to pause or unpause conversions of a cosmos denom.
+func (s msgServer) SetCosmosCoinConversionPaused(
+	goCtx context.Context,
+	msg *types.MsgSetCosmosCoinConversionPaused,
+) (*types.MsgSetCosmosCoinConversionPausedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := s.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	s.keeper.SetCosmosCoinConversionPaused(ctx, msg.CosmosDenom, msg.Paused)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Authority),
+		),
+	)
+
+	return &types.MsgSetCosmosCoinConversionPausedResponse{}, nil
+}
+
+// MigrateCosmosCoinERC20 handles a MsgMigrateCosmosCoinERC20 message to move a
+// cosmos denom to a newly deployed ERC20 contract.
+func (s msgServer) MigrateCosmosCoinERC20(
+	goCtx context.Context,
+	msg *types.MsgMigrateCosmosCoinERC20,
+) (*types.MsgMigrateCosmosCoinERC20Response, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := s.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	contractAddress, err := s.keeper.MigrateCosmosCoinERC20(ctx, msg.CosmosDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Authority),
+		),
+	)
+
+	return &types.MsgMigrateCosmosCoinERC20Response{ContractAddress: contractAddress.Hex()}, nil
+}
+
+// SetConversionDirectionPaused handles a MsgSetConversionDirectionPaused message
+// to pause or unpause the conversions of a denom in one direction.
+func (s msgServer) SetConversionDirectionPaused(
+	goCtx context.Context,
+	msg *types.MsgSetConversionDirectionPaused,
+) (*types.MsgSetConversionDirectionPausedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := s.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	s.keeper.SetConversionDirectionPaused(ctx, msg.Denom, msg.Direction, msg.Paused)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Authority),
+		),
+	)
+
+	return &types.MsgSetConversionDirectionPausedResponse{}, nil
+}
+
+// validateAuthority returns an error if the address is not the module authority.
+func (s msgServer) validateAuthority(authority string) error {
+	if s.keeper.GetAuthority().String() != authority {
+		return errorsmod.Wrapf(
+			govtypes.ErrInvalidSigner,
+			"invalid authority; expected %s, got %s",
+			s.keeper.GetAuthority(),
+			authority,
+		)
+	}
+	return nil
+}
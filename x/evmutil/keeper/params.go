@@ -2,9 +2,11 @@ package keeper
 
 import (
 	"bytes"
+	"strings"
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/kava-labs/kava/x/evmutil/types"
 )
@@ -32,7 +34,19 @@ func (k Keeper) GetAllowedTokenMetadata(ctx sdk.Context, cosmosDenom string) (ty
 	return types.AllowedCosmosCoinERC20Token{}, false
 }
 
+// GetConversionRateLimit returns the rate limit of a denom and a bool indicating if it was found.
+func (k Keeper) GetConversionRateLimit(ctx sdk.Context, denom string) (types.ConversionRateLimit, bool) {
+	params := k.GetParams(ctx)
+	for _, limit := range params.ConversionRateLimits {
+		if limit.Denom == denom {
+			return limit, true
+		}
+	}
+	return types.ConversionRateLimit{}, false
+}
+
 // GetEnabledConversionPairFromERC20Address returns an ConversionPair from the internal contract address.
+// Both governance enabled and permissionlessly registered conversion pairs are returned.
 func (k Keeper) GetEnabledConversionPairFromERC20Address(
 	ctx sdk.Context,
 	address types.InternalEVMAddress,
@@ -44,10 +58,15 @@ func (k Keeper) GetEnabledConversionPairFromERC20Address(
 		}
 	}
 
+	if pair, found := k.GetRegisteredConversionPair(ctx, address); found {
+		return pair, nil
+	}
+
 	return types.ConversionPair{}, errorsmod.Wrap(types.ErrEVMConversionNotEnabled, address.String())
 }
 
 // GetEnabledConversionPairFromDenom returns an ConversionPair from the sdk.Coin denom.
+// Both governance enabled and permissionlessly registered conversion pairs are returned.
 func (k Keeper) GetEnabledConversionPairFromDenom(
 	ctx sdk.Context,
 	denom string,
@@ -59,5 +78,13 @@ func (k Keeper) GetEnabledConversionPairFromDenom(
 		}
 	}
 
+	if types.IsRegisteredERC20Denom(denom) {
+		address := common.HexToAddress(strings.TrimPrefix(denom, types.RegisteredERC20DenomPrefix))
+		pair, found := k.GetRegisteredConversionPair(ctx, types.NewInternalEVMAddress(address))
+		if found && pair.Denom == denom {
+			return pair, nil
+		}
+	}
+
 	return types.ConversionPair{}, errorsmod.Wrap(types.ErrEVMConversionNotEnabled, denom)
 }
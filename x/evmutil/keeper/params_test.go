@@ -6,6 +6,8 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/kava-labs/kava/x/evmutil/keeper"
 	"github.com/kava-labs/kava/x/evmutil/testutil"
@@ -53,6 +55,7 @@ func (suite *ParamsTestSuite) TestHistoricParamsQuery() {
 		oldParamStore,
 		suite.App.GetBankKeeper(),
 		suite.App.GetAccountKeeper(),
+		authtypes.NewModuleAddress(govtypes.ModuleName),
 	)
 
 	// prior to making GetParams() use GetParamSetIfExists, this would panic.
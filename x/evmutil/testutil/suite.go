@@ -153,6 +153,8 @@ func (suite *Suite) SetupTest() {
 			),
 		),
 		types.NewAllowedCosmosCoinERC20Tokens(),
+		types.DefaultERC20RegistrationDeposit,
+		types.DefaultConversionRateLimits,
 	))
 
 	queryHelper := baseapp.NewQueryServerTestHelper(suite.Ctx, suite.App.InterfaceRegistry())
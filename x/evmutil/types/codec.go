@@ -17,6 +17,12 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgConvertERC20ToCoin{}, "evmutil/MsgConvertERC20ToCoin")
 	legacy.RegisterAminoMsg(cdc, &MsgConvertCosmosCoinToERC20{}, "evmutil/MsgConvertCosmosCoinToERC20")
 	legacy.RegisterAminoMsg(cdc, &MsgConvertCosmosCoinFromERC20{}, "evmutil/MsgConvertCosmosCoinFromERC20")
+	legacy.RegisterAminoMsg(cdc, &MsgRegisterERC20ConversionPair{}, "evmutil/MsgRegisterERC20ConversionPair")
+	legacy.RegisterAminoMsg(cdc, &MsgIBCTransferERC20{}, "evmutil/MsgIBCTransferERC20")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateCosmosCoinERC20Metadata{}, "evmutil/MsgUpdateCosmosERC20Metadata")
+	legacy.RegisterAminoMsg(cdc, &MsgSetCosmosCoinConversionPaused{}, "evmutil/MsgSetCosmosConversionPaused")
+	legacy.RegisterAminoMsg(cdc, &MsgMigrateCosmosCoinERC20{}, "evmutil/MsgMigrateCosmosCoinERC20")
+	legacy.RegisterAminoMsg(cdc, &MsgSetConversionDirectionPaused{}, "evmutil/MsgSetConversionDirectionPaused")
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -25,6 +31,12 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgConvertERC20ToCoin{},
 		&MsgConvertCosmosCoinToERC20{},
 		&MsgConvertCosmosCoinFromERC20{},
+		&MsgRegisterERC20ConversionPair{},
+		&MsgIBCTransferERC20{},
+		&MsgUpdateCosmosCoinERC20Metadata{},
+		&MsgSetCosmosCoinConversionPaused{},
+		&MsgMigrateCosmosCoinERC20{},
+		&MsgSetConversionDirectionPaused{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
@@ -45,6 +46,49 @@ func (pair ConversionPair) Validate() error {
 	return nil
 }
 
+// MaxRegisteredERC20Decimals is the maximum number of decimals an ERC20 may
+// have to be registered as a conversion pair.
+const MaxRegisteredERC20Decimals = 18
+
+// RegisteredERC20Denom returns the sdk.Coin denom of a permissionlessly
+// registered conversion pair for the given ERC20 contract address.
+func RegisteredERC20Denom(address InternalEVMAddress) string {
+	return RegisteredERC20DenomPrefix + address.Hex()
+}
+
+// NewRegisteredConversionPair returns a new ConversionPair for a
+// permissionlessly registered ERC20 with a denom derived from the address.
+func NewRegisteredConversionPair(address InternalEVMAddress, decimals uint32) ConversionPair {
+	pair := NewConversionPair(address, RegisteredERC20Denom(address))
+	pair.Decimals = decimals
+	return pair
+}
+
+// IsRegisteredERC20Denom returns true if the denom has the format of a
+// registered ERC20 conversion pair denom.
+func IsRegisteredERC20Denom(denom string) bool {
+	addr, found := strings.CutPrefix(denom, RegisteredERC20DenomPrefix)
+	return found && common.IsHexAddress(addr)
+}
+
+// ValidateRegistered returns an error if the ConversionPair is invalid or if
+// its denom is not the one derived from its ERC20 address.
+func (pair ConversionPair) ValidateRegistered() error {
+	if err := pair.Validate(); err != nil {
+		return err
+	}
+
+	if expected := RegisteredERC20Denom(pair.GetAddress()); pair.Denom != expected {
+		return fmt.Errorf("registered conversion pair denom must be %s, found %s", expected, pair.Denom)
+	}
+
+	if pair.Decimals > MaxRegisteredERC20Decimals {
+		return fmt.Errorf("registered conversion pair decimals must be at most %d, found %d", MaxRegisteredERC20Decimals, pair.Decimals)
+	}
+
+	return nil
+}
+
 // ConversionPairs defines a slice of ConversionPair.
 type ConversionPairs []ConversionPair
 
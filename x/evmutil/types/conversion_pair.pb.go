@@ -31,6 +31,9 @@ type ConversionPair struct {
 	KavaERC20Address HexBytes `protobuf:"bytes,1,opt,name=kava_erc20_address,json=kavaErc20Address,proto3,casttype=HexBytes" json:"kava_erc20_address,omitempty"`
 	// Denom of the corresponding sdk.Coin
 	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// Decimals of a permissionlessly registered ERC20, unset for pairs enabled
+	// in params
+	Decimals uint32 `protobuf:"varint,3,opt,name=decimals,proto3" json:"decimals,omitempty"`
 }
 
 func (m *ConversionPair) Reset()         { *m = ConversionPair{} }
@@ -124,30 +127,30 @@ func init() {
 }
 
 var fileDescriptor_e1396d08199817d0 = []byte{
-	// 356 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x3c, 0x91, 0xcf, 0x4a, 0xeb, 0x40,
-	0x18, 0xc5, 0x33, 0xf7, 0xf6, 0x96, 0xde, 0xb1, 0x4a, 0x19, 0x8a, 0x84, 0x0a, 0xd3, 0xd8, 0x55,
-	0x15, 0x4c, 0xda, 0xba, 0x73, 0xd7, 0xc6, 0x82, 0x50, 0x10, 0x09, 0xae, 0xdc, 0x84, 0x49, 0x32,
-	0xd4, 0xd0, 0x24, 0x5f, 0xc9, 0xa4, 0xb1, 0x05, 0x1f, 0xc0, 0x95, 0xf8, 0x08, 0x2e, 0x7d, 0x14,
-	0x97, 0x5d, 0xba, 0x2a, 0x35, 0x7d, 0x0b, 0x57, 0x92, 0x49, 0xe8, 0xee, 0x3b, 0xe7, 0x3b, 0xe7,
-	0xc7, 0xfc, 0xc1, 0xe7, 0x33, 0x96, 0x32, 0x83, 0xa7, 0xe1, 0x22, 0xf1, 0x03, 0x23, 0xed, 0x3b,
-	0x3c, 0x61, 0x7d, 0xc3, 0x85, 0x28, 0xe5, 0xb1, 0xf0, 0x21, 0xb2, 0xe7, 0xcc, 0x8f, 0xf5, 0x79,
-	0x0c, 0x09, 0x90, 0x66, 0x9e, 0xd5, 0xcb, 0xac, 0x5e, 0x66, 0x5b, 0xcd, 0x29, 0x4c, 0x41, 0x06,
-	0x8c, 0x7c, 0x2a, 0xb2, 0x9d, 0x67, 0x7c, 0x64, 0xee, 0x21, 0x77, 0xcc, 0x8f, 0xc9, 0x2d, 0x26,
-	0x79, 0xdf, 0xe6, 0xb1, 0x3b, 0xe8, 0xd9, 0xcc, 0xf3, 0x62, 0x2e, 0x84, 0x8a, 0x34, 0xd4, 0xad,
-	0x8f, 0xb4, 0x6c, 0xd3, 0x6e, 0x4c, 0x58, 0xca, 0xc6, 0x96, 0x39, 0xe8, 0x0d, 0x8b, 0xdd, 0xcf,
-	0xa6, 0x5d, 0xbb, 0xe1, 0xcb, 0xd1, 0x2a, 0xe1, 0xc2, 0x6a, 0xe4, 0xdd, 0x71, 0x5e, 0x2d, 0xb7,
-	0xa4, 0x89, 0xff, 0x79, 0x3c, 0x82, 0x50, 0xfd, 0xa3, 0xa1, 0xee, 0x7f, 0xab, 0x10, 0x57, 0x95,
-	0x97, 0xf7, 0xb6, 0xd2, 0x79, 0x45, 0xf8, 0x64, 0x18, 0x04, 0xf0, 0xc4, 0x3d, 0x13, 0x44, 0x08,
-	0xc2, 0x04, 0x3f, 0x92, 0xec, 0x7b, 0x98, 0xf1, 0x88, 0x9c, 0xe2, 0xba, 0x2b, 0x7d, 0xbb, 0x40,
-	0x20, 0x89, 0x38, 0x28, 0xbc, 0xeb, 0xdc, 0x22, 0x04, 0x57, 0x22, 0x16, 0xf2, 0x92, 0x2e, 0x67,
-	0x72, 0x8c, 0xab, 0x62, 0x15, 0x3a, 0x10, 0xa8, 0x7f, 0xa5, 0x5b, 0x2a, 0xd2, 0xc2, 0x35, 0x8f,
-	0xbb, 0x7e, 0xc8, 0x02, 0xa1, 0x56, 0x34, 0xd4, 0x3d, 0xb4, 0xf6, 0xba, 0x38, 0xd0, 0x68, 0xb2,
-	0xfd, 0xa6, 0xe8, 0x23, 0xa3, 0xe8, 0x33, 0xa3, 0x68, 0x9d, 0x51, 0xb4, 0xcd, 0x28, 0x7a, 0xdb,
-	0x51, 0x65, 0xbd, 0xa3, 0xca, 0xd7, 0x8e, 0x2a, 0x0f, 0x67, 0x53, 0x3f, 0x79, 0x5c, 0x38, 0xba,
-	0x0b, 0xa1, 0x91, 0xdf, 0xf5, 0x22, 0x60, 0x8e, 0x90, 0x93, 0xb1, 0xdc, 0xff, 0x4f, 0xb2, 0x9a,
-	0x73, 0xe1, 0x54, 0xe5, 0x13, 0x5f, 0xfe, 0x06, 0x00, 0x00, 0xff, 0xff, 0x95, 0x40, 0xc5, 0x63,
-	0xbc, 0x01, 0x00, 0x00,
+	// 359 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0x31, 0x4b, 0xfb, 0x40,
+	0x18, 0xc6, 0x73, 0xff, 0x7f, 0x2d, 0xed, 0x59, 0xa5, 0x1c, 0x45, 0x4a, 0x85, 0x6b, 0xec, 0x54,
+	0x05, 0x93, 0xb6, 0x6e, 0x6e, 0x6d, 0x2c, 0x08, 0x05, 0x91, 0xe0, 0xe4, 0x12, 0x2e, 0xc9, 0x51,
+	0x43, 0x93, 0x5c, 0xb9, 0x4b, 0x63, 0xfb, 0x0d, 0x9c, 0xc4, 0xd1, 0xd1, 0xd1, 0x8f, 0xe2, 0xd8,
+	0xd1, 0xa9, 0xd4, 0xf4, 0x5b, 0x38, 0x49, 0x2e, 0x21, 0x20, 0xb8, 0xbd, 0xcf, 0xfb, 0xfe, 0xee,
+	0x79, 0x5e, 0xde, 0x83, 0x67, 0x33, 0x12, 0x13, 0x9d, 0xc6, 0xc1, 0x22, 0xf2, 0x7c, 0x3d, 0xee,
+	0xdb, 0x34, 0x22, 0x7d, 0xdd, 0x61, 0x61, 0x4c, 0xb9, 0xf0, 0x58, 0x68, 0xcd, 0x89, 0xc7, 0xb5,
+	0x39, 0x67, 0x11, 0x43, 0x8d, 0x94, 0xd5, 0x72, 0x56, 0xcb, 0xd9, 0x56, 0x63, 0xca, 0xa6, 0x4c,
+	0x02, 0x7a, 0x5a, 0x65, 0x6c, 0xe7, 0x15, 0xc0, 0x43, 0xa3, 0x70, 0xb9, 0x25, 0x1e, 0x47, 0x37,
+	0x10, 0xa5, 0x06, 0x16, 0xe5, 0xce, 0xa0, 0x67, 0x11, 0xd7, 0xe5, 0x54, 0x88, 0x26, 0x50, 0x41,
+	0xb7, 0x36, 0x52, 0x93, 0x4d, 0xbb, 0x3e, 0x21, 0x31, 0x19, 0x9b, 0xc6, 0xa0, 0x37, 0xcc, 0x66,
+	0xdf, 0x9b, 0x76, 0xe5, 0x9a, 0x2e, 0x47, 0xab, 0x88, 0x0a, 0xb3, 0x9e, 0xbe, 0x1d, 0x73, 0xa7,
+	0x98, 0xa2, 0x06, 0xdc, 0x73, 0x69, 0xc8, 0x82, 0xe6, 0x3f, 0x15, 0x74, 0xab, 0x66, 0x26, 0x50,
+	0x0b, 0x56, 0x5c, 0xea, 0x78, 0x01, 0xf1, 0x45, 0xf3, 0xbf, 0x0a, 0xba, 0x07, 0x66, 0xa1, 0x2f,
+	0x4b, 0x4f, 0x6f, 0x6d, 0xa5, 0xf3, 0x0c, 0xe0, 0xf1, 0xd0, 0xf7, 0xd9, 0x23, 0x75, 0x0d, 0x26,
+	0x02, 0x26, 0x0c, 0xe6, 0x85, 0x32, 0xf7, 0x8e, 0xcd, 0x68, 0x88, 0x4e, 0x60, 0xcd, 0x91, 0x7d,
+	0x2b, 0xb3, 0x07, 0xd2, 0x7e, 0x3f, 0xeb, 0x5d, 0xc9, 0x10, 0x04, 0x4b, 0x21, 0x09, 0x68, 0x9e,
+	0x2c, 0x6b, 0x74, 0x04, 0xcb, 0x62, 0x15, 0xd8, 0xcc, 0x97, 0xb1, 0x55, 0x33, 0x57, 0xbf, 0x16,
+	0x2a, 0xfd, 0xb5, 0xd0, 0x68, 0xb2, 0xfd, 0xc2, 0xe0, 0x3d, 0xc1, 0xe0, 0x23, 0xc1, 0x60, 0x9d,
+	0x60, 0xb0, 0x4d, 0x30, 0x78, 0xd9, 0x61, 0x65, 0xbd, 0xc3, 0xca, 0xe7, 0x0e, 0x2b, 0xf7, 0xa7,
+	0x53, 0x2f, 0x7a, 0x58, 0xd8, 0x9a, 0xc3, 0x02, 0x3d, 0xbd, 0xc3, 0xb9, 0x4f, 0x6c, 0x21, 0x2b,
+	0x7d, 0x59, 0x7c, 0x5e, 0xb4, 0x9a, 0x53, 0x61, 0x97, 0xe5, 0xfd, 0x2f, 0x7e, 0x06, 0x00, 0x03,
+	0x77, 0xc7, 0xe2, 0xd9, 0x01, 0x00, 0x00,
 }
 
 func (this *ConversionPair) VerboseEqual(that interface{}) error {
@@ -181,6 +184,9 @@ func (this *ConversionPair) VerboseEqual(that interface{}) error {
 	if this.Denom != that1.Denom {
 		return fmt.Errorf("Denom this(%v) Not Equal that(%v)", this.Denom, that1.Denom)
 	}
+	if this.Decimals != that1.Decimals {
+		return fmt.Errorf("Decimals this(%v) Not Equal that(%v)", this.Decimals, that1.Decimals)
+	}
 	return nil
 }
 func (this *ConversionPair) Equal(that interface{}) bool {
@@ -208,6 +214,9 @@ func (this *ConversionPair) Equal(that interface{}) bool {
 	if this.Denom != that1.Denom {
 		return false
 	}
+	if this.Decimals != that1.Decimals {
+		return false
+	}
 	return true
 }
 func (this *AllowedCosmosCoinERC20Token) VerboseEqual(that interface{}) error {
@@ -302,6 +311,11 @@ func (m *ConversionPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Decimals != 0 {
+		i = encodeVarintConversionPair(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Denom) > 0 {
 		i -= len(m.Denom)
 		copy(dAtA[i:], m.Denom)
@@ -393,6 +407,9 @@ func (m *ConversionPair) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovConversionPair(uint64(l))
 	}
+	if m.Decimals != 0 {
+		n += 1 + sovConversionPair(uint64(m.Decimals))
+	}
 	return n
 }
 
@@ -521,6 +538,25 @@ func (m *ConversionPair) Unmarshal(dAtA []byte) error {
 			}
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConversionPair
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipConversionPair(dAtA[iNdEx:])
@@ -12,4 +12,9 @@ var (
 	ErrInvalidCosmosDenom           = errorsmod.Register(ModuleName, 7, "invalid cosmos denom")
 	ErrSDKConversionNotEnabled      = errorsmod.Register(ModuleName, 8, "sdk.Coin not enabled to convert to ERC20 token")
 	ErrInsufficientConversionAmount = errorsmod.Register(ModuleName, 9, "insufficient conversion amount")
+	ErrConversionPairExists         = errorsmod.Register(ModuleName, 10, "conversion pair already exists")
+	ErrInvalidERC20Metadata         = errorsmod.Register(ModuleName, 11, "invalid ERC20 token metadata")
+	ErrIBCTransferNotEnabled        = errorsmod.Register(ModuleName, 12, "ibc transfers are not enabled")
+	ErrConversionPaused             = errorsmod.Register(ModuleName, 13, "sdk.Coin conversions are paused")
+	ErrExceedsConversionRateLimit   = errorsmod.Register(ModuleName, 14, "conversion exceeds rate limit")
 )
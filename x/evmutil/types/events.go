@@ -11,6 +11,15 @@ const (
 	EventTypeConvertCosmosCoinToERC20   = "convert_cosmos_coin_to_erc20"
 	EventTypeConvertCosmosCoinFromERC20 = "convert_cosmos_coin_from_erc20"
 
+	EventTypeRegisterERC20ConversionPair = "register_erc20_conversion_pair"
+
+	EventTypeIBCTransferERC20       = "ibc_transfer_erc20"
+	EventTypeIBCTransferERC20Refund = "ibc_transfer_erc20_refund"
+
+	EventTypeUpdateCosmosCoinERC20Metadata = "update_cosmos_coin_erc20_metadata"
+	EventTypeMigrateCosmosCoinERC20        = "migrate_cosmos_coin_erc20"
+	EventTypeSetConversionDirectionPaused  = "set_conversion_direction_paused"
+
 	// Event Attributes - Common
 	AttributeKeyReceiver = "receiver"
 	AttributeKeyAmount   = "amount"
@@ -18,4 +27,21 @@ const (
 	// Event Attributes - Conversions
 	AttributeKeyInitiator    = "initiator"
 	AttributeKeyERC20Address = "erc20_address"
+
+	// Event Attributes - Registration
+	AttributeKeyDenom   = "denom"
+	AttributeKeyDeposit = "deposit"
+
+	// Event Attributes - IBC transfers
+	AttributeKeySourcePort    = "source_port"
+	AttributeKeySourceChannel = "source_channel"
+	AttributeKeySequence      = "sequence"
+	AttributeKeySuccess       = "success"
+
+	// Event Attributes - Governance
+	AttributeKeyName                 = "name"
+	AttributeKeySymbol               = "symbol"
+	AttributeKeyPaused               = "paused"
+	AttributeKeyPreviousERC20Address = "previous_erc20_address"
+	AttributeKeyDirection            = "direction"
 )
@@ -8,10 +8,11 @@ import (
 )
 
 // NewGenesisState returns a new genesis state object for the module.
-func NewGenesisState(accounts []Account, params Params) *GenesisState {
+func NewGenesisState(accounts []Account, params Params, registeredPairs ConversionPairs) *GenesisState {
 	return &GenesisState{
-		Accounts: accounts,
-		Params:   params,
+		Accounts:                  accounts,
+		Params:                    params,
+		RegisteredConversionPairs: registeredPairs,
 	}
 }
 
@@ -20,6 +21,7 @@ func DefaultGenesisState() *GenesisState {
 	return NewGenesisState(
 		[]Account{},
 		DefaultParams(),
+		ConversionPairs{},
 	)
 }
 
@@ -42,6 +44,34 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	if err := gs.RegisteredConversionPairs.Validate(); err != nil {
+		return err
+	}
+
+	enabledPairs := make(map[string]bool, len(gs.Params.EnabledConversionPairs))
+	for _, pair := range gs.Params.EnabledConversionPairs {
+		enabledPairs[pair.GetAddress().Hex()] = true
+	}
+	for _, pair := range gs.RegisteredConversionPairs {
+		if err := pair.ValidateRegistered(); err != nil {
+			return err
+		}
+		if enabledPairs[pair.GetAddress().Hex()] {
+			return fmt.Errorf("registered conversion pair %s is already an enabled conversion pair", pair.GetAddress())
+		}
+	}
+
+	seenPausedDirections := make(map[PausedConversionDirection]bool, len(gs.PausedConversionDirections))
+	for _, paused := range gs.PausedConversionDirections {
+		if err := paused.Validate(); err != nil {
+			return err
+		}
+		if seenPausedDirections[paused] {
+			return fmt.Errorf("duplicate paused conversion direction %s for %s", paused.Direction, paused.Denom)
+		}
+		seenPausedDirections[paused] = true
+	}
+
 	return nil
 }
 
@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -31,6 +32,12 @@ type GenesisState struct {
 	Accounts []Account `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts"`
 	// params defines all the parameters of the module.
 	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// registered_conversion_pairs defines the ERC20 conversion pairs that were
+	// registered permissionlessly via Msg/RegisterERC20ConversionPair.
+	RegisteredConversionPairs ConversionPairs `protobuf:"bytes,3,rep,name=registered_conversion_pairs,json=registeredConversionPairs,proto3,castrepeated=ConversionPairs" json:"registered_conversion_pairs"`
+	// paused_conversion_directions defines the denoms and conversion directions that
+	// were paused via Msg/SetConversionDirectionPaused or Msg/SetCosmosCoinConversionPaused.
+	PausedConversionDirections []PausedConversionDirection `protobuf:"bytes,5,rep,name=paused_conversion_directions,json=pausedConversionDirections,proto3" json:"paused_conversion_directions"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -114,6 +121,12 @@ type Params struct {
 	// allowed_cosmos_denoms is a list of denom & erc20 token metadata pairs.
 	// if a denom is in the list, it is allowed to be converted to an erc20 in the evm.
 	AllowedCosmosDenoms AllowedCosmosCoinERC20Tokens `protobuf:"bytes,1,rep,name=allowed_cosmos_denoms,json=allowedCosmosDenoms,proto3,castrepeated=AllowedCosmosCoinERC20Tokens" json:"allowed_cosmos_denoms"`
+	// erc20_registration_deposit is the amount burned from the initiator when
+	// registering an ERC20 conversion pair for a contract they do not own.
+	ERC20RegistrationDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=erc20_registration_deposit,json=erc20RegistrationDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"erc20_registration_deposit"`
+	// conversion_rate_limits defines the caps on the amount of each denom that can be
+	// converted in each direction within a time period.
+	ConversionRateLimits ConversionRateLimits `protobuf:"bytes,6,rep,name=conversion_rate_limits,json=conversionRateLimits,proto3,castrepeated=ConversionRateLimits" json:"conversion_rate_limits"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -163,6 +176,20 @@ func (m *Params) GetAllowedCosmosDenoms() AllowedCosmosCoinERC20Tokens {
 	return nil
 }
 
+func (m *Params) GetERC20RegistrationDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ERC20RegistrationDeposit
+	}
+	return nil
+}
+
+func (m *Params) GetConversionRateLimits() ConversionRateLimits {
+	if m != nil {
+		return m.ConversionRateLimits
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "kava.evmutil.v1beta1.GenesisState")
 	proto.RegisterType((*Account)(nil), "kava.evmutil.v1beta1.Account")
@@ -174,38 +201,50 @@ func init() {
 }
 
 var fileDescriptor_d916ab97b8e628c2 = []byte{
-	// 489 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xde, 0xa9, 0x21, 0xd1, 0x69, 0x41, 0xd8, 0x56, 0x8d, 0xa5, 0xce, 0x96, 0x50, 0x24, 0x0a,
-	0xbb, 0x6b, 0xe2, 0xad, 0x08, 0xd2, 0x8d, 0xa2, 0xc5, 0x4b, 0x59, 0xc5, 0x83, 0x97, 0xf0, 0x76,
-	0x77, 0x88, 0x4b, 0x76, 0x67, 0xc2, 0xce, 0x24, 0xb5, 0xff, 0x40, 0xf0, 0xa0, 0xfe, 0x03, 0x8f,
-	0xe2, 0xb9, 0x3f, 0xa2, 0xe0, 0xa5, 0xf4, 0x24, 0x1e, 0x62, 0x4d, 0xfe, 0x85, 0x27, 0xd9, 0x99,
-	0x49, 0xa8, 0x21, 0x8a, 0xa7, 0x9d, 0x7d, 0xf3, 0x7d, 0xef, 0xfb, 0xe6, 0x7b, 0x0f, 0x37, 0xfa,
-	0x30, 0x02, 0x9f, 0x8e, 0xf2, 0xa1, 0x4c, 0x33, 0x7f, 0xd4, 0x8a, 0xa8, 0x84, 0x96, 0xdf, 0xa3,
-	0x8c, 0x8a, 0x54, 0x78, 0x83, 0x82, 0x4b, 0x6e, 0x6f, 0x94, 0x18, 0xcf, 0x60, 0x3c, 0x83, 0xd9,
-	0xbc, 0x19, 0x73, 0x91, 0x73, 0xd1, 0x55, 0x18, 0x5f, 0xff, 0x68, 0xc2, 0xe6, 0x46, 0x8f, 0xf7,
-	0xb8, 0xae, 0x97, 0x27, 0x53, 0xbd, 0xbb, 0x54, 0x2a, 0xe6, 0x6c, 0x44, 0x0b, 0x91, 0x72, 0xd6,
-	0x1d, 0x40, 0x5a, 0x68, 0x6c, 0xe3, 0x23, 0xc2, 0x6b, 0x4f, 0xb4, 0x89, 0xe7, 0x12, 0x24, 0xb5,
-	0x1f, 0xe2, 0xcb, 0x10, 0xc7, 0x7c, 0xc8, 0xa4, 0xa8, 0xa3, 0xed, 0x4b, 0xcd, 0xd5, 0xf6, 0x2d,
-	0x6f, 0x99, 0x2d, 0x6f, 0x4f, 0xa3, 0x82, 0xca, 0xc9, 0xd8, 0xb1, 0xc2, 0x39, 0xc9, 0xde, 0xc5,
-	0xd5, 0x01, 0x14, 0x90, 0x8b, 0xfa, 0xca, 0x36, 0x6a, 0xae, 0xb6, 0xb7, 0x96, 0xd3, 0x0f, 0x14,
-	0xc6, 0xb0, 0x0d, 0x63, 0xb7, 0xf2, 0xf6, 0x93, 0x63, 0x35, 0xbe, 0x22, 0x5c, 0x33, 0xdd, 0xed,
-	0x08, 0xd7, 0x20, 0x49, 0x0a, 0x2a, 0x4a, 0x37, 0xa8, 0xb9, 0x16, 0x3c, 0xfd, 0x35, 0x76, 0xdc,
-	0x5e, 0x2a, 0x5f, 0x0f, 0x23, 0x2f, 0xe6, 0xb9, 0xc9, 0xc3, 0x7c, 0x5c, 0x91, 0xf4, 0x7d, 0x79,
-	0x34, 0xa0, 0xa2, 0xb4, 0xb7, 0xa7, 0x89, 0x67, 0xc7, 0xee, 0xba, 0x49, 0xcd, 0x54, 0x82, 0x23,
-	0x49, 0x45, 0x38, 0x6b, 0x6c, 0xbf, 0xc4, 0xb5, 0x08, 0x32, 0x60, 0x31, 0x55, 0x96, 0xaf, 0x04,
-	0x0f, 0x4a, 0x53, 0xdf, 0xc7, 0xce, 0xed, 0xff, 0xd0, 0xd9, 0x67, 0xf2, 0xec, 0xd8, 0xc5, 0x46,
-	0x60, 0x9f, 0xc9, 0x70, 0xd6, 0xcc, 0xbc, 0xe6, 0xfd, 0x0a, 0xae, 0xea, 0xc7, 0xda, 0x87, 0xb8,
-	0x4e, 0x19, 0x44, 0x19, 0x4d, 0xba, 0x0b, 0xd3, 0x10, 0xf5, 0x8a, 0xca, 0x7a, 0x67, 0x79, 0x58,
-	0x9d, 0x39, 0xfa, 0x00, 0xd2, 0x22, 0xb8, 0x51, 0xfa, 0xfb, 0xf2, 0xc3, 0xb9, 0xfa, 0x67, 0x5d,
-	0x84, 0xd7, 0x4d, 0xfb, 0x85, 0xba, 0xfd, 0x0e, 0xe1, 0x6b, 0x90, 0x65, 0xfc, 0x50, 0x29, 0xab,
-	0x6d, 0x4a, 0x28, 0xe3, 0xf9, 0x6c, 0xc4, 0xad, 0xbf, 0x8c, 0x58, 0x53, 0x3a, 0x8a, 0xd1, 0xe1,
-	0x29, 0x7b, 0x1c, 0x76, 0xda, 0xf7, 0x5e, 0xf0, 0x3e, 0x65, 0xc1, 0x8e, 0xf1, 0xb0, 0xf5, 0x0f,
-	0x90, 0x08, 0xd7, 0xe1, 0xe2, 0xed, 0x23, 0xa5, 0x19, 0x3c, 0x3b, 0xff, 0x49, 0xd0, 0xe7, 0x09,
-	0x41, 0x27, 0x13, 0x82, 0x4e, 0x27, 0x04, 0x9d, 0x4f, 0x08, 0xfa, 0x30, 0x25, 0xd6, 0xe9, 0x94,
-	0x58, 0xdf, 0xa6, 0xc4, 0x7a, 0x75, 0xe7, 0x42, 0xf0, 0xa5, 0x33, 0x37, 0x83, 0x48, 0xa8, 0x93,
-	0xff, 0x66, 0xbe, 0xd8, 0x2a, 0xff, 0xa8, 0xaa, 0xf6, 0xf8, 0xfe, 0xef, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xa1, 0xec, 0x74, 0x78, 0x60, 0x03, 0x00, 0x00,
+	// 676 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcf, 0x4f, 0x13, 0x41,
+	0x14, 0xee, 0x4a, 0x29, 0x38, 0x90, 0x68, 0x96, 0x8a, 0x4b, 0xad, 0xbb, 0xa4, 0x41, 0x53, 0x4c,
+	0xba, 0x0b, 0xf5, 0x46, 0x4c, 0x0c, 0x5b, 0x8c, 0xa2, 0x1c, 0xc8, 0x6a, 0x3c, 0x78, 0x69, 0x66,
+	0xb7, 0x93, 0x3a, 0x61, 0x3b, 0xb3, 0xd9, 0x99, 0x16, 0x39, 0x70, 0x37, 0xf1, 0xe2, 0x9f, 0xe0,
+	0x85, 0xc4, 0x70, 0x26, 0xfe, 0x0d, 0x24, 0x5e, 0x08, 0x27, 0xe3, 0xa1, 0x60, 0xf9, 0x2f, 0x3c,
+	0x99, 0x9d, 0x19, 0xca, 0xd2, 0x2c, 0xca, 0xc1, 0x53, 0xbb, 0xef, 0x7d, 0xdf, 0xf7, 0x7e, 0x7c,
+	0x2f, 0x03, 0x2a, 0x5b, 0xb0, 0x07, 0x1d, 0xd4, 0xeb, 0x74, 0x39, 0x0e, 0x9d, 0xde, 0xb2, 0x8f,
+	0x38, 0x5c, 0x76, 0xda, 0x88, 0x20, 0x86, 0x99, 0x1d, 0xc5, 0x94, 0x53, 0xbd, 0x98, 0x60, 0x6c,
+	0x85, 0xb1, 0x15, 0xa6, 0x64, 0x06, 0x94, 0x75, 0x28, 0x73, 0x7c, 0xc8, 0xd0, 0x90, 0x18, 0x50,
+	0x4c, 0x24, 0xab, 0x34, 0x27, 0xf3, 0x4d, 0xf1, 0xe5, 0xc8, 0x0f, 0x95, 0x2a, 0xb6, 0x69, 0x9b,
+	0xca, 0x78, 0xf2, 0x4f, 0x45, 0x1f, 0x65, 0xb6, 0x12, 0x50, 0xd2, 0x43, 0x31, 0xc3, 0x94, 0x34,
+	0x23, 0x88, 0x63, 0x85, 0x7d, 0x90, 0x89, 0x8d, 0x21, 0x47, 0xcd, 0x10, 0x77, 0x30, 0x97, 0xb0,
+	0xca, 0xde, 0x18, 0x98, 0x7e, 0x2e, 0x67, 0x79, 0xcd, 0x21, 0x47, 0xfa, 0x53, 0x30, 0x09, 0x83,
+	0x80, 0x76, 0x09, 0x67, 0x86, 0x36, 0x3f, 0x56, 0x9d, 0xaa, 0xdf, 0xb7, 0xb3, 0xa6, 0xb3, 0x57,
+	0x25, 0xca, 0xcd, 0x1f, 0xf6, 0xad, 0x9c, 0x37, 0x24, 0xe9, 0x2b, 0xa0, 0x10, 0xc1, 0x18, 0x76,
+	0x98, 0x71, 0x63, 0x5e, 0xab, 0x4e, 0xd5, 0xcb, 0xd9, 0xf4, 0x4d, 0x81, 0x51, 0x6c, 0xc5, 0xd0,
+	0x77, 0xc1, 0xbd, 0x18, 0xb5, 0x31, 0xe3, 0x28, 0x46, 0xad, 0xe6, 0xc8, 0x60, 0xcc, 0x18, 0x13,
+	0xfd, 0x2c, 0x64, 0x0b, 0x36, 0x86, 0xe8, 0x4d, 0x88, 0x63, 0xf7, 0x6e, 0x22, 0xbc, 0x7f, 0x62,
+	0xdd, 0xba, 0x1c, 0x67, 0xde, 0xdc, 0x45, 0x85, 0x91, 0x94, 0xbe, 0x0d, 0xca, 0x11, 0xec, 0xb2,
+	0xcb, 0xa5, 0x5b, 0x38, 0x46, 0x01, 0xc7, 0x94, 0x30, 0x63, 0x5c, 0xd4, 0x77, 0xae, 0x1a, 0xa8,
+	0xcb, 0xd2, 0x92, 0x6b, 0xe7, 0x3c, 0x35, 0x63, 0x29, 0xba, 0x0a, 0xc0, 0x56, 0xf2, 0x1f, 0xbf,
+	0x58, 0xb9, 0x97, 0xf9, 0xc9, 0xfc, 0xed, 0x71, 0xaf, 0x38, 0x6c, 0x41, 0x1c, 0x47, 0x0b, 0x11,
+	0xda, 0x61, 0x95, 0xef, 0x1a, 0x98, 0x50, 0x1b, 0xd7, 0x7d, 0x30, 0x01, 0x5b, 0xad, 0x18, 0xb1,
+	0xc4, 0x21, 0xad, 0x3a, 0xed, 0xbe, 0xf8, 0xdd, 0xb7, 0x6a, 0x6d, 0xcc, 0xdf, 0x77, 0x7d, 0x3b,
+	0xa0, 0x1d, 0x75, 0x4a, 0xea, 0xa7, 0xc6, 0x5a, 0x5b, 0x0e, 0xdf, 0x89, 0x10, 0x4b, 0x2c, 0x5b,
+	0x95, 0xc4, 0xe3, 0x83, 0xda, 0x8c, 0x4c, 0xdb, 0x2a, 0xe2, 0xee, 0x70, 0xc4, 0xbc, 0x73, 0x61,
+	0xfd, 0x2d, 0x98, 0xf0, 0x61, 0x08, 0x49, 0x80, 0x84, 0x8d, 0x37, 0xdd, 0x27, 0xc9, 0x10, 0x3f,
+	0xfb, 0xd6, 0xc3, 0x6b, 0xd4, 0x59, 0x27, 0xfc, 0xf8, 0xa0, 0x06, 0x54, 0x81, 0x75, 0xc2, 0xbd,
+	0x73, 0x31, 0x39, 0x69, 0xe5, 0x5b, 0x1e, 0x14, 0xe4, 0x01, 0xe8, 0xdb, 0xc0, 0x40, 0x04, 0xfa,
+	0x61, 0x96, 0xdf, 0xf9, 0xff, 0xe1, 0xf7, 0xac, 0x92, 0x1f, 0x35, 0xfb, 0x93, 0x06, 0xee, 0xc0,
+	0x30, 0xa4, 0xdb, 0xa3, 0xbb, 0x56, 0x67, 0xbf, 0x7c, 0xc5, 0xd9, 0x4b, 0x4a, 0x43, 0x30, 0x1a,
+	0x14, 0x93, 0x67, 0x5e, 0xa3, 0xbe, 0xf4, 0x86, 0x6e, 0x21, 0xe2, 0x2e, 0xa8, 0x1e, 0xca, 0x7f,
+	0x01, 0x31, 0x6f, 0x06, 0xa6, 0xb3, 0x6b, 0xa2, 0xa6, 0xbe, 0xa7, 0x81, 0x12, 0x8a, 0x83, 0xfa,
+	0x52, 0x53, 0x9e, 0x67, 0x0c, 0xb9, 0x38, 0x3e, 0x14, 0x51, 0x86, 0xb9, 0xba, 0xbc, 0x39, 0x5b,
+	0xad, 0x34, 0x79, 0x51, 0x52, 0x8b, 0xc0, 0xc4, 0xdd, 0x48, 0x4a, 0x0f, 0xfa, 0x96, 0x21, 0x2a,
+	0x79, 0x29, 0x8d, 0x35, 0x29, 0xb1, 0x7f, 0x62, 0x55, 0xaf, 0x61, 0x5d, 0x22, 0xc6, 0x3c, 0x43,
+	0xb4, 0x92, 0xa1, 0xa2, 0xef, 0x82, 0xd9, 0x94, 0x4d, 0x17, 0xcf, 0x09, 0x33, 0x0a, 0xa2, 0xc5,
+	0xc5, 0x7f, 0x99, 0xe5, 0x41, 0x8e, 0x36, 0x12, 0x86, 0x5b, 0x56, 0xdb, 0x2a, 0x66, 0x24, 0x99,
+	0x57, 0x0c, 0x32, 0xa2, 0xee, 0xab, 0xd3, 0x5f, 0xa6, 0xf6, 0x75, 0x60, 0x6a, 0x87, 0x03, 0x53,
+	0x3b, 0x1a, 0x98, 0xda, 0xe9, 0xc0, 0xd4, 0x3e, 0x9f, 0x99, 0xb9, 0xa3, 0x33, 0x33, 0xf7, 0xe3,
+	0xcc, 0xcc, 0xbd, 0x5b, 0x4c, 0x0d, 0x99, 0xb4, 0x52, 0x0b, 0xa1, 0xcf, 0xc4, 0x3f, 0xe7, 0xc3,
+	0xf0, 0x39, 0x14, 0xb3, 0xfa, 0x05, 0xf1, 0x04, 0x3e, 0xfe, 0x33, 0x00, 0x14, 0xee, 0x30, 0xd9,
+	0xe2, 0x05, 0x00, 0x00,
 }
 
 func (this *GenesisState) VerboseEqual(that interface{}) error {
@@ -244,6 +283,22 @@ func (this *GenesisState) VerboseEqual(that interface{}) error {
 	if !this.Params.Equal(&that1.Params) {
 		return fmt.Errorf("Params this(%v) Not Equal that(%v)", this.Params, that1.Params)
 	}
+	if len(this.RegisteredConversionPairs) != len(that1.RegisteredConversionPairs) {
+		return fmt.Errorf("RegisteredConversionPairs this(%v) Not Equal that(%v)", len(this.RegisteredConversionPairs), len(that1.RegisteredConversionPairs))
+	}
+	for i := range this.RegisteredConversionPairs {
+		if !this.RegisteredConversionPairs[i].Equal(&that1.RegisteredConversionPairs[i]) {
+			return fmt.Errorf("RegisteredConversionPairs this[%v](%v) Not Equal that[%v](%v)", i, this.RegisteredConversionPairs[i], i, that1.RegisteredConversionPairs[i])
+		}
+	}
+	if len(this.PausedConversionDirections) != len(that1.PausedConversionDirections) {
+		return fmt.Errorf("PausedConversionDirections this(%v) Not Equal that(%v)", len(this.PausedConversionDirections), len(that1.PausedConversionDirections))
+	}
+	for i := range this.PausedConversionDirections {
+		if !this.PausedConversionDirections[i].Equal(&that1.PausedConversionDirections[i]) {
+			return fmt.Errorf("PausedConversionDirections this[%v](%v) Not Equal that[%v](%v)", i, this.PausedConversionDirections[i], i, that1.PausedConversionDirections[i])
+		}
+	}
 	return nil
 }
 func (this *GenesisState) Equal(that interface{}) bool {
@@ -276,6 +331,22 @@ func (this *GenesisState) Equal(that interface{}) bool {
 	if !this.Params.Equal(&that1.Params) {
 		return false
 	}
+	if len(this.RegisteredConversionPairs) != len(that1.RegisteredConversionPairs) {
+		return false
+	}
+	for i := range this.RegisteredConversionPairs {
+		if !this.RegisteredConversionPairs[i].Equal(&that1.RegisteredConversionPairs[i]) {
+			return false
+		}
+	}
+	if len(this.PausedConversionDirections) != len(that1.PausedConversionDirections) {
+		return false
+	}
+	for i := range this.PausedConversionDirections {
+		if !this.PausedConversionDirections[i].Equal(&that1.PausedConversionDirections[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *Account) VerboseEqual(that interface{}) error {
@@ -379,6 +450,22 @@ func (this *Params) VerboseEqual(that interface{}) error {
 			return fmt.Errorf("AllowedCosmosDenoms this[%v](%v) Not Equal that[%v](%v)", i, this.AllowedCosmosDenoms[i], i, that1.AllowedCosmosDenoms[i])
 		}
 	}
+	if len(this.ERC20RegistrationDeposit) != len(that1.ERC20RegistrationDeposit) {
+		return fmt.Errorf("ERC20RegistrationDeposit this(%v) Not Equal that(%v)", len(this.ERC20RegistrationDeposit), len(that1.ERC20RegistrationDeposit))
+	}
+	for i := range this.ERC20RegistrationDeposit {
+		if !this.ERC20RegistrationDeposit[i].Equal(&that1.ERC20RegistrationDeposit[i]) {
+			return fmt.Errorf("ERC20RegistrationDeposit this[%v](%v) Not Equal that[%v](%v)", i, this.ERC20RegistrationDeposit[i], i, that1.ERC20RegistrationDeposit[i])
+		}
+	}
+	if len(this.ConversionRateLimits) != len(that1.ConversionRateLimits) {
+		return fmt.Errorf("ConversionRateLimits this(%v) Not Equal that(%v)", len(this.ConversionRateLimits), len(that1.ConversionRateLimits))
+	}
+	for i := range this.ConversionRateLimits {
+		if !this.ConversionRateLimits[i].Equal(&that1.ConversionRateLimits[i]) {
+			return fmt.Errorf("ConversionRateLimits this[%v](%v) Not Equal that[%v](%v)", i, this.ConversionRateLimits[i], i, that1.ConversionRateLimits[i])
+		}
+	}
 	return nil
 }
 func (this *Params) Equal(that interface{}) bool {
@@ -416,6 +503,22 @@ func (this *Params) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.ERC20RegistrationDeposit) != len(that1.ERC20RegistrationDeposit) {
+		return false
+	}
+	for i := range this.ERC20RegistrationDeposit {
+		if !this.ERC20RegistrationDeposit[i].Equal(&that1.ERC20RegistrationDeposit[i]) {
+			return false
+		}
+	}
+	if len(this.ConversionRateLimits) != len(that1.ConversionRateLimits) {
+		return false
+	}
+	for i := range this.ConversionRateLimits {
+		if !this.ConversionRateLimits[i].Equal(&that1.ConversionRateLimits[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -438,6 +541,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PausedConversionDirections) > 0 {
+		for iNdEx := len(m.PausedConversionDirections) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PausedConversionDirections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.RegisteredConversionPairs) > 0 {
+		for iNdEx := len(m.RegisteredConversionPairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RegisteredConversionPairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -525,6 +656,34 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ConversionRateLimits) > 0 {
+		for iNdEx := len(m.ConversionRateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConversionRateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ERC20RegistrationDeposit) > 0 {
+		for iNdEx := len(m.ERC20RegistrationDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ERC20RegistrationDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.EnabledConversionPairs) > 0 {
 		for iNdEx := len(m.EnabledConversionPairs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -581,6 +740,18 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.RegisteredConversionPairs) > 0 {
+		for _, e := range m.RegisteredConversionPairs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PausedConversionDirections) > 0 {
+		for _, e := range m.PausedConversionDirections {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -617,6 +788,18 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ERC20RegistrationDeposit) > 0 {
+		for _, e := range m.ERC20RegistrationDeposit {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ConversionRateLimits) > 0 {
+		for _, e := range m.ConversionRateLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -722,6 +905,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredConversionPairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegisteredConversionPairs = append(m.RegisteredConversionPairs, ConversionPair{})
+			if err := m.RegisteredConversionPairs[len(m.RegisteredConversionPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedConversionDirections", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PausedConversionDirections = append(m.PausedConversionDirections, PausedConversionDirection{})
+			if err := m.PausedConversionDirections[len(m.PausedConversionDirections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -958,6 +1209,74 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ERC20RegistrationDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ERC20RegistrationDeposit = append(m.ERC20RegistrationDeposit, types.Coin{})
+			if err := m.ERC20RegistrationDeposit[len(m.ERC20RegistrationDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionRateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConversionRateLimits = append(m.ConversionRateLimits, ConversionRateLimit{})
+			if err := m.ConversionRateLimits[len(m.ConversionRateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
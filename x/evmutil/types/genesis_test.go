@@ -14,10 +14,12 @@ import (
 func TestGenesisState_Validate(t *testing.T) {
 	_, addrs := app.GeneratePrivKeyAddressPairs(2)
 	tests := []struct {
-		name     string
-		accounts []types.Account
-		success  bool
-		params   types.Params
+		name            string
+		accounts        []types.Account
+		success         bool
+		params          types.Params
+		registeredPairs types.ConversionPairs
+		pausedDirs      []types.PausedConversionDirection
 	}{
 		{
 			name: "dup addresses",
@@ -52,9 +54,77 @@ func TestGenesisState_Validate(t *testing.T) {
 					types.NewConversionPair(types.NewInternalEVMAddress(common.HexToAddress("0xinvalidaddress")), "weth"),
 				),
 				types.NewAllowedCosmosCoinERC20Tokens(),
+				types.DefaultERC20RegistrationDeposit,
+				types.DefaultConversionRateLimits,
 			),
 			success: false,
 		},
+		{
+			name: "registered pair with non-derived denom",
+			registeredPairs: types.NewConversionPairs(
+				types.NewConversionPair(types.NewInternalEVMAddress(common.HexToAddress("0x0000000000000000000000000000000000000001")), "weth"),
+			),
+			success: false,
+		},
+		{
+			name: "registered pair with too many decimals",
+			registeredPairs: types.NewConversionPairs(
+				types.NewRegisteredConversionPair(types.NewInternalEVMAddress(common.HexToAddress("0x0000000000000000000000000000000000000001")), 19),
+			),
+			success: false,
+		},
+		{
+			name: "registered pair already enabled in params",
+			params: types.NewParams(
+				types.NewConversionPairs(
+					types.NewConversionPair(types.NewInternalEVMAddress(common.HexToAddress("0x0000000000000000000000000000000000000001")), "weth"),
+				),
+				types.NewAllowedCosmosCoinERC20Tokens(),
+				types.DefaultERC20RegistrationDeposit,
+				types.DefaultConversionRateLimits,
+			),
+			registeredPairs: types.NewConversionPairs(
+				types.NewRegisteredConversionPair(types.NewInternalEVMAddress(common.HexToAddress("0x0000000000000000000000000000000000000001")), 18),
+			),
+			success: false,
+		},
+		{
+			name: "valid registered pairs",
+			registeredPairs: types.NewConversionPairs(
+				types.NewRegisteredConversionPair(types.NewInternalEVMAddress(common.HexToAddress("0x0000000000000000000000000000000000000001")), 18),
+				types.NewRegisteredConversionPair(types.NewInternalEVMAddress(common.HexToAddress("0x0000000000000000000000000000000000000002")), 18),
+			),
+			success: true,
+		},
+		{
+			name:       "invalid paused direction",
+			pausedDirs: []types.PausedConversionDirection{types.NewPausedConversionDirection("hard", types.CONVERSION_DIRECTION_UNSPECIFIED)},
+			success:    false,
+		},
+		{
+			name: "invalid paused direction denom",
+			pausedDirs: []types.PausedConversionDirection{
+				types.NewPausedConversionDirection("!", types.CONVERSION_DIRECTION_TO_ERC20),
+			},
+			success: false,
+		},
+		{
+			name: "duplicate paused direction",
+			pausedDirs: []types.PausedConversionDirection{
+				types.NewPausedConversionDirection("hard", types.CONVERSION_DIRECTION_TO_ERC20),
+				types.NewPausedConversionDirection("hard", types.CONVERSION_DIRECTION_TO_ERC20),
+			},
+			success: false,
+		},
+		{
+			name: "valid paused directions",
+			pausedDirs: []types.PausedConversionDirection{
+				types.NewPausedConversionDirection("hard", types.CONVERSION_DIRECTION_TO_ERC20),
+				types.NewPausedConversionDirection("hard", types.CONVERSION_DIRECTION_FROM_ERC20),
+				types.NewPausedConversionDirection("weth", types.CONVERSION_DIRECTION_TO_ERC20),
+			},
+			success: true,
+		},
 		{
 			name: "valid state",
 			accounts: []types.Account{
@@ -66,7 +136,8 @@ func TestGenesisState_Validate(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			gs := types.NewGenesisState(tt.accounts, tt.params)
+			gs := types.NewGenesisState(tt.accounts, tt.params, tt.registeredPairs)
+			gs.PausedConversionDirections = tt.pausedDirs
 			err := gs.Validate()
 			if tt.success {
 				require.NoError(t, err)
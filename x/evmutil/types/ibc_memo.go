@@ -0,0 +1,49 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// EVMMemoKey is the key of the ICS-20 memo object that requests the
+// conversion of received coins to an ERC20.
+const EVMMemoKey = "evm"
+
+// EVMMemo is the value of the EVMMemoKey in an ICS-20 memo, eg.
+// {"evm":{"receiver":"0x..."}}
+type EVMMemo struct {
+	// Receiver is the 0x address that receives the converted ERC20.
+	Receiver string `json:"receiver"`
+}
+
+// ParseEVMMemo returns the EVM receiver requested by an ICS-20 memo and a bool
+// indicating if the memo requests a conversion. Memos that are not JSON objects
+// or do not contain the EVMMemoKey are ignored. An error is returned if the
+// EVMMemoKey is set but is not valid.
+func ParseEVMMemo(memo string) (InternalEVMAddress, bool, error) {
+	var memoObject map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &memoObject); err != nil {
+		return InternalEVMAddress{}, false, nil
+	}
+
+	raw, found := memoObject[EVMMemoKey]
+	if !found {
+		return InternalEVMAddress{}, false, nil
+	}
+
+	var evmMemo EVMMemo
+	if err := json.Unmarshal(raw, &evmMemo); err != nil {
+		return InternalEVMAddress{}, true, fmt.Errorf("invalid %s memo: %w", EVMMemoKey, err)
+	}
+	if !common.IsHexAddress(evmMemo.Receiver) {
+		return InternalEVMAddress{}, true, fmt.Errorf("invalid %s memo: receiver '%s' is not a hex address", EVMMemoKey, evmMemo.Receiver)
+	}
+	receiver := common.HexToAddress(evmMemo.Receiver)
+	if receiver == (common.Address{}) {
+		return InternalEVMAddress{}, true, fmt.Errorf("invalid %s memo: receiver cannot be the zero address", EVMMemoKey)
+	}
+
+	return NewInternalEVMAddress(receiver), true, nil
+}
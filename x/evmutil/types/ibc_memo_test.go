@@ -0,0 +1,46 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kava-labs/kava/x/evmutil/types"
+)
+
+func TestParseEVMMemo(t *testing.T) {
+	receiver := "0x7Bbf300890857b8c241b219C6a489431669b3aFA"
+
+	testCases := []struct {
+		name          string
+		memo          string
+		expectedFound bool
+		expectedErr   string
+	}{
+		{"valid", `{"evm":{"receiver":"` + receiver + `"}}`, true, ""},
+		{"valid with other keys", `{"wasm":{},"evm":{"receiver":"` + receiver + `"}}`, true, ""},
+		{"empty memo", "", false, ""},
+		{"plain text memo", "hello", false, ""},
+		{"other keys", `{"forward":{"receiver":"` + receiver + `"}}`, false, ""},
+		{"invalid evm object", `{"evm":"` + receiver + `"}`, true, "invalid evm memo"},
+		{"missing receiver", `{"evm":{}}`, true, "is not a hex address"},
+		{"bech32 receiver", `{"evm":{"receiver":"kava1abc"}}`, true, "is not a hex address"},
+		{"zero receiver", `{"evm":{"receiver":"0x0000000000000000000000000000000000000000"}}`, true, "cannot be the zero address"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			addr, found, err := types.ParseEVMMemo(tc.memo)
+			require.Equal(t, tc.expectedFound, found)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			if found {
+				require.Equal(t, types.NewInternalEVMAddress(common.HexToAddress(receiver)), addr)
+			}
+		})
+	}
+}
@@ -23,8 +23,19 @@ var (
 	AccountStoreKeyPrefix = []byte{0x00}
 	// DeployedCosmosCoinContractKeyPrefix is the key for storing deployed KavaWrappedCosmosCoinERC20s contract addresses
 	DeployedCosmosCoinContractKeyPrefix = []byte{0x01}
+	// RegisteredConversionPairKeyPrefix is the prefix for keys that store permissionlessly registered ERC20 conversion pairs
+	RegisteredConversionPairKeyPrefix = []byte{0x02}
+	// ERC20IBCTransferKeyPrefix is the prefix for keys that store in-flight ICS-20 transfers of converted ERC20s
+	ERC20IBCTransferKeyPrefix = []byte{0x03}
+	// PausedConversionDirectionKeyPrefix is the prefix for keys that mark conversions of a denom in a direction as paused
+	PausedConversionDirectionKeyPrefix = []byte{0x05}
+	// ConversionVolumeKeyPrefix is the prefix for keys that store the converted volume of rate limited denoms
+	ConversionVolumeKeyPrefix = []byte{0x06}
 )
 
+// RegisteredERC20DenomPrefix is the prefix of sdk.Coin denoms created for registered ERC20 conversion pairs
+const RegisteredERC20DenomPrefix = "erc20/"
+
 // AccountStoreKey turns an address to a key used to get the account from the store
 func AccountStoreKey(addr sdk.AccAddress) []byte {
 	return append(AccountStoreKeyPrefix, address.MustLengthPrefix(addr)...)
@@ -41,6 +52,34 @@ func DenomFromDeployedCosmosCoinContractKey(key []byte) string {
 	return string(key[1:])
 }
 
+// RegisteredConversionPairKey gives the store key that holds the registered conversion pair
+// for the given ERC20 contract address
+func RegisteredConversionPairKey(contractAddress InternalEVMAddress) []byte {
+	return append(RegisteredConversionPairKeyPrefix, contractAddress.Bytes()...)
+}
+
+// ERC20IBCTransferKey gives the store key that holds the in-flight ICS-20 transfer of a converted
+// ERC20 sent with the given source port, source channel and packet sequence
+func ERC20IBCTransferKey(sourcePort, sourceChannel string, sequence uint64) []byte {
+	key := append(ERC20IBCTransferKeyPrefix, address.MustLengthPrefix([]byte(sourcePort))...)
+	key = append(key, address.MustLengthPrefix([]byte(sourceChannel))...)
+	return append(key, sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// PausedConversionDirectionKey gives the store key that marks conversions of the given denom in
+// the given direction as paused
+func PausedConversionDirectionKey(denom string, direction ConversionDirection) []byte {
+	key := append(PausedConversionDirectionKeyPrefix, address.MustLengthPrefix([]byte(denom))...)
+	return append(key, byte(direction))
+}
+
+// ConversionVolumeKey gives the store key that holds the volume of denom converted in the given
+// direction during the current rate limit window
+func ConversionVolumeKey(denom string, direction ConversionDirection) []byte {
+	key := append(ConversionVolumeKeyPrefix, address.MustLengthPrefix([]byte(denom))...)
+	return append(key, byte(direction))
+}
+
 // ModuleAddress is the native module address for EVM
 var ModuleEVMAddress common.Address
 
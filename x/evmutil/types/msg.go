@@ -1,11 +1,15 @@
 package types
 
 import (
+	"strings"
+
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -20,6 +24,21 @@ var (
 	_ legacytx.LegacyMsg = &MsgConvertCosmosCoinToERC20{}
 	_ sdk.Msg            = &MsgConvertCosmosCoinFromERC20{}
 	_ legacytx.LegacyMsg = &MsgConvertCosmosCoinFromERC20{}
+
+	_ sdk.Msg            = &MsgRegisterERC20ConversionPair{}
+	_ legacytx.LegacyMsg = &MsgRegisterERC20ConversionPair{}
+
+	_ sdk.Msg            = &MsgIBCTransferERC20{}
+	_ legacytx.LegacyMsg = &MsgIBCTransferERC20{}
+
+	_ sdk.Msg            = &MsgUpdateCosmosCoinERC20Metadata{}
+	_ legacytx.LegacyMsg = &MsgUpdateCosmosCoinERC20Metadata{}
+	_ sdk.Msg            = &MsgSetCosmosCoinConversionPaused{}
+	_ legacytx.LegacyMsg = &MsgSetCosmosCoinConversionPaused{}
+	_ sdk.Msg            = &MsgMigrateCosmosCoinERC20{}
+	_ legacytx.LegacyMsg = &MsgMigrateCosmosCoinERC20{}
+	_ sdk.Msg            = &MsgSetConversionDirectionPaused{}
+	_ legacytx.LegacyMsg = &MsgSetConversionDirectionPaused{}
 )
 
 // legacy message types
@@ -29,6 +48,15 @@ const (
 
 	TypeMsgConvertCosmosCoinToERC20   = "evmutil_convert_cosmos_coin_to_erc20"
 	TypeMsgConvertCosmosCoinFromERC20 = "evmutil_convert_cosmos_coin_from_erc20"
+
+	TypeMsgRegisterERC20ConversionPair = "evmutil_register_erc20_conversion_pair"
+
+	TypeMsgIBCTransferERC20 = "evmutil_ibc_transfer_erc20"
+
+	TypeMsgUpdateCosmosCoinERC20Metadata = "evmutil_update_cosmos_coin_erc20_metadata"
+	TypeMsgSetCosmosCoinConversionPaused = "evmutil_set_cosmos_coin_conversion_paused"
+	TypeMsgMigrateCosmosCoinERC20        = "evmutil_migrate_cosmos_coin_erc20"
+	TypeMsgSetConversionDirectionPaused  = "evmutil_set_conversion_direction_paused"
 )
 
 ////////////////////////////
@@ -264,3 +292,319 @@ func (MsgConvertCosmosCoinFromERC20) Route() string { return RouterKey }
 
 // Type implements legacytx.LegacyMsg
 func (MsgConvertCosmosCoinFromERC20) Type() string { return TypeMsgConvertCosmosCoinFromERC20 }
+
+////////////////////////////
+// Permissionless EVM-native asset registration
+////////////////////////////
+
+// NewMsgRegisterERC20ConversionPair returns a new MsgRegisterERC20ConversionPair
+func NewMsgRegisterERC20ConversionPair(
+	initiator string,
+	contractAddr InternalEVMAddress,
+) MsgRegisterERC20ConversionPair {
+	return MsgRegisterERC20ConversionPair{
+		Initiator:        initiator,
+		KavaERC20Address: contractAddr.String(),
+	}
+}
+
+// GetSigners implements types.Msg
+func (msg MsgRegisterERC20ConversionPair) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Initiator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// ValidateBasic implements types.Msg
+func (msg MsgRegisterERC20ConversionPair) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Initiator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid initiator address (%s): %s", msg.Initiator, err.Error())
+	}
+
+	if !common.IsHexAddress(msg.KavaERC20Address) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "erc20 contract address is not a valid hex address (%s)", msg.KavaERC20Address)
+	}
+
+	if common.HexToAddress(msg.KavaERC20Address) == (common.Address{}) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "erc20 contract address cannot be zero address")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements legacytx.LegacyMsg
+func (msg MsgRegisterERC20ConversionPair) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// Route implements legacytx.LegacyMsg
+func (MsgRegisterERC20ConversionPair) Route() string { return RouterKey }
+
+// Type implements legacytx.LegacyMsg
+func (MsgRegisterERC20ConversionPair) Type() string { return TypeMsgRegisterERC20ConversionPair }
+
+////////////////////////////
+// EVM-native assets -> IBC
+////////////////////////////
+
+// NewMsgIBCTransferERC20 returns a new MsgIBCTransferERC20
+func NewMsgIBCTransferERC20(
+	initiator InternalEVMAddress,
+	contractAddr InternalEVMAddress,
+	amount sdkmath.Int,
+	sourcePort, sourceChannel, receiver string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	memo string,
+) MsgIBCTransferERC20 {
+	return MsgIBCTransferERC20{
+		Initiator:        initiator.String(),
+		KavaERC20Address: contractAddr.String(),
+		Amount:           amount,
+		SourcePort:       sourcePort,
+		SourceChannel:    sourceChannel,
+		Receiver:         receiver,
+		TimeoutHeight:    timeoutHeight,
+		TimeoutTimestamp: timeoutTimestamp,
+		Memo:             memo,
+	}
+}
+
+// GetSigners implements types.Msg
+func (msg MsgIBCTransferERC20) GetSigners() []sdk.AccAddress {
+	addr := common.HexToAddress(msg.Initiator)
+	sender := sdk.AccAddress(addr.Bytes())
+	return []sdk.AccAddress{sender}
+}
+
+// ValidateBasic implements types.Msg
+func (msg MsgIBCTransferERC20) ValidateBasic() error {
+	if !common.IsHexAddress(msg.Initiator) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "initiator is not a valid hex address")
+	}
+
+	if !common.IsHexAddress(msg.KavaERC20Address) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "erc20 contract address is not a valid hex address")
+	}
+
+	if msg.Amount.IsNil() || msg.Amount.LTE(sdk.ZeroInt()) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "amount cannot be zero or less")
+	}
+
+	if err := host.PortIdentifierValidator(msg.SourcePort); err != nil {
+		return errorsmod.Wrap(err, "invalid source port ID")
+	}
+	if err := host.ChannelIdentifierValidator(msg.SourceChannel); err != nil {
+		return errorsmod.Wrap(err, "invalid source channel ID")
+	}
+
+	if strings.TrimSpace(msg.Receiver) == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "receiver cannot be blank")
+	}
+
+	if msg.TimeoutHeight.IsZero() && msg.TimeoutTimestamp == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "timeout height and timeout timestamp cannot both be zero")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements legacytx.LegacyMsg
+func (msg MsgIBCTransferERC20) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// Route implements legacytx.LegacyMsg
+func (MsgIBCTransferERC20) Route() string { return RouterKey }
+
+// Type implements legacytx.LegacyMsg
+func (MsgIBCTransferERC20) Type() string { return TypeMsgIBCTransferERC20 }
+
+////////////////////////////
+// Governance of Cosmos-native asset ERC20s
+////////////////////////////
+
+// NewMsgUpdateCosmosCoinERC20Metadata returns a new MsgUpdateCosmosCoinERC20Metadata
+func NewMsgUpdateCosmosCoinERC20Metadata(
+	authority string,
+	cosmosDenom string,
+	name string,
+	symbol string,
+) MsgUpdateCosmosCoinERC20Metadata {
+	return MsgUpdateCosmosCoinERC20Metadata{
+		Authority:   authority,
+		CosmosDenom: cosmosDenom,
+		Name:        name,
+		Symbol:      symbol,
+	}
+}
+
+// GetSigners implements types.Msg
+func (msg MsgUpdateCosmosCoinERC20Metadata) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+// ValidateBasic implements types.Msg
+func (msg MsgUpdateCosmosCoinERC20Metadata) ValidateBasic() error {
+	if err := validateAuthorityAndDenom(msg.Authority, msg.CosmosDenom); err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(msg.Name) == "" {
+		return errorsmod.Wrap(ErrInvalidERC20Metadata, "name cannot be empty")
+	}
+	if strings.TrimSpace(msg.Symbol) == "" {
+		return errorsmod.Wrap(ErrInvalidERC20Metadata, "symbol cannot be empty")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements legacytx.LegacyMsg
+func (msg MsgUpdateCosmosCoinERC20Metadata) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// Route implements legacytx.LegacyMsg
+func (MsgUpdateCosmosCoinERC20Metadata) Route() string { return RouterKey }
+
+// Type implements legacytx.LegacyMsg
+func (MsgUpdateCosmosCoinERC20Metadata) Type() string { return TypeMsgUpdateCosmosCoinERC20Metadata }
+
+// NewMsgSetCosmosCoinConversionPaused returns a new MsgSetCosmosCoinConversionPaused
+func NewMsgSetCosmosCoinConversionPaused(
+	authority string,
+	cosmosDenom string,
+	paused bool,
+) MsgSetCosmosCoinConversionPaused {
+	return MsgSetCosmosCoinConversionPaused{
+		Authority:   authority,
+		CosmosDenom: cosmosDenom,
+		Paused:      paused,
+	}
+}
+
+// GetSigners implements types.Msg
+func (msg MsgSetCosmosCoinConversionPaused) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+// ValidateBasic implements types.Msg
+func (msg MsgSetCosmosCoinConversionPaused) ValidateBasic() error {
+	return validateAuthorityAndDenom(msg.Authority, msg.CosmosDenom)
+}
+
+// GetSignBytes implements legacytx.LegacyMsg
+func (msg MsgSetCosmosCoinConversionPaused) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// Route implements legacytx.LegacyMsg
+func (MsgSetCosmosCoinConversionPaused) Route() string { return RouterKey }
+
+// Type implements legacytx.LegacyMsg
+func (MsgSetCosmosCoinConversionPaused) Type() string { return TypeMsgSetCosmosCoinConversionPaused }
+
+// NewMsgMigrateCosmosCoinERC20 returns a new MsgMigrateCosmosCoinERC20
+func NewMsgMigrateCosmosCoinERC20(authority string, cosmosDenom string) MsgMigrateCosmosCoinERC20 {
+	return MsgMigrateCosmosCoinERC20{
+		Authority:   authority,
+		CosmosDenom: cosmosDenom,
+	}
+}
+
+// GetSigners implements types.Msg
+func (msg MsgMigrateCosmosCoinERC20) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+// ValidateBasic implements types.Msg
+func (msg MsgMigrateCosmosCoinERC20) ValidateBasic() error {
+	return validateAuthorityAndDenom(msg.Authority, msg.CosmosDenom)
+}
+
+// GetSignBytes implements legacytx.LegacyMsg
+func (msg MsgMigrateCosmosCoinERC20) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// Route implements legacytx.LegacyMsg
+func (MsgMigrateCosmosCoinERC20) Route() string { return RouterKey }
+
+// Type implements legacytx.LegacyMsg
+func (MsgMigrateCosmosCoinERC20) Type() string { return TypeMsgMigrateCosmosCoinERC20 }
+
+// NewMsgSetConversionDirectionPaused returns a new MsgSetConversionDirectionPaused
+func NewMsgSetConversionDirectionPaused(
+	authority string,
+	denom string,
+	direction ConversionDirection,
+	paused bool,
+) MsgSetConversionDirectionPaused {
+	return MsgSetConversionDirectionPaused{
+		Authority: authority,
+		Denom:     denom,
+		Direction: direction,
+		Paused:    paused,
+	}
+}
+
+// GetSigners implements types.Msg
+func (msg MsgSetConversionDirectionPaused) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+// ValidateBasic implements types.Msg
+func (msg MsgSetConversionDirectionPaused) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s): %s", msg.Authority, err.Error())
+	}
+
+	if err := NewPausedConversionDirection(msg.Denom, msg.Direction).Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes implements legacytx.LegacyMsg
+func (msg MsgSetConversionDirectionPaused) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// Route implements legacytx.LegacyMsg
+func (MsgSetConversionDirectionPaused) Route() string { return RouterKey }
+
+// Type implements legacytx.LegacyMsg
+func (MsgSetConversionDirectionPaused) Type() string { return TypeMsgSetConversionDirectionPaused }
+
+func validateAuthorityAndDenom(authority string, cosmosDenom string) error {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s): %s", authority, err.Error())
+	}
+
+	if err := sdk.ValidateDenom(cosmosDenom); err != nil {
+		return errorsmod.Wrap(ErrInvalidCosmosDenom, err.Error())
+	}
+
+	return nil
+}
@@ -10,6 +10,7 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
 )
 
 func TestMsgConvertCoinToERC20(t *testing.T) {
@@ -431,3 +432,230 @@ func TestConvertCosmosCoinFromERC20_GetSigners(t *testing.T) {
 		})
 	})
 }
+
+func TestMsgRegisterERC20ConversionPair(t *testing.T) {
+	validKavaAddr := app.RandomAddress()
+	validContractAddr := testutil.RandomInternalEVMAddress()
+
+	testCases := []struct {
+		name         string
+		initiator    string
+		contractAddr string
+		expectedErr  string
+	}{
+		{
+			name:         "valid",
+			initiator:    validKavaAddr.String(),
+			contractAddr: validContractAddr.Hex(),
+			expectedErr:  "",
+		},
+		{
+			name:         "invalid - invalid initiator",
+			initiator:    "invalid",
+			contractAddr: validContractAddr.Hex(),
+			expectedErr:  "invalid initiator address",
+		},
+		{
+			name:         "invalid - 0x initiator",
+			initiator:    testutil.RandomEvmAddress().Hex(),
+			contractAddr: validContractAddr.Hex(),
+			expectedErr:  "invalid initiator address",
+		},
+		{
+			name:         "invalid - invalid contract address",
+			initiator:    validKavaAddr.String(),
+			contractAddr: "0xinvalid",
+			expectedErr:  "erc20 contract address is not a valid hex address",
+		},
+		{
+			name:         "invalid - zero contract address",
+			initiator:    validKavaAddr.String(),
+			contractAddr: "0x0000000000000000000000000000000000000000",
+			expectedErr:  "erc20 contract address cannot be zero address",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.MsgRegisterERC20ConversionPair{
+				Initiator:        tc.initiator,
+				KavaERC20Address: tc.contractAddr,
+			}
+			err := msg.ValidateBasic()
+
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, "evmutil", msg.Route())
+				require.Equal(t, "evmutil_register_erc20_conversion_pair", msg.Type())
+				require.NotPanics(t, func() { _ = msg.GetSignBytes() })
+				require.Equal(t, []sdk.AccAddress{validKavaAddr}, msg.GetSigners())
+			}
+		})
+	}
+}
+
+func TestMsgIBCTransferERC20(t *testing.T) {
+	initiator := testutil.RandomInternalEVMAddress()
+	contractAddr := testutil.RandomInternalEVMAddress()
+	validMsg := func() types.MsgIBCTransferERC20 {
+		return types.NewMsgIBCTransferERC20(
+			initiator,
+			contractAddr,
+			sdkmath.NewInt(1000),
+			"transfer",
+			"channel-0",
+			"cosmos1receiver",
+			clienttypes.ZeroHeight(),
+			1e18,
+			"",
+		)
+	}
+
+	testCases := []struct {
+		name        string
+		malleate    func(msg *types.MsgIBCTransferERC20)
+		expectedErr string
+	}{
+		{
+			name:        "valid - timeout timestamp",
+			malleate:    func(msg *types.MsgIBCTransferERC20) {},
+			expectedErr: "",
+		},
+		{
+			name: "valid - timeout height",
+			malleate: func(msg *types.MsgIBCTransferERC20) {
+				msg.TimeoutHeight = clienttypes.NewHeight(1, 100)
+				msg.TimeoutTimestamp = 0
+			},
+			expectedErr: "",
+		},
+		{
+			name:        "invalid - bech32 initiator",
+			malleate:    func(msg *types.MsgIBCTransferERC20) { msg.Initiator = app.RandomAddress().String() },
+			expectedErr: "initiator is not a valid hex address",
+		},
+		{
+			name:        "invalid - contract address",
+			malleate:    func(msg *types.MsgIBCTransferERC20) { msg.KavaERC20Address = "0xinvalid" },
+			expectedErr: "erc20 contract address is not a valid hex address",
+		},
+		{
+			name:        "invalid - zero amount",
+			malleate:    func(msg *types.MsgIBCTransferERC20) { msg.Amount = sdkmath.ZeroInt() },
+			expectedErr: "amount cannot be zero or less",
+		},
+		{
+			name:        "invalid - source port",
+			malleate:    func(msg *types.MsgIBCTransferERC20) { msg.SourcePort = "" },
+			expectedErr: "invalid source port ID",
+		},
+		{
+			name:        "invalid - source channel",
+			malleate:    func(msg *types.MsgIBCTransferERC20) { msg.SourceChannel = "x" },
+			expectedErr: "invalid source channel ID",
+		},
+		{
+			name:        "invalid - blank receiver",
+			malleate:    func(msg *types.MsgIBCTransferERC20) { msg.Receiver = "  " },
+			expectedErr: "receiver cannot be blank",
+		},
+		{
+			name:        "invalid - no timeout",
+			malleate:    func(msg *types.MsgIBCTransferERC20) { msg.TimeoutTimestamp = 0 },
+			expectedErr: "timeout height and timeout timestamp cannot both be zero",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := validMsg()
+			tc.malleate(&msg)
+			err := msg.ValidateBasic()
+
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, "evmutil", msg.Route())
+				require.Equal(t, "evmutil_ibc_transfer_erc20", msg.Type())
+				require.NotPanics(t, func() { _ = msg.GetSignBytes() })
+				require.Equal(t, []sdk.AccAddress{sdk.AccAddress(initiator.Bytes())}, msg.GetSigners())
+			}
+		})
+	}
+}
+
+func TestMsgUpdateCosmosCoinERC20Metadata(t *testing.T) {
+	validAuthority := app.RandomAddress().String()
+
+	testCases := []struct {
+		name        string
+		msg         types.MsgUpdateCosmosCoinERC20Metadata
+		expectedErr string
+	}{
+		{
+			name: "valid",
+			msg:  types.NewMsgUpdateCosmosCoinERC20Metadata(validAuthority, "ibc/ABC", "Atom", "ATOM"),
+		},
+		{
+			name:        "invalid - invalid authority",
+			msg:         types.NewMsgUpdateCosmosCoinERC20Metadata("invalid", "ibc/ABC", "Atom", "ATOM"),
+			expectedErr: "invalid authority address",
+		},
+		{
+			name:        "invalid - invalid denom",
+			msg:         types.NewMsgUpdateCosmosCoinERC20Metadata(validAuthority, "!", "Atom", "ATOM"),
+			expectedErr: "invalid cosmos denom",
+		},
+		{
+			name:        "invalid - empty name",
+			msg:         types.NewMsgUpdateCosmosCoinERC20Metadata(validAuthority, "ibc/ABC", " ", "ATOM"),
+			expectedErr: "name cannot be empty",
+		},
+		{
+			name:        "invalid - empty symbol",
+			msg:         types.NewMsgUpdateCosmosCoinERC20Metadata(validAuthority, "ibc/ABC", "Atom", ""),
+			expectedErr: "symbol cannot be empty",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgSetCosmosCoinConversionPaused(t *testing.T) {
+	validAuthority := app.RandomAddress().String()
+
+	require.NoError(t, types.NewMsgSetCosmosCoinConversionPaused(validAuthority, "ibc/ABC", true).ValidateBasic())
+	require.ErrorContains(t, types.NewMsgSetCosmosCoinConversionPaused("invalid", "ibc/ABC", true).ValidateBasic(), "invalid authority address")
+	require.ErrorContains(t, types.NewMsgSetCosmosCoinConversionPaused(validAuthority, "", false).ValidateBasic(), "invalid cosmos denom")
+}
+
+func TestMsgMigrateCosmosCoinERC20(t *testing.T) {
+	validAuthority := app.RandomAddress().String()
+
+	require.NoError(t, types.NewMsgMigrateCosmosCoinERC20(validAuthority, "ibc/ABC").ValidateBasic())
+	require.ErrorContains(t, types.NewMsgMigrateCosmosCoinERC20("invalid", "ibc/ABC").ValidateBasic(), "invalid authority address")
+	require.ErrorContains(t, types.NewMsgMigrateCosmosCoinERC20(validAuthority, "!").ValidateBasic(), "invalid cosmos denom")
+}
+
+func TestMsgSetConversionDirectionPaused(t *testing.T) {
+	validAuthority := app.RandomAddress().String()
+
+	require.NoError(t, types.NewMsgSetConversionDirectionPaused(validAuthority, "hard", types.CONVERSION_DIRECTION_TO_ERC20, true).ValidateBasic())
+	require.NoError(t, types.NewMsgSetConversionDirectionPaused(validAuthority, "erc20/0x6B175474E89094C44Da98b954EedeAC495271d0F", types.CONVERSION_DIRECTION_FROM_ERC20, false).ValidateBasic())
+	require.ErrorContains(t, types.NewMsgSetConversionDirectionPaused("invalid", "hard", types.CONVERSION_DIRECTION_TO_ERC20, true).ValidateBasic(), "invalid authority address")
+	require.ErrorContains(t, types.NewMsgSetConversionDirectionPaused(validAuthority, "hard", types.CONVERSION_DIRECTION_UNSPECIFIED, true).ValidateBasic(), "invalid conversion direction")
+	require.ErrorContains(t, types.NewMsgSetConversionDirectionPaused(validAuthority, "", types.CONVERSION_DIRECTION_TO_ERC20, true).ValidateBasic(), "invalid paused conversion denom")
+}
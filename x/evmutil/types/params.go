@@ -1,15 +1,22 @@
 package types
 
 import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 // Parameter keys and default values
 var (
-	KeyEnabledConversionPairs  = []byte("EnabledConversionPairs")
-	DefaultConversionPairs     = ConversionPairs{}
-	KeyAllowedCosmosDenoms     = []byte("AllowedCosmosDenoms")
-	DefaultAllowedCosmosDenoms = AllowedCosmosCoinERC20Tokens{}
+	KeyEnabledConversionPairs       = []byte("EnabledConversionPairs")
+	DefaultConversionPairs          = ConversionPairs{}
+	KeyAllowedCosmosDenoms          = []byte("AllowedCosmosDenoms")
+	DefaultAllowedCosmosDenoms      = AllowedCosmosCoinERC20Tokens{}
+	KeyERC20RegistrationDeposit     = []byte("ERC20RegistrationDeposit")
+	DefaultERC20RegistrationDeposit = sdk.NewCoins(sdk.NewInt64Coin("ukava", 1_000_000_000))
+	KeyConversionRateLimits         = []byte("ConversionRateLimits")
+	DefaultConversionRateLimits     = ConversionRateLimits{}
 )
 
 // ParamKeyTable for evmutil module.
@@ -23,6 +30,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyEnabledConversionPairs, &p.EnabledConversionPairs, validateConversionPairs),
 		paramtypes.NewParamSetPair(KeyAllowedCosmosDenoms, &p.AllowedCosmosDenoms, validateAllowedCosmosCoinERC20Tokens),
+		paramtypes.NewParamSetPair(KeyERC20RegistrationDeposit, &p.ERC20RegistrationDeposit, validateERC20RegistrationDeposit),
+		paramtypes.NewParamSetPair(KeyConversionRateLimits, &p.ConversionRateLimits, validateConversionRateLimits),
 	}
 }
 
@@ -30,10 +39,14 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 func NewParams(
 	conversionPairs ConversionPairs,
 	allowedCosmosDenoms AllowedCosmosCoinERC20Tokens,
+	erc20RegistrationDeposit sdk.Coins,
+	conversionRateLimits ConversionRateLimits,
 ) Params {
 	return Params{
-		EnabledConversionPairs: conversionPairs,
-		AllowedCosmosDenoms:    allowedCosmosDenoms,
+		EnabledConversionPairs:   conversionPairs,
+		AllowedCosmosDenoms:      allowedCosmosDenoms,
+		ERC20RegistrationDeposit: erc20RegistrationDeposit,
+		ConversionRateLimits:     conversionRateLimits,
 	}
 }
 
@@ -42,6 +55,8 @@ func DefaultParams() Params {
 	return NewParams(
 		DefaultConversionPairs,
 		DefaultAllowedCosmosDenoms,
+		DefaultERC20RegistrationDeposit,
+		DefaultConversionRateLimits,
 	)
 }
 
@@ -53,5 +68,22 @@ func (p *Params) Validate() error {
 	if err := p.AllowedCosmosDenoms.Validate(); err != nil {
 		return err
 	}
+	if err := validateERC20RegistrationDeposit(p.ERC20RegistrationDeposit); err != nil {
+		return err
+	}
+	return p.ConversionRateLimits.Validate()
+}
+
+// validateERC20RegistrationDeposit validates an interface as the ERC20RegistrationDeposit param
+func validateERC20RegistrationDeposit(i interface{}) error {
+	deposit, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if !deposit.IsValid() && !deposit.Empty() {
+		return fmt.Errorf("invalid erc20 registration deposit: %s", deposit)
+	}
+
 	return nil
 }
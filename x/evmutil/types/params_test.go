@@ -3,10 +3,12 @@ package types_test
 import (
 	bytes "bytes"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	"sigs.k8s.io/yaml"
 
+	sdkmath "cosmossdk.io/math"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	"github.com/kava-labs/kava/app"
@@ -41,6 +43,8 @@ func (suite *ParamsTestSuite) TestMarshalYAML() {
 	p := types.NewParams(
 		conversionPairs,
 		allowedCosmosDenoms,
+		types.DefaultERC20RegistrationDeposit,
+		types.DefaultConversionRateLimits,
 	)
 
 	data, err := yaml.Marshal(p)
@@ -128,24 +132,39 @@ func (suite *ParamsTestSuite) TestParams_Validate() {
 	}{
 		{
 			name:   "valid - empty",
-			params: types.NewParams(types.NewConversionPairs(), types.NewAllowedCosmosCoinERC20Tokens()),
+			params: types.NewParams(types.NewConversionPairs(), types.NewAllowedCosmosCoinERC20Tokens(), types.DefaultERC20RegistrationDeposit, types.DefaultConversionRateLimits),
 			expErr: "",
 		},
 		{
 			name:   "valid - with data",
-			params: types.NewParams(validConversionPairs, validAllowedCosmosDenoms),
+			params: types.NewParams(validConversionPairs, validAllowedCosmosDenoms, types.DefaultERC20RegistrationDeposit, types.DefaultConversionRateLimits),
 			expErr: "",
 		},
 		{
 			name:   "invalid - invalid conversion pair",
-			params: types.NewParams(invalidConversionPairs, validAllowedCosmosDenoms),
+			params: types.NewParams(invalidConversionPairs, validAllowedCosmosDenoms, types.DefaultERC20RegistrationDeposit, types.DefaultConversionRateLimits),
 			expErr: "found duplicate",
 		},
 		{
 			name:   "invalid - invalid allowed cosmos denoms",
-			params: types.NewParams(validConversionPairs, invalidAllowedCosmosDenoms),
+			params: types.NewParams(validConversionPairs, invalidAllowedCosmosDenoms, types.DefaultERC20RegistrationDeposit, types.DefaultConversionRateLimits),
 			expErr: "invalid token",
 		},
+		{
+			name: "valid - with rate limits",
+			params: types.NewParams(validConversionPairs, validAllowedCosmosDenoms, types.DefaultERC20RegistrationDeposit, types.NewConversionRateLimits(
+				types.NewConversionRateLimit("usdc", true, sdkmath.NewInt(1e12), 24*time.Hour),
+			)),
+			expErr: "",
+		},
+		{
+			name: "invalid - duplicate rate limits",
+			params: types.NewParams(validConversionPairs, validAllowedCosmosDenoms, types.DefaultERC20RegistrationDeposit, types.NewConversionRateLimits(
+				types.NewConversionRateLimit("usdc", true, sdkmath.NewInt(1e12), 24*time.Hour),
+				types.NewConversionRateLimit("usdc", false, sdkmath.NewInt(1e6), time.Hour),
+			)),
+			expErr: "found duplicate rate limit",
+		},
 	}
 
 	for _, tc := range testCases {
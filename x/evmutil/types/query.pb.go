@@ -260,52 +260,275 @@ func (m *DeployedCosmosCoinContract) GetCosmosDenom() string {
 	return ""
 }
 
+// QueryRegisteredConversionPairsRequest defines the request type for Query/RegisteredConversionPairs method.
+type QueryRegisteredConversionPairsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRegisteredConversionPairsRequest) Reset()         { *m = QueryRegisteredConversionPairsRequest{} }
+func (m *QueryRegisteredConversionPairsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRegisteredConversionPairsRequest) ProtoMessage()    {}
+func (*QueryRegisteredConversionPairsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8d0512331709e7, []int{5}
+}
+func (m *QueryRegisteredConversionPairsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRegisteredConversionPairsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRegisteredConversionPairsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRegisteredConversionPairsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRegisteredConversionPairsRequest.Merge(m, src)
+}
+func (m *QueryRegisteredConversionPairsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRegisteredConversionPairsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRegisteredConversionPairsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRegisteredConversionPairsRequest proto.InternalMessageInfo
+
+func (m *QueryRegisteredConversionPairsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryRegisteredConversionPairsResponse defines the response type for the Query/RegisteredConversionPairs method.
+type QueryRegisteredConversionPairsResponse struct {
+	// registered_conversion_pairs is a list of permissionlessly registered ERC20 conversion pairs
+	RegisteredConversionPairs []ConversionPair `protobuf:"bytes,1,rep,name=registered_conversion_pairs,json=registeredConversionPairs,proto3" json:"registered_conversion_pairs"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRegisteredConversionPairsResponse) Reset() {
+	*m = QueryRegisteredConversionPairsResponse{}
+}
+func (m *QueryRegisteredConversionPairsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRegisteredConversionPairsResponse) ProtoMessage()    {}
+func (*QueryRegisteredConversionPairsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8d0512331709e7, []int{6}
+}
+func (m *QueryRegisteredConversionPairsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRegisteredConversionPairsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRegisteredConversionPairsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRegisteredConversionPairsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRegisteredConversionPairsResponse.Merge(m, src)
+}
+func (m *QueryRegisteredConversionPairsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRegisteredConversionPairsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRegisteredConversionPairsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRegisteredConversionPairsResponse proto.InternalMessageInfo
+
+func (m *QueryRegisteredConversionPairsResponse) GetRegisteredConversionPairs() []ConversionPair {
+	if m != nil {
+		return m.RegisteredConversionPairs
+	}
+	return nil
+}
+
+func (m *QueryRegisteredConversionPairsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryConversionUtilizationRequest defines the request type for the Query/ConversionUtilization method.
+type QueryConversionUtilizationRequest struct {
+	// denom of the sdk.Coin to query.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryConversionUtilizationRequest) Reset()         { *m = QueryConversionUtilizationRequest{} }
+func (m *QueryConversionUtilizationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConversionUtilizationRequest) ProtoMessage()    {}
+func (*QueryConversionUtilizationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8d0512331709e7, []int{7}
+}
+func (m *QueryConversionUtilizationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConversionUtilizationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConversionUtilizationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConversionUtilizationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConversionUtilizationRequest.Merge(m, src)
+}
+func (m *QueryConversionUtilizationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConversionUtilizationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConversionUtilizationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConversionUtilizationRequest proto.InternalMessageInfo
+
+func (m *QueryConversionUtilizationRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryConversionUtilizationResponse defines the response type for the Query/ConversionUtilization method.
+type QueryConversionUtilizationResponse struct {
+	// rate_limit is the rate limit of the denom.
+	RateLimit ConversionRateLimit `protobuf:"bytes,1,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit"`
+	// utilizations is the utilization of the rate limit in each conversion direction.
+	Utilizations []ConversionUtilization `protobuf:"bytes,2,rep,name=utilizations,proto3" json:"utilizations"`
+}
+
+func (m *QueryConversionUtilizationResponse) Reset()         { *m = QueryConversionUtilizationResponse{} }
+func (m *QueryConversionUtilizationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConversionUtilizationResponse) ProtoMessage()    {}
+func (*QueryConversionUtilizationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8d0512331709e7, []int{8}
+}
+func (m *QueryConversionUtilizationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConversionUtilizationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConversionUtilizationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConversionUtilizationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConversionUtilizationResponse.Merge(m, src)
+}
+func (m *QueryConversionUtilizationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConversionUtilizationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConversionUtilizationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConversionUtilizationResponse proto.InternalMessageInfo
+
+func (m *QueryConversionUtilizationResponse) GetRateLimit() ConversionRateLimit {
+	if m != nil {
+		return m.RateLimit
+	}
+	return ConversionRateLimit{}
+}
+
+func (m *QueryConversionUtilizationResponse) GetUtilizations() []ConversionUtilization {
+	if m != nil {
+		return m.Utilizations
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "kava.evmutil.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "kava.evmutil.v1beta1.QueryParamsResponse")
 	proto.RegisterType((*QueryDeployedCosmosCoinContractsRequest)(nil), "kava.evmutil.v1beta1.QueryDeployedCosmosCoinContractsRequest")
 	proto.RegisterType((*QueryDeployedCosmosCoinContractsResponse)(nil), "kava.evmutil.v1beta1.QueryDeployedCosmosCoinContractsResponse")
 	proto.RegisterType((*DeployedCosmosCoinContract)(nil), "kava.evmutil.v1beta1.DeployedCosmosCoinContract")
+	proto.RegisterType((*QueryRegisteredConversionPairsRequest)(nil), "kava.evmutil.v1beta1.QueryRegisteredConversionPairsRequest")
+	proto.RegisterType((*QueryRegisteredConversionPairsResponse)(nil), "kava.evmutil.v1beta1.QueryRegisteredConversionPairsResponse")
+	proto.RegisterType((*QueryConversionUtilizationRequest)(nil), "kava.evmutil.v1beta1.QueryConversionUtilizationRequest")
+	proto.RegisterType((*QueryConversionUtilizationResponse)(nil), "kava.evmutil.v1beta1.QueryConversionUtilizationResponse")
 }
 
 func init() { proto.RegisterFile("kava/evmutil/v1beta1/query.proto", fileDescriptor_4a8d0512331709e7) }
 
 var fileDescriptor_4a8d0512331709e7 = []byte{
-	// 542 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4f, 0x6f, 0xd3, 0x30,
-	0x14, 0x8f, 0x0b, 0x14, 0xea, 0x8e, 0x8b, 0xa9, 0xd0, 0xd4, 0x55, 0xe9, 0x08, 0x88, 0x75, 0x48,
-	0x38, 0x5b, 0x41, 0x1c, 0x26, 0x40, 0xa2, 0x1d, 0x20, 0x0e, 0x48, 0x2c, 0x07, 0x0e, 0x5c, 0x2a,
-	0x27, 0xb1, 0x42, 0x44, 0x6a, 0xa7, 0xb1, 0x5b, 0x51, 0x71, 0x83, 0x0b, 0x47, 0x24, 0xbe, 0x40,
-	0x3f, 0xce, 0x8e, 0x93, 0xb8, 0xa0, 0x1d, 0x26, 0xd4, 0x72, 0x40, 0x9c, 0xf8, 0x08, 0xa8, 0xb6,
-	0xbb, 0x15, 0x91, 0xb6, 0x88, 0x9b, 0xf5, 0xfc, 0x7b, 0xfe, 0xfd, 0x79, 0x2f, 0x81, 0x9b, 0x6f,
-	0xc8, 0x80, 0xb8, 0x74, 0xd0, 0xed, 0xcb, 0x38, 0x71, 0x07, 0xbb, 0x3e, 0x95, 0x64, 0xd7, 0xed,
-	0xf5, 0x69, 0x36, 0xc4, 0x69, 0xc6, 0x25, 0x47, 0x95, 0x29, 0x02, 0x1b, 0x04, 0x36, 0x88, 0xea,
-	0xad, 0x80, 0x8b, 0x2e, 0x17, 0xae, 0x4f, 0x04, 0xd5, 0xf0, 0xd3, 0xe6, 0x94, 0x44, 0x31, 0x23,
-	0x32, 0xe6, 0x4c, 0xbf, 0x50, 0xad, 0x44, 0x3c, 0xe2, 0xea, 0xe8, 0x4e, 0x4f, 0xa6, 0x5a, 0x8b,
-	0x38, 0x8f, 0x12, 0xea, 0x92, 0x34, 0x76, 0x09, 0x63, 0x5c, 0xaa, 0x16, 0x61, 0x6e, 0x9d, 0x5c,
-	0x5d, 0x11, 0x65, 0x54, 0xc4, 0x06, 0xe3, 0x54, 0x20, 0x3a, 0x98, 0x32, 0xbf, 0x20, 0x19, 0xe9,
-	0x0a, 0x8f, 0xf6, 0xfa, 0x54, 0x48, 0xe7, 0x00, 0x5e, 0xf9, 0xa3, 0x2a, 0x52, 0xce, 0x04, 0x45,
-	0x7b, 0xb0, 0x98, 0xaa, 0xca, 0x3a, 0xd8, 0x04, 0x8d, 0x72, 0xb3, 0x86, 0xf3, 0x7c, 0x61, 0xdd,
-	0xd5, 0x3a, 0x7f, 0x78, 0x52, 0xb7, 0x3c, 0xd3, 0xe1, 0x8c, 0x00, 0xdc, 0x52, 0x6f, 0xee, 0xd3,
-	0x34, 0xe1, 0x43, 0x1a, 0xb6, 0x95, 0xf9, 0x36, 0x8f, 0x59, 0x9b, 0x33, 0x99, 0x91, 0x40, 0xce,
-	0xe8, 0xd1, 0x75, 0x78, 0x59, 0x47, 0xd3, 0x09, 0x29, 0xe3, 0x8a, 0xee, 0x5c, 0xa3, 0xe4, 0xad,
-	0xe9, 0xe2, 0xbe, 0xaa, 0xa1, 0x27, 0x10, 0x9e, 0xa5, 0xb4, 0x5e, 0x50, 0x82, 0x6e, 0x62, 0x0d,
-	0xc1, 0xd3, 0x48, 0xb1, 0x9e, 0xc0, 0x99, 0xaa, 0x88, 0x1a, 0x02, 0x6f, 0xae, 0x73, 0xef, 0xd2,
-	0xc7, 0x51, 0xdd, 0xfa, 0x31, 0xaa, 0x5b, 0xce, 0x2f, 0x00, 0x1b, 0xab, 0x25, 0x9a, 0x2c, 0xde,
-	0x41, 0x3b, 0x34, 0xb0, 0x8e, 0x11, 0x1b, 0xf0, 0x98, 0x75, 0x82, 0x19, 0x52, 0x89, 0x2e, 0x37,
-	0x77, 0xf2, 0x33, 0x5a, 0x4c, 0x61, 0x72, 0xdb, 0x08, 0x17, 0x8b, 0x40, 0x4f, 0x73, 0xbc, 0x6f,
-	0xad, 0xf4, 0xae, 0x95, 0xcf, 0x9b, 0x77, 0x7a, 0xb0, 0xba, 0x58, 0x09, 0xba, 0x06, 0xd7, 0xe6,
-	0xe7, 0xa0, 0xa6, 0x5e, 0xf2, 0xca, 0x73, 0x63, 0x40, 0x3b, 0xf0, 0x22, 0x09, 0xc3, 0x8c, 0x0a,
-	0xa1, 0x64, 0x94, 0x5a, 0x57, 0x8f, 0x4f, 0xea, 0xe8, 0x19, 0x93, 0x34, 0x63, 0x24, 0x79, 0xfc,
-	0xf2, 0xf9, 0x23, 0x7d, 0xeb, 0xcd, 0x60, 0xcd, 0x9f, 0x05, 0x78, 0x41, 0xa5, 0x8c, 0x3e, 0x00,
-	0x58, 0xd4, 0xbb, 0x82, 0x1a, 0xf9, 0x29, 0xfd, 0xbd, 0x9a, 0xd5, 0xed, 0x7f, 0x40, 0x6a, 0xa3,
-	0xce, 0x8d, 0xf7, 0x5f, 0xbe, 0x7f, 0x2e, 0xd8, 0xa8, 0xe6, 0xe6, 0x7e, 0x08, 0x7a, 0x31, 0xd1,
-	0x31, 0x80, 0x1b, 0x4b, 0x06, 0x8e, 0x1e, 0x2c, 0x21, 0x5c, 0xbd, 0xcb, 0xd5, 0x87, 0xff, 0xdb,
-	0x6e, 0x4c, 0xdc, 0x57, 0x26, 0xee, 0xa1, 0xbb, 0xf9, 0x26, 0x96, 0xef, 0x60, 0xab, 0x7d, 0x38,
-	0xb6, 0xc1, 0xd1, 0xd8, 0x06, 0xdf, 0xc6, 0x36, 0xf8, 0x34, 0xb1, 0xad, 0xa3, 0x89, 0x6d, 0x7d,
-	0x9d, 0xd8, 0xd6, 0xab, 0xed, 0x28, 0x96, 0xaf, 0xfb, 0x3e, 0x0e, 0x78, 0x57, 0xbd, 0x7c, 0x3b,
-	0x21, 0xbe, 0xd0, 0x1c, 0x6f, 0x4f, 0x59, 0xe4, 0x30, 0xa5, 0xc2, 0x2f, 0xaa, 0x5f, 0xc5, 0x9d,
-	0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x1f, 0xfa, 0x86, 0x41, 0xe8, 0x04, 0x00, 0x00,
+	// 770 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4d, 0x6f, 0xd3, 0x4a,
+	0x14, 0x8d, 0xfb, 0x5e, 0xf3, 0x5e, 0xa6, 0x65, 0x33, 0x04, 0xd4, 0xa6, 0x95, 0xd3, 0x9a, 0x7e,
+	0xa4, 0x45, 0xd8, 0xfd, 0x40, 0x40, 0x4b, 0x41, 0xa2, 0x29, 0x20, 0x24, 0x40, 0xad, 0xa5, 0xb2,
+	0x60, 0x13, 0x4d, 0x92, 0x91, 0x19, 0x48, 0x3c, 0xae, 0x67, 0x12, 0x51, 0x2a, 0x36, 0xb0, 0x81,
+	0x1d, 0x12, 0x7f, 0xa0, 0x3f, 0xa7, 0x12, 0x9b, 0x22, 0x36, 0xa8, 0x12, 0x15, 0x6a, 0x59, 0xb0,
+	0xe4, 0x27, 0x20, 0xcf, 0x8c, 0x13, 0x07, 0x1c, 0xa7, 0x54, 0xec, 0x9c, 0x99, 0x73, 0xef, 0x3d,
+	0xf7, 0x9c, 0xb9, 0x57, 0x01, 0x63, 0xcf, 0x50, 0x13, 0x59, 0xb8, 0x59, 0x6f, 0x70, 0x52, 0xb3,
+	0x9a, 0xf3, 0x65, 0xcc, 0xd1, 0xbc, 0xb5, 0xd5, 0xc0, 0xfe, 0xb6, 0xe9, 0xf9, 0x94, 0x53, 0x98,
+	0x0d, 0x10, 0xa6, 0x42, 0x98, 0x0a, 0x91, 0x9b, 0xad, 0x50, 0x56, 0xa7, 0xcc, 0x2a, 0x23, 0x86,
+	0x25, 0xbc, 0x15, 0xec, 0x21, 0x87, 0xb8, 0x88, 0x13, 0xea, 0xca, 0x0c, 0xb9, 0xac, 0x43, 0x1d,
+	0x2a, 0x3e, 0xad, 0xe0, 0x4b, 0x9d, 0x8e, 0x3a, 0x94, 0x3a, 0x35, 0x6c, 0x21, 0x8f, 0x58, 0xc8,
+	0x75, 0x29, 0x17, 0x21, 0x4c, 0xdd, 0xce, 0xc6, 0xf2, 0xaa, 0x50, 0xb7, 0x89, 0x7d, 0x46, 0xa8,
+	0x5b, 0xf2, 0x10, 0xf1, 0x15, 0xd6, 0x88, 0xc5, 0x3a, 0xd8, 0xc5, 0x8c, 0x84, 0xf9, 0x26, 0x63,
+	0x31, 0x3e, 0xe2, 0xb8, 0x54, 0x23, 0x75, 0xc2, 0x25, 0xcc, 0xc8, 0x02, 0xb8, 0x11, 0x34, 0xb3,
+	0x8e, 0x7c, 0x54, 0x67, 0x36, 0xde, 0x6a, 0x60, 0xc6, 0x8d, 0x0d, 0x70, 0xb6, 0xe3, 0x94, 0x79,
+	0xd4, 0x65, 0x18, 0x2e, 0x83, 0xb4, 0x27, 0x4e, 0x86, 0xb4, 0x31, 0xad, 0x30, 0xb0, 0x30, 0x6a,
+	0xc6, 0x49, 0x65, 0xca, 0xa8, 0xd5, 0x7f, 0xf7, 0x0e, 0xf3, 0x29, 0x5b, 0x45, 0x18, 0xbb, 0x1a,
+	0x98, 0x16, 0x39, 0xd7, 0xb0, 0x57, 0xa3, 0xdb, 0xb8, 0x5a, 0x14, 0x7a, 0x16, 0x29, 0x71, 0x8b,
+	0xd4, 0xe5, 0x3e, 0xaa, 0xf0, 0xb0, 0x3c, 0xbc, 0x00, 0xce, 0x48, 0xb5, 0x4b, 0x55, 0xec, 0x52,
+	0x51, 0xee, 0x9f, 0x42, 0xc6, 0x1e, 0x94, 0x87, 0x6b, 0xe2, 0x0c, 0xde, 0x01, 0xa0, 0x2d, 0xfc,
+	0x50, 0x9f, 0x20, 0x34, 0x65, 0x4a, 0x88, 0x19, 0xb8, 0x64, 0x4a, 0x53, 0xdb, 0xac, 0x1c, 0xac,
+	0x0a, 0xd8, 0x91, 0xc8, 0xe5, 0xff, 0xdf, 0xec, 0xe6, 0x53, 0xdf, 0x77, 0xf3, 0x29, 0xe3, 0x87,
+	0x06, 0x0a, 0xbd, 0x29, 0x2a, 0x2d, 0x76, 0x80, 0x5e, 0x55, 0xb0, 0x92, 0x22, 0x5b, 0xa1, 0xc4,
+	0x2d, 0x55, 0x42, 0xa4, 0x20, 0x3d, 0xb0, 0x30, 0x17, 0xaf, 0x51, 0xf7, 0x12, 0x4a, 0xb7, 0x91,
+	0x6a, 0x77, 0x12, 0xf0, 0x6e, 0x4c, 0xef, 0xd3, 0x3d, 0x7b, 0x97, 0xcc, 0xa3, 0xcd, 0x1b, 0x5b,
+	0x20, 0xd7, 0x9d, 0x09, 0x1c, 0x07, 0x83, 0x51, 0x1f, 0x84, 0xeb, 0x19, 0x7b, 0x20, 0x62, 0x03,
+	0x9c, 0x03, 0xff, 0xa1, 0x6a, 0xd5, 0xc7, 0x8c, 0x09, 0x1a, 0x99, 0xd5, 0xf3, 0x07, 0x87, 0x79,
+	0x78, 0xcf, 0xe5, 0xd8, 0x77, 0x51, 0xed, 0xf6, 0xa3, 0x07, 0xb7, 0xe4, 0xad, 0x1d, 0xc2, 0x0c,
+	0x0a, 0x26, 0x85, 0xc8, 0x36, 0x76, 0x08, 0xe3, 0xd8, 0x0f, 0x2a, 0x87, 0x8f, 0x7c, 0x1d, 0x11,
+	0xbf, 0xf5, 0x0a, 0x3a, 0x0d, 0xd6, 0x4e, 0x6b, 0xb0, 0xf1, 0x45, 0x03, 0x53, 0xbd, 0x2a, 0x2a,
+	0x53, 0x9f, 0x82, 0x11, 0xbf, 0x05, 0x2a, 0xfd, 0x32, 0x7c, 0xa1, 0xa3, 0x13, 0xf1, 0x8e, 0x76,
+	0xe6, 0x54, 0x2e, 0x0e, 0xfb, 0xdd, 0x6a, 0xfe, 0x3d, 0x0f, 0x97, 0xc0, 0xb8, 0x68, 0xaf, 0x5d,
+	0x60, 0x93, 0x93, 0x1a, 0x79, 0x21, 0x6e, 0x43, 0x31, 0xb3, 0xa0, 0x3f, 0xea, 0xa1, 0xfc, 0x61,
+	0x7c, 0xd0, 0x80, 0x91, 0x14, 0xab, 0x64, 0x79, 0x08, 0x40, 0x7b, 0x71, 0x28, 0x27, 0x66, 0x7a,
+	0xa9, 0x60, 0x23, 0x8e, 0xef, 0x07, 0x01, 0x4a, 0x8a, 0x8c, 0x1f, 0x1e, 0xc0, 0x4d, 0x30, 0xd8,
+	0x68, 0x97, 0x09, 0x5e, 0x4e, 0xa0, 0xeb, 0xc5, 0x5e, 0x19, 0x23, 0xd4, 0x54, 0xce, 0x8e, 0x34,
+	0x0b, 0x6f, 0xd3, 0xa0, 0x5f, 0x74, 0x03, 0x5f, 0x6b, 0x20, 0x2d, 0xb7, 0x10, 0x2c, 0xc4, 0x67,
+	0xfd, 0x7d, 0xe9, 0xe5, 0x66, 0x4e, 0x80, 0x94, 0x82, 0x18, 0x13, 0xaf, 0x3e, 0x7d, 0x7b, 0xdf,
+	0xa7, 0xc3, 0x51, 0x2b, 0x76, 0xcb, 0xca, 0x95, 0x07, 0x0f, 0x34, 0x30, 0x92, 0xb0, 0x4a, 0xe0,
+	0x8d, 0x84, 0x82, 0xbd, 0xb7, 0x64, 0xee, 0xe6, 0x69, 0xc3, 0x55, 0x13, 0x2b, 0xa2, 0x89, 0x2b,
+	0xf0, 0x72, 0x7c, 0x13, 0xc9, 0xdb, 0x0d, 0x7e, 0xd4, 0xc0, 0x70, 0xd7, 0x81, 0x82, 0xd7, 0x13,
+	0xb8, 0xf5, 0x1a, 0xfc, 0xdc, 0xca, 0xe9, 0x82, 0x55, 0x5b, 0x4b, 0xa2, 0xad, 0x45, 0x38, 0x1f,
+	0xdf, 0x56, 0xc2, 0x7c, 0xc3, 0x3d, 0x0d, 0x9c, 0x8b, 0x7d, 0x6e, 0xf0, 0x6a, 0x02, 0xa5, 0xa4,
+	0xb9, 0xcb, 0x5d, 0xfb, 0xf3, 0xc0, 0x93, 0xd9, 0x13, 0x21, 0x1f, 0x19, 0x02, 0x6b, 0x47, 0x0c,
+	0xf6, 0xcb, 0xd5, 0xe2, 0xde, 0x91, 0xae, 0xed, 0x1f, 0xe9, 0xda, 0xd7, 0x23, 0x5d, 0x7b, 0x77,
+	0xac, 0xa7, 0xf6, 0x8f, 0xf5, 0xd4, 0xe7, 0x63, 0x3d, 0xf5, 0x78, 0xc6, 0x21, 0xfc, 0x49, 0xa3,
+	0x6c, 0x56, 0x68, 0x5d, 0x64, 0xbe, 0x54, 0x43, 0x65, 0x26, 0x6b, 0x3c, 0x6f, 0x55, 0xe1, 0xdb,
+	0x1e, 0x66, 0xe5, 0xb4, 0xf8, 0x8f, 0xb0, 0xf8, 0x73, 0x00, 0x68, 0xe7, 0xdb, 0x4d, 0x34, 0x09,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -324,6 +547,10 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// DeployedCosmosCoinContracts queries a list cosmos coin denom and their deployed erc20 address
 	DeployedCosmosCoinContracts(ctx context.Context, in *QueryDeployedCosmosCoinContractsRequest, opts ...grpc.CallOption) (*QueryDeployedCosmosCoinContractsResponse, error)
+	// RegisteredConversionPairs queries the ERC20 conversion pairs registered via Msg/RegisterERC20ConversionPair
+	RegisteredConversionPairs(ctx context.Context, in *QueryRegisteredConversionPairsRequest, opts ...grpc.CallOption) (*QueryRegisteredConversionPairsResponse, error)
+	// ConversionUtilization queries the rate limit utilization of a denom in each conversion direction
+	ConversionUtilization(ctx context.Context, in *QueryConversionUtilizationRequest, opts ...grpc.CallOption) (*QueryConversionUtilizationResponse, error)
 }
 
 type queryClient struct {
@@ -352,12 +579,34 @@ func (c *queryClient) DeployedCosmosCoinContracts(ctx context.Context, in *Query
 	return out, nil
 }
 
+func (c *queryClient) RegisteredConversionPairs(ctx context.Context, in *QueryRegisteredConversionPairsRequest, opts ...grpc.CallOption) (*QueryRegisteredConversionPairsResponse, error) {
+	out := new(QueryRegisteredConversionPairsResponse)
+	err := c.cc.Invoke(ctx, "/kava.evmutil.v1beta1.Query/RegisteredConversionPairs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ConversionUtilization(ctx context.Context, in *QueryConversionUtilizationRequest, opts ...grpc.CallOption) (*QueryConversionUtilizationResponse, error) {
+	out := new(QueryConversionUtilizationResponse)
+	err := c.cc.Invoke(ctx, "/kava.evmutil.v1beta1.Query/ConversionUtilization", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries all parameters of the evmutil module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// DeployedCosmosCoinContracts queries a list cosmos coin denom and their deployed erc20 address
 	DeployedCosmosCoinContracts(context.Context, *QueryDeployedCosmosCoinContractsRequest) (*QueryDeployedCosmosCoinContractsResponse, error)
+	// RegisteredConversionPairs queries the ERC20 conversion pairs registered via Msg/RegisterERC20ConversionPair
+	RegisteredConversionPairs(context.Context, *QueryRegisteredConversionPairsRequest) (*QueryRegisteredConversionPairsResponse, error)
+	// ConversionUtilization queries the rate limit utilization of a denom in each conversion direction
+	ConversionUtilization(context.Context, *QueryConversionUtilizationRequest) (*QueryConversionUtilizationResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -370,6 +619,12 @@ func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsReq
 func (*UnimplementedQueryServer) DeployedCosmosCoinContracts(ctx context.Context, req *QueryDeployedCosmosCoinContractsRequest) (*QueryDeployedCosmosCoinContractsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeployedCosmosCoinContracts not implemented")
 }
+func (*UnimplementedQueryServer) RegisteredConversionPairs(ctx context.Context, req *QueryRegisteredConversionPairsRequest) (*QueryRegisteredConversionPairsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisteredConversionPairs not implemented")
+}
+func (*UnimplementedQueryServer) ConversionUtilization(ctx context.Context, req *QueryConversionUtilizationRequest) (*QueryConversionUtilizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConversionUtilization not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -411,6 +666,42 @@ func _Query_DeployedCosmosCoinContracts_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_RegisteredConversionPairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRegisteredConversionPairsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RegisteredConversionPairs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.evmutil.v1beta1.Query/RegisteredConversionPairs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RegisteredConversionPairs(ctx, req.(*QueryRegisteredConversionPairsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ConversionUtilization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConversionUtilizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ConversionUtilization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.evmutil.v1beta1.Query/ConversionUtilization",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConversionUtilization(ctx, req.(*QueryConversionUtilizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kava.evmutil.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -423,6 +714,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeployedCosmosCoinContracts",
 			Handler:    _Query_DeployedCosmosCoinContracts_Handler,
 		},
+		{
+			MethodName: "RegisteredConversionPairs",
+			Handler:    _Query_RegisteredConversionPairs_Handler,
+		},
+		{
+			MethodName: "ConversionUtilization",
+			Handler:    _Query_ConversionUtilization_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kava/evmutil/v1beta1/query.proto",
@@ -619,82 +918,243 @@ func (m *DeployedCosmosCoinContract) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRegisteredConversionPairsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryRegisteredConversionPairsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDeployedCosmosCoinContractsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryRegisteredConversionPairsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.CosmosDenoms) > 0 {
-		for _, s := range m.CosmosDenoms {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDeployedCosmosCoinContractsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.DeployedCosmosCoinContracts) > 0 {
-		for _, e := range m.DeployedCosmosCoinContracts {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryRegisteredConversionPairsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DeployedCosmosCoinContract) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryRegisteredConversionPairsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRegisteredConversionPairsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.CosmosDenom)
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RegisteredConversionPairs) > 0 {
+		for iNdEx := len(m.RegisteredConversionPairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RegisteredConversionPairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConversionUtilizationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConversionUtilizationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConversionUtilizationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConversionUtilizationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConversionUtilizationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConversionUtilizationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Utilizations) > 0 {
+		for iNdEx := len(m.Utilizations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Utilizations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDeployedCosmosCoinContractsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CosmosDenoms) > 0 {
+		for _, s := range m.CosmosDenoms {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDeployedCosmosCoinContractsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DeployedCosmosCoinContracts) > 0 {
+		for _, e := range m.DeployedCosmosCoinContracts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DeployedCosmosCoinContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CosmosDenom)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
@@ -705,6 +1165,68 @@ func (m *DeployedCosmosCoinContract) Size() (n int) {
 	return n
 }
 
+func (m *QueryRegisteredConversionPairsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRegisteredConversionPairsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RegisteredConversionPairs) > 0 {
+		for _, e := range m.RegisteredConversionPairs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConversionUtilizationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConversionUtilizationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RateLimit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Utilizations) > 0 {
+		for _, e := range m.Utilizations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -730,16 +1252,217 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDeployedCosmosCoinContractsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDeployedCosmosCoinContractsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDeployedCosmosCoinContractsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CosmosDenoms = append(m.CosmosDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -761,7 +1484,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDeployedCosmosCoinContractsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -784,15 +1507,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDeployedCosmosCoinContractsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDeployedCosmosCoinContractsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeployedCosmosCoinContracts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -819,7 +1542,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DeployedCosmosCoinContracts = append(m.DeployedCosmosCoinContracts, DeployedCosmosCoinContract{})
+			if err := m.DeployedCosmosCoinContracts[len(m.DeployedCosmosCoinContracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -844,7 +1604,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDeployedCosmosCoinContractsRequest) Unmarshal(dAtA []byte) error {
+func (m *DeployedCosmosCoinContract) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -867,15 +1627,15 @@ func (m *QueryDeployedCosmosCoinContractsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDeployedCosmosCoinContractsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeployedCosmosCoinContract: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDeployedCosmosCoinContractsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeployedCosmosCoinContract: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CosmosDenoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -903,9 +1663,95 @@ func (m *QueryDeployedCosmosCoinContractsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CosmosDenoms = append(m.CosmosDenoms, string(dAtA[iNdEx:postIndex]))
+			m.CosmosDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v InternalEVMAddress
+			m.Address = &v
+			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRegisteredConversionPairsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRegisteredConversionPairsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRegisteredConversionPairsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -962,7 +1808,7 @@ func (m *QueryDeployedCosmosCoinContractsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDeployedCosmosCoinContractsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRegisteredConversionPairsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -985,15 +1831,15 @@ func (m *QueryDeployedCosmosCoinContractsResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDeployedCosmosCoinContractsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRegisteredConversionPairsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDeployedCosmosCoinContractsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRegisteredConversionPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeployedCosmosCoinContracts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredConversionPairs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1020,8 +1866,8 @@ func (m *QueryDeployedCosmosCoinContractsResponse) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DeployedCosmosCoinContracts = append(m.DeployedCosmosCoinContracts, DeployedCosmosCoinContract{})
-			if err := m.DeployedCosmosCoinContracts[len(m.DeployedCosmosCoinContracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RegisteredConversionPairs = append(m.RegisteredConversionPairs, ConversionPair{})
+			if err := m.RegisteredConversionPairs[len(m.RegisteredConversionPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1082,7 +1928,7 @@ func (m *QueryDeployedCosmosCoinContractsResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *DeployedCosmosCoinContract) Unmarshal(dAtA []byte) error {
+func (m *QueryConversionUtilizationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1105,15 +1951,15 @@ func (m *DeployedCosmosCoinContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeployedCosmosCoinContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConversionUtilizationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeployedCosmosCoinContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConversionUtilizationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CosmosDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1141,13 +1987,96 @@ func (m *DeployedCosmosCoinContract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CosmosDenom = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConversionUtilizationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConversionUtilizationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConversionUtilizationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Utilizations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1157,25 +2086,23 @@ func (m *DeployedCosmosCoinContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v InternalEVMAddress
-			m.Address = &v
-			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Utilizations = append(m.Utilizations, ConversionUtilization{})
+			if err := m.Utilizations[len(m.Utilizations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,113 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// IsValid returns true if the conversion direction is valid and false otherwise.
+func (d ConversionDirection) IsValid() bool {
+	return d == CONVERSION_DIRECTION_TO_ERC20 || d == CONVERSION_DIRECTION_FROM_ERC20
+}
+
+// Validate returns an error if the conversion direction is invalid.
+func (d ConversionDirection) Validate() error {
+	if !d.IsValid() {
+		return fmt.Errorf("invalid conversion direction %s", d)
+	}
+	return nil
+}
+
+// NewConversionDirectionFromString converts a string to a ConversionDirection
+func NewConversionDirectionFromString(str string) ConversionDirection {
+	switch strings.ToLower(str) {
+	case "to-erc20":
+		return CONVERSION_DIRECTION_TO_ERC20
+	case "from-erc20":
+		return CONVERSION_DIRECTION_FROM_ERC20
+	default:
+		return CONVERSION_DIRECTION_UNSPECIFIED
+	}
+}
+
+// AllConversionDirections returns the valid conversion directions.
+func AllConversionDirections() []ConversionDirection {
+	return []ConversionDirection{CONVERSION_DIRECTION_TO_ERC20, CONVERSION_DIRECTION_FROM_ERC20}
+}
+
+// NewPausedConversionDirection returns a new PausedConversionDirection
+func NewPausedConversionDirection(denom string, direction ConversionDirection) PausedConversionDirection {
+	return PausedConversionDirection{
+		Denom:     denom,
+		Direction: direction,
+	}
+}
+
+// Validate returns an error if the paused conversion direction is invalid.
+func (p PausedConversionDirection) Validate() error {
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return fmt.Errorf("invalid paused conversion denom: %w", err)
+	}
+	return p.Direction.Validate()
+}
+
+// NewConversionRateLimit returns a new ConversionRateLimit
+func NewConversionRateLimit(denom string, active bool, limit sdkmath.Int, timePeriod time.Duration) ConversionRateLimit {
+	return ConversionRateLimit{
+		Denom:      denom,
+		Active:     active,
+		Limit:      limit,
+		TimePeriod: timePeriod,
+	}
+}
+
+// Validate returns an error if the rate limit is invalid.
+func (l ConversionRateLimit) Validate() error {
+	if err := sdk.ValidateDenom(l.Denom); err != nil {
+		return fmt.Errorf("invalid rate limit denom: %w", err)
+	}
+	if l.Limit.IsNil() || l.Limit.IsNegative() {
+		return fmt.Errorf("rate limit of %s must be non-negative: %s", l.Denom, l.Limit)
+	}
+	if l.TimePeriod <= 0 {
+		return fmt.Errorf("rate limit time period of %s must be positive: %s", l.Denom, l.TimePeriod)
+	}
+	return nil
+}
+
+// ConversionRateLimits defines a slice of ConversionRateLimit
+type ConversionRateLimits []ConversionRateLimit
+
+// NewConversionRateLimits returns ConversionRateLimits from the provided values.
+func NewConversionRateLimits(limits ...ConversionRateLimit) ConversionRateLimits {
+	return ConversionRateLimits(limits)
+}
+
+// Validate returns an error if any of the rate limits are invalid or have duplicate denoms.
+func (limits ConversionRateLimits) Validate() error {
+	seenDenoms := make(map[string]bool, len(limits))
+	for _, limit := range limits {
+		if err := limit.Validate(); err != nil {
+			return err
+		}
+		if seenDenoms[limit.Denom] {
+			return fmt.Errorf("found duplicate rate limit for denom %s", limit.Denom)
+		}
+		seenDenoms[limit.Denom] = true
+	}
+	return nil
+}
+
+// validateConversionRateLimits validates an interface as ConversionRateLimits
+func validateConversionRateLimits(i interface{}) error {
+	limits, ok := i.(ConversionRateLimits)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return limits.Validate()
+}
@@ -0,0 +1,1612 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: kava/evmutil/v1beta1/rate_limit.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ConversionDirection defines the direction of a conversion between sdk.Coin and ERC20.
+type ConversionDirection int32
+
+const (
+	// CONVERSION_DIRECTION_UNSPECIFIED is an invalid direction.
+	CONVERSION_DIRECTION_UNSPECIFIED ConversionDirection = 0
+	// CONVERSION_DIRECTION_TO_ERC20 converts an sdk.Coin to an ERC20.
+	CONVERSION_DIRECTION_TO_ERC20 ConversionDirection = 1
+	// CONVERSION_DIRECTION_FROM_ERC20 converts an ERC20 to an sdk.Coin.
+	CONVERSION_DIRECTION_FROM_ERC20 ConversionDirection = 2
+)
+
+var ConversionDirection_name = map[int32]string{
+	0: "CONVERSION_DIRECTION_UNSPECIFIED",
+	1: "CONVERSION_DIRECTION_TO_ERC20",
+	2: "CONVERSION_DIRECTION_FROM_ERC20",
+}
+
+var ConversionDirection_value = map[string]int32{
+	"CONVERSION_DIRECTION_UNSPECIFIED": 0,
+	"CONVERSION_DIRECTION_TO_ERC20":    1,
+	"CONVERSION_DIRECTION_FROM_ERC20":  2,
+}
+
+func (x ConversionDirection) String() string {
+	return proto.EnumName(ConversionDirection_name, int32(x))
+}
+
+func (ConversionDirection) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3e28f08ae27a014a, []int{0}
+}
+
+// ConversionRateLimit defines a cap on the amount of an sdk.Coin denom that can be converted
+// in each direction within a time period.
+type ConversionRateLimit struct {
+	// Denom of the sdk.Coin of an EVM-native conversion pair or a cosmos-native asset.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// Active indicates if the limit is enforced.
+	Active bool `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
+	// Limit is the maximum amount converted in each direction within the time period.
+	Limit github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=limit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"limit"`
+	// TimePeriod is the length of the rate limit window.
+	TimePeriod time.Duration `protobuf:"bytes,4,opt,name=time_period,json=timePeriod,proto3,stdduration" json:"time_period"`
+}
+
+func (m *ConversionRateLimit) Reset()         { *m = ConversionRateLimit{} }
+func (m *ConversionRateLimit) String() string { return proto.CompactTextString(m) }
+func (*ConversionRateLimit) ProtoMessage()    {}
+func (*ConversionRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e28f08ae27a014a, []int{0}
+}
+func (m *ConversionRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConversionRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConversionRateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConversionRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConversionRateLimit.Merge(m, src)
+}
+func (m *ConversionRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConversionRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConversionRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConversionRateLimit proto.InternalMessageInfo
+
+// ConversionVolume defines the amount of a denom converted in a direction during the current
+// and previous rate limit windows. The rolling volume weights the previous window by the part of
+// it that is still within one time period of the block time.
+type ConversionVolume struct {
+	// Amount converted since the window start.
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// WindowStart is the time the current window started.
+	WindowStart time.Time `protobuf:"bytes,2,opt,name=window_start,json=windowStart,proto3,stdtime" json:"window_start"`
+	// PreviousAmount is the amount converted during the window before the current window.
+	PreviousAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=previous_amount,json=previousAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"previous_amount"`
+}
+
+func (m *ConversionVolume) Reset()         { *m = ConversionVolume{} }
+func (m *ConversionVolume) String() string { return proto.CompactTextString(m) }
+func (*ConversionVolume) ProtoMessage()    {}
+func (*ConversionVolume) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e28f08ae27a014a, []int{1}
+}
+func (m *ConversionVolume) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConversionVolume) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConversionVolume.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConversionVolume) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConversionVolume.Merge(m, src)
+}
+func (m *ConversionVolume) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConversionVolume) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConversionVolume.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConversionVolume proto.InternalMessageInfo
+
+func (m *ConversionVolume) GetWindowStart() time.Time {
+	if m != nil {
+		return m.WindowStart
+	}
+	return time.Time{}
+}
+
+// ConversionUtilization defines the rate limit utilization of a denom in a direction.
+type ConversionUtilization struct {
+	// Direction of the conversions.
+	Direction ConversionDirection `protobuf:"varint,1,opt,name=direction,proto3,enum=kava.evmutil.v1beta1.ConversionDirection" json:"direction,omitempty"`
+	// Paused indicates if conversions of the denom in the direction are paused.
+	Paused bool `protobuf:"varint,2,opt,name=paused,proto3" json:"paused,omitempty"`
+	// Volume is the amount converted within the rolling window ending at the block time.
+	Volume github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=volume,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"volume"`
+	// Remaining is the amount that can still be converted within the rolling window.
+	Remaining github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=remaining,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"remaining"`
+	// WindowEnd is the time the current window ends, after which its volume starts to
+	// roll out of the rolling window.
+	WindowEnd time.Time `protobuf:"bytes,5,opt,name=window_end,json=windowEnd,proto3,stdtime" json:"window_end"`
+}
+
+func (m *ConversionUtilization) Reset()         { *m = ConversionUtilization{} }
+func (m *ConversionUtilization) String() string { return proto.CompactTextString(m) }
+func (*ConversionUtilization) ProtoMessage()    {}
+func (*ConversionUtilization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e28f08ae27a014a, []int{2}
+}
+func (m *ConversionUtilization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConversionUtilization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConversionUtilization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConversionUtilization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConversionUtilization.Merge(m, src)
+}
+func (m *ConversionUtilization) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConversionUtilization) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConversionUtilization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConversionUtilization proto.InternalMessageInfo
+
+func (m *ConversionUtilization) GetDirection() ConversionDirection {
+	if m != nil {
+		return m.Direction
+	}
+	return CONVERSION_DIRECTION_UNSPECIFIED
+}
+
+func (m *ConversionUtilization) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *ConversionUtilization) GetWindowEnd() time.Time {
+	if m != nil {
+		return m.WindowEnd
+	}
+	return time.Time{}
+}
+
+// PausedConversionDirection defines a denom whose conversions in a direction are paused.
+type PausedConversionDirection struct {
+	// Denom of the sdk.Coin of an EVM-native conversion pair or a cosmos-native asset.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// Direction of the paused conversions.
+	Direction ConversionDirection `protobuf:"varint,2,opt,name=direction,proto3,enum=kava.evmutil.v1beta1.ConversionDirection" json:"direction,omitempty"`
+}
+
+func (m *PausedConversionDirection) Reset()         { *m = PausedConversionDirection{} }
+func (m *PausedConversionDirection) String() string { return proto.CompactTextString(m) }
+func (*PausedConversionDirection) ProtoMessage()    {}
+func (*PausedConversionDirection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e28f08ae27a014a, []int{3}
+}
+func (m *PausedConversionDirection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PausedConversionDirection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PausedConversionDirection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PausedConversionDirection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PausedConversionDirection.Merge(m, src)
+}
+func (m *PausedConversionDirection) XXX_Size() int {
+	return m.Size()
+}
+func (m *PausedConversionDirection) XXX_DiscardUnknown() {
+	xxx_messageInfo_PausedConversionDirection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PausedConversionDirection proto.InternalMessageInfo
+
+func (m *PausedConversionDirection) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *PausedConversionDirection) GetDirection() ConversionDirection {
+	if m != nil {
+		return m.Direction
+	}
+	return CONVERSION_DIRECTION_UNSPECIFIED
+}
+
+func init() {
+	proto.RegisterEnum("kava.evmutil.v1beta1.ConversionDirection", ConversionDirection_name, ConversionDirection_value)
+	proto.RegisterType((*ConversionRateLimit)(nil), "kava.evmutil.v1beta1.ConversionRateLimit")
+	proto.RegisterType((*ConversionVolume)(nil), "kava.evmutil.v1beta1.ConversionVolume")
+	proto.RegisterType((*ConversionUtilization)(nil), "kava.evmutil.v1beta1.ConversionUtilization")
+	proto.RegisterType((*PausedConversionDirection)(nil), "kava.evmutil.v1beta1.PausedConversionDirection")
+}
+
+func init() {
+	proto.RegisterFile("kava/evmutil/v1beta1/rate_limit.proto", fileDescriptor_3e28f08ae27a014a)
+}
+
+var fileDescriptor_3e28f08ae27a014a = []byte{
+	// 638 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcf, 0x6e, 0xd3, 0x30,
+	0x1c, 0x8e, 0x4b, 0x37, 0xad, 0x2e, 0x1a, 0x93, 0x19, 0x28, 0xab, 0x44, 0x5a, 0xca, 0x1f, 0x6d,
+	0x48, 0x4b, 0x58, 0xb9, 0x21, 0x2e, 0xac, 0xed, 0xa6, 0x0a, 0x58, 0xa7, 0xb4, 0xdb, 0x61, 0x97,
+	0xc8, 0x6d, 0x4c, 0xb1, 0xd6, 0xc4, 0x55, 0xe2, 0x64, 0xb0, 0x27, 0x18, 0xb7, 0x1d, 0x77, 0x44,
+	0xe2, 0xc2, 0x03, 0xf0, 0x10, 0x3b, 0x4e, 0x9c, 0x10, 0x87, 0x31, 0xda, 0x07, 0xe0, 0x15, 0x90,
+	0x1d, 0x97, 0x30, 0x28, 0x07, 0x50, 0x4f, 0xf5, 0x2f, 0xfe, 0xbe, 0x9f, 0x7f, 0xdf, 0xf7, 0xd9,
+	0x85, 0xf7, 0xf6, 0x71, 0x8c, 0x2d, 0x12, 0x7b, 0x11, 0xa7, 0x7d, 0x2b, 0x5e, 0xeb, 0x10, 0x8e,
+	0xd7, 0xac, 0x00, 0x73, 0xe2, 0xf4, 0xa9, 0x47, 0xb9, 0x39, 0x08, 0x18, 0x67, 0x68, 0x51, 0xc0,
+	0x4c, 0x05, 0x33, 0x15, 0xac, 0xb0, 0xd4, 0x65, 0xa1, 0xc7, 0x42, 0x47, 0x62, 0xac, 0xa4, 0x48,
+	0x08, 0x85, 0xc5, 0x1e, 0xeb, 0xb1, 0xe4, 0xbb, 0x58, 0xa9, 0xaf, 0x46, 0x8f, 0xb1, 0x5e, 0x9f,
+	0x58, 0xb2, 0xea, 0x44, 0x2f, 0x2d, 0x37, 0x0a, 0x30, 0xa7, 0xcc, 0x57, 0xfb, 0xc5, 0xdf, 0xf7,
+	0x39, 0xf5, 0x48, 0xc8, 0xb1, 0x37, 0x48, 0x00, 0xe5, 0x11, 0x80, 0xd7, 0xab, 0xcc, 0x8f, 0x49,
+	0x10, 0x52, 0xe6, 0xdb, 0x98, 0x93, 0xe7, 0x62, 0x4a, 0xb4, 0x08, 0x67, 0x5c, 0xe2, 0x33, 0x4f,
+	0x07, 0x25, 0xb0, 0x9c, 0xb3, 0x93, 0x02, 0xdd, 0x84, 0xb3, 0xb8, 0xcb, 0x69, 0x4c, 0xf4, 0x4c,
+	0x09, 0x2c, 0xcf, 0xd9, 0xaa, 0x42, 0x36, 0x9c, 0x91, 0xe2, 0xf4, 0x2b, 0x02, 0xbd, 0xfe, 0xe4,
+	0xf4, 0xbc, 0xa8, 0x7d, 0x39, 0x2f, 0xde, 0xef, 0x51, 0xfe, 0x2a, 0xea, 0x98, 0x5d, 0xe6, 0x29,
+	0x31, 0xea, 0x67, 0x35, 0x74, 0xf7, 0x2d, 0xfe, 0x66, 0x40, 0x42, 0xb3, 0xe1, 0xf3, 0x4f, 0x1f,
+	0x57, 0xa1, 0xd2, 0xda, 0xf0, 0xb9, 0x9d, 0xb4, 0x42, 0x35, 0x98, 0x17, 0xc3, 0x3a, 0x03, 0x12,
+	0x50, 0xe6, 0xea, 0xd9, 0x12, 0x58, 0xce, 0x57, 0x96, 0xcc, 0x44, 0x90, 0x39, 0x16, 0x64, 0xd6,
+	0x94, 0xe0, 0xf5, 0x39, 0x71, 0xe8, 0xc9, 0xd7, 0x22, 0xb0, 0xa1, 0xe0, 0x6d, 0x4b, 0xda, 0xe3,
+	0xec, 0xd1, 0xbb, 0xa2, 0x56, 0x3e, 0xc9, 0xc0, 0x85, 0x54, 0xe5, 0x2e, 0xeb, 0x47, 0x1e, 0x41,
+	0x6d, 0x38, 0x8b, 0x3d, 0x16, 0xf9, 0x5c, 0x07, 0x53, 0x98, 0x5a, 0xf5, 0x42, 0x9b, 0xf0, 0xea,
+	0x01, 0xf5, 0x5d, 0x76, 0xe0, 0x84, 0x1c, 0x07, 0x5c, 0x1a, 0x95, 0xaf, 0x14, 0xfe, 0x98, 0xbb,
+	0x3d, 0x0e, 0x22, 0x19, 0xfc, 0x58, 0x0c, 0x9e, 0x4f, 0x98, 0x2d, 0x41, 0x44, 0x04, 0x5e, 0x1b,
+	0x04, 0x24, 0xa6, 0x2c, 0x0a, 0x1d, 0x35, 0xe7, 0x34, 0xdc, 0x9d, 0x1f, 0x37, 0x7d, 0x2a, 0x7b,
+	0x96, 0xbf, 0x67, 0xe0, 0x8d, 0xd4, 0x9a, 0x1d, 0x4e, 0xfb, 0xf4, 0x50, 0x1a, 0x8a, 0x36, 0x61,
+	0xce, 0xa5, 0x01, 0xe9, 0x8a, 0x42, 0x5a, 0x34, 0x5f, 0x59, 0x31, 0x27, 0x5d, 0x5b, 0x33, 0xe5,
+	0xd7, 0xc6, 0x04, 0x3b, 0xe5, 0x8a, 0x5b, 0x33, 0xc0, 0x51, 0x48, 0xdc, 0xf1, 0xad, 0x49, 0x2a,
+	0x11, 0x40, 0x2c, 0xa3, 0x98, 0x8a, 0x30, 0xd5, 0x0b, 0xed, 0xc1, 0x5c, 0x40, 0x3c, 0x4c, 0x7d,
+	0xea, 0xf7, 0xf4, 0xec, 0x14, 0x1a, 0xa7, 0xed, 0x50, 0x15, 0x42, 0x15, 0x2e, 0xf1, 0x5d, 0x7d,
+	0xe6, 0x1f, 0xa2, 0xcd, 0x25, 0xbc, 0xba, 0xef, 0x96, 0x0f, 0xe1, 0xd2, 0xb6, 0x34, 0x60, 0x82,
+	0x6d, 0x7f, 0x79, 0x77, 0x97, 0xa2, 0xc8, 0xfc, 0x7f, 0x14, 0x0f, 0xde, 0x5e, 0x7a, 0xee, 0xe9,
+	0xb1, 0x77, 0x61, 0xa9, 0xda, 0xdc, 0xda, 0xad, 0xdb, 0xad, 0x46, 0x73, 0xcb, 0xa9, 0x35, 0xec,
+	0x7a, 0xb5, 0x2d, 0x56, 0x3b, 0x5b, 0xad, 0xed, 0x7a, 0xb5, 0xb1, 0xd1, 0xa8, 0xd7, 0x16, 0x34,
+	0x74, 0x1b, 0xde, 0x9a, 0x88, 0x6a, 0x37, 0x9d, 0xba, 0x5d, 0xad, 0x3c, 0x5c, 0x00, 0xe8, 0x0e,
+	0x2c, 0x4e, 0x84, 0x6c, 0xd8, 0xcd, 0x17, 0x0a, 0x94, 0x29, 0x64, 0x8f, 0xde, 0x1b, 0xda, 0xfa,
+	0xb3, 0x8b, 0x6f, 0x06, 0xf8, 0x30, 0x34, 0xc0, 0xe9, 0xd0, 0x00, 0x67, 0x43, 0x03, 0x5c, 0x0c,
+	0x0d, 0x70, 0x3c, 0x32, 0xb4, 0xb3, 0x91, 0xa1, 0x7d, 0x1e, 0x19, 0xda, 0xde, 0xca, 0x2f, 0x79,
+	0x09, 0xb5, 0xab, 0x7d, 0xdc, 0x09, 0xe5, 0xca, 0x7a, 0xfd, 0xf3, 0x2f, 0x56, 0xc6, 0xd6, 0x99,
+	0x95, 0xee, 0x3f, 0xfa, 0x31, 0x00, 0x11, 0xbe, 0x7d, 0x2f, 0x7f, 0x05, 0x00, 0x00,
+}
+
+func (this *ConversionRateLimit) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*ConversionRateLimit)
+	if !ok {
+		that2, ok := that.(ConversionRateLimit)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *ConversionRateLimit")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *ConversionRateLimit but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *ConversionRateLimit but is not nil && this == nil")
+	}
+	if this.Denom != that1.Denom {
+		return fmt.Errorf("Denom this(%v) Not Equal that(%v)", this.Denom, that1.Denom)
+	}
+	if this.Active != that1.Active {
+		return fmt.Errorf("Active this(%v) Not Equal that(%v)", this.Active, that1.Active)
+	}
+	if !this.Limit.Equal(that1.Limit) {
+		return fmt.Errorf("Limit this(%v) Not Equal that(%v)", this.Limit, that1.Limit)
+	}
+	if this.TimePeriod != that1.TimePeriod {
+		return fmt.Errorf("TimePeriod this(%v) Not Equal that(%v)", this.TimePeriod, that1.TimePeriod)
+	}
+	return nil
+}
+func (this *ConversionRateLimit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ConversionRateLimit)
+	if !ok {
+		that2, ok := that.(ConversionRateLimit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.Active != that1.Active {
+		return false
+	}
+	if !this.Limit.Equal(that1.Limit) {
+		return false
+	}
+	if this.TimePeriod != that1.TimePeriod {
+		return false
+	}
+	return true
+}
+func (this *ConversionVolume) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*ConversionVolume)
+	if !ok {
+		that2, ok := that.(ConversionVolume)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *ConversionVolume")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *ConversionVolume but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *ConversionVolume but is not nil && this == nil")
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return fmt.Errorf("Amount this(%v) Not Equal that(%v)", this.Amount, that1.Amount)
+	}
+	if !this.WindowStart.Equal(that1.WindowStart) {
+		return fmt.Errorf("WindowStart this(%v) Not Equal that(%v)", this.WindowStart, that1.WindowStart)
+	}
+	if !this.PreviousAmount.Equal(that1.PreviousAmount) {
+		return fmt.Errorf("PreviousAmount this(%v) Not Equal that(%v)", this.PreviousAmount, that1.PreviousAmount)
+	}
+	return nil
+}
+func (this *ConversionVolume) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ConversionVolume)
+	if !ok {
+		that2, ok := that.(ConversionVolume)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	if !this.WindowStart.Equal(that1.WindowStart) {
+		return false
+	}
+	if !this.PreviousAmount.Equal(that1.PreviousAmount) {
+		return false
+	}
+	return true
+}
+func (this *ConversionUtilization) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*ConversionUtilization)
+	if !ok {
+		that2, ok := that.(ConversionUtilization)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *ConversionUtilization")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *ConversionUtilization but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *ConversionUtilization but is not nil && this == nil")
+	}
+	if this.Direction != that1.Direction {
+		return fmt.Errorf("Direction this(%v) Not Equal that(%v)", this.Direction, that1.Direction)
+	}
+	if this.Paused != that1.Paused {
+		return fmt.Errorf("Paused this(%v) Not Equal that(%v)", this.Paused, that1.Paused)
+	}
+	if !this.Volume.Equal(that1.Volume) {
+		return fmt.Errorf("Volume this(%v) Not Equal that(%v)", this.Volume, that1.Volume)
+	}
+	if !this.Remaining.Equal(that1.Remaining) {
+		return fmt.Errorf("Remaining this(%v) Not Equal that(%v)", this.Remaining, that1.Remaining)
+	}
+	if !this.WindowEnd.Equal(that1.WindowEnd) {
+		return fmt.Errorf("WindowEnd this(%v) Not Equal that(%v)", this.WindowEnd, that1.WindowEnd)
+	}
+	return nil
+}
+func (this *ConversionUtilization) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ConversionUtilization)
+	if !ok {
+		that2, ok := that.(ConversionUtilization)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Direction != that1.Direction {
+		return false
+	}
+	if this.Paused != that1.Paused {
+		return false
+	}
+	if !this.Volume.Equal(that1.Volume) {
+		return false
+	}
+	if !this.Remaining.Equal(that1.Remaining) {
+		return false
+	}
+	if !this.WindowEnd.Equal(that1.WindowEnd) {
+		return false
+	}
+	return true
+}
+func (this *PausedConversionDirection) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*PausedConversionDirection)
+	if !ok {
+		that2, ok := that.(PausedConversionDirection)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *PausedConversionDirection")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *PausedConversionDirection but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *PausedConversionDirection but is not nil && this == nil")
+	}
+	if this.Denom != that1.Denom {
+		return fmt.Errorf("Denom this(%v) Not Equal that(%v)", this.Denom, that1.Denom)
+	}
+	if this.Direction != that1.Direction {
+		return fmt.Errorf("Direction this(%v) Not Equal that(%v)", this.Direction, that1.Direction)
+	}
+	return nil
+}
+func (this *PausedConversionDirection) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PausedConversionDirection)
+	if !ok {
+		that2, ok := that.(PausedConversionDirection)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.Direction != that1.Direction {
+		return false
+	}
+	return true
+}
+func (m *ConversionRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConversionRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConversionRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TimePeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimePeriod):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintRateLimit(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Limit.Size()
+		i -= size
+		if _, err := m.Limit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRateLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintRateLimit(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConversionVolume) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConversionVolume) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConversionVolume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PreviousAmount.Size()
+		i -= size
+		if _, err := m.PreviousAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRateLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.WindowStart, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.WindowStart):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintRateLimit(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRateLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ConversionUtilization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConversionUtilization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConversionUtilization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.WindowEnd, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.WindowEnd):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintRateLimit(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Remaining.Size()
+		i -= size
+		if _, err := m.Remaining.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRateLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Volume.Size()
+		i -= size
+		if _, err := m.Volume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRateLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Direction != 0 {
+		i = encodeVarintRateLimit(dAtA, i, uint64(m.Direction))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PausedConversionDirection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PausedConversionDirection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PausedConversionDirection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Direction != 0 {
+		i = encodeVarintRateLimit(dAtA, i, uint64(m.Direction))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintRateLimit(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRateLimit(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRateLimit(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ConversionRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovRateLimit(uint64(l))
+	}
+	if m.Active {
+		n += 2
+	}
+	l = m.Limit.Size()
+	n += 1 + l + sovRateLimit(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimePeriod)
+	n += 1 + l + sovRateLimit(uint64(l))
+	return n
+}
+
+func (m *ConversionVolume) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovRateLimit(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.WindowStart)
+	n += 1 + l + sovRateLimit(uint64(l))
+	l = m.PreviousAmount.Size()
+	n += 1 + l + sovRateLimit(uint64(l))
+	return n
+}
+
+func (m *ConversionUtilization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Direction != 0 {
+		n += 1 + sovRateLimit(uint64(m.Direction))
+	}
+	if m.Paused {
+		n += 2
+	}
+	l = m.Volume.Size()
+	n += 1 + l + sovRateLimit(uint64(l))
+	l = m.Remaining.Size()
+	n += 1 + l + sovRateLimit(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.WindowEnd)
+	n += 1 + l + sovRateLimit(uint64(l))
+	return n
+}
+
+func (m *PausedConversionDirection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovRateLimit(uint64(l))
+	}
+	if m.Direction != 0 {
+		n += 1 + sovRateLimit(uint64(m.Direction))
+	}
+	return n
+}
+
+func sovRateLimit(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRateLimit(x uint64) (n int) {
+	return sovRateLimit(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ConversionRateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRateLimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConversionRateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConversionRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimePeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TimePeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRateLimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConversionVolume) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRateLimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConversionVolume: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConversionVolume: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.WindowStart, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PreviousAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRateLimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConversionUtilization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRateLimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConversionUtilization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConversionUtilization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			m.Direction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Direction |= ConversionDirection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Remaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowEnd", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.WindowEnd, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRateLimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PausedConversionDirection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRateLimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PausedConversionDirection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PausedConversionDirection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			m.Direction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Direction |= ConversionDirection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRateLimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRateLimit(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRateLimit
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRateLimit
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRateLimit
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRateLimit
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRateLimit        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRateLimit          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRateLimit = fmt.Errorf("proto: unexpected end of group")
+)
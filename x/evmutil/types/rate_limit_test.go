@@ -0,0 +1,72 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kava-labs/kava/x/evmutil/types"
+)
+
+func TestConversionDirection_Validate(t *testing.T) {
+	require.NoError(t, types.CONVERSION_DIRECTION_TO_ERC20.Validate())
+	require.NoError(t, types.CONVERSION_DIRECTION_FROM_ERC20.Validate())
+	require.Error(t, types.CONVERSION_DIRECTION_UNSPECIFIED.Validate())
+	require.Error(t, types.ConversionDirection(3).Validate())
+
+	require.Equal(t, types.CONVERSION_DIRECTION_TO_ERC20, types.NewConversionDirectionFromString("to-erc20"))
+	require.Equal(t, types.CONVERSION_DIRECTION_FROM_ERC20, types.NewConversionDirectionFromString("FROM-ERC20"))
+	require.Equal(t, types.CONVERSION_DIRECTION_UNSPECIFIED, types.NewConversionDirectionFromString("sideways"))
+}
+
+func TestConversionRateLimit_Validate(t *testing.T) {
+	testCases := []struct {
+		name   string
+		limit  types.ConversionRateLimit
+		expErr string
+	}{
+		{
+			name:   "valid",
+			limit:  types.NewConversionRateLimit("erc20/usdc", true, sdkmath.NewInt(1e12), time.Hour),
+			expErr: "",
+		},
+		{
+			name:   "valid - zero limit",
+			limit:  types.NewConversionRateLimit("erc20/usdc", true, sdkmath.ZeroInt(), time.Hour),
+			expErr: "",
+		},
+		{
+			name:   "invalid - denom",
+			limit:  types.NewConversionRateLimit("!", true, sdkmath.NewInt(1e12), time.Hour),
+			expErr: "invalid rate limit denom",
+		},
+		{
+			name:   "invalid - nil limit",
+			limit:  types.ConversionRateLimit{Denom: "hard", Active: true, TimePeriod: time.Hour},
+			expErr: "must be non-negative",
+		},
+		{
+			name:   "invalid - negative limit",
+			limit:  types.NewConversionRateLimit("hard", true, sdkmath.NewInt(-1), time.Hour),
+			expErr: "must be non-negative",
+		},
+		{
+			name:   "invalid - zero time period",
+			limit:  types.NewConversionRateLimit("hard", true, sdkmath.NewInt(1), 0),
+			expErr: "time period of hard must be positive",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.limit.Validate()
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -12,6 +12,7 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	types1 "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -438,196 +439,824 @@ func (m *MsgConvertCosmosCoinFromERC20Response) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgConvertCosmosCoinFromERC20Response proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgConvertCoinToERC20)(nil), "kava.evmutil.v1beta1.MsgConvertCoinToERC20")
-	proto.RegisterType((*MsgConvertCoinToERC20Response)(nil), "kava.evmutil.v1beta1.MsgConvertCoinToERC20Response")
-	proto.RegisterType((*MsgConvertERC20ToCoin)(nil), "kava.evmutil.v1beta1.MsgConvertERC20ToCoin")
-	proto.RegisterType((*MsgConvertERC20ToCoinResponse)(nil), "kava.evmutil.v1beta1.MsgConvertERC20ToCoinResponse")
-	proto.RegisterType((*MsgConvertCosmosCoinToERC20)(nil), "kava.evmutil.v1beta1.MsgConvertCosmosCoinToERC20")
-	proto.RegisterType((*MsgConvertCosmosCoinToERC20Response)(nil), "kava.evmutil.v1beta1.MsgConvertCosmosCoinToERC20Response")
-	proto.RegisterType((*MsgConvertCosmosCoinFromERC20)(nil), "kava.evmutil.v1beta1.MsgConvertCosmosCoinFromERC20")
-	proto.RegisterType((*MsgConvertCosmosCoinFromERC20Response)(nil), "kava.evmutil.v1beta1.MsgConvertCosmosCoinFromERC20Response")
+// MsgRegisterERC20ConversionPair defines a permissionless registration of an EVM-native ERC20 as a conversion pair.
+type MsgRegisterERC20ConversionPair struct {
+	// Kava bech32 address initiating the registration.
+	Initiator string `protobuf:"bytes,1,opt,name=initiator,proto3" json:"initiator,omitempty"`
+	// EVM 0x hex address of the ERC20 contract.
+	KavaERC20Address string `protobuf:"bytes,2,opt,name=kava_erc20_address,json=kavaErc20Address,proto3" json:"kava_erc20_address,omitempty"`
 }
 
-func init() { proto.RegisterFile("kava/evmutil/v1beta1/tx.proto", fileDescriptor_6e82783c6c58f89c) }
+func (m *MsgRegisterERC20ConversionPair) Reset()         { *m = MsgRegisterERC20ConversionPair{} }
+func (m *MsgRegisterERC20ConversionPair) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterERC20ConversionPair) ProtoMessage()    {}
+func (*MsgRegisterERC20ConversionPair) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e82783c6c58f89c, []int{8}
+}
+func (m *MsgRegisterERC20ConversionPair) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterERC20ConversionPair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterERC20ConversionPair.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterERC20ConversionPair) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterERC20ConversionPair.Merge(m, src)
+}
+func (m *MsgRegisterERC20ConversionPair) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterERC20ConversionPair) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterERC20ConversionPair.DiscardUnknown(m)
+}
 
-var fileDescriptor_6e82783c6c58f89c = []byte{
-	// 559 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0x41, 0x6b, 0xd4, 0x4c,
-	0x18, 0xde, 0x69, 0x4b, 0xf9, 0x76, 0xbe, 0x4b, 0x19, 0x56, 0x48, 0xa3, 0xcd, 0x96, 0x95, 0x6a,
-	0x45, 0x36, 0xe9, 0xee, 0x8a, 0x20, 0x7a, 0x71, 0x97, 0x0a, 0xa5, 0xf4, 0x12, 0xf7, 0xe4, 0x65,
-	0x99, 0x64, 0x87, 0x18, 0xda, 0x64, 0x96, 0xcc, 0x6c, 0xa8, 0x3f, 0x40, 0x10, 0x11, 0xd1, 0x3f,
-	0xe0, 0xd9, 0x1f, 0xd0, 0x1f, 0xd1, 0x63, 0xe9, 0x49, 0x3c, 0x2c, 0x35, 0xfb, 0x47, 0x64, 0x92,
-	0x49, 0x3a, 0xd6, 0x98, 0xb5, 0x22, 0x78, 0xda, 0xcc, 0xbc, 0xcf, 0xf3, 0xbe, 0xcf, 0xf3, 0xbe,
-	0x33, 0xb3, 0x70, 0xe3, 0x10, 0xc7, 0xd8, 0x22, 0x71, 0x30, 0xe5, 0xfe, 0x91, 0x15, 0x77, 0x1c,
-	0xc2, 0x71, 0xc7, 0xe2, 0xc7, 0xe6, 0x24, 0xa2, 0x9c, 0xa2, 0x86, 0x08, 0x9b, 0x32, 0x6c, 0xca,
-	0xb0, 0x6e, 0xb8, 0x94, 0x05, 0x94, 0x59, 0x0e, 0x66, 0xa4, 0xe0, 0xb8, 0xd4, 0x0f, 0x33, 0x96,
-	0xbe, 0x9e, 0xc5, 0x47, 0xe9, 0xca, 0xca, 0x16, 0x32, 0xd4, 0xf0, 0xa8, 0x47, 0xb3, 0x7d, 0xf1,
-	0x95, 0xed, 0xb6, 0x3e, 0x01, 0x78, 0xe3, 0x80, 0x79, 0x03, 0x1a, 0xc6, 0x24, 0xe2, 0x03, 0xea,
-	0x87, 0x43, 0xba, 0x6b, 0x0f, 0xba, 0x3b, 0xe8, 0x21, 0xac, 0xfb, 0xa1, 0xcf, 0x7d, 0xcc, 0x69,
-	0xa4, 0x81, 0x4d, 0xb0, 0x5d, 0xef, 0x6b, 0xe7, 0x27, 0xed, 0x86, 0x4c, 0xfa, 0x74, 0x3c, 0x8e,
-	0x08, 0x63, 0xcf, 0x79, 0xe4, 0x87, 0x9e, 0x7d, 0x09, 0x45, 0x3a, 0xfc, 0x2f, 0x22, 0x2e, 0xf1,
-	0x63, 0x12, 0x69, 0x4b, 0x82, 0x66, 0x17, 0x6b, 0xd4, 0x81, 0xab, 0x38, 0xa0, 0xd3, 0x90, 0x6b,
-	0xcb, 0x9b, 0x60, 0xfb, 0xff, 0xee, 0xba, 0x29, 0xb3, 0x09, 0x3f, 0xb9, 0x49, 0x53, 0xa8, 0xb0,
-	0x25, 0xb0, 0xd5, 0x84, 0x1b, 0xa5, 0xfa, 0x6c, 0xc2, 0x26, 0x34, 0x64, 0xa4, 0xf5, 0x7a, 0x49,
-	0x75, 0x90, 0xc6, 0x86, 0x54, 0x00, 0xd1, 0xad, 0x9f, 0x1c, 0xa8, 0x3a, 0x1f, 0x5c, 0xd5, 0x59,
-	0x61, 0xef, 0xd2, 0x41, 0x1f, 0x22, 0x31, 0x98, 0x11, 0x89, 0xdc, 0xee, 0xce, 0x08, 0x67, 0xa8,
-	0xd4, 0x4d, 0xbd, 0xdf, 0x48, 0x66, 0xcd, 0xb5, 0x7d, 0x1c, 0xe3, 0x54, 0x84, 0xcc, 0x60, 0xaf,
-	0x09, 0xfc, 0xae, 0x80, 0xcb, 0x1d, 0x34, 0x2c, 0xba, 0xb0, 0x92, 0xf2, 0x9e, 0x9c, 0xce, 0x9a,
-	0xb5, 0xaf, 0xb3, 0xe6, 0x1d, 0xcf, 0xe7, 0x2f, 0xa7, 0x8e, 0xe9, 0xd2, 0x40, 0x8e, 0x4e, 0xfe,
-	0xb4, 0xd9, 0xf8, 0xd0, 0xe2, 0xaf, 0x26, 0x84, 0x99, 0x7b, 0x21, 0x3f, 0x3f, 0x69, 0x43, 0xa9,
-	0x72, 0x2f, 0xe4, 0xe5, 0x8d, 0x52, 0xda, 0x50, 0x34, 0xea, 0x2d, 0x80, 0x37, 0xd5, 0x56, 0x8a,
-	0x0c, 0xea, 0xc0, 0xab, 0xdb, 0xf5, 0x97, 0xc7, 0xba, 0x05, 0x6f, 0x57, 0x68, 0x29, 0x34, 0xbf,
-	0x03, 0x3f, 0x8e, 0x3f, 0xc7, 0x3d, 0x8b, 0x68, 0xf0, 0x0f, 0x54, 0xdf, 0x85, 0x5b, 0x95, 0x6a,
-	0x72, 0xdd, 0xdd, 0x8f, 0x2b, 0x70, 0xf9, 0x80, 0x79, 0x28, 0x86, 0xa8, 0xe4, 0x6a, 0xdd, 0x37,
-	0xcb, 0x2e, 0xb7, 0x59, 0x7a, 0xce, 0xf5, 0xde, 0x35, 0xc0, 0x79, 0x7d, 0xa5, 0xae, 0x7a, 0x21,
-	0x16, 0xd6, 0x55, 0xc0, 0x8b, 0xeb, 0x96, 0x9c, 0x31, 0xf4, 0x06, 0x40, 0xed, 0x97, 0x07, 0xac,
-	0xb3, 0xd8, 0xc9, 0x15, 0x8a, 0xfe, 0xe8, 0xda, 0x94, 0x42, 0xca, 0x7b, 0x00, 0xf5, 0x8a, 0x73,
-	0xd3, 0xfb, 0xfd, 0xcc, 0x05, 0x49, 0x7f, 0xfc, 0x07, 0xa4, 0x5c, 0x50, 0x7f, 0xff, 0xe2, 0x9b,
-	0x01, 0x3e, 0x27, 0x06, 0x38, 0x4d, 0x0c, 0x70, 0x96, 0x18, 0xe0, 0x22, 0x31, 0xc0, 0x87, 0xb9,
-	0x51, 0x3b, 0x9b, 0x1b, 0xb5, 0x2f, 0x73, 0xa3, 0xf6, 0xe2, 0x9e, 0xf2, 0x00, 0x88, 0x42, 0xed,
-	0x23, 0xec, 0xb0, 0xf4, 0xcb, 0x3a, 0x2e, 0xfe, 0x29, 0xd2, 0x77, 0xc0, 0x59, 0x4d, 0x9f, 0xef,
-	0xde, 0xf7, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa2, 0x5a, 0x1f, 0x90, 0x46, 0x06, 0x00, 0x00,
+var xxx_messageInfo_MsgRegisterERC20ConversionPair proto.InternalMessageInfo
+
+func (m *MsgRegisterERC20ConversionPair) GetInitiator() string {
+	if m != nil {
+		return m.Initiator
+	}
+	return ""
 }
 
-func (this *MsgConvertCoinToERC20) VerboseEqual(that interface{}) error {
-	if that == nil {
-		if this == nil {
-			return nil
-		}
-		return fmt.Errorf("that == nil && this != nil")
+func (m *MsgRegisterERC20ConversionPair) GetKavaERC20Address() string {
+	if m != nil {
+		return m.KavaERC20Address
 	}
+	return ""
+}
 
-	that1, ok := that.(*MsgConvertCoinToERC20)
-	if !ok {
-		that2, ok := that.(MsgConvertCoinToERC20)
-		if ok {
-			that1 = &that2
-		} else {
-			return fmt.Errorf("that is not of type *MsgConvertCoinToERC20")
+// MsgRegisterERC20ConversionPairResponse defines the response value from Msg/RegisterERC20ConversionPair.
+type MsgRegisterERC20ConversionPairResponse struct {
+	// Denom of the sdk.Coin created for the registered ERC20.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *MsgRegisterERC20ConversionPairResponse) Reset() {
+	*m = MsgRegisterERC20ConversionPairResponse{}
+}
+func (m *MsgRegisterERC20ConversionPairResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterERC20ConversionPairResponse) ProtoMessage()    {}
+func (*MsgRegisterERC20ConversionPairResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e82783c6c58f89c, []int{9}
+}
+func (m *MsgRegisterERC20ConversionPairResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterERC20ConversionPairResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterERC20ConversionPairResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		if this == nil {
-			return nil
-		}
-		return fmt.Errorf("that is type *MsgConvertCoinToERC20 but is nil && this != nil")
-	} else if this == nil {
-		return fmt.Errorf("that is type *MsgConvertCoinToERC20 but is not nil && this == nil")
+}
+func (m *MsgRegisterERC20ConversionPairResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterERC20ConversionPairResponse.Merge(m, src)
+}
+func (m *MsgRegisterERC20ConversionPairResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterERC20ConversionPairResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterERC20ConversionPairResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterERC20ConversionPairResponse proto.InternalMessageInfo
+
+func (m *MsgRegisterERC20ConversionPairResponse) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if this.Initiator != that1.Initiator {
-		return fmt.Errorf("Initiator this(%v) Not Equal that(%v)", this.Initiator, that1.Initiator)
+	return ""
+}
+
+// MsgIBCTransferERC20 defines a conversion from Kava ERC20 to sdk.Coin for EVM-native assets followed by an
+// ICS-20 transfer of the converted sdk.Coin.
+type MsgIBCTransferERC20 struct {
+	// EVM 0x hex address initiating the conversion and sending the transfer.
+	Initiator string `protobuf:"bytes,1,opt,name=initiator,proto3" json:"initiator,omitempty"`
+	// EVM 0x hex address of the ERC20 contract.
+	KavaERC20Address string `protobuf:"bytes,2,opt,name=kava_erc20_address,json=kavaErc20Address,proto3" json:"kava_erc20_address,omitempty"`
+	// ERC20 token amount to convert and transfer.
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// Port on which the packet will be sent.
+	SourcePort string `protobuf:"bytes,4,opt,name=source_port,json=sourcePort,proto3" json:"source_port,omitempty"`
+	// Channel by which the packet will be sent.
+	SourceChannel string `protobuf:"bytes,5,opt,name=source_channel,json=sourceChannel,proto3" json:"source_channel,omitempty"`
+	// Recipient address on the destination chain.
+	Receiver string `protobuf:"bytes,6,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// Timeout height relative to the current block height.
+	// The timeout is disabled when set to 0.
+	TimeoutHeight types1.Height `protobuf:"bytes,7,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height"`
+	// Timeout timestamp in absolute nanoseconds since unix epoch.
+	// The timeout is disabled when set to 0.
+	TimeoutTimestamp uint64 `protobuf:"varint,8,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
+	// Optional memo of the ICS-20 transfer.
+	Memo string `protobuf:"bytes,9,opt,name=memo,proto3" json:"memo,omitempty"`
+}
+
+func (m *MsgIBCTransferERC20) Reset()         { *m = MsgIBCTransferERC20{} }
+func (m *MsgIBCTransferERC20) String() string { return proto.CompactTextString(m) }
+func (*MsgIBCTransferERC20) ProtoMessage()    {}
+func (*MsgIBCTransferERC20) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e82783c6c58f89c, []int{10}
+}
+func (m *MsgIBCTransferERC20) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgIBCTransferERC20) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgIBCTransferERC20.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.Receiver != that1.Receiver {
-		return fmt.Errorf("Receiver this(%v) Not Equal that(%v)", this.Receiver, that1.Receiver)
+}
+func (m *MsgIBCTransferERC20) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgIBCTransferERC20.Merge(m, src)
+}
+func (m *MsgIBCTransferERC20) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgIBCTransferERC20) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgIBCTransferERC20.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgIBCTransferERC20 proto.InternalMessageInfo
+
+func (m *MsgIBCTransferERC20) GetInitiator() string {
+	if m != nil {
+		return m.Initiator
 	}
-	if !this.Amount.Equal(that1.Amount) {
-		return fmt.Errorf("Amount this(%v) Not Equal that(%v)", this.Amount, that1.Amount)
+	return ""
+}
+
+func (m *MsgIBCTransferERC20) GetKavaERC20Address() string {
+	if m != nil {
+		return m.KavaERC20Address
 	}
-	return nil
+	return ""
 }
-func (this *MsgConvertCoinToERC20) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *MsgIBCTransferERC20) GetSourcePort() string {
+	if m != nil {
+		return m.SourcePort
 	}
+	return ""
+}
 
-	that1, ok := that.(*MsgConvertCoinToERC20)
-	if !ok {
-		that2, ok := that.(MsgConvertCoinToERC20)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *MsgIBCTransferERC20) GetSourceChannel() string {
+	if m != nil {
+		return m.SourceChannel
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return ""
+}
+
+func (m *MsgIBCTransferERC20) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
 	}
-	if this.Initiator != that1.Initiator {
-		return false
+	return ""
+}
+
+func (m *MsgIBCTransferERC20) GetTimeoutHeight() types1.Height {
+	if m != nil {
+		return m.TimeoutHeight
 	}
-	if this.Receiver != that1.Receiver {
-		return false
+	return types1.Height{}
+}
+
+func (m *MsgIBCTransferERC20) GetTimeoutTimestamp() uint64 {
+	if m != nil {
+		return m.TimeoutTimestamp
 	}
-	if !this.Amount.Equal(that1.Amount) {
-		return false
+	return 0
+}
+
+func (m *MsgIBCTransferERC20) GetMemo() string {
+	if m != nil {
+		return m.Memo
 	}
-	return true
+	return ""
 }
-func (this *MsgConvertCoinToERC20Response) VerboseEqual(that interface{}) error {
-	if that == nil {
-		if this == nil {
-			return nil
+
+// MsgIBCTransferERC20Response defines the response value from Msg/IBCTransferERC20.
+type MsgIBCTransferERC20Response struct {
+	// Sequence number of the ICS-20 transfer packet.
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *MsgIBCTransferERC20Response) Reset()         { *m = MsgIBCTransferERC20Response{} }
+func (m *MsgIBCTransferERC20Response) String() string { return proto.CompactTextString(m) }
+func (*MsgIBCTransferERC20Response) ProtoMessage()    {}
+func (*MsgIBCTransferERC20Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e82783c6c58f89c, []int{11}
+}
+func (m *MsgIBCTransferERC20Response) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgIBCTransferERC20Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgIBCTransferERC20Response.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		return fmt.Errorf("that == nil && this != nil")
+		return b[:n], nil
 	}
+}
+func (m *MsgIBCTransferERC20Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgIBCTransferERC20Response.Merge(m, src)
+}
+func (m *MsgIBCTransferERC20Response) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgIBCTransferERC20Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgIBCTransferERC20Response.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*MsgConvertCoinToERC20Response)
-	if !ok {
-		that2, ok := that.(MsgConvertCoinToERC20Response)
-		if ok {
-			that1 = &that2
-		} else {
-			return fmt.Errorf("that is not of type *MsgConvertCoinToERC20Response")
-		}
+var xxx_messageInfo_MsgIBCTransferERC20Response proto.InternalMessageInfo
+
+func (m *MsgIBCTransferERC20Response) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
 	}
-	if that1 == nil {
-		if this == nil {
-			return nil
+	return 0
+}
+
+// MsgUpdateCosmosCoinERC20Metadata defines a governance update of the name and symbol of the deployed ERC20
+// representing a cosmos sdk.Coin.
+type MsgUpdateCosmosCoinERC20Metadata struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Denom of the cosmos sdk.Coin represented by the ERC20.
+	CosmosDenom string `protobuf:"bytes,2,opt,name=cosmos_denom,json=cosmosDenom,proto3" json:"cosmos_denom,omitempty"`
+	// New name of the ERC20 token.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// New symbol of the ERC20 token.
+	Symbol string `protobuf:"bytes,4,opt,name=symbol,proto3" json:"symbol,omitempty"`
+}
+
+func (m *MsgUpdateCosmosCoinERC20Metadata) Reset()         { *m = MsgUpdateCosmosCoinERC20Metadata{} }
+func (m *MsgUpdateCosmosCoinERC20Metadata) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateCosmosCoinERC20Metadata) ProtoMessage()    {}
+func (*MsgUpdateCosmosCoinERC20Metadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e82783c6c58f89c, []int{12}
+}
+func (m *MsgUpdateCosmosCoinERC20Metadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateCosmosCoinERC20Metadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateCosmosCoinERC20Metadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		return fmt.Errorf("that is type *MsgConvertCoinToERC20Response but is nil && this != nil")
-	} else if this == nil {
-		return fmt.Errorf("that is type *MsgConvertCoinToERC20Response but is not nil && this == nil")
+		return b[:n], nil
 	}
-	return nil
 }
-func (this *MsgConvertCoinToERC20Response) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *MsgUpdateCosmosCoinERC20Metadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateCosmosCoinERC20Metadata.Merge(m, src)
+}
+func (m *MsgUpdateCosmosCoinERC20Metadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateCosmosCoinERC20Metadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateCosmosCoinERC20Metadata.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*MsgConvertCoinToERC20Response)
-	if !ok {
-		that2, ok := that.(MsgConvertCoinToERC20Response)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+var xxx_messageInfo_MsgUpdateCosmosCoinERC20Metadata proto.InternalMessageInfo
+
+func (m *MsgUpdateCosmosCoinERC20Metadata) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return ""
+}
+
+func (m *MsgUpdateCosmosCoinERC20Metadata) GetCosmosDenom() string {
+	if m != nil {
+		return m.CosmosDenom
 	}
-	return true
+	return ""
 }
-func (this *MsgConvertERC20ToCoin) VerboseEqual(that interface{}) error {
-	if that == nil {
-		if this == nil {
-			return nil
-		}
-		return fmt.Errorf("that == nil && this != nil")
+
+func (m *MsgUpdateCosmosCoinERC20Metadata) GetName() string {
+	if m != nil {
+		return m.Name
 	}
+	return ""
+}
 
-	that1, ok := that.(*MsgConvertERC20ToCoin)
+func (m *MsgUpdateCosmosCoinERC20Metadata) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+// MsgUpdateCosmosCoinERC20MetadataResponse defines the response value from Msg/UpdateCosmosCoinERC20Metadata.
+type MsgUpdateCosmosCoinERC20MetadataResponse struct {
+}
+
+func (m *MsgUpdateCosmosCoinERC20MetadataResponse) Reset() {
+	*m = MsgUpdateCosmosCoinERC20MetadataResponse{}
+}
+func (m *MsgUpdateCosmosCoinERC20MetadataResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateCosmosCoinERC20MetadataResponse) ProtoMessage()    {}
+func (*MsgUpdateCosmosCoinERC20MetadataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e82783c6c58f89c, []int{13}
+}
+func (m *MsgUpdateCosmosCoinERC20MetadataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateCosmosCoinERC20MetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateCosmosCoinERC20MetadataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateCosmosCoinERC20MetadataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateCosmosCoinERC20MetadataResponse.Merge(m, src)
+}
+func (m *MsgUpdateCosmosCoinERC20MetadataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateCosmosCoinERC20MetadataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateCosmosCoinERC20MetadataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateCosmosCoinERC20MetadataResponse proto.InternalMessageInfo
+
+// MsgSetCosmosCoinConversionPaused defines a governance pause of conversions of a single cosmos sdk.Coin denom.
+type MsgSetCosmosCoinConversionPaused struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Denom of the cosmos sdk.Coin to pause or unpause.
+	CosmosDenom string `protobuf:"bytes,2,opt,name=cosmos_denom,json=cosmosDenom,proto3" json:"cosmos_denom,omitempty"`
+	// Paused indicates if conversions of the denom are paused.
+	Paused bool `protobuf:"varint,3,opt,name=paused,proto3" json:"paused,omitempty"`
+}
+
+func (m *MsgSetCosmosCoinConversionPaused) Reset()         { *m = MsgSetCosmosCoinConversionPaused{} }
+func (m *MsgSetCosmosCoinConversionPaused) String() string { return proto.CompactTextString(m) }
+func (*MsgSetCosmosCoinConversionPaused) ProtoMessage()    {}
+func (*MsgSetCosmosCoinConversionPaused) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e82783c6c58f89c, []int{14}
+}
+func (m *MsgSetCosmosCoinConversionPaused) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetCosmosCoinConversionPaused) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetCosmosCoinConversionPaused.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetCosmosCoinConversionPaused) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetCosmosCoinConversionPaused.Merge(m, src)
+}
+func (m *MsgSetCosmosCoinConversionPaused) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetCosmosCoinConversionPaused) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetCosmosCoinConversionPaused.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetCosmosCoinConversionPaused proto.InternalMessageInfo
+
+func (m *MsgSetCosmosCoinConversionPaused) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetCosmosCoinConversionPaused) GetCosmosDenom() string {
+	if m != nil {
+		return m.CosmosDenom
+	}
+	return ""
+}
+
+func (m *MsgSetCosmosCoinConversionPaused) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+// MsgSetCosmosCoinConversionPausedResponse defines the response value from Msg/SetCosmosCoinConversionPaused.
+type MsgSetCosmosCoinConversionPausedResponse struct {
+}
+
+func (m *MsgSetCosmosCoinConversionPausedResponse) Reset() {
+	*m = MsgSetCosmosCoinConversionPausedResponse{}
+}
+func (m *MsgSetCosmosCoinConversionPausedResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetCosmosCoinConversionPausedResponse) ProtoMessage()    {}
+func (*MsgSetCosmosCoinConversionPausedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e82783c6c58f89c, []int{15}
+}
+func (m *MsgSetCosmosCoinConversionPausedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetCosmosCoinConversionPausedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetCosmosCoinConversionPausedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetCosmosCoinConversionPausedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetCosmosCoinConversionPausedResponse.Merge(m, src)
+}
+func (m *MsgSetCosmosCoinConversionPausedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetCosmosCoinConversionPausedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetCosmosCoinConversionPausedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetCosmosCoinConversionPausedResponse proto.InternalMessageInfo
+
+// MsgMigrateCosmosCoinERC20 defines a governance migration of a cosmos sdk.Coin denom to a newly deployed ERC20
+// contract.
+type MsgMigrateCosmosCoinERC20 struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Denom of the cosmos sdk.Coin to migrate.
+	CosmosDenom string `protobuf:"bytes,2,opt,name=cosmos_denom,json=cosmosDenom,proto3" json:"cosmos_denom,omitempty"`
+}
+
+func (m *MsgMigrateCosmosCoinERC20) Reset()         { *m = MsgMigrateCosmosCoinERC20{} }
+func (m *MsgMigrateCosmosCoinERC20) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateCosmosCoinERC20) ProtoMessage()    {}
+func (*MsgMigrateCosmosCoinERC20) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e82783c6c58f89c, []int{16}
+}
+func (m *MsgMigrateCosmosCoinERC20) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateCosmosCoinERC20) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateCosmosCoinERC20.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateCosmosCoinERC20) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateCosmosCoinERC20.Merge(m, src)
+}
+func (m *MsgMigrateCosmosCoinERC20) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateCosmosCoinERC20) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateCosmosCoinERC20.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateCosmosCoinERC20 proto.InternalMessageInfo
+
+func (m *MsgMigrateCosmosCoinERC20) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgMigrateCosmosCoinERC20) GetCosmosDenom() string {
+	if m != nil {
+		return m.CosmosDenom
+	}
+	return ""
+}
+
+// MsgMigrateCosmosCoinERC20Response defines the response value from Msg/MigrateCosmosCoinERC20.
+type MsgMigrateCosmosCoinERC20Response struct {
+	// EVM 0x hex address of the new ERC20 contract.
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+}
+
+func (m *MsgMigrateCosmosCoinERC20Response) Reset()         { *m = MsgMigrateCosmosCoinERC20Response{} }
+func (m *MsgMigrateCosmosCoinERC20Response) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateCosmosCoinERC20Response) ProtoMessage()    {}
+func (*MsgMigrateCosmosCoinERC20Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e82783c6c58f89c, []int{17}
+}
+func (m *MsgMigrateCosmosCoinERC20Response) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateCosmosCoinERC20Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateCosmosCoinERC20Response.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateCosmosCoinERC20Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateCosmosCoinERC20Response.Merge(m, src)
+}
+func (m *MsgMigrateCosmosCoinERC20Response) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateCosmosCoinERC20Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateCosmosCoinERC20Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateCosmosCoinERC20Response proto.InternalMessageInfo
+
+func (m *MsgMigrateCosmosCoinERC20Response) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+// MsgSetConversionDirectionPaused defines a governance emergency pause of the conversions of a
+// denom in a direction.
+type MsgSetConversionDirectionPaused struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Direction of the conversions to pause or unpause.
+	Direction ConversionDirection `protobuf:"varint,2,opt,name=direction,proto3,enum=kava.evmutil.v1beta1.ConversionDirection" json:"direction,omitempty"`
+	// Paused indicates if conversions of the denom in the direction are paused.
+	Paused bool `protobuf:"varint,3,opt,name=paused,proto3" json:"paused,omitempty"`
+	// Denom of the sdk.Coin of an EVM-native conversion pair or a cosmos-native asset.
+	Denom string `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *MsgSetConversionDirectionPaused) Reset()         { *m = MsgSetConversionDirectionPaused{} }
+func (m *MsgSetConversionDirectionPaused) String() string { return proto.CompactTextString(m) }
+func (*MsgSetConversionDirectionPaused) ProtoMessage()    {}
+func (*MsgSetConversionDirectionPaused) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e82783c6c58f89c, []int{18}
+}
+func (m *MsgSetConversionDirectionPaused) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetConversionDirectionPaused) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetConversionDirectionPaused.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetConversionDirectionPaused) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetConversionDirectionPaused.Merge(m, src)
+}
+func (m *MsgSetConversionDirectionPaused) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetConversionDirectionPaused) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetConversionDirectionPaused.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetConversionDirectionPaused proto.InternalMessageInfo
+
+func (m *MsgSetConversionDirectionPaused) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetConversionDirectionPaused) GetDirection() ConversionDirection {
+	if m != nil {
+		return m.Direction
+	}
+	return CONVERSION_DIRECTION_UNSPECIFIED
+}
+
+func (m *MsgSetConversionDirectionPaused) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *MsgSetConversionDirectionPaused) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// MsgSetConversionDirectionPausedResponse defines the response value from Msg/SetConversionDirectionPaused.
+type MsgSetConversionDirectionPausedResponse struct {
+}
+
+func (m *MsgSetConversionDirectionPausedResponse) Reset() {
+	*m = MsgSetConversionDirectionPausedResponse{}
+}
+func (m *MsgSetConversionDirectionPausedResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetConversionDirectionPausedResponse) ProtoMessage()    {}
+func (*MsgSetConversionDirectionPausedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e82783c6c58f89c, []int{19}
+}
+func (m *MsgSetConversionDirectionPausedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetConversionDirectionPausedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetConversionDirectionPausedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetConversionDirectionPausedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetConversionDirectionPausedResponse.Merge(m, src)
+}
+func (m *MsgSetConversionDirectionPausedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetConversionDirectionPausedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetConversionDirectionPausedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetConversionDirectionPausedResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgConvertCoinToERC20)(nil), "kava.evmutil.v1beta1.MsgConvertCoinToERC20")
+	proto.RegisterType((*MsgConvertCoinToERC20Response)(nil), "kava.evmutil.v1beta1.MsgConvertCoinToERC20Response")
+	proto.RegisterType((*MsgConvertERC20ToCoin)(nil), "kava.evmutil.v1beta1.MsgConvertERC20ToCoin")
+	proto.RegisterType((*MsgConvertERC20ToCoinResponse)(nil), "kava.evmutil.v1beta1.MsgConvertERC20ToCoinResponse")
+	proto.RegisterType((*MsgConvertCosmosCoinToERC20)(nil), "kava.evmutil.v1beta1.MsgConvertCosmosCoinToERC20")
+	proto.RegisterType((*MsgConvertCosmosCoinToERC20Response)(nil), "kava.evmutil.v1beta1.MsgConvertCosmosCoinToERC20Response")
+	proto.RegisterType((*MsgConvertCosmosCoinFromERC20)(nil), "kava.evmutil.v1beta1.MsgConvertCosmosCoinFromERC20")
+	proto.RegisterType((*MsgConvertCosmosCoinFromERC20Response)(nil), "kava.evmutil.v1beta1.MsgConvertCosmosCoinFromERC20Response")
+	proto.RegisterType((*MsgRegisterERC20ConversionPair)(nil), "kava.evmutil.v1beta1.MsgRegisterERC20ConversionPair")
+	proto.RegisterType((*MsgRegisterERC20ConversionPairResponse)(nil), "kava.evmutil.v1beta1.MsgRegisterERC20ConversionPairResponse")
+	proto.RegisterType((*MsgIBCTransferERC20)(nil), "kava.evmutil.v1beta1.MsgIBCTransferERC20")
+	proto.RegisterType((*MsgIBCTransferERC20Response)(nil), "kava.evmutil.v1beta1.MsgIBCTransferERC20Response")
+	proto.RegisterType((*MsgUpdateCosmosCoinERC20Metadata)(nil), "kava.evmutil.v1beta1.MsgUpdateCosmosCoinERC20Metadata")
+	proto.RegisterType((*MsgUpdateCosmosCoinERC20MetadataResponse)(nil), "kava.evmutil.v1beta1.MsgUpdateCosmosCoinERC20MetadataResponse")
+	proto.RegisterType((*MsgSetCosmosCoinConversionPaused)(nil), "kava.evmutil.v1beta1.MsgSetCosmosCoinConversionPaused")
+	proto.RegisterType((*MsgSetCosmosCoinConversionPausedResponse)(nil), "kava.evmutil.v1beta1.MsgSetCosmosCoinConversionPausedResponse")
+	proto.RegisterType((*MsgMigrateCosmosCoinERC20)(nil), "kava.evmutil.v1beta1.MsgMigrateCosmosCoinERC20")
+	proto.RegisterType((*MsgMigrateCosmosCoinERC20Response)(nil), "kava.evmutil.v1beta1.MsgMigrateCosmosCoinERC20Response")
+	proto.RegisterType((*MsgSetConversionDirectionPaused)(nil), "kava.evmutil.v1beta1.MsgSetConversionDirectionPaused")
+	proto.RegisterType((*MsgSetConversionDirectionPausedResponse)(nil), "kava.evmutil.v1beta1.MsgSetConversionDirectionPausedResponse")
+}
+
+func init() { proto.RegisterFile("kava/evmutil/v1beta1/tx.proto", fileDescriptor_6e82783c6c58f89c) }
+
+var fileDescriptor_6e82783c6c58f89c = []byte{
+	// 1131 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xb6, 0x6e, 0x48, 0x5e, 0x69, 0x08, 0x4b, 0x88, 0x9c, 0x4d, 0x63, 0xa7, 0x46, 0x69,
+	0x13, 0xaa, 0xec, 0xd6, 0x49, 0x01, 0x15, 0x4a, 0x24, 0x9c, 0x96, 0x12, 0x55, 0x46, 0xd5, 0xd6,
+	0x5c, 0xb8, 0x58, 0xe3, 0xf5, 0xb0, 0x19, 0xd5, 0xbb, 0x63, 0x66, 0xc6, 0x56, 0x73, 0x45, 0x42,
+	0x42, 0x08, 0x21, 0x90, 0x40, 0x5c, 0x10, 0xca, 0x11, 0xee, 0xfd, 0x0b, 0x48, 0x3d, 0x46, 0x3d,
+	0x21, 0x0e, 0x51, 0x49, 0x0e, 0xf0, 0x33, 0xd0, 0xee, 0xce, 0x8e, 0x37, 0xc9, 0x7a, 0x9d, 0x98,
+	0x54, 0x9c, 0x3c, 0xf3, 0xe6, 0x7d, 0x6f, 0xbe, 0xef, 0xbd, 0x37, 0xb3, 0x63, 0x98, 0x7f, 0x84,
+	0x7a, 0xc8, 0xc2, 0x3d, 0xaf, 0x2b, 0x48, 0xdb, 0xea, 0x55, 0x9a, 0x58, 0xa0, 0x8a, 0x25, 0x1e,
+	0x9b, 0x1d, 0x46, 0x05, 0xd5, 0xa7, 0x83, 0x65, 0x53, 0x2e, 0x9b, 0x72, 0xd9, 0x28, 0x3a, 0x94,
+	0x7b, 0x94, 0x5b, 0x4d, 0xc4, 0xb1, 0xc2, 0x38, 0x94, 0xf8, 0x11, 0xca, 0x98, 0x8d, 0xd6, 0x1b,
+	0xe1, 0xcc, 0x8a, 0x26, 0x72, 0x69, 0xda, 0xa5, 0x2e, 0x8d, 0xec, 0xc1, 0x48, 0x5a, 0x4b, 0xa4,
+	0xe9, 0x58, 0x0e, 0x65, 0xd8, 0x72, 0xda, 0x04, 0xfb, 0xc2, 0xea, 0x55, 0xe4, 0x48, 0x3a, 0x2c,
+	0xa6, 0xd2, 0x64, 0x48, 0xe0, 0x46, 0x9b, 0x78, 0x44, 0xba, 0x95, 0x7f, 0xd1, 0xe0, 0xf5, 0x1a,
+	0x77, 0x37, 0xa8, 0xdf, 0xc3, 0x4c, 0x6c, 0x50, 0xe2, 0xd7, 0xe9, 0x5d, 0x7b, 0x63, 0xf5, 0x86,
+	0xfe, 0x36, 0x4c, 0x10, 0x9f, 0x08, 0x82, 0x04, 0x65, 0x05, 0x6d, 0x41, 0x5b, 0x9a, 0xa8, 0x16,
+	0x9e, 0x3d, 0x59, 0x99, 0x96, 0xe4, 0x3e, 0x68, 0xb5, 0x18, 0xe6, 0xfc, 0xa1, 0x60, 0xc4, 0x77,
+	0xed, 0xbe, 0xab, 0x6e, 0xc0, 0x38, 0xc3, 0x0e, 0x26, 0x3d, 0xcc, 0x0a, 0xe7, 0x02, 0x98, 0xad,
+	0xe6, 0x7a, 0x05, 0xc6, 0x90, 0x47, 0xbb, 0xbe, 0x28, 0x9c, 0x5f, 0xd0, 0x96, 0x2e, 0xae, 0xce,
+	0x9a, 0x32, 0x5a, 0x90, 0x97, 0x38, 0x59, 0x66, 0xc0, 0xc2, 0x96, 0x8e, 0xe5, 0x12, 0xcc, 0xa7,
+	0xf2, 0xb3, 0x31, 0xef, 0x50, 0x9f, 0xe3, 0xf2, 0x97, 0xe7, 0x92, 0x0a, 0xc2, 0xb5, 0x3a, 0x0d,
+	0x1c, 0xf5, 0xcb, 0xc7, 0x14, 0x24, 0x79, 0xde, 0x3c, 0xca, 0x33, 0x43, 0x5e, 0x5f, 0x41, 0x15,
+	0xf4, 0x20, 0xb1, 0x0d, 0xcc, 0x9c, 0xd5, 0x1b, 0x0d, 0x14, 0x79, 0x85, 0x6a, 0x26, 0xaa, 0xd3,
+	0xfb, 0x7b, 0xa5, 0xa9, 0xfb, 0xa8, 0x87, 0x42, 0x12, 0x32, 0x82, 0x3d, 0x15, 0xf8, 0xdf, 0x65,
+	0x8e, 0xb2, 0xe8, 0x75, 0x95, 0x85, 0x7c, 0x88, 0xbb, 0xfd, 0x74, 0xaf, 0x94, 0xfb, 0x73, 0xaf,
+	0x74, 0xd5, 0x25, 0x62, 0xab, 0xdb, 0x34, 0x1d, 0xea, 0xc9, 0x16, 0x90, 0x3f, 0x2b, 0xbc, 0xf5,
+	0xc8, 0x12, 0xdb, 0x1d, 0xcc, 0xcd, 0x4d, 0x5f, 0x3c, 0x7b, 0xb2, 0x02, 0x92, 0xe5, 0xa6, 0x2f,
+	0xd2, 0x13, 0x95, 0x48, 0x83, 0x4a, 0xd4, 0xd7, 0x1a, 0xcc, 0x25, 0x53, 0x19, 0x44, 0x48, 0x16,
+	0x3c, 0x3b, 0x5d, 0x67, 0x5c, 0xd6, 0x45, 0x78, 0x23, 0x83, 0x8b, 0xe2, 0xfc, 0x8d, 0x06, 0xf3,
+	0x69, 0x7e, 0x1f, 0x32, 0xea, 0xfd, 0x0f, 0xac, 0xaf, 0xc1, 0x62, 0x26, 0x1b, 0xc5, 0xfb, 0x67,
+	0x0d, 0x8a, 0x35, 0xee, 0xda, 0xd8, 0x25, 0x5c, 0x60, 0x16, 0x2e, 0x46, 0x30, 0x4e, 0xa8, 0xff,
+	0x00, 0x11, 0x36, 0xf2, 0xf9, 0x4a, 0xef, 0xc0, 0x73, 0xa7, 0xe9, 0xc0, 0xf2, 0x3a, 0x5c, 0xcd,
+	0x66, 0x17, 0x0b, 0xd1, 0xa7, 0xe1, 0x42, 0x0b, 0xfb, 0xd4, 0x93, 0xa9, 0x8d, 0x26, 0xe5, 0xdf,
+	0xcf, 0xc3, 0x6b, 0x35, 0xee, 0x6e, 0x56, 0x37, 0xea, 0x0c, 0xf9, 0xfc, 0x33, 0xcc, 0x4e, 0x52,
+	0x8c, 0x33, 0x60, 0xae, 0xd7, 0x0f, 0x15, 0xed, 0x8c, 0xce, 0x8e, 0x5e, 0x82, 0x8b, 0x9c, 0x76,
+	0x99, 0x83, 0x1b, 0x1d, 0xca, 0xe4, 0xb1, 0xb4, 0x21, 0x32, 0x3d, 0xa0, 0x4c, 0xe8, 0x8b, 0x30,
+	0x29, 0x1d, 0x9c, 0x2d, 0xe4, 0xfb, 0xb8, 0x5d, 0xb8, 0x10, 0xfa, 0x5c, 0x8a, 0xac, 0x1b, 0x91,
+	0xf1, 0x50, 0xbb, 0x8d, 0x1d, 0x69, 0xb7, 0x7b, 0x30, 0x29, 0x88, 0x87, 0x69, 0x57, 0x34, 0xb6,
+	0x30, 0x71, 0xb7, 0x44, 0xe1, 0xa5, 0xb0, 0xed, 0x0c, 0x93, 0x34, 0x1d, 0x33, 0xb8, 0xca, 0x4d,
+	0x79, 0x81, 0xf7, 0x2a, 0xe6, 0x47, 0xa1, 0x47, 0x35, 0x1f, 0xa8, 0xb3, 0x2f, 0x49, 0x5c, 0x64,
+	0xd4, 0xaf, 0xc3, 0xab, 0x71, 0xa0, 0xe0, 0x97, 0x0b, 0xe4, 0x75, 0x0a, 0xe3, 0x0b, 0xda, 0x52,
+	0xde, 0x9e, 0x92, 0x0b, 0xf5, 0xd8, 0xae, 0xeb, 0x90, 0xf7, 0xb0, 0x47, 0x0b, 0x13, 0x21, 0x9b,
+	0x70, 0xfc, 0xee, 0xf8, 0xce, 0x4e, 0x29, 0xf7, 0xcf, 0x4e, 0x29, 0x57, 0xbe, 0x05, 0x73, 0x29,
+	0x65, 0x54, 0xc5, 0x37, 0x60, 0x9c, 0xe3, 0xcf, 0xbb, 0xd8, 0x77, 0x70, 0x58, 0xcd, 0xbc, 0xad,
+	0xe6, 0xe5, 0xdf, 0x34, 0x58, 0xa8, 0x71, 0xf7, 0x93, 0x4e, 0x0b, 0x09, 0xdc, 0x3f, 0x0a, 0x61,
+	0x80, 0x1a, 0x16, 0xa8, 0x85, 0x04, 0x0a, 0x7a, 0x1c, 0x75, 0xc5, 0x16, 0x65, 0x44, 0x6c, 0x0f,
+	0xef, 0x71, 0xe5, 0xaa, 0x5f, 0x81, 0x97, 0xe5, 0x07, 0x31, 0x6a, 0xbe, 0xe8, 0xe8, 0x5e, 0x8c,
+	0x6c, 0x77, 0x02, 0x53, 0x20, 0xcc, 0x47, 0x1e, 0x8e, 0xda, 0xc0, 0x0e, 0xc7, 0xfa, 0x0c, 0x8c,
+	0xf1, 0x6d, 0xaf, 0x49, 0xdb, 0xb2, 0x82, 0x72, 0x56, 0x7e, 0x13, 0x96, 0x86, 0x51, 0x55, 0x27,
+	0xf7, 0xc7, 0x48, 0xd7, 0x43, 0x9c, 0x38, 0xdf, 0xc9, 0xb3, 0xd1, 0xe5, 0xb8, 0xf5, 0x22, 0x75,
+	0xcd, 0xc0, 0x58, 0x27, 0xdc, 0x24, 0x54, 0x36, 0x6e, 0xcb, 0x99, 0xd4, 0x90, 0x49, 0x4b, 0x69,
+	0xe8, 0xc1, 0x6c, 0x8d, 0xbb, 0x35, 0xe2, 0xb2, 0xe3, 0x82, 0x5f, 0x20, 0xf7, 0xf2, 0xc7, 0x70,
+	0x65, 0xe0, 0xbe, 0xaa, 0xa9, 0x96, 0x61, 0xca, 0xa1, 0xbe, 0x60, 0xc8, 0x11, 0xea, 0x0e, 0x88,
+	0xae, 0x8a, 0x57, 0x62, 0x7b, 0x7c, 0x4d, 0xed, 0x6a, 0x50, 0x8a, 0x45, 0xc7, 0x52, 0xef, 0x10,
+	0x86, 0x1d, 0xf1, 0xdf, 0x4b, 0x71, 0x0f, 0x26, 0x5a, 0x71, 0xa8, 0x50, 0xcb, 0xe4, 0xea, 0xb2,
+	0x99, 0xf6, 0x76, 0x33, 0x53, 0xf6, 0xb6, 0xfb, 0xd8, 0x41, 0x05, 0xeb, 0xdf, 0x9c, 0xf9, 0xe4,
+	0xcd, 0xb9, 0x0c, 0xd7, 0x86, 0x28, 0x8a, 0x13, 0xb5, 0xfa, 0x37, 0xc0, 0xf9, 0x1a, 0x77, 0xf5,
+	0x1e, 0xe8, 0x29, 0xcf, 0xb3, 0xeb, 0xe9, 0x64, 0x53, 0xdf, 0x4a, 0xc6, 0xda, 0x29, 0x9c, 0x55,
+	0xa1, 0xfa, 0xfb, 0x26, 0x1f, 0x55, 0x43, 0xf7, 0x4d, 0x38, 0x1b, 0x6b, 0xa7, 0x70, 0x56, 0xfb,
+	0x7e, 0xa5, 0x41, 0x61, 0xe0, 0x23, 0xa5, 0x32, 0x5c, 0xc9, 0x11, 0x88, 0x71, 0xeb, 0xd4, 0x10,
+	0x45, 0xe5, 0x5b, 0x0d, 0x8c, 0x8c, 0xb7, 0xc7, 0xda, 0xc9, 0x23, 0x2b, 0x90, 0xf1, 0xde, 0x08,
+	0x20, 0x45, 0xe8, 0x7b, 0x0d, 0xe6, 0xb2, 0x1e, 0x15, 0x37, 0x07, 0x06, 0xcf, 0x40, 0x19, 0xb7,
+	0x47, 0x41, 0x29, 0x4e, 0x1d, 0x98, 0x3a, 0xf6, 0x10, 0x58, 0x1e, 0x18, 0xf1, 0xa8, 0xab, 0x51,
+	0x39, 0xb1, 0xab, 0xda, 0xf1, 0x27, 0x0d, 0xe6, 0x87, 0x7c, 0x78, 0x06, 0x06, 0xcd, 0xc4, 0x19,
+	0xeb, 0xa3, 0xe1, 0x0e, 0x31, 0x1b, 0xf2, 0xe9, 0x18, 0xb8, 0x43, 0x26, 0xce, 0x58, 0x1f, 0x0d,
+	0xa7, 0x98, 0x7d, 0xa1, 0xc1, 0xcc, 0x80, 0x2f, 0x82, 0x35, 0x30, 0x74, 0x3a, 0xc0, 0x78, 0xe7,
+	0x94, 0x00, 0x45, 0xe2, 0x07, 0x0d, 0x2e, 0x67, 0xde, 0xe6, 0x6f, 0x65, 0xab, 0x1c, 0x00, 0x33,
+	0xde, 0x1f, 0x09, 0x16, 0xd3, 0xaa, 0xde, 0x7f, 0xfe, 0x57, 0x51, 0xfb, 0x75, 0xbf, 0xa8, 0x3d,
+	0xdd, 0x2f, 0x6a, 0xbb, 0xfb, 0x45, 0xed, 0xf9, 0x7e, 0x51, 0xfb, 0xee, 0xa0, 0x98, 0xdb, 0x3d,
+	0x28, 0xe6, 0xfe, 0x38, 0x28, 0xe6, 0x3e, 0x5d, 0x4e, 0x3c, 0x2f, 0x83, 0xad, 0x56, 0xda, 0xa8,
+	0xc9, 0xc3, 0x91, 0xf5, 0x58, 0xfd, 0xc9, 0x0e, 0x5f, 0x99, 0xcd, 0xb1, 0xf0, 0x8f, 0xf5, 0xda,
+	0xbf, 0x03, 0x00, 0x5c, 0xf3, 0xf0, 0x44, 0x28, 0x10, 0x00, 0x00,
+}
+
+func (this *MsgConvertCoinToERC20) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*MsgConvertCoinToERC20)
 	if !ok {
-		that2, ok := that.(MsgConvertERC20ToCoin)
+		that2, ok := that.(MsgConvertCoinToERC20)
 		if ok {
 			that1 = &that2
 		} else {
-			return fmt.Errorf("that is not of type *MsgConvertERC20ToCoin")
+			return fmt.Errorf("that is not of type *MsgConvertCoinToERC20")
 		}
 	}
 	if that1 == nil {
 		if this == nil {
 			return nil
 		}
-		return fmt.Errorf("that is type *MsgConvertERC20ToCoin but is nil && this != nil")
+		return fmt.Errorf("that is type *MsgConvertCoinToERC20 but is nil && this != nil")
 	} else if this == nil {
-		return fmt.Errorf("that is type *MsgConvertERC20ToCoin but is not nil && this == nil")
+		return fmt.Errorf("that is type *MsgConvertCoinToERC20 but is not nil && this == nil")
 	}
 	if this.Initiator != that1.Initiator {
 		return fmt.Errorf("Initiator this(%v) Not Equal that(%v)", this.Initiator, that1.Initiator)
@@ -635,22 +1264,19 @@ func (this *MsgConvertERC20ToCoin) VerboseEqual(that interface{}) error {
 	if this.Receiver != that1.Receiver {
 		return fmt.Errorf("Receiver this(%v) Not Equal that(%v)", this.Receiver, that1.Receiver)
 	}
-	if this.KavaERC20Address != that1.KavaERC20Address {
-		return fmt.Errorf("KavaERC20Address this(%v) Not Equal that(%v)", this.KavaERC20Address, that1.KavaERC20Address)
-	}
 	if !this.Amount.Equal(that1.Amount) {
 		return fmt.Errorf("Amount this(%v) Not Equal that(%v)", this.Amount, that1.Amount)
 	}
 	return nil
 }
-func (this *MsgConvertERC20ToCoin) Equal(that interface{}) bool {
+func (this *MsgConvertCoinToERC20) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MsgConvertERC20ToCoin)
+	that1, ok := that.(*MsgConvertCoinToERC20)
 	if !ok {
-		that2, ok := that.(MsgConvertERC20ToCoin)
+		that2, ok := that.(MsgConvertCoinToERC20)
 		if ok {
 			that1 = &that2
 		} else {
@@ -668,15 +1294,12 @@ func (this *MsgConvertERC20ToCoin) Equal(that interface{}) bool {
 	if this.Receiver != that1.Receiver {
 		return false
 	}
-	if this.KavaERC20Address != that1.KavaERC20Address {
-		return false
-	}
 	if !this.Amount.Equal(that1.Amount) {
 		return false
 	}
 	return true
 }
-func (this *MsgConvertERC20ToCoinResponse) VerboseEqual(that interface{}) error {
+func (this *MsgConvertCoinToERC20Response) VerboseEqual(that interface{}) error {
 	if that == nil {
 		if this == nil {
 			return nil
@@ -684,20 +1307,140 @@ func (this *MsgConvertERC20ToCoinResponse) VerboseEqual(that interface{}) error
 		return fmt.Errorf("that == nil && this != nil")
 	}
 
-	that1, ok := that.(*MsgConvertERC20ToCoinResponse)
+	that1, ok := that.(*MsgConvertCoinToERC20Response)
 	if !ok {
-		that2, ok := that.(MsgConvertERC20ToCoinResponse)
+		that2, ok := that.(MsgConvertCoinToERC20Response)
 		if ok {
 			that1 = &that2
 		} else {
-			return fmt.Errorf("that is not of type *MsgConvertERC20ToCoinResponse")
+			return fmt.Errorf("that is not of type *MsgConvertCoinToERC20Response")
 		}
 	}
 	if that1 == nil {
 		if this == nil {
 			return nil
 		}
-		return fmt.Errorf("that is type *MsgConvertERC20ToCoinResponse but is nil && this != nil")
+		return fmt.Errorf("that is type *MsgConvertCoinToERC20Response but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *MsgConvertCoinToERC20Response but is not nil && this == nil")
+	}
+	return nil
+}
+func (this *MsgConvertCoinToERC20Response) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgConvertCoinToERC20Response)
+	if !ok {
+		that2, ok := that.(MsgConvertCoinToERC20Response)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *MsgConvertERC20ToCoin) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*MsgConvertERC20ToCoin)
+	if !ok {
+		that2, ok := that.(MsgConvertERC20ToCoin)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *MsgConvertERC20ToCoin")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *MsgConvertERC20ToCoin but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *MsgConvertERC20ToCoin but is not nil && this == nil")
+	}
+	if this.Initiator != that1.Initiator {
+		return fmt.Errorf("Initiator this(%v) Not Equal that(%v)", this.Initiator, that1.Initiator)
+	}
+	if this.Receiver != that1.Receiver {
+		return fmt.Errorf("Receiver this(%v) Not Equal that(%v)", this.Receiver, that1.Receiver)
+	}
+	if this.KavaERC20Address != that1.KavaERC20Address {
+		return fmt.Errorf("KavaERC20Address this(%v) Not Equal that(%v)", this.KavaERC20Address, that1.KavaERC20Address)
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return fmt.Errorf("Amount this(%v) Not Equal that(%v)", this.Amount, that1.Amount)
+	}
+	return nil
+}
+func (this *MsgConvertERC20ToCoin) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgConvertERC20ToCoin)
+	if !ok {
+		that2, ok := that.(MsgConvertERC20ToCoin)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Initiator != that1.Initiator {
+		return false
+	}
+	if this.Receiver != that1.Receiver {
+		return false
+	}
+	if this.KavaERC20Address != that1.KavaERC20Address {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	return true
+}
+func (this *MsgConvertERC20ToCoinResponse) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*MsgConvertERC20ToCoinResponse)
+	if !ok {
+		that2, ok := that.(MsgConvertERC20ToCoinResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *MsgConvertERC20ToCoinResponse")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *MsgConvertERC20ToCoinResponse but is nil && this != nil")
 	} else if this == nil {
 		return fmt.Errorf("that is type *MsgConvertERC20ToCoinResponse but is not nil && this == nil")
 	}
@@ -952,636 +1695,3613 @@ func (this *MsgConvertCosmosCoinFromERC20Response) Equal(that interface{}) bool
 	}
 	return true
 }
+func (this *MsgRegisterERC20ConversionPair) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// ConvertCoinToERC20 defines a method for converting sdk.Coin to Kava ERC20.
-	ConvertCoinToERC20(ctx context.Context, in *MsgConvertCoinToERC20, opts ...grpc.CallOption) (*MsgConvertCoinToERC20Response, error)
-	// ConvertERC20ToCoin defines a method for converting Kava ERC20 to sdk.Coin.
-	ConvertERC20ToCoin(ctx context.Context, in *MsgConvertERC20ToCoin, opts ...grpc.CallOption) (*MsgConvertERC20ToCoinResponse, error)
-	// ConvertCosmosCoinToERC20 defines a method for converting a cosmos sdk.Coin to an ERC20.
-	ConvertCosmosCoinToERC20(ctx context.Context, in *MsgConvertCosmosCoinToERC20, opts ...grpc.CallOption) (*MsgConvertCosmosCoinToERC20Response, error)
-	// ConvertCosmosCoinFromERC20 defines a method for converting a cosmos sdk.Coin to an ERC20.
-	ConvertCosmosCoinFromERC20(ctx context.Context, in *MsgConvertCosmosCoinFromERC20, opts ...grpc.CallOption) (*MsgConvertCosmosCoinFromERC20Response, error)
-}
-
-type msgClient struct {
-	cc grpc1.ClientConn
-}
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
-}
-
-func (c *msgClient) ConvertCoinToERC20(ctx context.Context, in *MsgConvertCoinToERC20, opts ...grpc.CallOption) (*MsgConvertCoinToERC20Response, error) {
-	out := new(MsgConvertCoinToERC20Response)
-	err := c.cc.Invoke(ctx, "/kava.evmutil.v1beta1.Msg/ConvertCoinToERC20", in, out, opts...)
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*MsgRegisterERC20ConversionPair)
+	if !ok {
+		that2, ok := that.(MsgRegisterERC20ConversionPair)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *MsgRegisterERC20ConversionPair")
+		}
 	}
-	return out, nil
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *MsgRegisterERC20ConversionPair but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *MsgRegisterERC20ConversionPair but is not nil && this == nil")
+	}
+	if this.Initiator != that1.Initiator {
+		return fmt.Errorf("Initiator this(%v) Not Equal that(%v)", this.Initiator, that1.Initiator)
+	}
+	if this.KavaERC20Address != that1.KavaERC20Address {
+		return fmt.Errorf("KavaERC20Address this(%v) Not Equal that(%v)", this.KavaERC20Address, that1.KavaERC20Address)
+	}
+	return nil
 }
+func (this *MsgRegisterERC20ConversionPair) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (c *msgClient) ConvertERC20ToCoin(ctx context.Context, in *MsgConvertERC20ToCoin, opts ...grpc.CallOption) (*MsgConvertERC20ToCoinResponse, error) {
-	out := new(MsgConvertERC20ToCoinResponse)
-	err := c.cc.Invoke(ctx, "/kava.evmutil.v1beta1.Msg/ConvertERC20ToCoin", in, out, opts...)
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*MsgRegisterERC20ConversionPair)
+	if !ok {
+		that2, ok := that.(MsgRegisterERC20ConversionPair)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return out, nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Initiator != that1.Initiator {
+		return false
+	}
+	if this.KavaERC20Address != that1.KavaERC20Address {
+		return false
+	}
+	return true
 }
+func (this *MsgRegisterERC20ConversionPairResponse) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
 
-func (c *msgClient) ConvertCosmosCoinToERC20(ctx context.Context, in *MsgConvertCosmosCoinToERC20, opts ...grpc.CallOption) (*MsgConvertCosmosCoinToERC20Response, error) {
-	out := new(MsgConvertCosmosCoinToERC20Response)
-	err := c.cc.Invoke(ctx, "/kava.evmutil.v1beta1.Msg/ConvertCosmosCoinToERC20", in, out, opts...)
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*MsgRegisterERC20ConversionPairResponse)
+	if !ok {
+		that2, ok := that.(MsgRegisterERC20ConversionPairResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *MsgRegisterERC20ConversionPairResponse")
+		}
 	}
-	return out, nil
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *MsgRegisterERC20ConversionPairResponse but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *MsgRegisterERC20ConversionPairResponse but is not nil && this == nil")
+	}
+	if this.Denom != that1.Denom {
+		return fmt.Errorf("Denom this(%v) Not Equal that(%v)", this.Denom, that1.Denom)
+	}
+	return nil
 }
+func (this *MsgRegisterERC20ConversionPairResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (c *msgClient) ConvertCosmosCoinFromERC20(ctx context.Context, in *MsgConvertCosmosCoinFromERC20, opts ...grpc.CallOption) (*MsgConvertCosmosCoinFromERC20Response, error) {
-	out := new(MsgConvertCosmosCoinFromERC20Response)
-	err := c.cc.Invoke(ctx, "/kava.evmutil.v1beta1.Msg/ConvertCosmosCoinFromERC20", in, out, opts...)
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*MsgRegisterERC20ConversionPairResponse)
+	if !ok {
+		that2, ok := that.(MsgRegisterERC20ConversionPairResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return out, nil
-}
-
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// ConvertCoinToERC20 defines a method for converting sdk.Coin to Kava ERC20.
-	ConvertCoinToERC20(context.Context, *MsgConvertCoinToERC20) (*MsgConvertCoinToERC20Response, error)
-	// ConvertERC20ToCoin defines a method for converting Kava ERC20 to sdk.Coin.
-	ConvertERC20ToCoin(context.Context, *MsgConvertERC20ToCoin) (*MsgConvertERC20ToCoinResponse, error)
-	// ConvertCosmosCoinToERC20 defines a method for converting a cosmos sdk.Coin to an ERC20.
-	ConvertCosmosCoinToERC20(context.Context, *MsgConvertCosmosCoinToERC20) (*MsgConvertCosmosCoinToERC20Response, error)
-	// ConvertCosmosCoinFromERC20 defines a method for converting a cosmos sdk.Coin to an ERC20.
-	ConvertCosmosCoinFromERC20(context.Context, *MsgConvertCosmosCoinFromERC20) (*MsgConvertCosmosCoinFromERC20Response, error)
-}
-
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	return true
 }
+func (this *MsgIBCTransferERC20Response) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
 
-func (*UnimplementedMsgServer) ConvertCoinToERC20(ctx context.Context, req *MsgConvertCoinToERC20) (*MsgConvertCoinToERC20Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ConvertCoinToERC20 not implemented")
-}
-func (*UnimplementedMsgServer) ConvertERC20ToCoin(ctx context.Context, req *MsgConvertERC20ToCoin) (*MsgConvertERC20ToCoinResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ConvertERC20ToCoin not implemented")
-}
-func (*UnimplementedMsgServer) ConvertCosmosCoinToERC20(ctx context.Context, req *MsgConvertCosmosCoinToERC20) (*MsgConvertCosmosCoinToERC20Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ConvertCosmosCoinToERC20 not implemented")
-}
-func (*UnimplementedMsgServer) ConvertCosmosCoinFromERC20(ctx context.Context, req *MsgConvertCosmosCoinFromERC20) (*MsgConvertCosmosCoinFromERC20Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ConvertCosmosCoinFromERC20 not implemented")
+	that1, ok := that.(*MsgIBCTransferERC20Response)
+	if !ok {
+		that2, ok := that.(MsgIBCTransferERC20Response)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *MsgIBCTransferERC20Response")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *MsgIBCTransferERC20Response but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *MsgIBCTransferERC20Response but is not nil && this == nil")
+	}
+	if this.Sequence != that1.Sequence {
+		return fmt.Errorf("Sequence this(%v) Not Equal that(%v)", this.Sequence, that1.Sequence)
+	}
+	return nil
 }
+func (this *MsgIBCTransferERC20Response) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+	that1, ok := that.(*MsgIBCTransferERC20Response)
+	if !ok {
+		that2, ok := that.(MsgIBCTransferERC20Response)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Sequence != that1.Sequence {
+		return false
+	}
+	return true
 }
+func (this *MsgUpdateCosmosCoinERC20Metadata) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
 
-func _Msg_ConvertCoinToERC20_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgConvertCoinToERC20)
-	if err := dec(in); err != nil {
-		return nil, err
+	that1, ok := that.(*MsgUpdateCosmosCoinERC20Metadata)
+	if !ok {
+		that2, ok := that.(MsgUpdateCosmosCoinERC20Metadata)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *MsgUpdateCosmosCoinERC20Metadata")
+		}
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).ConvertCoinToERC20(ctx, in)
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *MsgUpdateCosmosCoinERC20Metadata but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *MsgUpdateCosmosCoinERC20Metadata but is not nil && this == nil")
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kava.evmutil.v1beta1.Msg/ConvertCoinToERC20",
+	if this.Authority != that1.Authority {
+		return fmt.Errorf("Authority this(%v) Not Equal that(%v)", this.Authority, that1.Authority)
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ConvertCoinToERC20(ctx, req.(*MsgConvertCoinToERC20))
+	if this.CosmosDenom != that1.CosmosDenom {
+		return fmt.Errorf("CosmosDenom this(%v) Not Equal that(%v)", this.CosmosDenom, that1.CosmosDenom)
 	}
-	return interceptor(ctx, in, info, handler)
+	if this.Name != that1.Name {
+		return fmt.Errorf("Name this(%v) Not Equal that(%v)", this.Name, that1.Name)
+	}
+	if this.Symbol != that1.Symbol {
+		return fmt.Errorf("Symbol this(%v) Not Equal that(%v)", this.Symbol, that1.Symbol)
+	}
+	return nil
 }
+func (this *MsgUpdateCosmosCoinERC20Metadata) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func _Msg_ConvertERC20ToCoin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgConvertERC20ToCoin)
-	if err := dec(in); err != nil {
-		return nil, err
+	that1, ok := that.(*MsgUpdateCosmosCoinERC20Metadata)
+	if !ok {
+		that2, ok := that.(MsgUpdateCosmosCoinERC20Metadata)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).ConvertERC20ToCoin(ctx, in)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kava.evmutil.v1beta1.Msg/ConvertERC20ToCoin",
+	if this.Authority != that1.Authority {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ConvertERC20ToCoin(ctx, req.(*MsgConvertERC20ToCoin))
+	if this.CosmosDenom != that1.CosmosDenom {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Symbol != that1.Symbol {
+		return false
+	}
+	return true
 }
+func (this *MsgUpdateCosmosCoinERC20MetadataResponse) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
 
-func _Msg_ConvertCosmosCoinToERC20_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgConvertCosmosCoinToERC20)
-	if err := dec(in); err != nil {
-		return nil, err
+	that1, ok := that.(*MsgUpdateCosmosCoinERC20MetadataResponse)
+	if !ok {
+		that2, ok := that.(MsgUpdateCosmosCoinERC20MetadataResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *MsgUpdateCosmosCoinERC20MetadataResponse")
+		}
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).ConvertCosmosCoinToERC20(ctx, in)
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *MsgUpdateCosmosCoinERC20MetadataResponse but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *MsgUpdateCosmosCoinERC20MetadataResponse but is not nil && this == nil")
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kava.evmutil.v1beta1.Msg/ConvertCosmosCoinToERC20",
+	return nil
+}
+func (this *MsgUpdateCosmosCoinERC20MetadataResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ConvertCosmosCoinToERC20(ctx, req.(*MsgConvertCosmosCoinToERC20))
+
+	that1, ok := that.(*MsgUpdateCosmosCoinERC20MetadataResponse)
+	if !ok {
+		that2, ok := that.(MsgUpdateCosmosCoinERC20MetadataResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return interceptor(ctx, in, info, handler)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
 }
+func (this *MsgSetCosmosCoinConversionPaused) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
 
-func _Msg_ConvertCosmosCoinFromERC20_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgConvertCosmosCoinFromERC20)
-	if err := dec(in); err != nil {
-		return nil, err
+	that1, ok := that.(*MsgSetCosmosCoinConversionPaused)
+	if !ok {
+		that2, ok := that.(MsgSetCosmosCoinConversionPaused)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *MsgSetCosmosCoinConversionPaused")
+		}
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).ConvertCosmosCoinFromERC20(ctx, in)
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *MsgSetCosmosCoinConversionPaused but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *MsgSetCosmosCoinConversionPaused but is not nil && this == nil")
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kava.evmutil.v1beta1.Msg/ConvertCosmosCoinFromERC20",
+	if this.Authority != that1.Authority {
+		return fmt.Errorf("Authority this(%v) Not Equal that(%v)", this.Authority, that1.Authority)
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ConvertCosmosCoinFromERC20(ctx, req.(*MsgConvertCosmosCoinFromERC20))
+	if this.CosmosDenom != that1.CosmosDenom {
+		return fmt.Errorf("CosmosDenom this(%v) Not Equal that(%v)", this.CosmosDenom, that1.CosmosDenom)
 	}
-	return interceptor(ctx, in, info, handler)
+	if this.Paused != that1.Paused {
+		return fmt.Errorf("Paused this(%v) Not Equal that(%v)", this.Paused, that1.Paused)
+	}
+	return nil
 }
+func (this *MsgSetCosmosCoinConversionPaused) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "kava.evmutil.v1beta1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ConvertCoinToERC20",
-			Handler:    _Msg_ConvertCoinToERC20_Handler,
-		},
-		{
-			MethodName: "ConvertERC20ToCoin",
-			Handler:    _Msg_ConvertERC20ToCoin_Handler,
-		},
-		{
-			MethodName: "ConvertCosmosCoinToERC20",
-			Handler:    _Msg_ConvertCosmosCoinToERC20_Handler,
-		},
-		{
-			MethodName: "ConvertCosmosCoinFromERC20",
-			Handler:    _Msg_ConvertCosmosCoinFromERC20_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "kava/evmutil/v1beta1/tx.proto",
+	that1, ok := that.(*MsgSetCosmosCoinConversionPaused)
+	if !ok {
+		that2, ok := that.(MsgSetCosmosCoinConversionPaused)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Authority != that1.Authority {
+		return false
+	}
+	if this.CosmosDenom != that1.CosmosDenom {
+		return false
+	}
+	if this.Paused != that1.Paused {
+		return false
+	}
+	return true
 }
+func (this *MsgSetCosmosCoinConversionPausedResponse) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
 
-func (m *MsgConvertCoinToERC20) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*MsgSetCosmosCoinConversionPausedResponse)
+	if !ok {
+		that2, ok := that.(MsgSetCosmosCoinConversionPausedResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *MsgSetCosmosCoinConversionPausedResponse")
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *MsgSetCosmosCoinConversionPausedResponse but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *MsgSetCosmosCoinConversionPausedResponse but is not nil && this == nil")
+	}
+	return nil
 }
+func (this *MsgSetCosmosCoinConversionPausedResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MsgConvertCoinToERC20) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*MsgSetCosmosCoinConversionPausedResponse)
+	if !ok {
+		that2, ok := that.(MsgSetCosmosCoinConversionPausedResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
 }
+func (this *MsgMigrateCosmosCoinERC20) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
 
-func (m *MsgConvertCoinToERC20) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Amount != nil {
-		{
-			size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+	that1, ok := that.(*MsgMigrateCosmosCoinERC20)
+	if !ok {
+		that2, ok := that.(MsgMigrateCosmosCoinERC20)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *MsgMigrateCosmosCoinERC20")
 		}
-		i--
-		dAtA[i] = 0x1a
 	}
-	if len(m.Receiver) > 0 {
-		i -= len(m.Receiver)
-		copy(dAtA[i:], m.Receiver)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
-		i--
-		dAtA[i] = 0x12
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *MsgMigrateCosmosCoinERC20 but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *MsgMigrateCosmosCoinERC20 but is not nil && this == nil")
 	}
-	if len(m.Initiator) > 0 {
-		i -= len(m.Initiator)
-		copy(dAtA[i:], m.Initiator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Initiator)))
-		i--
-		dAtA[i] = 0xa
+	if this.Authority != that1.Authority {
+		return fmt.Errorf("Authority this(%v) Not Equal that(%v)", this.Authority, that1.Authority)
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgConvertCoinToERC20Response) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.CosmosDenom != that1.CosmosDenom {
+		return fmt.Errorf("CosmosDenom this(%v) Not Equal that(%v)", this.CosmosDenom, that1.CosmosDenom)
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgConvertCoinToERC20Response) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return nil
 }
+func (this *MsgMigrateCosmosCoinERC20) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MsgConvertCoinToERC20Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	that1, ok := that.(*MsgMigrateCosmosCoinERC20)
+	if !ok {
+		that2, ok := that.(MsgMigrateCosmosCoinERC20)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Authority != that1.Authority {
+		return false
+	}
+	if this.CosmosDenom != that1.CosmosDenom {
+		return false
+	}
+	return true
 }
+func (this *MsgMigrateCosmosCoinERC20Response) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
 
-func (m *MsgConvertERC20ToCoin) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*MsgMigrateCosmosCoinERC20Response)
+	if !ok {
+		that2, ok := that.(MsgMigrateCosmosCoinERC20Response)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *MsgMigrateCosmosCoinERC20Response")
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *MsgMigrateCosmosCoinERC20Response but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *MsgMigrateCosmosCoinERC20Response but is not nil && this == nil")
+	}
+	if this.ContractAddress != that1.ContractAddress {
+		return fmt.Errorf("ContractAddress this(%v) Not Equal that(%v)", this.ContractAddress, that1.ContractAddress)
+	}
+	return nil
 }
+func (this *MsgMigrateCosmosCoinERC20Response) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MsgConvertERC20ToCoin) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*MsgMigrateCosmosCoinERC20Response)
+	if !ok {
+		that2, ok := that.(MsgMigrateCosmosCoinERC20Response)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ContractAddress != that1.ContractAddress {
+		return false
+	}
+	return true
 }
+func (this *MsgSetConversionDirectionPaused) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
 
-func (m *MsgConvertERC20ToCoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	that1, ok := that.(*MsgSetConversionDirectionPaused)
+	if !ok {
+		that2, ok := that.(MsgSetConversionDirectionPaused)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *MsgSetConversionDirectionPaused")
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.KavaERC20Address) > 0 {
-		i -= len(m.KavaERC20Address)
-		copy(dAtA[i:], m.KavaERC20Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.KavaERC20Address)))
-		i--
-		dAtA[i] = 0x1a
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *MsgSetConversionDirectionPaused but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *MsgSetConversionDirectionPaused but is not nil && this == nil")
 	}
-	if len(m.Receiver) > 0 {
-		i -= len(m.Receiver)
-		copy(dAtA[i:], m.Receiver)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
-		i--
-		dAtA[i] = 0x12
+	if this.Authority != that1.Authority {
+		return fmt.Errorf("Authority this(%v) Not Equal that(%v)", this.Authority, that1.Authority)
 	}
-	if len(m.Initiator) > 0 {
-		i -= len(m.Initiator)
-		copy(dAtA[i:], m.Initiator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Initiator)))
-		i--
-		dAtA[i] = 0xa
+	if this.Direction != that1.Direction {
+		return fmt.Errorf("Direction this(%v) Not Equal that(%v)", this.Direction, that1.Direction)
 	}
-	return len(dAtA) - i, nil
+	if this.Paused != that1.Paused {
+		return fmt.Errorf("Paused this(%v) Not Equal that(%v)", this.Paused, that1.Paused)
+	}
+	if this.Denom != that1.Denom {
+		return fmt.Errorf("Denom this(%v) Not Equal that(%v)", this.Denom, that1.Denom)
+	}
+	return nil
 }
+func (this *MsgSetConversionDirectionPaused) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MsgConvertERC20ToCoinResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*MsgSetConversionDirectionPaused)
+	if !ok {
+		that2, ok := that.(MsgSetConversionDirectionPaused)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Authority != that1.Authority {
+		return false
+	}
+	if this.Direction != that1.Direction {
+		return false
+	}
+	if this.Paused != that1.Paused {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	return true
 }
+func (this *MsgSetConversionDirectionPausedResponse) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
 
-func (m *MsgConvertERC20ToCoinResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*MsgSetConversionDirectionPausedResponse)
+	if !ok {
+		that2, ok := that.(MsgSetConversionDirectionPausedResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *MsgSetConversionDirectionPausedResponse")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *MsgSetConversionDirectionPausedResponse but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *MsgSetConversionDirectionPausedResponse but is not nil && this == nil")
+	}
+	return nil
 }
+func (this *MsgSetConversionDirectionPausedResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MsgConvertERC20ToCoinResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	that1, ok := that.(*MsgSetConversionDirectionPausedResponse)
+	if !ok {
+		that2, ok := that.(MsgSetConversionDirectionPausedResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
 }
 
-func (m *MsgConvertCosmosCoinToERC20) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// ConvertCoinToERC20 defines a method for converting sdk.Coin to Kava ERC20.
+	ConvertCoinToERC20(ctx context.Context, in *MsgConvertCoinToERC20, opts ...grpc.CallOption) (*MsgConvertCoinToERC20Response, error)
+	// ConvertERC20ToCoin defines a method for converting Kava ERC20 to sdk.Coin.
+	ConvertERC20ToCoin(ctx context.Context, in *MsgConvertERC20ToCoin, opts ...grpc.CallOption) (*MsgConvertERC20ToCoinResponse, error)
+	// ConvertCosmosCoinToERC20 defines a method for converting a cosmos sdk.Coin to an ERC20.
+	ConvertCosmosCoinToERC20(ctx context.Context, in *MsgConvertCosmosCoinToERC20, opts ...grpc.CallOption) (*MsgConvertCosmosCoinToERC20Response, error)
+	// ConvertCosmosCoinFromERC20 defines a method for converting a cosmos sdk.Coin to an ERC20.
+	ConvertCosmosCoinFromERC20(ctx context.Context, in *MsgConvertCosmosCoinFromERC20, opts ...grpc.CallOption) (*MsgConvertCosmosCoinFromERC20Response, error)
+	// RegisterERC20ConversionPair defines a method for permissionlessly registering an EVM-native ERC20
+	// to be converted to and from an sdk.Coin.
+	RegisterERC20ConversionPair(ctx context.Context, in *MsgRegisterERC20ConversionPair, opts ...grpc.CallOption) (*MsgRegisterERC20ConversionPairResponse, error)
+	// IBCTransferERC20 defines a method for converting Kava ERC20 to sdk.Coin and sending it over IBC
+	// with an ICS-20 transfer in a single step.
+	IBCTransferERC20(ctx context.Context, in *MsgIBCTransferERC20, opts ...grpc.CallOption) (*MsgIBCTransferERC20Response, error)
+	// UpdateCosmosCoinERC20Metadata defines a governance method for updating the name and symbol of a
+	// deployed ERC20 representing a cosmos sdk.Coin.
+	UpdateCosmosCoinERC20Metadata(ctx context.Context, in *MsgUpdateCosmosCoinERC20Metadata, opts ...grpc.CallOption) (*MsgUpdateCosmosCoinERC20MetadataResponse, error)
+	// SetCosmosCoinConversionPaused defines a governance method for pausing or unpausing conversions of a
+	// single cosmos sdk.Coin denom.
+	SetCosmosCoinConversionPaused(ctx context.Context, in *MsgSetCosmosCoinConversionPaused, opts ...grpc.CallOption) (*MsgSetCosmosCoinConversionPausedResponse, error)
+	// MigrateCosmosCoinERC20 defines a governance method for migrating a cosmos sdk.Coin denom to a newly
+	// deployed ERC20 contract.
+	MigrateCosmosCoinERC20(ctx context.Context, in *MsgMigrateCosmosCoinERC20, opts ...grpc.CallOption) (*MsgMigrateCosmosCoinERC20Response, error)
+	// SetConversionDirectionPaused defines a governance method for pausing or unpausing all conversions
+	// in a direction.
+	SetConversionDirectionPaused(ctx context.Context, in *MsgSetConversionDirectionPaused, opts ...grpc.CallOption) (*MsgSetConversionDirectionPausedResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) ConvertCoinToERC20(ctx context.Context, in *MsgConvertCoinToERC20, opts ...grpc.CallOption) (*MsgConvertCoinToERC20Response, error) {
+	out := new(MsgConvertCoinToERC20Response)
+	err := c.cc.Invoke(ctx, "/kava.evmutil.v1beta1.Msg/ConvertCoinToERC20", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgConvertCosmosCoinToERC20) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ConvertERC20ToCoin(ctx context.Context, in *MsgConvertERC20ToCoin, opts ...grpc.CallOption) (*MsgConvertERC20ToCoinResponse, error) {
+	out := new(MsgConvertERC20ToCoinResponse)
+	err := c.cc.Invoke(ctx, "/kava.evmutil.v1beta1.Msg/ConvertERC20ToCoin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgConvertCosmosCoinToERC20) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Amount != nil {
-		{
-			size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Receiver) > 0 {
-		i -= len(m.Receiver)
-		copy(dAtA[i:], m.Receiver)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Initiator) > 0 {
-		i -= len(m.Initiator)
-		copy(dAtA[i:], m.Initiator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Initiator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) ConvertCosmosCoinToERC20(ctx context.Context, in *MsgConvertCosmosCoinToERC20, opts ...grpc.CallOption) (*MsgConvertCosmosCoinToERC20Response, error) {
+	out := new(MsgConvertCosmosCoinToERC20Response)
+	err := c.cc.Invoke(ctx, "/kava.evmutil.v1beta1.Msg/ConvertCosmosCoinToERC20", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgConvertCosmosCoinToERC20Response) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ConvertCosmosCoinFromERC20(ctx context.Context, in *MsgConvertCosmosCoinFromERC20, opts ...grpc.CallOption) (*MsgConvertCosmosCoinFromERC20Response, error) {
+	out := new(MsgConvertCosmosCoinFromERC20Response)
+	err := c.cc.Invoke(ctx, "/kava.evmutil.v1beta1.Msg/ConvertCosmosCoinFromERC20", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgConvertCosmosCoinToERC20Response) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RegisterERC20ConversionPair(ctx context.Context, in *MsgRegisterERC20ConversionPair, opts ...grpc.CallOption) (*MsgRegisterERC20ConversionPairResponse, error) {
+	out := new(MsgRegisterERC20ConversionPairResponse)
+	err := c.cc.Invoke(ctx, "/kava.evmutil.v1beta1.Msg/RegisterERC20ConversionPair", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgConvertCosmosCoinToERC20Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) IBCTransferERC20(ctx context.Context, in *MsgIBCTransferERC20, opts ...grpc.CallOption) (*MsgIBCTransferERC20Response, error) {
+	out := new(MsgIBCTransferERC20Response)
+	err := c.cc.Invoke(ctx, "/kava.evmutil.v1beta1.Msg/IBCTransferERC20", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgConvertCosmosCoinFromERC20) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateCosmosCoinERC20Metadata(ctx context.Context, in *MsgUpdateCosmosCoinERC20Metadata, opts ...grpc.CallOption) (*MsgUpdateCosmosCoinERC20MetadataResponse, error) {
+	out := new(MsgUpdateCosmosCoinERC20MetadataResponse)
+	err := c.cc.Invoke(ctx, "/kava.evmutil.v1beta1.Msg/UpdateCosmosCoinERC20Metadata", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgConvertCosmosCoinFromERC20) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetCosmosCoinConversionPaused(ctx context.Context, in *MsgSetCosmosCoinConversionPaused, opts ...grpc.CallOption) (*MsgSetCosmosCoinConversionPausedResponse, error) {
+	out := new(MsgSetCosmosCoinConversionPausedResponse)
+	err := c.cc.Invoke(ctx, "/kava.evmutil.v1beta1.Msg/SetCosmosCoinConversionPaused", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgConvertCosmosCoinFromERC20) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Amount != nil {
-		{
-			size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Receiver) > 0 {
-		i -= len(m.Receiver)
-		copy(dAtA[i:], m.Receiver)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Initiator) > 0 {
-		i -= len(m.Initiator)
-		copy(dAtA[i:], m.Initiator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Initiator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) MigrateCosmosCoinERC20(ctx context.Context, in *MsgMigrateCosmosCoinERC20, opts ...grpc.CallOption) (*MsgMigrateCosmosCoinERC20Response, error) {
+	out := new(MsgMigrateCosmosCoinERC20Response)
+	err := c.cc.Invoke(ctx, "/kava.evmutil.v1beta1.Msg/MigrateCosmosCoinERC20", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgConvertCosmosCoinFromERC20Response) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetConversionDirectionPaused(ctx context.Context, in *MsgSetConversionDirectionPaused, opts ...grpc.CallOption) (*MsgSetConversionDirectionPausedResponse, error) {
+	out := new(MsgSetConversionDirectionPausedResponse)
+	err := c.cc.Invoke(ctx, "/kava.evmutil.v1beta1.Msg/SetConversionDirectionPaused", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgConvertCosmosCoinFromERC20Response) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// ConvertCoinToERC20 defines a method for converting sdk.Coin to Kava ERC20.
+	ConvertCoinToERC20(context.Context, *MsgConvertCoinToERC20) (*MsgConvertCoinToERC20Response, error)
+	// ConvertERC20ToCoin defines a method for converting Kava ERC20 to sdk.Coin.
+	ConvertERC20ToCoin(context.Context, *MsgConvertERC20ToCoin) (*MsgConvertERC20ToCoinResponse, error)
+	// ConvertCosmosCoinToERC20 defines a method for converting a cosmos sdk.Coin to an ERC20.
+	ConvertCosmosCoinToERC20(context.Context, *MsgConvertCosmosCoinToERC20) (*MsgConvertCosmosCoinToERC20Response, error)
+	// ConvertCosmosCoinFromERC20 defines a method for converting a cosmos sdk.Coin to an ERC20.
+	ConvertCosmosCoinFromERC20(context.Context, *MsgConvertCosmosCoinFromERC20) (*MsgConvertCosmosCoinFromERC20Response, error)
+	// RegisterERC20ConversionPair defines a method for permissionlessly registering an EVM-native ERC20
+	// to be converted to and from an sdk.Coin.
+	RegisterERC20ConversionPair(context.Context, *MsgRegisterERC20ConversionPair) (*MsgRegisterERC20ConversionPairResponse, error)
+	// IBCTransferERC20 defines a method for converting Kava ERC20 to sdk.Coin and sending it over IBC
+	// with an ICS-20 transfer in a single step.
+	IBCTransferERC20(context.Context, *MsgIBCTransferERC20) (*MsgIBCTransferERC20Response, error)
+	// UpdateCosmosCoinERC20Metadata defines a governance method for updating the name and symbol of a
+	// deployed ERC20 representing a cosmos sdk.Coin.
+	UpdateCosmosCoinERC20Metadata(context.Context, *MsgUpdateCosmosCoinERC20Metadata) (*MsgUpdateCosmosCoinERC20MetadataResponse, error)
+	// SetCosmosCoinConversionPaused defines a governance method for pausing or unpausing conversions of a
+	// single cosmos sdk.Coin denom.
+	SetCosmosCoinConversionPaused(context.Context, *MsgSetCosmosCoinConversionPaused) (*MsgSetCosmosCoinConversionPausedResponse, error)
+	// MigrateCosmosCoinERC20 defines a governance method for migrating a cosmos sdk.Coin denom to a newly
+	// deployed ERC20 contract.
+	MigrateCosmosCoinERC20(context.Context, *MsgMigrateCosmosCoinERC20) (*MsgMigrateCosmosCoinERC20Response, error)
+	// SetConversionDirectionPaused defines a governance method for pausing or unpausing all conversions
+	// in a direction.
+	SetConversionDirectionPaused(context.Context, *MsgSetConversionDirectionPaused) (*MsgSetConversionDirectionPausedResponse, error)
 }
 
-func (m *MsgConvertCosmosCoinFromERC20Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (*UnimplementedMsgServer) ConvertCoinToERC20(ctx context.Context, req *MsgConvertCoinToERC20) (*MsgConvertCoinToERC20Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertCoinToERC20 not implemented")
 }
-func (m *MsgConvertCoinToERC20) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Initiator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Amount != nil {
-		l = m.Amount.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (*UnimplementedMsgServer) ConvertERC20ToCoin(ctx context.Context, req *MsgConvertERC20ToCoin) (*MsgConvertERC20ToCoinResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertERC20ToCoin not implemented")
+}
+func (*UnimplementedMsgServer) ConvertCosmosCoinToERC20(ctx context.Context, req *MsgConvertCosmosCoinToERC20) (*MsgConvertCosmosCoinToERC20Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertCosmosCoinToERC20 not implemented")
+}
+func (*UnimplementedMsgServer) ConvertCosmosCoinFromERC20(ctx context.Context, req *MsgConvertCosmosCoinFromERC20) (*MsgConvertCosmosCoinFromERC20Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertCosmosCoinFromERC20 not implemented")
+}
+func (*UnimplementedMsgServer) RegisterERC20ConversionPair(ctx context.Context, req *MsgRegisterERC20ConversionPair) (*MsgRegisterERC20ConversionPairResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterERC20ConversionPair not implemented")
+}
+func (*UnimplementedMsgServer) IBCTransferERC20(ctx context.Context, req *MsgIBCTransferERC20) (*MsgIBCTransferERC20Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IBCTransferERC20 not implemented")
+}
+func (*UnimplementedMsgServer) UpdateCosmosCoinERC20Metadata(ctx context.Context, req *MsgUpdateCosmosCoinERC20Metadata) (*MsgUpdateCosmosCoinERC20MetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCosmosCoinERC20Metadata not implemented")
+}
+func (*UnimplementedMsgServer) SetCosmosCoinConversionPaused(ctx context.Context, req *MsgSetCosmosCoinConversionPaused) (*MsgSetCosmosCoinConversionPausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCosmosCoinConversionPaused not implemented")
+}
+func (*UnimplementedMsgServer) MigrateCosmosCoinERC20(ctx context.Context, req *MsgMigrateCosmosCoinERC20) (*MsgMigrateCosmosCoinERC20Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateCosmosCoinERC20 not implemented")
+}
+func (*UnimplementedMsgServer) SetConversionDirectionPaused(ctx context.Context, req *MsgSetConversionDirectionPaused) (*MsgSetConversionDirectionPausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetConversionDirectionPaused not implemented")
 }
 
-func (m *MsgConvertCoinToERC20Response) Size() (n int) {
-	if m == nil {
-		return 0
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_ConvertCoinToERC20_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConvertCoinToERC20)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).ConvertCoinToERC20(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.evmutil.v1beta1.Msg/ConvertCoinToERC20",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConvertCoinToERC20(ctx, req.(*MsgConvertCoinToERC20))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgConvertERC20ToCoin) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ConvertERC20ToCoin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConvertERC20ToCoin)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Initiator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).ConvertERC20ToCoin(ctx, in)
 	}
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.evmutil.v1beta1.Msg/ConvertERC20ToCoin",
 	}
-	l = len(m.KavaERC20Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConvertERC20ToCoin(ctx, req.(*MsgConvertERC20ToCoin))
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgConvertERC20ToCoinResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ConvertCosmosCoinToERC20_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConvertCosmosCoinToERC20)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).ConvertCosmosCoinToERC20(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.evmutil.v1beta1.Msg/ConvertCosmosCoinToERC20",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConvertCosmosCoinToERC20(ctx, req.(*MsgConvertCosmosCoinToERC20))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgConvertCosmosCoinToERC20) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ConvertCosmosCoinFromERC20_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConvertCosmosCoinFromERC20)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Initiator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).ConvertCosmosCoinFromERC20(ctx, in)
 	}
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.evmutil.v1beta1.Msg/ConvertCosmosCoinFromERC20",
 	}
-	if m.Amount != nil {
-		l = m.Amount.Size()
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConvertCosmosCoinFromERC20(ctx, req.(*MsgConvertCosmosCoinFromERC20))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgConvertCosmosCoinToERC20Response) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RegisterERC20ConversionPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterERC20ConversionPair)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterERC20ConversionPair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.evmutil.v1beta1.Msg/RegisterERC20ConversionPair",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterERC20ConversionPair(ctx, req.(*MsgRegisterERC20ConversionPair))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgConvertCosmosCoinFromERC20) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_IBCTransferERC20_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgIBCTransferERC20)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Initiator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).IBCTransferERC20(ctx, in)
 	}
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.evmutil.v1beta1.Msg/IBCTransferERC20",
 	}
-	if m.Amount != nil {
-		l = m.Amount.Size()
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).IBCTransferERC20(ctx, req.(*MsgIBCTransferERC20))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgConvertCosmosCoinFromERC20Response) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateCosmosCoinERC20Metadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateCosmosCoinERC20Metadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateCosmosCoinERC20Metadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.evmutil.v1beta1.Msg/UpdateCosmosCoinERC20Metadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateCosmosCoinERC20Metadata(ctx, req.(*MsgUpdateCosmosCoinERC20Metadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetCosmosCoinConversionPaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetCosmosCoinConversionPaused)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetCosmosCoinConversionPaused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.evmutil.v1beta1.Msg/SetCosmosCoinConversionPaused",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetCosmosCoinConversionPaused(ctx, req.(*MsgSetCosmosCoinConversionPaused))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MigrateCosmosCoinERC20_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrateCosmosCoinERC20)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MigrateCosmosCoinERC20(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.evmutil.v1beta1.Msg/MigrateCosmosCoinERC20",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MigrateCosmosCoinERC20(ctx, req.(*MsgMigrateCosmosCoinERC20))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetConversionDirectionPaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetConversionDirectionPaused)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetConversionDirectionPaused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.evmutil.v1beta1.Msg/SetConversionDirectionPaused",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetConversionDirectionPaused(ctx, req.(*MsgSetConversionDirectionPaused))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "kava.evmutil.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ConvertCoinToERC20",
+			Handler:    _Msg_ConvertCoinToERC20_Handler,
+		},
+		{
+			MethodName: "ConvertERC20ToCoin",
+			Handler:    _Msg_ConvertERC20ToCoin_Handler,
+		},
+		{
+			MethodName: "ConvertCosmosCoinToERC20",
+			Handler:    _Msg_ConvertCosmosCoinToERC20_Handler,
+		},
+		{
+			MethodName: "ConvertCosmosCoinFromERC20",
+			Handler:    _Msg_ConvertCosmosCoinFromERC20_Handler,
+		},
+		{
+			MethodName: "RegisterERC20ConversionPair",
+			Handler:    _Msg_RegisterERC20ConversionPair_Handler,
+		},
+		{
+			MethodName: "IBCTransferERC20",
+			Handler:    _Msg_IBCTransferERC20_Handler,
+		},
+		{
+			MethodName: "UpdateCosmosCoinERC20Metadata",
+			Handler:    _Msg_UpdateCosmosCoinERC20Metadata_Handler,
+		},
+		{
+			MethodName: "SetCosmosCoinConversionPaused",
+			Handler:    _Msg_SetCosmosCoinConversionPaused_Handler,
+		},
+		{
+			MethodName: "MigrateCosmosCoinERC20",
+			Handler:    _Msg_MigrateCosmosCoinERC20_Handler,
+		},
+		{
+			MethodName: "SetConversionDirectionPaused",
+			Handler:    _Msg_SetConversionDirectionPaused_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "kava/evmutil/v1beta1/tx.proto",
+}
+
+func (m *MsgConvertCoinToERC20) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertCoinToERC20) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertCoinToERC20) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != nil {
+		{
+			size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Initiator) > 0 {
+		i -= len(m.Initiator)
+		copy(dAtA[i:], m.Initiator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Initiator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertCoinToERC20Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertCoinToERC20Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertCoinToERC20Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertERC20ToCoin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertERC20ToCoin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertERC20ToCoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.KavaERC20Address) > 0 {
+		i -= len(m.KavaERC20Address)
+		copy(dAtA[i:], m.KavaERC20Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.KavaERC20Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Initiator) > 0 {
+		i -= len(m.Initiator)
+		copy(dAtA[i:], m.Initiator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Initiator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertERC20ToCoinResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertERC20ToCoinResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertERC20ToCoinResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertCosmosCoinToERC20) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertCosmosCoinToERC20) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertCosmosCoinToERC20) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != nil {
+		{
+			size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Initiator) > 0 {
+		i -= len(m.Initiator)
+		copy(dAtA[i:], m.Initiator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Initiator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertCosmosCoinToERC20Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertCosmosCoinToERC20Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertCosmosCoinToERC20Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertCosmosCoinFromERC20) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertCosmosCoinFromERC20) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertCosmosCoinFromERC20) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != nil {
+		{
+			size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Initiator) > 0 {
+		i -= len(m.Initiator)
+		copy(dAtA[i:], m.Initiator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Initiator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertCosmosCoinFromERC20Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertCosmosCoinFromERC20Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertCosmosCoinFromERC20Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterERC20ConversionPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterERC20ConversionPair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterERC20ConversionPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.KavaERC20Address) > 0 {
+		i -= len(m.KavaERC20Address)
+		copy(dAtA[i:], m.KavaERC20Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.KavaERC20Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Initiator) > 0 {
+		i -= len(m.Initiator)
+		copy(dAtA[i:], m.Initiator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Initiator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterERC20ConversionPairResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterERC20ConversionPairResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterERC20ConversionPairResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgIBCTransferERC20) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgIBCTransferERC20) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgIBCTransferERC20) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size, err := m.TimeoutHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.SourceChannel) > 0 {
+		i -= len(m.SourceChannel)
+		copy(dAtA[i:], m.SourceChannel)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SourceChannel)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SourcePort) > 0 {
+		i -= len(m.SourcePort)
+		copy(dAtA[i:], m.SourcePort)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SourcePort)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.KavaERC20Address) > 0 {
+		i -= len(m.KavaERC20Address)
+		copy(dAtA[i:], m.KavaERC20Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.KavaERC20Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Initiator) > 0 {
+		i -= len(m.Initiator)
+		copy(dAtA[i:], m.Initiator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Initiator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgIBCTransferERC20Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgIBCTransferERC20Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgIBCTransferERC20Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateCosmosCoinERC20Metadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCosmosCoinERC20Metadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCosmosCoinERC20Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CosmosDenom) > 0 {
+		i -= len(m.CosmosDenom)
+		copy(dAtA[i:], m.CosmosDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CosmosDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateCosmosCoinERC20MetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCosmosCoinERC20MetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCosmosCoinERC20MetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetCosmosCoinConversionPaused) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetCosmosCoinConversionPaused) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetCosmosCoinConversionPaused) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CosmosDenom) > 0 {
+		i -= len(m.CosmosDenom)
+		copy(dAtA[i:], m.CosmosDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CosmosDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetCosmosCoinConversionPausedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetCosmosCoinConversionPausedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetCosmosCoinConversionPausedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateCosmosCoinERC20) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateCosmosCoinERC20) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateCosmosCoinERC20) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CosmosDenom) > 0 {
+		i -= len(m.CosmosDenom)
+		copy(dAtA[i:], m.CosmosDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CosmosDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateCosmosCoinERC20Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateCosmosCoinERC20Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateCosmosCoinERC20Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetConversionDirectionPaused) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetConversionDirectionPaused) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetConversionDirectionPaused) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Direction != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Direction))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetConversionDirectionPausedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetConversionDirectionPausedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetConversionDirectionPausedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgConvertCoinToERC20) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Initiator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Amount != nil {
+		l = m.Amount.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConvertCoinToERC20Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgConvertERC20ToCoin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Initiator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.KavaERC20Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgConvertERC20ToCoinResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgConvertCosmosCoinToERC20) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Initiator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Amount != nil {
+		l = m.Amount.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConvertCosmosCoinToERC20Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgConvertCosmosCoinFromERC20) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Initiator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Amount != nil {
+		l = m.Amount.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConvertCosmosCoinFromERC20Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterERC20ConversionPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Initiator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.KavaERC20Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterERC20ConversionPairResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgIBCTransferERC20) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Initiator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.KavaERC20Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.SourcePort)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SourceChannel)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TimeoutHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovTx(uint64(m.TimeoutTimestamp))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgIBCTransferERC20Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovTx(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *MsgUpdateCosmosCoinERC20Metadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CosmosDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateCosmosCoinERC20MetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetCosmosCoinConversionPaused) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CosmosDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Paused {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetCosmosCoinConversionPausedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMigrateCosmosCoinERC20) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CosmosDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMigrateCosmosCoinERC20Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetConversionDirectionPaused) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Direction != 0 {
+		n += 1 + sovTx(uint64(m.Direction))
+	}
+	if m.Paused {
+		n += 2
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetConversionDirectionPausedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgConvertCoinToERC20) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertCoinToERC20: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertCoinToERC20: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Initiator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Initiator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Amount == nil {
+				m.Amount = &types.Coin{}
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertCoinToERC20Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertCoinToERC20Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertCoinToERC20Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertERC20ToCoin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertERC20ToCoin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertERC20ToCoin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Initiator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Initiator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KavaERC20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KavaERC20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertERC20ToCoinResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertERC20ToCoinResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertERC20ToCoinResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertCosmosCoinToERC20) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertCosmosCoinToERC20: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertCosmosCoinToERC20: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Initiator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Initiator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Amount == nil {
+				m.Amount = &types.Coin{}
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertCosmosCoinToERC20Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertCosmosCoinToERC20Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertCosmosCoinToERC20Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertCosmosCoinFromERC20) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertCosmosCoinFromERC20: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertCosmosCoinFromERC20: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Initiator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Initiator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Amount == nil {
+				m.Amount = &types.Coin{}
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertCosmosCoinFromERC20Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertCosmosCoinFromERC20Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertCosmosCoinFromERC20Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterERC20ConversionPair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterERC20ConversionPair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterERC20ConversionPair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Initiator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Initiator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KavaERC20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KavaERC20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterERC20ConversionPairResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterERC20ConversionPairResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterERC20ConversionPairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgIBCTransferERC20) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgIBCTransferERC20: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgIBCTransferERC20: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Initiator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Initiator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KavaERC20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KavaERC20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourcePort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourcePort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TimeoutHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgIBCTransferERC20Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgIBCTransferERC20Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgIBCTransferERC20Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgConvertCoinToERC20) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateCosmosCoinERC20Metadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1604,15 +5324,15 @@ func (m *MsgConvertCoinToERC20) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertCoinToERC20: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateCosmosCoinERC20Metadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertCoinToERC20: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateCosmosCoinERC20Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Initiator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1640,11 +5360,11 @@ func (m *MsgConvertCoinToERC20) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Initiator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1672,13 +5392,13 @@ func (m *MsgConvertCoinToERC20) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
+			m.CosmosDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1688,27 +5408,55 @@ func (m *MsgConvertCoinToERC20) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Amount == nil {
-				m.Amount = &types.Coin{}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1731,7 +5479,7 @@ func (m *MsgConvertCoinToERC20) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConvertCoinToERC20Response) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateCosmosCoinERC20MetadataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1754,10 +5502,10 @@ func (m *MsgConvertCoinToERC20Response) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertCoinToERC20Response: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateCosmosCoinERC20MetadataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertCoinToERC20Response: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateCosmosCoinERC20MetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1781,7 +5529,7 @@ func (m *MsgConvertCoinToERC20Response) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConvertERC20ToCoin) Unmarshal(dAtA []byte) error {
+func (m *MsgSetCosmosCoinConversionPaused) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1804,15 +5552,15 @@ func (m *MsgConvertERC20ToCoin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertERC20ToCoin: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetCosmosCoinConversionPaused: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertERC20ToCoin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetCosmosCoinConversionPaused: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Initiator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1840,11 +5588,11 @@ func (m *MsgConvertERC20ToCoin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Initiator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1872,45 +5620,13 @@ func (m *MsgConvertERC20ToCoin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
+			m.CosmosDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KavaERC20Address", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.KavaERC20Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1920,26 +5636,12 @@ func (m *MsgConvertERC20ToCoin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Paused = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1961,7 +5663,7 @@ func (m *MsgConvertERC20ToCoin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConvertERC20ToCoinResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetCosmosCoinConversionPausedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1984,10 +5686,10 @@ func (m *MsgConvertERC20ToCoinResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertERC20ToCoinResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetCosmosCoinConversionPausedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertERC20ToCoinResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetCosmosCoinConversionPausedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2011,7 +5713,7 @@ func (m *MsgConvertERC20ToCoinResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConvertCosmosCoinToERC20) Unmarshal(dAtA []byte) error {
+func (m *MsgMigrateCosmosCoinERC20) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2034,15 +5736,15 @@ func (m *MsgConvertCosmosCoinToERC20) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertCosmosCoinToERC20: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMigrateCosmosCoinERC20: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertCosmosCoinToERC20: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMigrateCosmosCoinERC20: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Initiator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2070,11 +5772,11 @@ func (m *MsgConvertCosmosCoinToERC20) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Initiator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2102,43 +5804,7 @@ func (m *MsgConvertCosmosCoinToERC20) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Amount == nil {
-				m.Amount = &types.Coin{}
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CosmosDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2161,7 +5827,7 @@ func (m *MsgConvertCosmosCoinToERC20) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConvertCosmosCoinToERC20Response) Unmarshal(dAtA []byte) error {
+func (m *MsgMigrateCosmosCoinERC20Response) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2184,12 +5850,44 @@ func (m *MsgConvertCosmosCoinToERC20Response) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertCosmosCoinToERC20Response: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMigrateCosmosCoinERC20Response: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertCosmosCoinToERC20Response: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMigrateCosmosCoinERC20Response: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2211,7 +5909,7 @@ func (m *MsgConvertCosmosCoinToERC20Response) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConvertCosmosCoinFromERC20) Unmarshal(dAtA []byte) error {
+func (m *MsgSetConversionDirectionPaused) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2234,15 +5932,15 @@ func (m *MsgConvertCosmosCoinFromERC20) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertCosmosCoinFromERC20: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetConversionDirectionPaused: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertCosmosCoinFromERC20: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetConversionDirectionPaused: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Initiator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2270,13 +5968,13 @@ func (m *MsgConvertCosmosCoinFromERC20) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Initiator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
 			}
-			var stringLen uint64
+			m.Direction = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2286,29 +5984,36 @@ func (m *MsgConvertCosmosCoinFromERC20) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Direction |= ConversionDirection(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			m.Paused = bool(v != 0)
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2318,27 +6023,23 @@ func (m *MsgConvertCosmosCoinFromERC20) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Amount == nil {
-				m.Amount = &types.Coin{}
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2361,7 +6062,7 @@ func (m *MsgConvertCosmosCoinFromERC20) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConvertCosmosCoinFromERC20Response) Unmarshal(dAtA []byte) error {
+func (m *MsgSetConversionDirectionPausedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2384,10 +6085,10 @@ func (m *MsgConvertCosmosCoinFromERC20Response) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertCosmosCoinFromERC20Response: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetConversionDirectionPausedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertCosmosCoinFromERC20Response: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetConversionDirectionPausedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
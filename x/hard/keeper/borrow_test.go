@@ -9,6 +9,7 @@ import (
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	tmtime "github.com/cometbft/cometbft/types/time"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/kava-labs/kava/app"
 	"github.com/kava-labs/kava/x/hard"
 	"github.com/kava-labs/kava/x/hard/keeper"
@@ -817,6 +818,95 @@ func (suite *KeeperTestSuite) TestValidateBorrow() {
 	suite.Require().NoError(err)
 }
 
+func (suite *KeeperTestSuite) TestBorrow_TombstonedCollateral() {
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	valAccAddr, borrower, supplier := addrs[0], addrs[1], addrs[2]
+	valAddr := sdk.ValAddress(valAccAddr)
+
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+	derivativeDenom := tApp.GetLiquidKeeper().GetLiquidStakingTokenDenom(valAddr)
+
+	authGS := app.NewFundedGenStateWithCoins(
+		tApp.AppCodec(),
+		[]sdk.Coins{
+			sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(1000*KAVA_CF))),
+			sdk.NewCoins(sdk.NewCoin(derivativeDenom, sdkmath.NewInt(100*KAVA_CF))),
+			sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(1000*USDX_CF))),
+		},
+		[]sdk.AccAddress{valAccAddr, borrower, supplier},
+	)
+
+	model := types.NewInterestRateModel(sdk.MustNewDecFromStr("1.0"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10"))
+	hardGS := types.NewGenesisState(
+		types.NewParams(
+			types.MoneyMarkets{
+				types.NewMoneyMarket("usdx", types.NewBorrowLimit(false, sdk.NewDec(100000000*USDX_CF), sdk.MustNewDecFromStr("1")), "usdx:usd", sdkmath.NewInt(USDX_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec()),
+				types.NewMoneyMarket(derivativeDenom, types.NewBorrowLimit(false, sdk.NewDec(100000000*KAVA_CF), sdk.MustNewDecFromStr("0.8")), "kava:usd", sdkmath.NewInt(KAVA_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec()),
+			},
+			sdk.NewDec(10),
+		),
+		types.DefaultAccumulationTimes,
+		types.DefaultDeposits,
+		types.DefaultBorrows,
+		types.DefaultTotalSupplied,
+		types.DefaultTotalBorrowed,
+		types.DefaultTotalReserves,
+	)
+
+	pricefeedGS := pricefeedtypes.GenesisState{
+		Params: pricefeedtypes.Params{
+			Markets: []pricefeedtypes.Market{
+				{MarketID: "usdx:usd", BaseAsset: "usdx", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+				{MarketID: "kava:usd", BaseAsset: "kava", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+			},
+		},
+		PostedPrices: []pricefeedtypes.PostedPrice{
+			{MarketID: "usdx:usd", OracleAddress: sdk.AccAddress{}, Price: sdk.MustNewDecFromStr("1.00"), Expiry: time.Now().Add(1 * time.Hour)},
+			{MarketID: "kava:usd", OracleAddress: sdk.AccAddress{}, Price: sdk.MustNewDecFromStr("2.00"), Expiry: time.Now().Add(1 * time.Hour)},
+		},
+	}
+
+	tApp.InitializeFromGenesisStates(
+		authGS,
+		app.GenesisState{pricefeedtypes.ModuleName: tApp.AppCodec().MustMarshalJSON(&pricefeedGS)},
+		app.GenesisState{types.ModuleName: tApp.AppCodec().MustMarshalJSON(&hardGS)},
+	)
+	suite.app = tApp
+	suite.ctx = ctx
+	suite.keeper = tApp.GetHardKeeper()
+
+	suite.Require().NoError(tApp.CreateNewUnbondedValidator(suite.ctx, valAddr, sdkmath.NewInt(100*KAVA_CF)))
+	staking.EndBlocker(suite.ctx, tApp.GetStakingKeeper())
+	hard.BeginBlocker(suite.ctx, suite.keeper)
+
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, supplier, sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(1000*USDX_CF)))))
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin(derivativeDenom, sdkmath.NewInt(100*KAVA_CF)))))
+	suite.Require().NoError(suite.keeper.Borrow(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(100*USDX_CF)))))
+
+	validator, found := tApp.GetStakingKeeper().GetValidator(suite.ctx, valAddr)
+	suite.Require().True(found)
+	consAddr, err := validator.GetConsAddr()
+	suite.Require().NoError(err)
+	tApp.GetSlashingKeeper().Tombstone(suite.ctx, consAddr)
+
+	liquidKeeper := tApp.GetLiquidKeeper()
+	liquidParams := liquidKeeper.GetParams(suite.ctx)
+	liquidParams.DisableTombstonedCollateral = true
+	liquidKeeper.SetParams(suite.ctx, liquidParams)
+
+	err = suite.keeper.Borrow(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(1*USDX_CF))))
+	suite.Require().ErrorIs(err, types.ErrInsufficientLoanToValue)
+
+	deposit, found := suite.keeper.GetDeposit(suite.ctx, borrower)
+	suite.Require().True(found)
+	borrow, found := suite.keeper.GetBorrow(suite.ctx, borrower)
+	suite.Require().True(found)
+	isWithinRange, err := suite.keeper.IsWithinValidLtvRange(suite.ctx, deposit, borrow)
+	suite.Require().NoError(err)
+	suite.False(isWithinRange, "tombstoned derivative deposits should not count as collateral")
+}
+
 func (suite *KeeperTestSuite) TestFilterCoinsByDenoms() {
 	type args struct {
 		coins         sdk.Coins
@@ -111,6 +111,9 @@ func (k Keeper) ValidateDeposit(ctx sdk.Context, coins sdk.Coins) error {
 		if !foundMm {
 			return errorsmod.Wrapf(types.ErrInvalidDepositDenom, "money market denom %s not found", depCoin.Denom)
 		}
+		if !k.liquidKeeper.IsDerivativeCollateralEnabled(ctx, depCoin.Denom) {
+			return errorsmod.Wrapf(types.ErrInvalidDepositDenom, "%s is disabled as collateral", depCoin.Denom)
+		}
 	}
 
 	return nil
@@ -21,13 +21,14 @@ type Keeper struct {
 	bankKeeper      types.BankKeeper
 	pricefeedKeeper types.PricefeedKeeper
 	auctionKeeper   types.AuctionKeeper
+	liquidKeeper    types.LiquidKeeper
 	hooks           types.HARDHooks
 }
 
 // NewKeeper creates a new keeper
 func NewKeeper(cdc codec.Codec, key storetypes.StoreKey, paramstore paramtypes.Subspace,
 	ak types.AccountKeeper, bk types.BankKeeper,
-	pfk types.PricefeedKeeper, auk types.AuctionKeeper,
+	pfk types.PricefeedKeeper, auk types.AuctionKeeper, lk types.LiquidKeeper,
 ) Keeper {
 	if !paramstore.HasKeyTable() {
 		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
@@ -41,6 +42,7 @@ func NewKeeper(cdc codec.Codec, key storetypes.StoreKey, paramstore paramtypes.S
 		bankKeeper:      bk,
 		pricefeedKeeper: pfk,
 		auctionKeeper:   auk,
+		liquidKeeper:    lk,
 		hooks:           nil,
 	}
 }
@@ -397,12 +397,21 @@ func (k Keeper) LoadLiquidationData(ctx sdk.Context, deposit types.Deposit, borr
 			return liqMap, err
 		}
 
-		liqMap[denom] = LiqData{priceData.Price, mm.BorrowLimit.LoanToValue, mm.ConversionFactor}
+		liqMap[denom] = LiqData{priceData.Price, k.getCollateralLoanToValue(ctx, mm), mm.ConversionFactor}
 	}
 
 	return liqMap, nil
 }
 
+// getCollateralLoanToValue returns the loan-to-value ratio a deposit of the money market's denom is valued at.
+// Deposits of derivatives that are disabled as collateral can't be borrowed against.
+func (k Keeper) getCollateralLoanToValue(ctx sdk.Context, mm types.MoneyMarket) sdk.Dec {
+	if !k.liquidKeeper.IsDerivativeCollateralEnabled(ctx, mm.Denom) {
+		return sdk.ZeroDec()
+	}
+	return mm.BorrowLimit.LoanToValue
+}
+
 func getDenoms(coins sdk.Coins) []string {
 	denoms := []string{}
 	for _, coin := range coins {
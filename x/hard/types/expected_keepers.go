@@ -49,6 +49,11 @@ type AuctionKeeper interface {
 	StartCollateralAuction(ctx sdk.Context, seller string, lot sdk.Coin, maxBid sdk.Coin, lotReturnAddrs []sdk.AccAddress, lotReturnWeights []sdkmath.Int, debt sdk.Coin) (uint64, error)
 }
 
+// LiquidKeeper defines the expected interface for the liquid keeper
+type LiquidKeeper interface {
+	IsDerivativeCollateralEnabled(ctx sdk.Context, denom string) bool
+}
+
 // HARDHooks event hooks for other keepers to run code in response to HARD modifications
 type HARDHooks interface {
 	AfterDepositCreated(ctx sdk.Context, deposit Deposit)
@@ -23,11 +23,11 @@ func (k Keeper) AccumulateSavingsRewards(ctx sdk.Context, rewardPeriod types.Mul
 
 	acc := types.NewAccumulator(previousAccrualTime, indexes)
 
-	savingsMacc := k.accountKeeper.GetModuleAccount(ctx, savingstypes.ModuleName)
-	maccCoins := k.bankKeeper.GetAllBalances(ctx, savingsMacc.GetAddress())
-	denomBalance := maccCoins.AmountOf(rewardPeriod.CollateralType)
+	// savings deposits are shares of the module's assets, so rewards are split between shares, with locked shares
+	// weighted by the reward multiplier of their lockup tier
+	totalShares := k.savingsKeeper.GetTotalWeightedShares(ctx, rewardPeriod.CollateralType)
 
-	acc.Accumulate(rewardPeriod, sdk.NewDecFromInt(denomBalance), ctx.BlockTime())
+	acc.Accumulate(rewardPeriod, totalShares, ctx.BlockTime())
 
 	k.SetSavingsRewardAccrualTime(ctx, rewardPeriod.CollateralType, acc.PreviousAccumulationTime)
 
@@ -75,10 +75,12 @@ func (k Keeper) SynchronizeSavingsReward(ctx sdk.Context, deposit savingstypes.D
 		claim.RewardIndexes = claim.RewardIndexes.With(denom, globalRewardIndexes)
 	}
 
-	// Existing denoms have their reward indexes + reward amount synced
+	// Existing denoms have their reward indexes + reward amount synced, with locked shares weighted by the reward
+	// multiplier of their lockup tier
+	weightedShares := sdk.NewDecCoinsFromCoins(deposit.Amount...).Add(k.savingsKeeper.GetLockupBoost(ctx, deposit.Depositor)...)
 	existingDenoms := setDifference(getDenoms(deposit.Amount), incomingDenoms)
 	for _, denom := range existingDenoms {
-		claim = k.synchronizeSingleSavingsReward(ctx, claim, denom, sdk.NewDecFromInt(deposit.Amount.AmountOf(denom)))
+		claim = k.synchronizeSingleSavingsReward(ctx, claim, denom, weightedShares.AmountOf(denom))
 	}
 
 	k.SetSavingsClaim(ctx, claim)
@@ -132,8 +134,9 @@ func (k Keeper) GetSynchronizedSavingsClaim(ctx sdk.Context, owner sdk.AccAddres
 		return types.SavingsClaim{}, false
 	}
 
-	for _, coin := range deposit.Amount {
-		claim = k.synchronizeSingleSavingsReward(ctx, claim, coin.Denom, sdk.NewDecFromInt(coin.Amount))
+	weightedShares := sdk.NewDecCoinsFromCoins(deposit.Amount...).Add(k.savingsKeeper.GetLockupBoost(ctx, owner)...)
+	for _, shares := range weightedShares {
+		claim = k.synchronizeSingleSavingsReward(ctx, claim, shares.Denom, shares.Amount)
 	}
 
 	return claim, true
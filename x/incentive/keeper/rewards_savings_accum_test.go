@@ -125,6 +125,8 @@ func (suite *SavingsRewardsTestSuite) TestAccumulateSavingsRewards() {
 		suite.Run(tc.name, func() {
 			params := savingstypes.NewParams(
 				[]string{"ukava"},
+				nil,
+				nil,
 			)
 			deposits := savingstypes.Deposits{
 				savingstypes.NewDeposit(
@@ -132,7 +134,7 @@ func (suite *SavingsRewardsTestSuite) TestAccumulateSavingsRewards() {
 					sdk.NewCoins(tc.args.deposit),
 				),
 			}
-			savingsGenesis := savingstypes.NewGenesisState(params, deposits)
+			savingsGenesis := savingstypes.NewGenesisState(params, deposits, nil, savingstypes.DefaultNextLockupID)
 
 			authBuilder := app.NewAuthBankGenesisBuilder().
 				WithSimpleAccount(suite.addrs[0], cs(c("ukava", 1e9))).
@@ -158,6 +160,87 @@ func (suite *SavingsRewardsTestSuite) TestAccumulateSavingsRewards() {
 	}
 }
 
+func (suite *SavingsRewardsTestSuite) TestAccumulateSavingsRewards_Lockups() {
+	deposit := c("ukava", 1_000_000)
+	params := savingstypes.NewParams([]string{"ukava"}, nil, nil)
+	deposits := savingstypes.Deposits{
+		savingstypes.NewDeposit(suite.addrs[0], sdk.NewCoins(deposit)),
+	}
+	// half of the deposit is locked with a 3x reward multiplier, so the total weighted shares are 2,000,000
+	lockups := savingstypes.Lockups{
+		savingstypes.NewLockup(
+			1, suite.addrs[0], cs(c("ukava", 500_000)),
+			savingstypes.NewLockupTier(time.Hour, d("3"), d("0.1")),
+			suite.genesisTime,
+		),
+	}
+	savingsGenesis := savingstypes.NewGenesisState(params, deposits, lockups, 2)
+
+	authBuilder := app.NewAuthBankGenesisBuilder().
+		WithSimpleAccount(suite.addrs[0], cs(c("ukava", 1e9))).
+		WithSimpleModuleAccount(savingstypes.ModuleName, sdk.NewCoins(deposit))
+
+	incentBuilder := testutil.NewIncentiveGenesisBuilder().
+		WithGenesisTime(suite.genesisTime).
+		WithSimpleSavingsRewardPeriod(deposit.Denom, cs(c("hard", 122354)))
+
+	suite.SetupWithGenState(authBuilder, incentBuilder, savingsGenesis)
+
+	runCtx := suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(7 * time.Second))
+
+	rewardPeriods, found := suite.keeper.GetSavingsRewardPeriods(runCtx, deposit.Denom)
+	suite.Require().True(found)
+	suite.keeper.AccumulateSavingsRewards(runCtx, rewardPeriods)
+
+	rewardIndexes, _ := suite.keeper.GetSavingsRewardIndexes(runCtx, deposit.Denom)
+	suite.Require().Equal(types.RewardIndexes{types.NewRewardIndex("hard", d("0.428239000000000000"))}, rewardIndexes)
+}
+
+func (suite *SavingsRewardsTestSuite) TestLockDeposit_RewardsNotBackdated() {
+	deposit := c("ukava", 1_000_000)
+	params := savingstypes.NewParams([]string{"ukava"}, nil, savingstypes.LockupTiers{
+		savingstypes.NewLockupTier(time.Hour, d("3"), d("0.1")),
+	})
+	savingsGenesis := savingstypes.NewGenesisState(params, nil, nil, savingstypes.DefaultNextLockupID)
+
+	authBuilder := app.NewAuthBankGenesisBuilder().
+		WithSimpleAccount(suite.addrs[0], cs(c("ukava", 1e9))).
+		WithSimpleAccount(suite.addrs[1], cs(c("ukava", 1e9)))
+
+	incentBuilder := testutil.NewIncentiveGenesisBuilder().
+		WithGenesisTime(suite.genesisTime).
+		WithSimpleSavingsRewardPeriod(deposit.Denom, cs(c("hard", 122354)))
+
+	suite.SetupWithGenState(authBuilder, incentBuilder, savingsGenesis)
+
+	for _, addr := range suite.addrs[:2] {
+		suite.Require().NoError(suite.savingsKeeper.Deposit(suite.ctx, addr, cs(deposit)))
+	}
+	rewardPeriods, found := suite.keeper.GetSavingsRewardPeriods(suite.ctx, deposit.Denom)
+	suite.Require().True(found)
+
+	// 856478hard are split equally between 2,000,000 shares
+	ctx := suite.ctx.WithBlockTime(suite.genesisTime.Add(7 * time.Second))
+	suite.keeper.AccumulateSavingsRewards(ctx, rewardPeriods)
+
+	// the lockup adds 1,000,000 shares with a 3x multiplier, so addrs[0] has 4,000,000 of 5,000,000 weighted shares
+	_, err := suite.savingsKeeper.LockDeposit(ctx, suite.addrs[0], cs(deposit), time.Hour)
+	suite.Require().NoError(err)
+
+	ctx = ctx.WithBlockTime(suite.genesisTime.Add(14 * time.Second))
+	suite.keeper.AccumulateSavingsRewards(ctx, rewardPeriods)
+
+	claim, found := suite.keeper.GetSynchronizedSavingsClaim(ctx, suite.addrs[0])
+	suite.Require().True(found)
+	// 0.428239 * 1,000,000 synced by the lockup, then 0.1712956 * 4,000,000 rounded
+	suite.Equal(cs(c("hard", 428_239+685_182)), claim.Reward, "boost should only earn rewards after the lockup")
+
+	claim, found = suite.keeper.GetSynchronizedSavingsClaim(ctx, suite.addrs[1])
+	suite.Require().True(found)
+	// (0.428239 + 0.1712956) * 1,000,000 rounded
+	suite.Equal(cs(c("hard", 599_535)), claim.Reward)
+}
+
 func TestSavingsRewardsTestSuite(t *testing.T) {
 	suite.Run(t, new(SavingsRewardsTestSuite))
 }
@@ -19,6 +19,11 @@ func TestSynchronizeSavingsReward(t *testing.T) {
 	suite.Run(t, new(SynchronizeSavingsRewardTests))
 }
 
+func (suite *SynchronizeSavingsRewardTests) SetupTest() {
+	suite.unitTester.SetupTest()
+	suite.keeper = suite.NewKeeper(&fakeParamSubspace{}, nil, nil, nil, nil, nil, nil, newFakeSavingsKeeper(), nil, nil)
+}
+
 func (suite *SynchronizeSavingsRewardTests) TestClaimUpdatedWhenGlobalIndexesHaveIncreased() {
 	// This is the normal case
 	// Given some time has passed (meaning the global indexes have increased)
@@ -236,6 +241,57 @@ func (suite *SynchronizeSavingsRewardTests) TestClaimUpdatedWhenNewRewardDenomAd
 	)
 }
 
+func (suite *SynchronizeSavingsRewardTests) TestClaimUpdatedWithLockupBoost() {
+	// When a depositor has locked shares
+	// The locked shares earn rewards weighted by the lockup's reward multiplier
+
+	denom := "test"
+	owner := arbitraryAddress()
+
+	claim := types.SavingsClaim{
+		BaseMultiClaim: types.BaseMultiClaim{
+			Owner:  owner,
+			Reward: sdk.NewCoins(),
+		},
+		RewardIndexes: types.MultiRewardIndexes{
+			{
+				CollateralType: denom,
+				RewardIndexes: types.RewardIndexes{
+					{
+						CollateralType: "rewarddenom",
+						RewardFactor:   d("1.0"),
+					},
+				},
+			},
+		},
+	}
+	suite.storeSavingsClaim(claim)
+
+	globalIndexes := types.MultiRewardIndexes{
+		{
+			CollateralType: denom,
+			RewardIndexes: types.RewardIndexes{
+				{
+					CollateralType: "rewarddenom",
+					RewardFactor:   d("2.0"),
+				},
+			},
+		},
+	}
+	suite.storeGlobalSavingsIndexes(globalIndexes)
+
+	savingsKeeper := newFakeSavingsKeeper().addLockupBoost(owner, sdk.NewDecCoins(sdk.NewDecCoin(denom, i(5e8))))
+	suite.keeper = suite.NewKeeper(&fakeParamSubspace{}, nil, nil, nil, nil, nil, nil, savingsKeeper, nil, nil)
+
+	deposit := savingstypes.NewDeposit(owner, sdk.NewCoins(sdk.NewCoin(denom, i(1e9))))
+	suite.keeper.SynchronizeSavingsReward(suite.ctx, deposit, []string{})
+
+	syncedClaim, _ := suite.keeper.GetSavingsClaim(suite.ctx, owner)
+	suite.Equal(globalIndexes, syncedClaim.RewardIndexes)
+	// new reward is (new index - old index) * (shares + lockup boost)
+	suite.Equal(cs(c("rewarddenom", 1.5e9)), syncedClaim.Reward)
+}
+
 func getDenoms(coins sdk.Coins) []string {
 	denoms := []string{}
 	for _, coin := range coins {
@@ -4,9 +4,11 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	hardtypes "github.com/kava-labs/kava/x/hard/types"
 	"github.com/kava-labs/kava/x/incentive/types"
+	savingstypes "github.com/kava-labs/kava/x/savings/types"
 )
 
 // AccumulateHardSupplyRewards calculates new rewards to distribute this block and updates the global indexes to reflect this.
@@ -59,6 +61,12 @@ func (k Keeper) getHardSupplyTotalSourceShares(ctx sdk.Context, denom string) sd
 // InitializeHardSupplyReward initializes the supply-side of a hard liquidity provider claim
 // by creating the claim and setting the supply reward factor index
 func (k Keeper) InitializeHardSupplyReward(ctx sdk.Context, deposit hardtypes.Deposit) {
+	// The savings module supplies its depositors' assets to hard, and its depositors are rewarded by savings rewards.
+	// No claim is created for its module account so it doesn't accrue hard supply rewards nobody can claim.
+	if deposit.Depositor.Equals(authtypes.NewModuleAddress(savingstypes.ModuleAccountName)) {
+		return
+	}
+
 	claim, found := k.GetHardLiquidityProviderClaim(ctx, deposit.Depositor)
 	if !found {
 		claim = types.NewHardLiquidityProviderClaim(deposit.Depositor, sdk.Coins{}, nil, nil)
@@ -3,9 +3,11 @@ package keeper_test
 import (
 	"testing"
 
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/kava-labs/kava/x/incentive/types"
+	savingstypes "github.com/kava-labs/kava/x/savings/types"
 )
 
 // InitializeHardSupplyRewardTests runs unit tests for the keeper.InitializeHardSupplyReward method
@@ -76,3 +78,18 @@ func (suite *InitializeHardSupplyRewardTests) TestClaimIndexesAreSetEmptyForMiss
 	syncedClaim, _ := suite.keeper.GetHardLiquidityProviderClaim(suite.ctx, owner)
 	suite.Equal(expectedIndexes, syncedClaim.SupplyRewardIndexes)
 }
+
+func (suite *InitializeHardSupplyRewardTests) TestClaimIsNotCreatedForSavingsModuleAccount() {
+	globalIndexes := nonEmptyMultiRewardIndexes
+	suite.storeGlobalSupplyIndexes(globalIndexes)
+
+	owner := authtypes.NewModuleAddress(savingstypes.ModuleAccountName)
+	deposit := NewHardDepositBuilder(owner).
+		WithArbitrarySourceShares(extractCollateralTypes(globalIndexes)...).
+		Build()
+
+	suite.keeper.InitializeHardSupplyReward(suite.ctx, deposit)
+
+	_, found := suite.keeper.GetHardLiquidityProviderClaim(suite.ctx, owner)
+	suite.False(found)
+}
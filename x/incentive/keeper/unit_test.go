@@ -26,6 +26,7 @@ import (
 	hardtypes "github.com/kava-labs/kava/x/hard/types"
 	"github.com/kava-labs/kava/x/incentive/keeper"
 	"github.com/kava-labs/kava/x/incentive/types"
+	savingstypes "github.com/kava-labs/kava/x/savings/types"
 )
 
 // NewTestContext sets up a basic context with an in-memory db
@@ -293,6 +294,57 @@ func (k *fakeSwapKeeper) GetDepositorSharesAmount(_ sdk.Context, depositor sdk.A
 	return shares, found
 }
 
+// fakeSavingsKeeper is a stub savings keeper.
+// It can be used to return values to the incentive keeper without having to initialize a full savings keeper.
+type fakeSavingsKeeper struct {
+	deposits    map[string]savingstypes.Deposit
+	boosts      map[string]sdk.DecCoins
+	totalShares map[string]sdk.Dec
+}
+
+var _ types.SavingsKeeper = newFakeSavingsKeeper()
+
+func newFakeSavingsKeeper() *fakeSavingsKeeper {
+	return &fakeSavingsKeeper{
+		deposits:    map[string]savingstypes.Deposit{},
+		boosts:      map[string]sdk.DecCoins{},
+		totalShares: map[string]sdk.Dec{},
+	}
+}
+
+func (k *fakeSavingsKeeper) addLockupBoost(depositor sdk.AccAddress, boost sdk.DecCoins) *fakeSavingsKeeper {
+	k.boosts[depositor.String()] = k.boosts[depositor.String()].Add(boost...)
+	for _, coin := range boost {
+		total, found := k.totalShares[coin.Denom]
+		if !found {
+			total = sdk.ZeroDec()
+		}
+		k.totalShares[coin.Denom] = total.Add(coin.Amount)
+	}
+	return k
+}
+
+func (k *fakeSavingsKeeper) GetDeposit(_ sdk.Context, depositor sdk.AccAddress) (savingstypes.Deposit, bool) {
+	deposit, found := k.deposits[depositor.String()]
+	return deposit, found
+}
+
+func (k *fakeSavingsKeeper) GetSavingsModuleAccountBalances(_ sdk.Context) sdk.Coins {
+	return sdk.NewCoins()
+}
+
+func (k *fakeSavingsKeeper) GetLockupBoost(_ sdk.Context, depositor sdk.AccAddress) sdk.DecCoins {
+	return k.boosts[depositor.String()]
+}
+
+func (k *fakeSavingsKeeper) GetTotalWeightedShares(_ sdk.Context, denom string) sdk.Dec {
+	total, found := k.totalShares[denom]
+	if !found {
+		return sdk.ZeroDec()
+	}
+	return total
+}
+
 // fakeHardKeeper is a stub hard keeper.
 // It can be used to return values to the incentive keeper without having to initialize a full hard keeper.
 type fakeHardKeeper struct {
@@ -66,6 +66,8 @@ type SwapKeeper interface {
 type SavingsKeeper interface {
 	GetDeposit(ctx sdk.Context, depositor sdk.AccAddress) (savingstypes.Deposit, bool)
 	GetSavingsModuleAccountBalances(ctx sdk.Context) sdk.Coins
+	GetLockupBoost(ctx sdk.Context, depositor sdk.AccAddress) sdk.DecCoins
+	GetTotalWeightedShares(ctx sdk.Context, denom string) sdk.Dec
 }
 
 // EarnKeeper defines the required methods needed by this modules keeper
@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
@@ -26,6 +27,8 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		queryParamsCmd(),
 		queryBalanceCmd(),
+		queryPaymentStreamsCmd(),
+		queryPaymentStreamCmd(),
 	)
 
 	return cmd
@@ -79,3 +82,68 @@ func queryBalanceCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func queryPaymentStreamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "payment-streams",
+		Short: "get the community pool payment streams",
+		Long:  "Get all community pool payment streams that have not been completed or cancelled.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(cliCtx)
+			res, err := queryClient.PaymentStreams(context.Background(), &types.QueryPaymentStreamsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return cliCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "payment-streams")
+	return cmd
+}
+
+func queryPaymentStreamCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "payment-stream [stream-id]",
+		Short: "get a community pool payment stream",
+		Long:  "Get a community pool payment stream by id.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			streamID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid stream id: %w", err)
+			}
+
+			queryClient := types.NewQueryClient(cliCtx)
+			res, err := queryClient.PaymentStream(context.Background(), &types.QueryPaymentStreamRequest{
+				StreamId: streamID,
+			})
+			if err != nil {
+				return err
+			}
+			return cliCtx.PrintProto(&res.PaymentStream)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
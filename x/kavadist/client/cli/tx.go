@@ -89,3 +89,130 @@ Where proposal.json contains:
 
 	return cmd
 }
+
+// GetCmdSubmitPaymentStreamProposal implements the command to submit a community-pool payment stream proposal
+func GetCmdSubmitPaymentStreamProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "community-pool-payment-stream [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a community pool payment stream proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a community pool payment stream proposal along with an initial deposit.
+The recipient is paid from the community pool each block between the start and end times. Nothing is paid before
+the cliff time. The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal community-pool-payment-stream <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Community Pool Payment Stream",
+  "description": "Pay a team some KAVA over a year!",
+  "recipient": "kava1mz2003lathm95n5vnlthmtfvrzrjkrr53j4464",
+  "amount": [
+    {
+      "denom": "ukava",
+      "amount": "1000000000"
+    }
+  ],
+  "start_time": "2024-01-01T00:00:00Z",
+  "end_time": "2025-01-01T00:00:00Z",
+  "cliff_time": "2024-04-01T00:00:00Z",
+  "deposit": [
+    {
+      "denom": "ukava",
+      "amount": "1000000000"
+    }
+  ]
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposal, err := ParseCommunityPoolPaymentStreamProposalJSON(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			content := types.NewCommunityPoolPaymentStreamProposal(
+				proposal.Title, proposal.Description, proposal.Recipient, proposal.Amount,
+				proposal.StartTime, proposal.EndTime, proposal.CliffTime,
+			)
+			msg, err := govv1beta1.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdSubmitCancelPaymentStreamProposal implements the command to submit a community-pool cancel payment stream proposal
+func GetCmdSubmitCancelPaymentStreamProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "community-pool-cancel-payment-stream [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a community pool cancel payment stream proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to cancel a community pool payment stream along with an initial deposit.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal community-pool-cancel-payment-stream <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Cancel Community Pool Payment Stream",
+  "description": "Stop paying a team that has missed its milestones.",
+  "stream_id": "1",
+  "deposit": [
+    {
+      "denom": "ukava",
+      "amount": "1000000000"
+    }
+  ]
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposal, err := ParseCommunityPoolCancelPaymentStreamProposalJSON(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			content := types.NewCommunityPoolCancelPaymentStreamProposal(proposal.Title, proposal.Description, proposal.StreamID)
+			msg, err := govv1beta1.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
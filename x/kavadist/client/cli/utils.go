@@ -22,3 +22,33 @@ func ParseCommunityPoolMultiSpendProposalJSON(cdc codec.JSONCodec, proposalFile
 
 	return proposal, nil
 }
+
+// ParseCommunityPoolPaymentStreamProposalJSON reads and parses a CommunityPoolPaymentStreamProposalJSON from a file.
+func ParseCommunityPoolPaymentStreamProposalJSON(cdc codec.JSONCodec, proposalFile string) (types.CommunityPoolPaymentStreamProposalJSON, error) {
+	proposal := types.CommunityPoolPaymentStreamProposalJSON{}
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ParseCommunityPoolCancelPaymentStreamProposalJSON reads and parses a CommunityPoolCancelPaymentStreamProposalJSON from a file.
+func ParseCommunityPoolCancelPaymentStreamProposalJSON(cdc codec.JSONCodec, proposalFile string) (types.CommunityPoolCancelPaymentStreamProposalJSON, error) {
+	proposal := types.CommunityPoolCancelPaymentStreamProposalJSON{}
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
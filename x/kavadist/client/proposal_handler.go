@@ -6,7 +6,9 @@ import (
 	"github.com/kava-labs/kava/x/kavadist/client/cli"
 )
 
-// community-pool multi-spend proposal handler
+// community-pool multi-spend and payment stream proposal handlers
 var (
-	ProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitProposal)
+	ProposalHandler                    = govclient.NewProposalHandler(cli.GetCmdSubmitProposal)
+	PaymentStreamProposalHandler       = govclient.NewProposalHandler(cli.GetCmdSubmitPaymentStreamProposal)
+	CancelPaymentStreamProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitCancelPaymentStreamProposal)
 )
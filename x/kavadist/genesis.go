@@ -22,6 +22,11 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, accountKeeper types.AccountKe
 		k.SetPreviousBlockTime(ctx, gs.PreviousBlockTime)
 	}
 
+	for _, stream := range gs.PaymentStreams {
+		k.SetPaymentStream(ctx, stream)
+	}
+	k.SetNextPaymentStreamID(ctx, gs.NextPaymentStreamID)
+
 	// check if the module account exists
 	moduleAcc := accountKeeper.GetModuleAccount(ctx, types.KavaDistMacc)
 	if moduleAcc == nil {
@@ -42,8 +47,5 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	if !found {
 		previousBlockTime = types.DefaultPreviousBlockTime
 	}
-	return &types.GenesisState{
-		Params:            params,
-		PreviousBlockTime: previousBlockTime,
-	}
+	return types.NewGenesisState(params, previousBlockTime, k.GetAllPaymentStreams(ctx), k.GetNextPaymentStreamID(ctx))
 }
@@ -32,6 +32,8 @@ func (suite *genesisTestSuite) TestInitGenesis_ValidationPanic() {
 			},
 		},
 		tmtime.Canonical(time.Unix(1, 0)),
+		types.PaymentStreams{},
+		types.DefaultNextPaymentStreamID,
 	)
 
 	suite.Require().Panics(func() {
@@ -52,6 +54,18 @@ func (suite *genesisTestSuite) TestInitAndExportGenesis() {
 			},
 		},
 		time.Date(2020, 1, 2, 1, 1, 1, 1, time.UTC),
+		types.PaymentStreams{
+			{
+				ID:        2,
+				Recipient: suite.Addrs[0],
+				Amount:    sdk.NewCoins(sdk.NewInt64Coin("ukava", 1000000)),
+				Paid:      sdk.NewCoins(sdk.NewInt64Coin("ukava", 250000)),
+				StartTime: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				EndTime:   time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+				CliffTime: time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		3,
 	)
 
 	kavadist.InitGenesis(suite.Ctx, suite.Keeper, suite.AccountKeeper, state)
@@ -10,12 +10,16 @@ import (
 	"github.com/kava-labs/kava/x/kavadist/types"
 )
 
-// NewCommunityPoolMultiSpendProposalHandler
+// NewCommunityPoolMultiSpendProposalHandler handles the kavadist community pool proposals
 func NewCommunityPoolMultiSpendProposalHandler(k keeper.Keeper) govv1beta1.Handler {
 	return func(ctx sdk.Context, content govv1beta1.Content) error {
 		switch c := content.(type) {
 		case *types.CommunityPoolMultiSpendProposal:
 			return keeper.HandleCommunityPoolMultiSpendProposal(ctx, k, c)
+		case *types.CommunityPoolPaymentStreamProposal:
+			return keeper.HandleCommunityPoolPaymentStreamProposal(ctx, k, c)
+		case *types.CommunityPoolCancelPaymentStreamProposal:
+			return keeper.HandleCommunityPoolCancelPaymentStreamProposal(ctx, k, c)
 		default:
 			return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized kavadist proposal content type: %T", c)
 		}
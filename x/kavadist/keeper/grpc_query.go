@@ -3,7 +3,11 @@ package keeper
 import (
 	"context"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/kava-labs/kava/x/kavadist/types"
 )
@@ -32,3 +36,40 @@ func (s queryServer) Params(ctx context.Context, req *types.QueryParamsRequest)
 
 	return &types.QueryParamsResponse{Params: params}, nil
 }
+
+func (s queryServer) PaymentStreams(ctx context.Context, req *types.QueryPaymentStreamsRequest) (*types.QueryPaymentStreamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	streams := types.PaymentStreams{}
+	store := prefix.NewStore(sdkCtx.KVStore(s.keeper.key), types.PaymentStreamKeyPrefix)
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var stream types.PaymentStream
+		if err := s.keeper.cdc.Unmarshal(value, &stream); err != nil {
+			return err
+		}
+		streams = append(streams, stream)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPaymentStreamsResponse{PaymentStreams: streams, Pagination: pageRes}, nil
+}
+
+func (s queryServer) PaymentStream(ctx context.Context, req *types.QueryPaymentStreamRequest) (*types.QueryPaymentStreamResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	stream, found := s.keeper.GetPaymentStream(sdkCtx, req.StreamId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "payment stream %d not found", req.StreamId)
+	}
+
+	return &types.QueryPaymentStreamResponse{PaymentStream: stream}, nil
+}
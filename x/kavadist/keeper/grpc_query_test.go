@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"context"
 	"fmt"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -114,3 +115,31 @@ func (suite *keeperTestSuite) TestGRPCBalance() {
 		})
 	}
 }
+
+func (suite *keeperTestSuite) TestGRPCPaymentStreams() {
+	ctx, queryClient := suite.Ctx, suite.QueryClient
+	start := ctx.BlockTime()
+
+	res, err := queryClient.PaymentStreams(context.Background(), &types.QueryPaymentStreamsRequest{})
+	suite.Require().NoError(err)
+	suite.Empty(res.PaymentStreams)
+
+	amount := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(1000)))
+	id1, err := suite.Keeper.CreatePaymentStream(ctx, suite.Addrs[0], amount, start, start.Add(time.Hour), start)
+	suite.Require().NoError(err)
+	id2, err := suite.Keeper.CreatePaymentStream(ctx, suite.Addrs[0], amount, start, start.Add(2*time.Hour), start)
+	suite.Require().NoError(err)
+
+	res, err = queryClient.PaymentStreams(context.Background(), &types.QueryPaymentStreamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.PaymentStreams, 2)
+	suite.Equal(id1, res.PaymentStreams[0].ID)
+	suite.Equal(id2, res.PaymentStreams[1].ID)
+
+	streamRes, err := queryClient.PaymentStream(context.Background(), &types.QueryPaymentStreamRequest{StreamId: id2})
+	suite.Require().NoError(err)
+	suite.Equal(start.Add(2*time.Hour), streamRes.PaymentStream.EndTime)
+
+	_, err = queryClient.PaymentStream(context.Background(), &types.QueryPaymentStreamRequest{StreamId: 100})
+	suite.Require().Error(err)
+}
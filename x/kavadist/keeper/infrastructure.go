@@ -66,7 +66,7 @@ func (k Keeper) distributeInfrastructureCoins(ctx sdk.Context, partnerRewards ty
 	for _, pr := range partnerRewards {
 		coinsToSend := sdk.NewCoin(types.GovDenom, pr.RewardsPerSecond.Amount.Mul(timeElapsed))
 		// TODO check balance, log if insufficient and return rather than error
-		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, pr.Address, sdk.NewCoins(coinsToSend))
+		err := k.distributeToTarget(ctx, pr.Address, sdk.NewCoins(coinsToSend), pr.Target)
 		if err != nil {
 			return err
 		}
@@ -77,12 +77,22 @@ func (k Keeper) distributeInfrastructureCoins(ctx sdk.Context, partnerRewards ty
 		coinsToDistribute = updatedCoins
 	}
 	for _, cr := range coreRewards {
-		coinsToSend := sdk.NewCoin(types.GovDenom, sdk.NewDecFromInt(coinsToDistribute.Amount).Mul(cr.Weight).RoundInt())
+		weight := k.getCoreRewardWeight(ctx, cr)
+		coinsToSend := sdk.NewCoin(types.GovDenom, sdk.NewDecFromInt(coinsToDistribute.Amount).Mul(weight).RoundInt())
 		// TODO check balance, log if insufficient and return rather than error
-		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, cr.Address, sdk.NewCoins(coinsToSend))
+		err := k.distributeToTarget(ctx, cr.Address, sdk.NewCoins(coinsToSend), cr.Target)
 		if err != nil {
 			return err
 		}
+		// rewards scaled off by a weight source are returned to the community pool rather than left in the module account
+		fullCoins := sdk.NewCoin(types.GovDenom, sdk.NewDecFromInt(coinsToDistribute.Amount).Mul(cr.Weight).RoundInt())
+		if unweightedCoins := fullCoins.Sub(coinsToSend); unweightedCoins.IsPositive() {
+			macc := k.accountKeeper.GetModuleAccount(ctx, types.KavaDistMacc)
+			if err := k.communityKeeper.FundCommunityPool(ctx, macc.GetAddress(), sdk.NewCoins(unweightedCoins)); err != nil {
+				return err
+			}
+			coinsToSend = fullCoins
+		}
 		neg, updatedCoins := safeSub(coinsToDistribute, coinsToSend)
 		if neg {
 			return fmt.Errorf("negative coins")
@@ -1,8 +1,10 @@
 package keeper
 
 import (
+	"fmt"
 	"time"
 
+	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
@@ -14,12 +16,16 @@ import (
 
 // Keeper keeper for the cdp module
 type Keeper struct {
-	key           storetypes.StoreKey
-	cdc           codec.BinaryCodec
-	paramSubspace paramtypes.Subspace
-	bankKeeper    types.BankKeeper
-	distKeeper    types.DistKeeper
-	accountKeeper types.AccountKeeper
+	key             storetypes.StoreKey
+	cdc             codec.BinaryCodec
+	paramSubspace   paramtypes.Subspace
+	bankKeeper      types.BankKeeper
+	distKeeper      types.DistKeeper
+	accountKeeper   types.AccountKeeper
+	communityKeeper types.CommunityKeeper
+	pricefeedKeeper types.PricefeedKeeper
+	incentiveKeeper types.IncentiveKeeper
+	earnKeeper      types.EarnKeeper
 
 	blacklistedAddrs map[string]bool
 }
@@ -27,7 +33,8 @@ type Keeper struct {
 // NewKeeper creates a new keeper
 func NewKeeper(
 	cdc codec.BinaryCodec, key storetypes.StoreKey, paramstore paramtypes.Subspace, bk types.BankKeeper, ak types.AccountKeeper,
-	dk types.DistKeeper, blacklistedAddrs map[string]bool,
+	dk types.DistKeeper, ck types.CommunityKeeper, pk types.PricefeedKeeper, ik types.IncentiveKeeper,
+	ek types.EarnKeeper, blacklistedAddrs map[string]bool,
 ) Keeper {
 	if !paramstore.HasKeyTable() {
 		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
@@ -40,10 +47,19 @@ func NewKeeper(
 		bankKeeper:       bk,
 		distKeeper:       dk,
 		accountKeeper:    ak,
+		communityKeeper:  ck,
+		pricefeedKeeper:  pk,
+		incentiveKeeper:  ik,
+		earnKeeper:       ek,
 		blacklistedAddrs: blacklistedAddrs,
 	}
 }
 
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
 // GetPreviousBlockTime get the blocktime for the previous block
 func (k Keeper) GetPreviousBlockTime(ctx sdk.Context) (blockTime time.Time, found bool) {
 	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousBlockTimeKey)
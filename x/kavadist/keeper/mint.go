@@ -11,6 +11,9 @@ import (
 
 // MintPeriodInflation mints new tokens according to the inflation schedule specified in the parameters
 func (k Keeper) MintPeriodInflation(ctx sdk.Context) error {
+	// payment streams are funded by the community pool rather than inflation, so are paid even when inflation is inactive
+	k.distributePaymentStreams(ctx)
+
 	params := k.GetParams(ctx)
 	if !params.Active {
 		ctx.EventManager().EmitEvent(
@@ -22,3 +22,23 @@ func HandleCommunityPoolMultiSpendProposal(ctx sdk.Context, k Keeper, p *types.C
 
 	return nil
 }
+
+// HandleCommunityPoolPaymentStreamProposal is a handler for executing a passed community pool payment stream proposal
+func HandleCommunityPoolPaymentStreamProposal(ctx sdk.Context, k Keeper, p *types.CommunityPoolPaymentStreamProposal) error {
+	if k.blacklistedAddrs[p.Recipient] {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is blacklisted from receiving external funds", p.Recipient)
+	}
+	recipient, err := sdk.AccAddressFromBech32(p.Recipient)
+	if err != nil {
+		return err
+	}
+
+	_, err = k.CreatePaymentStream(ctx, recipient, p.Amount, p.StartTime, p.EndTime, p.CliffTime)
+	return err
+}
+
+// HandleCommunityPoolCancelPaymentStreamProposal is a handler for executing a passed community pool cancel payment
+// stream proposal
+func HandleCommunityPoolCancelPaymentStreamProposal(ctx sdk.Context, k Keeper, p *types.CommunityPoolCancelPaymentStreamProposal) error {
+	return k.CancelPaymentStream(ctx, p.StreamID)
+}
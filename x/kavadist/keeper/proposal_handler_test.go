@@ -1,8 +1,12 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 
 	"github.com/kava-labs/kava/x/kavadist/keeper"
 	"github.com/kava-labs/kava/x/kavadist/types"
@@ -39,3 +43,31 @@ func (suite *keeperTestSuite) TestHandleCommunityPoolMultiSpendProposal() {
 	expected := initBalances.AmountOf("ukava").Add(sdkmath.NewInt(proposalAmount1 + proposalAmount2))
 	suite.Require().Equal(expected, balances.AmountOf("ukava"))
 }
+
+func (suite *keeperTestSuite) TestHandleCommunityPoolPaymentStreamProposal() {
+	addr, ctx := suite.Addrs[0], suite.Ctx
+	start := ctx.BlockTime()
+	amount := sdk.NewCoins(sdk.NewInt64Coin("ukava", 1000))
+
+	proposal := types.NewCommunityPoolPaymentStreamProposal(
+		"test title", "description", addr.String(), amount, start, start.Add(1000*time.Second), start,
+	)
+	err := keeper.HandleCommunityPoolPaymentStreamProposal(ctx, suite.Keeper, proposal)
+	suite.Require().NoError(err)
+
+	streams := suite.Keeper.GetAllPaymentStreams(ctx)
+	suite.Require().Len(streams, 1)
+	suite.Equal(addr, streams[0].Recipient)
+	suite.Equal(amount, streams[0].Amount)
+
+	// blocked module accounts cannot receive payment streams
+	macc := suite.AccountKeeper.GetModuleAddress(distrtypes.ModuleName)
+	proposal.Recipient = macc.String()
+	err = keeper.HandleCommunityPoolPaymentStreamProposal(ctx, suite.Keeper, proposal)
+	suite.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	cancelProposal := types.NewCommunityPoolCancelPaymentStreamProposal("test title", "description", streams[0].ID)
+	err = keeper.HandleCommunityPoolCancelPaymentStreamProposal(ctx, suite.Keeper, cancelProposal)
+	suite.Require().NoError(err)
+	suite.Empty(suite.Keeper.GetAllPaymentStreams(ctx))
+}
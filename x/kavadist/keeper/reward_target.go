@@ -0,0 +1,149 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	earntypes "github.com/kava-labs/kava/x/earn/types"
+	"github.com/kava-labs/kava/x/kavadist/types"
+)
+
+// RewardTarget is the interface that must be implemented by an infrastructure reward target.
+type RewardTarget interface {
+	// GetRewardTargetType returns the reward target type
+	GetRewardTargetType() types.RewardTargetType
+
+	// Distribute sends the specified amount of coins from the kavadist module account to the recipient.
+	Distribute(ctx sdk.Context, recipient sdk.AccAddress, amount sdk.Coins) error
+}
+
+// GetRewardTarget returns the reward target for the given reward target params.
+func (k Keeper) GetRewardTarget(target types.RewardTarget) (RewardTarget, error) {
+	switch target.Type {
+	case types.REWARD_TARGET_TYPE_ACCOUNT:
+		return AccountRewardTarget{keeper: k}, nil
+	case types.REWARD_TARGET_TYPE_VESTING:
+		return VestingRewardTarget{keeper: k, duration: target.VestingDuration}, nil
+	case types.REWARD_TARGET_TYPE_EARN_VAULT:
+		return EarnVaultRewardTarget{keeper: k, strategy: target.EarnStrategy}, nil
+	default:
+		return nil, fmt.Errorf("unknown reward target type: %s", target.Type)
+	}
+}
+
+// distributeToTarget sends rewards from the module account to the recipient's reward target. If the rewards cannot
+// be distributed to the target, for example when an earn vault has been removed, they are sent to the recipient's
+// account instead so that a misconfigured target does not halt the chain.
+func (k Keeper) distributeToTarget(ctx sdk.Context, recipient sdk.AccAddress, amount sdk.Coins, target types.RewardTarget) error {
+	if amount.IsZero() {
+		return nil
+	}
+
+	rewardTarget, err := k.GetRewardTarget(target)
+	if err == nil {
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err = rewardTarget.Distribute(cacheCtx, recipient, amount); err == nil {
+			writeCache()
+			return nil
+		}
+	}
+
+	k.Logger(ctx).Error(fmt.Sprintf("failed to distribute %s to %s reward target of %s: %s", amount, target.Type, recipient, err))
+	return AccountRewardTarget{keeper: k}.Distribute(ctx, recipient, amount)
+}
+
+// getCoreRewardWeight returns the weight of a core reward adjusted by its weight source
+func (k Keeper) getCoreRewardWeight(ctx sdk.Context, cr types.CoreReward) sdk.Dec {
+	switch cr.WeightSource {
+	case types.WEIGHT_SOURCE_PRICEFEED:
+		price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, cr.MarketID)
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("failed to get score for core reward %s from market %s: %s", cr.Address, cr.MarketID, err))
+			return sdk.ZeroDec()
+		}
+		// scores are clamped so the total of all weights can never exceed the configured weights
+		score := sdk.MinDec(sdk.MaxDec(price.Price, sdk.ZeroDec()), sdk.OneDec())
+		return cr.Weight.Mul(score)
+	default:
+		return cr.Weight
+	}
+}
+
+// AccountRewardTarget sends rewards to the recipient's account.
+type AccountRewardTarget struct {
+	keeper Keeper
+}
+
+var _ RewardTarget = AccountRewardTarget{}
+
+// GetRewardTargetType returns the reward target type
+func (t AccountRewardTarget) GetRewardTargetType() types.RewardTargetType {
+	return types.REWARD_TARGET_TYPE_ACCOUNT
+}
+
+// Distribute sends rewards to the recipient's account
+func (t AccountRewardTarget) Distribute(ctx sdk.Context, recipient sdk.AccAddress, amount sdk.Coins) error {
+	return t.keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, amount)
+}
+
+// VestingRewardTarget adds rewards to the recipient's vesting schedule, converting the recipient to a periodic
+// vesting account if necessary.
+type VestingRewardTarget struct {
+	keeper   Keeper
+	duration time.Duration
+}
+
+var _ RewardTarget = VestingRewardTarget{}
+
+// GetRewardTargetType returns the reward target type
+func (t VestingRewardTarget) GetRewardTargetType() types.RewardTargetType {
+	return types.REWARD_TARGET_TYPE_VESTING
+}
+
+// Distribute adds rewards to the recipient's vesting schedule, vesting at the end of the first vesting reward period
+// after the target's duration
+func (t VestingRewardTarget) Distribute(ctx sdk.Context, recipient sdk.AccAddress, amount sdk.Coins) error {
+	return t.keeper.incentiveKeeper.SendTimeLockedCoinsToAccount(
+		ctx, types.ModuleName, recipient, amount, GetVestingRewardLength(ctx.BlockTime(), t.duration),
+	)
+}
+
+// GetVestingRewardLength returns the length of time rewards distributed at the block time vest for. Vesting end times
+// are rounded up to the next VestingRewardPeriod boundary so rewards distributed within the same period are added to
+// the same vesting period rather than adding a new vesting period to the recipient's account every block.
+func GetVestingRewardLength(blockTime time.Time, duration time.Duration) int64 {
+	period := int64(types.VestingRewardPeriod.Seconds())
+	endTime := blockTime.Unix() + int64(duration.Seconds())
+	if remainder := endTime % period; remainder != 0 {
+		endTime += period - remainder
+	}
+	return endTime - blockTime.Unix()
+}
+
+// EarnVaultRewardTarget deposits rewards into the earn vault of each reward denom on behalf of the recipient.
+type EarnVaultRewardTarget struct {
+	keeper   Keeper
+	strategy earntypes.StrategyType
+}
+
+var _ RewardTarget = EarnVaultRewardTarget{}
+
+// GetRewardTargetType returns the reward target type
+func (t EarnVaultRewardTarget) GetRewardTargetType() types.RewardTargetType {
+	return types.REWARD_TARGET_TYPE_EARN_VAULT
+}
+
+// Distribute sends rewards to the recipient and deposits them into earn vaults with the target's strategy
+func (t EarnVaultRewardTarget) Distribute(ctx sdk.Context, recipient sdk.AccAddress, amount sdk.Coins) error {
+	if err := t.keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, amount); err != nil {
+		return err
+	}
+	for _, coin := range amount {
+		if err := t.keeper.earnKeeper.Deposit(ctx, recipient, coin, t.strategy); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,199 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+
+	earntypes "github.com/kava-labs/kava/x/earn/types"
+	"github.com/kava-labs/kava/x/kavadist/keeper"
+	"github.com/kava-labs/kava/x/kavadist/types"
+	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
+	savingstypes "github.com/kava-labs/kava/x/savings/types"
+)
+
+var (
+	infraStartTime = time.Date(2022, time.October, 1, 1, 0, 0, 0, time.UTC)
+	infraEndTime   = time.Date(2023, time.October, 1, 1, 0, 0, 0, time.UTC)
+	// 5% apy for one year distributes 50000000000ukava to a core reward with a weight of 1
+	infraPayout = sdkmath.NewInt(50000000000)
+)
+
+// mintInfraRewards distributes one year of infrastructure rewards to the given core rewards.
+func (suite *keeperTestSuite) mintInfraRewards(ctx sdk.Context, coreRewards types.CoreRewards) {
+	infraPeriods := types.Periods{types.NewPeriod(infraStartTime, infraEndTime, sdk.MustNewDecFromStr("1.000000001547125958"))}
+	params := types.NewParams(true, types.DefaultPeriods, types.NewInfraParams(infraPeriods, types.DefaultInfraParams.PartnerRewards, coreRewards))
+	suite.Keeper.SetParams(ctx, params)
+	suite.Keeper.SetPreviousBlockTime(ctx, infraStartTime)
+
+	// Delete initial genesis tokens to start with a clean slate
+	suite.App.DeleteGenesisValidator(suite.T(), ctx)
+	suite.App.DeleteGenesisValidatorCoins(suite.T(), ctx)
+
+	suite.Require().NoError(suite.Keeper.MintPeriodInflation(ctx.WithBlockTime(infraEndTime)))
+}
+
+func (suite *keeperTestSuite) TestInfraPayout_PricefeedWeight() {
+	ctx := suite.Ctx.WithBlockTime(infraEndTime)
+	pricefeedKeeper := suite.App.GetPriceFeedKeeper()
+	pricefeedKeeper.SetParams(ctx, pricefeedtypes.NewParams([]pricefeedtypes.Market{
+		{MarketID: "uptime:score", BaseAsset: "uptime", QuoteAsset: "score", Oracles: []sdk.AccAddress{}, Active: true},
+		{MarketID: "overflow:score", BaseAsset: "overflow", QuoteAsset: "score", Oracles: []sdk.AccAddress{}, Active: true},
+	}))
+	_, err := pricefeedKeeper.SetPrice(ctx, sdk.AccAddress{}, "uptime:score", sdk.MustNewDecFromStr("0.5"), infraEndTime.Add(time.Hour))
+	suite.Require().NoError(err)
+	suite.Require().NoError(pricefeedKeeper.SetCurrentPrices(ctx, "uptime:score"))
+	_, err = pricefeedKeeper.SetPrice(ctx, sdk.AccAddress{}, "overflow:score", sdk.NewDec(2), infraEndTime.Add(time.Hour))
+	suite.Require().NoError(err)
+	suite.Require().NoError(pricefeedKeeper.SetCurrentPrices(ctx, "overflow:score"))
+
+	testCases := []struct {
+		name             string
+		marketID         string
+		expectedIncrease sdkmath.Int
+	}{
+		{"weight is scaled by the score", "uptime:score", infraPayout.QuoRaw(2)},
+		{"scores are capped at 1", "overflow:score", infraPayout},
+		{"markets without a price have no weight", "missing:score", sdk.ZeroInt()},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			cacheCtx, _ := ctx.CacheContext()
+			coreReward := types.NewCoreReward(suite.Addrs[0], sdk.OneDec())
+			coreReward.WeightSource = types.WEIGHT_SOURCE_PRICEFEED
+			coreReward.MarketID = tc.marketID
+
+			communityKeeper := suite.App.GetCommunityKeeper()
+			initialBalance := suite.BankKeeper.GetBalance(cacheCtx, suite.Addrs[0], types.GovDenom)
+			initialCommunityBalance := communityKeeper.GetModuleAccountBalance(cacheCtx).AmountOf(types.GovDenom)
+			suite.mintInfraRewards(cacheCtx, types.CoreRewards{coreReward})
+
+			finalBalance := suite.BankKeeper.GetBalance(cacheCtx, suite.Addrs[0], types.GovDenom)
+			suite.Equal(tc.expectedIncrease.Int64(), finalBalance.Amount.Sub(initialBalance.Amount).Int64())
+
+			// rewards scaled off by the score are returned to the community pool
+			finalCommunityBalance := communityKeeper.GetModuleAccountBalance(cacheCtx).AmountOf(types.GovDenom)
+			suite.Equal(infraPayout.Sub(tc.expectedIncrease).Int64(), finalCommunityBalance.Sub(initialCommunityBalance).Int64())
+			kavadistAddr := suite.AccountKeeper.GetModuleAddress(types.KavaDistMacc)
+			suite.True(suite.BankKeeper.GetBalance(cacheCtx, kavadistAddr, types.GovDenom).IsZero())
+		})
+	}
+}
+
+func (suite *keeperTestSuite) TestInfraPayout_VestingTarget() {
+	ctx := suite.Ctx.WithBlockTime(infraEndTime)
+	coreReward := types.NewCoreReward(suite.Addrs[0], sdk.OneDec())
+	coreReward.Target = types.NewRewardTarget(types.REWARD_TARGET_TYPE_VESTING, 30*24*time.Hour, earntypes.STRATEGY_TYPE_UNSPECIFIED)
+
+	suite.mintInfraRewards(ctx, types.CoreRewards{coreReward})
+
+	acc := suite.AccountKeeper.GetAccount(ctx, suite.Addrs[0])
+	vacc, ok := acc.(*vestingtypes.PeriodicVestingAccount)
+	suite.Require().True(ok, "recipient should be converted to a periodic vesting account")
+	expected := sdk.NewCoins(sdk.NewCoin(types.GovDenom, infraPayout))
+	suite.Equal(expected, vacc.OriginalVesting)
+	// the vesting end time is rounded up to the next vesting reward period
+	suite.Equal(time.Date(2023, time.November, 1, 0, 0, 0, 0, time.UTC).Unix(), vacc.EndTime)
+}
+
+func (suite *keeperTestSuite) TestInfraPayout_VestingTargetBatchesPeriods() {
+	ctx := suite.Ctx.WithBlockTime(infraEndTime)
+	coreReward := types.NewCoreReward(suite.Addrs[0], sdk.OneDec())
+	coreReward.Target = types.NewRewardTarget(types.REWARD_TARGET_TYPE_VESTING, 30*24*time.Hour, earntypes.STRATEGY_TYPE_UNSPECIFIED)
+	suite.mintInfraRewards(ctx, types.CoreRewards{coreReward})
+
+	getVestingAccount := func(ctx sdk.Context) *vestingtypes.PeriodicVestingAccount {
+		vacc, ok := suite.AccountKeeper.GetAccount(ctx, suite.Addrs[0]).(*vestingtypes.PeriodicVestingAccount)
+		suite.Require().True(ok)
+		return vacc
+	}
+	suite.Require().Len(getVestingAccount(ctx).VestingPeriods, 1)
+
+	// the infrastructure period has ended, so extend it to keep minting rewards
+	params := suite.Keeper.GetParams(ctx)
+	params.InfrastructureParams.InfrastructurePeriods = types.Periods{
+		types.NewPeriod(infraStartTime, infraEndTime.Add(48*time.Hour), sdk.MustNewDecFromStr("1.000000001547125958")),
+	}
+	suite.Keeper.SetParams(ctx, params)
+
+	// rewards distributed within the same vesting reward period are added to the existing vesting period
+	blockTime := infraEndTime
+	for i := 0; i < 10; i++ {
+		blockTime = blockTime.Add(6 * time.Second)
+		ctx = ctx.WithBlockTime(blockTime)
+		suite.Require().NoError(suite.Keeper.MintPeriodInflation(ctx))
+	}
+	vacc := getVestingAccount(ctx)
+	suite.Len(vacc.VestingPeriods, 1)
+	suite.Equal(vacc.OriginalVesting, vacc.VestingPeriods[0].Amount)
+
+	// rewards distributed in the next period add one new vesting period
+	blockTime = blockTime.Add(types.VestingRewardPeriod)
+	for i := 0; i < 10; i++ {
+		blockTime = blockTime.Add(6 * time.Second)
+		ctx = ctx.WithBlockTime(blockTime)
+		suite.Require().NoError(suite.Keeper.MintPeriodInflation(ctx))
+	}
+	vacc = getVestingAccount(ctx)
+	suite.Len(vacc.VestingPeriods, 2)
+	suite.Equal(vacc.OriginalVesting, vacc.VestingPeriods[0].Amount.Add(vacc.VestingPeriods[1].Amount...))
+}
+
+func (suite *keeperTestSuite) TestGetVestingRewardLength() {
+	day := int64(types.VestingRewardPeriod.Seconds())
+	testCases := []struct {
+		name      string
+		blockTime time.Time
+		duration  time.Duration
+		expected  int64
+	}{
+		{"end time on a period boundary", time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), 24 * time.Hour, day},
+		{"end time rounded up to the next period", time.Date(2023, 1, 1, 1, 0, 0, 0, time.UTC), 24 * time.Hour, 2*day - 3600},
+		{"short durations vest at the end of the period", time.Date(2023, 1, 1, 23, 59, 0, 0, time.UTC), time.Second, 60},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.Equal(tc.expected, keeper.GetVestingRewardLength(tc.blockTime, tc.duration))
+		})
+	}
+}
+
+func (suite *keeperTestSuite) TestInfraPayout_EarnVaultTarget() {
+	ctx := suite.Ctx.WithBlockTime(infraEndTime)
+	suite.App.GetSavingsKeeper().SetParams(ctx, savingstypes.NewParams([]string{types.GovDenom}, nil, nil))
+	suite.App.GetEarnKeeper().SetParams(ctx, earntypes.NewParams(earntypes.AllowedVaults{
+		earntypes.NewAllowedVault(types.GovDenom, earntypes.StrategyTypes{earntypes.STRATEGY_TYPE_SAVINGS}, false, nil),
+	}))
+
+	coreReward := types.NewCoreReward(suite.Addrs[0], sdk.OneDec())
+	coreReward.Target = types.NewRewardTarget(types.REWARD_TARGET_TYPE_EARN_VAULT, 0, earntypes.STRATEGY_TYPE_SAVINGS)
+
+	initialBalance := suite.BankKeeper.GetBalance(ctx, suite.Addrs[0], types.GovDenom)
+	suite.mintInfraRewards(ctx, types.CoreRewards{coreReward})
+
+	earnKeeper := suite.App.GetEarnKeeper()
+	shares, found := earnKeeper.GetVaultAccountShares(ctx, suite.Addrs[0])
+	suite.Require().True(found)
+	suite.Equal(sdk.NewDecFromInt(infraPayout), shares.AmountOf(types.GovDenom))
+	suite.Equal(initialBalance, suite.BankKeeper.GetBalance(ctx, suite.Addrs[0], types.GovDenom))
+}
+
+func (suite *keeperTestSuite) TestInfraPayout_FailedTargetFallsBackToAccount() {
+	ctx := suite.Ctx.WithBlockTime(infraEndTime)
+
+	// there is no ukava earn vault, so rewards are sent to the recipient's account
+	coreReward := types.NewCoreReward(suite.Addrs[0], sdk.OneDec())
+	coreReward.Target = types.NewRewardTarget(types.REWARD_TARGET_TYPE_EARN_VAULT, 0, earntypes.STRATEGY_TYPE_SAVINGS)
+
+	initialBalance := suite.BankKeeper.GetBalance(ctx, suite.Addrs[0], types.GovDenom)
+	suite.mintInfraRewards(ctx, types.CoreRewards{coreReward})
+
+	finalBalance := suite.BankKeeper.GetBalance(ctx, suite.Addrs[0], types.GovDenom)
+	suite.Equal(infraPayout, finalBalance.Amount.Sub(initialBalance.Amount))
+	earnKeeper := suite.App.GetEarnKeeper()
+	_, found := earnKeeper.GetVaultAccountShares(ctx, suite.Addrs[0])
+	suite.False(found)
+}
@@ -0,0 +1,223 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/kavadist/types"
+)
+
+// CreatePaymentStream creates a payment stream that pays the recipient from the community pool between the start and
+// end times. Nothing is paid before the cliff time.
+func (k Keeper) CreatePaymentStream(
+	ctx sdk.Context, recipient sdk.AccAddress, amount sdk.Coins, startTime, endTime, cliffTime time.Time,
+) (uint64, error) {
+	if !endTime.After(ctx.BlockTime()) {
+		return 0, errorsmod.Wrapf(types.ErrInvalidPaymentStream, "end time %s has passed", endTime)
+	}
+
+	id := k.GetNextPaymentStreamID(ctx)
+	stream := types.NewPaymentStream(id, recipient, amount, startTime, endTime, cliffTime)
+	if err := stream.Validate(); err != nil {
+		return 0, errorsmod.Wrap(types.ErrInvalidPaymentStream, err.Error())
+	}
+
+	k.SetPaymentStream(ctx, stream)
+	k.SetNextPaymentStreamID(ctx, id+1)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreatePaymentStream,
+			sdk.NewAttribute(types.AttributeKeyPaymentStreamID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return id, nil
+}
+
+// CancelPaymentStream removes a payment stream, stopping any further payments to the recipient
+func (k Keeper) CancelPaymentStream(ctx sdk.Context, id uint64) error {
+	stream, found := k.GetPaymentStream(ctx, id)
+	if !found {
+		return errorsmod.Wrapf(types.ErrPaymentStreamNotFound, "%d", id)
+	}
+
+	k.DeletePaymentStream(ctx, id)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelPaymentStream,
+			sdk.NewAttribute(types.AttributeKeyPaymentStreamID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, stream.Recipient.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, stream.Paid.String()),
+		),
+	)
+
+	return nil
+}
+
+// distributePaymentStreams pays each payment stream that is due the amount accrued since it was last paid. If the
+// community pool does not hold enough to pay a stream in full, the available balance is paid and the remainder is paid
+// in later blocks. A stream that fails to be paid is skipped until its next payout time.
+func (k Keeper) distributePaymentStreams(ctx sdk.Context) {
+	var ids []uint64
+	k.IteratePaymentStreamQueue(ctx, ctx.BlockTime(), func(id uint64) bool {
+		ids = append(ids, id)
+		return false
+	})
+
+	for _, id := range ids {
+		stream, found := k.GetPaymentStream(ctx, id)
+		if !found {
+			continue
+		}
+		k.payPaymentStream(ctx, stream)
+	}
+}
+
+// payPaymentStream pays a payment stream the amount owed, and removes it once it is fully paid
+func (k Keeper) payPaymentStream(ctx sdk.Context, stream types.PaymentStream) {
+	owed := stream.GetOwed(ctx.BlockTime())
+	payout := owed.Min(k.communityKeeper.GetModuleAccountBalance(ctx))
+
+	switch {
+	case owed.IsZero():
+	case payout.IsZero():
+		k.Logger(ctx).Info(fmt.Sprintf("insufficient community pool balance to pay payment stream %d", stream.ID))
+	default:
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.communityKeeper.DistributeFromCommunityPool(cacheCtx, stream.Recipient, payout); err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("failed to pay payment stream %d: %s", stream.ID, err))
+			stream.NextPayoutTime = stream.GetNextPayoutTime(ctx.BlockTime())
+			k.SetPaymentStream(ctx, stream)
+			return
+		}
+		writeCache()
+		stream.Paid = stream.Paid.Add(payout...)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypePaymentStreamPayout,
+				sdk.NewAttribute(types.AttributeKeyPaymentStreamID, strconv.FormatUint(stream.ID, 10)),
+				sdk.NewAttribute(types.AttributeKeyRecipient, stream.Recipient.String()),
+				sdk.NewAttribute(types.AttributeKeyAmount, payout.String()),
+			),
+		)
+	}
+
+	if stream.IsComplete() {
+		k.DeletePaymentStream(ctx, stream.ID)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypePaymentStreamComplete,
+				sdk.NewAttribute(types.AttributeKeyPaymentStreamID, strconv.FormatUint(stream.ID, 10)),
+				sdk.NewAttribute(types.AttributeKeyRecipient, stream.Recipient.String()),
+				sdk.NewAttribute(types.AttributeKeyAmount, stream.Paid.String()),
+			),
+		)
+		return
+	}
+
+	// streams the community pool could not pay in full stay due so the shortfall is paid as soon as possible
+	if stream.GetOwed(ctx.BlockTime()).IsZero() {
+		stream.NextPayoutTime = stream.GetNextPayoutTime(ctx.BlockTime())
+	}
+	k.SetPaymentStream(ctx, stream)
+}
+
+// GetPaymentStream returns a payment stream from the store
+func (k Keeper) GetPaymentStream(ctx sdk.Context, id uint64) (types.PaymentStream, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PaymentStreamKeyPrefix)
+	bz := store.Get(types.PaymentStreamKeyFromID(id))
+	if bz == nil {
+		return types.PaymentStream{}, false
+	}
+	var stream types.PaymentStream
+	k.cdc.MustUnmarshal(bz, &stream)
+	return stream, true
+}
+
+// SetPaymentStream sets a payment stream in the store and queues it by its next payout time
+func (k Keeper) SetPaymentStream(ctx sdk.Context, stream types.PaymentStream) {
+	queueStore := prefix.NewStore(ctx.KVStore(k.key), types.PaymentStreamQueuePrefix)
+	if previous, found := k.GetPaymentStream(ctx, stream.ID); found {
+		queueStore.Delete(types.PaymentStreamQueueKey(previous.NextPayoutTime, previous.ID))
+	}
+	queueStore.Set(types.PaymentStreamQueueKey(stream.NextPayoutTime, stream.ID), sdk.Uint64ToBigEndian(stream.ID))
+
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PaymentStreamKeyPrefix)
+	bz := k.cdc.MustMarshal(&stream)
+	store.Set(types.PaymentStreamKeyFromID(stream.ID), bz)
+}
+
+// DeletePaymentStream deletes a payment stream from the store and the payout queue
+func (k Keeper) DeletePaymentStream(ctx sdk.Context, id uint64) {
+	stream, found := k.GetPaymentStream(ctx, id)
+	if !found {
+		return
+	}
+
+	queueStore := prefix.NewStore(ctx.KVStore(k.key), types.PaymentStreamQueuePrefix)
+	queueStore.Delete(types.PaymentStreamQueueKey(stream.NextPayoutTime, id))
+
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PaymentStreamKeyPrefix)
+	store.Delete(types.PaymentStreamKeyFromID(id))
+}
+
+// IteratePaymentStreams iterates over all payment streams in order of id and performs a callback function
+func (k Keeper) IteratePaymentStreams(ctx sdk.Context, cb func(stream types.PaymentStream) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PaymentStreamKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var stream types.PaymentStream
+		k.cdc.MustUnmarshal(iterator.Value(), &stream)
+		if cb(stream) {
+			break
+		}
+	}
+}
+
+// IteratePaymentStreamQueue iterates over the ids of payment streams with a next payout time at or before the cutoff
+// time, in order of next payout time
+func (k Keeper) IteratePaymentStreamQueue(ctx sdk.Context, inclusiveCutoffTime time.Time, cb func(id uint64) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PaymentStreamQueuePrefix)
+	iterator := store.Iterator(nil, sdk.PrefixEndBytes(sdk.FormatTimeBytes(inclusiveCutoffTime)))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(sdk.BigEndianToUint64(iterator.Value())) {
+			break
+		}
+	}
+}
+
+// GetAllPaymentStreams returns all payment streams from the store
+func (k Keeper) GetAllPaymentStreams(ctx sdk.Context) types.PaymentStreams {
+	streams := types.PaymentStreams{}
+	k.IteratePaymentStreams(ctx, func(stream types.PaymentStream) bool {
+		streams = append(streams, stream)
+		return false
+	})
+	return streams
+}
+
+// GetNextPaymentStreamID returns the id of the next payment stream
+func (k Keeper) GetNextPaymentStreamID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.key).Get(types.NextPaymentStreamIDKey)
+	if bz == nil {
+		return types.DefaultNextPaymentStreamID
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextPaymentStreamID sets the id of the next payment stream
+func (k Keeper) SetNextPaymentStreamID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.key).Set(types.NextPaymentStreamIDKey, sdk.Uint64ToBigEndian(id))
+}
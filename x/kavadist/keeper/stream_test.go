@@ -0,0 +1,160 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/kava-labs/kava/app"
+	communitytypes "github.com/kava-labs/kava/x/community/types"
+	"github.com/kava-labs/kava/x/kavadist/types"
+)
+
+func (suite *keeperTestSuite) fundCommunityPool(amount sdk.Coins) {
+	suite.Require().NoError(suite.App.FundModuleAccount(suite.Ctx, communitytypes.ModuleAccountName, amount))
+}
+
+func (suite *keeperTestSuite) TestCreatePaymentStream() {
+	recipient := app.RandomAddress()
+	start := suite.Ctx.BlockTime()
+	amount := sdk.NewCoins(sdk.NewInt64Coin("ukava", 1000))
+
+	id, err := suite.Keeper.CreatePaymentStream(suite.Ctx, recipient, amount, start, start.Add(1000*time.Second), start)
+	suite.Require().NoError(err)
+	suite.Equal(types.DefaultNextPaymentStreamID, id)
+	suite.Equal(id+1, suite.Keeper.GetNextPaymentStreamID(suite.Ctx))
+
+	stream, found := suite.Keeper.GetPaymentStream(suite.Ctx, id)
+	suite.Require().True(found)
+	suite.Equal(types.NewPaymentStream(id, recipient, amount, start, start.Add(1000*time.Second), start), stream)
+
+	// cliff must be between the start and end time
+	_, err = suite.Keeper.CreatePaymentStream(suite.Ctx, recipient, amount, start, start.Add(1000*time.Second), start.Add(-time.Second))
+	suite.ErrorIs(err, types.ErrInvalidPaymentStream)
+
+	// streams that have already ended cannot be created
+	_, err = suite.Keeper.CreatePaymentStream(suite.Ctx, recipient, amount, start.Add(-1000*time.Second), start, start)
+	suite.ErrorIs(err, types.ErrInvalidPaymentStream)
+}
+
+func (suite *keeperTestSuite) TestPaymentStreamPayouts() {
+	recipient := app.RandomAddress()
+	start := suite.Ctx.BlockTime()
+	suite.fundCommunityPool(sdk.NewCoins(sdk.NewInt64Coin("ukava", 1e6)))
+
+	id, err := suite.Keeper.CreatePaymentStream(
+		suite.Ctx, recipient, sdk.NewCoins(sdk.NewInt64Coin("ukava", 1000)),
+		start, start.Add(1000*time.Second), start.Add(100*time.Second),
+	)
+	suite.Require().NoError(err)
+
+	// nothing is paid before the cliff
+	suite.Ctx = suite.Ctx.WithBlockTime(start.Add(50 * time.Second))
+	suite.Require().NoError(suite.Keeper.MintPeriodInflation(suite.Ctx))
+	suite.True(suite.BankKeeper.GetAllBalances(suite.Ctx, recipient).IsZero())
+
+	// payments accrued since the start are paid after the cliff
+	suite.Ctx = suite.Ctx.WithBlockTime(start.Add(250 * time.Second))
+	suite.Require().NoError(suite.Keeper.MintPeriodInflation(suite.Ctx))
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin("ukava", 250)), suite.BankKeeper.GetAllBalances(suite.Ctx, recipient))
+
+	stream, found := suite.Keeper.GetPaymentStream(suite.Ctx, id)
+	suite.Require().True(found)
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin("ukava", 250)), stream.Paid)
+	suite.Equal(start.Add(1000*time.Second), stream.NextPayoutTime, "the last payout should be at the end time")
+
+	// streams are not paid again until their next payout time
+	suite.Ctx = suite.Ctx.WithBlockTime(start.Add(500 * time.Second))
+	suite.Require().NoError(suite.Keeper.MintPeriodInflation(suite.Ctx))
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin("ukava", 250)), suite.BankKeeper.GetAllBalances(suite.Ctx, recipient))
+
+	// the remainder is paid at the end and the stream is removed
+	suite.Ctx = suite.Ctx.WithBlockTime(start.Add(2000 * time.Second))
+	suite.Require().NoError(suite.Keeper.MintPeriodInflation(suite.Ctx))
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin("ukava", 1000)), suite.BankKeeper.GetAllBalances(suite.Ctx, recipient))
+
+	_, found = suite.Keeper.GetPaymentStream(suite.Ctx, id)
+	suite.False(found)
+}
+
+func (suite *keeperTestSuite) TestPaymentStreamPayouts_InsufficientCommunityPool() {
+	recipient := app.RandomAddress()
+	start := suite.Ctx.BlockTime()
+	communityPool := suite.AccountKeeper.GetModuleAddress(communitytypes.ModuleAccountName)
+	suite.fundCommunityPool(sdk.NewCoins(sdk.NewInt64Coin("ukava", 100)))
+
+	id, err := suite.Keeper.CreatePaymentStream(
+		suite.Ctx, recipient, sdk.NewCoins(sdk.NewInt64Coin("ukava", 1000)), start, start.Add(1000*time.Second), start,
+	)
+	suite.Require().NoError(err)
+
+	// the available balance is paid when the community pool cannot pay the full amount
+	suite.Ctx = suite.Ctx.WithBlockTime(start.Add(250 * time.Second))
+	suite.Require().NoError(suite.Keeper.MintPeriodInflation(suite.Ctx))
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin("ukava", 100)), suite.BankKeeper.GetAllBalances(suite.Ctx, recipient))
+	suite.True(suite.BankKeeper.GetAllBalances(suite.Ctx, communityPool).IsZero())
+
+	// the shortfall is paid once the community pool is funded
+	suite.fundCommunityPool(sdk.NewCoins(sdk.NewInt64Coin("ukava", 1e6)))
+	suite.Ctx = suite.Ctx.WithBlockTime(start.Add(300 * time.Second))
+	suite.Require().NoError(suite.Keeper.MintPeriodInflation(suite.Ctx))
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin("ukava", 300)), suite.BankKeeper.GetAllBalances(suite.Ctx, recipient))
+
+	stream, found := suite.Keeper.GetPaymentStream(suite.Ctx, id)
+	suite.Require().True(found)
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin("ukava", 300)), stream.Paid)
+}
+
+func (suite *keeperTestSuite) TestPaymentStreamPayouts_FailedPayout() {
+	recipient := app.RandomAddress()
+	blockedRecipient := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	start := suite.Ctx.BlockTime()
+	suite.fundCommunityPool(sdk.NewCoins(sdk.NewInt64Coin("ukava", 1e6)))
+
+	amount := sdk.NewCoins(sdk.NewInt64Coin("ukava", 1000))
+	failingID, err := suite.Keeper.CreatePaymentStream(suite.Ctx, blockedRecipient, amount, start, start.Add(10000*time.Second), start)
+	suite.Require().NoError(err)
+	id, err := suite.Keeper.CreatePaymentStream(suite.Ctx, recipient, amount, start, start.Add(10000*time.Second), start)
+	suite.Require().NoError(err)
+
+	// a stream that can't be paid is skipped without affecting other streams
+	suite.Ctx = suite.Ctx.WithBlockTime(start.Add(2500 * time.Second))
+	suite.Require().NoError(suite.Keeper.MintPeriodInflation(suite.Ctx))
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin("ukava", 250)), suite.BankKeeper.GetAllBalances(suite.Ctx, recipient))
+
+	failing, found := suite.Keeper.GetPaymentStream(suite.Ctx, failingID)
+	suite.Require().True(found)
+	suite.True(failing.Paid.IsZero())
+	suite.Equal(suite.Ctx.BlockTime().Add(types.PaymentStreamPayoutInterval), failing.NextPayoutTime)
+
+	stream, found := suite.Keeper.GetPaymentStream(suite.Ctx, id)
+	suite.Require().True(found)
+	suite.Equal(suite.Ctx.BlockTime().Add(types.PaymentStreamPayoutInterval), stream.NextPayoutTime)
+}
+
+func (suite *keeperTestSuite) TestCancelPaymentStream() {
+	recipient := app.RandomAddress()
+	start := suite.Ctx.BlockTime()
+	suite.fundCommunityPool(sdk.NewCoins(sdk.NewInt64Coin("ukava", 1e6)))
+
+	id, err := suite.Keeper.CreatePaymentStream(
+		suite.Ctx, recipient, sdk.NewCoins(sdk.NewInt64Coin("ukava", 1000)), start, start.Add(1000*time.Second), start,
+	)
+	suite.Require().NoError(err)
+
+	suite.Ctx = suite.Ctx.WithBlockTime(start.Add(250 * time.Second))
+	suite.Require().NoError(suite.Keeper.MintPeriodInflation(suite.Ctx))
+
+	suite.Require().NoError(suite.Keeper.CancelPaymentStream(suite.Ctx, id))
+	_, found := suite.Keeper.GetPaymentStream(suite.Ctx, id)
+	suite.False(found)
+
+	// no further payments are made after a stream is cancelled
+	suite.Ctx = suite.Ctx.WithBlockTime(start.Add(500 * time.Second))
+	suite.Require().NoError(suite.Keeper.MintPeriodInflation(suite.Ctx))
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin("ukava", 250)), suite.BankKeeper.GetAllBalances(suite.Ctx, recipient))
+
+	err = suite.Keeper.CancelPaymentStream(suite.Ctx, id)
+	suite.ErrorIs(err, types.ErrPaymentStreamNotFound)
+}
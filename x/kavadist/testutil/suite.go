@@ -54,7 +54,9 @@ func (suite *Suite) SetupTest() {
 		},
 	}
 	params := types.NewParams(true, testPeriods, types.DefaultInfraParams)
-	moduleGs := types.ModuleCdc.MustMarshalJSON(types.NewGenesisState(params, types.DefaultPreviousBlockTime))
+	moduleGs := types.ModuleCdc.MustMarshalJSON(types.NewGenesisState(
+		params, types.DefaultPreviousBlockTime, types.PaymentStreams{}, types.DefaultNextPaymentStreamID,
+	))
 	gs := app.GenesisState{types.ModuleName: moduleGs}
 	suite.App = tApp.InitializeFromGenesisStates(authGS, gs)
 	suite.Ctx = ctx
@@ -12,12 +12,16 @@ import (
 // on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&CommunityPoolMultiSpendProposal{}, "kava/CommunityPoolMultiSpendProposal", nil)
+	cdc.RegisterConcrete(&CommunityPoolPaymentStreamProposal{}, "kava/CommunityPoolPaymentStreamProposal", nil)
+	cdc.RegisterConcrete(&CommunityPoolCancelPaymentStreamProposal{}, "kava/CommunityPoolCancelPaymentStreamProposal", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*govv1beta1.Content)(nil),
 		&CommunityPoolMultiSpendProposal{},
+		&CommunityPoolPaymentStreamProposal{},
+		&CommunityPoolCancelPaymentStreamProposal{},
 	)
 }
 
@@ -6,4 +6,6 @@ import errorsmod "cosmossdk.io/errors"
 var (
 	ErrInvalidProposalAmount  = errorsmod.Register(ModuleName, 2, "invalid community pool multi-spend proposal amount")
 	ErrEmptyProposalRecipient = errorsmod.Register(ModuleName, 3, "invalid community pool multi-spend proposal recipient")
+	ErrInvalidPaymentStream   = errorsmod.Register(ModuleName, 4, "invalid payment stream")
+	ErrPaymentStreamNotFound  = errorsmod.Register(ModuleName, 5, "payment stream not found")
 )
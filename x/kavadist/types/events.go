@@ -1,8 +1,15 @@
 package types
 
 const (
-	EventTypeKavaDist      = ModuleName
-	AttributeKeyInflation  = "kava_dist_inflation"
-	AttributeKeyStatus     = "kava_dist_status"
-	AttributeValueInactive = "inactive"
+	EventTypeKavaDist              = ModuleName
+	EventTypeCreatePaymentStream   = "create_payment_stream"
+	EventTypeCancelPaymentStream   = "cancel_payment_stream"
+	EventTypePaymentStreamPayout   = "payment_stream_payout"
+	EventTypePaymentStreamComplete = "payment_stream_complete"
+	AttributeKeyInflation          = "kava_dist_inflation"
+	AttributeKeyStatus             = "kava_dist_status"
+	AttributeKeyPaymentStreamID    = "payment_stream_id"
+	AttributeKeyRecipient          = "recipient"
+	AttributeKeyAmount             = "amount"
+	AttributeValueInactive         = "inactive"
 )
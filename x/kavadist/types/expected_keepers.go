@@ -3,6 +3,9 @@ package types
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authTypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	earntypes "github.com/kava-labs/kava/x/earn/types"
+	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
 )
 
 // DistKeeper defines the expected distribution keeper interface
@@ -10,6 +13,28 @@ type DistKeeper interface {
 	DistributeFromFeePool(ctx sdk.Context, amount sdk.Coins, receiveAddr sdk.AccAddress) error
 }
 
+// CommunityKeeper defines the expected community keeper interface
+type CommunityKeeper interface {
+	GetModuleAccountBalance(ctx sdk.Context) sdk.Coins
+	FundCommunityPool(ctx sdk.Context, sender sdk.AccAddress, amount sdk.Coins) error
+	DistributeFromCommunityPool(ctx sdk.Context, recipient sdk.AccAddress, amount sdk.Coins) error
+}
+
+// PricefeedKeeper defines the expected pricefeed keeper interface
+type PricefeedKeeper interface {
+	GetCurrentPrice(ctx sdk.Context, marketID string) (pricefeedtypes.CurrentPrice, error)
+}
+
+// IncentiveKeeper defines the expected incentive keeper interface
+type IncentiveKeeper interface {
+	SendTimeLockedCoinsToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins, length int64) error
+}
+
+// EarnKeeper defines the expected earn keeper interface
+type EarnKeeper interface {
+	Deposit(ctx sdk.Context, depositor sdk.AccAddress, amount sdk.Coin, depositStrategy earntypes.StrategyType) error
+}
+
 // AccountKeeper defines the expected account keeper interface
 type AccountKeeper interface {
 	GetModuleAccount(ctx sdk.Context, moduleName string) authTypes.ModuleAccountI
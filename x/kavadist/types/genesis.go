@@ -5,19 +5,28 @@ import (
 	"time"
 )
 
+// DefaultNextPaymentStreamID is the id of the first payment stream
+const DefaultNextPaymentStreamID uint64 = 1
+
 // NewGenesisState returns a new genesis state
-func NewGenesisState(params Params, previousBlockTime time.Time) *GenesisState {
+func NewGenesisState(
+	params Params, previousBlockTime time.Time, paymentStreams PaymentStreams, nextPaymentStreamID uint64,
+) *GenesisState {
 	return &GenesisState{
-		Params:            params,
-		PreviousBlockTime: previousBlockTime,
+		Params:              params,
+		PreviousBlockTime:   previousBlockTime,
+		PaymentStreams:      paymentStreams,
+		NextPaymentStreamID: nextPaymentStreamID,
 	}
 }
 
 // DefaultGenesisState returns a default genesis state
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params:            DefaultParams(),
-		PreviousBlockTime: DefaultPreviousBlockTime,
+		Params:              DefaultParams(),
+		PreviousBlockTime:   DefaultPreviousBlockTime,
+		PaymentStreams:      PaymentStreams{},
+		NextPaymentStreamID: DefaultNextPaymentStreamID,
 	}
 }
 
@@ -30,5 +39,13 @@ func (gs GenesisState) Validate() error {
 	if gs.PreviousBlockTime.Equal(time.Time{}) {
 		return fmt.Errorf("previous block time not set")
 	}
+	if err := gs.PaymentStreams.Validate(); err != nil {
+		return err
+	}
+	for _, ps := range gs.PaymentStreams {
+		if ps.ID >= gs.NextPaymentStreamID {
+			return fmt.Errorf("payment stream id %d must be less than next payment stream id %d", ps.ID, gs.NextPaymentStreamID)
+		}
+	}
 	return nil
 }
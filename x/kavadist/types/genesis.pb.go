@@ -29,8 +29,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the kavadist module's genesis state.
 type GenesisState struct {
-	Params            Params    `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	PreviousBlockTime time.Time `protobuf:"bytes,2,opt,name=previous_block_time,json=previousBlockTime,proto3,stdtime" json:"previous_block_time"`
+	Params              Params         `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	PreviousBlockTime   time.Time      `protobuf:"bytes,2,opt,name=previous_block_time,json=previousBlockTime,proto3,stdtime" json:"previous_block_time"`
+	PaymentStreams      PaymentStreams `protobuf:"bytes,3,rep,name=payment_streams,json=paymentStreams,proto3,castrepeated=PaymentStreams" json:"payment_streams"`
+	NextPaymentStreamID uint64         `protobuf:"varint,4,opt,name=next_payment_stream_id,json=nextPaymentStreamId,proto3" json:"next_payment_stream_id,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -80,6 +82,20 @@ func (m *GenesisState) GetPreviousBlockTime() time.Time {
 	return time.Time{}
 }
 
+func (m *GenesisState) GetPaymentStreams() PaymentStreams {
+	if m != nil {
+		return m.PaymentStreams
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextPaymentStreamID() uint64 {
+	if m != nil {
+		return m.NextPaymentStreamID
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "kava.kavadist.v1beta1.GenesisState")
 }
@@ -89,25 +105,30 @@ func init() {
 }
 
 var fileDescriptor_77f4885f7744ff13 = []byte{
-	// 279 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xce, 0x4e, 0x2c, 0x4b,
-	0xd4, 0x07, 0x11, 0x29, 0x99, 0xc5, 0x25, 0xfa, 0x65, 0x86, 0x49, 0xa9, 0x25, 0x89, 0x86, 0xfa,
-	0xe9, 0xa9, 0x79, 0xa9, 0xc5, 0x99, 0xc5, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0xa2, 0x20,
-	0x79, 0x3d, 0x98, 0x22, 0x3d, 0xa8, 0x22, 0x29, 0x91, 0xf4, 0xfc, 0xf4, 0x7c, 0xb0, 0x0a, 0x7d,
-	0x10, 0x0b, 0xa2, 0x58, 0x4a, 0x3e, 0x3d, 0x3f, 0x3f, 0x3d, 0x27, 0x55, 0x1f, 0xcc, 0x4b, 0x2a,
-	0x4d, 0xd3, 0x2f, 0xc9, 0xcc, 0x4d, 0x2d, 0x2e, 0x49, 0xcc, 0x2d, 0x80, 0x2a, 0x50, 0xc2, 0x6e,
-	0x65, 0x41, 0x62, 0x51, 0x62, 0x2e, 0xd4, 0x46, 0xa5, 0x85, 0x8c, 0x5c, 0x3c, 0xee, 0x10, 0x37,
-	0x04, 0x97, 0x24, 0x96, 0xa4, 0x0a, 0x59, 0x73, 0xb1, 0x41, 0x14, 0x48, 0x30, 0x2a, 0x30, 0x6a,
-	0x70, 0x1b, 0xc9, 0xea, 0x61, 0x75, 0x93, 0x5e, 0x00, 0x58, 0x91, 0x13, 0xcb, 0x89, 0x7b, 0xf2,
-	0x0c, 0x41, 0x50, 0x2d, 0x42, 0x21, 0x5c, 0xc2, 0x05, 0x45, 0xa9, 0x65, 0x99, 0xf9, 0xa5, 0xc5,
-	0xf1, 0x49, 0x39, 0xf9, 0xc9, 0xd9, 0xf1, 0x20, 0x37, 0x49, 0x30, 0x81, 0x4d, 0x92, 0xd2, 0x83,
-	0x38, 0x58, 0x0f, 0xe6, 0x60, 0xbd, 0x10, 0x98, 0x83, 0x9d, 0x38, 0x40, 0xc6, 0x4c, 0xb8, 0x2f,
-	0xcf, 0x18, 0x24, 0x08, 0x33, 0xc0, 0x09, 0xa4, 0x1f, 0xa4, 0xc2, 0xc9, 0xe5, 0xc4, 0x23, 0x39,
-	0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63,
-	0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0xb4, 0xd2, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92,
-	0xf3, 0x73, 0xc1, 0xfe, 0xd4, 0xcd, 0x49, 0x4c, 0x2a, 0x06, 0xb3, 0xf4, 0x2b, 0x10, 0x1e, 0x2f,
-	0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0x5b, 0x6b, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x10,
-	0xd1, 0xf3, 0x3d, 0x89, 0x01, 0x00, 0x00,
+	// 367 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xc1, 0x6a, 0xf2, 0x40,
+	0x10, 0xc7, 0x13, 0x15, 0xf9, 0x88, 0x1f, 0x96, 0xc6, 0xd6, 0x06, 0xa1, 0x89, 0xd8, 0x1e, 0xa4,
+	0xd0, 0x5d, 0xb4, 0xc7, 0xde, 0x82, 0x50, 0x0a, 0xa5, 0x94, 0xe8, 0xa9, 0x97, 0xb0, 0xd1, 0x6d,
+	0x1a, 0x34, 0xd9, 0x25, 0xbb, 0x8a, 0xbe, 0x85, 0xcf, 0xd1, 0x27, 0xf1, 0xe8, 0xa9, 0xf4, 0xa4,
+	0x25, 0xbe, 0x48, 0xd9, 0x8d, 0xa1, 0x84, 0xda, 0x5e, 0xc2, 0xcc, 0xe4, 0x37, 0xff, 0xf9, 0xcf,
+	0x8e, 0x76, 0x31, 0x46, 0x33, 0x04, 0xc5, 0x67, 0x14, 0x30, 0x0e, 0x67, 0x1d, 0x0f, 0x73, 0xd4,
+	0x81, 0x3e, 0x8e, 0x30, 0x0b, 0x18, 0xa0, 0x31, 0xe1, 0x44, 0x3f, 0x15, 0xff, 0x41, 0x06, 0x81,
+	0x3d, 0xd4, 0x38, 0xf1, 0x89, 0x4f, 0x24, 0x01, 0x45, 0x94, 0xc2, 0x0d, 0xcb, 0x27, 0xc4, 0x9f,
+	0x60, 0x28, 0x33, 0x6f, 0xfa, 0x02, 0x79, 0x10, 0x62, 0xc6, 0x51, 0x48, 0xf7, 0x40, 0xeb, 0xf0,
+	0x48, 0x8a, 0x62, 0x14, 0xb2, 0xbf, 0x19, 0xc6, 0x63, 0x8c, 0xc2, 0x94, 0x69, 0xbd, 0x17, 0xb4,
+	0xff, 0x77, 0xa9, 0xcf, 0x3e, 0x47, 0x1c, 0xeb, 0xb7, 0x5a, 0x39, 0x15, 0x31, 0xd4, 0xa6, 0xda,
+	0xae, 0x74, 0xcf, 0xc1, 0x41, 0xdf, 0xe0, 0x49, 0x42, 0x76, 0x69, 0xb5, 0xb1, 0x14, 0x67, 0xdf,
+	0xa2, 0x0f, 0xb4, 0x1a, 0x8d, 0xf1, 0x2c, 0x20, 0x53, 0xe6, 0x7a, 0x13, 0x32, 0x1c, 0xbb, 0xc2,
+	0xb7, 0x51, 0x90, 0x4a, 0x0d, 0x90, 0x2e, 0x05, 0xb2, 0xa5, 0xc0, 0x20, 0x5b, 0xca, 0xfe, 0x27,
+	0x64, 0x96, 0x5b, 0x4b, 0x75, 0x8e, 0x33, 0x01, 0x5b, 0xf4, 0x0b, 0x42, 0xc7, 0xda, 0x11, 0x45,
+	0x8b, 0x10, 0x47, 0xdc, 0x4d, 0xbd, 0x33, 0xa3, 0xd8, 0x2c, 0xb6, 0x2b, 0xdd, 0xcb, 0x5f, 0xbd,
+	0x49, 0xba, 0x2f, 0x61, 0xbb, 0x2e, 0xb4, 0xdf, 0xb6, 0x56, 0x35, 0x57, 0x66, 0x4e, 0x95, 0xe6,
+	0x72, 0xfd, 0x41, 0xab, 0x47, 0x78, 0xce, 0xdd, 0xfc, 0x2c, 0x37, 0x18, 0x19, 0xa5, 0xa6, 0xda,
+	0x2e, 0xd9, 0x67, 0xc9, 0xc6, 0xaa, 0x3d, 0xe2, 0x39, 0xcf, 0xe9, 0xdc, 0xf7, 0x9c, 0x5a, 0xf4,
+	0xa3, 0x38, 0xb2, 0x7b, 0xab, 0xc4, 0x54, 0xd7, 0x89, 0xa9, 0x7e, 0x26, 0xa6, 0xba, 0xdc, 0x99,
+	0xca, 0x7a, 0x67, 0x2a, 0x1f, 0x3b, 0x53, 0x79, 0xbe, 0xf2, 0x03, 0xfe, 0x3a, 0xf5, 0xc0, 0x90,
+	0x84, 0xf2, 0x38, 0xd7, 0x13, 0xe4, 0x31, 0x19, 0xc1, 0xf9, 0xf7, 0xb5, 0xf8, 0x82, 0x62, 0xe6,
+	0x95, 0xe5, 0x5b, 0xdd, 0x7c, 0x0d, 0x00, 0x6f, 0x1b, 0xd5, 0xcd, 0x62, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -130,6 +151,25 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NextPaymentStreamID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextPaymentStreamID))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.PaymentStreams) > 0 {
+		for iNdEx := len(m.PaymentStreams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PaymentStreams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PreviousBlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PreviousBlockTime):])
 	if err1 != nil {
 		return 0, err1
@@ -172,6 +212,15 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PreviousBlockTime)
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PaymentStreams) > 0 {
+		for _, e := range m.PaymentStreams {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextPaymentStreamID != 0 {
+		n += 1 + sovGenesis(uint64(m.NextPaymentStreamID))
+	}
 	return n
 }
 
@@ -276,6 +325,59 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentStreams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaymentStreams = append(m.PaymentStreams, PaymentStream{})
+			if err := m.PaymentStreams[len(m.PaymentStreams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPaymentStreamID", wireType)
+			}
+			m.NextPaymentStreamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextPaymentStreamID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,5 +1,11 @@
 package types
 
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName name that will be used throughout the module
 	ModuleName = "kavadist"
@@ -18,9 +24,25 @@ const (
 
 	// Treasury
 	FundModuleAccount = "kava-fund"
+
+	// VestingRewardPeriod is the interval vesting reward target end times are aligned to
+	VestingRewardPeriod = 24 * time.Hour
 )
 
 var (
-	CurrentDistPeriodKey = []byte{0x00}
-	PreviousBlockTimeKey = []byte{0x01}
+	CurrentDistPeriodKey     = []byte{0x00}
+	PreviousBlockTimeKey     = []byte{0x01}
+	PaymentStreamKeyPrefix   = []byte{0x02}
+	NextPaymentStreamIDKey   = []byte{0x03}
+	PaymentStreamQueuePrefix = []byte{0x04}
 )
+
+// PaymentStreamKeyFromID returns the store key of a payment stream
+func PaymentStreamKeyFromID(id uint64) []byte {
+	return sdk.Uint64ToBigEndian(id)
+}
+
+// PaymentStreamQueueKey returns the key of a payment stream in the queue of streams ordered by next payout time
+func PaymentStreamQueueKey(nextPayoutTime time.Time, id uint64) []byte {
+	return append(sdk.FormatTimeBytes(nextPayoutTime), sdk.Uint64ToBigEndian(id)...)
+}
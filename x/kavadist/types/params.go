@@ -9,6 +9,8 @@ import (
 
 	tmtime "github.com/cometbft/cometbft/types/time"
 	// cdptypes "github.com/kava-labs/kava/x/cdp/types"
+
+	earntypes "github.com/kava-labs/kava/x/earn/types"
 )
 
 // Parameter keys and default values
@@ -83,7 +85,11 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return validatePeriodsParams(p.Periods)
+	if err := validatePeriodsParams(p.Periods); err != nil {
+		return err
+	}
+
+	return validateInfraParams(p.InfrastructureParams)
 }
 
 // NewPeriod returns a new instance of Period
@@ -127,6 +133,66 @@ func NewCoreReward(addr sdk.AccAddress, w sdk.Dec) CoreReward {
 	}
 }
 
+// NewRewardTarget returns a new instance of RewardTarget
+func NewRewardTarget(targetType RewardTargetType, vestingDuration time.Duration, earnStrategy earntypes.StrategyType) RewardTarget {
+	return RewardTarget{
+		Type:            targetType,
+		VestingDuration: vestingDuration,
+		EarnStrategy:    earnStrategy,
+	}
+}
+
+// Validate performs a basic check of a core reward's fields.
+func (cr CoreReward) Validate() error {
+	if cr.Address.Empty() {
+		return fmt.Errorf("core reward address cannot be empty")
+	}
+	if cr.Weight.IsNil() || cr.Weight.IsNegative() {
+		return fmt.Errorf("core reward weight must be non-negative: %s", cr.Weight)
+	}
+	switch cr.WeightSource {
+	case WEIGHT_SOURCE_FIXED:
+	case WEIGHT_SOURCE_PRICEFEED:
+		if cr.MarketID == "" {
+			return fmt.Errorf("core reward market id cannot be empty with weight source %s", cr.WeightSource)
+		}
+	default:
+		return fmt.Errorf("invalid core reward weight source: %s", cr.WeightSource)
+	}
+	return cr.Target.Validate()
+}
+
+// Validate performs a basic check of a partner reward's fields.
+func (pr PartnerReward) Validate() error {
+	if pr.Address.Empty() {
+		return fmt.Errorf("partner reward address cannot be empty")
+	}
+	if !pr.RewardsPerSecond.IsValid() {
+		return fmt.Errorf("invalid partner rewards per second: %s", pr.RewardsPerSecond)
+	}
+	return pr.Target.Validate()
+}
+
+// Validate performs a basic check of a reward target's fields.
+func (rt RewardTarget) Validate() error {
+	switch rt.Type {
+	case REWARD_TARGET_TYPE_ACCOUNT:
+		return nil
+	case REWARD_TARGET_TYPE_VESTING:
+		if rt.VestingDuration < time.Second {
+			return fmt.Errorf("reward target vesting duration must be at least one second: %s", rt.VestingDuration)
+		}
+		return nil
+	case REWARD_TARGET_TYPE_EARN_VAULT:
+		if rt.EarnStrategy == earntypes.STRATEGY_TYPE_UNSPECIFIED {
+			return fmt.Errorf("reward target earn strategy cannot be unspecified")
+		}
+		return nil
+	default:
+		return fmt.Errorf("invalid reward target type: %s", rt.Type)
+	}
+}
+
 func validateActiveParam(i interface{}) error {
 	_, ok := i.(bool)
 	if !ok {
@@ -184,6 +250,16 @@ func validateInfraParams(i interface{}) error {
 
 		// TODO: validate period Inflation?
 	}
+	for _, cr := range infraParams.CoreRewards {
+		if err := cr.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, pr := range infraParams.PartnerRewards {
+		if err := pr.Validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -11,6 +11,8 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	types1 "github.com/kava-labs/kava/x/earn/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -30,6 +32,66 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// WeightSource defines how the weight of a core reward is adjusted by on-chain metrics.
+type WeightSource int32
+
+const (
+	// WEIGHT_SOURCE_FIXED uses the weight set in the params, which can be updated by governance or a committee.
+	WEIGHT_SOURCE_FIXED WeightSource = 0
+	// WEIGHT_SOURCE_PRICEFEED scales the weight by a score between 0 and 1 posted by oracles to a pricefeed market.
+	WEIGHT_SOURCE_PRICEFEED WeightSource = 1
+)
+
+var WeightSource_name = map[int32]string{
+	0: "WEIGHT_SOURCE_FIXED",
+	1: "WEIGHT_SOURCE_PRICEFEED",
+}
+
+var WeightSource_value = map[string]int32{
+	"WEIGHT_SOURCE_FIXED":     0,
+	"WEIGHT_SOURCE_PRICEFEED": 1,
+}
+
+func (x WeightSource) String() string {
+	return proto.EnumName(WeightSource_name, int32(x))
+}
+
+func (WeightSource) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2c7a7a4b0c884a4e, []int{0}
+}
+
+// RewardTargetType defines where infrastructure rewards are distributed.
+type RewardTargetType int32
+
+const (
+	// REWARD_TARGET_TYPE_ACCOUNT sends rewards to the recipient's account.
+	REWARD_TARGET_TYPE_ACCOUNT RewardTargetType = 0
+	// REWARD_TARGET_TYPE_VESTING adds rewards to the recipient's vesting schedule.
+	REWARD_TARGET_TYPE_VESTING RewardTargetType = 1
+	// REWARD_TARGET_TYPE_EARN_VAULT deposits rewards into an earn vault on behalf of the recipient.
+	REWARD_TARGET_TYPE_EARN_VAULT RewardTargetType = 2
+)
+
+var RewardTargetType_name = map[int32]string{
+	0: "REWARD_TARGET_TYPE_ACCOUNT",
+	1: "REWARD_TARGET_TYPE_VESTING",
+	2: "REWARD_TARGET_TYPE_EARN_VAULT",
+}
+
+var RewardTargetType_value = map[string]int32{
+	"REWARD_TARGET_TYPE_ACCOUNT":    0,
+	"REWARD_TARGET_TYPE_VESTING":    1,
+	"REWARD_TARGET_TYPE_EARN_VAULT": 2,
+}
+
+func (x RewardTargetType) String() string {
+	return proto.EnumName(RewardTargetType_name, int32(x))
+}
+
+func (RewardTargetType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2c7a7a4b0c884a4e, []int{1}
+}
+
 // Params governance parameters for kavadist module
 type Params struct {
 	Active               bool                 `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"`
@@ -113,6 +175,13 @@ var xxx_messageInfo_InfrastructureParams proto.InternalMessageInfo
 type CoreReward struct {
 	Address github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=address,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"address,omitempty"`
 	Weight  github_com_cosmos_cosmos_sdk_types.Dec        `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
+	// weight_source determines how the weight is adjusted by on-chain metrics.
+	WeightSource WeightSource `protobuf:"varint,3,opt,name=weight_source,json=weightSource,proto3,enum=kava.kavadist.v1beta1.WeightSource" json:"weight_source,omitempty"`
+	// market_id is the pricefeed market that posts a score between 0 and 1 scaling the weight, used with
+	// WEIGHT_SOURCE_PRICEFEED.
+	MarketID string `protobuf:"bytes,4,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// target determines where rewards are distributed.
+	Target RewardTarget `protobuf:"bytes,5,opt,name=target,proto3" json:"target"`
 }
 
 func (m *CoreReward) Reset()         { *m = CoreReward{} }
@@ -152,6 +221,8 @@ var xxx_messageInfo_CoreReward proto.InternalMessageInfo
 type PartnerReward struct {
 	Address          github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=address,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"address,omitempty"`
 	RewardsPerSecond types.Coin                                    `protobuf:"bytes,2,opt,name=rewards_per_second,json=rewardsPerSecond,proto3" json:"rewards_per_second"`
+	// target determines where rewards are distributed.
+	Target RewardTarget `protobuf:"bytes,3,opt,name=target,proto3" json:"target"`
 }
 
 func (m *PartnerReward) Reset()         { *m = PartnerReward{} }
@@ -187,6 +258,49 @@ func (m *PartnerReward) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PartnerReward proto.InternalMessageInfo
 
+// RewardTarget defines where infrastructure rewards are distributed for a recipient.
+type RewardTarget struct {
+	Type RewardTargetType `protobuf:"varint,1,opt,name=type,proto3,enum=kava.kavadist.v1beta1.RewardTargetType" json:"type,omitempty"`
+	// vesting_duration is the length of time rewards vest for, used with REWARD_TARGET_TYPE_VESTING.
+	VestingDuration time.Duration `protobuf:"bytes,2,opt,name=vesting_duration,json=vestingDuration,proto3,stdduration" json:"vesting_duration"`
+	// earn_strategy is the strategy of the earn vault rewards are deposited into, used with
+	// REWARD_TARGET_TYPE_EARN_VAULT.
+	EarnStrategy types1.StrategyType `protobuf:"varint,3,opt,name=earn_strategy,json=earnStrategy,proto3,enum=kava.earn.v1beta1.StrategyType" json:"earn_strategy,omitempty"`
+}
+
+func (m *RewardTarget) Reset()         { *m = RewardTarget{} }
+func (m *RewardTarget) String() string { return proto.CompactTextString(m) }
+func (*RewardTarget) ProtoMessage()    {}
+func (*RewardTarget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c7a7a4b0c884a4e, []int{4}
+}
+func (m *RewardTarget) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardTarget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardTarget.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardTarget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardTarget.Merge(m, src)
+}
+func (m *RewardTarget) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardTarget) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardTarget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardTarget proto.InternalMessageInfo
+
 // Period stores the specified start and end dates, and the inflation, expressed as a decimal
 // representing the yearly APR of KAVA tokens that will be minted during that period
 type Period struct {
@@ -201,7 +315,7 @@ type Period struct {
 func (m *Period) Reset()      { *m = Period{} }
 func (*Period) ProtoMessage() {}
 func (*Period) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2c7a7a4b0c884a4e, []int{4}
+	return fileDescriptor_2c7a7a4b0c884a4e, []int{5}
 }
 func (m *Period) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -231,10 +345,13 @@ func (m *Period) XXX_DiscardUnknown() {
 var xxx_messageInfo_Period proto.InternalMessageInfo
 
 func init() {
+	proto.RegisterEnum("kava.kavadist.v1beta1.WeightSource", WeightSource_name, WeightSource_value)
+	proto.RegisterEnum("kava.kavadist.v1beta1.RewardTargetType", RewardTargetType_name, RewardTargetType_value)
 	proto.RegisterType((*Params)(nil), "kava.kavadist.v1beta1.Params")
 	proto.RegisterType((*InfrastructureParams)(nil), "kava.kavadist.v1beta1.InfrastructureParams")
 	proto.RegisterType((*CoreReward)(nil), "kava.kavadist.v1beta1.CoreReward")
 	proto.RegisterType((*PartnerReward)(nil), "kava.kavadist.v1beta1.PartnerReward")
+	proto.RegisterType((*RewardTarget)(nil), "kava.kavadist.v1beta1.RewardTarget")
 	proto.RegisterType((*Period)(nil), "kava.kavadist.v1beta1.Period")
 }
 
@@ -243,48 +360,68 @@ func init() {
 }
 
 var fileDescriptor_2c7a7a4b0c884a4e = []byte{
-	// 649 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0x3f, 0x4f, 0x1b, 0x3f,
-	0x18, 0x8e, 0x49, 0x7e, 0x01, 0x1c, 0x7e, 0x50, 0x99, 0x3f, 0x0a, 0x48, 0xbd, 0x4b, 0xa3, 0xaa,
-	0x8a, 0x5a, 0xe5, 0x4e, 0xa4, 0x52, 0x07, 0xd4, 0x0e, 0x5c, 0x19, 0x5a, 0x89, 0x4a, 0xe8, 0xca,
-	0xd2, 0x2e, 0x91, 0xcf, 0xe7, 0x04, 0x0b, 0x72, 0x3e, 0xd9, 0x0e, 0x94, 0x6f, 0xc1, 0xd8, 0x91,
-	0xb9, 0x33, 0x5f, 0xa1, 0x6a, 0x86, 0x0e, 0x88, 0x09, 0x75, 0x80, 0x02, 0x4b, 0x3f, 0x43, 0xa7,
-	0xea, 0x6c, 0x87, 0x10, 0x04, 0x52, 0xba, 0x74, 0x49, 0xee, 0x7d, 0xfd, 0x3e, 0xcf, 0xfb, 0x3e,
-	0xe7, 0xe7, 0x3d, 0x58, 0xdd, 0xc6, 0xbb, 0xd8, 0xcf, 0x7e, 0x62, 0x26, 0x95, 0xbf, 0xbb, 0x1c,
-	0x51, 0x85, 0x97, 0xfd, 0x14, 0x0b, 0xdc, 0x91, 0x5e, 0x2a, 0xb8, 0xe2, 0x68, 0x3e, 0x3b, 0xf6,
-	0xfa, 0x35, 0x9e, 0xad, 0x59, 0x72, 0x08, 0x97, 0x1d, 0x2e, 0xfd, 0x08, 0x4b, 0x7a, 0x0d, 0x24,
-	0x9c, 0x25, 0x06, 0xb6, 0xb4, 0x68, 0xce, 0x9b, 0x3a, 0xf2, 0x4d, 0x60, 0x8f, 0xe6, 0xda, 0xbc,
-	0xcd, 0x4d, 0x3e, 0x7b, 0xb2, 0x59, 0xb7, 0xcd, 0x79, 0x7b, 0x87, 0xfa, 0x3a, 0x8a, 0xba, 0x2d,
-	0x5f, 0xb1, 0x0e, 0x95, 0x0a, 0x77, 0x52, 0x53, 0x50, 0xfd, 0x06, 0x60, 0x71, 0x43, 0x4f, 0x86,
-	0x16, 0x60, 0x11, 0x13, 0xc5, 0x76, 0x69, 0x19, 0x54, 0x40, 0x6d, 0x22, 0xb4, 0x11, 0x7a, 0x05,
-	0xc7, 0x53, 0x2a, 0x18, 0x8f, 0x65, 0x39, 0x5f, 0xc9, 0xd7, 0x4a, 0x8d, 0x87, 0xde, 0x9d, 0xd3,
-	0x7b, 0x1b, 0xba, 0x2a, 0x28, 0xf4, 0xce, 0xdc, 0x5c, 0xd8, 0xc7, 0xa0, 0x16, 0x9c, 0x67, 0x49,
-	0x4b, 0x60, 0xa9, 0x44, 0x97, 0xa8, 0xae, 0xa0, 0x4d, 0xf3, 0x26, 0xca, 0x85, 0x0a, 0xa8, 0x95,
-	0x1a, 0xcf, 0xee, 0x21, 0x7b, 0x3b, 0x84, 0x31, 0x23, 0x5a, 0xea, 0x39, 0x76, 0xc7, 0x59, 0xf5,
-	0xeb, 0x18, 0x9c, 0xbb, 0x0b, 0x84, 0x28, 0x5c, 0xb8, 0x3d, 0x80, 0x95, 0x03, 0x46, 0x91, 0x33,
-	0x93, 0xf5, 0xfc, 0x72, 0xee, 0x8e, 0x9b, 0x58, 0x86, 0xb7, 0xe4, 0xd8, 0x34, 0xfa, 0x00, 0xa7,
-	0x08, 0x17, 0xb4, 0x29, 0xe8, 0x1e, 0x16, 0xb1, 0x2c, 0x8f, 0x69, 0xf2, 0x47, 0xf7, 0x90, 0xbf,
-	0xe6, 0x82, 0x86, 0xba, 0x32, 0x98, 0xb5, 0x0d, 0x4a, 0x83, 0x9c, 0x0c, 0x4b, 0x64, 0x10, 0x20,
-	0x0a, 0x67, 0x52, 0x2c, 0x54, 0x42, 0xc5, 0x35, 0xbb, 0xb9, 0x89, 0xc7, 0xf7, 0x8d, 0x6e, 0xaa,
-	0x6d, 0x83, 0x05, 0xdb, 0x60, 0x7a, 0x28, 0x2d, 0xc3, 0xe9, 0x74, 0x28, 0x5e, 0x29, 0x7c, 0x3e,
-	0x74, 0x41, 0xf5, 0x3b, 0x80, 0x70, 0x30, 0x09, 0x8a, 0xe0, 0x38, 0x8e, 0x63, 0x41, 0xa5, 0xd4,
-	0xb6, 0x98, 0x0a, 0xde, 0xfc, 0x3e, 0x73, 0xeb, 0x6d, 0xa6, 0xb6, 0xba, 0x91, 0x47, 0x78, 0xc7,
-	0xba, 0xd0, 0xfe, 0xd5, 0x65, 0xbc, 0xed, 0xab, 0xfd, 0x94, 0x4a, 0x6f, 0x95, 0x90, 0x55, 0x03,
-	0x3c, 0x39, 0xaa, 0xcf, 0x5a, 0xaf, 0xda, 0x4c, 0xb0, 0xaf, 0xa8, 0x0c, 0xfb, 0xc4, 0x68, 0x13,
-	0x16, 0xf7, 0x28, 0x6b, 0x6f, 0xa9, 0xf2, 0x58, 0x05, 0xd4, 0x26, 0x83, 0x97, 0xd9, 0xc0, 0x3f,
-	0xce, 0xdc, 0x27, 0x23, 0xb4, 0x59, 0xa3, 0xe4, 0xe4, 0xa8, 0x0e, 0x2d, 0xff, 0x1a, 0x25, 0xa1,
-	0xe5, 0xb2, 0x72, 0x7a, 0x00, 0xfe, 0x3f, 0xa4, 0xfb, 0x9f, 0x28, 0x7a, 0x07, 0x91, 0xbd, 0xa9,
-	0xcc, 0x6c, 0x4d, 0x49, 0x09, 0x4f, 0x62, 0xad, 0xae, 0xd4, 0x58, 0xf4, 0x2c, 0x34, 0xdb, 0xf2,
-	0x1b, 0x86, 0x60, 0x89, 0xf5, 0xf7, 0x03, 0x0b, 0xdd, 0xa0, 0xe2, 0xbd, 0x06, 0x5a, 0x29, 0xc7,
-	0xd9, 0xae, 0x6a, 0xb7, 0xa1, 0x15, 0xf8, 0x9f, 0x54, 0x58, 0x28, 0xad, 0xa0, 0xd4, 0x58, 0xf2,
-	0xcc, 0x9e, 0x7b, 0xfd, 0x3d, 0xf7, 0x36, 0xfb, 0x7b, 0x1e, 0x4c, 0x64, 0x9c, 0x07, 0xe7, 0x2e,
-	0x08, 0x0d, 0x04, 0xbd, 0x80, 0x79, 0x7a, 0x3d, 0xcc, 0x68, 0xc8, 0x0c, 0x80, 0xd6, 0xe1, 0x24,
-	0x4b, 0x5a, 0x3b, 0x58, 0x31, 0x9e, 0x94, 0xf3, 0xfa, 0xcd, 0x79, 0x7f, 0x77, 0x51, 0xe1, 0x80,
-	0x60, 0xa5, 0xf0, 0xeb, 0xd0, 0x05, 0xc1, 0x7a, 0xef, 0xc2, 0xc9, 0x9d, 0x5e, 0x38, 0xb9, 0xde,
-	0xa5, 0x03, 0x8e, 0x2f, 0x1d, 0xf0, 0xf3, 0xd2, 0x01, 0x07, 0x57, 0x4e, 0xee, 0xf8, 0xca, 0xc9,
-	0x9d, 0x5e, 0x39, 0xb9, 0x8f, 0x4f, 0x6f, 0x50, 0x67, 0x3e, 0xaf, 0xef, 0xe0, 0x48, 0xea, 0x27,
-	0xff, 0xd3, 0xe0, 0x23, 0xab, 0x5b, 0x44, 0x45, 0x2d, 0xe2, 0xf9, 0x9f, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xca, 0x25, 0xda, 0x69, 0x82, 0x05, 0x00, 0x00,
+	// 962 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcd, 0x4f, 0x1b, 0x47,
+	0x14, 0xf7, 0x62, 0xc7, 0xc0, 0xd8, 0x80, 0x35, 0x10, 0xe2, 0xb8, 0xca, 0xae, 0x43, 0xab, 0xd6,
+	0xa5, 0xf2, 0x5a, 0x71, 0xa5, 0x1e, 0x68, 0x7b, 0xf0, 0xc7, 0x06, 0x5c, 0x11, 0x62, 0x8d, 0x97,
+	0xd0, 0xf4, 0xb2, 0x1a, 0xef, 0x0e, 0xce, 0x0a, 0xec, 0xb5, 0x66, 0xc6, 0x50, 0xd4, 0x6b, 0x0f,
+	0x95, 0x7a, 0xc9, 0x31, 0xc7, 0x48, 0xbd, 0xf5, 0x9c, 0x7f, 0xa1, 0x2a, 0x47, 0x94, 0x53, 0xd4,
+	0x03, 0x69, 0xe0, 0xd0, 0xfe, 0x0d, 0x3d, 0x55, 0x3b, 0x33, 0xeb, 0x0f, 0x8a, 0x25, 0xaa, 0x4a,
+	0xbd, 0xc0, 0xbe, 0x8f, 0xdf, 0x7b, 0xbf, 0xf7, 0x31, 0x4f, 0x06, 0x6b, 0x07, 0xf8, 0x08, 0x97,
+	0xc2, 0x3f, 0x9e, 0xcf, 0x78, 0xe9, 0xe8, 0x41, 0x9b, 0x70, 0xfc, 0xa0, 0xd4, 0xc7, 0x14, 0x77,
+	0x99, 0xd9, 0xa7, 0x01, 0x0f, 0xe0, 0xed, 0xd0, 0x6c, 0x46, 0x3e, 0xa6, 0xf2, 0xc9, 0xe9, 0x6e,
+	0xc0, 0xba, 0x01, 0x2b, 0xb5, 0x31, 0x23, 0x43, 0xa0, 0x1b, 0xf8, 0x3d, 0x09, 0xcb, 0xdd, 0x95,
+	0x76, 0x47, 0x48, 0x25, 0x29, 0x28, 0xd3, 0x4a, 0x27, 0xe8, 0x04, 0x52, 0x1f, 0x7e, 0x29, 0xad,
+	0xde, 0x09, 0x82, 0xce, 0x21, 0x29, 0x09, 0xa9, 0x3d, 0xd8, 0x2f, 0x79, 0x03, 0x8a, 0xb9, 0x1f,
+	0x44, 0x01, 0x8d, 0xab, 0x76, 0xee, 0x77, 0x09, 0xe3, 0xb8, 0xdb, 0x57, 0x0e, 0x79, 0x51, 0x0c,
+	0xc1, 0xb4, 0x37, 0xe4, 0xc3, 0x38, 0xc5, 0x9c, 0x74, 0x4e, 0xa4, 0xc7, 0xda, 0xaf, 0x1a, 0x48,
+	0x36, 0x45, 0x6d, 0x70, 0x15, 0x24, 0xb1, 0xcb, 0xfd, 0x23, 0x92, 0xd5, 0xf2, 0x5a, 0x61, 0x0e,
+	0x29, 0x09, 0x7e, 0x09, 0x66, 0xfb, 0x84, 0xfa, 0x81, 0xc7, 0xb2, 0xf1, 0x7c, 0xbc, 0x90, 0x2a,
+	0xdf, 0x33, 0xaf, 0xad, 0xdf, 0x6c, 0x0a, 0xaf, 0x6a, 0xe2, 0xf4, 0xdc, 0x88, 0xa1, 0x08, 0x03,
+	0xf7, 0xc1, 0x6d, 0xbf, 0xb7, 0x4f, 0x31, 0xe3, 0x74, 0xe0, 0xf2, 0x01, 0x25, 0x8e, 0xec, 0x65,
+	0x36, 0x91, 0xd7, 0x0a, 0xa9, 0xf2, 0x27, 0x53, 0x82, 0x35, 0x26, 0x30, 0x92, 0xa2, 0x0a, 0xbd,
+	0xe2, 0x5f, 0x63, 0x5b, 0xfb, 0x65, 0x06, 0xac, 0x5c, 0x07, 0x82, 0x04, 0xac, 0x5e, 0x25, 0xa0,
+	0xca, 0xd1, 0x6e, 0x52, 0xce, 0x52, 0x98, 0xf3, 0xe7, 0xb7, 0xc6, 0xac, 0x94, 0x19, 0xba, 0x52,
+	0x8e, 0x52, 0xc3, 0xa7, 0x20, 0xed, 0x06, 0x94, 0x38, 0x94, 0x1c, 0x63, 0xea, 0xb1, 0xec, 0x8c,
+	0x08, 0x7e, 0x7f, 0x4a, 0xf0, 0x5a, 0x40, 0x09, 0x12, 0x9e, 0xd5, 0x65, 0x95, 0x20, 0x35, 0xd2,
+	0x31, 0x94, 0x72, 0x47, 0x02, 0x24, 0x60, 0xa9, 0x8f, 0x29, 0xef, 0x11, 0x3a, 0x8c, 0x2e, 0x27,
+	0xf1, 0xc1, 0x34, 0xea, 0xd2, 0x5b, 0x25, 0x58, 0x55, 0x09, 0x16, 0x27, 0xd4, 0x0c, 0x2d, 0xf6,
+	0x27, 0xe4, 0x8d, 0xc4, 0x8b, 0x97, 0x86, 0xb6, 0xf6, 0x7d, 0x1c, 0x80, 0x11, 0x13, 0xd8, 0x06,
+	0xb3, 0xd8, 0xf3, 0x28, 0x61, 0x4c, 0xac, 0x45, 0xba, 0xba, 0xf5, 0xd7, 0xb9, 0x51, 0xec, 0xf8,
+	0xfc, 0xd9, 0xa0, 0x6d, 0xba, 0x41, 0x57, 0xed, 0xb1, 0xfa, 0x57, 0x64, 0xde, 0x41, 0x89, 0x9f,
+	0xf4, 0x09, 0x33, 0x2b, 0xae, 0x5b, 0x91, 0xc0, 0xd7, 0xaf, 0x8a, 0xcb, 0xd2, 0x6c, 0x2a, 0x4d,
+	0xf5, 0x84, 0x13, 0x86, 0xa2, 0xc0, 0xd0, 0x06, 0xc9, 0x63, 0xe2, 0x77, 0x9e, 0xf1, 0xec, 0x4c,
+	0x5e, 0x2b, 0xcc, 0x57, 0xbf, 0x08, 0x09, 0xff, 0x76, 0x6e, 0x7c, 0x78, 0x83, 0x34, 0x75, 0xe2,
+	0xbe, 0x7e, 0x55, 0x04, 0x2a, 0x7e, 0x9d, 0xb8, 0x48, 0xc5, 0x82, 0x5b, 0x60, 0x41, 0x7e, 0x39,
+	0x2c, 0x18, 0x50, 0x97, 0x64, 0xe3, 0x79, 0xad, 0xb0, 0x58, 0x7e, 0x7f, 0x4a, 0xcf, 0xf6, 0x84,
+	0x6f, 0x4b, 0xb8, 0xa2, 0xf4, 0xf1, 0x98, 0x04, 0x3f, 0x06, 0xf3, 0x5d, 0x4c, 0x0f, 0x08, 0x77,
+	0x7c, 0x4f, 0xac, 0xed, 0x7c, 0x35, 0x7d, 0x71, 0x6e, 0xcc, 0x3d, 0x12, 0xca, 0x46, 0x1d, 0xcd,
+	0x49, 0x73, 0xc3, 0x83, 0x15, 0x90, 0xe4, 0x98, 0x76, 0x08, 0xcf, 0xde, 0x12, 0xeb, 0x3d, 0x2d,
+	0x9b, 0xec, 0xae, 0x2d, 0x5c, 0xd5, 0x5a, 0x2b, 0xa0, 0x1a, 0xc3, 0x8f, 0x33, 0x60, 0x61, 0x62,
+	0x5e, 0xff, 0xcb, 0x24, 0x1e, 0x01, 0xa8, 0x36, 0x2c, 0x7c, 0x24, 0x0e, 0x23, 0x6e, 0xd0, 0xf3,
+	0xc4, 0x54, 0x52, 0xe5, 0xbb, 0xa6, 0x82, 0x86, 0xf7, 0x6d, 0x6c, 0x91, 0xfd, 0x9e, 0x2a, 0x20,
+	0xa3, 0xa0, 0x4d, 0x42, 0x5b, 0x02, 0x38, 0xd6, 0x8d, 0xf8, 0x7f, 0xeb, 0xc6, 0x1f, 0x1a, 0x48,
+	0x8f, 0x3b, 0xc1, 0xcf, 0x41, 0x22, 0x2c, 0x4e, 0x74, 0x62, 0xb1, 0xfc, 0xd1, 0x0d, 0xe2, 0xda,
+	0x27, 0x7d, 0x82, 0x04, 0x08, 0xee, 0x80, 0xcc, 0x11, 0x61, 0xdc, 0xef, 0x75, 0x9c, 0xe8, 0xa2,
+	0x0e, 0x6b, 0x94, 0x27, 0xd5, 0x8c, 0x4e, 0xaa, 0x59, 0x57, 0x0e, 0xd5, 0xb9, 0x90, 0xd6, 0x8b,
+	0xb7, 0x86, 0x86, 0x96, 0x14, 0x38, 0x32, 0xc1, 0x3a, 0x58, 0x08, 0x6f, 0xac, 0x13, 0xdd, 0x56,
+	0xb5, 0x69, 0x86, 0x64, 0x15, 0x9a, 0x86, 0x8c, 0x5a, 0xca, 0x45, 0xb0, 0x49, 0x87, 0xa6, 0x48,
+	0xa3, 0x2a, 0x3d, 0x0b, 0x0f, 0xb2, 0x38, 0x29, 0x70, 0x03, 0xdc, 0x62, 0x1c, 0x53, 0x2e, 0x8a,
+	0x4c, 0x95, 0x73, 0xff, 0xe0, 0x66, 0x47, 0xe7, 0x5e, 0x92, 0x7b, 0x1e, 0x92, 0x93, 0x10, 0xf8,
+	0x19, 0x88, 0x93, 0xe1, 0xe4, 0x6e, 0x86, 0x0c, 0x01, 0x70, 0x1b, 0xcc, 0xfb, 0xbd, 0xfd, 0x43,
+	0xd9, 0x93, 0xb8, 0x58, 0x33, 0xf3, 0xdf, 0xbd, 0x46, 0x34, 0x0a, 0xb0, 0x91, 0xf8, 0xf3, 0xa5,
+	0xa1, 0xad, 0x7f, 0x05, 0xd2, 0xe3, 0x8f, 0x0b, 0xde, 0x01, 0xcb, 0x7b, 0x56, 0x63, 0x73, 0xcb,
+	0x76, 0x5a, 0x8f, 0x77, 0x51, 0xcd, 0x72, 0x1e, 0x36, 0xbe, 0xb6, 0xea, 0x99, 0x18, 0x7c, 0x0f,
+	0xdc, 0x99, 0x34, 0x34, 0x51, 0xa3, 0x66, 0x3d, 0xb4, 0xac, 0x7a, 0x46, 0xcb, 0x25, 0x7e, 0xf8,
+	0x49, 0x8f, 0xad, 0x7f, 0x07, 0x32, 0x57, 0x87, 0x0a, 0x75, 0x90, 0x43, 0xd6, 0x5e, 0x05, 0xd5,
+	0x1d, 0xbb, 0x82, 0x36, 0x2d, 0xdb, 0xb1, 0x9f, 0x36, 0x2d, 0xa7, 0x52, 0xab, 0x3d, 0xde, 0xdd,
+	0xb1, 0x33, 0xb1, 0x29, 0xf6, 0x27, 0x56, 0xcb, 0x6e, 0xec, 0x6c, 0x66, 0x34, 0x78, 0x1f, 0xdc,
+	0xbb, 0xc6, 0x6e, 0x55, 0xd0, 0x8e, 0xf3, 0xa4, 0xb2, 0xbb, 0x6d, 0x67, 0x66, 0x64, 0xf2, 0xea,
+	0xf6, 0xe9, 0x3b, 0x3d, 0xf6, 0xe6, 0x9d, 0x1e, 0x3b, 0xbd, 0xd0, 0xb5, 0xb3, 0x0b, 0x5d, 0xfb,
+	0xfd, 0x42, 0xd7, 0x9e, 0x5f, 0xea, 0xb1, 0xb3, 0x4b, 0x3d, 0xf6, 0xe6, 0x52, 0x8f, 0x7d, 0xb3,
+	0x3e, 0xd6, 0xa3, 0x70, 0xf8, 0xc5, 0x43, 0xdc, 0x66, 0xe2, 0xab, 0xf4, 0xed, 0xe8, 0x47, 0x85,
+	0xe8, 0x55, 0x3b, 0x29, 0xa6, 0xf1, 0xe9, 0xdf, 0x03, 0x00, 0x20, 0x93, 0x91, 0x9f, 0x72, 0x08,
+	0x00, 0x00,
 }
 
 func (this *Period) Equal(that interface{}) bool {
@@ -459,6 +596,28 @@ func (m *CoreReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Target.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.MarketID) > 0 {
+		i -= len(m.MarketID)
+		copy(dAtA[i:], m.MarketID)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.MarketID)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.WeightSource != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.WeightSource))
+		i--
+		dAtA[i] = 0x18
+	}
 	{
 		size := m.Weight.Size()
 		i -= size
@@ -499,6 +658,16 @@ func (m *PartnerReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Target.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size, err := m.RewardsPerSecond.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -519,6 +688,47 @@ func (m *PartnerReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RewardTarget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardTarget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardTarget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EarnStrategy != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.EarnStrategy))
+		i--
+		dAtA[i] = 0x18
+	}
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.VestingDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VestingDuration):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintParams(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x12
+	if m.Type != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Period) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -549,20 +759,20 @@ func (m *Period) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x1a
-	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.End, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.End):])
-	if err3 != nil {
-		return 0, err3
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.End, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.End):])
+	if err6 != nil {
+		return 0, err6
 	}
-	i -= n3
-	i = encodeVarintParams(dAtA, i, uint64(n3))
+	i -= n6
+	i = encodeVarintParams(dAtA, i, uint64(n6))
 	i--
 	dAtA[i] = 0x12
-	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Start, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Start):])
-	if err4 != nil {
-		return 0, err4
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Start, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Start):])
+	if err7 != nil {
+		return 0, err7
 	}
-	i -= n4
-	i = encodeVarintParams(dAtA, i, uint64(n4))
+	i -= n7
+	i = encodeVarintParams(dAtA, i, uint64(n7))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -638,6 +848,15 @@ func (m *CoreReward) Size() (n int) {
 	}
 	l = m.Weight.Size()
 	n += 1 + l + sovParams(uint64(l))
+	if m.WeightSource != 0 {
+		n += 1 + sovParams(uint64(m.WeightSource))
+	}
+	l = len(m.MarketID)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.Target.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -653,6 +872,25 @@ func (m *PartnerReward) Size() (n int) {
 	}
 	l = m.RewardsPerSecond.Size()
 	n += 1 + l + sovParams(uint64(l))
+	l = m.Target.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *RewardTarget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovParams(uint64(m.Type))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VestingDuration)
+	n += 1 + l + sovParams(uint64(l))
+	if m.EarnStrategy != 0 {
+		n += 1 + sovParams(uint64(m.EarnStrategy))
+	}
 	return n
 }
 
@@ -1063,6 +1301,90 @@ func (m *CoreReward) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightSource", wireType)
+			}
+			m.WeightSource = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WeightSource |= WeightSource(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Target.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -1180,6 +1502,160 @@ func (m *PartnerReward) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Target.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardTarget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardTarget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardTarget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= RewardTargetType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.VestingDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarnStrategy", wireType)
+			}
+			m.EarnStrategy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EarnStrategy |= types1.StrategyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -8,6 +8,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	earntypes "github.com/kava-labs/kava/x/earn/types"
 	"github.com/kava-labs/kava/x/kavadist/types"
 )
 
@@ -96,6 +97,56 @@ func (suite *ParamTestSuite) TestParamValidation() {
 	}
 }
 
+func (suite *ParamTestSuite) TestInfraParamsValidation() {
+	addr := sdk.AccAddress("test_address________")
+
+	pricefeedReward := types.NewCoreReward(addr, sdk.OneDec())
+	pricefeedReward.WeightSource = types.WEIGHT_SOURCE_PRICEFEED
+	pricefeedReward.MarketID = "uptime:score"
+
+	missingMarketReward := pricefeedReward
+	missingMarketReward.MarketID = ""
+
+	vestingReward := types.NewCoreReward(addr, sdk.OneDec())
+	vestingReward.Target = types.NewRewardTarget(types.REWARD_TARGET_TYPE_VESTING, time.Hour, earntypes.STRATEGY_TYPE_UNSPECIFIED)
+
+	zeroVestingReward := vestingReward
+	zeroVestingReward.Target.VestingDuration = 0
+
+	earnPartnerReward := types.NewPartnerReward(addr, sdk.NewInt64Coin("ukava", 1))
+	earnPartnerReward.Target = types.NewRewardTarget(types.REWARD_TARGET_TYPE_EARN_VAULT, 0, earntypes.STRATEGY_TYPE_SAVINGS)
+
+	unspecifiedEarnPartnerReward := earnPartnerReward
+	unspecifiedEarnPartnerReward.Target.EarnStrategy = earntypes.STRATEGY_TYPE_UNSPECIFIED
+
+	testCases := []struct {
+		name           string
+		coreRewards    types.CoreRewards
+		partnerRewards types.PartnerRewards
+		expectPass     bool
+	}{
+		{"account targets", types.CoreRewards{types.NewCoreReward(addr, sdk.OneDec())}, nil, true},
+		{"pricefeed weight source", types.CoreRewards{pricefeedReward}, nil, true},
+		{"pricefeed weight source without market", types.CoreRewards{missingMarketReward}, nil, false},
+		{"vesting target", types.CoreRewards{vestingReward}, nil, true},
+		{"vesting target without duration", types.CoreRewards{zeroVestingReward}, nil, false},
+		{"earn vault target", nil, types.PartnerRewards{earnPartnerReward}, true},
+		{"earn vault target without strategy", nil, types.PartnerRewards{unspecifiedEarnPartnerReward}, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			params := types.NewParams(true, types.DefaultPeriods, types.NewInfraParams(nil, tc.partnerRewards, tc.coreRewards))
+			err := params.Validate()
+			if tc.expectPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func TestParamsTestSuite(t *testing.T) {
 	suite.Run(t, new(ParamTestSuite))
 }
@@ -3,7 +3,9 @@ package types
 import (
 	"fmt"
 	"strings"
+	"time"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govcodec "github.com/cosmos/cosmos-sdk/x/gov/codec"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
@@ -12,14 +14,26 @@ import (
 const (
 	// ProposalTypeCommunityPoolMultiSpend defines the type for a CommunityPoolMultiSpendProposal
 	ProposalTypeCommunityPoolMultiSpend = "CommunityPoolMultiSpend"
+	// ProposalTypeCommunityPoolPaymentStream defines the type for a CommunityPoolPaymentStreamProposal
+	ProposalTypeCommunityPoolPaymentStream = "CommunityPoolPaymentStream"
+	// ProposalTypeCommunityPoolCancelPaymentStream defines the type for a CommunityPoolCancelPaymentStreamProposal
+	ProposalTypeCommunityPoolCancelPaymentStream = "CommunityPoolCancelPaymentStream"
 )
 
-// Assert CommunityPoolMultiSpendProposal implements govtypes.Content at compile-time
-var _ govv1beta1.Content = CommunityPoolMultiSpendProposal{}
+// Assert proposals implement govtypes.Content at compile-time
+var (
+	_ govv1beta1.Content = CommunityPoolMultiSpendProposal{}
+	_ govv1beta1.Content = CommunityPoolPaymentStreamProposal{}
+	_ govv1beta1.Content = CommunityPoolCancelPaymentStreamProposal{}
+)
 
 func init() {
 	govv1beta1.RegisterProposalType(ProposalTypeCommunityPoolMultiSpend)
 	govcodec.ModuleCdc.Amino.RegisterConcrete(CommunityPoolMultiSpendProposal{}, "kava/CommunityPoolMultiSpendProposal", nil)
+	govv1beta1.RegisterProposalType(ProposalTypeCommunityPoolPaymentStream)
+	govcodec.ModuleCdc.Amino.RegisterConcrete(CommunityPoolPaymentStreamProposal{}, "kava/CommunityPoolPaymentStreamProposal", nil)
+	govv1beta1.RegisterProposalType(ProposalTypeCommunityPoolCancelPaymentStream)
+	govcodec.ModuleCdc.Amino.RegisterConcrete(CommunityPoolCancelPaymentStreamProposal{}, "kava/CommunityPoolCancelPaymentStreamProposal", nil)
 }
 
 // NewCommunityPoolMultiSpendProposal creates a new community pool multi-spend proposal.
@@ -104,3 +118,99 @@ func (msr MultiSpendRecipient) GetAddress() sdk.AccAddress {
 
 	return addr
 }
+
+// NewCommunityPoolPaymentStreamProposal creates a new community pool payment stream proposal.
+func NewCommunityPoolPaymentStreamProposal(
+	title, description, recipient string, amount sdk.Coins, startTime, endTime, cliffTime time.Time,
+) *CommunityPoolPaymentStreamProposal {
+	return &CommunityPoolPaymentStreamProposal{
+		Title:       title,
+		Description: description,
+		Recipient:   recipient,
+		Amount:      amount,
+		StartTime:   startTime,
+		EndTime:     endTime,
+		CliffTime:   cliffTime,
+	}
+}
+
+// GetTitle returns the title of a community pool payment stream proposal.
+func (p CommunityPoolPaymentStreamProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a community pool payment stream proposal.
+func (p CommunityPoolPaymentStreamProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a community pool payment stream proposal.
+func (p CommunityPoolPaymentStreamProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a community pool payment stream proposal.
+func (p CommunityPoolPaymentStreamProposal) ProposalType() string {
+	return ProposalTypeCommunityPoolPaymentStream
+}
+
+// ValidateBasic stateless validation of a community pool payment stream proposal.
+func (p CommunityPoolPaymentStreamProposal) ValidateBasic() error {
+	if err := govv1beta1.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if p.Recipient == "" {
+		return ErrEmptyProposalRecipient
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Recipient); err != nil {
+		return err
+	}
+	if err := validateStreamTerms(p.Amount, p.StartTime, p.EndTime, p.CliffTime); err != nil {
+		return errorsmod.Wrap(ErrInvalidPaymentStream, err.Error())
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (p CommunityPoolPaymentStreamProposal) String() string {
+	return fmt.Sprintf(`Community Pool Payment Stream Proposal:
+  Title:       %s
+  Description: %s
+  Recipient:   %s
+  Amount:      %s
+  Start Time:  %s
+  End Time:    %s
+  Cliff Time:  %s
+`, p.Title, p.Description, p.Recipient, p.Amount, p.StartTime, p.EndTime, p.CliffTime)
+}
+
+// NewCommunityPoolCancelPaymentStreamProposal creates a new community pool cancel payment stream proposal.
+func NewCommunityPoolCancelPaymentStreamProposal(title, description string, streamID uint64) *CommunityPoolCancelPaymentStreamProposal {
+	return &CommunityPoolCancelPaymentStreamProposal{
+		Title:       title,
+		Description: description,
+		StreamID:    streamID,
+	}
+}
+
+// GetTitle returns the title of a community pool cancel payment stream proposal.
+func (p CommunityPoolCancelPaymentStreamProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a community pool cancel payment stream proposal.
+func (p CommunityPoolCancelPaymentStreamProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a community pool cancel payment stream proposal.
+func (p CommunityPoolCancelPaymentStreamProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a community pool cancel payment stream proposal.
+func (p CommunityPoolCancelPaymentStreamProposal) ProposalType() string {
+	return ProposalTypeCommunityPoolCancelPaymentStream
+}
+
+// ValidateBasic stateless validation of a community pool cancel payment stream proposal.
+func (p CommunityPoolCancelPaymentStreamProposal) ValidateBasic() error {
+	return govv1beta1.ValidateAbstract(p)
+}
+
+// String implements fmt.Stringer
+func (p CommunityPoolCancelPaymentStreamProposal) String() string {
+	return fmt.Sprintf(`Community Pool Cancel Payment Stream Proposal:
+  Title:       %s
+  Description: %s
+  Stream ID:   %d
+`, p.Title, p.Description, p.StreamID)
+}
@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -195,42 +196,248 @@ func (m *QueryBalanceResponse) GetCoins() github_com_cosmos_cosmos_sdk_types.Coi
 	return nil
 }
 
+// QueryPaymentStreamsRequest defines the request type for querying x/kavadist payment streams.
+type QueryPaymentStreamsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPaymentStreamsRequest) Reset()         { *m = QueryPaymentStreamsRequest{} }
+func (m *QueryPaymentStreamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPaymentStreamsRequest) ProtoMessage()    {}
+func (*QueryPaymentStreamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_08142b3a0a4f2f78, []int{4}
+}
+func (m *QueryPaymentStreamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPaymentStreamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPaymentStreamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPaymentStreamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPaymentStreamsRequest.Merge(m, src)
+}
+func (m *QueryPaymentStreamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPaymentStreamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPaymentStreamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPaymentStreamsRequest proto.InternalMessageInfo
+
+func (m *QueryPaymentStreamsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPaymentStreamsResponse defines the response type for querying x/kavadist payment streams.
+type QueryPaymentStreamsResponse struct {
+	PaymentStreams PaymentStreams      `protobuf:"bytes,1,rep,name=payment_streams,json=paymentStreams,proto3,castrepeated=PaymentStreams" json:"payment_streams"`
+	Pagination     *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPaymentStreamsResponse) Reset()         { *m = QueryPaymentStreamsResponse{} }
+func (m *QueryPaymentStreamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPaymentStreamsResponse) ProtoMessage()    {}
+func (*QueryPaymentStreamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_08142b3a0a4f2f78, []int{5}
+}
+func (m *QueryPaymentStreamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPaymentStreamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPaymentStreamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPaymentStreamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPaymentStreamsResponse.Merge(m, src)
+}
+func (m *QueryPaymentStreamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPaymentStreamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPaymentStreamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPaymentStreamsResponse proto.InternalMessageInfo
+
+func (m *QueryPaymentStreamsResponse) GetPaymentStreams() PaymentStreams {
+	if m != nil {
+		return m.PaymentStreams
+	}
+	return nil
+}
+
+func (m *QueryPaymentStreamsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPaymentStreamRequest defines the request type for querying a x/kavadist payment stream.
+type QueryPaymentStreamRequest struct {
+	StreamId uint64 `protobuf:"varint,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+}
+
+func (m *QueryPaymentStreamRequest) Reset()         { *m = QueryPaymentStreamRequest{} }
+func (m *QueryPaymentStreamRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPaymentStreamRequest) ProtoMessage()    {}
+func (*QueryPaymentStreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_08142b3a0a4f2f78, []int{6}
+}
+func (m *QueryPaymentStreamRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPaymentStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPaymentStreamRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPaymentStreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPaymentStreamRequest.Merge(m, src)
+}
+func (m *QueryPaymentStreamRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPaymentStreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPaymentStreamRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPaymentStreamRequest proto.InternalMessageInfo
+
+func (m *QueryPaymentStreamRequest) GetStreamId() uint64 {
+	if m != nil {
+		return m.StreamId
+	}
+	return 0
+}
+
+// QueryPaymentStreamResponse defines the response type for querying a x/kavadist payment stream.
+type QueryPaymentStreamResponse struct {
+	PaymentStream PaymentStream `protobuf:"bytes,1,opt,name=payment_stream,json=paymentStream,proto3" json:"payment_stream"`
+}
+
+func (m *QueryPaymentStreamResponse) Reset()         { *m = QueryPaymentStreamResponse{} }
+func (m *QueryPaymentStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPaymentStreamResponse) ProtoMessage()    {}
+func (*QueryPaymentStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_08142b3a0a4f2f78, []int{7}
+}
+func (m *QueryPaymentStreamResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPaymentStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPaymentStreamResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPaymentStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPaymentStreamResponse.Merge(m, src)
+}
+func (m *QueryPaymentStreamResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPaymentStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPaymentStreamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPaymentStreamResponse proto.InternalMessageInfo
+
+func (m *QueryPaymentStreamResponse) GetPaymentStream() PaymentStream {
+	if m != nil {
+		return m.PaymentStream
+	}
+	return PaymentStream{}
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "kava.kavadist.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "kava.kavadist.v1beta1.QueryParamsResponse")
 	proto.RegisterType((*QueryBalanceRequest)(nil), "kava.kavadist.v1beta1.QueryBalanceRequest")
 	proto.RegisterType((*QueryBalanceResponse)(nil), "kava.kavadist.v1beta1.QueryBalanceResponse")
+	proto.RegisterType((*QueryPaymentStreamsRequest)(nil), "kava.kavadist.v1beta1.QueryPaymentStreamsRequest")
+	proto.RegisterType((*QueryPaymentStreamsResponse)(nil), "kava.kavadist.v1beta1.QueryPaymentStreamsResponse")
+	proto.RegisterType((*QueryPaymentStreamRequest)(nil), "kava.kavadist.v1beta1.QueryPaymentStreamRequest")
+	proto.RegisterType((*QueryPaymentStreamResponse)(nil), "kava.kavadist.v1beta1.QueryPaymentStreamResponse")
 }
 
 func init() { proto.RegisterFile("kava/kavadist/v1beta1/query.proto", fileDescriptor_08142b3a0a4f2f78) }
 
 var fileDescriptor_08142b3a0a4f2f78 = []byte{
-	// 400 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xcf, 0x6e, 0xda, 0x30,
-	0x1c, 0x4e, 0xd8, 0x60, 0x92, 0xb9, 0x79, 0x20, 0x6d, 0xd1, 0x66, 0x20, 0x93, 0x26, 0x60, 0xc2,
-	0x1e, 0xec, 0xb8, 0x5b, 0xb6, 0x07, 0xd8, 0x72, 0xec, 0xcd, 0x09, 0x56, 0x1a, 0x01, 0x71, 0x88,
-	0x0d, 0x2a, 0xd7, 0x1e, 0x7b, 0xaa, 0xd4, 0x27, 0xe8, 0xb5, 0x4f, 0xc2, 0x11, 0xa9, 0x97, 0x9e,
-	0xda, 0x0a, 0xfa, 0x20, 0x95, 0x63, 0x43, 0x8b, 0x0a, 0x88, 0x4b, 0x62, 0xfd, 0xfc, 0x7d, 0xbf,
-	0xef, 0x4f, 0x02, 0x1a, 0x03, 0x3a, 0xa5, 0x44, 0x3d, 0xfa, 0xb1, 0x90, 0x64, 0xda, 0x0d, 0x98,
-	0xa4, 0x5d, 0x32, 0x9e, 0xb0, 0x6c, 0x86, 0xd3, 0x8c, 0x4b, 0x0e, 0xab, 0xea, 0x16, 0xaf, 0x21,
-	0xd8, 0x40, 0x1c, 0x14, 0x72, 0x31, 0xe2, 0x82, 0x04, 0x54, 0xb0, 0x0d, 0x2f, 0xe4, 0x71, 0xa2,
-	0x69, 0x4e, 0x25, 0xe2, 0x11, 0xcf, 0x8f, 0x44, 0x9d, 0xcc, 0xf4, 0x4b, 0xc4, 0x79, 0x34, 0x64,
-	0x84, 0xa6, 0x31, 0xa1, 0x49, 0xc2, 0x25, 0x95, 0x31, 0x4f, 0x84, 0xb9, 0x75, 0x77, 0xbb, 0x49,
-	0x69, 0x46, 0x47, 0x06, 0xe3, 0x56, 0x00, 0xfc, 0xaf, 0xdc, 0xfd, 0xcb, 0x87, 0x3e, 0x1b, 0x4f,
-	0x98, 0x90, 0xae, 0x0f, 0x3e, 0x6e, 0x4d, 0x45, 0xca, 0x13, 0xc1, 0xe0, 0x6f, 0x50, 0xd2, 0xe4,
-	0x4f, 0x76, 0xdd, 0x6e, 0x96, 0x7b, 0x5f, 0xf1, 0xce, 0x30, 0x58, 0xd3, 0xbc, 0xf7, 0xf3, 0xfb,
-	0x9a, 0xe5, 0x1b, 0x8a, 0x5b, 0x35, 0x3b, 0x3d, 0x3a, 0xa4, 0x49, 0xc8, 0xd6, 0x52, 0x33, 0x50,
-	0xd9, 0x1e, 0x1b, 0x2d, 0x0a, 0x8a, 0x2a, 0xbe, 0x92, 0x7a, 0xd7, 0x2c, 0xf7, 0x3e, 0x63, 0x5d,
-	0x10, 0x56, 0x05, 0x6d, 0x84, 0xfe, 0xf0, 0x38, 0xf1, 0x7e, 0x2a, 0x99, 0x9b, 0x87, 0x5a, 0x33,
-	0x8a, 0xe5, 0xe9, 0x24, 0xc0, 0x21, 0x1f, 0x11, 0xd3, 0xa6, 0x7e, 0x75, 0x44, 0x7f, 0x40, 0xe4,
-	0x2c, 0x65, 0x22, 0x27, 0x08, 0x5f, 0x6f, 0xee, 0x5d, 0x17, 0x40, 0x31, 0xd7, 0x86, 0x17, 0x36,
-	0x28, 0x69, 0xd3, 0xb0, 0xb5, 0x27, 0xd3, 0xdb, 0x96, 0x9c, 0xf6, 0x31, 0x50, 0x1d, 0xc7, 0x6d,
-	0x9d, 0xdf, 0x3e, 0x5d, 0x15, 0xbe, 0xc1, 0x06, 0x39, 0xf0, 0x51, 0x98, 0x64, 0x99, 0x50, 0x66,
-	0x3e, 0x98, 0x36, 0xe0, 0x41, 0x89, 0xed, 0x26, 0x9d, 0x1f, 0x47, 0x61, 0x8d, 0x9f, 0xef, 0xb9,
-	0x9f, 0x3a, 0x44, 0x7b, 0xfc, 0x04, 0x1a, 0xef, 0xfd, 0x9d, 0x2f, 0x91, 0xbd, 0x58, 0x22, 0xfb,
-	0x71, 0x89, 0xec, 0xcb, 0x15, 0xb2, 0x16, 0x2b, 0x64, 0xdd, 0xad, 0x90, 0x75, 0xd2, 0x7e, 0x55,
-	0xb7, 0xa2, 0x77, 0x86, 0x34, 0x10, 0x7a, 0xdb, 0xd9, 0xcb, 0xbe, 0xbc, 0xf6, 0xa0, 0x94, 0xff,
-	0x6c, 0xbf, 0x9e, 0x03, 0x00, 0x00, 0xff, 0xff, 0x28, 0xdc, 0x24, 0xfa, 0x20, 0x03, 0x00, 0x00,
+	// 614 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcf, 0x6e, 0xd3, 0x30,
+	0x18, 0x6f, 0x46, 0x57, 0xc0, 0xd3, 0x8a, 0x64, 0x3a, 0xc4, 0x32, 0xc8, 0xb6, 0x80, 0x4a, 0x57,
+	0xb4, 0x78, 0x2d, 0x1c, 0x10, 0xdc, 0x0a, 0x02, 0x71, 0x63, 0xe1, 0xc6, 0x65, 0x72, 0x5a, 0x2b,
+	0x44, 0x6b, 0xe3, 0xac, 0x76, 0x27, 0x2a, 0xc4, 0x85, 0x23, 0x27, 0x24, 0x1e, 0x02, 0x89, 0x13,
+	0x8f, 0xb1, 0x0b, 0xd2, 0x24, 0x2e, 0x9c, 0x00, 0xb5, 0x3c, 0x07, 0x9a, 0xfc, 0x27, 0x6d, 0xbd,
+	0xb5, 0x5d, 0x76, 0x69, 0x13, 0xfb, 0xfb, 0x7e, 0xff, 0xe2, 0xcf, 0x60, 0x73, 0x1f, 0x1f, 0x62,
+	0x24, 0x7e, 0x5a, 0x11, 0xe3, 0xe8, 0xb0, 0x16, 0x10, 0x8e, 0x6b, 0xe8, 0xa0, 0x47, 0xba, 0x7d,
+	0x2f, 0xe9, 0x52, 0x4e, 0xe1, 0x8a, 0xd8, 0xf5, 0xd2, 0x12, 0x4f, 0x97, 0xd8, 0xd5, 0x26, 0x65,
+	0x1d, 0xca, 0x50, 0x80, 0x19, 0x51, 0xf5, 0xa3, 0xee, 0x04, 0x87, 0x51, 0x8c, 0x79, 0x44, 0x63,
+	0x05, 0x61, 0x3b, 0x93, 0xb5, 0x69, 0x55, 0x93, 0x46, 0xe9, 0x7e, 0x29, 0xa4, 0x21, 0x95, 0x8f,
+	0x48, 0x3c, 0xe9, 0xd5, 0x5b, 0x21, 0xa5, 0x61, 0x9b, 0x20, 0x9c, 0x44, 0x08, 0xc7, 0x31, 0xe5,
+	0x12, 0x92, 0xe9, 0x5d, 0x77, 0xba, 0xf2, 0x04, 0x77, 0x71, 0xe7, 0x9c, 0x1a, 0xc6, 0xbb, 0x04,
+	0x77, 0x54, 0x8d, 0x5b, 0x02, 0x70, 0x57, 0xa8, 0x7f, 0x25, 0x1b, 0x7d, 0x72, 0xd0, 0x23, 0x8c,
+	0xbb, 0x3e, 0xb8, 0x6e, 0xac, 0xb2, 0x84, 0xc6, 0x8c, 0xc0, 0x27, 0xa0, 0xa0, 0x08, 0x6e, 0x5a,
+	0x1b, 0x56, 0x65, 0xa9, 0x7e, 0xdb, 0x9b, 0x1a, 0x8e, 0xa7, 0xda, 0x1a, 0xf9, 0xa3, 0xdf, 0xeb,
+	0x39, 0x5f, 0xb7, 0xb8, 0x2b, 0x1a, 0xb3, 0x81, 0xdb, 0x38, 0x6e, 0x92, 0x94, 0xaa, 0x0f, 0x4a,
+	0xe6, 0xb2, 0xe6, 0xc2, 0x60, 0x51, 0x44, 0x24, 0xa8, 0x2e, 0x55, 0x96, 0xea, 0xab, 0x9e, 0x0a,
+	0xd1, 0x13, 0x21, 0x8e, 0x88, 0x9e, 0xd2, 0x28, 0x6e, 0xec, 0x08, 0x9a, 0x6f, 0x7f, 0xd6, 0x2b,
+	0x61, 0xc4, 0xdf, 0xf6, 0x02, 0xaf, 0x49, 0x3b, 0x48, 0x27, 0xae, 0xfe, 0xb6, 0x59, 0x6b, 0x1f,
+	0xf1, 0x7e, 0x42, 0x98, 0x6c, 0x60, 0xbe, 0x42, 0x76, 0x5b, 0xc0, 0xd6, 0x2e, 0xfb, 0x1d, 0x12,
+	0xf3, 0xd7, 0x32, 0x97, 0x34, 0x03, 0xf8, 0x1c, 0x80, 0xf1, 0x97, 0xd4, 0x86, 0xcb, 0x86, 0x0a,
+	0x75, 0x4c, 0xc6, 0xa6, 0xc3, 0xd4, 0x94, 0x3f, 0xd1, 0xe9, 0xfe, 0xb0, 0xc0, 0xda, 0x54, 0x1a,
+	0x6d, 0x94, 0x80, 0x6b, 0x89, 0xda, 0xd9, 0x53, 0x5f, 0x26, 0xb5, 0x7c, 0x77, 0x66, 0xba, 0x13,
+	0x38, 0x8d, 0x1b, 0xda, 0x7d, 0xf1, 0x14, 0x7c, 0x31, 0x31, 0xde, 0xe1, 0x0b, 0xc3, 0xce, 0x82,
+	0xb4, 0x73, 0xef, 0x5c, 0x3b, 0x4a, 0xa3, 0xe1, 0xe7, 0x11, 0x58, 0x3d, 0x6b, 0x27, 0x0d, 0x6d,
+	0x0d, 0x5c, 0x55, 0x26, 0xf6, 0xa2, 0x96, 0xcc, 0x2c, 0xef, 0x5f, 0x51, 0x0b, 0x2f, 0x5b, 0x2e,
+	0x9d, 0x96, 0xf7, 0x28, 0x87, 0x5d, 0x50, 0x34, 0x73, 0xd0, 0x99, 0x67, 0x8b, 0x41, 0x9d, 0xb5,
+	0x65, 0xc3, 0x74, 0xfd, 0x7f, 0x1e, 0x2c, 0x4a, 0x46, 0xf8, 0xc9, 0x02, 0x05, 0x75, 0x2a, 0xe1,
+	0xd6, 0x0c, 0xbc, 0xb3, 0x63, 0x60, 0x57, 0xb3, 0x94, 0x2a, 0xf9, 0xee, 0xd6, 0xc7, 0x9f, 0xff,
+	0xbe, 0x2c, 0xdc, 0x81, 0x9b, 0x68, 0xce, 0x64, 0x12, 0x4e, 0xba, 0x4c, 0x88, 0xb9, 0xac, 0x8f,
+	0x3b, 0x9c, 0x4b, 0x61, 0x8e, 0x8a, 0x7d, 0x3f, 0x53, 0xad, 0xd6, 0x53, 0x96, 0x7a, 0x36, 0xa0,
+	0x33, 0x43, 0x4f, 0xa0, 0x05, 0x7c, 0xb5, 0xc0, 0xa9, 0xa3, 0x03, 0x6b, 0xf3, 0x6d, 0x4f, 0x19,
+	0x16, 0xbb, 0x7e, 0x91, 0x16, 0xad, 0xd0, 0x93, 0x0a, 0x2b, 0xb0, 0x3c, 0x33, 0x31, 0x63, 0x2a,
+	0xe0, 0x77, 0x0b, 0x2c, 0x1b, 0x50, 0x70, 0x27, 0x33, 0x6b, 0xaa, 0xb3, 0x76, 0x81, 0x0e, 0x2d,
+	0xf3, 0xb1, 0x94, 0xf9, 0x10, 0xd6, 0xb3, 0xc9, 0x44, 0xef, 0x47, 0x03, 0xf0, 0xa1, 0xf1, 0xec,
+	0x68, 0xe0, 0x58, 0xc7, 0x03, 0xc7, 0xfa, 0x3b, 0x70, 0xac, 0xcf, 0x43, 0x27, 0x77, 0x3c, 0x74,
+	0x72, 0xbf, 0x86, 0x4e, 0xee, 0x4d, 0x75, 0xe2, 0xb2, 0x12, 0x90, 0xdb, 0x6d, 0x1c, 0x30, 0xc5,
+	0xf0, 0x6e, 0xcc, 0x21, 0x2f, 0xad, 0xa0, 0x20, 0xaf, 0xea, 0x07, 0x27, 0x03, 0x00, 0x9e, 0x45,
+	0xad, 0xa0, 0xae, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -249,6 +456,10 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// Balance queries the balance of all coins of x/kavadist module.
 	Balance(ctx context.Context, in *QueryBalanceRequest, opts ...grpc.CallOption) (*QueryBalanceResponse, error)
+	// PaymentStreams queries all community pool payment streams.
+	PaymentStreams(ctx context.Context, in *QueryPaymentStreamsRequest, opts ...grpc.CallOption) (*QueryPaymentStreamsResponse, error)
+	// PaymentStream queries a community pool payment stream by id.
+	PaymentStream(ctx context.Context, in *QueryPaymentStreamRequest, opts ...grpc.CallOption) (*QueryPaymentStreamResponse, error)
 }
 
 type queryClient struct {
@@ -277,12 +488,34 @@ func (c *queryClient) Balance(ctx context.Context, in *QueryBalanceRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) PaymentStreams(ctx context.Context, in *QueryPaymentStreamsRequest, opts ...grpc.CallOption) (*QueryPaymentStreamsResponse, error) {
+	out := new(QueryPaymentStreamsResponse)
+	err := c.cc.Invoke(ctx, "/kava.kavadist.v1beta1.Query/PaymentStreams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PaymentStream(ctx context.Context, in *QueryPaymentStreamRequest, opts ...grpc.CallOption) (*QueryPaymentStreamResponse, error) {
+	out := new(QueryPaymentStreamResponse)
+	err := c.cc.Invoke(ctx, "/kava.kavadist.v1beta1.Query/PaymentStream", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of x/kavadist module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// Balance queries the balance of all coins of x/kavadist module.
 	Balance(context.Context, *QueryBalanceRequest) (*QueryBalanceResponse, error)
+	// PaymentStreams queries all community pool payment streams.
+	PaymentStreams(context.Context, *QueryPaymentStreamsRequest) (*QueryPaymentStreamsResponse, error)
+	// PaymentStream queries a community pool payment stream by id.
+	PaymentStream(context.Context, *QueryPaymentStreamRequest) (*QueryPaymentStreamResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -295,6 +528,12 @@ func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsReq
 func (*UnimplementedQueryServer) Balance(ctx context.Context, req *QueryBalanceRequest) (*QueryBalanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Balance not implemented")
 }
+func (*UnimplementedQueryServer) PaymentStreams(ctx context.Context, req *QueryPaymentStreamsRequest) (*QueryPaymentStreamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentStreams not implemented")
+}
+func (*UnimplementedQueryServer) PaymentStream(ctx context.Context, req *QueryPaymentStreamRequest) (*QueryPaymentStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentStream not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -336,6 +575,42 @@ func _Query_Balance_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PaymentStreams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPaymentStreamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PaymentStreams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.kavadist.v1beta1.Query/PaymentStreams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PaymentStreams(ctx, req.(*QueryPaymentStreamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PaymentStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPaymentStreamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PaymentStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.kavadist.v1beta1.Query/PaymentStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PaymentStream(ctx, req.(*QueryPaymentStreamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kava.kavadist.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -348,6 +623,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Balance",
 			Handler:    _Query_Balance_Handler,
 		},
+		{
+			MethodName: "PaymentStreams",
+			Handler:    _Query_PaymentStreams_Handler,
+		},
+		{
+			MethodName: "PaymentStream",
+			Handler:    _Query_PaymentStream_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kava/kavadist/v1beta1/query.proto",
@@ -469,76 +752,276 @@ func (m *QueryBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryPaymentStreamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryPaymentStreamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPaymentStreamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBalanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPaymentStreamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryBalanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPaymentStreamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymentStreamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.PaymentStreams) > 0 {
+		for iNdEx := len(m.PaymentStreams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PaymentStreams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryPaymentStreamRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryPaymentStreamRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+
+func (m *QueryPaymentStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPaymentStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymentStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymentStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PaymentStream.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPaymentStreamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPaymentStreamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PaymentStreams) > 0 {
+		for _, e := range m.PaymentStreams {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPaymentStreamRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		n += 1 + sovQuery(uint64(m.StreamId))
+	}
+	return n
+}
+
+func (m *QueryPaymentStreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PaymentStream.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
@@ -797,6 +1280,364 @@ func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryPaymentStreamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPaymentStreamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPaymentStreamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPaymentStreamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPaymentStreamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPaymentStreamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentStreams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaymentStreams = append(m.PaymentStreams, PaymentStream{})
+			if err := m.PaymentStreams[len(m.PaymentStreams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPaymentStreamRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPaymentStreamRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPaymentStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
+			}
+			m.StreamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPaymentStreamResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPaymentStreamResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPaymentStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentStream", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PaymentStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
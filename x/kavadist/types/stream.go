@@ -0,0 +1,117 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PaymentStreamPayoutInterval is the time between payouts of a payment stream. Streams are paid in batches rather
+// than every block to bound the work done each block.
+const PaymentStreamPayoutInterval = time.Hour
+
+// NewPaymentStream returns a new payment stream with nothing paid, that is first paid at the cliff time
+func NewPaymentStream(id uint64, recipient sdk.AccAddress, amount sdk.Coins, startTime, endTime, cliffTime time.Time) PaymentStream {
+	return PaymentStream{
+		ID:             id,
+		Recipient:      recipient,
+		Amount:         amount,
+		StartTime:      startTime,
+		EndTime:        endTime,
+		CliffTime:      cliffTime,
+		NextPayoutTime: cliffTime,
+	}
+}
+
+// Validate performs a stateless validation of the payment stream
+func (ps PaymentStream) Validate() error {
+	if ps.Recipient.Empty() {
+		return errors.New("payment stream recipient cannot be empty")
+	}
+	if err := validateStreamTerms(ps.Amount, ps.StartTime, ps.EndTime, ps.CliffTime); err != nil {
+		return err
+	}
+	if !ps.Paid.IsValid() {
+		return fmt.Errorf("invalid payment stream paid amount: %s", ps.Paid)
+	}
+	if !ps.Amount.IsAllGTE(ps.Paid) {
+		return fmt.Errorf("payment stream paid amount %s exceeds total amount %s", ps.Paid, ps.Amount)
+	}
+	return nil
+}
+
+// GetVested returns the amount of the stream the recipient is entitled to at the given time, including any amount
+// that has already been paid
+func (ps PaymentStream) GetVested(blockTime time.Time) sdk.Coins {
+	if blockTime.Before(ps.CliffTime) {
+		return sdk.NewCoins()
+	}
+	if !blockTime.Before(ps.EndTime) {
+		return ps.Amount
+	}
+
+	elapsed := sdk.NewDec(blockTime.Unix() - ps.StartTime.Unix())
+	duration := sdk.NewDec(ps.EndTime.Unix() - ps.StartTime.Unix())
+
+	vested := sdk.NewCoins()
+	for _, coin := range ps.Amount {
+		amount := sdk.NewDecFromInt(coin.Amount).Mul(elapsed).Quo(duration).TruncateInt()
+		vested = vested.Add(sdk.NewCoin(coin.Denom, amount))
+	}
+	return vested
+}
+
+// GetOwed returns the amount of the stream that has vested but has not been paid
+func (ps PaymentStream) GetOwed(blockTime time.Time) sdk.Coins {
+	owed, _ := ps.GetVested(blockTime).SafeSub(ps.Paid...)
+	return owed
+}
+
+// GetNextPayoutTime returns the time the stream is paid at after a payout at the given time. The last payout is made
+// at the end time.
+func (ps PaymentStream) GetNextPayoutTime(blockTime time.Time) time.Time {
+	next := blockTime.Add(PaymentStreamPayoutInterval)
+	if blockTime.Before(ps.EndTime) && next.After(ps.EndTime) {
+		return ps.EndTime
+	}
+	return next
+}
+
+// IsComplete returns true if the full amount of the stream has been paid
+func (ps PaymentStream) IsComplete() bool {
+	return ps.Paid.IsAllGTE(ps.Amount)
+}
+
+// PaymentStreams is a slice of PaymentStream
+type PaymentStreams []PaymentStream
+
+// Validate performs a stateless validation of the payment streams
+func (pss PaymentStreams) Validate() error {
+	ids := make(map[uint64]bool, len(pss))
+	for _, ps := range pss {
+		if ids[ps.ID] {
+			return fmt.Errorf("duplicate payment stream id %d", ps.ID)
+		}
+		ids[ps.ID] = true
+
+		if err := ps.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateStreamTerms(amount sdk.Coins, startTime, endTime, cliffTime time.Time) error {
+	if amount.Empty() || !amount.IsValid() {
+		return fmt.Errorf("invalid payment stream amount: %s", amount)
+	}
+	if !startTime.Before(endTime) {
+		return fmt.Errorf("payment stream start time %s must be before end time %s", startTime, endTime)
+	}
+	if cliffTime.Before(startTime) || cliffTime.After(endTime) {
+		return fmt.Errorf("payment stream cliff time %s must be between start time %s and end time %s", cliffTime, startTime, endTime)
+	}
+	return nil
+}
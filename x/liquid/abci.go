@@ -0,0 +1,38 @@
+package liquid
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/liquid/keeper"
+	"github.com/kava-labs/kava/x/liquid/types"
+)
+
+// BeginBlocker compounds the staking rewards of the basket liquid staking token once per compound interval.
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+
+	interval := k.GetParams(ctx).BasketCompoundInterval
+	if ctx.BlockTime().Before(k.GetLastBasketCompoundTime(ctx).Add(interval)) {
+		return
+	}
+	k.SetLastBasketCompoundTime(ctx, ctx.BlockTime())
+
+	// A failure to compound should not halt the chain, so discard any partial
+	// state changes and try again next interval.
+	cacheCtx, writeCache := ctx.CacheContext()
+	if _, err := k.CompoundBasketRewards(cacheCtx); err != nil {
+		k.Logger(ctx).Error("failed to compound basket rewards", "error", err)
+		return
+	}
+	writeCache()
+}
+
+// EndBlocker removes the unbonding records of unbondings the staking module completed this block.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	k.CompleteUnbondingRecords(ctx)
+}
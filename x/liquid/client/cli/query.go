@@ -1,10 +1,16 @@
 package cli
 
 import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
 
 	"github.com/kava-labs/kava/x/liquid/types"
 )
@@ -19,7 +25,14 @@ func GetQueryCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	cmds := []*cobra.Command{}
+	cmds := []*cobra.Command{
+		queryParamsCmd(),
+		queryBasketExchangeRateCmd(),
+		queryDerivativeExchangeRateCmd(),
+		querySlashEventsCmd(),
+		queryUnbondingRecordsCmd(),
+		queryUnbondingQueueCmd(),
+	}
 
 	for _, cmd := range cmds {
 		flags.AddQueryFlagsToCmd(cmd)
@@ -29,3 +42,208 @@ func GetQueryCmd() *cobra.Command {
 
 	return liquidQueryCmd
 }
+
+func queryParamsCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Short: "Query the current liquid module parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+}
+
+func queryBasketExchangeRateCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "basket-exchange-rate",
+		Short: "Query the value of the basket liquid staking token in staking tokens",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BasketExchangeRate(context.Background(), &types.QueryBasketExchangeRateRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+func queryDerivativeExchangeRateCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "derivative-exchange-rate [denom] [height]",
+		Short: "Query the value of a derivative in staking tokens, optionally at a past height",
+		Example: fmt.Sprintf(
+			`$ %[1]s q %[2]s derivative-exchange-rate bkava-kavavaloper16lnfpgn6llvn4fstg5nfrljj6aaxyee9z59jqd
+$ %[1]s q %[2]s derivative-exchange-rate bkava-kavavaloper16lnfpgn6llvn4fstg5nfrljj6aaxyee9z59jqd 1000`,
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var height int64
+			if len(args) > 1 {
+				height, err = strconv.ParseInt(args[1], 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid height: %w", err)
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DerivativeExchangeRate(context.Background(), &types.QueryDerivativeExchangeRateRequest{
+				Denom:  args[0],
+				Height: height,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+func querySlashEventsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "slash-events [denom]",
+		Short: "Query the recorded slashes of a derivative",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SlashEvents(context.Background(), &types.QuerySlashEventsRequest{
+				Denom:      args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "slash events")
+
+	return cmd
+}
+
+func queryUnbondingRecordsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unbonding-records [delegator]",
+		Short: "Query the pending unbondings a delegator started by undelegating derivatives",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.UnbondingRecords(context.Background(), &types.QueryUnbondingRecordsRequest{
+				Delegator:  args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "unbonding records")
+
+	return cmd
+}
+
+func queryUnbondingQueueCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unbonding-queue [end-time]",
+		Short: "Query the pending derivative unbondings in order of completion time",
+		Long: fmt.Sprintf(`Query the pending derivative unbondings in order of completion time.
+An optional RFC3339 end time limits the results to unbondings that complete at or before it.
+
+Example:
+$ %s query %s unbonding-queue 2024-01-01T00:00:00Z
+`, version.AppName, types.ModuleName),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var endTime *time.Time
+			if len(args) > 0 {
+				t, err := time.Parse(time.RFC3339, args[0])
+				if err != nil {
+					return fmt.Errorf("invalid end time: %w", err)
+				}
+				endTime = &t
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.UnbondingQueue(context.Background(), &types.QueryUnbondingQueueRequest{
+				EndTime:    endTime,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "unbonding records")
+
+	return cmd
+}
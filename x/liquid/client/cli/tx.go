@@ -29,6 +29,11 @@ func GetTxCmd() *cobra.Command {
 	cmds := []*cobra.Command{
 		getCmdMintDerivative(),
 		getCmdBurnDerivative(),
+		getCmdRedelegateDerivative(),
+		getCmdUndelegateDerivative(),
+		getCmdMintBasket(),
+		getCmdBurnBasket(),
+		getCmdConvertToBasket(),
 	}
 
 	for _, cmd := range cmds {
@@ -107,3 +112,158 @@ func getCmdBurnDerivative() *cobra.Command {
 		},
 	}
 }
+
+func getCmdRedelegateDerivative() *cobra.Command {
+	return &cobra.Command{
+		Use:   "redelegate [amount] [dst-validator-addr]",
+		Short: "redelegates staking derivative to another validator",
+		Long:  "Redelegate moves the delegation behind some staking derivative to another validator, and swaps the user's staking derivative for the destination validator's staking derivative.",
+		Example: fmt.Sprintf(
+			`%s tx %s redelegate 10000000bkava-kavavaloper16lnfpgn6llvn4fstg5nfrljj6aaxyee9z59jqd kavavaloper1ypjp0m04pyp73hwgtc0dgkx0e9rrydeckewa42 --from <key>`, version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(args[1])
+			if err != nil {
+				return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+			}
+
+			msg := types.NewMsgRedelegateDerivative(clientCtx.GetFromAddress(), valAddr, amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+func getCmdUndelegateDerivative() *cobra.Command {
+	return &cobra.Command{
+		Use:   "undelegate [amount]",
+		Short: "burns staking derivative and unbonds its delegation",
+		Long:  "Undelegate removes some staking derivative from a user's account and starts unbonding the delegation behind it. The unbonding can be queried by its unbonding record until it completes.",
+		Example: fmt.Sprintf(
+			`%s tx %s undelegate 10000000bkava-kavavaloper16lnfpgn6llvn4fstg5nfrljj6aaxyee9z59jqd --from <key>`, version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := types.ParseLiquidStakingTokenDenom(amount.Denom)
+			if err != nil {
+				return errorsmod.Wrap(types.ErrInvalidDenom, err.Error())
+			}
+
+			msg := types.NewMsgUndelegateDerivative(clientCtx.GetFromAddress(), valAddr, amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+func getCmdMintBasket() *cobra.Command {
+	return &cobra.Command{
+		Use:   "mint-basket [amount]",
+		Short: "delegates staking tokens across the basket validators to mint basket liquid staking tokens",
+		Long:  "Mint basket delegates some staking tokens from a user's account across the basket validators and issues them basket liquid staking tokens.",
+		Example: fmt.Sprintf(
+			`%s tx %s mint-basket 10000000ukava --from <key>`, version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgMintBasket(clientCtx.GetFromAddress(), amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+func getCmdBurnBasket() *cobra.Command {
+	return &cobra.Command{
+		Use:   "burn-basket [amount]",
+		Short: "burns basket liquid staking tokens to redeem delegations",
+		Long:  "Burn basket removes some basket liquid staking tokens from a user's account and converts them to the user's share of each basket delegation.",
+		Example: fmt.Sprintf(
+			`%s tx %s burn-basket 10000000stkava --from <key>`, version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBurnBasket(clientCtx.GetFromAddress(), amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+func getCmdConvertToBasket() *cobra.Command {
+	return &cobra.Command{
+		Use:   "convert-to-basket [amount]",
+		Short: "converts staking derivatives into basket liquid staking tokens",
+		Long:  "Convert to basket removes some staking derivative from a user's account, moves the underlying delegation into the basket, and issues them basket liquid staking tokens.",
+		Example: fmt.Sprintf(
+			`%s tx %s convert-to-basket 10000000bkava-kavavaloper16lnfpgn6llvn4fstg5nfrljj6aaxyee9z59jqd --from <key>`, version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgConvertToBasket(clientCtx.GetFromAddress(), amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
@@ -0,0 +1,42 @@
+package liquid
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/liquid/keeper"
+	"github.com/kava-labs/kava/x/liquid/types"
+)
+
+// InitGenesis initializes the store state from a genesis state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs types.GenesisState) {
+	k.SetParams(ctx, gs.Params)
+
+	for _, event := range gs.SlashEvents {
+		k.SetSlashEvent(ctx, event)
+	}
+
+	for _, record := range gs.UnbondingRecords {
+		k.SetUnbondingRecord(ctx, record)
+	}
+
+	// genesis states from before unbonding records were added have no next id
+	if gs.NextUnbondingRecordID != 0 {
+		k.SetNextUnbondingRecordID(ctx, gs.NextUnbondingRecordID)
+	}
+
+	// a zero time compounds the basket rewards in the first block
+	if !gs.LastBasketCompoundTime.IsZero() {
+		k.SetLastBasketCompoundTime(ctx, gs.LastBasketCompoundTime)
+	}
+}
+
+// ExportGenesis exports the store to a genesis state
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
+	return types.NewGenesisState(
+		k.GetParams(ctx),
+		k.GetAllSlashEvents(ctx),
+		k.GetAllUnbondingRecords(ctx),
+		k.GetNextUnbondingRecordID(ctx),
+		k.GetLastBasketCompoundTime(ctx),
+	)
+}
@@ -0,0 +1,402 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/kava-labs/kava/x/liquid/types"
+)
+
+// MintBasket delegates a user's staking tokens across the basket validators and mints them basket liquid staking
+// tokens of equal value at the current exchange rate.
+func (k Keeper) MintBasket(ctx sdk.Context, sender sdk.AccAddress, amount sdk.Coin) (sdk.Coin, error) {
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	if amount.Denom != bondDenom {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidDenom, "expected %s", bondDenom)
+	}
+
+	if err := k.withdrawBasketRewards(ctx); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	basketAmount, err := k.basketAmountFromTokens(ctx, amount.Amount)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.BasketAccountName, sdk.NewCoins(amount)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return k.delegateAndMintBasket(ctx, sender, amount.Amount, basketAmount)
+}
+
+// ConvertToBasket burns a user's staking derivatives and mints them basket liquid staking tokens of equal value.
+//
+// The module's delegation shares backing the derivatives are transferred to the basket and stay with the source
+// validator, so they remain subject to its slashes and unbonding.
+func (k Keeper) ConvertToBasket(ctx sdk.Context, sender sdk.AccAddress, amount sdk.Coin) (sdk.Coin, error) {
+	valAddr, err := types.ParseLiquidStakingTokenDenom(amount.Denom)
+	if err != nil {
+		return sdk.Coin{}, errorsmod.Wrap(types.ErrInvalidDenom, err.Error())
+	}
+
+	if err := k.withdrawBasketRewards(ctx); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	modAddr := k.accountKeeper.GetModuleAddress(types.ModuleAccountName)
+	shares := k.getDerivativeShares(ctx, valAddr, amount)
+	// Same as BurnDerivative, block moving delegations needed to cover slashes of redelegations.
+	if err := k.checkReceivingRedelegations(ctx, modAddr, valAddr, shares); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.Coin{}, types.ErrNoValidatorFound
+	}
+	tokens := validator.TokensFromSharesTruncated(shares).TruncateInt()
+
+	basketAmount, err := k.basketAmountFromTokens(ctx, tokens)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if !basketAmount.IsPositive() {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrBasketAmountTooSmall, "%s is worth less than 1%s", amount, types.BasketDenom)
+	}
+
+	if err := k.burnCoins(ctx, sender, sdk.NewCoins(amount)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	basketAddr := k.accountKeeper.GetModuleAddress(types.BasketAccountName)
+	if _, err := k.transferDelegation(ctx, valAddr, modAddr, basketAddr, shares); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBurnDerivative,
+			sdk.NewAttribute(types.AttributeKeyDelegator, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeySharesTransferred, shares.String()),
+		),
+	)
+
+	return k.mintBasket(ctx, sender, tokens, basketAmount)
+}
+
+// BurnBasket burns a user's basket liquid staking tokens and transfers them their share of each basket delegation,
+// along with their share of any staking tokens the basket has not delegated yet.
+//
+// It returns the value in staking tokens of everything transferred to the user.
+func (k Keeper) BurnBasket(ctx sdk.Context, sender sdk.AccAddress, amount sdk.Coin) (sdk.Coin, error) {
+	if amount.Denom != types.BasketDenom {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidDenom, "expected %s", types.BasketDenom)
+	}
+
+	if err := k.withdrawBasketRewards(ctx); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, types.BasketDenom).Amount
+	if err := k.burnCoins(ctx, sender, sdk.NewCoins(amount)); err != nil {
+		return sdk.Coin{}, err
+	}
+	fraction := sdk.NewDecFromInt(amount.Amount).QuoInt(supply)
+
+	basketAddr := k.accountKeeper.GetModuleAddress(types.BasketAccountName)
+
+	// Collect delegations before transferring, as transfers modify the delegations being iterated.
+	var delegations []stakingtypes.Delegation
+	k.stakingKeeper.IterateDelegatorDelegations(ctx, basketAddr, func(delegation stakingtypes.Delegation) bool {
+		delegations = append(delegations, delegation)
+		return false
+	})
+
+	received := sdkmath.ZeroInt()
+	for _, delegation := range delegations {
+		shares := delegation.Shares.MulTruncate(fraction)
+		if !shares.IsPositive() {
+			continue
+		}
+
+		valAddr := delegation.GetValidatorAddr()
+		validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+		if !found {
+			return sdk.Coin{}, types.ErrNoValidatorFound
+		}
+		tokens := validator.TokensFromSharesTruncated(shares).TruncateInt()
+		if !tokens.IsPositive() {
+			continue
+		}
+
+		if _, err := k.TransferDelegation(ctx, valAddr, basketAddr, sender, shares); err != nil {
+			return sdk.Coin{}, err
+		}
+		received = received.Add(tokens)
+	}
+
+	// Share out staking tokens that have not been compounded yet, including the rewards withdrawn above.
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	balance := k.bankKeeper.GetBalance(ctx, basketAddr, bondDenom)
+	undelegated := sdk.NewDecFromInt(balance.Amount).Mul(fraction).TruncateInt()
+	if undelegated.IsPositive() {
+		coins := sdk.NewCoins(sdk.NewCoin(bondDenom, undelegated))
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.BasketAccountName, sender, coins); err != nil {
+			return sdk.Coin{}, err
+		}
+		received = received.Add(undelegated)
+	}
+
+	if !received.IsPositive() {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrBasketAmountTooSmall, "%s is worth less than 1%s", amount, bondDenom)
+	}
+
+	receivedCoin := sdk.NewCoin(bondDenom, received)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBurnBasket,
+			sdk.NewAttribute(types.AttributeKeyDelegator, sender.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyTokens, receivedCoin.String()),
+		),
+	)
+
+	return receivedCoin, nil
+}
+
+// CompoundBasketRewards withdraws the staking rewards of all basket delegations and delegates the basket's staking
+// tokens across the basket validators, increasing the value of each basket liquid staking token.
+//
+// It returns the amount of staking tokens delegated.
+func (k Keeper) CompoundBasketRewards(ctx sdk.Context) (sdk.Coin, error) {
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	basketAddr := k.accountKeeper.GetModuleAddress(types.BasketAccountName)
+
+	if err := k.withdrawBasketRewards(ctx); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	balance := k.bankKeeper.GetBalance(ctx, basketAddr, bondDenom)
+	if !balance.IsPositive() || len(k.GetBasketValidators(ctx)) == 0 {
+		return sdk.NewCoin(bondDenom, sdkmath.ZeroInt()), nil
+	}
+
+	if err := k.delegateBasketTokens(ctx, balance.Amount); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCompoundBasket,
+			sdk.NewAttribute(types.AttributeKeyTokens, balance.String()),
+		),
+	)
+
+	return balance, nil
+}
+
+// withdrawBasketRewards withdraws the staking rewards of all basket delegations to the basket. Rewards are only
+// delegated on an interval, so they are withdrawn before minting or burning basket tokens to include them in the
+// basket value.
+func (k Keeper) withdrawBasketRewards(ctx sdk.Context) error {
+	basketAddr := k.accountKeeper.GetModuleAddress(types.BasketAccountName)
+
+	var valAddrs []sdk.ValAddress
+	k.stakingKeeper.IterateDelegatorDelegations(ctx, basketAddr, func(delegation stakingtypes.Delegation) bool {
+		valAddrs = append(valAddrs, delegation.GetValidatorAddr())
+		return false
+	})
+
+	for _, valAddr := range valAddrs {
+		if _, err := k.collectStakingRewards(ctx, types.BasketAccountName, valAddr, types.BasketAccountName); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetLastBasketCompoundTime returns the block time the basket rewards were last compounded, or the zero time if
+// they have never been compounded.
+func (k Keeper) GetLastBasketCompoundTime(ctx sdk.Context) time.Time {
+	bz := ctx.KVStore(k.storeKey).Get(types.LastBasketCompoundTimeKey)
+	if bz == nil {
+		return time.Time{}
+	}
+
+	t, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		panic(fmt.Sprintf("invalid last basket compound time: %s", err))
+	}
+
+	return t
+}
+
+// SetLastBasketCompoundTime stores the block time the basket rewards were last compounded.
+func (k Keeper) SetLastBasketCompoundTime(ctx sdk.Context, t time.Time) {
+	ctx.KVStore(k.storeKey).Set(types.LastBasketCompoundTimeKey, sdk.FormatTimeBytes(t))
+}
+
+// GetBasketValidators returns the validators that basket delegations are sent to, with their weights.
+// Validators that are not bonded are skipped.
+func (k Keeper) GetBasketValidators(ctx sdk.Context) types.BasketValidators {
+	params := k.GetParams(ctx)
+
+	basketValidators := types.BasketValidators{}
+	switch params.BasketWeighting {
+	case types.BASKET_WEIGHTING_STAKE:
+		for _, validator := range k.stakingKeeper.GetBondedValidatorsByPower(ctx) {
+			if len(basketValidators) >= int(params.MaxBasketValidators) {
+				break
+			}
+			if !validator.GetTokens().IsPositive() {
+				continue
+			}
+			basketValidators = append(
+				basketValidators,
+				types.NewBasketValidator(validator.GetOperator(), sdk.NewDecFromInt(validator.GetTokens())),
+			)
+		}
+	default:
+		for _, basketValidator := range params.BasketValidators {
+			valAddr, err := sdk.ValAddressFromBech32(basketValidator.ValidatorAddress)
+			if err != nil {
+				panic(fmt.Sprintf("invalid basket validator address in params: %s", err))
+			}
+			validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+			if !found || !validator.IsBonded() {
+				continue
+			}
+			basketValidators = append(basketValidators, basketValidator)
+		}
+	}
+
+	return basketValidators
+}
+
+// GetBasketValue returns the total value in staking tokens of the basket delegations, plus the staking tokens held
+// by the basket that have not been delegated yet.
+func (k Keeper) GetBasketValue(ctx sdk.Context) sdk.Coin {
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	basketAddr := k.accountKeeper.GetModuleAddress(types.BasketAccountName)
+
+	total := k.bankKeeper.GetBalance(ctx, basketAddr, bondDenom).Amount
+	k.stakingKeeper.IterateDelegatorDelegations(ctx, basketAddr, func(delegation stakingtypes.Delegation) bool {
+		validator, found := k.stakingKeeper.GetValidator(ctx, delegation.GetValidatorAddr())
+		if found {
+			total = total.Add(validator.TokensFromSharesTruncated(delegation.Shares).TruncateInt())
+		}
+		return false
+	})
+
+	return sdk.NewCoin(bondDenom, total)
+}
+
+// GetBasketExchangeRate returns the value in staking tokens of one basket liquid staking token.
+// The exchange rate is one when there is no basket supply.
+func (k Keeper) GetBasketExchangeRate(ctx sdk.Context) sdk.Dec {
+	supply := k.bankKeeper.GetSupply(ctx, types.BasketDenom).Amount
+	if supply.IsZero() {
+		return sdk.OneDec()
+	}
+
+	return sdk.NewDecFromInt(k.GetBasketValue(ctx).Amount).QuoInt(supply)
+}
+
+// basketAmountFromTokens returns the amount of basket liquid staking tokens worth the given staking tokens.
+// It must be called before the tokens are added to the basket.
+func (k Keeper) basketAmountFromTokens(ctx sdk.Context, tokens sdkmath.Int) (sdkmath.Int, error) {
+	supply := k.bankKeeper.GetSupply(ctx, types.BasketDenom).Amount
+	if supply.IsZero() {
+		return tokens, nil
+	}
+
+	value := k.GetBasketValue(ctx).Amount
+	if value.IsZero() {
+		return sdkmath.Int{}, fmt.Errorf("basket has a supply of %s%s but no value", supply, types.BasketDenom)
+	}
+
+	return tokens.Mul(supply).Quo(value), nil
+}
+
+// delegateAndMintBasket delegates staking tokens held by the basket and mints basket liquid staking tokens to the
+// recipient.
+func (k Keeper) delegateAndMintBasket(
+	ctx sdk.Context,
+	recipient sdk.AccAddress,
+	tokens sdkmath.Int,
+	basketAmount sdkmath.Int,
+) (sdk.Coin, error) {
+	if !basketAmount.IsPositive() {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrBasketAmountTooSmall, "%s%s is worth less than 1%s", tokens, k.stakingKeeper.BondDenom(ctx), types.BasketDenom)
+	}
+
+	if err := k.delegateBasketTokens(ctx, tokens); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return k.mintBasket(ctx, recipient, tokens, basketAmount)
+}
+
+// mintBasket mints basket liquid staking tokens worth the given staking tokens to the recipient.
+func (k Keeper) mintBasket(ctx sdk.Context, recipient sdk.AccAddress, tokens sdkmath.Int, basketAmount sdkmath.Int) (sdk.Coin, error) {
+	basketCoin := sdk.NewCoin(types.BasketDenom, basketAmount)
+	if err := k.mintCoins(ctx, recipient, sdk.NewCoins(basketCoin)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMintBasket,
+			sdk.NewAttribute(types.AttributeKeyDelegator, recipient.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, basketCoin.String()),
+			sdk.NewAttribute(types.AttributeKeyTokens, sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), tokens).String()),
+		),
+	)
+
+	return basketCoin, nil
+}
+
+// delegateBasketTokens delegates staking tokens held by the basket across the basket validators by weight.
+// Any amount lost to rounding is delegated to the last validator.
+func (k Keeper) delegateBasketTokens(ctx sdk.Context, amount sdkmath.Int) error {
+	basketValidators := k.GetBasketValidators(ctx)
+	if len(basketValidators) == 0 {
+		return types.ErrEmptyBasket
+	}
+
+	basketAddr := k.accountKeeper.GetModuleAddress(types.BasketAccountName)
+	totalWeight := basketValidators.TotalWeight()
+
+	remaining := amount
+	for i, basketValidator := range basketValidators {
+		portion := remaining
+		if i < len(basketValidators)-1 {
+			portion = sdk.NewDecFromInt(amount).Mul(basketValidator.Weight).Quo(totalWeight).TruncateInt()
+		}
+		remaining = remaining.Sub(portion)
+
+		if !portion.IsPositive() {
+			continue
+		}
+
+		valAddr, err := sdk.ValAddressFromBech32(basketValidator.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+		if _, err := k.delegateFromAccount(ctx, valAddr, basketAddr, portion); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,284 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/liquid"
+	"github.com/kava-labs/kava/x/liquid/types"
+)
+
+// setupBasket creates and bonds validators with the given self delegations, and sets a governance weighted basket
+// of the validators with non-zero weights.
+func (suite *KeeperTestSuite) setupBasket(selfDelegations []sdkmath.Int, weights []sdk.Dec) []sdk.ValAddress {
+	_, addrs := app.GeneratePrivKeyAddressPairs(len(selfDelegations))
+
+	valAddrs := make([]sdk.ValAddress, len(selfDelegations))
+	basketValidators := types.BasketValidators{}
+	for idx, selfDelegation := range selfDelegations {
+		valAddrs[idx] = sdk.ValAddress(addrs[idx])
+		suite.CreateAccountWithAddress(addrs[idx], suite.NewBondCoins(selfDelegation))
+		suite.CreateNewUnbondedValidator(valAddrs[idx], selfDelegation)
+
+		if weights[idx].IsPositive() {
+			basketValidators = append(basketValidators, types.NewBasketValidator(valAddrs[idx], weights[idx]))
+		}
+	}
+	staking.EndBlocker(suite.Ctx, suite.StakingKeeper)
+
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.BASKET_WEIGHTING_GOVERNANCE, basketValidators, 0, false, types.DefaultBasketCompoundInterval))
+
+	return valAddrs
+}
+
+func (suite *KeeperTestSuite) basketAddress() sdk.AccAddress {
+	return suite.App.GetAccountKeeper().GetModuleAddress(types.BasketAccountName)
+}
+
+func (suite *KeeperTestSuite) TestMintBasket() {
+	valAddrs := suite.setupBasket([]sdkmath.Int{i(1e9), i(1e9)}, []sdk.Dec{d("1"), d("3")})
+	user := suite.CreateAccount(suite.NewBondCoins(i(1e9)), 5).GetAddress()
+
+	received, err := suite.Keeper.MintBasket(suite.Ctx, user, suite.NewBondCoin(i(100e6)))
+	suite.Require().NoError(err)
+	suite.Equal(c(types.BasketDenom, 100e6), received)
+
+	suite.AccountBalanceEqual(user, sdk.NewCoins(c("ukava", 900e6), c(types.BasketDenom, 100e6)))
+	suite.DelegationSharesEqual(valAddrs[0], suite.basketAddress(), d("25000000"))
+	suite.DelegationSharesEqual(valAddrs[1], suite.basketAddress(), d("75000000"))
+	suite.Equal(suite.NewBondCoin(i(100e6)), suite.Keeper.GetBasketValue(suite.Ctx))
+	suite.Equal(d("1"), suite.Keeper.GetBasketExchangeRate(suite.Ctx))
+
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeMintBasket,
+		sdk.NewAttribute(types.AttributeKeyDelegator, user.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, received.String()),
+		sdk.NewAttribute(types.AttributeKeyTokens, suite.NewBondCoin(i(100e6)).String()),
+	))
+
+	// A slash lowers the value of each basket token, so the same tokens mint more basket tokens.
+	suite.SlashValidator(valAddrs[1], d("0.1"))
+	suite.Equal(suite.NewBondCoin(i(92_500_000)), suite.Keeper.GetBasketValue(suite.Ctx))
+	suite.Equal(d("0.925"), suite.Keeper.GetBasketExchangeRate(suite.Ctx))
+
+	received, err = suite.Keeper.MintBasket(suite.Ctx, user, suite.NewBondCoin(i(92_500_000)))
+	suite.Require().NoError(err)
+	suite.Equal(c(types.BasketDenom, 100e6), received)
+}
+
+func (suite *KeeperTestSuite) TestMintBasket_Errors() {
+	user := suite.CreateAccount(suite.NewBondCoins(i(1e9)), 5).GetAddress()
+
+	_, err := suite.Keeper.MintBasket(suite.Ctx, user, suite.NewBondCoin(i(100e6)))
+	suite.ErrorIs(err, types.ErrEmptyBasket, "default params have no basket validators")
+
+	valAddrs := suite.setupBasket([]sdkmath.Int{i(1e9)}, []sdk.Dec{d("1")})
+
+	_, err = suite.Keeper.MintBasket(suite.Ctx, user, c("hard", 100e6))
+	suite.ErrorIs(err, types.ErrInvalidDenom)
+
+	// unbonded validators are skipped
+	suite.CreateAccountWithAddress(sdk.AccAddress(valAddrs[0]), suite.NewBondCoins(i(1e9)))
+	suite.StakingKeeper.Jail(suite.Ctx, mustConsAddr(suite, valAddrs[0]))
+	staking.EndBlocker(suite.Ctx, suite.StakingKeeper)
+	suite.Empty(suite.Keeper.GetBasketValidators(suite.Ctx))
+
+	_, err = suite.Keeper.MintBasket(suite.Ctx, user, suite.NewBondCoin(i(100e6)))
+	suite.ErrorIs(err, types.ErrEmptyBasket)
+}
+
+func (suite *KeeperTestSuite) TestBurnBasket() {
+	valAddrs := suite.setupBasket([]sdkmath.Int{i(1e9), i(1e9)}, []sdk.Dec{d("1"), d("3")})
+	user := suite.CreateAccount(suite.NewBondCoins(i(1e9)), 5).GetAddress()
+
+	_, err := suite.Keeper.MintBasket(suite.Ctx, user, suite.NewBondCoin(i(100e6)))
+	suite.Require().NoError(err)
+
+	// tokens not yet compounded are shared out with the delegations
+	suite.AddCoinsToModule(types.BasketAccountName, suite.NewBondCoins(i(10e6)))
+
+	received, err := suite.Keeper.BurnBasket(suite.Ctx, user, c(types.BasketDenom, 50e6))
+	suite.Require().NoError(err)
+	suite.Equal(suite.NewBondCoin(i(55e6)), received)
+
+	suite.AccountBalanceEqual(user, sdk.NewCoins(c("ukava", 905e6), c(types.BasketDenom, 50e6)))
+	suite.DelegationSharesEqual(valAddrs[0], user, d("12500000"))
+	suite.DelegationSharesEqual(valAddrs[1], user, d("37500000"))
+	suite.DelegationSharesEqual(valAddrs[0], suite.basketAddress(), d("12500000"))
+	suite.DelegationSharesEqual(valAddrs[1], suite.basketAddress(), d("37500000"))
+	suite.Equal(c(types.BasketDenom, 50e6), suite.BankKeeper.GetSupply(suite.Ctx, types.BasketDenom))
+
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeBurnBasket,
+		sdk.NewAttribute(types.AttributeKeyDelegator, user.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, c(types.BasketDenom, 50e6).String()),
+		sdk.NewAttribute(types.AttributeKeyTokens, received.String()),
+	))
+
+	// burning the remaining supply empties the basket
+	_, err = suite.Keeper.BurnBasket(suite.Ctx, user, c(types.BasketDenom, 50e6))
+	suite.Require().NoError(err)
+	suite.DelegationSharesEqual(valAddrs[0], suite.basketAddress(), sdk.ZeroDec())
+	suite.DelegationSharesEqual(valAddrs[1], suite.basketAddress(), sdk.ZeroDec())
+	suite.AccountBalanceEqual(suite.basketAddress(), sdk.NewCoins())
+
+	_, err = suite.Keeper.BurnBasket(suite.Ctx, user, c(types.BasketDenom, 1))
+	suite.Require().Error(err)
+
+	_, err = suite.Keeper.BurnBasket(suite.Ctx, user, c("ukava", 1))
+	suite.ErrorIs(err, types.ErrInvalidDenom)
+}
+
+func (suite *KeeperTestSuite) TestConvertToBasket() {
+	valAddrs := suite.setupBasket([]sdkmath.Int{i(1e9), i(1e9)}, []sdk.Dec{sdk.ZeroDec(), d("1")})
+	user := suite.CreateAccount(suite.NewBondCoins(i(1e9)), 5).GetAddress()
+	suite.CreateDelegation(valAddrs[0], user, i(100e6))
+
+	derivative, err := suite.Keeper.MintDerivative(suite.Ctx, user, valAddrs[0], suite.NewBondCoin(i(100e6)))
+	suite.Require().NoError(err)
+
+	received, err := suite.Keeper.ConvertToBasket(suite.Ctx, user, derivative)
+	suite.Require().NoError(err)
+	suite.Equal(c(types.BasketDenom, 100e6), received)
+
+	modAddr := suite.App.GetAccountKeeper().GetModuleAddress(types.ModuleAccountName)
+	suite.AccountBalanceEqual(user, sdk.NewCoins(c("ukava", 900e6), c(types.BasketDenom, 100e6)))
+	suite.DelegationSharesEqual(valAddrs[0], modAddr, sdk.ZeroDec())
+	suite.DelegationSharesEqual(valAddrs[0], suite.basketAddress(), d("100000000"))
+	suite.DelegationSharesEqual(valAddrs[1], suite.basketAddress(), sdk.ZeroDec())
+	suite.True(suite.BankKeeper.GetSupply(suite.Ctx, derivative.Denom).IsZero())
+
+	// converted stake stays with the source validator, so it is still slashed
+	suite.SlashValidator(valAddrs[0], d("0.1"))
+	suite.Equal(suite.NewBondCoin(i(90e6)), suite.Keeper.GetBasketValue(suite.Ctx))
+
+	_, err = suite.Keeper.ConvertToBasket(suite.Ctx, user, c(types.BasketDenom, 1))
+	suite.ErrorIs(err, types.ErrInvalidDenom)
+}
+
+func (suite *KeeperTestSuite) TestCompoundBasketRewards() {
+	valAddrs := suite.setupBasket([]sdkmath.Int{i(900e6)}, []sdk.Dec{d("1")})
+	user := suite.CreateAccount(suite.NewBondCoins(i(1e9)), 5).GetAddress()
+
+	_, err := suite.Keeper.MintBasket(suite.Ctx, user, suite.NewBondCoin(i(100e6)))
+	suite.Require().NoError(err)
+
+	compounded, err := suite.Keeper.CompoundBasketRewards(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.True(compounded.IsZero(), "expected no rewards to compound")
+
+	suite.Ctx = suite.Ctx.WithBlockHeight(2)
+	suite.Require().NoError(suite.App.FundModuleAccount(suite.Ctx, distrtypes.ModuleName, suite.NewBondCoins(i(500e6))))
+	validator, found := suite.StakingKeeper.GetValidator(suite.Ctx, valAddrs[0])
+	suite.Require().True(found)
+	suite.App.GetDistrKeeper().AllocateTokensToValidator(
+		suite.Ctx, validator, sdk.NewDecCoins(sdk.NewDecCoin("ukava", i(500e6))),
+	)
+
+	// the basket owns a tenth of the validator's stake
+	compounded, err = suite.Keeper.CompoundBasketRewards(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Equal(suite.NewBondCoin(i(50e6)), compounded)
+
+	suite.DelegationSharesEqual(valAddrs[0], suite.basketAddress(), d("150000000"))
+	suite.AccountBalanceEqual(suite.basketAddress(), sdk.NewCoins())
+	suite.Equal(d("1.5"), suite.Keeper.GetBasketExchangeRate(suite.Ctx))
+
+	received, err := suite.Keeper.MintBasket(suite.Ctx, user, suite.NewBondCoin(i(150e6)))
+	suite.Require().NoError(err)
+	suite.Equal(c(types.BasketDenom, 100e6), received)
+}
+
+func (suite *KeeperTestSuite) TestMintBasket_PendingRewards() {
+	valAddrs := suite.setupBasket([]sdkmath.Int{i(900e6)}, []sdk.Dec{d("1")})
+	user := suite.CreateAccount(suite.NewBondCoins(i(1e9)), 5).GetAddress()
+
+	_, err := suite.Keeper.MintBasket(suite.Ctx, user, suite.NewBondCoin(i(100e6)))
+	suite.Require().NoError(err)
+
+	suite.Ctx = suite.Ctx.WithBlockHeight(2)
+	suite.Require().NoError(suite.App.FundModuleAccount(suite.Ctx, distrtypes.ModuleName, suite.NewBondCoins(i(500e6))))
+	validator, found := suite.StakingKeeper.GetValidator(suite.Ctx, valAddrs[0])
+	suite.Require().True(found)
+	suite.App.GetDistrKeeper().AllocateTokensToValidator(
+		suite.Ctx, validator, sdk.NewDecCoins(sdk.NewDecCoin("ukava", i(500e6))),
+	)
+
+	// rewards that have not been compounded are included in the mint price
+	received, err := suite.Keeper.MintBasket(suite.Ctx, user, suite.NewBondCoin(i(150e6)))
+	suite.Require().NoError(err)
+	suite.Equal(c(types.BasketDenom, 100e6), received)
+
+	suite.AccountBalanceEqual(suite.basketAddress(), suite.NewBondCoins(i(50e6)))
+	suite.Equal(d("1.5"), suite.Keeper.GetBasketExchangeRate(suite.Ctx))
+}
+
+func (suite *KeeperTestSuite) TestBeginBlocker_CompoundInterval() {
+	valAddrs := suite.setupBasket([]sdkmath.Int{i(900e6)}, []sdk.Dec{d("1")})
+	user := suite.CreateAccount(suite.NewBondCoins(i(1e9)), 5).GetAddress()
+
+	_, err := suite.Keeper.MintBasket(suite.Ctx, user, suite.NewBondCoin(i(100e6)))
+	suite.Require().NoError(err)
+
+	startTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.Ctx = suite.Ctx.WithBlockTime(startTime)
+	liquid.BeginBlocker(suite.Ctx, suite.Keeper)
+	suite.Equal(startTime, suite.Keeper.GetLastBasketCompoundTime(suite.Ctx))
+
+	suite.Ctx = suite.Ctx.WithBlockHeight(2)
+	suite.Require().NoError(suite.App.FundModuleAccount(suite.Ctx, distrtypes.ModuleName, suite.NewBondCoins(i(500e6))))
+	validator, found := suite.StakingKeeper.GetValidator(suite.Ctx, valAddrs[0])
+	suite.Require().True(found)
+	suite.App.GetDistrKeeper().AllocateTokensToValidator(
+		suite.Ctx, validator, sdk.NewDecCoins(sdk.NewDecCoin("ukava", i(500e6))),
+	)
+
+	// rewards are not compounded before the interval has passed
+	suite.Ctx = suite.Ctx.WithBlockTime(startTime.Add(types.DefaultBasketCompoundInterval - time.Second))
+	liquid.BeginBlocker(suite.Ctx, suite.Keeper)
+	suite.DelegationSharesEqual(valAddrs[0], suite.basketAddress(), d("100000000"))
+	suite.Equal(startTime, suite.Keeper.GetLastBasketCompoundTime(suite.Ctx))
+
+	suite.Ctx = suite.Ctx.WithBlockTime(startTime.Add(types.DefaultBasketCompoundInterval))
+	liquid.BeginBlocker(suite.Ctx, suite.Keeper)
+	suite.DelegationSharesEqual(valAddrs[0], suite.basketAddress(), d("150000000"))
+	suite.Equal(suite.Ctx.BlockTime(), suite.Keeper.GetLastBasketCompoundTime(suite.Ctx))
+}
+
+func (suite *KeeperTestSuite) TestGetBasketValidators_Stake() {
+	valAddrs := suite.setupBasket([]sdkmath.Int{i(1e9), i(3e9)}, []sdk.Dec{sdk.ZeroDec(), sdk.ZeroDec()})
+
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.BASKET_WEIGHTING_STAKE, nil, 1, false, types.DefaultBasketCompoundInterval))
+	suite.Equal(
+		types.BasketValidators{types.NewBasketValidator(valAddrs[1], d("3000000000"))},
+		suite.Keeper.GetBasketValidators(suite.Ctx),
+	)
+
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.BASKET_WEIGHTING_STAKE, nil, 2, false, types.DefaultBasketCompoundInterval))
+	suite.Equal(
+		types.BasketValidators{
+			types.NewBasketValidator(valAddrs[1], d("3000000000")),
+			types.NewBasketValidator(valAddrs[0], d("1000000000")),
+		},
+		suite.Keeper.GetBasketValidators(suite.Ctx),
+	)
+
+	user := suite.CreateAccount(suite.NewBondCoins(i(1e9)), 5).GetAddress()
+	_, err := suite.Keeper.MintBasket(suite.Ctx, user, suite.NewBondCoin(i(100e6)))
+	suite.Require().NoError(err)
+	suite.DelegationSharesEqual(valAddrs[0], suite.basketAddress(), d("25000000"))
+	suite.DelegationSharesEqual(valAddrs[1], suite.basketAddress(), d("75000000"))
+}
+
+func mustConsAddr(suite *KeeperTestSuite, valAddr sdk.ValAddress) sdk.ConsAddress {
+	validator, found := suite.StakingKeeper.GetValidator(suite.Ctx, valAddr)
+	suite.Require().True(found)
+	consAddr, err := validator.GetConsAddr()
+	suite.Require().NoError(err)
+	return consAddr
+}
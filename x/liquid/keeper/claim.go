@@ -13,14 +13,25 @@ func (k Keeper) CollectStakingRewards(
 	validator sdk.ValAddress,
 	destinationModAccount string,
 ) (sdk.Coins, error) {
-	macc := k.accountKeeper.GetModuleAccount(ctx, types.ModuleAccountName)
+	return k.collectStakingRewards(ctx, types.ModuleAccountName, validator, destinationModAccount)
+}
+
+// collectStakingRewards withdraws the staking rewards of a module account's delegation to a validator, and sends
+// them to the destination module account.
+func (k Keeper) collectStakingRewards(
+	ctx sdk.Context,
+	delegatorModAccount string,
+	validator sdk.ValAddress,
+	destinationModAccount string,
+) (sdk.Coins, error) {
+	macc := k.accountKeeper.GetModuleAccount(ctx, delegatorModAccount)
 
 	// Ensure withdraw address is as expected
 	withdrawAddr := k.distributionKeeper.GetDelegatorWithdrawAddr(ctx, macc.GetAddress())
 	if !withdrawAddr.Equals(macc.GetAddress()) {
 		panic(fmt.Sprintf(
-			"unexpected withdraw address for liquid staking module account, expected %s, got %s",
-			macc.GetAddress(), withdrawAddr,
+			"unexpected withdraw address for %s module account, expected %s, got %s",
+			delegatorModAccount, macc.GetAddress(), withdrawAddr,
 		))
 	}
 
@@ -29,11 +40,11 @@ func (k Keeper) CollectStakingRewards(
 		return nil, err
 	}
 
-	if rewards.IsZero() {
+	if rewards.IsZero() || destinationModAccount == delegatorModAccount {
 		return rewards, nil
 	}
 
-	err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleAccountName, destinationModAccount, rewards)
+	err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, delegatorModAccount, destinationModAccount, rewards)
 	if err != nil {
 		return nil, err
 	}
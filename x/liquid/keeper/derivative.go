@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
@@ -13,7 +14,7 @@ import (
 // MintDerivative removes a user's staking delegation and mints them equivalent staking derivative coins.
 //
 // The input staking token amount is used to calculate shares in the user's delegation, which are transferred to a delegation owned by the module.
-// Derivative coins are them minted and transferred to the user.
+// Derivative coins are them minted and transferred to the user, priced by the shares backing each existing derivative.
 func (k Keeper) MintDerivative(ctx sdk.Context, delegatorAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coin) (sdk.Coin, error) {
 	bondDenom := k.stakingKeeper.BondDenom(ctx)
 	if amount.Denom != bondDenom {
@@ -51,6 +52,91 @@ func (k Keeper) MintDerivative(ctx sdk.Context, delegatorAddr sdk.AccAddress, va
 	return liquidToken, nil
 }
 
+// RedelegateDerivative moves the stake behind a user's staking derivatives to another validator, and swaps the
+// derivatives for derivatives of the destination validator.
+//
+// The module's delegation shares backing the derivatives are redelegated with the staking module, so the staking
+// module's redelegation limits apply and the redelegated stake remains slashable for infractions at the source
+// validator until the redelegation completes. Redelegating to a jailed validator, or one whose self delegation is
+// below its min self delegation, is blocked.
+//
+// Derivatives are priced by the shares backing them on both validators, so a later slash of the redelegation only
+// lowers the value of the destination derivatives by the same fraction for every holder.
+func (k Keeper) RedelegateDerivative(
+	ctx sdk.Context,
+	delegatorAddr sdk.AccAddress,
+	dstValAddr sdk.ValAddress,
+	amount sdk.Coin,
+) (sdk.Coin, time.Time, error) {
+	srcValAddr, err := types.ParseLiquidStakingTokenDenom(amount.Denom)
+	if err != nil {
+		return sdk.Coin{}, time.Time{}, errorsmod.Wrap(types.ErrInvalidDenom, err.Error())
+	}
+
+	dstValidator, found := k.stakingKeeper.GetValidator(ctx, dstValAddr)
+	if !found {
+		return sdk.Coin{}, time.Time{}, types.ErrNoValidatorFound
+	}
+	if dstValidator.IsJailed() {
+		return sdk.Coin{}, time.Time{}, errorsmod.Wrapf(types.ErrUntransferableShares, "destination validator %s is jailed", dstValAddr)
+	}
+	selfDelegation, found := k.stakingKeeper.GetDelegation(ctx, sdk.AccAddress(dstValAddr), dstValAddr)
+	if !found || isBelowMinSelfDelegation(dstValidator, selfDelegation.Shares) {
+		return sdk.Coin{}, time.Time{}, errorsmod.Wrapf(types.ErrSelfDelegationBelowMinimum, "destination validator %s", dstValAddr)
+	}
+
+	// Price both derivatives before the supplies change.
+	shares := k.getDerivativeShares(ctx, srcValAddr, amount)
+	dstBacking := k.getDerivativeBacking(ctx, dstValAddr, k.GetLiquidStakingTokenDenom(dstValAddr))
+	if !dstBacking.IsPositive() {
+		return sdk.Coin{}, time.Time{}, errorsmod.Wrapf(types.ErrUntransferableShares, "destination validator %s derivatives have no backing", dstValAddr)
+	}
+
+	if err := k.burnCoins(ctx, delegatorAddr, sdk.NewCoins(amount)); err != nil {
+		return sdk.Coin{}, time.Time{}, err
+	}
+
+	modAddr := k.accountKeeper.GetModuleAccount(ctx, types.ModuleAccountName).GetAddress()
+	sharesBefore := sdk.ZeroDec()
+	if delegation, found := k.stakingKeeper.GetDelegation(ctx, modAddr, dstValAddr); found {
+		sharesBefore = delegation.Shares
+	}
+
+	completionTime, err := k.stakingKeeper.BeginRedelegation(ctx, modAddr, srcValAddr, dstValAddr, shares)
+	if err != nil {
+		return sdk.Coin{}, time.Time{}, err
+	}
+
+	delegation, found := k.stakingKeeper.GetDelegation(ctx, modAddr, dstValAddr)
+	if !found {
+		return sdk.Coin{}, time.Time{}, types.ErrNoDelegatorForAddress
+	}
+	receivedShares := delegation.Shares.Sub(sharesBefore)
+
+	// Any fractional derivative is left as shares in the module account.
+	derivative := sdk.NewCoin(k.GetLiquidStakingTokenDenom(dstValAddr), receivedShares.Quo(dstBacking).TruncateInt())
+	if !derivative.IsPositive() {
+		return sdk.Coin{}, time.Time{}, errorsmod.Wrap(types.ErrUntransferableShares, "redelegated shares are less than one derivative")
+	}
+	if err := k.mintCoins(ctx, delegatorAddr, sdk.NewCoins(derivative)); err != nil {
+		return sdk.Coin{}, time.Time{}, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRedelegateDerivative,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delegatorAddr.String()),
+			sdk.NewAttribute(types.AttributeKeySourceValidator, srcValAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyDestValidator, dstValAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyReceived, derivative.String()),
+			sdk.NewAttribute(types.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
+		),
+	)
+
+	return derivative, completionTime, nil
+}
+
 // CalculateDerivativeSharesFromTokens converts a staking token amount into its equivalent delegation shares, and staking derivative amount.
 // This combines the code for calculating the shares to be transferred, and the derivative coins to be minted.
 func (k Keeper) CalculateDerivativeSharesFromTokens(ctx sdk.Context, delegator sdk.AccAddress, validator sdk.ValAddress, tokens sdkmath.Int) (sdkmath.Int, sdk.Dec, error) {
@@ -61,25 +147,37 @@ func (k Keeper) CalculateDerivativeSharesFromTokens(ctx sdk.Context, delegator s
 	if err != nil {
 		return sdkmath.Int{}, sdk.Dec{}, err
 	}
-	return shares.TruncateInt(), shares, nil
+
+	backing := k.getDerivativeBacking(ctx, validator, k.GetLiquidStakingTokenDenom(validator))
+	if !backing.IsPositive() {
+		return sdkmath.Int{}, sdk.Dec{}, errorsmod.Wrapf(types.ErrUntransferableShares, "validator %s derivatives have no backing", validator)
+	}
+	return shares.Quo(backing).TruncateInt(), shares, nil
 }
 
 // BurnDerivative burns an user's staking derivative coins and returns them an equivalent staking delegation.
 //
-// The derivative coins are burned, and an equivalent number of shares in the module's staking delegation are transferred back to the user.
+// The derivative coins are burned, and the shares backing them in the module's staking delegation are transferred back to the user.
 func (k Keeper) BurnDerivative(ctx sdk.Context, delegatorAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coin) (sdk.Dec, error) {
 
 	if amount.Denom != k.GetLiquidStakingTokenDenom(valAddr) {
 		return sdk.Dec{}, errorsmod.Wrap(types.ErrInvalidDenom, "derivative denom does not match validator")
 	}
 
+	// Price the derivatives before the supply changes.
+	shares := k.getDerivativeShares(ctx, valAddr, amount)
+
 	if err := k.burnCoins(ctx, delegatorAddr, sdk.NewCoins(amount)); err != nil {
 		return sdk.Dec{}, err
 	}
 
+	// The module's delegation may receive redelegations from RedelegateDerivative, so burns are only blocked if they
+	// would leave too few shares to cover slashes of the redelegations.
 	modAcc := k.accountKeeper.GetModuleAccount(ctx, types.ModuleAccountName)
-	shares := sdk.NewDecFromInt(amount.Amount)
-	receivedShares, err := k.TransferDelegation(ctx, valAddr, modAcc.GetAddress(), delegatorAddr, shares)
+	if err := k.checkReceivingRedelegations(ctx, modAcc.GetAddress(), valAddr, shares); err != nil {
+		return sdk.Dec{}, err
+	}
+	receivedShares, err := k.transferDelegation(ctx, valAddr, modAcc.GetAddress(), delegatorAddr, shares)
 	if err != nil {
 		return sdk.Dec{}, err
 	}
@@ -128,8 +226,9 @@ func (k Keeper) GetStakedTokensForDerivatives(ctx sdk.Context, coins sdk.Coins)
 			return sdk.Coin{}, fmt.Errorf("invalid derivative denom %s: validator not found", coin.Denom)
 		}
 
-		// bkava is 1:1 to delegation shares
-		valTokens := validator.TokensFromSharesTruncated(sdk.NewDecFromInt(coin.Amount))
+		// bkava is 1:1 to delegation shares, unless a slashed redelegation has left fewer shares than bkava
+		shares := k.getDerivativeShares(ctx, valAddr, coin)
+		valTokens := validator.TokensFromSharesTruncated(shares)
 		total = total.Add(valTokens.TruncateInt())
 	}
 
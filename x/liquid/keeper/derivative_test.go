@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"fmt"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -26,6 +27,7 @@ func (suite *KeeperTestSuite) TestBurnDerivative() {
 		balance          sdk.Coin
 		moduleDelegation sdkmath.Int
 		burnAmount       sdk.Coin
+		expectedShares   sdk.Dec
 		expectedErr      error
 	}{
 		{
@@ -62,11 +64,11 @@ func (suite *KeeperTestSuite) TestBurnDerivative() {
 			expectedErr:      sdkerrors.ErrInsufficientFunds,
 		},
 		{
-			name:             "error when backing delegation isn't large enough",
+			name:             "burn is priced by the backing delegation when it is smaller than the supply",
 			balance:          c(liquidDenom, 1e9),
 			moduleDelegation: i(999_999_999),
 			burnAmount:       c(liquidDenom, 1e9),
-			expectedErr:      stakingtypes.ErrNotEnoughDelegationShares,
+			expectedShares:   d("999999999"),
 		},
 	}
 
@@ -97,6 +99,9 @@ func (suite *KeeperTestSuite) TestBurnDerivative() {
 			suite.AccountBalanceEqual(moduleAccAddress, modBalance) // ensure derivatives are burned, and not in module account
 
 			sharesTransferred := sdk.NewDecFromInt(tc.burnAmount.Amount)
+			if !tc.expectedShares.IsNil() {
+				sharesTransferred = tc.expectedShares
+			}
 			suite.DelegationSharesEqual(valAddr, user, sharesTransferred)
 			suite.DelegationSharesEqual(valAddr, moduleAccAddress, sdk.NewDecFromInt(tc.moduleDelegation).Sub(sharesTransferred))
 
@@ -316,6 +321,145 @@ func (suite *KeeperTestSuite) TestMintDerivative() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestRedelegateDerivative() {
+	_, addrs := app.GeneratePrivKeyAddressPairs(5)
+	val1AccAddr, val2AccAddr, user1, user2 := addrs[0], addrs[1], addrs[2], addrs[3]
+	val1Addr, val2Addr := sdk.ValAddress(val1AccAddr), sdk.ValAddress(val2AccAddr)
+	moduleAccAddress := authtypes.NewModuleAddress(types.ModuleAccountName)
+
+	initialBalance := i(1e9)
+	for _, addr := range addrs[:4] {
+		suite.CreateAccountWithAddress(addr, suite.NewBondCoins(initialBalance))
+	}
+	suite.CreateNewUnbondedValidator(val1Addr, initialBalance)
+	suite.CreateNewUnbondedValidator(val2Addr, initialBalance)
+	staking.EndBlocker(suite.Ctx, suite.StakingKeeper)
+
+	suite.CreateDelegation(val1Addr, user1, i(100e6))
+	derivative1, err := suite.Keeper.MintDerivative(suite.Ctx, user1, val1Addr, suite.NewBondCoin(i(100e6)))
+	suite.Require().NoError(err)
+
+	received, completionTime, err := suite.Keeper.RedelegateDerivative(suite.Ctx, user1, val2Addr, c(derivative1.Denom, 40e6))
+	suite.Require().NoError(err)
+
+	derivative2Denom := suite.Keeper.GetLiquidStakingTokenDenom(val2Addr)
+	suite.Equal(c(derivative2Denom, 40e6), received)
+	suite.Equal(suite.Ctx.BlockTime().Add(suite.StakingKeeper.UnbondingTime(suite.Ctx)), completionTime)
+	suite.AccountBalanceEqual(user1, sdk.NewCoins(c("ukava", 900e6), c(derivative1.Denom, 60e6), c(derivative2Denom, 40e6)))
+	suite.DelegationSharesEqual(val1Addr, moduleAccAddress, d("60000000"))
+	suite.DelegationSharesEqual(val2Addr, moduleAccAddress, d("40000000"))
+	suite.True(suite.StakingKeeper.HasReceivingRedelegation(suite.Ctx, moduleAccAddress, val2Addr))
+
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeRedelegateDerivative,
+		sdk.NewAttribute(types.AttributeKeyDelegator, user1.String()),
+		sdk.NewAttribute(types.AttributeKeySourceValidator, val1Addr.String()),
+		sdk.NewAttribute(types.AttributeKeyDestValidator, val2Addr.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, c(derivative1.Denom, 40e6).String()),
+		sdk.NewAttribute(types.AttributeKeyReceived, received.String()),
+		sdk.NewAttribute(types.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
+	))
+
+	suite.Run("redelegating back before completion is a transitive redelegation", func() {
+		_, _, err := suite.Keeper.RedelegateDerivative(suite.Ctx, user1, val1Addr, c(derivative2Denom, 1e6))
+		suite.ErrorIs(err, stakingtypes.ErrTransitiveRedelegation)
+	})
+
+	suite.Run("redelegated shares cannot be burned until the redelegation completes", func() {
+		_, err := suite.Keeper.BurnDerivative(suite.Ctx, user1, val2Addr, c(derivative2Denom, 1e6))
+		suite.ErrorIs(err, types.ErrRedelegationsNotCompleted)
+	})
+
+	suite.Run("shares not needed to cover the redelegation can be burned", func() {
+		suite.CreateDelegation(val2Addr, user2, i(50e6))
+		_, err := suite.Keeper.MintDerivative(suite.Ctx, user2, val2Addr, suite.NewBondCoin(i(50e6)))
+		suite.Require().NoError(err)
+
+		_, err = suite.Keeper.BurnDerivative(suite.Ctx, user2, val2Addr, c(derivative2Denom, 50e6))
+		suite.Require().NoError(err)
+		suite.DelegationSharesEqual(val2Addr, moduleAccAddress, d("40000000"))
+	})
+
+	suite.Run("error when redelegating to the same validator", func() {
+		_, _, err := suite.Keeper.RedelegateDerivative(suite.Ctx, user1, val1Addr, c(derivative1.Denom, 1e6))
+		suite.ErrorIs(err, stakingtypes.ErrSelfRedelegation)
+	})
+
+	suite.Run("error when the destination validator is below its min self delegation", func() {
+		validator, found := suite.StakingKeeper.GetValidator(suite.Ctx, val2Addr)
+		suite.Require().True(found)
+		validator.MinSelfDelegation = initialBalance.AddRaw(1)
+		suite.StakingKeeper.SetValidator(suite.Ctx, validator)
+
+		_, _, err := suite.Keeper.RedelegateDerivative(suite.Ctx, user1, val2Addr, c(derivative1.Denom, 1e6))
+		suite.ErrorIs(err, types.ErrSelfDelegationBelowMinimum)
+
+		validator.MinSelfDelegation = sdk.OneInt()
+		suite.StakingKeeper.SetValidator(suite.Ctx, validator)
+	})
+
+	suite.Run("error when the destination validator is jailed", func() {
+		suite.StakingKeeper.Jail(suite.Ctx, mustConsAddr(suite, val2Addr))
+		_, _, err := suite.Keeper.RedelegateDerivative(suite.Ctx, user1, val2Addr, c(derivative1.Denom, 1e6))
+		suite.ErrorIs(err, types.ErrUntransferableShares)
+	})
+
+	suite.Run("error when the denom is not a derivative", func() {
+		_, _, err := suite.Keeper.RedelegateDerivative(suite.Ctx, user1, val2Addr, c("ukava", 1e6))
+		suite.ErrorIs(err, types.ErrInvalidDenom)
+	})
+}
+
+func (suite *KeeperTestSuite) TestRedelegateDerivative_Slashed() {
+	_, addrs := app.GeneratePrivKeyAddressPairs(4)
+	val1AccAddr, val2AccAddr, user1, user2 := addrs[0], addrs[1], addrs[2], addrs[3]
+	val1Addr, val2Addr := sdk.ValAddress(val1AccAddr), sdk.ValAddress(val2AccAddr)
+	moduleAccAddress := authtypes.NewModuleAddress(types.ModuleAccountName)
+
+	initialBalance := i(1e9)
+	for _, addr := range addrs {
+		suite.CreateAccountWithAddress(addr, suite.NewBondCoins(initialBalance))
+	}
+	suite.CreateNewUnbondedValidator(val1Addr, initialBalance)
+	suite.CreateNewUnbondedValidator(val2Addr, initialBalance)
+	staking.EndBlocker(suite.Ctx, suite.StakingKeeper)
+
+	suite.Ctx = suite.Ctx.WithBlockHeight(10)
+	suite.CreateDelegation(val1Addr, user1, i(100e6))
+	derivative1, err := suite.Keeper.MintDerivative(suite.Ctx, user1, val1Addr, suite.NewBondCoin(i(100e6)))
+	suite.Require().NoError(err)
+	suite.CreateDelegation(val2Addr, user2, i(100e6))
+	derivative2, err := suite.Keeper.MintDerivative(suite.Ctx, user2, val2Addr, suite.NewBondCoin(i(100e6)))
+	suite.Require().NoError(err)
+
+	_, _, err = suite.Keeper.RedelegateDerivative(suite.Ctx, user1, val2Addr, derivative1)
+	suite.Require().NoError(err)
+
+	// slash the redelegation, removing 10e6 shares from the module's destination delegation
+	suite.Ctx = suite.Ctx.WithBlockHeight(11)
+	validator, found := suite.StakingKeeper.GetValidator(suite.Ctx, val1Addr)
+	suite.Require().True(found)
+	power := suite.StakingKeeper.TokensToConsensusPower(suite.Ctx, validator.GetTokens())
+	suite.StakingKeeper.Slash(suite.Ctx, mustConsAddr(suite, val1Addr), 10, power, d("0.1"))
+	suite.DelegationSharesEqual(val2Addr, moduleAccAddress, d("190000000"))
+
+	// every holder of the destination derivative shares the loss
+	shares, err := suite.Keeper.BurnDerivative(suite.Ctx, user2, val2Addr, c(derivative2.Denom, 50e6))
+	suite.Require().NoError(err)
+	suite.Equal(d("47500000"), shares)
+	suite.DelegationSharesEqual(val2Addr, moduleAccAddress, d("142500000"))
+
+	rate, err := suite.Keeper.GetDerivativeExchangeRate(suite.Ctx, derivative2.Denom)
+	suite.Require().NoError(err)
+	suite.Equal(d("0.95"), rate)
+
+	// new derivatives are minted at the same price
+	suite.CreateDelegation(val2Addr, user2, i(95e6))
+	minted, err := suite.Keeper.MintDerivative(suite.Ctx, user2, val2Addr, suite.NewBondCoin(i(95e6)))
+	suite.Require().NoError(err)
+	suite.Equal(c(derivative2.Denom, 100e6), minted)
+}
+
 func (suite *KeeperTestSuite) TestIsDerivativeDenom() {
 	_, addrs := app.GeneratePrivKeyAddressPairs(5)
 	valAccAddr1, delegator, valAccAddr2 := addrs[0], addrs[1], addrs[2]
@@ -5,7 +5,9 @@ import (
 	"fmt"
 
 	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"google.golang.org/grpc/codes"
@@ -68,6 +70,184 @@ func (s queryServer) TotalSupply(
 	}, nil
 }
 
+// Params returns the module params.
+func (s queryServer) Params(
+	goCtx context.Context,
+	req *types.QueryParamsRequest,
+) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryParamsResponse{
+		Params: s.keeper.GetParams(ctx),
+	}, nil
+}
+
+// BasketExchangeRate returns the supply, value, and exchange rate of the basket liquid staking token.
+func (s queryServer) BasketExchangeRate(
+	goCtx context.Context,
+	req *types.QueryBasketExchangeRateRequest,
+) (*types.QueryBasketExchangeRateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryBasketExchangeRateResponse{
+		Supply:       s.keeper.bankKeeper.GetSupply(ctx, types.BasketDenom),
+		Value:        s.keeper.GetBasketValue(ctx),
+		ExchangeRate: s.keeper.GetBasketExchangeRate(ctx),
+		Validators:   s.keeper.GetBasketValidators(ctx),
+	}, nil
+}
+
+// DerivativeExchangeRate returns the value of a derivative denom at the current or a past height.
+func (s queryServer) DerivativeExchangeRate(
+	goCtx context.Context,
+	req *types.QueryDerivativeExchangeRateRequest,
+) (*types.QueryDerivativeExchangeRateResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := types.ParseLiquidStakingTokenDenom(req.Denom); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid derivative denom: %s", err)
+	}
+	if req.Height < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "height must not be negative")
+	}
+
+	height := req.Height
+	var (
+		rate sdk.Dec
+		err  error
+	)
+	if height == 0 {
+		height = ctx.BlockHeight()
+		rate, err = s.keeper.GetDerivativeExchangeRate(ctx, req.Denom)
+	} else {
+		rate, err = s.keeper.GetDerivativeExchangeRateAtHeight(ctx, req.Denom, height)
+	}
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryDerivativeExchangeRateResponse{
+		Denom:        req.Denom,
+		Height:       height,
+		ExchangeRate: rate,
+	}, nil
+}
+
+// SlashEvents returns the recorded slash events of a derivative denom.
+func (s queryServer) SlashEvents(
+	goCtx context.Context,
+	req *types.QuerySlashEventsRequest,
+) (*types.QuerySlashEventsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := types.ParseLiquidStakingTokenDenom(req.Denom); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid derivative denom: %s", err)
+	}
+
+	var events types.SlashEvents
+	eventStore := prefix.NewStore(ctx.KVStore(s.keeper.storeKey), types.SlashEventsKey(req.Denom))
+
+	pageRes, err := query.Paginate(eventStore, req.Pagination, func(_ []byte, value []byte) error {
+		var event types.SlashEvent
+		if err := s.keeper.cdc.Unmarshal(value, &event); err != nil {
+			return err
+		}
+		events = append(events, event)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySlashEventsResponse{
+		SlashEvents: events,
+		Pagination:  pageRes,
+	}, nil
+}
+
+// UnbondingRecords returns the pending unbondings of a delegator started by undelegating derivatives.
+func (s queryServer) UnbondingRecords(
+	goCtx context.Context,
+	req *types.QueryUnbondingRecordsRequest,
+) (*types.QueryUnbondingRecordsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delegator, err := sdk.AccAddressFromBech32(req.Delegator)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid delegator address: %s", err)
+	}
+
+	var records types.UnbondingRecords
+	delegatorStore := prefix.NewStore(
+		ctx.KVStore(s.keeper.storeKey),
+		append(types.UnbondingRecordByDelegatorKeyPrefix, types.UnbondingRecordsByDelegatorKey(delegator)...),
+	)
+
+	pageRes, err := query.Paginate(delegatorStore, req.Pagination, func(_ []byte, value []byte) error {
+		record, found := s.keeper.GetUnbondingRecord(ctx, sdk.BigEndianToUint64(value))
+		if !found {
+			return types.ErrUnbondingRecordNotFound
+		}
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryUnbondingRecordsResponse{
+		UnbondingRecords: records,
+		Pagination:       pageRes,
+	}, nil
+}
+
+// UnbondingQueue returns the pending unbondings started by undelegating derivatives, in order of completion time.
+func (s queryServer) UnbondingQueue(
+	goCtx context.Context,
+	req *types.QueryUnbondingQueueRequest,
+) (*types.QueryUnbondingQueueResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var records types.UnbondingRecords
+	queueStore := prefix.NewStore(ctx.KVStore(s.keeper.storeKey), types.UnbondingQueueKeyPrefix)
+
+	pageRes, err := query.FilteredPaginate(queueStore, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		record, found := s.keeper.GetUnbondingRecord(ctx, sdk.BigEndianToUint64(value))
+		if !found {
+			return false, types.ErrUnbondingRecordNotFound
+		}
+
+		if req.EndTime != nil && record.CompletionTime.After(*req.EndTime) {
+			return false, nil
+		}
+
+		if accumulate {
+			records = append(records, record)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryUnbondingQueueResponse{
+		UnbondingRecords: records,
+		Pagination:       pageRes,
+	}, nil
+}
+
 func (s queryServer) getDelegatedBalance(ctx sdk.Context, delegator sdk.AccAddress) sdkmath.Int {
 	balance := sdk.ZeroDec()
 
@@ -3,10 +3,12 @@ package keeper_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/stretchr/testify/suite"
 
@@ -23,7 +25,11 @@ type grpcQueryTestSuite struct {
 
 func (suite *grpcQueryTestSuite) SetupTest() {
 	suite.KeeperTestSuite.SetupTest()
+	suite.SetupQueryClient()
+}
 
+// SetupQueryClient creates a query client that uses the suite's current context.
+func (suite *grpcQueryTestSuite) SetupQueryClient() {
 	queryHelper := baseapp.NewQueryServerTestHelper(suite.Ctx, suite.App.InterfaceRegistry())
 	types.RegisterQueryServer(queryHelper, keeper.NewQueryServerImpl(suite.Keeper))
 
@@ -290,3 +296,150 @@ func (suite *grpcQueryTestSuite) TestQueryTotalSupply() {
 		})
 	}
 }
+
+func (suite *grpcQueryTestSuite) TestQueryParams() {
+	res, err := suite.queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Equal(types.DefaultParams(), res.Params)
+}
+
+func (suite *grpcQueryTestSuite) TestQueryBasketExchangeRate() {
+	res, err := suite.queryClient.BasketExchangeRate(context.Background(), &types.QueryBasketExchangeRateRequest{})
+	suite.Require().NoError(err)
+	suite.Equal(&types.QueryBasketExchangeRateResponse{
+		Supply:       c(types.BasketDenom, 0),
+		Value:        suite.NewBondCoin(sdk.ZeroInt()),
+		ExchangeRate: sdk.OneDec(),
+		Validators:   nil,
+	}, res)
+
+	valAddrs := suite.setupBasket([]sdkmath.Int{i(1e9)}, []sdk.Dec{d("1")})
+	user := suite.CreateAccount(suite.NewBondCoins(i(1e9)), 5).GetAddress()
+	_, err = suite.Keeper.MintBasket(suite.Ctx, user, suite.NewBondCoin(i(100e6)))
+	suite.Require().NoError(err)
+	suite.AddCoinsToModule(types.BasketAccountName, suite.NewBondCoins(i(10e6)))
+
+	res, err = suite.queryClient.BasketExchangeRate(context.Background(), &types.QueryBasketExchangeRateRequest{})
+	suite.Require().NoError(err)
+	suite.Equal(&types.QueryBasketExchangeRateResponse{
+		Supply:       c(types.BasketDenom, 100e6),
+		Value:        suite.NewBondCoin(i(110e6)),
+		ExchangeRate: d("1.1"),
+		Validators:   types.BasketValidators{types.NewBasketValidator(valAddrs[0], d("1"))},
+	}, res)
+}
+
+func (suite *grpcQueryTestSuite) TestQueryDerivativeExchangeRate() {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	valAddr := sdk.ValAddress(addrs[0])
+	suite.CreateAccountWithAddress(addrs[0], suite.NewBondCoins(i(1e9)))
+	suite.CreateNewUnbondedValidator(valAddr, i(1e9))
+	staking.EndBlocker(suite.Ctx, suite.StakingKeeper)
+
+	denom := suite.Keeper.GetLiquidStakingTokenDenom(valAddr)
+	suite.Keeper.SetSlashEvent(suite.Ctx, types.NewSlashEvent(denom, valAddr, 10, suite.Ctx.BlockTime(), d("0.2"), d("1"), d("0.8")))
+
+	suite.Ctx = suite.Ctx.WithBlockHeight(30)
+	suite.SetupQueryClient()
+
+	res, err := suite.queryClient.DerivativeExchangeRate(context.Background(), &types.QueryDerivativeExchangeRateRequest{Denom: denom})
+	suite.Require().NoError(err)
+	suite.Equal(&types.QueryDerivativeExchangeRateResponse{Denom: denom, Height: 30, ExchangeRate: d("1")}, res)
+
+	res, err = suite.queryClient.DerivativeExchangeRate(context.Background(), &types.QueryDerivativeExchangeRateRequest{Denom: denom, Height: 9})
+	suite.Require().NoError(err)
+	suite.Equal(d("1"), res.ExchangeRate)
+
+	res, err = suite.queryClient.DerivativeExchangeRate(context.Background(), &types.QueryDerivativeExchangeRateRequest{Denom: denom, Height: 20})
+	suite.Require().NoError(err)
+	suite.Equal(d("0.8"), res.ExchangeRate)
+
+	_, err = suite.queryClient.DerivativeExchangeRate(context.Background(), &types.QueryDerivativeExchangeRateRequest{Denom: denom, Height: 31})
+	suite.Error(err)
+
+	_, err = suite.queryClient.DerivativeExchangeRate(context.Background(), &types.QueryDerivativeExchangeRateRequest{Denom: "ukava"})
+	suite.Error(err)
+}
+
+func (suite *grpcQueryTestSuite) TestQuerySlashEvents() {
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	val1Addr, val2Addr := sdk.ValAddress(addrs[0]), sdk.ValAddress(addrs[1])
+	denom1 := suite.Keeper.GetLiquidStakingTokenDenom(val1Addr)
+	denom2 := suite.Keeper.GetLiquidStakingTokenDenom(val2Addr)
+
+	events := types.SlashEvents{
+		types.NewSlashEvent(denom1, val1Addr, 5, suite.Ctx.BlockTime(), d("0.1"), d("1"), d("0.9")),
+		types.NewSlashEvent(denom1, val1Addr, 300, suite.Ctx.BlockTime(), d("0.5"), d("0.9"), d("0.45")),
+	}
+	for _, event := range events {
+		suite.Keeper.SetSlashEvent(suite.Ctx, event)
+	}
+	suite.Keeper.SetSlashEvent(suite.Ctx, types.NewSlashEvent(denom2, val2Addr, 7, suite.Ctx.BlockTime(), d("0.1"), d("1"), d("0.9")))
+
+	res, err := suite.queryClient.SlashEvents(context.Background(), &types.QuerySlashEventsRequest{Denom: denom1})
+	suite.Require().NoError(err)
+	suite.Equal(events, res.SlashEvents)
+
+	_, err = suite.queryClient.SlashEvents(context.Background(), &types.QuerySlashEventsRequest{Denom: "ukava"})
+	suite.Error(err)
+}
+
+func (suite *grpcQueryTestSuite) TestQueryUnbondingRecords() {
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	valAddr, user1, user2 := sdk.ValAddress(addrs[0]), addrs[1], addrs[2]
+	denom := suite.Keeper.GetLiquidStakingTokenDenom(valAddr)
+	completionTime := suite.Ctx.BlockTime().Add(time.Hour)
+
+	records := types.UnbondingRecords{
+		types.NewUnbondingRecord(1, user1, valAddr, c(denom, 10e6), suite.NewBondCoin(i(10e6)), 1, completionTime),
+		types.NewUnbondingRecord(3, user1, valAddr, c(denom, 30e6), suite.NewBondCoin(i(30e6)), 1, completionTime),
+	}
+	for _, record := range records {
+		suite.Keeper.SetUnbondingRecord(suite.Ctx, record)
+	}
+	suite.Keeper.SetUnbondingRecord(suite.Ctx, types.NewUnbondingRecord(2, user2, valAddr, c(denom, 20e6), suite.NewBondCoin(i(20e6)), 1, completionTime))
+
+	res, err := suite.queryClient.UnbondingRecords(context.Background(), &types.QueryUnbondingRecordsRequest{Delegator: user1.String()})
+	suite.Require().NoError(err)
+	suite.Equal(records, res.UnbondingRecords)
+
+	res, err = suite.queryClient.UnbondingRecords(context.Background(), &types.QueryUnbondingRecordsRequest{
+		Delegator:  user1.String(),
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	suite.Require().NoError(err)
+	suite.Equal(records[:1], res.UnbondingRecords)
+	suite.NotNil(res.Pagination.NextKey)
+
+	res, err = suite.queryClient.UnbondingRecords(context.Background(), &types.QueryUnbondingRecordsRequest{Delegator: sdk.AccAddress(addrs[0]).String()})
+	suite.Require().NoError(err)
+	suite.Empty(res.UnbondingRecords)
+
+	_, err = suite.queryClient.UnbondingRecords(context.Background(), &types.QueryUnbondingRecordsRequest{Delegator: "invalid"})
+	suite.Error(err)
+}
+
+func (suite *grpcQueryTestSuite) TestQueryUnbondingQueue() {
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	valAddr, user := sdk.ValAddress(addrs[0]), addrs[1]
+	denom := suite.Keeper.GetLiquidStakingTokenDenom(valAddr)
+	now := suite.Ctx.BlockTime()
+
+	records := types.UnbondingRecords{
+		types.NewUnbondingRecord(2, user, valAddr, c(denom, 20e6), suite.NewBondCoin(i(20e6)), 1, now.Add(time.Hour)),
+		types.NewUnbondingRecord(1, user, valAddr, c(denom, 10e6), suite.NewBondCoin(i(10e6)), 1, now.Add(2*time.Hour)),
+		types.NewUnbondingRecord(3, user, valAddr, c(denom, 30e6), suite.NewBondCoin(i(30e6)), 1, now.Add(3*time.Hour)),
+	}
+	for _, record := range records {
+		suite.Keeper.SetUnbondingRecord(suite.Ctx, record)
+	}
+
+	res, err := suite.queryClient.UnbondingQueue(context.Background(), &types.QueryUnbondingQueueRequest{})
+	suite.Require().NoError(err)
+	suite.Equal(records, res.UnbondingRecords)
+
+	endTime := now.Add(2 * time.Hour)
+	res, err = suite.queryClient.UnbondingQueue(context.Background(), &types.QueryUnbondingQueueRequest{EndTime: &endTime})
+	suite.Require().NoError(err)
+	suite.Equal(records[:2], res.UnbondingRecords)
+}
@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// Hooks wrapper struct for hooks
+type Hooks struct {
+	k Keeper
+}
+
+var _ stakingtypes.StakingHooks = Hooks{}
+
+// Hooks create new liquid hooks
+func (k Keeper) Hooks() Hooks { return Hooks{k} }
+
+// BeforeValidatorSlashed records the change in value of the slashed validator's derivative, and of the
+// derivatives of any validators the module has redelegated to from it.
+// The fraction is the portion of the validator's tokens that are about to be burned.
+//
+// The staking module has already slashed the validator's unbonding delegations when this runs, so the balances of
+// the validator's unbonding records are updated to match.
+func (h Hooks) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec) error {
+	if err := h.k.RecordValidatorSlash(ctx, valAddr, fraction); err != nil {
+		return err
+	}
+
+	h.k.SlashUnbondingRecords(ctx, valAddr)
+	return nil
+}
+
+// NOTE: following hooks are just implemented to ensure StakingHooks interface compliance
+
+// AfterValidatorCreated runs after a validator is created
+func (h Hooks) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress) error {
+	return nil
+}
+
+// BeforeValidatorModified runs before a validator is modified
+func (h Hooks) BeforeValidatorModified(ctx sdk.Context, valAddr sdk.ValAddress) error {
+	return nil
+}
+
+// AfterValidatorRemoved runs after a validator is removed
+func (h Hooks) AfterValidatorRemoved(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	return nil
+}
+
+// AfterValidatorBonded runs after a validator is bonded
+func (h Hooks) AfterValidatorBonded(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	return nil
+}
+
+// AfterValidatorBeginUnbonding runs after a validator begins unbonding
+func (h Hooks) AfterValidatorBeginUnbonding(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	return nil
+}
+
+// BeforeDelegationCreated runs before a delegation is created
+func (h Hooks) BeforeDelegationCreated(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return nil
+}
+
+// BeforeDelegationSharesModified runs before an existing delegation is modified
+func (h Hooks) BeforeDelegationSharesModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return nil
+}
+
+// BeforeDelegationRemoved runs directly before a delegation is deleted
+func (h Hooks) BeforeDelegationRemoved(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return nil
+}
+
+// AfterDelegationModified runs after a delegation is modified
+func (h Hooks) AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return nil
+}
+
+// AfterUnbondingInitiated is called when an unbonding operation
+// (validator unbonding, unbonding delegation, redelegation) was initiated
+func (h Hooks) AfterUnbondingInitiated(_ sdk.Context, _ uint64) error {
+	return nil
+}
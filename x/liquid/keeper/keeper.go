@@ -5,6 +5,7 @@ import (
 
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/kava-labs/kava/x/liquid/types"
@@ -12,40 +13,57 @@ import (
 
 // Keeper struct for the liquid module.
 type Keeper struct {
-	cdc codec.Codec
+	cdc      codec.Codec
+	storeKey storetypes.StoreKey
 
 	accountKeeper      types.AccountKeeper
 	bankKeeper         types.BankKeeper
 	stakingKeeper      types.StakingKeeper
 	distributionKeeper types.DistributionKeeper
+	slashingKeeper     types.SlashingKeeper
 
 	derivativeDenom string
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this should be the x/gov module account.
+	authority sdk.AccAddress
 }
 
 // NewKeeper returns a new keeper for the liquid module.
 func NewKeeper(
-	cdc codec.Codec,
+	cdc codec.Codec, storeKey storetypes.StoreKey,
 	ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper, dk types.DistributionKeeper,
-	derivativeDenom string,
+	slk types.SlashingKeeper, derivativeDenom string, authority sdk.AccAddress,
 ) Keeper {
+	if err := sdk.VerifyAddressFormat(authority); err != nil {
+		panic(err)
+	}
 
 	return Keeper{
 		cdc:                cdc,
+		storeKey:           storeKey,
 		accountKeeper:      ak,
 		bankKeeper:         bk,
 		stakingKeeper:      sk,
 		distributionKeeper: dk,
+		slashingKeeper:     slk,
 		derivativeDenom:    derivativeDenom,
+		authority:          authority,
 	}
 }
 
 // NewDefaultKeeper returns a new keeper for the liquid module with default values.
 func NewDefaultKeeper(
-	cdc codec.Codec,
+	cdc codec.Codec, storeKey storetypes.StoreKey,
 	ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper, dk types.DistributionKeeper,
+	slk types.SlashingKeeper, authority sdk.AccAddress,
 ) Keeper {
 
-	return NewKeeper(cdc, ak, bk, sk, dk, types.DefaultDerivativeDenom)
+	return NewKeeper(cdc, storeKey, ak, bk, sk, dk, slk, types.DefaultDerivativeDenom, authority)
+}
+
+// GetAuthority returns the x/liquid module's authority.
+func (k Keeper) GetAuthority() sdk.AccAddress {
+	return k.authority
 }
 
 // Logger returns a module-specific logger.
@@ -3,7 +3,9 @@ package keeper
 import (
 	"context"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/kava-labs/kava/x/liquid/types"
 )
@@ -82,3 +84,173 @@ func (k msgServer) BurnDerivative(goCtx context.Context, msg *types.MsgBurnDeriv
 		Received: sharesReceived,
 	}, nil
 }
+
+// RedelegateDerivative handles RedelegateDerivative msgs.
+func (k msgServer) RedelegateDerivative(goCtx context.Context, msg *types.MsgRedelegateDerivative) (*types.MsgRedelegateDerivativeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	validatorDst, err := sdk.ValAddressFromBech32(msg.ValidatorDst)
+	if err != nil {
+		return nil, err
+	}
+
+	received, completionTime, err := k.keeper.RedelegateDerivative(ctx, sender, validatorDst, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	)
+
+	return &types.MsgRedelegateDerivativeResponse{
+		Received:       received,
+		CompletionTime: completionTime,
+	}, nil
+}
+
+// UndelegateDerivative handles UndelegateDerivative msgs.
+func (k msgServer) UndelegateDerivative(goCtx context.Context, msg *types.MsgUndelegateDerivative) (*types.MsgUndelegateDerivativeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	validator, err := sdk.ValAddressFromBech32(msg.Validator)
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := k.keeper.UndelegateDerivative(ctx, sender, validator, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	)
+
+	return &types.MsgUndelegateDerivativeResponse{
+		UnbondingRecordID: record.ID,
+		Balance:           record.Balance,
+		CompletionTime:    record.CompletionTime,
+	}, nil
+}
+
+// MintBasket handles MintBasket msgs.
+func (k msgServer) MintBasket(goCtx context.Context, msg *types.MsgMintBasket) (*types.MsgMintBasketResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	received, err := k.keeper.MintBasket(ctx, sender, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	)
+
+	return &types.MsgMintBasketResponse{
+		Received: received,
+	}, nil
+}
+
+// BurnBasket handles BurnBasket msgs.
+func (k msgServer) BurnBasket(goCtx context.Context, msg *types.MsgBurnBasket) (*types.MsgBurnBasketResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	received, err := k.keeper.BurnBasket(ctx, sender, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	)
+
+	return &types.MsgBurnBasketResponse{
+		Received: received,
+	}, nil
+}
+
+// ConvertToBasket handles ConvertToBasket msgs.
+func (k msgServer) ConvertToBasket(goCtx context.Context, msg *types.MsgConvertToBasket) (*types.MsgConvertToBasketResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	received, err := k.keeper.ConvertToBasket(ctx, sender, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	)
+
+	return &types.MsgConvertToBasketResponse{
+		Received: received,
+	}, nil
+}
+
+// UpdateParams handles UpdateParams msgs.
+func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.keeper.GetAuthority().String() != msg.Authority {
+		return nil, errorsmod.Wrapf(
+			govtypes.ErrInvalidSigner,
+			"invalid authority; expected %s, got %s",
+			k.keeper.GetAuthority(),
+			msg.Authority,
+		)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidParams, err.Error())
+	}
+
+	k.keeper.SetParams(ctx, msg.Params)
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
@@ -0,0 +1,40 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/kava-labs/kava/x/liquid/keeper"
+	"github.com/kava-labs/kava/x/liquid/types"
+)
+
+func (suite *KeeperTestSuite) TestGetParams_Default() {
+	suite.Equal(types.DefaultParams(), suite.Keeper.GetParams(suite.Ctx))
+}
+
+func (suite *KeeperTestSuite) TestUpdateParams() {
+	msgServer := keeper.NewMsgServerImpl(suite.Keeper)
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+	params := types.NewParams(types.BASKET_WEIGHTING_STAKE, nil, 10, false, types.DefaultBasketCompoundInterval)
+
+	_, err := msgServer.UpdateParams(sdk.WrapSDKContext(suite.Ctx), &types.MsgUpdateParams{
+		Authority: authtypes.NewModuleAddress("not-gov").String(),
+		Params:    params,
+	})
+	suite.ErrorIs(err, govtypes.ErrInvalidSigner)
+	suite.Equal(types.DefaultParams(), suite.Keeper.GetParams(suite.Ctx))
+
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(suite.Ctx), &types.MsgUpdateParams{
+		Authority: govAddr.String(),
+		Params:    types.NewParams(types.BASKET_WEIGHTING_STAKE, nil, 0, false, types.DefaultBasketCompoundInterval),
+	})
+	suite.ErrorIs(err, types.ErrInvalidParams)
+
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(suite.Ctx), &types.MsgUpdateParams{
+		Authority: govAddr.String(),
+		Params:    params,
+	})
+	suite.Require().NoError(err)
+	suite.Equal(params, suite.Keeper.GetParams(suite.Ctx))
+}
@@ -0,0 +1,260 @@
+package keeper
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/kava-labs/kava/x/liquid/types"
+)
+
+// GetDerivativeExchangeRate returns the amount of staking tokens one derivative is currently worth.
+// Derivatives are normally backed 1:1 by the module's delegation shares. If a redelegation into the
+// validator has been slashed the module holds fewer shares than the derivative supply, and the value
+// of the shares is spread across all derivatives.
+func (k Keeper) GetDerivativeExchangeRate(ctx sdk.Context, denom string) (sdk.Dec, error) {
+	valAddr, err := types.ParseLiquidStakingTokenDenom(denom)
+	if err != nil {
+		return sdk.Dec{}, fmt.Errorf("invalid derivative denom: %w", err)
+	}
+
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.Dec{}, fmt.Errorf("invalid derivative denom %s: validator not found", denom)
+	}
+
+	return k.getDerivativeExchangeRate(ctx, validator, denom), nil
+}
+
+func (k Keeper) getDerivativeExchangeRate(ctx sdk.Context, validator stakingtypes.Validator, denom string) sdk.Dec {
+	if validator.DelegatorShares.IsZero() {
+		return sdk.ZeroDec()
+	}
+
+	return validator.TokensFromShares(k.getDerivativeBacking(ctx, validator.GetOperator(), denom))
+}
+
+// getDerivativeBacking returns the delegation shares backing each derivative, which is at most one.
+func (k Keeper) getDerivativeBacking(ctx sdk.Context, valAddr sdk.ValAddress, denom string) sdk.Dec {
+	supply := k.bankKeeper.GetSupply(ctx, denom).Amount
+	if supply.IsZero() {
+		return sdk.OneDec()
+	}
+
+	moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleAccountName)
+	delegation, found := k.stakingKeeper.GetDelegation(ctx, moduleAddr, valAddr)
+	if !found {
+		return sdk.ZeroDec()
+	}
+
+	backing := delegation.Shares.QuoInt(supply)
+	if backing.GT(sdk.OneDec()) {
+		return sdk.OneDec()
+	}
+
+	return backing
+}
+
+// getDerivativeShares returns the delegation shares backing an amount of derivatives.
+func (k Keeper) getDerivativeShares(ctx sdk.Context, valAddr sdk.ValAddress, amount sdk.Coin) sdk.Dec {
+	return sdk.NewDecFromInt(amount.Amount).MulTruncate(k.getDerivativeBacking(ctx, valAddr, amount.Denom))
+}
+
+// GetDerivativeExchangeRateAtHeight returns the amount of staking tokens one derivative was worth at
+// the end of a past block. Derivative value only changes when the validator is slashed, so it is found
+// from the recorded slash events.
+func (k Keeper) GetDerivativeExchangeRateAtHeight(ctx sdk.Context, denom string, height int64) (sdk.Dec, error) {
+	if height > ctx.BlockHeight() {
+		return sdk.Dec{}, fmt.Errorf("height %d is greater than current height %d", height, ctx.BlockHeight())
+	}
+
+	var (
+		last  *types.SlashEvent
+		after *types.SlashEvent
+	)
+	k.IterateSlashEvents(ctx, denom, func(event types.SlashEvent) bool {
+		if event.Height > height {
+			after = &event
+			return true
+		}
+		last = &event
+		return false
+	})
+
+	switch {
+	case last != nil:
+		return last.ExchangeRateAfter, nil
+	case after != nil:
+		return after.ExchangeRateBefore, nil
+	default:
+		return k.GetDerivativeExchangeRate(ctx, denom)
+	}
+}
+
+// RecordValidatorSlash records the change in value of a validator's derivative that a slash of the
+// validator's tokens by fraction will cause.
+// It also records the value lost by derivatives of validators that the module has redelegated to from
+// the slashed validator, as the staking module slashes those redelegations before the validator.
+func (k Keeper) RecordValidatorSlash(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec) error {
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return types.ErrNoValidatorFound
+	}
+
+	denom := k.GetLiquidStakingTokenDenom(valAddr)
+	rateBefore := k.getDerivativeExchangeRate(ctx, validator, denom)
+	rateAfter := rateBefore.Mul(sdk.OneDec().Sub(fraction))
+	k.recordSlashEvent(ctx, denom, valAddr, fraction, rateBefore, rateAfter)
+
+	moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleAccountName)
+	redelegations := k.stakingKeeper.GetRedelegations(ctx, moduleAddr, math.MaxUint16)
+	for _, redelegation := range redelegations {
+		if redelegation.ValidatorSrcAddress != valAddr.String() {
+			continue
+		}
+
+		dstAddr, err := sdk.ValAddressFromBech32(redelegation.ValidatorDstAddress)
+		if err != nil {
+			return err
+		}
+		dstValidator, found := k.stakingKeeper.GetValidator(ctx, dstAddr)
+		if !found {
+			continue
+		}
+
+		dstDenom := k.GetLiquidStakingTokenDenom(dstAddr)
+		dstRateAfter := k.getDerivativeExchangeRate(ctx, dstValidator, dstDenom)
+		dstRateBefore := k.getLastExchangeRate(ctx, dstValidator, dstDenom)
+		if dstRateAfter.Equal(dstRateBefore) {
+			continue
+		}
+		k.recordSlashEvent(ctx, dstDenom, dstAddr, sdk.ZeroDec(), dstRateBefore, dstRateAfter)
+	}
+
+	return nil
+}
+
+// getLastExchangeRate returns the exchange rate after the latest slash event of a derivative, or the
+// value of the validator's shares if it has none.
+func (k Keeper) getLastExchangeRate(ctx sdk.Context, validator stakingtypes.Validator, denom string) sdk.Dec {
+	var last *types.SlashEvent
+	k.IterateSlashEvents(ctx, denom, func(event types.SlashEvent) bool {
+		last = &event
+		return false
+	})
+	if last != nil {
+		return last.ExchangeRateAfter
+	}
+
+	if validator.DelegatorShares.IsZero() {
+		return sdk.ZeroDec()
+	}
+	return validator.TokensFromShares(sdk.OneDec())
+}
+
+// recordSlashEvent stores a slash event and emits an event for it. Slashes of the same denom in the
+// same block are combined into one slash event.
+func (k Keeper) recordSlashEvent(
+	ctx sdk.Context, denom string, valAddr sdk.ValAddress, fraction, rateBefore, rateAfter sdk.Dec,
+) {
+	event := types.NewSlashEvent(denom, valAddr, ctx.BlockHeight(), ctx.BlockTime(), fraction, rateBefore, rateAfter)
+
+	if existing, found := k.GetSlashEvent(ctx, denom, ctx.BlockHeight()); found {
+		remaining := sdk.OneDec().Sub(existing.SlashFraction).Mul(sdk.OneDec().Sub(fraction))
+		event.SlashFraction = sdk.OneDec().Sub(remaining)
+		event.ExchangeRateBefore = existing.ExchangeRateBefore
+	}
+
+	k.SetSlashEvent(ctx, event)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDerivativeSlashed,
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeySlashFraction, fraction.String()),
+			sdk.NewAttribute(types.AttributeKeyExchangeRate, rateAfter.String()),
+		),
+	)
+}
+
+// GetSlashEvent returns the slash event of a derivative denom at a height.
+func (k Keeper) GetSlashEvent(ctx sdk.Context, denom string, height int64) (types.SlashEvent, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.SlashEventKey(denom, height))
+	if bz == nil {
+		return types.SlashEvent{}, false
+	}
+
+	var event types.SlashEvent
+	k.cdc.MustUnmarshal(bz, &event)
+
+	return event, true
+}
+
+// SetSlashEvent stores a slash event.
+func (k Keeper) SetSlashEvent(ctx sdk.Context, event types.SlashEvent) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&event)
+	store.Set(types.SlashEventKey(event.Denom, event.Height), bz)
+}
+
+// IterateSlashEvents iterates over the slash events of a derivative denom in order of height.
+func (k Keeper) IterateSlashEvents(ctx sdk.Context, denom string, cb func(event types.SlashEvent) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SlashEventsKey(denom))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var event types.SlashEvent
+		k.cdc.MustUnmarshal(iterator.Value(), &event)
+		if cb(event) {
+			break
+		}
+	}
+}
+
+// GetAllSlashEvents returns the slash events of all derivative denoms.
+func (k Keeper) GetAllSlashEvents(ctx sdk.Context) types.SlashEvents {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SlashEventKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var events types.SlashEvents
+	for ; iterator.Valid(); iterator.Next() {
+		var event types.SlashEvent
+		k.cdc.MustUnmarshal(iterator.Value(), &event)
+		events = append(events, event)
+	}
+
+	return events
+}
+
+// IsDerivativeCollateralEnabled returns false if the denom is the derivative of a tombstoned validator
+// and governance has disabled tombstoned derivatives as collateral. It returns true for all other denoms.
+func (k Keeper) IsDerivativeCollateralEnabled(ctx sdk.Context, denom string) bool {
+	if !k.GetParams(ctx).DisableTombstonedCollateral {
+		return true
+	}
+
+	valAddr, err := types.ParseLiquidStakingTokenDenom(denom)
+	if err != nil {
+		return true
+	}
+
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return true
+	}
+
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return true
+	}
+
+	return !k.slashingKeeper.IsTombstoned(ctx, consAddr)
+}
@@ -0,0 +1,138 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/liquid/types"
+)
+
+func (suite *KeeperTestSuite) TestRecordValidatorSlash() {
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	valAccAddr, user := addrs[0], addrs[1]
+	valAddr := sdk.ValAddress(valAccAddr)
+
+	initialBalance := i(1e9)
+	suite.CreateAccountWithAddress(valAccAddr, suite.NewBondCoins(initialBalance))
+	suite.CreateAccountWithAddress(user, suite.NewBondCoins(initialBalance))
+	suite.CreateNewUnbondedValidator(valAddr, initialBalance)
+	staking.EndBlocker(suite.Ctx, suite.StakingKeeper)
+
+	suite.CreateDelegation(valAddr, user, i(100e6))
+	derivative, err := suite.Keeper.MintDerivative(suite.Ctx, user, valAddr, suite.NewBondCoin(i(100e6)))
+	suite.Require().NoError(err)
+
+	suite.Ctx = suite.Ctx.WithBlockHeight(10)
+	suite.SlashValidator(valAddr, d("0.1"))
+
+	event, found := suite.Keeper.GetSlashEvent(suite.Ctx, derivative.Denom, 10)
+	suite.Require().True(found)
+	suite.Equal(types.NewSlashEvent(derivative.Denom, valAddr, 10, suite.Ctx.BlockTime(), d("0.1"), d("1"), d("0.9")), event)
+
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeDerivativeSlashed,
+		sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyDenom, derivative.Denom),
+		sdk.NewAttribute(types.AttributeKeySlashFraction, d("0.1").String()),
+		sdk.NewAttribute(types.AttributeKeyExchangeRate, d("0.9").String()),
+	))
+
+	rate, err := suite.Keeper.GetDerivativeExchangeRate(suite.Ctx, derivative.Denom)
+	suite.Require().NoError(err)
+	suite.Equal(d("0.9"), rate)
+
+	suite.Run("slashes in the same block are combined", func() {
+		suite.SlashValidator(valAddr, d("0.5"))
+
+		event, found := suite.Keeper.GetSlashEvent(suite.Ctx, derivative.Denom, 10)
+		suite.Require().True(found)
+		suite.Equal(d("0.55"), event.SlashFraction)
+		suite.Equal(d("1"), event.ExchangeRateBefore)
+		suite.Equal(d("0.45"), event.ExchangeRateAfter)
+	})
+
+	suite.Run("exchange rate is found at past heights", func() {
+		suite.Ctx = suite.Ctx.WithBlockHeight(20)
+		suite.SlashValidator(valAddr, d("0.2"))
+
+		for height, expected := range map[int64]sdk.Dec{
+			5:  d("1"),
+			10: d("0.45"),
+			15: d("0.45"),
+			20: d("0.36"),
+		} {
+			rate, err := suite.Keeper.GetDerivativeExchangeRateAtHeight(suite.Ctx, derivative.Denom, height)
+			suite.Require().NoError(err)
+			suite.Equalf(expected, rate, "height %d", height)
+		}
+
+		_, err := suite.Keeper.GetDerivativeExchangeRateAtHeight(suite.Ctx, derivative.Denom, 21)
+		suite.Error(err)
+
+		suite.Len(suite.Keeper.GetAllSlashEvents(suite.Ctx), 2)
+	})
+}
+
+func (suite *KeeperTestSuite) TestRecordValidatorSlash_Redelegation() {
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	val1AccAddr, val2AccAddr, user := addrs[0], addrs[1], addrs[2]
+	val1Addr, val2Addr := sdk.ValAddress(val1AccAddr), sdk.ValAddress(val2AccAddr)
+	moduleAccAddress := authtypes.NewModuleAddress(types.ModuleAccountName)
+
+	initialBalance := i(1e9)
+	for _, addr := range addrs {
+		suite.CreateAccountWithAddress(addr, suite.NewBondCoins(initialBalance))
+	}
+	suite.CreateNewUnbondedValidator(val1Addr, initialBalance)
+	suite.CreateNewUnbondedValidator(val2Addr, initialBalance)
+	staking.EndBlocker(suite.Ctx, suite.StakingKeeper)
+
+	suite.Ctx = suite.Ctx.WithBlockHeight(10)
+	suite.CreateDelegation(val1Addr, user, i(100e6))
+	derivative1, err := suite.Keeper.MintDerivative(suite.Ctx, user, val1Addr, suite.NewBondCoin(i(100e6)))
+	suite.Require().NoError(err)
+	derivative2, _, err := suite.Keeper.RedelegateDerivative(suite.Ctx, user, val2Addr, c(derivative1.Denom, 40e6))
+	suite.Require().NoError(err)
+
+	// slash for an infraction at the height the redelegation was created, so the redelegation is slashed
+	suite.Ctx = suite.Ctx.WithBlockHeight(11)
+	validator, found := suite.StakingKeeper.GetValidator(suite.Ctx, val1Addr)
+	suite.Require().True(found)
+	power := suite.StakingKeeper.TokensToConsensusPower(suite.Ctx, validator.GetTokens())
+	suite.StakingKeeper.Slash(suite.Ctx, mustConsAddr(suite, val1Addr), 10, power, d("0.1"))
+
+	suite.DelegationSharesEqual(val2Addr, moduleAccAddress, d("36000000"))
+
+	event, found := suite.Keeper.GetSlashEvent(suite.Ctx, derivative2.Denom, 11)
+	suite.Require().True(found)
+	suite.Equal(types.NewSlashEvent(derivative2.Denom, val2Addr, 11, suite.Ctx.BlockTime(), d("0"), d("1"), d("0.9")), event)
+
+	value, err := suite.Keeper.GetStakedTokensForDerivatives(suite.Ctx, sdk.NewCoins(derivative2))
+	suite.Require().NoError(err)
+	suite.Equal(suite.NewBondCoin(i(36e6)), value)
+}
+
+func (suite *KeeperTestSuite) TestIsDerivativeCollateralEnabled() {
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	valAccAddr, user := addrs[0], addrs[1]
+	valAddr := sdk.ValAddress(valAccAddr)
+
+	initialBalance := i(1e9)
+	suite.CreateAccountWithAddress(valAccAddr, suite.NewBondCoins(initialBalance))
+	suite.CreateAccountWithAddress(user, suite.NewBondCoins(initialBalance))
+	suite.CreateNewUnbondedValidator(valAddr, initialBalance)
+	staking.EndBlocker(suite.Ctx, suite.StakingKeeper)
+
+	denom := suite.Keeper.GetLiquidStakingTokenDenom(valAddr)
+	suite.True(suite.Keeper.IsDerivativeCollateralEnabled(suite.Ctx, denom))
+
+	suite.App.GetSlashingKeeper().Tombstone(suite.Ctx, mustConsAddr(suite, valAddr))
+	suite.True(suite.Keeper.IsDerivativeCollateralEnabled(suite.Ctx, denom), "expected enabled when params allow tombstoned collateral")
+
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.BASKET_WEIGHTING_GOVERNANCE, nil, 0, true, types.DefaultBasketCompoundInterval))
+	suite.False(suite.Keeper.IsDerivativeCollateralEnabled(suite.Ctx, denom))
+	suite.True(suite.Keeper.IsDerivativeCollateralEnabled(suite.Ctx, "ukava"))
+	suite.True(suite.Keeper.IsDerivativeCollateralEnabled(suite.Ctx, suite.Keeper.GetLiquidStakingTokenDenom(sdk.ValAddress(user))))
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"math"
+
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -23,6 +25,12 @@ func (k Keeper) TransferDelegation(ctx sdk.Context, valAddr sdk.ValAddress, from
 		return sdk.Dec{}, types.ErrRedelegationsNotCompleted
 	}
 
+	return k.transferDelegation(ctx, valAddr, fromDelegator, toDelegator, shares)
+}
+
+// transferDelegation moves some delegation shares between addresses without checking the redelegations into the
+// sending delegation.
+func (k Keeper) transferDelegation(ctx sdk.Context, valAddr sdk.ValAddress, fromDelegator, toDelegator sdk.AccAddress, shares sdk.Dec) (sdk.Dec, error) {
 	if shares.IsNil() || shares.LT(sdk.ZeroDec()) {
 		return sdk.Dec{}, errorsmod.Wrap(types.ErrUntransferableShares, "nil or negative shares")
 	}
@@ -64,6 +72,42 @@ func (k Keeper) TransferDelegation(ctx sdk.Context, valAddr sdk.ValAddress, from
 	return receivedShares, nil
 }
 
+// checkReceivingRedelegations returns an error if removing shares from a delegation would leave fewer shares than the
+// delegator received from incomplete redelegations to the validator. This ensures slashes of the redelegations can
+// still be fully applied to the delegation.
+func (k Keeper) checkReceivingRedelegations(ctx sdk.Context, delegator sdk.AccAddress, valAddr sdk.ValAddress, shares sdk.Dec) error {
+	if !k.stakingKeeper.HasReceivingRedelegation(ctx, delegator, valAddr) {
+		return nil
+	}
+
+	delegation, found := k.stakingKeeper.GetDelegation(ctx, delegator, valAddr)
+	if !found {
+		return types.ErrNoDelegatorForAddress
+	}
+
+	if shares.IsNil() || delegation.Shares.Sub(shares).LT(k.getReceivingRedelegationShares(ctx, delegator, valAddr)) {
+		return types.ErrRedelegationsNotCompleted
+	}
+
+	return nil
+}
+
+// getReceivingRedelegationShares returns the total shares a delegator received from redelegations to a validator
+// that have not completed.
+func (k Keeper) getReceivingRedelegationShares(ctx sdk.Context, delegator sdk.AccAddress, valAddr sdk.ValAddress) sdk.Dec {
+	total := sdk.ZeroDec()
+	for _, redelegation := range k.stakingKeeper.GetRedelegations(ctx, delegator, math.MaxUint16) {
+		if redelegation.ValidatorDstAddress != valAddr.String() {
+			continue
+		}
+		for _, entry := range redelegation.Entries {
+			total = total.Add(entry.SharesDst)
+		}
+	}
+
+	return total
+}
+
 // isBelowMinSelfDelegation check if the supplied shares, converted to tokens, are under the validator's min_self_delegation.
 func isBelowMinSelfDelegation(validator stakingtypes.ValidatorI, shares sdk.Dec) bool {
 	return validator.TokensFromShares(shares).TruncateInt().LT(validator.GetMinSelfDelegation())
@@ -0,0 +1,246 @@
+package keeper
+
+import (
+	"strconv"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/liquid/types"
+)
+
+// UndelegateDerivative burns a user's staking derivative coins and starts unbonding the equivalent staking delegation.
+//
+// An unbonding record is stored so the pending withdrawal can be queried until the unbonding completes.
+func (k Keeper) UndelegateDerivative(
+	ctx sdk.Context, delegatorAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coin,
+) (types.UnbondingRecord, error) {
+	shares, err := k.BurnDerivative(ctx, delegatorAddr, valAddr, amount)
+	if err != nil {
+		return types.UnbondingRecord{}, err
+	}
+
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return types.UnbondingRecord{}, types.ErrNoValidatorFound
+	}
+	// this matches the amount the staking module removes from the validator when unbonding
+	balance := validator.TokensFromShares(shares).TruncateInt()
+
+	completionTime, err := k.stakingKeeper.Undelegate(ctx, delegatorAddr, valAddr, shares)
+	if err != nil {
+		return types.UnbondingRecord{}, err
+	}
+
+	id := k.GetNextUnbondingRecordID(ctx)
+	record := types.NewUnbondingRecord(
+		id, delegatorAddr, valAddr, amount, sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), balance),
+		ctx.BlockHeight(), completionTime,
+	)
+	k.SetUnbondingRecord(ctx, record)
+	k.SetNextUnbondingRecordID(ctx, id+1)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUndelegateDerivative,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delegatorAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyTokens, record.Balance.String()),
+			sdk.NewAttribute(types.AttributeKeyUnbondingRecordID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
+		),
+	)
+
+	return record, nil
+}
+
+// SlashUnbondingRecords reduces the balances of a validator's unbonding records to match the slashed balances of the
+// staking module's unbonding delegation entries. It must run after the staking module slashes the unbonding
+// delegations of the validator.
+func (k Keeper) SlashUnbondingRecords(ctx sdk.Context, valAddr sdk.ValAddress) {
+	k.IterateUnbondingRecordsByValidator(ctx, valAddr, func(record types.UnbondingRecord) bool {
+		unbonding, found := k.stakingKeeper.GetUnbondingDelegation(ctx, record.GetDelegator(), valAddr)
+		if !found {
+			return false
+		}
+
+		for _, entry := range unbonding.Entries {
+			if entry.CreationHeight != record.CreationHeight || !entry.CompletionTime.Equal(record.CompletionTime) {
+				continue
+			}
+			if !entry.InitialBalance.IsPositive() {
+				break
+			}
+
+			// Unbondings started in the same block are combined into one entry by the staking module,
+			// so the record receives its share of the entry's remaining balance.
+			balance := entry.Balance.Mul(record.InitialBalance.Amount).Quo(entry.InitialBalance)
+			if balance.LT(record.Balance.Amount) {
+				record.Balance.Amount = balance
+				k.SetUnbondingRecord(ctx, record)
+			}
+			break
+		}
+		return false
+	})
+}
+
+// CompleteUnbondingRecords removes the unbonding records that complete at or before the block time. The staking
+// module returns the unbonded tokens to the delegators in the same block.
+func (k Keeper) CompleteUnbondingRecords(ctx sdk.Context) {
+	var ids []uint64
+	k.IterateUnbondingQueue(ctx, ctx.BlockTime(), func(record types.UnbondingRecord) bool {
+		ids = append(ids, record.ID)
+		return false
+	})
+
+	for _, id := range ids {
+		record, found := k.GetUnbondingRecord(ctx, id)
+		if !found {
+			continue
+		}
+		k.DeleteUnbondingRecord(ctx, id)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCompleteUnbonding,
+				sdk.NewAttribute(types.AttributeKeyDelegator, record.Delegator),
+				sdk.NewAttribute(types.AttributeKeyValidator, record.ValidatorAddress),
+				sdk.NewAttribute(types.AttributeKeyUnbondingRecordID, strconv.FormatUint(id, 10)),
+			),
+		)
+	}
+}
+
+// GetUnbondingRecord returns an unbonding record by id.
+func (k Keeper) GetUnbondingRecord(ctx sdk.Context, id uint64) (types.UnbondingRecord, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.UnbondingRecordKeyPrefix)
+
+	bz := store.Get(types.UnbondingRecordKey(id))
+	if bz == nil {
+		return types.UnbondingRecord{}, false
+	}
+
+	var record types.UnbondingRecord
+	k.cdc.MustUnmarshal(bz, &record)
+
+	return record, true
+}
+
+// SetUnbondingRecord stores an unbonding record and adds it to the delegator and completion time indexes.
+func (k Keeper) SetUnbondingRecord(ctx sdk.Context, record types.UnbondingRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.UnbondingRecordKeyPrefix)
+	store.Set(types.UnbondingRecordKey(record.ID), k.cdc.MustMarshal(&record))
+
+	idBz := sdk.Uint64ToBigEndian(record.ID)
+
+	delegatorStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.UnbondingRecordByDelegatorKeyPrefix)
+	delegatorStore.Set(types.UnbondingRecordByDelegatorKey(record.GetDelegator(), record.ID), idBz)
+
+	validatorStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.UnbondingRecordByValidatorKeyPrefix)
+	validatorStore.Set(types.UnbondingRecordByValidatorKey(record.GetValidator(), record.ID), idBz)
+
+	queueStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.UnbondingQueueKeyPrefix)
+	queueStore.Set(types.UnbondingQueueKey(record.CompletionTime, record.ID), idBz)
+}
+
+// DeleteUnbondingRecord removes an unbonding record from the store, and any indexes.
+func (k Keeper) DeleteUnbondingRecord(ctx sdk.Context, id uint64) {
+	record, found := k.GetUnbondingRecord(ctx, id)
+	if !found {
+		return
+	}
+
+	delegatorStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.UnbondingRecordByDelegatorKeyPrefix)
+	delegatorStore.Delete(types.UnbondingRecordByDelegatorKey(record.GetDelegator(), id))
+
+	validatorStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.UnbondingRecordByValidatorKeyPrefix)
+	validatorStore.Delete(types.UnbondingRecordByValidatorKey(record.GetValidator(), id))
+
+	queueStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.UnbondingQueueKeyPrefix)
+	queueStore.Delete(types.UnbondingQueueKey(record.CompletionTime, id))
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.UnbondingRecordKeyPrefix)
+	store.Delete(types.UnbondingRecordKey(id))
+}
+
+// IterateUnbondingQueue iterates over the unbonding records that complete at or before the cutoff time, in order of
+// completion time.
+func (k Keeper) IterateUnbondingQueue(
+	ctx sdk.Context, inclusiveCutoffTime time.Time, cb func(record types.UnbondingRecord) (stop bool),
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.UnbondingQueueKeyPrefix)
+	iterator := store.Iterator(
+		nil, // start at the very start of the prefix store
+		sdk.PrefixEndBytes(sdk.FormatTimeBytes(inclusiveCutoffTime)), // include any keys with times equal to inclusiveCutoffTime
+	)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		record, found := k.GetUnbondingRecord(ctx, sdk.BigEndianToUint64(iterator.Value()))
+		if !found {
+			panic(errorsmod.Wrapf(types.ErrUnbondingRecordNotFound, "queue references missing record %x", iterator.Value()))
+		}
+
+		if cb(record) {
+			break
+		}
+	}
+}
+
+// IterateUnbondingRecordsByValidator iterates over the unbonding records of a validator in order of id.
+func (k Keeper) IterateUnbondingRecordsByValidator(
+	ctx sdk.Context, valAddr sdk.ValAddress, cb func(record types.UnbondingRecord) (stop bool),
+) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		append(types.UnbondingRecordByValidatorKeyPrefix, types.UnbondingRecordsByValidatorKey(valAddr)...),
+	)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		record, found := k.GetUnbondingRecord(ctx, sdk.BigEndianToUint64(iterator.Value()))
+		if !found {
+			panic(errorsmod.Wrapf(types.ErrUnbondingRecordNotFound, "validator index references missing record %x", iterator.Value()))
+		}
+
+		if cb(record) {
+			break
+		}
+	}
+}
+
+// GetAllUnbondingRecords returns all unbonding records in order of id.
+func (k Keeper) GetAllUnbondingRecords(ctx sdk.Context) types.UnbondingRecords {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.UnbondingRecordKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var records types.UnbondingRecords
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.UnbondingRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// GetNextUnbondingRecordID returns the id of the next unbonding record.
+func (k Keeper) GetNextUnbondingRecordID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.NextUnbondingRecordIDKey)
+	if bz == nil {
+		return types.DefaultNextUnbondingRecordID
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextUnbondingRecordID stores the id of the next unbonding record.
+func (k Keeper) SetNextUnbondingRecordID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.NextUnbondingRecordIDKey, sdk.Uint64ToBigEndian(id))
+}
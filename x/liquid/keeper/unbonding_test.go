@@ -0,0 +1,172 @@
+package keeper_test
+
+import (
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/liquid"
+	"github.com/kava-labs/kava/x/liquid/types"
+)
+
+func (suite *KeeperTestSuite) TestUndelegateDerivative() {
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	valAccAddr, user := addrs[0], addrs[1]
+	valAddr := sdk.ValAddress(valAccAddr)
+
+	initialBalance := i(1e9)
+	suite.CreateAccountWithAddress(valAccAddr, suite.NewBondCoins(initialBalance))
+	suite.CreateAccountWithAddress(user, suite.NewBondCoins(initialBalance))
+	suite.CreateNewUnbondedValidator(valAddr, initialBalance)
+	staking.EndBlocker(suite.Ctx, suite.StakingKeeper)
+
+	suite.CreateDelegation(valAddr, user, i(100e6))
+	derivative, err := suite.Keeper.MintDerivative(suite.Ctx, user, valAddr, suite.NewBondCoin(i(100e6)))
+	suite.Require().NoError(err)
+
+	suite.Ctx = suite.Ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+	record, err := suite.Keeper.UndelegateDerivative(suite.Ctx, user, valAddr, c(derivative.Denom, 40e6))
+	suite.Require().NoError(err)
+
+	completionTime := suite.Ctx.BlockTime().Add(suite.StakingKeeper.UnbondingTime(suite.Ctx))
+	expected := types.NewUnbondingRecord(
+		types.DefaultNextUnbondingRecordID, user, valAddr, c(derivative.Denom, 40e6), suite.NewBondCoin(i(40e6)),
+		10, completionTime,
+	)
+	suite.Equal(expected, record)
+
+	stored, found := suite.Keeper.GetUnbondingRecord(suite.Ctx, record.ID)
+	suite.Require().True(found)
+	suite.Equal(expected, stored)
+	suite.Equal(types.DefaultNextUnbondingRecordID+1, suite.Keeper.GetNextUnbondingRecordID(suite.Ctx))
+
+	unbonding, found := suite.StakingKeeper.GetUnbondingDelegation(suite.Ctx, user, valAddr)
+	suite.Require().True(found)
+	suite.Require().Len(unbonding.Entries, 1)
+	suite.Equal(i(40e6), unbonding.Entries[0].Balance)
+
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeUndelegateDerivative,
+		sdk.NewAttribute(types.AttributeKeyDelegator, user.String()),
+		sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, c(derivative.Denom, 40e6).String()),
+		sdk.NewAttribute(types.AttributeKeyTokens, suite.NewBondCoin(i(40e6)).String()),
+		sdk.NewAttribute(types.AttributeKeyUnbondingRecordID, strconv.FormatUint(record.ID, 10)),
+		sdk.NewAttribute(types.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
+	))
+
+	suite.Run("fails when derivatives cannot be burned", func() {
+		_, err := suite.Keeper.UndelegateDerivative(suite.Ctx, user, valAddr, c(derivative.Denom, 100e6))
+		suite.Error(err)
+		suite.Equal(types.DefaultNextUnbondingRecordID+1, suite.Keeper.GetNextUnbondingRecordID(suite.Ctx))
+	})
+}
+
+func (suite *KeeperTestSuite) TestCompleteUnbondingRecords() {
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	valAccAddr, user := addrs[0], addrs[1]
+	valAddr := sdk.ValAddress(valAccAddr)
+
+	initialBalance := i(1e9)
+	suite.CreateAccountWithAddress(valAccAddr, suite.NewBondCoins(initialBalance))
+	suite.CreateAccountWithAddress(user, suite.NewBondCoins(initialBalance))
+	suite.CreateNewUnbondedValidator(valAddr, initialBalance)
+	staking.EndBlocker(suite.Ctx, suite.StakingKeeper)
+
+	suite.CreateDelegation(valAddr, user, i(100e6))
+	derivative, err := suite.Keeper.MintDerivative(suite.Ctx, user, valAddr, suite.NewBondCoin(i(100e6)))
+	suite.Require().NoError(err)
+
+	first, err := suite.Keeper.UndelegateDerivative(suite.Ctx, user, valAddr, c(derivative.Denom, 10e6))
+	suite.Require().NoError(err)
+
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(time.Hour))
+	second, err := suite.Keeper.UndelegateDerivative(suite.Ctx, user, valAddr, c(derivative.Denom, 20e6))
+	suite.Require().NoError(err)
+
+	suite.Len(suite.Keeper.GetAllUnbondingRecords(suite.Ctx), 2)
+
+	// records are kept until they complete
+	suite.Ctx = suite.Ctx.WithBlockTime(first.CompletionTime.Add(-time.Second))
+	liquid.EndBlocker(suite.Ctx, suite.Keeper)
+	suite.Len(suite.Keeper.GetAllUnbondingRecords(suite.Ctx), 2)
+
+	suite.Ctx = suite.Ctx.WithBlockTime(first.CompletionTime).WithEventManager(sdk.NewEventManager())
+	liquid.EndBlocker(suite.Ctx, suite.Keeper)
+	suite.Equal(types.UnbondingRecords{second}, suite.Keeper.GetAllUnbondingRecords(suite.Ctx))
+
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeCompleteUnbonding,
+		sdk.NewAttribute(types.AttributeKeyDelegator, user.String()),
+		sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyUnbondingRecordID, strconv.FormatUint(first.ID, 10)),
+	))
+
+	var queued []uint64
+	suite.Keeper.IterateUnbondingQueue(suite.Ctx, second.CompletionTime, func(record types.UnbondingRecord) bool {
+		queued = append(queued, record.ID)
+		return false
+	})
+	suite.Equal([]uint64{second.ID}, queued)
+
+	suite.Ctx = suite.Ctx.WithBlockTime(second.CompletionTime.Add(time.Second))
+	liquid.EndBlocker(suite.Ctx, suite.Keeper)
+	suite.Empty(suite.Keeper.GetAllUnbondingRecords(suite.Ctx))
+}
+
+func (suite *KeeperTestSuite) TestSlashUnbondingRecords() {
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	valAccAddr, user := addrs[0], addrs[1]
+	valAddr := sdk.ValAddress(valAccAddr)
+
+	initialBalance := i(1e9)
+	suite.CreateAccountWithAddress(valAccAddr, suite.NewBondCoins(initialBalance))
+	suite.CreateAccountWithAddress(user, suite.NewBondCoins(initialBalance))
+	suite.CreateNewUnbondedValidator(valAddr, initialBalance)
+	staking.EndBlocker(suite.Ctx, suite.StakingKeeper)
+
+	suite.CreateDelegation(valAddr, user, i(100e6))
+	derivative, err := suite.Keeper.MintDerivative(suite.Ctx, user, valAddr, suite.NewBondCoin(i(100e6)))
+	suite.Require().NoError(err)
+
+	suite.Ctx = suite.Ctx.WithBlockHeight(5)
+	beforeInfraction, err := suite.Keeper.UndelegateDerivative(suite.Ctx, user, valAddr, c(derivative.Denom, 10e6))
+	suite.Require().NoError(err)
+
+	// unbondings in the same block are combined into one staking entry
+	suite.Ctx = suite.Ctx.WithBlockHeight(10)
+	first, err := suite.Keeper.UndelegateDerivative(suite.Ctx, user, valAddr, c(derivative.Denom, 30e6))
+	suite.Require().NoError(err)
+	second, err := suite.Keeper.UndelegateDerivative(suite.Ctx, user, valAddr, c(derivative.Denom, 10e6))
+	suite.Require().NoError(err)
+
+	suite.Ctx = suite.Ctx.WithBlockHeight(20)
+	validator, found := suite.StakingKeeper.GetValidator(suite.Ctx, valAddr)
+	suite.Require().True(found)
+	consAddr, err := validator.GetConsAddr()
+	suite.Require().NoError(err)
+	power := suite.StakingKeeper.TokensToConsensusPower(suite.Ctx, validator.GetTokens())
+	suite.StakingKeeper.Slash(suite.Ctx, consAddr, 10, power, d("0.1"))
+
+	for _, tc := range []struct {
+		record   types.UnbondingRecord
+		expected sdk.Coin
+	}{
+		{beforeInfraction, suite.NewBondCoin(i(10e6))},
+		{first, suite.NewBondCoin(i(27e6))},
+		{second, suite.NewBondCoin(i(9e6))},
+	} {
+		stored, found := suite.Keeper.GetUnbondingRecord(suite.Ctx, tc.record.ID)
+		suite.Require().True(found)
+		suite.Equal(tc.expected, stored.Balance)
+		suite.Equal(tc.record.InitialBalance, stored.InitialBalance)
+	}
+
+	unbonding, found := suite.StakingKeeper.GetUnbondingDelegation(suite.Ctx, user, valAddr)
+	suite.Require().True(found)
+	suite.Require().Len(unbonding.Entries, 2)
+	suite.Equal(i(36e6), unbonding.Entries[1].Balance)
+}
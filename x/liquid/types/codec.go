@@ -13,6 +13,12 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgMintDerivative{}, "liquid/MsgMintDerivative", nil)
 	cdc.RegisterConcrete(&MsgBurnDerivative{}, "liquid/MsgBurnDerivative", nil)
+	cdc.RegisterConcrete(&MsgRedelegateDerivative{}, "liquid/MsgRedelegateDerivative", nil)
+	cdc.RegisterConcrete(&MsgUndelegateDerivative{}, "liquid/MsgUndelegateDerivative", nil)
+	cdc.RegisterConcrete(&MsgMintBasket{}, "liquid/MsgMintBasket", nil)
+	cdc.RegisterConcrete(&MsgBurnBasket{}, "liquid/MsgBurnBasket", nil)
+	cdc.RegisterConcrete(&MsgConvertToBasket{}, "liquid/MsgConvertToBasket", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "liquid/MsgUpdateParams", nil)
 }
 
 // RegisterInterfaces registers proto messages under their interfaces for unmarshalling,
@@ -21,6 +27,12 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgMintDerivative{},
 		&MsgBurnDerivative{},
+		&MsgRedelegateDerivative{},
+		&MsgUndelegateDerivative{},
+		&MsgMintBasket{},
+		&MsgBurnBasket{},
+		&MsgConvertToBasket{},
+		&MsgUpdateParams{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -10,4 +10,8 @@ var (
 	ErrRedelegationsNotCompleted  = errorsmod.Register(ModuleName, 6, "active redelegations cannot be transferred")
 	ErrUntransferableShares       = errorsmod.Register(ModuleName, 7, "shares cannot be transferred")
 	ErrSelfDelegationBelowMinimum = errorsmod.Register(ModuleName, 8, "validator's self delegation must be greater than their minimum self delegation")
+	ErrInvalidParams              = errorsmod.Register(ModuleName, 9, "invalid params")
+	ErrEmptyBasket                = errorsmod.Register(ModuleName, 10, "basket has no validators")
+	ErrBasketAmountTooSmall       = errorsmod.Register(ModuleName, 11, "amount too small to convert")
+	ErrUnbondingRecordNotFound    = errorsmod.Register(ModuleName, 12, "unbonding record not found")
 )
@@ -1,11 +1,27 @@
 package types
 
 const (
-	EventTypeMintDerivative = "mint_derivative"
-	EventTypeBurnDerivative = "burn_derivative"
+	EventTypeMintDerivative       = "mint_derivative"
+	EventTypeBurnDerivative       = "burn_derivative"
+	EventTypeRedelegateDerivative = "redelegate_derivative"
+	EventTypeMintBasket           = "mint_basket"
+	EventTypeBurnBasket           = "burn_basket"
+	EventTypeCompoundBasket       = "compound_basket"
+	EventTypeDerivativeSlashed    = "derivative_slashed"
+	EventTypeUndelegateDerivative = "undelegate_derivative"
+	EventTypeCompleteUnbonding    = "complete_derivative_unbonding"
 
 	AttributeValueCategory        = ModuleName
 	AttributeKeyDelegator         = "delegator"
 	AttributeKeyValidator         = "validator"
 	AttributeKeySharesTransferred = "shares_transferred"
+	AttributeKeyTokens            = "tokens"
+	AttributeKeySourceValidator   = "source_validator"
+	AttributeKeyDestValidator     = "destination_validator"
+	AttributeKeyReceived          = "received"
+	AttributeKeyCompletionTime    = "completion_time"
+	AttributeKeyDenom             = "denom"
+	AttributeKeySlashFraction     = "slash_fraction"
+	AttributeKeyExchangeRate      = "exchange_rate"
+	AttributeKeyUnbondingRecordID = "unbonding_record_id"
 )
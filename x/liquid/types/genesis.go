@@ -0,0 +1,51 @@
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+// NewGenesisState returns a new genesis state object
+func NewGenesisState(
+	params Params,
+	slashEvents SlashEvents,
+	unbondingRecords UnbondingRecords,
+	nextUnbondingRecordID uint64,
+	lastBasketCompoundTime time.Time,
+) GenesisState {
+	return GenesisState{
+		Params:                 params,
+		SlashEvents:            slashEvents,
+		UnbondingRecords:       unbondingRecords,
+		NextUnbondingRecordID:  nextUnbondingRecordID,
+		LastBasketCompoundTime: lastBasketCompoundTime,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state for the module.
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(DefaultParams(), nil, nil, DefaultNextUnbondingRecordID, time.Time{})
+}
+
+// Validate performs basic validation of genesis data.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return fmt.Errorf("invalid params: %w", err)
+	}
+
+	if err := gs.SlashEvents.Validate(); err != nil {
+		return fmt.Errorf("invalid slash events: %w", err)
+	}
+
+	if err := gs.UnbondingRecords.Validate(); err != nil {
+		return fmt.Errorf("invalid unbonding records: %w", err)
+	}
+
+	for _, record := range gs.UnbondingRecords {
+		if record.ID >= gs.NextUnbondingRecordID {
+			return fmt.Errorf("unbonding record id %d must be less than the next unbonding record id %d", record.ID, gs.NextUnbondingRecordID)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,554 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: kava/liquid/v1beta1/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the liquid module's genesis state.
+type GenesisState struct {
+	// params defines all the parameters of the module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// slash_events are the recorded slashes of derivative denoms.
+	SlashEvents SlashEvents `protobuf:"bytes,2,rep,name=slash_events,json=slashEvents,proto3,castrepeated=SlashEvents" json:"slash_events"`
+	// unbonding_records are the unbondings started by undelegating derivatives that have not completed.
+	UnbondingRecords UnbondingRecords `protobuf:"bytes,3,rep,name=unbonding_records,json=unbondingRecords,proto3,castrepeated=UnbondingRecords" json:"unbonding_records"`
+	// next_unbonding_record_id is the id of the next unbonding record.
+	NextUnbondingRecordID uint64 `protobuf:"varint,4,opt,name=next_unbonding_record_id,json=nextUnbondingRecordId,proto3" json:"next_unbonding_record_id,omitempty"`
+	// last_basket_compound_time is the block time the basket staking rewards were last compounded.
+	LastBasketCompoundTime time.Time `protobuf:"bytes,5,opt,name=last_basket_compound_time,json=lastBasketCompoundTime,proto3,stdtime" json:"last_basket_compound_time"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52a1b41165d7aa5e, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetSlashEvents() SlashEvents {
+	if m != nil {
+		return m.SlashEvents
+	}
+	return nil
+}
+
+func (m *GenesisState) GetUnbondingRecords() UnbondingRecords {
+	if m != nil {
+		return m.UnbondingRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextUnbondingRecordID() uint64 {
+	if m != nil {
+		return m.NextUnbondingRecordID
+	}
+	return 0
+}
+
+func (m *GenesisState) GetLastBasketCompoundTime() time.Time {
+	if m != nil {
+		return m.LastBasketCompoundTime
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "kava.liquid.v1beta1.GenesisState")
+}
+
+func init() { proto.RegisterFile("kava/liquid/v1beta1/genesis.proto", fileDescriptor_52a1b41165d7aa5e) }
+
+var fileDescriptor_52a1b41165d7aa5e = []byte{
+	// 421 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x4f, 0x8f, 0x94, 0x30,
+	0x18, 0x87, 0xc1, 0x19, 0x37, 0x06, 0xf6, 0xb0, 0xb2, 0xae, 0x61, 0xc7, 0x04, 0xf0, 0xcf, 0x81,
+	0x8b, 0x6d, 0x76, 0x3d, 0x79, 0xad, 0x1a, 0xe3, 0xc5, 0x18, 0x56, 0x63, 0xe2, 0xa5, 0x29, 0x43,
+	0x65, 0xc8, 0x40, 0x8b, 0xb4, 0x4c, 0xc6, 0x6f, 0x31, 0x9f, 0xc3, 0xcf, 0xe1, 0x61, 0x8e, 0x73,
+	0xf4, 0x34, 0x63, 0x98, 0x2f, 0x62, 0x5a, 0xc0, 0x49, 0x08, 0xb7, 0xf6, 0xed, 0xc3, 0xf3, 0x6b,
+	0x5f, 0x5e, 0xeb, 0xe9, 0x92, 0xac, 0x08, 0xcc, 0xb3, 0x1f, 0x75, 0x96, 0xc0, 0xd5, 0x4d, 0x4c,
+	0x25, 0xb9, 0x81, 0x29, 0x65, 0x54, 0x64, 0x02, 0x94, 0x15, 0x97, 0xdc, 0xb9, 0x54, 0x08, 0x68,
+	0x11, 0xd0, 0x21, 0xb3, 0x47, 0x29, 0x4f, 0xb9, 0x3e, 0x87, 0x6a, 0xd5, 0xa2, 0x33, 0x3f, 0xe5,
+	0x3c, 0xcd, 0x29, 0xd4, 0xbb, 0xb8, 0xfe, 0x0e, 0x65, 0x56, 0x50, 0x21, 0x49, 0x51, 0x76, 0x40,
+	0x30, 0x16, 0x57, 0x92, 0x8a, 0x14, 0xa2, 0x57, 0x8c, 0x11, 0x22, 0x27, 0x62, 0xd1, 0x01, 0xcf,
+	0xc7, 0x80, 0x9a, 0xc5, 0x9c, 0x25, 0x19, 0x4b, 0x5b, 0xe8, 0xd9, 0xef, 0x89, 0x75, 0xfe, 0xbe,
+	0x7d, 0xc5, 0x9d, 0x24, 0x92, 0x3a, 0xaf, 0xad, 0xb3, 0x36, 0xc6, 0x35, 0x03, 0x33, 0xb4, 0x6f,
+	0x9f, 0x80, 0x91, 0x57, 0x81, 0x4f, 0x1a, 0x41, 0xd3, 0xed, 0xde, 0x37, 0xa2, 0xee, 0x03, 0xe7,
+	0xab, 0x75, 0xae, 0xf3, 0x31, 0x5d, 0x51, 0x26, 0x85, 0x7b, 0x2f, 0x98, 0x84, 0xf6, 0xad, 0x3f,
+	0x2a, 0xb8, 0x53, 0xe0, 0x3b, 0xc5, 0xa1, 0x4b, 0x25, 0xf9, 0x75, 0xf0, 0xed, 0x53, 0x4d, 0x44,
+	0xb6, 0x38, 0x6d, 0x9c, 0xa5, 0xf5, 0xf0, 0xff, 0xbd, 0x71, 0x45, 0xe7, 0xbc, 0x4a, 0x84, 0x3b,
+	0xd1, 0xf6, 0x17, 0xa3, 0xf6, 0x2f, 0x3d, 0x1d, 0x69, 0x18, 0xb9, 0x5d, 0xc4, 0xc5, 0xe0, 0x40,
+	0x44, 0x17, 0xf5, 0xa0, 0xe2, 0x44, 0x96, 0xcb, 0xe8, 0x5a, 0xe2, 0x61, 0x22, 0xce, 0x12, 0x77,
+	0x1a, 0x98, 0xe1, 0x14, 0x5d, 0x37, 0x7b, 0xff, 0xea, 0x23, 0x5d, 0xcb, 0x81, 0xed, 0xc3, 0xdb,
+	0xe8, 0x8a, 0x8d, 0x94, 0x13, 0x07, 0x5b, 0xd7, 0x39, 0x11, 0x12, 0xc7, 0x44, 0x2c, 0xa9, 0xc4,
+	0x73, 0x5e, 0x94, 0xbc, 0x66, 0x09, 0x56, 0x7f, 0xdd, 0xbd, 0xaf, 0xfb, 0x3c, 0x03, 0xed, 0x48,
+	0x80, 0x7e, 0x24, 0xc0, 0xe7, 0x7e, 0x24, 0xd0, 0x03, 0x75, 0xfd, 0xcd, 0xc1, 0x37, 0xa3, 0xc7,
+	0x4a, 0x83, 0xb4, 0xe5, 0x4d, 0x27, 0x51, 0x18, 0x42, 0xdb, 0xc6, 0x33, 0x77, 0x8d, 0x67, 0xfe,
+	0x6d, 0x3c, 0x73, 0x73, 0xf4, 0x8c, 0xdd, 0xd1, 0x33, 0xfe, 0x1c, 0x3d, 0xe3, 0x5b, 0x98, 0x66,
+	0x72, 0x51, 0xc7, 0x60, 0xce, 0x0b, 0xa8, 0x5a, 0xf5, 0x32, 0x27, 0xb1, 0xd0, 0x2b, 0xb8, 0xee,
+	0x87, 0x43, 0xfe, 0x2c, 0xa9, 0x88, 0xcf, 0x74, 0xf2, 0xab, 0x7f, 0x03, 0x00, 0xf1, 0x3e, 0x09,
+	0x53, 0xea, 0x02, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastBasketCompoundTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastBasketCompoundTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintGenesis(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	if m.NextUnbondingRecordID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextUnbondingRecordID))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.UnbondingRecords) > 0 {
+		for iNdEx := len(m.UnbondingRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbondingRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.SlashEvents) > 0 {
+		for iNdEx := len(m.SlashEvents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashEvents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.SlashEvents) > 0 {
+		for _, e := range m.SlashEvents {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UnbondingRecords) > 0 {
+		for _, e := range m.UnbondingRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextUnbondingRecordID != 0 {
+		n += 1 + sovGenesis(uint64(m.NextUnbondingRecordID))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastBasketCompoundTime)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashEvents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashEvents = append(m.SlashEvents, SlashEvent{})
+			if err := m.SlashEvents[len(m.SlashEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingRecords = append(m.UnbondingRecords, UnbondingRecord{})
+			if err := m.UnbondingRecords[len(m.UnbondingRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextUnbondingRecordID", wireType)
+			}
+			m.NextUnbondingRecordID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextUnbondingRecordID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastBasketCompoundTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.LastBasketCompoundTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
@@ -3,25 +3,68 @@ package types
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
 	// ModuleName The name that will be used throughout the module
 	ModuleName = "liquid"
 
+	// StoreKey Top level store key where all module items will be stored
+	StoreKey = ModuleName
+
 	// RouterKey Top level router key
 	RouterKey = ModuleName
 
 	// ModuleAccountName is the module account's name
 	ModuleAccountName = ModuleName
 
+	// BasketAccountName is the name of the module account that owns the
+	// delegations backing the basket liquid staking token
+	BasketAccountName = "liquid_basket"
+
 	DefaultDerivativeDenom = "bkava"
 
+	// BasketDenom is the denom of the basket liquid staking token. It does not
+	// use the derivative denom prefix, so it is never treated as a per-validator
+	// derivative.
+	BasketDenom = "stkava"
+
 	DenomSeparator = "-"
 )
 
+var (
+	// ParamsKey is the key for the module params
+	ParamsKey = []byte{0x01}
+	// SlashEventKeyPrefix is the prefix for slash events, keyed by derivative denom and height
+	SlashEventKeyPrefix = []byte{0x02}
+	// UnbondingRecordKeyPrefix is the prefix for unbonding records, keyed by id
+	UnbondingRecordKeyPrefix = []byte{0x03}
+	// UnbondingRecordByDelegatorKeyPrefix is the prefix for the index of unbonding records by delegator
+	UnbondingRecordByDelegatorKeyPrefix = []byte{0x04}
+	// UnbondingQueueKeyPrefix is the prefix for the index of unbonding records by completion time
+	UnbondingQueueKeyPrefix = []byte{0x05}
+	// NextUnbondingRecordIDKey is the key for the next unbonding record id
+	NextUnbondingRecordIDKey = []byte{0x06}
+	// LastBasketCompoundTimeKey is the key for the block time the basket rewards were last compounded
+	LastBasketCompoundTimeKey = []byte{0x07}
+	// UnbondingRecordByValidatorKeyPrefix is the prefix for the index of unbonding records by validator
+	UnbondingRecordByValidatorKeyPrefix = []byte{0x08}
+)
+
+// SlashEventsKey returns the key prefix for all slash events of a derivative denom.
+func SlashEventsKey(denom string) []byte {
+	return append(SlashEventKeyPrefix, address.MustLengthPrefix([]byte(denom))...)
+}
+
+// SlashEventKey returns the key for the slash event of a derivative denom at a height.
+func SlashEventKey(denom string, height int64) []byte {
+	return append(SlashEventsKey(denom), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
 func GetLiquidStakingTokenDenom(bondDenom string, valAddr sdk.ValAddress) string {
 	return fmt.Sprintf("%s%s%s", bondDenom, DenomSeparator, valAddr.String())
 }
@@ -44,3 +87,33 @@ func ParseLiquidStakingTokenDenom(denom string) (sdk.ValAddress, error) {
 
 	return addr, nil
 }
+
+// UnbondingRecordKey returns the key for an unbonding record.
+func UnbondingRecordKey(id uint64) []byte {
+	return sdk.Uint64ToBigEndian(id)
+}
+
+// UnbondingRecordsByDelegatorKey returns the key prefix for the unbonding records of a delegator.
+func UnbondingRecordsByDelegatorKey(delegator sdk.AccAddress) []byte {
+	return address.MustLengthPrefix(delegator)
+}
+
+// UnbondingRecordByDelegatorKey returns the key for an unbonding record in the delegator index.
+func UnbondingRecordByDelegatorKey(delegator sdk.AccAddress, id uint64) []byte {
+	return append(UnbondingRecordsByDelegatorKey(delegator), sdk.Uint64ToBigEndian(id)...)
+}
+
+// UnbondingRecordsByValidatorKey returns the key prefix for the unbonding records of a validator.
+func UnbondingRecordsByValidatorKey(valAddr sdk.ValAddress) []byte {
+	return address.MustLengthPrefix(valAddr)
+}
+
+// UnbondingRecordByValidatorKey returns the key for an unbonding record in the validator index.
+func UnbondingRecordByValidatorKey(valAddr sdk.ValAddress, id uint64) []byte {
+	return append(UnbondingRecordsByValidatorKey(valAddr), sdk.Uint64ToBigEndian(id)...)
+}
+
+// UnbondingQueueKey returns the key for an unbonding record in the completion time index.
+func UnbondingQueueKey(completionTime time.Time, id uint64) []byte {
+	return append(sdk.FormatTimeBytes(completionTime), sdk.Uint64ToBigEndian(id)...)
+}
@@ -12,6 +12,16 @@ const (
 	TypeMsgMintDerivative = "mint_derivative"
 	// TypeMsgBurnDerivative represents the type string for MsgBurnDerivative
 	TypeMsgBurnDerivative = "burn_derivative"
+	// TypeMsgRedelegateDerivative represents the type string for MsgRedelegateDerivative
+	TypeMsgRedelegateDerivative = "redelegate_derivative"
+	// TypeMsgUndelegateDerivative represents the type string for MsgUndelegateDerivative
+	TypeMsgUndelegateDerivative = "undelegate_derivative"
+	// TypeMsgMintBasket represents the type string for MsgMintBasket
+	TypeMsgMintBasket = "mint_basket"
+	// TypeMsgBurnBasket represents the type string for MsgBurnBasket
+	TypeMsgBurnBasket = "burn_basket"
+	// TypeMsgConvertToBasket represents the type string for MsgConvertToBasket
+	TypeMsgConvertToBasket = "convert_to_basket"
 )
 
 // ensure Msg interface compliance at compile time
@@ -20,6 +30,18 @@ var (
 	_ legacytx.LegacyMsg = &MsgMintDerivative{}
 	_ sdk.Msg            = &MsgBurnDerivative{}
 	_ legacytx.LegacyMsg = &MsgBurnDerivative{}
+	_ sdk.Msg            = &MsgRedelegateDerivative{}
+	_ legacytx.LegacyMsg = &MsgRedelegateDerivative{}
+	_ sdk.Msg            = &MsgUndelegateDerivative{}
+	_ legacytx.LegacyMsg = &MsgUndelegateDerivative{}
+	_ sdk.Msg            = &MsgMintBasket{}
+	_ legacytx.LegacyMsg = &MsgMintBasket{}
+	_ sdk.Msg            = &MsgBurnBasket{}
+	_ legacytx.LegacyMsg = &MsgBurnBasket{}
+	_ sdk.Msg            = &MsgConvertToBasket{}
+	_ legacytx.LegacyMsg = &MsgConvertToBasket{}
+	_ sdk.Msg            = &MsgUpdateParams{}
+	_ legacytx.LegacyMsg = &MsgUpdateParams{}
 )
 
 // NewMsgMintDerivative returns a new MsgMintDerivative
@@ -119,3 +141,290 @@ func (msg MsgBurnDerivative) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+// NewMsgRedelegateDerivative returns a new MsgRedelegateDerivative
+func NewMsgRedelegateDerivative(sender sdk.AccAddress, validatorDst sdk.ValAddress, amount sdk.Coin) MsgRedelegateDerivative {
+	return MsgRedelegateDerivative{
+		Sender:       sender.String(),
+		ValidatorDst: validatorDst.String(),
+		Amount:       amount,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgRedelegateDerivative) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgRedelegateDerivative) Type() string { return TypeMsgRedelegateDerivative }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgRedelegateDerivative) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	validatorDst, err := sdk.ValAddressFromBech32(msg.ValidatorDst)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if msg.Amount.IsNil() || !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "'%s'", msg.Amount)
+	}
+
+	validatorSrc, err := ParseLiquidStakingTokenDenom(msg.Amount.Denom)
+	if err != nil {
+		return errorsmod.Wrap(ErrInvalidDenom, err.Error())
+	}
+
+	if validatorSrc.Equals(validatorDst) {
+		return errorsmod.Wrap(ErrInvalidDenom, "derivative denom must not be for the destination validator")
+	}
+
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgRedelegateDerivative) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgRedelegateDerivative) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgUndelegateDerivative returns a new MsgUndelegateDerivative
+func NewMsgUndelegateDerivative(sender sdk.AccAddress, validator sdk.ValAddress, amount sdk.Coin) MsgUndelegateDerivative {
+	return MsgUndelegateDerivative{
+		Sender:    sender.String(),
+		Validator: validator.String(),
+		Amount:    amount,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgUndelegateDerivative) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgUndelegateDerivative) Type() string { return TypeMsgUndelegateDerivative }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgUndelegateDerivative) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	_, err = sdk.ValAddressFromBech32(msg.Validator)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if msg.Amount.IsNil() || !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "'%s'", msg.Amount)
+	}
+
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgUndelegateDerivative) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgUndelegateDerivative) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgMintBasket returns a new MsgMintBasket
+func NewMsgMintBasket(sender sdk.AccAddress, amount sdk.Coin) MsgMintBasket {
+	return MsgMintBasket{
+		Sender: sender.String(),
+		Amount: amount,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgMintBasket) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgMintBasket) Type() string { return TypeMsgMintBasket }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgMintBasket) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if msg.Amount.IsNil() || !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "'%s'", msg.Amount)
+	}
+
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgMintBasket) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgMintBasket) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgBurnBasket returns a new MsgBurnBasket
+func NewMsgBurnBasket(sender sdk.AccAddress, amount sdk.Coin) MsgBurnBasket {
+	return MsgBurnBasket{
+		Sender: sender.String(),
+		Amount: amount,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgBurnBasket) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgBurnBasket) Type() string { return TypeMsgBurnBasket }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgBurnBasket) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if msg.Amount.IsNil() || !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "'%s'", msg.Amount)
+	}
+
+	if msg.Amount.Denom != BasketDenom {
+		return errorsmod.Wrapf(ErrInvalidDenom, "expected %s", BasketDenom)
+	}
+
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgBurnBasket) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgBurnBasket) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgConvertToBasket returns a new MsgConvertToBasket
+func NewMsgConvertToBasket(sender sdk.AccAddress, amount sdk.Coin) MsgConvertToBasket {
+	return MsgConvertToBasket{
+		Sender: sender.String(),
+		Amount: amount,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgConvertToBasket) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgConvertToBasket) Type() string { return TypeMsgConvertToBasket }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgConvertToBasket) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if msg.Amount.IsNil() || !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "'%s'", msg.Amount)
+	}
+
+	if _, err := ParseLiquidStakingTokenDenom(msg.Amount.Denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidDenom, err.Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgConvertToBasket) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgConvertToBasket) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgUpdateParams returns a new MsgUpdateParams
+func NewMsgUpdateParams(authority sdk.AccAddress, params Params) MsgUpdateParams {
+	return MsgUpdateParams{
+		Authority: authority.String(),
+		Params:    params,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgUpdateParams) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgUpdateParams) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgUpdateParams) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidParams, err.Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
@@ -7,6 +7,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -51,6 +52,94 @@ func TestMsgBurnDerivative_Signing(t *testing.T) {
 	assert.Equal(t, signBytes, msg.GetSignBytes())
 }
 
+func TestMsgRedelegateDerivative(t *testing.T) {
+	address := mustAccAddressFromBech32("kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d")
+	validatorAddress := mustValAddressFromBech32("kavavaloper1ypjp0m04pyp73hwgtc0dgkx0e9rrydeckewa42")
+	derivativeDenom := "bkava-kavavaloper16lnfpgn6llvn4fstg5nfrljj6aaxyee9z59jqd"
+
+	msg := types.NewMsgRedelegateDerivative(address, validatorAddress, sdk.NewInt64Coin(derivativeDenom, 1e9))
+	require.NoError(t, msg.ValidateBasic())
+
+	// checking for the "type" field ensures the msg is registered on the amino codec
+	signBytes := []byte(
+		`{"type":"liquid/MsgRedelegateDerivative","value":{"amount":{"amount":"1000000000","denom":"bkava-kavavaloper16lnfpgn6llvn4fstg5nfrljj6aaxyee9z59jqd"},"sender":"kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d","validator_dst":"kavavaloper1ypjp0m04pyp73hwgtc0dgkx0e9rrydeckewa42"}}`,
+	)
+	assert.Equal(t, []sdk.AccAddress{address}, msg.GetSigners())
+	assert.Equal(t, signBytes, msg.GetSignBytes())
+
+	msg = types.NewMsgRedelegateDerivative(address, validatorAddress, sdk.NewInt64Coin("ukava", 1e9))
+	require.ErrorIs(t, msg.ValidateBasic(), types.ErrInvalidDenom)
+
+	msg = types.NewMsgRedelegateDerivative(address, validatorAddress, sdk.NewInt64Coin("bkava-"+validatorAddress.String(), 1e9))
+	require.ErrorIs(t, msg.ValidateBasic(), types.ErrInvalidDenom)
+
+	msg = types.NewMsgRedelegateDerivative(address, validatorAddress, sdk.NewInt64Coin(derivativeDenom, 0))
+	require.ErrorIs(t, msg.ValidateBasic(), sdkerrors.ErrInvalidCoins)
+}
+
+func TestMsgUndelegateDerivative(t *testing.T) {
+	address := mustAccAddressFromBech32("kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d")
+	validatorAddress := mustValAddressFromBech32("kavavaloper1ypjp0m04pyp73hwgtc0dgkx0e9rrydeckewa42")
+	derivativeDenom := "bkava-kavavaloper1ypjp0m04pyp73hwgtc0dgkx0e9rrydeckewa42"
+
+	msg := types.NewMsgUndelegateDerivative(address, validatorAddress, sdk.NewInt64Coin(derivativeDenom, 1e9))
+	require.NoError(t, msg.ValidateBasic())
+
+	// checking for the "type" field ensures the msg is registered on the amino codec
+	signBytes := []byte(
+		`{"type":"liquid/MsgUndelegateDerivative","value":{"amount":{"amount":"1000000000","denom":"bkava-kavavaloper1ypjp0m04pyp73hwgtc0dgkx0e9rrydeckewa42"},"sender":"kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d","validator":"kavavaloper1ypjp0m04pyp73hwgtc0dgkx0e9rrydeckewa42"}}`,
+	)
+	assert.Equal(t, []sdk.AccAddress{address}, msg.GetSigners())
+	assert.Equal(t, signBytes, msg.GetSignBytes())
+
+	msg = types.NewMsgUndelegateDerivative(address, validatorAddress, sdk.NewInt64Coin(derivativeDenom, 0))
+	require.ErrorIs(t, msg.ValidateBasic(), sdkerrors.ErrInvalidCoins)
+}
+
+func TestMsgBasket_Signing(t *testing.T) {
+	address := mustAccAddressFromBech32("kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d")
+
+	mint := types.NewMsgMintBasket(address, sdk.NewInt64Coin("ukava", 1e9))
+	burn := types.NewMsgBurnBasket(address, sdk.NewInt64Coin(types.BasketDenom, 1e9))
+	convert := types.NewMsgConvertToBasket(address, sdk.NewInt64Coin("bkava-kavavaloper1ypjp0m04pyp73hwgtc0dgkx0e9rrydeckewa42", 1e9))
+
+	tests := []struct {
+		msg       legacytx.LegacyMsg
+		signBytes string
+	}{
+		{
+			msg:       &mint,
+			signBytes: `{"type":"liquid/MsgMintBasket","value":{"amount":{"amount":"1000000000","denom":"ukava"},"sender":"kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d"}}`,
+		},
+		{
+			msg:       &burn,
+			signBytes: `{"type":"liquid/MsgBurnBasket","value":{"amount":{"amount":"1000000000","denom":"stkava"},"sender":"kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d"}}`,
+		},
+		{
+			msg:       &convert,
+			signBytes: `{"type":"liquid/MsgConvertToBasket","value":{"amount":{"amount":"1000000000","denom":"bkava-kavavaloper1ypjp0m04pyp73hwgtc0dgkx0e9rrydeckewa42"},"sender":"kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d"}}`,
+		},
+	}
+	for _, tc := range tests {
+		assert.NoError(t, tc.msg.ValidateBasic())
+		assert.Equal(t, []sdk.AccAddress{address}, tc.msg.GetSigners())
+		assert.Equal(t, []byte(tc.signBytes), tc.msg.GetSignBytes())
+	}
+}
+
+func TestMsgBasket_ValidateDenom(t *testing.T) {
+	address := mustAccAddressFromBech32("kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d")
+
+	burn := types.NewMsgBurnBasket(address, sdk.NewInt64Coin("ukava", 1e9))
+	require.ErrorIs(t, burn.ValidateBasic(), types.ErrInvalidDenom)
+
+	convert := types.NewMsgConvertToBasket(address, sdk.NewInt64Coin(types.BasketDenom, 1e9))
+	require.ErrorIs(t, convert.ValidateBasic(), types.ErrInvalidDenom)
+
+	mint := types.NewMsgMintBasket(address, sdk.Coin{Denom: "ukava", Amount: sdkmath.ZeroInt()})
+	require.ErrorIs(t, mint.ValidateBasic(), sdkerrors.ErrInvalidCoins)
+}
+
 func TestMsg_Validate(t *testing.T) {
 	validAddress := mustAccAddressFromBech32("kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d")
 	validValidatorAddress := mustValAddressFromBech32("kavavaloper1ypjp0m04pyp73hwgtc0dgkx0e9rrydeckewa42")
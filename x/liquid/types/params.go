@@ -0,0 +1,111 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultBasketCompoundInterval is the default minimum time between compounds of the basket staking rewards.
+const DefaultBasketCompoundInterval = time.Hour
+
+// NewParams returns a new params object
+func NewParams(
+	basketWeighting BasketWeighting,
+	basketValidators BasketValidators,
+	maxBasketValidators uint32,
+	disableTombstonedCollateral bool,
+	basketCompoundInterval time.Duration,
+) Params {
+	return Params{
+		BasketWeighting:             basketWeighting,
+		BasketValidators:            basketValidators,
+		MaxBasketValidators:         maxBasketValidators,
+		DisableTombstonedCollateral: disableTombstonedCollateral,
+		BasketCompoundInterval:      basketCompoundInterval,
+	}
+}
+
+// DefaultParams returns default params for the liquid module. The basket is
+// governance weighted with no validators, so basket tokens cannot be minted
+// until governance sets a validator set. Derivatives of tombstoned validators
+// remain usable as collateral. Basket rewards are compounded at most once per
+// DefaultBasketCompoundInterval.
+func DefaultParams() Params {
+	return NewParams(BASKET_WEIGHTING_GOVERNANCE, nil, 0, false, DefaultBasketCompoundInterval)
+}
+
+// Validate checks the params are valid
+func (p Params) Validate() error {
+	switch p.BasketWeighting {
+	case BASKET_WEIGHTING_GOVERNANCE:
+	case BASKET_WEIGHTING_STAKE:
+		if p.MaxBasketValidators == 0 {
+			return fmt.Errorf("max basket validators must be positive for %s weighting", p.BasketWeighting)
+		}
+	default:
+		return fmt.Errorf("invalid basket weighting %s", p.BasketWeighting)
+	}
+
+	if err := p.BasketValidators.Validate(); err != nil {
+		return fmt.Errorf("invalid basket validators: %w", err)
+	}
+
+	if p.BasketCompoundInterval < 0 {
+		return fmt.Errorf("basket compound interval cannot be negative, got %s", p.BasketCompoundInterval)
+	}
+
+	return nil
+}
+
+// NewBasketValidator returns a new BasketValidator
+func NewBasketValidator(valAddr sdk.ValAddress, weight sdk.Dec) BasketValidator {
+	return BasketValidator{
+		ValidatorAddress: valAddr.String(),
+		Weight:           weight,
+	}
+}
+
+// Validate checks the basket validator has a valid address and a positive weight.
+func (bv BasketValidator) Validate() error {
+	if _, err := sdk.ValAddressFromBech32(bv.ValidatorAddress); err != nil {
+		return fmt.Errorf("invalid validator address %s: %w", bv.ValidatorAddress, err)
+	}
+
+	if bv.Weight.IsNil() || !bv.Weight.IsPositive() {
+		return fmt.Errorf("weight for validator %s must be positive, got %s", bv.ValidatorAddress, bv.Weight)
+	}
+
+	return nil
+}
+
+// BasketValidators is a slice of BasketValidator
+type BasketValidators []BasketValidator
+
+// Validate checks each basket validator is valid and listed once.
+func (bvs BasketValidators) Validate() error {
+	seen := make(map[string]bool, len(bvs))
+	for _, bv := range bvs {
+		if err := bv.Validate(); err != nil {
+			return err
+		}
+
+		if seen[bv.ValidatorAddress] {
+			return fmt.Errorf("duplicate validator %s", bv.ValidatorAddress)
+		}
+		seen[bv.ValidatorAddress] = true
+	}
+
+	return nil
+}
+
+// TotalWeight returns the sum of all weights.
+func (bvs BasketValidators) TotalWeight() sdk.Dec {
+	total := sdk.ZeroDec()
+	for _, bv := range bvs {
+		total = total.Add(bv.Weight)
+	}
+
+	return total
+}
@@ -0,0 +1,736 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: kava/liquid/v1beta1/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// BasketWeighting is the method used to choose the validators that the basket
+// liquid staking token delegates to, and the portion each one receives.
+type BasketWeighting int32
+
+const (
+	// BASKET_WEIGHTING_GOVERNANCE delegates to the validators listed in the params,
+	// weighted by their governance set weight. This is the default.
+	BASKET_WEIGHTING_GOVERNANCE BasketWeighting = 0
+	// BASKET_WEIGHTING_STAKE delegates to the top bonded validators by voting
+	// power, weighted by their bonded tokens.
+	BASKET_WEIGHTING_STAKE BasketWeighting = 1
+)
+
+var BasketWeighting_name = map[int32]string{
+	0: "BASKET_WEIGHTING_GOVERNANCE",
+	1: "BASKET_WEIGHTING_STAKE",
+}
+
+var BasketWeighting_value = map[string]int32{
+	"BASKET_WEIGHTING_GOVERNANCE": 0,
+	"BASKET_WEIGHTING_STAKE":      1,
+}
+
+func (x BasketWeighting) String() string {
+	return proto.EnumName(BasketWeighting_name, int32(x))
+}
+
+func (BasketWeighting) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d5095dfc5eac0281, []int{0}
+}
+
+// Params defines the parameters for the liquid module.
+type Params struct {
+	// basket_weighting is the method used to weight the basket validator set.
+	BasketWeighting BasketWeighting `protobuf:"varint,1,opt,name=basket_weighting,json=basketWeighting,proto3,enum=kava.liquid.v1beta1.BasketWeighting" json:"basket_weighting,omitempty"`
+	// basket_validators are the validators and weights used by BASKET_WEIGHTING_GOVERNANCE.
+	BasketValidators BasketValidators `protobuf:"bytes,2,rep,name=basket_validators,json=basketValidators,proto3,castrepeated=BasketValidators" json:"basket_validators"`
+	// max_basket_validators is the number of top bonded validators used by BASKET_WEIGHTING_STAKE.
+	MaxBasketValidators uint32 `protobuf:"varint,3,opt,name=max_basket_validators,json=maxBasketValidators,proto3" json:"max_basket_validators,omitempty"`
+	// disable_tombstoned_collateral stops derivatives of tombstoned validators
+	// from being deposited into hard and earn.
+	DisableTombstonedCollateral bool `protobuf:"varint,4,opt,name=disable_tombstoned_collateral,json=disableTombstonedCollateral,proto3" json:"disable_tombstoned_collateral,omitempty"`
+	// basket_compound_interval is the minimum time between compounds of the basket staking rewards.
+	BasketCompoundInterval time.Duration `protobuf:"bytes,5,opt,name=basket_compound_interval,json=basketCompoundInterval,proto3,stdduration" json:"basket_compound_interval"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5095dfc5eac0281, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+// BasketValidator is a validator in the basket validator set and its weight.
+type BasketValidator struct {
+	// validator_address is the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// weight is the relative portion of basket delegations sent to the validator.
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
+}
+
+func (m *BasketValidator) Reset()         { *m = BasketValidator{} }
+func (m *BasketValidator) String() string { return proto.CompactTextString(m) }
+func (*BasketValidator) ProtoMessage()    {}
+func (*BasketValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5095dfc5eac0281, []int{1}
+}
+func (m *BasketValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BasketValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BasketValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BasketValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BasketValidator.Merge(m, src)
+}
+func (m *BasketValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *BasketValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_BasketValidator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BasketValidator proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("kava.liquid.v1beta1.BasketWeighting", BasketWeighting_name, BasketWeighting_value)
+	proto.RegisterType((*Params)(nil), "kava.liquid.v1beta1.Params")
+	proto.RegisterType((*BasketValidator)(nil), "kava.liquid.v1beta1.BasketValidator")
+}
+
+func init() { proto.RegisterFile("kava/liquid/v1beta1/params.proto", fileDescriptor_d5095dfc5eac0281) }
+
+var fileDescriptor_d5095dfc5eac0281 = []byte{
+	// 554 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x31, 0x6f, 0xd3, 0x4e,
+	0x18, 0xc6, 0x7d, 0x6d, 0xfe, 0x51, 0xff, 0x57, 0x41, 0x5d, 0x17, 0x2a, 0x37, 0x55, 0x6d, 0x53,
+	0x21, 0x64, 0x21, 0xc5, 0x56, 0xc3, 0x86, 0x58, 0xe2, 0x24, 0x0a, 0x51, 0xa5, 0x14, 0x39, 0x56,
+	0x2b, 0x21, 0x21, 0xeb, 0x6c, 0x1f, 0xae, 0x15, 0x3b, 0x17, 0x7c, 0x97, 0x10, 0xbe, 0x41, 0x47,
+	0x46, 0x46, 0xa4, 0x6e, 0xcc, 0x5d, 0xd9, 0x3b, 0x56, 0x9d, 0x10, 0x43, 0x8b, 0x92, 0x2f, 0x82,
+	0x6c, 0x5f, 0x02, 0xa4, 0x88, 0xc9, 0x77, 0xef, 0xfd, 0xfc, 0xbc, 0xcf, 0xe9, 0x79, 0x0f, 0x6a,
+	0x7d, 0x34, 0x46, 0x66, 0x1c, 0xbd, 0x1b, 0x45, 0x81, 0x39, 0x3e, 0xf0, 0x30, 0x43, 0x07, 0xe6,
+	0x10, 0xa5, 0x28, 0xa1, 0xc6, 0x30, 0x25, 0x8c, 0x48, 0x5b, 0x19, 0x61, 0x14, 0x84, 0xc1, 0x89,
+	0xca, 0x8e, 0x4f, 0x68, 0x42, 0xa8, 0x9b, 0x23, 0x66, 0xb1, 0x29, 0xf8, 0xca, 0x83, 0x90, 0x84,
+	0xa4, 0xa8, 0x67, 0x2b, 0x5e, 0x55, 0x42, 0x42, 0xc2, 0x18, 0x9b, 0xf9, 0xce, 0x1b, 0xbd, 0x35,
+	0x83, 0x51, 0x8a, 0x58, 0x44, 0x06, 0xc5, 0xf9, 0xfe, 0xf9, 0x2a, 0x2c, 0xbf, 0xca, 0xdb, 0x4a,
+	0x47, 0x50, 0xf4, 0x10, 0xed, 0x63, 0xe6, 0xbe, 0xc7, 0x51, 0x78, 0xca, 0xa2, 0x41, 0x28, 0x03,
+	0x0d, 0xe8, 0xf7, 0x6b, 0x8f, 0x8d, 0xbf, 0x78, 0x31, 0xac, 0x1c, 0x3e, 0x99, 0xb3, 0xf6, 0x86,
+	0xf7, 0x67, 0x41, 0xea, 0xc3, 0x4d, 0x2e, 0x38, 0x46, 0x71, 0x14, 0x20, 0x46, 0x52, 0x2a, 0xaf,
+	0x68, 0xab, 0xfa, 0xfa, 0x3f, 0x15, 0x8f, 0xe7, 0xb0, 0x25, 0x5f, 0xde, 0xa8, 0xc2, 0x97, 0x5b,
+	0x55, 0x5c, 0x3a, 0xa0, 0xb6, 0xe8, 0x2d, 0x55, 0xa4, 0x1a, 0x7c, 0x98, 0xa0, 0x89, 0x7b, 0xb7,
+	0xe1, 0xaa, 0x06, 0xf4, 0x7b, 0xf6, 0x56, 0x82, 0x26, 0xcb, 0x2a, 0x92, 0x05, 0xf7, 0x82, 0x88,
+	0x22, 0x2f, 0xc6, 0x2e, 0x23, 0x89, 0x47, 0x19, 0x19, 0xe0, 0xc0, 0xf5, 0x49, 0x1c, 0x23, 0x86,
+	0x53, 0x14, 0xcb, 0x25, 0x0d, 0xe8, 0x6b, 0xf6, 0x2e, 0x87, 0x9c, 0x05, 0xd3, 0x58, 0x20, 0xd2,
+	0x1b, 0x28, 0xf3, 0x9e, 0x3e, 0x49, 0x86, 0x64, 0x34, 0x08, 0xdc, 0x68, 0xc0, 0x70, 0x3a, 0x46,
+	0xb1, 0xfc, 0x9f, 0x06, 0xf4, 0xf5, 0xda, 0x8e, 0x51, 0x64, 0x60, 0xcc, 0x33, 0x30, 0x9a, 0x3c,
+	0x03, 0x6b, 0x2d, 0xbb, 0xe0, 0xa7, 0x5b, 0x15, 0xd8, 0xdb, 0x85, 0x48, 0x83, 0x6b, 0x74, 0xb8,
+	0xc4, 0xf3, 0xd2, 0xd9, 0x67, 0x55, 0xd8, 0xff, 0x0a, 0xe0, 0xc6, 0x92, 0x7b, 0xa9, 0x0b, 0x37,
+	0x17, 0xb7, 0x74, 0x51, 0x10, 0xa4, 0x98, 0xd2, 0x3c, 0xaf, 0xff, 0xad, 0x47, 0xd7, 0x17, 0xd5,
+	0x3d, 0x3e, 0x1c, 0x8b, 0x1f, 0xea, 0x05, 0xd2, 0x63, 0x69, 0x16, 0x96, 0x38, 0x5e, 0xaa, 0x4b,
+	0x0e, 0x2c, 0x17, 0xb9, 0xcb, 0x2b, 0xb9, 0xc8, 0x8b, 0xcc, 0xdb, 0xf7, 0x1b, 0xf5, 0x49, 0x18,
+	0xb1, 0xd3, 0x91, 0x67, 0xf8, 0x24, 0xe1, 0x03, 0xc7, 0x3f, 0x55, 0x1a, 0xf4, 0x4d, 0xf6, 0x61,
+	0x88, 0xa9, 0xd1, 0xc4, 0xfe, 0xf5, 0x45, 0x15, 0xf2, 0x96, 0x4d, 0xec, 0xdb, 0x5c, 0xab, 0xf0,
+	0xff, 0xd4, 0x99, 0xdb, 0xff, 0x35, 0x1c, 0x2a, 0xdc, 0xb5, 0xea, 0xbd, 0xc3, 0x96, 0xe3, 0x9e,
+	0xb4, 0x3a, 0xed, 0x97, 0x4e, 0xa7, 0xdb, 0x76, 0xdb, 0x47, 0xc7, 0x2d, 0xbb, 0x5b, 0xef, 0x36,
+	0x5a, 0xa2, 0x20, 0x55, 0xe0, 0xf6, 0x1d, 0xa0, 0xe7, 0xd4, 0x0f, 0x5b, 0x22, 0xa8, 0x94, 0xce,
+	0xce, 0x15, 0xc1, 0xb2, 0x2e, 0xa7, 0x0a, 0xb8, 0x9a, 0x2a, 0xe0, 0xc7, 0x54, 0x01, 0x1f, 0x67,
+	0x8a, 0x70, 0x35, 0x53, 0x84, 0x6f, 0x33, 0x45, 0x78, 0xad, 0xff, 0xe6, 0x39, 0x1b, 0xb4, 0x6a,
+	0x8c, 0x3c, 0x9a, 0xaf, 0xcc, 0xc9, 0xfc, 0xd1, 0xe5, 0xce, 0xbd, 0x72, 0x1e, 0xca, 0xb3, 0x9f,
+	0x03, 0x00, 0x56, 0x17, 0x4b, 0x4d, 0x90, 0x03, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.BasketCompoundInterval, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.BasketCompoundInterval):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	if m.DisableTombstonedCollateral {
+		i--
+		if m.DisableTombstonedCollateral {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxBasketValidators != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxBasketValidators))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BasketValidators) > 0 {
+		for iNdEx := len(m.BasketValidators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BasketValidators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.BasketWeighting != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BasketWeighting))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BasketValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BasketValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BasketValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BasketWeighting != 0 {
+		n += 1 + sovParams(uint64(m.BasketWeighting))
+	}
+	if len(m.BasketValidators) > 0 {
+		for _, e := range m.BasketValidators {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MaxBasketValidators != 0 {
+		n += 1 + sovParams(uint64(m.MaxBasketValidators))
+	}
+	if m.DisableTombstonedCollateral {
+		n += 2
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.BasketCompoundInterval)
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *BasketValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasketWeighting", wireType)
+			}
+			m.BasketWeighting = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BasketWeighting |= BasketWeighting(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasketValidators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BasketValidators = append(m.BasketValidators, BasketValidator{})
+			if err := m.BasketValidators[len(m.BasketValidators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBasketValidators", wireType)
+			}
+			m.MaxBasketValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBasketValidators |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableTombstonedCollateral", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableTombstonedCollateral = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasketCompoundInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.BasketCompoundInterval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BasketValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BasketValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BasketValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,95 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kava-labs/kava/x/liquid/types"
+)
+
+func TestParams_Validate(t *testing.T) {
+	valAddr1 := mustValAddressFromBech32("kavavaloper1ypjp0m04pyp73hwgtc0dgkx0e9rrydeckewa42")
+	valAddr2 := mustValAddressFromBech32("kavavaloper16lnfpgn6llvn4fstg5nfrljj6aaxyee9z59jqd")
+
+	tests := []struct {
+		name    string
+		params  types.Params
+		wantErr string
+	}{
+		{
+			name:   "default params are valid",
+			params: types.DefaultParams(),
+		},
+		{
+			name:   "zero value params are valid",
+			params: types.Params{},
+		},
+		{
+			name: "governance weighted basket",
+			params: types.NewParams(types.BASKET_WEIGHTING_GOVERNANCE, types.BasketValidators{
+				types.NewBasketValidator(valAddr1, sdk.OneDec()),
+				types.NewBasketValidator(valAddr2, sdk.MustNewDecFromStr("0.5")),
+			}, 0, false, types.DefaultBasketCompoundInterval),
+		},
+		{
+			name:   "stake weighted basket",
+			params: types.NewParams(types.BASKET_WEIGHTING_STAKE, nil, 10, false, types.DefaultBasketCompoundInterval),
+		},
+		{
+			name:   "tombstoned collateral disabled",
+			params: types.NewParams(types.BASKET_WEIGHTING_GOVERNANCE, nil, 0, true, types.DefaultBasketCompoundInterval),
+		},
+		{
+			name:    "stake weighted basket without max validators",
+			params:  types.NewParams(types.BASKET_WEIGHTING_STAKE, nil, 0, false, types.DefaultBasketCompoundInterval),
+			wantErr: "max basket validators must be positive for BASKET_WEIGHTING_STAKE weighting",
+		},
+		{
+			name:    "negative compound interval",
+			params:  types.NewParams(types.BASKET_WEIGHTING_GOVERNANCE, nil, 0, false, -time.Second),
+			wantErr: "basket compound interval cannot be negative, got -1s",
+		},
+		{
+			name:    "invalid weighting",
+			params:  types.NewParams(types.BasketWeighting(5), nil, 0, false, types.DefaultBasketCompoundInterval),
+			wantErr: "invalid basket weighting 5",
+		},
+		{
+			name: "duplicate validator",
+			params: types.NewParams(types.BASKET_WEIGHTING_GOVERNANCE, types.BasketValidators{
+				types.NewBasketValidator(valAddr1, sdk.OneDec()),
+				types.NewBasketValidator(valAddr1, sdk.OneDec()),
+			}, 0, false, types.DefaultBasketCompoundInterval),
+			wantErr: "invalid basket validators: duplicate validator kavavaloper1ypjp0m04pyp73hwgtc0dgkx0e9rrydeckewa42",
+		},
+		{
+			name: "zero weight",
+			params: types.NewParams(types.BASKET_WEIGHTING_GOVERNANCE, types.BasketValidators{
+				types.NewBasketValidator(valAddr1, sdk.ZeroDec()),
+			}, 0, false, types.DefaultBasketCompoundInterval),
+			wantErr: "invalid basket validators: weight for validator kavavaloper1ypjp0m04pyp73hwgtc0dgkx0e9rrydeckewa42 must be positive, got 0.000000000000000000",
+		},
+		{
+			name: "invalid validator address",
+			params: types.NewParams(types.BASKET_WEIGHTING_GOVERNANCE, types.BasketValidators{
+				{ValidatorAddress: "kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d", Weight: sdk.OneDec()},
+			}, 0, false, types.DefaultBasketCompoundInterval),
+			wantErr: "invalid basket validators: invalid validator address kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d: invalid Bech32 prefix; expected kavavaloper, got kava",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.params.Validate()
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
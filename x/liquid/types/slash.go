@@ -0,0 +1,76 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewSlashEvent returns a new SlashEvent
+func NewSlashEvent(
+	denom string, valAddr sdk.ValAddress, height int64, blockTime time.Time,
+	slashFraction, exchangeRateBefore, exchangeRateAfter sdk.Dec,
+) SlashEvent {
+	return SlashEvent{
+		Denom:              denom,
+		ValidatorAddress:   valAddr.String(),
+		Height:             height,
+		Time:               blockTime,
+		SlashFraction:      slashFraction,
+		ExchangeRateBefore: exchangeRateBefore,
+		ExchangeRateAfter:  exchangeRateAfter,
+	}
+}
+
+// Validate checks the slash event is for the derivative of its validator and
+// has valid fractions and exchange rates.
+func (se SlashEvent) Validate() error {
+	valAddr, err := ParseLiquidStakingTokenDenom(se.Denom)
+	if err != nil {
+		return err
+	}
+
+	if valAddr.String() != se.ValidatorAddress {
+		return fmt.Errorf("validator %s does not match denom %s", se.ValidatorAddress, se.Denom)
+	}
+
+	if se.Height <= 0 {
+		return fmt.Errorf("height must be positive, got %d", se.Height)
+	}
+
+	if se.SlashFraction.IsNil() || se.SlashFraction.IsNegative() || se.SlashFraction.GT(sdk.OneDec()) {
+		return fmt.Errorf("slash fraction must be between 0 and 1, got %s", se.SlashFraction)
+	}
+
+	if se.ExchangeRateBefore.IsNil() || se.ExchangeRateBefore.IsNegative() {
+		return fmt.Errorf("exchange rate before must not be negative, got %s", se.ExchangeRateBefore)
+	}
+
+	if se.ExchangeRateAfter.IsNil() || se.ExchangeRateAfter.IsNegative() {
+		return fmt.Errorf("exchange rate after must not be negative, got %s", se.ExchangeRateAfter)
+	}
+
+	return nil
+}
+
+// SlashEvents is a slice of SlashEvent
+type SlashEvents []SlashEvent
+
+// Validate checks each slash event is valid and there is at most one per denom and height.
+func (ses SlashEvents) Validate() error {
+	seen := make(map[string]bool, len(ses))
+	for _, se := range ses {
+		if err := se.Validate(); err != nil {
+			return err
+		}
+
+		key := string(SlashEventKey(se.Denom, se.Height))
+		if seen[key] {
+			return fmt.Errorf("duplicate slash event for %s at height %d", se.Denom, se.Height)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
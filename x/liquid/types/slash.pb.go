@@ -0,0 +1,598 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: kava/liquid/v1beta1/slash.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SlashEvent records a slash that changed the value of a derivative denom.
+type SlashEvent struct {
+	// denom is the derivative denom whose value changed.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// validator_address is the operator address of the validator that was slashed.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// height is the block height the slash was applied at.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time the slash was applied at.
+	Time time.Time `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time"`
+	// slash_fraction is the fraction of the validator's tokens that was burned.
+	// It is zero when the derivative lost value because a redelegation into its
+	// validator was slashed.
+	SlashFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=slash_fraction,json=slashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction"`
+	// exchange_rate_before is the amount of staking tokens one derivative was worth before the slash.
+	ExchangeRateBefore github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=exchange_rate_before,json=exchangeRateBefore,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchange_rate_before"`
+	// exchange_rate_after is the amount of staking tokens one derivative was worth after the slash.
+	ExchangeRateAfter github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=exchange_rate_after,json=exchangeRateAfter,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchange_rate_after"`
+}
+
+func (m *SlashEvent) Reset()         { *m = SlashEvent{} }
+func (m *SlashEvent) String() string { return proto.CompactTextString(m) }
+func (*SlashEvent) ProtoMessage()    {}
+func (*SlashEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a943ba762d4e79ae, []int{0}
+}
+func (m *SlashEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashEvent.Merge(m, src)
+}
+func (m *SlashEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashEvent proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*SlashEvent)(nil), "kava.liquid.v1beta1.SlashEvent")
+}
+
+func init() { proto.RegisterFile("kava/liquid/v1beta1/slash.proto", fileDescriptor_a943ba762d4e79ae) }
+
+var fileDescriptor_a943ba762d4e79ae = []byte{
+	// 432 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0x3f, 0x6e, 0xd4, 0x40,
+	0x18, 0xc5, 0x6d, 0xb2, 0x59, 0x60, 0x10, 0x88, 0x4c, 0x56, 0xc8, 0xac, 0x84, 0xbd, 0x50, 0xa0,
+	0x6d, 0xd6, 0x56, 0xa0, 0x41, 0x88, 0x26, 0x56, 0xa0, 0xa4, 0x70, 0x10, 0x05, 0x8d, 0x35, 0xb6,
+	0x3f, 0xdb, 0xa3, 0xd8, 0x9e, 0x65, 0x66, 0xd6, 0x0a, 0x37, 0xa0, 0x41, 0xca, 0x11, 0x38, 0x44,
+	0x0e, 0x91, 0x32, 0x4a, 0x85, 0x28, 0x02, 0xda, 0xbd, 0x08, 0x9a, 0x3f, 0x96, 0x42, 0xea, 0xad,
+	0x3c, 0xef, 0xd3, 0xcf, 0xef, 0x3d, 0x8f, 0x3f, 0x14, 0x9c, 0x90, 0x9e, 0x44, 0x0d, 0xfd, 0xba,
+	0xa2, 0x45, 0xd4, 0x1f, 0x64, 0x20, 0xc9, 0x41, 0x24, 0x1a, 0x22, 0xea, 0x70, 0xc9, 0x99, 0x64,
+	0x78, 0x5f, 0x01, 0xa1, 0x01, 0x42, 0x0b, 0x4c, 0x9f, 0xe6, 0x4c, 0xb4, 0x4c, 0xa4, 0x1a, 0x89,
+	0x8c, 0x30, 0xfc, 0x74, 0x52, 0xb1, 0x8a, 0x99, 0xb9, 0x3a, 0xd9, 0x69, 0x50, 0x31, 0x56, 0x35,
+	0x10, 0x69, 0x95, 0xad, 0xca, 0x48, 0xd2, 0x16, 0x84, 0x24, 0xed, 0xd2, 0x00, 0x2f, 0x7e, 0x8c,
+	0x10, 0x3a, 0x56, 0xb1, 0xef, 0x7b, 0xe8, 0x24, 0x9e, 0xa0, 0xdd, 0x02, 0x3a, 0xd6, 0x7a, 0xee,
+	0xcc, 0x9d, 0xdf, 0x4f, 0x8c, 0xc0, 0x1f, 0xd1, 0x5e, 0x4f, 0x1a, 0x5a, 0x10, 0xc9, 0x78, 0x4a,
+	0x8a, 0x82, 0x83, 0x10, 0xde, 0x1d, 0x45, 0xc4, 0xcf, 0xaf, 0xce, 0x17, 0xcf, 0x6c, 0x91, 0xcf,
+	0x03, 0x73, 0x68, 0x90, 0x63, 0xc9, 0x69, 0x57, 0x25, 0x8f, 0xfb, 0x5b, 0x73, 0xfc, 0x04, 0x8d,
+	0x6b, 0xa0, 0x55, 0x2d, 0xbd, 0x9d, 0x99, 0x3b, 0xdf, 0x49, 0xac, 0xc2, 0x6f, 0xd0, 0x48, 0xf5,
+	0xf3, 0x46, 0x33, 0x77, 0xfe, 0xe0, 0xd5, 0x34, 0x34, 0xe5, 0xc3, 0xa1, 0x7c, 0xf8, 0x69, 0x28,
+	0x1f, 0xdf, 0xbb, 0xb8, 0x0e, 0x9c, 0xb3, 0x3f, 0x81, 0x9b, 0xe8, 0x37, 0x70, 0x8e, 0x1e, 0xe9,
+	0xcb, 0x4b, 0x4b, 0x4e, 0x72, 0x49, 0x59, 0xe7, 0xed, 0xea, 0x7a, 0xef, 0x14, 0xf7, 0xfb, 0x3a,
+	0x78, 0x59, 0x51, 0x59, 0xaf, 0xb2, 0x30, 0x67, 0xad, 0xbd, 0x36, 0xfb, 0x58, 0x88, 0xe2, 0x24,
+	0x92, 0xdf, 0x96, 0x20, 0xc2, 0x23, 0xc8, 0xaf, 0xce, 0x17, 0xc8, 0x7e, 0xcc, 0x11, 0xe4, 0xc9,
+	0x43, 0xed, 0xf9, 0xc1, 0x5a, 0xe2, 0x0e, 0x4d, 0xe0, 0x34, 0xaf, 0x49, 0x57, 0x41, 0xca, 0x89,
+	0x84, 0x34, 0x83, 0x92, 0x71, 0xf0, 0xc6, 0x5b, 0x88, 0xc2, 0x83, 0x73, 0x42, 0x24, 0xc4, 0xda,
+	0x17, 0x37, 0x68, 0xff, 0xff, 0x3c, 0x52, 0x4a, 0xe0, 0xde, 0xdd, 0x2d, 0xc4, 0xed, 0xdd, 0x8c,
+	0x3b, 0x54, 0xb6, 0x6f, 0x47, 0xdf, 0x7f, 0x06, 0x4e, 0x1c, 0x5f, 0xac, 0x7d, 0xf7, 0x72, 0xed,
+	0xbb, 0x7f, 0xd7, 0xbe, 0x7b, 0xb6, 0xf1, 0x9d, 0xcb, 0x8d, 0xef, 0xfc, 0xda, 0xf8, 0xce, 0x97,
+	0xf9, 0x8d, 0x20, 0xb5, 0x9b, 0x8b, 0x86, 0x64, 0x42, 0x9f, 0xa2, 0xd3, 0x61, 0x91, 0x75, 0x5c,
+	0x36, 0xd6, 0x3f, 0xec, 0xf5, 0xbf, 0x01, 0x00, 0xdb, 0x29, 0x46, 0xa0, 0xe4, 0x02, 0x00, 0x00,
+}
+
+func (m *SlashEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ExchangeRateAfter.Size()
+		i -= size
+		if _, err := m.ExchangeRateAfter.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlash(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.ExchangeRateBefore.Size()
+		i -= size
+		if _, err := m.ExchangeRateBefore.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlash(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlash(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintSlash(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintSlash(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintSlash(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintSlash(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSlash(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSlash(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SlashEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovSlash(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovSlash(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovSlash(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovSlash(uint64(l))
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovSlash(uint64(l))
+	l = m.ExchangeRateBefore.Size()
+	n += 1 + l + sovSlash(uint64(l))
+	l = m.ExchangeRateAfter.Size()
+	n += 1 + l + sovSlash(uint64(l))
+	return n
+}
+
+func sovSlash(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSlash(x uint64) (n int) {
+	return sovSlash(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SlashEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlash
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlash
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlash
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlash
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlash
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlash
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlash
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlash
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlash
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRateBefore", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlash
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlash
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangeRateBefore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRateAfter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlash
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlash
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangeRateAfter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlash(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlash
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSlash(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSlash
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSlash
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSlash
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSlash
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSlash
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSlash
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSlash        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSlash          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSlash = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,104 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kava-labs/kava/x/liquid/types"
+)
+
+func TestSlashEvents_Validate(t *testing.T) {
+	valAddr1 := mustValAddressFromBech32("kavavaloper1ypjp0m04pyp73hwgtc0dgkx0e9rrydeckewa42")
+	valAddr2 := mustValAddressFromBech32("kavavaloper16lnfpgn6llvn4fstg5nfrljj6aaxyee9z59jqd")
+	denom1 := types.GetLiquidStakingTokenDenom(types.DefaultDerivativeDenom, valAddr1)
+	blockTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	validEvent := func() types.SlashEvent {
+		return types.NewSlashEvent(denom1, valAddr1, 10, blockTime, sdk.MustNewDecFromStr("0.1"), sdk.OneDec(), sdk.MustNewDecFromStr("0.9"))
+	}
+
+	tests := []struct {
+		name    string
+		events  func() types.SlashEvents
+		wantErr string
+	}{
+		{
+			name:   "empty is valid",
+			events: func() types.SlashEvents { return nil },
+		},
+		{
+			name: "valid events",
+			events: func() types.SlashEvents {
+				later := validEvent()
+				later.Height = 20
+				return types.SlashEvents{validEvent(), later}
+			},
+		},
+		{
+			name: "duplicate height",
+			events: func() types.SlashEvents {
+				return types.SlashEvents{validEvent(), validEvent()}
+			},
+			wantErr: "duplicate slash event for " + denom1 + " at height 10",
+		},
+		{
+			name: "invalid denom",
+			events: func() types.SlashEvents {
+				event := validEvent()
+				event.Denom = "ukava"
+				return types.SlashEvents{event}
+			},
+			wantErr: "cannot parse denom ukava",
+		},
+		{
+			name: "validator does not match denom",
+			events: func() types.SlashEvents {
+				event := validEvent()
+				event.ValidatorAddress = valAddr2.String()
+				return types.SlashEvents{event}
+			},
+			wantErr: "validator " + valAddr2.String() + " does not match denom " + denom1,
+		},
+		{
+			name: "zero height",
+			events: func() types.SlashEvents {
+				event := validEvent()
+				event.Height = 0
+				return types.SlashEvents{event}
+			},
+			wantErr: "height must be positive, got 0",
+		},
+		{
+			name: "slash fraction greater than one",
+			events: func() types.SlashEvents {
+				event := validEvent()
+				event.SlashFraction = sdk.MustNewDecFromStr("1.1")
+				return types.SlashEvents{event}
+			},
+			wantErr: "slash fraction must be between 0 and 1, got 1.100000000000000000",
+		},
+		{
+			name: "negative exchange rate",
+			events: func() types.SlashEvents {
+				event := validEvent()
+				event.ExchangeRateAfter = sdk.MustNewDecFromStr("-1")
+				return types.SlashEvents{event}
+			},
+			wantErr: "exchange rate after must not be negative, got -1.000000000000000000",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.events().Validate()
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
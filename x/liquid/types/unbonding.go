@@ -0,0 +1,104 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultNextUnbondingRecordID is the id of the first unbonding record.
+const DefaultNextUnbondingRecordID uint64 = 1
+
+// NewUnbondingRecord returns a new UnbondingRecord
+func NewUnbondingRecord(
+	id uint64, delegator sdk.AccAddress, valAddr sdk.ValAddress, derivative, balance sdk.Coin,
+	creationHeight int64, completionTime time.Time,
+) UnbondingRecord {
+	return UnbondingRecord{
+		ID:               id,
+		Delegator:        delegator.String(),
+		ValidatorAddress: valAddr.String(),
+		Derivative:       derivative,
+		Balance:          balance,
+		CreationHeight:   creationHeight,
+		CompletionTime:   completionTime,
+		InitialBalance:   balance,
+	}
+}
+
+// GetDelegator returns the delegator address of the record.
+func (r UnbondingRecord) GetDelegator() sdk.AccAddress {
+	delegator, err := sdk.AccAddressFromBech32(r.Delegator)
+	if err != nil {
+		panic(err)
+	}
+	return delegator
+}
+
+// GetValidator returns the validator address of the record.
+func (r UnbondingRecord) GetValidator() sdk.ValAddress {
+	valAddr, err := sdk.ValAddressFromBech32(r.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return valAddr
+}
+
+// Validate checks the unbonding record has valid addresses and coins.
+func (r UnbondingRecord) Validate() error {
+	if r.ID == 0 {
+		return fmt.Errorf("id must be positive")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(r.Delegator); err != nil {
+		return fmt.Errorf("invalid delegator address %s: %w", r.Delegator, err)
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(r.ValidatorAddress)
+	if err != nil {
+		return fmt.Errorf("invalid validator address %s: %w", r.ValidatorAddress, err)
+	}
+
+	if !r.Derivative.IsValid() || !r.Derivative.IsPositive() {
+		return fmt.Errorf("derivative must be positive, got %s", r.Derivative)
+	}
+
+	derivativeVal, err := ParseLiquidStakingTokenDenom(r.Derivative.Denom)
+	if err != nil {
+		return err
+	}
+	if !derivativeVal.Equals(valAddr) {
+		return fmt.Errorf("derivative %s does not match validator %s", r.Derivative.Denom, r.ValidatorAddress)
+	}
+
+	if !r.InitialBalance.IsValid() {
+		return fmt.Errorf("invalid initial balance %s", r.InitialBalance)
+	}
+
+	if !r.Balance.IsValid() || r.Balance.Denom != r.InitialBalance.Denom || r.Balance.Amount.GT(r.InitialBalance.Amount) {
+		return fmt.Errorf("invalid balance %s for initial balance %s", r.Balance, r.InitialBalance)
+	}
+
+	return nil
+}
+
+// UnbondingRecords is a slice of UnbondingRecord
+type UnbondingRecords []UnbondingRecord
+
+// Validate checks each unbonding record is valid and ids are unique.
+func (rs UnbondingRecords) Validate() error {
+	seen := make(map[uint64]bool, len(rs))
+	for _, r := range rs {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+
+		if seen[r.ID] {
+			return fmt.Errorf("duplicate unbonding record id %d", r.ID)
+		}
+		seen[r.ID] = true
+	}
+
+	return nil
+}
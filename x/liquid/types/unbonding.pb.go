@@ -0,0 +1,626 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: kava/liquid/v1beta1/unbonding.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// UnbondingRecord tracks a staking unbonding that was started by undelegating derivatives.
+type UnbondingRecord struct {
+	// id is the unique id of the record.
+	ID uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// delegator is the address of the account the unbonded tokens are returned to.
+	Delegator string `protobuf:"bytes,2,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// validator_address is the operator address of the validator being unbonded from.
+	ValidatorAddress string `protobuf:"bytes,3,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// derivative is the amount of derivatives burned to start the unbonding.
+	Derivative types.Coin `protobuf:"bytes,4,opt,name=derivative,proto3" json:"derivative"`
+	// balance is the amount of staking tokens that will be returned, after any slashes of the unbonding.
+	Balance types.Coin `protobuf:"bytes,5,opt,name=balance,proto3" json:"balance"`
+	// creation_height is the block height the unbonding started at.
+	CreationHeight int64 `protobuf:"varint,6,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height,omitempty"`
+	// completion_time is the time the unbonding completes and the tokens are returned to the delegator.
+	CompletionTime time.Time `protobuf:"bytes,7,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time"`
+	// initial_balance is the amount of staking tokens being unbonded, at the time the unbonding started.
+	InitialBalance types.Coin `protobuf:"bytes,8,opt,name=initial_balance,json=initialBalance,proto3" json:"initial_balance"`
+}
+
+func (m *UnbondingRecord) Reset()         { *m = UnbondingRecord{} }
+func (m *UnbondingRecord) String() string { return proto.CompactTextString(m) }
+func (*UnbondingRecord) ProtoMessage()    {}
+func (*UnbondingRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_890e4a98873e652a, []int{0}
+}
+func (m *UnbondingRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnbondingRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnbondingRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnbondingRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnbondingRecord.Merge(m, src)
+}
+func (m *UnbondingRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnbondingRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnbondingRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnbondingRecord proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*UnbondingRecord)(nil), "kava.liquid.v1beta1.UnbondingRecord")
+}
+
+func init() {
+	proto.RegisterFile("kava/liquid/v1beta1/unbonding.proto", fileDescriptor_890e4a98873e652a)
+}
+
+var fileDescriptor_890e4a98873e652a = []byte{
+	// 464 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcf, 0x8e, 0xd3, 0x30,
+	0x10, 0xc6, 0x93, 0xb6, 0x74, 0x77, 0x8d, 0xd4, 0x82, 0x59, 0xa1, 0x6c, 0x25, 0x92, 0x02, 0x07,
+	0x72, 0xa9, 0xad, 0x05, 0x09, 0x09, 0x2e, 0x88, 0xc0, 0x61, 0x39, 0xc0, 0x21, 0xfc, 0x39, 0x70,
+	0xa9, 0x9c, 0xd8, 0xa4, 0x23, 0x92, 0xb8, 0x24, 0x6e, 0x04, 0x6f, 0xc0, 0x71, 0x1f, 0x81, 0x87,
+	0xd8, 0x87, 0xd8, 0xe3, 0x6a, 0x4f, 0x9c, 0x16, 0xd4, 0x3e, 0x03, 0x77, 0xe4, 0x38, 0x66, 0x81,
+	0x53, 0x6f, 0x9e, 0x6f, 0xbe, 0xdf, 0x78, 0x3c, 0x63, 0x74, 0xf7, 0x23, 0x6b, 0x18, 0xcd, 0xe1,
+	0xd3, 0x0a, 0x38, 0x6d, 0x0e, 0x13, 0xa1, 0xd8, 0x21, 0x5d, 0x95, 0x89, 0x2c, 0x39, 0x94, 0x19,
+	0x59, 0x56, 0x52, 0x49, 0x7c, 0x43, 0x9b, 0x88, 0x31, 0x91, 0xce, 0x34, 0xf1, 0x53, 0x59, 0x17,
+	0xb2, 0xa6, 0x09, 0xab, 0xc5, 0x1f, 0x32, 0x95, 0x50, 0x1a, 0x68, 0x72, 0x60, 0xf2, 0xf3, 0x36,
+	0xa2, 0x26, 0xe8, 0x52, 0xfb, 0x99, 0xcc, 0xa4, 0xd1, 0xf5, 0xa9, 0x53, 0x83, 0x4c, 0xca, 0x2c,
+	0x17, 0xb4, 0x8d, 0x92, 0xd5, 0x07, 0xaa, 0xa0, 0x10, 0xb5, 0x62, 0xc5, 0xd2, 0x18, 0xee, 0xfc,
+	0xea, 0xa3, 0xf1, 0x5b, 0xdb, 0x5a, 0x2c, 0x52, 0x59, 0x71, 0x7c, 0x13, 0xf5, 0x80, 0x7b, 0xee,
+	0xd4, 0x0d, 0x07, 0xd1, 0x70, 0x7d, 0x11, 0xf4, 0x5e, 0x3c, 0x8f, 0x7b, 0xc0, 0xf1, 0x43, 0xb4,
+	0xc7, 0x45, 0x2e, 0x32, 0xa6, 0x64, 0xe5, 0xf5, 0xa6, 0x6e, 0xb8, 0x17, 0x79, 0xe7, 0x27, 0xb3,
+	0xfd, 0xae, 0x8f, 0xa7, 0x9c, 0x57, 0xa2, 0xae, 0x5f, 0xab, 0x4a, 0x97, 0xba, 0xb4, 0xe2, 0x57,
+	0xe8, 0x7a, 0xc3, 0x72, 0xe0, 0x3a, 0x98, 0x33, 0xe3, 0xf2, 0xfa, 0x2d, 0x7f, 0xfb, 0xfc, 0x64,
+	0x76, 0xab, 0xe3, 0xdf, 0x59, 0xcf, 0xbf, 0x85, 0xae, 0x35, 0xff, 0xe9, 0xf8, 0x09, 0x42, 0x5c,
+	0x54, 0xd0, 0x30, 0x05, 0x8d, 0xf0, 0x06, 0x53, 0x37, 0xbc, 0x7a, 0xff, 0x80, 0x74, 0x55, 0xf4,
+	0xe8, 0xec, 0x3c, 0xc9, 0x33, 0x09, 0x65, 0x34, 0x38, 0xbd, 0x08, 0x9c, 0xf8, 0x2f, 0x04, 0x3f,
+	0x42, 0x3b, 0x09, 0xcb, 0x59, 0x99, 0x0a, 0xef, 0xca, 0x76, 0xb4, 0xf5, 0xe3, 0x7b, 0x68, 0x9c,
+	0x56, 0x82, 0x29, 0x90, 0xe5, 0x7c, 0x21, 0x20, 0x5b, 0x28, 0x6f, 0x38, 0x75, 0xc3, 0x7e, 0x3c,
+	0xb2, 0xf2, 0x51, 0xab, 0xe2, 0x97, 0x68, 0x9c, 0xca, 0x62, 0x99, 0x8b, 0xd6, 0xaa, 0xc7, 0xee,
+	0xed, 0xb4, 0x77, 0x4d, 0x88, 0xd9, 0x09, 0xb1, 0x3b, 0x21, 0x6f, 0xec, 0x4e, 0xa2, 0x5d, 0x7d,
+	0xd9, 0xf1, 0x8f, 0xc0, 0x8d, 0x47, 0x97, 0xb0, 0x4e, 0xe3, 0x23, 0x34, 0x86, 0x12, 0x14, 0xb0,
+	0x7c, 0x6e, 0x5b, 0xdf, 0xdd, 0xae, 0xf5, 0x51, 0xc7, 0x45, 0x06, 0x7b, 0x3c, 0xf8, 0xfa, 0x2d,
+	0x70, 0xa2, 0xe8, 0x74, 0xed, 0xbb, 0x67, 0x6b, 0xdf, 0xfd, 0xb9, 0xf6, 0xdd, 0xe3, 0x8d, 0xef,
+	0x9c, 0x6d, 0x7c, 0xe7, 0xfb, 0xc6, 0x77, 0xde, 0x87, 0x19, 0xa8, 0xc5, 0x2a, 0x21, 0xa9, 0x2c,
+	0xa8, 0xfe, 0xa3, 0xb3, 0x9c, 0x25, 0x75, 0x7b, 0xa2, 0x9f, 0xed, 0xa7, 0x56, 0x5f, 0x96, 0xa2,
+	0x4e, 0x86, 0xed, 0x0b, 0x1e, 0xfc, 0x1e, 0x00, 0xee, 0xe9, 0xfc, 0x87, 0xf0, 0x02, 0x00, 0x00,
+}
+
+func (m *UnbondingRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnbondingRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnbondingRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.InitialBalance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintUnbonding(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintUnbonding(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x3a
+	if m.CreationHeight != 0 {
+		i = encodeVarintUnbonding(dAtA, i, uint64(m.CreationHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintUnbonding(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Derivative.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintUnbonding(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintUnbonding(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintUnbonding(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintUnbonding(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintUnbonding(dAtA []byte, offset int, v uint64) int {
+	offset -= sovUnbonding(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *UnbondingRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovUnbonding(uint64(m.ID))
+	}
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovUnbonding(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovUnbonding(uint64(l))
+	}
+	l = m.Derivative.Size()
+	n += 1 + l + sovUnbonding(uint64(l))
+	l = m.Balance.Size()
+	n += 1 + l + sovUnbonding(uint64(l))
+	if m.CreationHeight != 0 {
+		n += 1 + sovUnbonding(uint64(m.CreationHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovUnbonding(uint64(l))
+	l = m.InitialBalance.Size()
+	n += 1 + l + sovUnbonding(uint64(l))
+	return n
+}
+
+func sovUnbonding(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozUnbonding(x uint64) (n int) {
+	return sovUnbonding(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *UnbondingRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUnbonding
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbondingRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbondingRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUnbonding
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUnbonding
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUnbonding
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Derivative", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUnbonding
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Derivative.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUnbonding
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUnbonding
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUnbonding
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUnbonding
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUnbonding(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipUnbonding(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowUnbonding
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowUnbonding
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowUnbonding
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthUnbonding
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupUnbonding
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthUnbonding
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthUnbonding        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowUnbonding          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupUnbonding = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,116 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kava-labs/kava/x/liquid/types"
+)
+
+func TestUnbondingRecords_Validate(t *testing.T) {
+	valAddr1 := mustValAddressFromBech32("kavavaloper1ypjp0m04pyp73hwgtc0dgkx0e9rrydeckewa42")
+	valAddr2 := mustValAddressFromBech32("kavavaloper16lnfpgn6llvn4fstg5nfrljj6aaxyee9z59jqd")
+	delegator := sdk.AccAddress(valAddr1)
+	denom1 := types.GetLiquidStakingTokenDenom(types.DefaultDerivativeDenom, valAddr1)
+	completionTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	validRecord := func() types.UnbondingRecord {
+		return types.NewUnbondingRecord(
+			1, delegator, valAddr1, sdk.NewInt64Coin(denom1, 1e6), sdk.NewInt64Coin("ukava", 1e6), 10, completionTime,
+		)
+	}
+
+	tests := []struct {
+		name    string
+		records func() types.UnbondingRecords
+		wantErr string
+	}{
+		{
+			name:    "empty is valid",
+			records: func() types.UnbondingRecords { return nil },
+		},
+		{
+			name: "valid records",
+			records: func() types.UnbondingRecords {
+				other := validRecord()
+				other.ID = 2
+				return types.UnbondingRecords{validRecord(), other}
+			},
+		},
+		{
+			name: "duplicate id",
+			records: func() types.UnbondingRecords {
+				return types.UnbondingRecords{validRecord(), validRecord()}
+			},
+			wantErr: "duplicate unbonding record id 1",
+		},
+		{
+			name: "zero id",
+			records: func() types.UnbondingRecords {
+				record := validRecord()
+				record.ID = 0
+				return types.UnbondingRecords{record}
+			},
+			wantErr: "id must be positive",
+		},
+		{
+			name: "zero derivative",
+			records: func() types.UnbondingRecords {
+				record := validRecord()
+				record.Derivative.Amount = sdkmath.ZeroInt()
+				return types.UnbondingRecords{record}
+			},
+			wantErr: "derivative must be positive, got 0" + denom1,
+		},
+		{
+			name: "validator does not match derivative",
+			records: func() types.UnbondingRecords {
+				record := validRecord()
+				record.ValidatorAddress = valAddr2.String()
+				return types.UnbondingRecords{record}
+			},
+			wantErr: "derivative " + denom1 + " does not match validator " + valAddr2.String(),
+		},
+		{
+			name: "invalid balance",
+			records: func() types.UnbondingRecords {
+				record := validRecord()
+				record.Balance.Amount = sdkmath.NewInt(-1)
+				return types.UnbondingRecords{record}
+			},
+			wantErr: "invalid balance -1ukava for initial balance 1000000ukava",
+		},
+		{
+			name: "slashed balance",
+			records: func() types.UnbondingRecords {
+				record := validRecord()
+				record.Balance.Amount = sdkmath.NewInt(9e5)
+				return types.UnbondingRecords{record}
+			},
+		},
+		{
+			name: "balance above initial balance",
+			records: func() types.UnbondingRecords {
+				record := validRecord()
+				record.Balance.Amount = sdkmath.NewInt(2e6)
+				return types.UnbondingRecords{record}
+			},
+			wantErr: "invalid balance 2000000ukava for initial balance 1000000ukava",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.records().Validate()
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
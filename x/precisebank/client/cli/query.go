@@ -0,0 +1,234 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/kava-labs/kava/x/precisebank/types"
+)
+
+const flagDenom = "denom"
+
+// GetQueryCmd returns the cli query commands for the precisebank module.
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	// commands that query a single extended denom
+	denomCmds := []*cobra.Command{
+		getCmdQueryTotalFractionalBalances(),
+		getCmdQueryRemainder(),
+		getCmdQueryFractionalBalance(),
+		getCmdQueryFractionalBalances(),
+		getCmdQueryAudit(),
+	}
+
+	for _, cmd := range denomCmds {
+		cmd.Flags().String(flagDenom, "", fmt.Sprintf("extended denom to query, defaults to %s", types.ExtendedCoinDenom))
+	}
+
+	cmds := append(denomCmds, getCmdQueryParams())
+
+	for _, cmd := range cmds {
+		flags.AddQueryFlagsToCmd(cmd)
+	}
+
+	queryCmd.AddCommand(cmds...)
+
+	return queryCmd
+}
+
+func getCmdQueryTotalFractionalBalances() *cobra.Command {
+	return &cobra.Command{
+		Use:   "total-fractional-balances",
+		Short: "Query the sum of all fractional balances",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			denom, err := cmd.Flags().GetString(flagDenom)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.TotalFractionalBalances(cmd.Context(), &types.QueryTotalFractionalBalancesRequest{
+				Denom: denom,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+func getCmdQueryRemainder() *cobra.Command {
+	return &cobra.Command{
+		Use:   "remainder",
+		Short: "Query the amount backed by the reserve but not owned by any account",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			denom, err := cmd.Flags().GetString(flagDenom)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Remainder(cmd.Context(), &types.QueryRemainderRequest{
+				Denom: denom,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+func getCmdQueryFractionalBalance() *cobra.Command {
+	return &cobra.Command{
+		Use:     "fractional-balance [address]",
+		Short:   "Query the fractional balance of an address",
+		Example: fmt.Sprintf("%s query %s fractional-balance kava1...", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			denom, err := cmd.Flags().GetString(flagDenom)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.FractionalBalance(cmd.Context(), &types.QueryFractionalBalanceRequest{
+				Address: args[0],
+				Denom:   denom,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+func getCmdQueryFractionalBalances() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "fractional-balances",
+		Short:   "Query the fractional balances of all accounts",
+		Example: fmt.Sprintf("%s query %s fractional-balances --limit 100", version.AppName, types.ModuleName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			denom, err := cmd.Flags().GetString(flagDenom)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.FractionalBalances(cmd.Context(), &types.QueryFractionalBalancesRequest{
+				Pagination: pageReq,
+				Denom:      denom,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "fractional balances")
+
+	return cmd
+}
+
+func getCmdQueryAudit() *cobra.Command {
+	return &cobra.Command{
+		Use:   "audit",
+		Short: "Run the module invariants and print a JSON reconciliation report",
+		Long: `Run all precisebank invariants against the current state and print a JSON report
+of their results, the sum of fractional balances, the remainder, the reserve balance,
+and the discrepancy between the reserve and the fractional balances plus remainder.`,
+		Example: fmt.Sprintf("%s query %s audit --height 100", version.AppName, types.ModuleName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			denom, err := cmd.Flags().GetString(flagDenom)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Reconciliation(cmd.Context(), &types.QueryReconciliationRequest{
+				Denom: denom,
+			})
+			if err != nil {
+				return err
+			}
+
+			// the report is always printed as json so it can be processed by other tools
+			return clientCtx.WithOutputFormat("json").PrintProto(res)
+		},
+	}
+}
+
+func getCmdQueryParams() *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Short: "Query the params and all denom extensions of the module",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
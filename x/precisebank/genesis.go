@@ -28,46 +28,87 @@ func InitGenesis(
 		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
 	}
 
+	keeper.SetParams(ctx, gs.Params)
+
+	initDenomBalances(ctx, keeper, ak, bk, types.DefaultDenomExtension(), gs.Balances, gs.Remainder)
+
+	for _, db := range gs.DenomBalances {
+		// Existence in params is verified in GenesisState.Validate()
+		ext, _ := gs.Params.DenomExtensions.Find(db.ExtendedDenom)
+
+		initDenomBalances(ctx, keeper, ak, bk, ext, db.Balances, db.Remainder)
+	}
+}
+
+// initDenomBalances checks the reserve backs the fractional balances and
+// remainder of a denom extension and sets them in state.
+func initDenomBalances(
+	ctx sdk.Context,
+	keeper keeper.Keeper,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	ext types.DenomExtension,
+	balances types.FractionalBalances,
+	remainder sdkmath.Int,
+) {
 	// Check module balance matches sum of fractional balances + remainder
 	// This is always a whole integer amount, as previously verified in
 	// GenesisState.Validate()
-	totalAmt := gs.TotalAmountWithRemainder()
+	totalAmt := balances.SumAmount().Add(remainder)
 
 	moduleAddr := ak.GetModuleAddress(types.ModuleName)
-	moduleBal := bk.GetBalance(ctx, moduleAddr, types.IntegerCoinDenom)
-	moduleBalExtended := moduleBal.Amount.Mul(types.ConversionFactor())
+	moduleBal := bk.GetBalance(ctx, moduleAddr, ext.IntegerDenom)
+	moduleBalExtended := moduleBal.Amount.Mul(ext.ConversionFactor)
 
 	// Compare balances in full precise extended amounts
 	if !totalAmt.Equal(moduleBalExtended) {
 		panic(fmt.Sprintf(
 			"module account balance does not match sum of fractional balances and remainder, balance is %s but expected %v%s (%v%s)",
 			moduleBal,
-			totalAmt, types.ExtendedCoinDenom,
-			totalAmt.Quo(types.ConversionFactor()), types.IntegerCoinDenom,
+			totalAmt, ext.ExtendedDenom,
+			totalAmt.Quo(ext.ConversionFactor), ext.IntegerDenom,
 		))
 	}
 
 	// Set FractionalBalances in state
-	for _, bal := range gs.Balances {
+	for _, bal := range balances {
 		addr := sdk.MustAccAddressFromBech32(bal.Address)
 
-		keeper.SetFractionalBalance(ctx, addr, bal.Amount)
+		keeper.SetDenomFractionalBalance(ctx, ext, addr, bal.Amount)
 	}
 
 	// Set remainder amount in state
-	keeper.SetRemainderAmount(ctx, gs.Remainder)
+	keeper.SetDenomRemainderAmount(ctx, ext, remainder)
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
+	params := keeper.GetParams(ctx)
+
+	balances, remainder := exportDenomBalances(ctx, keeper, types.DefaultDenomExtension())
+
+	denomBalances := make([]types.DenomBalances, 0, len(params.DenomExtensions))
+	for _, ext := range params.DenomExtensions {
+		extBalances, extRemainder := exportDenomBalances(ctx, keeper, ext)
+		denomBalances = append(denomBalances, types.NewDenomBalances(ext.ExtendedDenom, extBalances, extRemainder))
+	}
+
+	return types.NewGenesisStateWithDenoms(balances, remainder, params, denomBalances)
+}
+
+// exportDenomBalances returns the fractional balances and remainder of a
+// denom extension.
+func exportDenomBalances(
+	ctx sdk.Context,
+	keeper keeper.Keeper,
+	ext types.DenomExtension,
+) (types.FractionalBalances, sdkmath.Int) {
 	balances := types.FractionalBalances{}
-	keeper.IterateFractionalBalances(ctx, func(addr sdk.AccAddress, amount sdkmath.Int) bool {
+	keeper.IterateDenomFractionalBalances(ctx, ext, func(addr sdk.AccAddress, amount sdkmath.Int) bool {
 		balances = append(balances, types.NewFractionalBalance(addr.String(), amount))
 
 		return false
 	})
 
-	remainder := keeper.GetRemainderAmount(ctx)
-
-	return types.NewGenesisState(balances, remainder)
+	return balances, keeper.GetDenomRemainderAmount(ctx, ext)
 }
@@ -239,6 +239,34 @@ func (suite *GenesisTestSuite) TestExportGenesis() {
 				)
 			},
 		},
+		{
+			"denom extension balances, remainder",
+			func() *types.GenesisState {
+				err := suite.BankKeeper.MintCoins(
+					suite.Ctx,
+					types.ModuleName,
+					sdk.NewCoins(sdk.NewCoin("ucat", sdkmath.NewInt(1))),
+				)
+				suite.Require().NoError(err)
+
+				return types.NewGenesisStateWithDenoms(
+					types.FractionalBalances{},
+					sdkmath.ZeroInt(),
+					types.NewParams(types.DenomExtensions{
+						types.NewDenomExtension("ucat", "acat", sdkmath.NewInt(1_000)),
+					}),
+					[]types.DenomBalances{
+						types.NewDenomBalances(
+							"acat",
+							types.FractionalBalances{
+								types.NewFractionalBalance(sdk.AccAddress{1}.String(), sdkmath.NewInt(600)),
+							},
+							sdkmath.NewInt(400),
+						),
+					},
+				)
+			},
+		},
 	}
 
 	for _, tc := range tests {
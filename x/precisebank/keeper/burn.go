@@ -36,15 +36,9 @@ func (k Keeper) BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) err
 		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
 	}
 
-	// Get non-ExtendedCoinDenom coins
-	passthroughCoins := amt
-
-	extendedAmount := amt.AmountOf(types.ExtendedCoinDenom)
-	if extendedAmount.IsPositive() {
-		// Remove ExtendedCoinDenom from the coins as it is managed by x/precisebank
-		removeCoin := sdk.NewCoin(types.ExtendedCoinDenom, extendedAmount)
-		passthroughCoins = amt.Sub(removeCoin)
-	}
+	// Separate extended denom coins managed by x/precisebank from the rest
+	exts := k.GetDenomExtensions(ctx)
+	extendedCoins, passthroughCoins := exts.SplitExtendedCoins(amt)
 
 	// Coins unmanaged by x/precisebank are passed through to x/bank
 	if !passthroughCoins.Empty() {
@@ -53,14 +47,15 @@ func (k Keeper) BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) err
 		}
 	}
 
-	// Only burn extended coin if the amount is positive
-	if extendedAmount.IsPositive() {
-		if err := k.burnExtendedCoin(ctx, moduleName, extendedAmount); err != nil {
+	// Valid coins are always positive
+	for _, coin := range extendedCoins {
+		ext, _ := exts.Find(coin.Denom)
+		if err := k.burnExtendedCoin(ctx, ext, moduleName, coin.Amount); err != nil {
 			return err
 		}
 	}
 
-	fullEmissionCoins := sdk.NewCoins(types.SumExtendedCoin(amt))
+	fullEmissionCoins := exts.SumExtendedCoins(amt)
 	if fullEmissionCoins.IsZero() {
 		return nil
 	}
@@ -73,9 +68,10 @@ func (k Keeper) BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) err
 	return nil
 }
 
-// burnExtendedCoin burns the fractional amount of the ExtendedCoinDenom from the module account.
+// burnExtendedCoin burns the fractional amount of the extended denom from the module account.
 func (k Keeper) burnExtendedCoin(
 	ctx sdk.Context,
+	ext types.DenomExtension,
 	moduleName string,
 	amt sdkmath.Int,
 ) error {
@@ -84,16 +80,16 @@ func (k Keeper) burnExtendedCoin(
 
 	// We only need the fractional balance to burn coins, as integer burns will
 	// return errors on insufficient funds.
-	prevFractionalBalance := k.GetFractionalBalance(ctx, moduleAddr)
+	prevFractionalBalance := k.GetDenomFractionalBalance(ctx, ext, moduleAddr)
 
 	// Get remainder amount first to optimize direct burn.
-	prevRemainder := k.GetRemainderAmount(ctx)
+	prevRemainder := k.GetDenomRemainderAmount(ctx, ext)
 
 	// -------------------------------------------------------------------------
 	// Pure stateless calculations
 
-	integerBurnAmount := amt.Quo(types.ConversionFactor())
-	fractionalBurnAmount := amt.Mod(types.ConversionFactor())
+	integerBurnAmount := amt.Quo(ext.ConversionFactor)
+	fractionalBurnAmount := amt.Mod(ext.ConversionFactor)
 
 	// newFractionalBalance can be negative if fractional balance is insufficient.
 	newFractionalBalance := prevFractionalBalance.Sub(fractionalBurnAmount)
@@ -107,7 +103,7 @@ func (k Keeper) burnExtendedCoin(
 
 	// If true, remainder has accumulated enough fractional amounts to burn 1
 	// integer coin.
-	overflowingRemainder := newRemainder.GTE(types.ConversionFactor())
+	overflowingRemainder := newRemainder.GTE(ext.ConversionFactor)
 
 	// -------------------------------------------------------------------------
 	// Stateful operations for burn
@@ -123,8 +119,8 @@ func (k Keeper) burnExtendedCoin(
 	// Case #1: (optimization) direct burn instead of borrow (reserve transfer)
 	// & reserve burn. No additional reserve burn would be necessary after this.
 	if requiresBorrow && overflowingRemainder {
-		newFractionalBalance = newFractionalBalance.Add(types.ConversionFactor())
-		newRemainder = newRemainder.Sub(types.ConversionFactor())
+		newFractionalBalance = newFractionalBalance.Add(ext.ConversionFactor)
+		newRemainder = newRemainder.Sub(ext.ConversionFactor)
 
 		integerBurnAmount = integerBurnAmount.AddRaw(1)
 	}
@@ -132,32 +128,32 @@ func (k Keeper) burnExtendedCoin(
 	// Case #2: Transfer 1 integer coin to reserve for integer borrow to ensure
 	// reserve fully backs the fractional amount.
 	if requiresBorrow && !overflowingRemainder {
-		newFractionalBalance = newFractionalBalance.Add(types.ConversionFactor())
+		newFractionalBalance = newFractionalBalance.Add(ext.ConversionFactor)
 
 		// Transfer 1 integer coin to reserve to cover the borrowed fractional
 		// amount. SendCoinsFromModuleToModule will return an error if the
 		// module account has insufficient funds and an error with the full
 		// extended balance will be returned.
-		borrowCoin := sdk.NewCoin(types.IntegerCoinDenom, sdkmath.OneInt())
+		borrowCoin := sdk.NewCoin(ext.IntegerDenom, sdkmath.OneInt())
 		if err := k.bk.SendCoinsFromModuleToModule(
 			ctx,
 			moduleName,
 			types.ModuleName, // borrowed integer is transferred to reserve
 			sdk.NewCoins(borrowCoin),
 		); err != nil {
-			return k.updateInsufficientFundsError(ctx, moduleAddr, amt, err)
+			return k.updateInsufficientFundsError(ctx, ext, moduleAddr, amt, err)
 		}
 	}
 
 	// Case #3: Does not require borrow, but remainder has accumulated enough
 	// fractional amounts to burn 1 integer coin.
 	if !requiresBorrow && overflowingRemainder {
-		reserveBurnCoins := sdk.NewCoins(sdk.NewCoin(types.IntegerCoinDenom, sdkmath.OneInt()))
+		reserveBurnCoins := sdk.NewCoins(sdk.NewCoin(ext.IntegerDenom, sdkmath.OneInt()))
 		if err := k.bk.BurnCoins(ctx, types.ModuleName, reserveBurnCoins); err != nil {
 			return fmt.Errorf("failed to burn %s for reserve: %w", reserveBurnCoins, err)
 		}
 
-		newRemainder = newRemainder.Sub(types.ConversionFactor())
+		newRemainder = newRemainder.Sub(ext.ConversionFactor)
 	}
 
 	// Case #4: No additional work required, no borrow needed and no additional
@@ -166,17 +162,17 @@ func (k Keeper) burnExtendedCoin(
 	// Burn the integer amount - this may include the extra optimization burn
 	// from case #1
 	if !integerBurnAmount.IsZero() {
-		coin := sdk.NewCoin(types.IntegerCoinDenom, integerBurnAmount)
+		coin := sdk.NewCoin(ext.IntegerDenom, integerBurnAmount)
 		if err := k.bk.BurnCoins(ctx, moduleName, sdk.NewCoins(coin)); err != nil {
-			return k.updateInsufficientFundsError(ctx, moduleAddr, amt, err)
+			return k.updateInsufficientFundsError(ctx, ext, moduleAddr, amt, err)
 		}
 	}
 
 	// Assign new fractional balance in x/precisebank
-	k.SetFractionalBalance(ctx, moduleAddr, newFractionalBalance)
+	k.SetDenomFractionalBalance(ctx, ext, moduleAddr, newFractionalBalance)
 
 	// Update remainder for burned fractional coins
-	k.SetRemainderAmount(ctx, newRemainder)
+	k.SetDenomRemainderAmount(ctx, ext, newRemainder)
 
 	return nil
 }
@@ -0,0 +1,168 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/suite"
+
+	evmutiltypes "github.com/kava-labs/kava/x/evmutil/types"
+	"github.com/kava-labs/kava/x/precisebank/keeper"
+	"github.com/kava-labs/kava/x/precisebank/testutil"
+	"github.com/kava-labs/kava/x/precisebank/types"
+)
+
+type denomExtensionIntegrationTestSuite struct {
+	testutil.Suite
+
+	catExt types.DenomExtension
+}
+
+func (suite *denomExtensionIntegrationTestSuite) SetupTest() {
+	suite.Suite.SetupTest()
+
+	suite.catExt = types.NewDenomExtension("ucat", "acat", sdkmath.NewInt(1_000))
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.DenomExtensions{suite.catExt}))
+}
+
+func TestDenomExtensionIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(denomExtensionIntegrationTestSuite))
+}
+
+func (suite *denomExtensionIntegrationTestSuite) requireInvariantsValid() {
+	res, stop := keeper.AllInvariants(suite.Keeper)(suite.Ctx)
+	suite.Require().False(stop, "invariants should not be broken: %s", res)
+}
+
+func (suite *denomExtensionIntegrationTestSuite) TestMintSendBurn() {
+	moduleAddr := suite.AccountKeeper.GetModuleAddress(evmutiltypes.ModuleName)
+	reserveAddr := suite.AccountKeeper.GetModuleAddress(types.ModuleName)
+	recipient := sdk.AccAddress([]byte{1})
+
+	// Mint 1.5 ucat in acat and 1 akava in the same call
+	err := suite.Keeper.MintCoins(suite.Ctx, evmutiltypes.ModuleName, cs(c("acat", 1_500), c(types.ExtendedCoinDenom, 1)))
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(c("acat", 1_500), suite.Keeper.GetBalance(suite.Ctx, moduleAddr, "acat"))
+	suite.Require().Equal(c("ucat", 1), suite.BankKeeper.GetBalance(suite.Ctx, moduleAddr, "ucat"))
+	suite.Require().Equal(sdkmath.NewInt(500), suite.Keeper.GetDenomFractionalBalance(suite.Ctx, suite.catExt, moduleAddr))
+	suite.Require().Equal(c(types.ExtendedCoinDenom, 1), suite.Keeper.GetBalance(suite.Ctx, moduleAddr, types.ExtendedCoinDenom))
+	suite.Require().Equal(c("ucat", 1), suite.BankKeeper.GetBalance(suite.Ctx, reserveAddr, "ucat"), "reserve should back fractional acat")
+	suite.Require().Equal(c("acat", 0), suite.Keeper.GetBalance(suite.Ctx, reserveAddr, "acat"), "reserve should not display extended balance")
+	suite.Require().Equal(c("acat", 1_500), suite.Keeper.SpendableCoin(suite.Ctx, moduleAddr, "acat"))
+	suite.requireInvariantsValid()
+
+	// Send with a borrow from the sender
+	err = suite.Keeper.SendCoinsFromModuleToAccount(suite.Ctx, evmutiltypes.ModuleName, recipient, cs(c("acat", 700)))
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(c("acat", 800), suite.Keeper.GetBalance(suite.Ctx, moduleAddr, "acat"))
+	suite.Require().Equal(c("acat", 700), suite.Keeper.GetBalance(suite.Ctx, recipient, "acat"))
+	suite.requireInvariantsValid()
+
+	// Insufficient funds errors use the extended denom
+	err = suite.Keeper.SendCoins(suite.Ctx, recipient, moduleAddr, cs(c("acat", 701)))
+	suite.Require().ErrorContains(err, "spendable balance 700acat is smaller than 701acat")
+
+	// Burn the remaining module balance
+	err = suite.Keeper.BurnCoins(suite.Ctx, evmutiltypes.ModuleName, cs(c("acat", 800)))
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(c("acat", 0), suite.Keeper.GetBalance(suite.Ctx, moduleAddr, "acat"))
+	suite.Require().Equal(sdkmath.NewInt(300), suite.Keeper.GetDenomRemainderAmount(suite.Ctx, suite.catExt))
+	suite.Require().Equal(c("ucat", 1), suite.BankKeeper.GetBalance(suite.Ctx, reserveAddr, "ucat"))
+	suite.requireInvariantsValid()
+
+	// Built-in extension is unaffected by the acat remainder
+	suite.Require().Equal(types.ConversionFactor().SubRaw(1), suite.Keeper.GetRemainderAmount(suite.Ctx))
+}
+
+func (suite *denomExtensionIntegrationTestSuite) TestInvariants_PerDenom() {
+	addr := sdk.AccAddress([]byte{1})
+
+	// Fractional acat balance without any ucat in the reserve
+	suite.Keeper.SetDenomFractionalBalance(suite.Ctx, suite.catExt, addr, sdkmath.NewInt(999))
+
+	msg, broken := keeper.ReserveBacksFractionsInvariant(suite.Keeper)(suite.Ctx)
+	suite.Require().True(broken)
+	suite.Require().Contains(msg, "acat reserve balance 0 mismatches 999 (fractional balances 999 + remainder 0)")
+
+	msg, broken = keeper.BalancedFractionalTotalInvariant(suite.Keeper)(suite.Ctx)
+	suite.Require().True(broken)
+	suite.Require().Equal(
+		"precisebank: balance-remainder-total invariant\nacat: (sum(FractionalBalances) + remainder) % conversionFactor should be 0 but got 999\n",
+		msg,
+	)
+
+	// akava invariants are not broken by acat
+	_, broken = keeper.ValidFractionalAmountsInvariant(suite.Keeper)(suite.Ctx)
+	suite.Require().False(broken)
+}
+
+func (suite *denomExtensionIntegrationTestSuite) TestUpdateParams() {
+	msgServer := keeper.NewMsgServerImpl(suite.Keeper)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	dogExt := types.NewDenomExtension("udog", "adog", sdkmath.NewInt(1_000_000))
+
+	// Unauthorized
+	msg := types.NewMsgUpdateParams(sdk.AccAddress([]byte{1}), types.DefaultParams())
+	_, err := msgServer.UpdateParams(sdk.WrapSDKContext(suite.Ctx), &msg)
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	// Invalid params
+	msg = types.NewMsgUpdateParams(authority, types.NewParams(types.DenomExtensions{types.DefaultDenomExtension()}))
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(suite.Ctx), &msg)
+	suite.Require().ErrorIs(err, types.ErrInvalidParams)
+
+	// Add a denom extension
+	msg = types.NewMsgUpdateParams(authority, types.NewParams(types.DenomExtensions{suite.catExt, dogExt}))
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(suite.Ctx), &msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(msg.Params, suite.Keeper.GetParams(suite.Ctx))
+
+	ext, found := suite.Keeper.GetDenomExtension(suite.Ctx, "adog")
+	suite.Require().True(found)
+	suite.Require().Equal(dogExt, ext)
+
+	// Cannot remove a denom extension with fractional balances
+	err = suite.Keeper.MintCoins(suite.Ctx, evmutiltypes.ModuleName, cs(c("acat", 1)))
+	suite.Require().NoError(err)
+
+	msg = types.NewMsgUpdateParams(authority, types.NewParams(types.DenomExtensions{dogExt}))
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(suite.Ctx), &msg)
+	suite.Require().ErrorIs(err, types.ErrInvalidParams)
+	suite.Require().ErrorContains(err, "denom extension acat has fractional balances and cannot be removed or changed")
+
+	// Can remove a denom extension without fractional balances
+	msg = types.NewMsgUpdateParams(authority, types.NewParams(types.DenomExtensions{suite.catExt}))
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(suite.Ctx), &msg)
+	suite.Require().NoError(err)
+
+	// Cannot add a denom extension of a denom already in x/bank
+	err = suite.BankKeeper.MintCoins(suite.Ctx, evmutiltypes.ModuleName, cs(c("adog", 1)))
+	suite.Require().NoError(err)
+
+	msg = types.NewMsgUpdateParams(authority, types.NewParams(types.DenomExtensions{suite.catExt, dogExt}))
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(suite.Ctx), &msg)
+	suite.Require().ErrorIs(err, types.ErrInvalidParams)
+	suite.Require().ErrorContains(err, "extended denom adog already has x/bank supply of 1adog")
+}
+
+func (suite *denomExtensionIntegrationTestSuite) TestGetDenomExtensions_DiscardedParams() {
+	dogExt := types.NewDenomExtension("udog", "adog", sdkmath.NewInt(1_000_000))
+
+	// Set params in a cache context that is never written
+	cacheCtx, _ := suite.Ctx.CacheContext()
+	suite.Keeper.SetParams(cacheCtx, types.NewParams(types.DenomExtensions{suite.catExt, dogExt}))
+
+	_, found := suite.Keeper.GetDenomExtension(cacheCtx, "adog")
+	suite.Require().True(found)
+
+	// The parent context still uses its own params
+	_, found = suite.Keeper.GetDenomExtension(suite.Ctx, "adog")
+	suite.Require().False(found, "discarded params should not be used")
+	suite.Require().Equal(types.NewParams(types.DenomExtensions{suite.catExt}).AllDenomExtensions(), suite.Keeper.GetDenomExtensions(suite.Ctx))
+}
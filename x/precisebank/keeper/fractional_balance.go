@@ -7,6 +7,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/kava-labs/kava/x/precisebank/types"
 )
@@ -16,7 +17,48 @@ func (k *Keeper) GetFractionalBalance(
 	ctx sdk.Context,
 	address sdk.AccAddress,
 ) sdkmath.Int {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FractionalBalancePrefix)
+	return k.GetDenomFractionalBalance(ctx, types.DefaultDenomExtension(), address)
+}
+
+// SetFractionalBalance sets the fractional balance for an address.
+func (k *Keeper) SetFractionalBalance(
+	ctx sdk.Context,
+	address sdk.AccAddress,
+	amount sdkmath.Int,
+) {
+	k.SetDenomFractionalBalance(ctx, types.DefaultDenomExtension(), address, amount)
+}
+
+// DeleteFractionalBalance deletes the fractional balance for an address.
+func (k *Keeper) DeleteFractionalBalance(
+	ctx sdk.Context,
+	address sdk.AccAddress,
+) {
+	k.DeleteDenomFractionalBalance(ctx, types.DefaultDenomExtension(), address)
+}
+
+// IterateFractionalBalances iterates over all fractional balances in the store
+// and performs a callback function.
+func (k *Keeper) IterateFractionalBalances(
+	ctx sdk.Context,
+	cb func(address sdk.AccAddress, amount sdkmath.Int) (stop bool),
+) {
+	k.IterateDenomFractionalBalances(ctx, types.DefaultDenomExtension(), cb)
+}
+
+// GetTotalSumFractionalBalances returns the sum of all fractional balances.
+func (k *Keeper) GetTotalSumFractionalBalances(ctx sdk.Context) sdkmath.Int {
+	return k.GetTotalSumDenomFractionalBalances(ctx, types.DefaultDenomExtension())
+}
+
+// GetDenomFractionalBalance returns the fractional balance of the extended
+// denom for an address.
+func (k *Keeper) GetDenomFractionalBalance(
+	ctx sdk.Context,
+	ext types.DenomExtension,
+	address sdk.AccAddress,
+) sdkmath.Int {
+	store := k.denomFractionalBalanceStore(ctx, ext)
 
 	bz := store.Get(types.FractionalBalanceKey(address))
 	if bz == nil {
@@ -31,9 +73,11 @@ func (k *Keeper) GetFractionalBalance(
 	return bal
 }
 
-// SetFractionalBalance sets the fractional balance for an address.
-func (k *Keeper) SetFractionalBalance(
+// SetDenomFractionalBalance sets the fractional balance of the extended denom
+// for an address.
+func (k *Keeper) SetDenomFractionalBalance(
 	ctx sdk.Context,
+	ext types.DenomExtension,
 	address sdk.AccAddress,
 	amount sdkmath.Int,
 ) {
@@ -42,17 +86,17 @@ func (k *Keeper) SetFractionalBalance(
 	}
 
 	if amount.IsZero() {
-		k.DeleteFractionalBalance(ctx, address)
+		k.DeleteDenomFractionalBalance(ctx, ext, address)
 		return
 	}
 
-	// Ensure the fractional balance is valid before setting it. Use the
-	// NewFractionalAmountFromInt wrapper to use its Validate() method.
-	if err := types.ValidateFractionalAmount(amount); err != nil {
+	// Ensure the fractional balance is valid for the denom extension before
+	// setting it.
+	if err := ext.ValidateFractionalAmount(amount); err != nil {
 		panic(fmt.Errorf("amount is invalid: %w", err))
 	}
 
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FractionalBalancePrefix)
+	store := k.denomFractionalBalanceStore(ctx, ext)
 
 	amountBytes, err := amount.Marshal()
 	if err != nil {
@@ -62,22 +106,25 @@ func (k *Keeper) SetFractionalBalance(
 	store.Set(types.FractionalBalanceKey(address), amountBytes)
 }
 
-// DeleteFractionalBalance deletes the fractional balance for an address.
-func (k *Keeper) DeleteFractionalBalance(
+// DeleteDenomFractionalBalance deletes the fractional balance of the extended
+// denom for an address.
+func (k *Keeper) DeleteDenomFractionalBalance(
 	ctx sdk.Context,
+	ext types.DenomExtension,
 	address sdk.AccAddress,
 ) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FractionalBalancePrefix)
+	store := k.denomFractionalBalanceStore(ctx, ext)
 	store.Delete(types.FractionalBalanceKey(address))
 }
 
-// IterateFractionalBalances iterates over all fractional balances in the store
-// and performs a callback function.
-func (k *Keeper) IterateFractionalBalances(
+// IterateDenomFractionalBalances iterates over all fractional balances of the
+// extended denom in the store and performs a callback function.
+func (k *Keeper) IterateDenomFractionalBalances(
 	ctx sdk.Context,
+	ext types.DenomExtension,
 	cb func(address sdk.AccAddress, amount sdkmath.Int) (stop bool),
 ) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FractionalBalancePrefix)
+	store := k.denomFractionalBalanceStore(ctx, ext)
 
 	iterator := store.Iterator(nil, nil)
 	defer iterator.Close()
@@ -96,14 +143,56 @@ func (k *Keeper) IterateFractionalBalances(
 	}
 }
 
-// GetTotalSumFractionalBalances returns the sum of all fractional balances.
-func (k *Keeper) GetTotalSumFractionalBalances(ctx sdk.Context) sdkmath.Int {
+// GetTotalSumDenomFractionalBalances returns the sum of all fractional
+// balances of the extended denom.
+func (k *Keeper) GetTotalSumDenomFractionalBalances(
+	ctx sdk.Context,
+	ext types.DenomExtension,
+) sdkmath.Int {
 	sum := sdkmath.ZeroInt()
 
-	k.IterateFractionalBalances(ctx, func(_ sdk.AccAddress, amount sdkmath.Int) bool {
+	k.IterateDenomFractionalBalances(ctx, ext, func(_ sdk.AccAddress, amount sdkmath.Int) bool {
 		sum = sum.Add(amount)
 		return false
 	})
 
 	return sum
 }
+
+// GetPaginatedFractionalBalances returns a page of the fractional balances of
+// the extended denom in the store.
+func (k *Keeper) GetPaginatedFractionalBalances(
+	ctx sdk.Context,
+	ext types.DenomExtension,
+	pageReq *query.PageRequest,
+) (types.FractionalBalances, *query.PageResponse, error) {
+	store := k.denomFractionalBalanceStore(ctx, ext)
+
+	var balances types.FractionalBalances
+	pageRes, err := query.Paginate(store, pageReq, func(key, value []byte) error {
+		var amount sdkmath.Int
+		if err := amount.Unmarshal(value); err != nil {
+			return fmt.Errorf("failed to unmarshal fractional balance: %w", err)
+		}
+
+		balances = append(balances, types.NewFractionalBalance(sdk.AccAddress(key).String(), amount))
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return balances, pageRes, nil
+}
+
+// denomFractionalBalanceStore returns the prefix store of the fractional
+// balances of the extended denom.
+func (k *Keeper) denomFractionalBalanceStore(
+	ctx sdk.Context,
+	ext types.DenomExtension,
+) prefix.Store {
+	return prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.DenomFractionalBalancePrefixKey(ext.ExtendedDenom),
+	)
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/kava-labs/kava/x/precisebank/types"
 )
@@ -28,9 +30,14 @@ func (s queryServer) TotalFractionalBalances(
 ) (*types.QueryTotalFractionalBalancesResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	totalAmount := s.keeper.GetTotalSumFractionalBalances(ctx)
+	ext, err := s.denomExtension(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	totalAmount := s.keeper.GetTotalSumDenomFractionalBalances(ctx, ext)
 
-	totalCoin := sdk.NewCoin(types.ExtendedCoinDenom, totalAmount)
+	totalCoin := sdk.NewCoin(ext.ExtendedDenom, totalAmount)
 
 	return &types.QueryTotalFractionalBalancesResponse{
 		Total: totalCoin,
@@ -44,8 +51,13 @@ func (s queryServer) Remainder(
 ) (*types.QueryRemainderResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	remainder := s.keeper.GetRemainderAmount(ctx)
-	remainderCoin := sdk.NewCoin(types.ExtendedCoinDenom, remainder)
+	ext, err := s.denomExtension(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	remainder := s.keeper.GetDenomRemainderAmount(ctx, ext)
+	remainderCoin := sdk.NewCoin(ext.ExtendedDenom, remainder)
 
 	return &types.QueryRemainderResponse{
 		Remainder: remainderCoin,
@@ -64,10 +76,114 @@ func (s queryServer) FractionalBalance(
 		return nil, err
 	}
 
-	amt := s.keeper.GetFractionalBalance(ctx, address)
-	fractionalBalance := sdk.NewCoin(types.ExtendedCoinDenom, amt)
+	ext, err := s.denomExtension(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	amt := s.keeper.GetDenomFractionalBalance(ctx, ext, address)
+	fractionalBalance := sdk.NewCoin(ext.ExtendedDenom, amt)
 
 	return &types.QueryFractionalBalanceResponse{
 		FractionalBalance: fractionalBalance,
 	}, nil
 }
+
+// FractionalBalances returns a page of the fractional balances of all
+// accounts.
+func (s queryServer) FractionalBalances(
+	goCtx context.Context,
+	req *types.QueryFractionalBalancesRequest,
+) (*types.QueryFractionalBalancesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ext, err := s.denomExtension(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	balances, pageRes, err := s.keeper.GetPaginatedFractionalBalances(ctx, ext, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryFractionalBalancesResponse{
+		Balances:   balances,
+		Pagination: pageRes,
+	}, nil
+}
+
+// Reconciliation runs all invariants on demand and returns their results,
+// along with the reserve balance of the requested denom and its discrepancy
+// with the sum of fractional balances and the remainder.
+func (s queryServer) Reconciliation(
+	goCtx context.Context,
+	req *types.QueryReconciliationRequest,
+) (*types.QueryReconciliationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ext, err := s.denomExtension(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	totalAmount := s.keeper.GetTotalSumDenomFractionalBalances(ctx, ext)
+	remainder := s.keeper.GetDenomRemainderAmount(ctx, ext)
+	reserve := s.keeper.GetReserveExtendedBalance(ctx, ext)
+
+	invariants := RunInvariants(ctx, s.keeper)
+	broken := false
+	for _, res := range invariants {
+		broken = broken || res.Broken
+	}
+
+	return &types.QueryReconciliationResponse{
+		TotalFractionalBalances: sdk.NewCoin(ext.ExtendedDenom, totalAmount),
+		Remainder:               sdk.NewCoin(ext.ExtendedDenom, remainder),
+		Reserve:                 sdk.NewCoin(ext.ExtendedDenom, reserve),
+		Discrepancy:             reserve.Sub(totalAmount.Add(remainder)),
+		Invariants:              invariants,
+		Broken:                  broken,
+	}, nil
+}
+
+// Params returns the params and all denom extensions of the module.
+func (s queryServer) Params(
+	goCtx context.Context,
+	req *types.QueryParamsRequest,
+) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params := s.keeper.GetParams(ctx)
+
+	return &types.QueryParamsResponse{
+		Params:          params,
+		DenomExtensions: params.AllDenomExtensions(),
+	}, nil
+}
+
+// denomExtension returns the denom extension of the requested extended denom,
+// defaulting to ExtendedCoinDenom if empty.
+func (s queryServer) denomExtension(
+	ctx sdk.Context,
+	denom string,
+) (types.DenomExtension, error) {
+	if denom == "" {
+		return types.DefaultDenomExtension(), nil
+	}
+
+	ext, found := s.keeper.GetDenomExtension(ctx, denom)
+	if !found {
+		return types.DenomExtension{}, status.Errorf(codes.NotFound, "denom %s is not an extended denom", denom)
+	}
+
+	return ext, nil
+}
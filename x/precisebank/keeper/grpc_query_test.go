@@ -8,8 +8,11 @@ import (
 	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/kava-labs/kava/x/precisebank/keeper"
 	"github.com/kava-labs/kava/x/precisebank/testutil"
@@ -169,3 +172,140 @@ func (suite *grpcQueryTestSuite) TestQueryFractionalBalance() {
 		})
 	}
 }
+
+func (suite *grpcQueryTestSuite) TestQueryFractionalBalances() {
+	var expBalances types.FractionalBalances
+	for i := 1; i <= 5; i++ {
+		addr := sdk.AccAddress([]byte(strconv.Itoa(i)))
+		amount := types.ConversionFactor().QuoRaw(int64(i + 1))
+		suite.Keeper.SetFractionalBalance(suite.Ctx, addr, amount)
+
+		expBalances = append(expBalances, types.NewFractionalBalance(addr.String(), amount))
+	}
+
+	var (
+		balances types.FractionalBalances
+		nextKey  []byte
+	)
+	for {
+		res, err := suite.queryClient.FractionalBalances(
+			context.Background(),
+			&types.QueryFractionalBalancesRequest{
+				Pagination: &query.PageRequest{Key: nextKey, Limit: 2},
+			},
+		)
+		suite.Require().NoError(err)
+		suite.Require().LessOrEqual(len(res.Balances), 2)
+
+		balances = append(balances, res.Balances...)
+		nextKey = res.Pagination.NextKey
+		if nextKey == nil {
+			break
+		}
+	}
+
+	suite.Require().ElementsMatch(expBalances, balances)
+
+	res, err := suite.queryClient.FractionalBalances(
+		context.Background(),
+		&types.QueryFractionalBalancesRequest{
+			Pagination: &query.PageRequest{CountTotal: true},
+		},
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(len(expBalances)), res.Pagination.Total)
+}
+
+func (suite *grpcQueryTestSuite) TestQueryReconciliation() {
+	addr := sdk.AccAddress([]byte("test"))
+	amount := types.ConversionFactor().MulRaw(2).Add(types.ConversionFactor().QuoRaw(2))
+	suite.MintToAccount(addr, sdk.NewCoins(sdk.NewCoin(types.ExtendedCoinDenom, amount)))
+
+	res, err := suite.queryClient.Reconciliation(
+		context.Background(),
+		&types.QueryReconciliationRequest{},
+	)
+	suite.Require().NoError(err)
+
+	halfConversionFactor := types.ConversionFactor().QuoRaw(2)
+	suite.Require().Equal(sdk.NewCoin(types.ExtendedCoinDenom, halfConversionFactor), res.TotalFractionalBalances)
+	suite.Require().Equal(sdk.NewCoin(types.ExtendedCoinDenom, halfConversionFactor), res.Remainder)
+	suite.Require().Equal(sdk.NewCoin(types.ExtendedCoinDenom, types.ConversionFactor()), res.Reserve)
+	suite.Require().Equal(sdkmath.ZeroInt(), res.Discrepancy)
+	suite.Require().False(res.Broken)
+	suite.Require().Len(res.Invariants, 5)
+	for _, invariant := range res.Invariants {
+		suite.Require().False(invariant.Broken, invariant.Message)
+	}
+
+	// an unbacked fractional balance is reported as a discrepancy
+	suite.Keeper.SetFractionalBalance(suite.Ctx, sdk.AccAddress([]byte("other")), halfConversionFactor)
+
+	res, err = suite.queryClient.Reconciliation(
+		context.Background(),
+		&types.QueryReconciliationRequest{},
+	)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(halfConversionFactor.Neg(), res.Discrepancy)
+	suite.Require().True(res.Broken)
+	suite.Require().Equal("reserve-backs-fractions", res.Invariants[0].Route)
+	suite.Require().True(res.Invariants[0].Broken)
+	suite.Require().Equal("valid-fractional-balances", res.Invariants[2].Route)
+	suite.Require().False(res.Invariants[2].Broken)
+
+	_, err = keeper.NewQueryServerImpl(suite.Keeper).Reconciliation(sdk.WrapSDKContext(suite.Ctx), nil)
+	suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (suite *grpcQueryTestSuite) TestQueryParams() {
+	catExt := types.NewDenomExtension("ucat", "acat", sdkmath.NewInt(1_000))
+	params := types.NewParams(types.DenomExtensions{catExt})
+	suite.Keeper.SetParams(suite.Ctx, params)
+
+	res, err := suite.queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(params, res.Params)
+	suite.Require().Equal(types.DenomExtensions{types.DefaultDenomExtension(), catExt}, res.DenomExtensions)
+}
+
+func (suite *grpcQueryTestSuite) TestQueryDenom() {
+	catExt := types.NewDenomExtension("ucat", "acat", sdkmath.NewInt(1_000))
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.DenomExtensions{catExt}))
+
+	addr := sdk.AccAddress([]byte("test"))
+	suite.Keeper.SetDenomFractionalBalance(suite.Ctx, catExt, addr, sdkmath.NewInt(600))
+	suite.Keeper.SetDenomRemainderAmount(suite.Ctx, catExt, sdkmath.NewInt(400))
+
+	total, err := suite.queryClient.TotalFractionalBalances(context.Background(), &types.QueryTotalFractionalBalancesRequest{Denom: "acat"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin("acat", 600), total.Total)
+
+	remainder, err := suite.queryClient.Remainder(context.Background(), &types.QueryRemainderRequest{Denom: "acat"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin("acat", 400), remainder.Remainder)
+
+	bal, err := suite.queryClient.FractionalBalance(context.Background(), &types.QueryFractionalBalanceRequest{
+		Address: addr.String(),
+		Denom:   "acat",
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin("acat", 600), bal.FractionalBalance)
+
+	// akava is unaffected
+	bal, err = suite.queryClient.FractionalBalance(context.Background(), &types.QueryFractionalBalanceRequest{
+		Address: addr.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(types.ExtendedCoinDenom, 0), bal.FractionalBalance)
+
+	// Reserve has no ucat backing
+	rec, err := suite.queryClient.Reconciliation(context.Background(), &types.QueryReconciliationRequest{Denom: "acat"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdkmath.NewInt(-1_000), rec.Discrepancy)
+	suite.Require().True(rec.Broken)
+
+	_, err = suite.queryClient.Remainder(context.Background(), &types.QueryRemainderRequest{Denom: "ucat"})
+	suite.Require().ErrorContains(err, "denom ucat is not an extended denom")
+}
@@ -14,42 +14,57 @@ func RegisterInvariants(
 	k Keeper,
 	bk types.BankKeeper,
 ) {
-	ir.RegisterRoute(types.ModuleName, "reserve-backs-fractions", ReserveBacksFractionsInvariant(k))
-	ir.RegisterRoute(types.ModuleName, "balance-remainder-total", BalancedFractionalTotalInvariant(k))
-	ir.RegisterRoute(types.ModuleName, "valid-fractional-balances", ValidFractionalAmountsInvariant(k))
-	ir.RegisterRoute(types.ModuleName, "valid-remainder-amount", ValidRemainderAmountInvariant(k))
-	ir.RegisterRoute(types.ModuleName, "fractional-denom-not-in-bank", FractionalDenomNotInBankInvariant(k))
+	for _, r := range invariantRoutes(k) {
+		ir.RegisterRoute(types.ModuleName, r.route, r.invariant)
+	}
 }
 
 // AllInvariants runs all invariants of the X/precisebank module.
 func AllInvariants(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
-		res, stop := ReserveBacksFractionsInvariant(k)(ctx)
-		if stop {
-			return res, stop
+		for _, r := range invariantRoutes(k) {
+			res, stop := r.invariant(ctx)
+			if stop {
+				return res, stop
+			}
 		}
 
-		res, stop = BalancedFractionalTotalInvariant(k)(ctx)
-		if stop {
-			return res, stop
-		}
+		return "", false
+	}
+}
 
-		res, stop = ValidFractionalAmountsInvariant(k)(ctx)
-		if stop {
-			return res, stop
-		}
+// RunInvariants runs every invariant of the x/precisebank module, without
+// stopping at the first broken invariant, and returns their results.
+func RunInvariants(ctx sdk.Context, k Keeper) []types.InvariantResult {
+	routes := invariantRoutes(k)
+	results := make([]types.InvariantResult, 0, len(routes))
+	for _, r := range routes {
+		msg, broken := r.invariant(ctx)
+		results = append(results, types.InvariantResult{
+			Route:   r.route,
+			Broken:  broken,
+			Message: msg,
+		})
+	}
 
-		res, stop = ValidRemainderAmountInvariant(k)(ctx)
-		if stop {
-			return res, stop
-		}
+	return results
+}
 
-		res, stop = FractionalDenomNotInBankInvariant(k)(ctx)
-		if stop {
-			return res, stop
-		}
+// invariantRoute is an invariant and the route it is registered under.
+type invariantRoute struct {
+	route     string
+	invariant sdk.Invariant
+}
 
-		return "", false
+// invariantRoutes returns the invariants of the x/precisebank module in the
+// order they are run.
+func invariantRoutes(k Keeper) []invariantRoute {
+	return []invariantRoute{
+		{"reserve-backs-fractions", ReserveBacksFractionsInvariant(k)},
+		{"balance-remainder-total", BalancedFractionalTotalInvariant(k)},
+		{"valid-fractional-balances", ValidFractionalAmountsInvariant(k)},
+		{"valid-remainder-amount", ValidRemainderAmountInvariant(k)},
+		{"fractional-denom-not-in-bank", FractionalDenomNotInBankInvariant(k)},
 	}
 }
 
@@ -57,7 +72,7 @@ func AllInvariants(k Keeper) sdk.Invariant {
 // coins in the reserve is equal to the total amount of fractional balances,
 // such that the backing is always available to redeem all fractional balances
 // and there are no extra coins in the reserve that are not backing any
-// fractional balances.
+// fractional balances. This is checked for each denom extension.
 func ReserveBacksFractionsInvariant(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
 		var (
@@ -65,27 +80,27 @@ func ReserveBacksFractionsInvariant(k Keeper) sdk.Invariant {
 			broken bool
 		)
 
-		fractionalBalSum := k.GetTotalSumFractionalBalances(ctx)
-		remainderAmount := k.GetRemainderAmount(ctx)
-
-		// Get the total amount of backing coins in the reserve
-		moduleAddr := k.ak.GetModuleAddress(types.ModuleName)
-		reserveIntegerBalance := k.bk.GetBalance(ctx, moduleAddr, types.IntegerCoinDenom)
-		reserveExtendedBalance := reserveIntegerBalance.Amount.Mul(types.ConversionFactor())
-
-		// The total amount of backing coins in the reserve should be equal to
-		// fractional balances + remainder amount
-		totalRequiredBacking := fractionalBalSum.Add(remainderAmount)
-
-		broken = !reserveExtendedBalance.Equal(totalRequiredBacking)
-		msg = fmt.Sprintf(
-			"%s reserve balance %s mismatches %s (fractional balances %s + remainder %s)\n",
-			types.ExtendedCoinDenom,
-			reserveExtendedBalance,
-			totalRequiredBacking,
-			fractionalBalSum,
-			remainderAmount,
-		)
+		for _, ext := range k.GetDenomExtensions(ctx) {
+			fractionalBalSum := k.GetTotalSumDenomFractionalBalances(ctx, ext)
+			remainderAmount := k.GetDenomRemainderAmount(ctx, ext)
+
+			// Get the total amount of backing coins in the reserve
+			reserveExtendedBalance := k.GetReserveExtendedBalance(ctx, ext)
+
+			// The total amount of backing coins in the reserve should be equal to
+			// fractional balances + remainder amount
+			totalRequiredBacking := fractionalBalSum.Add(remainderAmount)
+
+			broken = broken || !reserveExtendedBalance.Equal(totalRequiredBacking)
+			msg += fmt.Sprintf(
+				"%s reserve balance %s mismatches %s (fractional balances %s + remainder %s)\n",
+				ext.ExtendedDenom,
+				reserveExtendedBalance,
+				totalRequiredBacking,
+				fractionalBalSum,
+				remainderAmount,
+			)
+		}
 
 		return sdk.FormatInvariant(
 			types.ModuleName, "module reserve backing total fractional balances",
@@ -103,14 +118,16 @@ func ValidFractionalAmountsInvariant(k Keeper) sdk.Invariant {
 			count int
 		)
 
-		k.IterateFractionalBalances(ctx, func(addr sdk.AccAddress, amount sdkmath.Int) bool {
-			if err := types.ValidateFractionalAmount(amount); err != nil {
-				count++
-				msg += fmt.Sprintf("\t%s has an invalid fractional amount of %s\n", addr, amount)
-			}
+		for _, ext := range k.GetDenomExtensions(ctx) {
+			k.IterateDenomFractionalBalances(ctx, ext, func(addr sdk.AccAddress, amount sdkmath.Int) bool {
+				if err := ext.ValidateFractionalAmount(amount); err != nil {
+					count++
+					msg += fmt.Sprintf("\t%s has an invalid fractional amount of %s\n", addr, formatExtendedAmount(ext, amount))
+				}
 
-			return false
-		})
+				return false
+			})
+		}
 
 		broken := count != 0
 
@@ -129,13 +146,18 @@ func ValidRemainderAmountInvariant(k Keeper) sdk.Invariant {
 			broken bool
 		)
 
-		remainderAmount := k.GetRemainderAmount(ctx)
-
-		if !remainderAmount.IsZero() {
-			// Only validate if non-zero, as zero is default value
-			if err := types.ValidateFractionalAmount(remainderAmount); err != nil {
-				broken = true
-				msg = fmt.Sprintf("remainder amount is invalid: %s", err)
+		for _, ext := range k.GetDenomExtensions(ctx) {
+			remainderAmount := k.GetDenomRemainderAmount(ctx, ext)
+
+			if !remainderAmount.IsZero() {
+				// Only validate if non-zero, as zero is default value
+				if err := ext.ValidateFractionalAmount(remainderAmount); err != nil {
+					broken = true
+					if msg != "" {
+						msg += "\n"
+					}
+					msg += fmt.Sprintf("%sremainder amount is invalid: %s", denomMsgPrefix(ext), err)
+				}
 			}
 		}
 
@@ -151,21 +173,27 @@ func ValidRemainderAmountInvariant(k Keeper) sdk.Invariant {
 // leftover amount.
 func BalancedFractionalTotalInvariant(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
-		fractionalBalSum := k.GetTotalSumFractionalBalances(ctx)
-		remainderAmount := k.GetRemainderAmount(ctx)
-
-		total := fractionalBalSum.Add(remainderAmount)
-		fractionalAmount := total.Mod(types.ConversionFactor())
-
 		broken := false
 		msg := ""
 
-		if !fractionalAmount.IsZero() {
-			broken = true
-			msg = fmt.Sprintf(
-				"(sum(FractionalBalances) + remainder) %% conversionFactor should be 0 but got %v",
-				fractionalAmount,
-			)
+		for _, ext := range k.GetDenomExtensions(ctx) {
+			fractionalBalSum := k.GetTotalSumDenomFractionalBalances(ctx, ext)
+			remainderAmount := k.GetDenomRemainderAmount(ctx, ext)
+
+			total := fractionalBalSum.Add(remainderAmount)
+			fractionalAmount := total.Mod(ext.ConversionFactor)
+
+			if !fractionalAmount.IsZero() {
+				broken = true
+				if msg != "" {
+					msg += "\n"
+				}
+				msg += fmt.Sprintf(
+					"%s(sum(FractionalBalances) + remainder) %% conversionFactor should be 0 but got %v",
+					denomMsgPrefix(ext),
+					fractionalAmount,
+				)
+			}
 		}
 
 		return sdk.FormatInvariant(
@@ -181,20 +209,27 @@ func BalancedFractionalTotalInvariant(k Keeper) sdk.Invariant {
 // the same total supply and is effectively the same asset. ukava held by this
 // module in x/bank backs all fractional balances in x/precisebank. If akava
 // somehow ends up in x/bank, then it would both break all expectations of this
-// module as well as be double-counted in the total supply.
+// module as well as be double-counted in the total supply. The same applies
+// to every other denom extension.
 func FractionalDenomNotInBankInvariant(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
-		extBankSupply := k.bk.GetSupply(ctx, types.ExtendedCoinDenom)
-
-		broken := !extBankSupply.IsZero()
+		broken := false
 		msg := ""
 
-		if broken {
-			msg = fmt.Sprintf(
-				"x/bank should not hold any %v but has supply of %v",
-				types.ExtendedCoinDenom,
-				extBankSupply,
-			)
+		for _, ext := range k.GetDenomExtensions(ctx) {
+			extBankSupply := k.bk.GetSupply(ctx, ext.ExtendedDenom)
+
+			if !extBankSupply.IsZero() {
+				broken = true
+				if msg != "" {
+					msg += "\n"
+				}
+				msg += fmt.Sprintf(
+					"x/bank should not hold any %v but has supply of %v",
+					ext.ExtendedDenom,
+					extBankSupply,
+				)
+			}
 		}
 
 		return sdk.FormatInvariant(
@@ -203,3 +238,23 @@ func FractionalDenomNotInBankInvariant(k Keeper) sdk.Invariant {
 		), broken
 	}
 }
+
+// denomMsgPrefix returns a prefix for invariant messages to identify denom
+// extensions other than the built-in one, which keeps its original messages.
+func denomMsgPrefix(ext types.DenomExtension) string {
+	if ext.IsDefault() {
+		return ""
+	}
+
+	return ext.ExtendedDenom + ": "
+}
+
+// formatExtendedAmount returns the amount with its denom for denom extensions
+// other than the built-in one, which keeps its original messages.
+func formatExtendedAmount(ext types.DenomExtension, amount sdkmath.Int) string {
+	if ext.IsDefault() {
+		return amount.String()
+	}
+
+	return amount.String() + ext.ExtendedDenom
+}
@@ -1,8 +1,11 @@
 package keeper
 
 import (
+	"fmt"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 
@@ -19,6 +22,13 @@ type Keeper struct {
 
 	bk types.BankKeeper
 	ak types.AccountKeeper
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this should be the x/gov module account.
+	authority sdk.AccAddress
+
+	// extensions caches the decoded denom extensions, shared by all copies of
+	// the keeper.
+	extensions *denomExtensionsCache
 }
 
 // NewKeeper creates a new keeper
@@ -27,11 +37,23 @@ func NewKeeper(
 	storeKey storetypes.StoreKey,
 	bk types.BankKeeper,
 	ak types.AccountKeeper,
+	authority sdk.AccAddress,
 ) Keeper {
+	if err := sdk.VerifyAddressFormat(authority); err != nil {
+		panic(fmt.Sprintf("invalid authority address: %s", err))
+	}
+
 	return Keeper{
-		cdc:      cdc,
-		storeKey: storeKey,
-		bk:       bk,
-		ak:       ak,
+		cdc:        cdc,
+		storeKey:   storeKey,
+		bk:         bk,
+		ak:         ak,
+		authority:  authority,
+		extensions: &denomExtensionsCache{},
 	}
 }
+
+// GetAuthority returns the x/precisebank module's authority.
+func (k Keeper) GetAuthority() sdk.AccAddress {
+	return k.authority
+}
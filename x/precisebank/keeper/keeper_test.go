@@ -7,6 +7,8 @@ import (
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/kava-labs/kava/app"
 	"github.com/kava-labs/kava/x/precisebank/keeper"
@@ -37,7 +39,7 @@ func NewMockedTestData(t *testing.T) testData {
 
 	tApp := app.NewTestApp()
 	cdc := tApp.AppCodec()
-	k := keeper.NewKeeper(cdc, storeKey, bk, ak)
+	k := keeper.NewKeeper(cdc, storeKey, bk, ak, authtypes.NewModuleAddress(govtypes.ModuleName))
 
 	return testData{
 		ctx:      ctx,
@@ -14,7 +14,7 @@ import (
 )
 
 // MintCoins creates new coins from thin air and adds it to the module account.
-// If ExtendedCoinDenom is provided, the corresponding fractional amount is
+// If an extended denom is provided, the corresponding fractional amount is
 // added to the module state.
 // It will panic if the module account does not exist or is unauthorized.
 func (k Keeper) MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error {
@@ -39,15 +39,9 @@ func (k Keeper) MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) err
 		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
 	}
 
-	// Get non-ExtendedCoinDenom coins
-	passthroughCoins := amt
-
-	extendedAmount := amt.AmountOf(types.ExtendedCoinDenom)
-	if extendedAmount.IsPositive() {
-		// Remove ExtendedCoinDenom from the coins as it is managed by x/precisebank
-		removeCoin := sdk.NewCoin(types.ExtendedCoinDenom, extendedAmount)
-		passthroughCoins = amt.Sub(removeCoin)
-	}
+	// Separate extended denom coins managed by x/precisebank from the rest
+	exts := k.GetDenomExtensions(ctx)
+	extendedCoins, passthroughCoins := exts.SplitExtendedCoins(amt)
 
 	// Coins unmanaged by x/precisebank are passed through to x/bank
 	if !passthroughCoins.Empty() {
@@ -56,14 +50,15 @@ func (k Keeper) MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) err
 		}
 	}
 
-	// Only mint extended coin if the amount is positive
-	if extendedAmount.IsPositive() {
-		if err := k.mintExtendedCoin(ctx, moduleName, extendedAmount); err != nil {
+	// Valid coins are always positive
+	for _, coin := range extendedCoins {
+		ext, _ := exts.Find(coin.Denom)
+		if err := k.mintExtendedCoin(ctx, ext, moduleName, coin.Amount); err != nil {
 			return err
 		}
 	}
 
-	fullEmissionCoins := sdk.NewCoins(types.SumExtendedCoin(amt))
+	fullEmissionCoins := exts.SumExtendedCoins(amt)
 	if fullEmissionCoins.IsZero() {
 		return nil
 	}
@@ -94,21 +89,22 @@ func (k Keeper) MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) err
 //   - Increase direct account mint amount by 1, no extra reserve mint
 func (k Keeper) mintExtendedCoin(
 	ctx sdk.Context,
+	ext types.DenomExtension,
 	recipientModuleName string,
 	amt sdkmath.Int,
 ) error {
 	moduleAddr := k.ak.GetModuleAddress(recipientModuleName)
 
 	// Get current module account fractional balance - 0 if not found
-	fractionalAmount := k.GetFractionalBalance(ctx, moduleAddr)
+	fractionalAmount := k.GetDenomFractionalBalance(ctx, ext, moduleAddr)
 
 	// Get separated mint amounts
-	integerMintAmount := amt.Quo(types.ConversionFactor())
-	fractionalMintAmount := amt.Mod(types.ConversionFactor())
+	integerMintAmount := amt.Quo(ext.ConversionFactor)
+	fractionalMintAmount := amt.Mod(ext.ConversionFactor)
 
 	// Get previous remainder amount, as we need to it before carry calculation
 	// for the optimization path.
-	prevRemainder := k.GetRemainderAmount(ctx)
+	prevRemainder := k.GetDenomRemainderAmount(ctx, ext)
 
 	// Deduct new remainder with minted fractional amount. This will result in
 	// two cases:
@@ -126,11 +122,11 @@ func (k Keeper) mintExtendedCoin(
 	newFractionalBalance := fractionalAmount.Add(fractionalMintAmount)
 
 	// Case #3 - Integer carry, remainder is sufficient (0 or positive)
-	if newFractionalBalance.GTE(types.ConversionFactor()) && newRemainder.GTE(sdkmath.ZeroInt()) {
+	if newFractionalBalance.GTE(ext.ConversionFactor) && newRemainder.GTE(sdkmath.ZeroInt()) {
 		// Carry should send from reserve -> account, instead of minting an
 		// extra integer coin. Otherwise doing an extra mint will require a burn
 		// from reserves to maintain exact backing.
-		carryCoin := sdk.NewCoin(types.IntegerCoinDenom, sdkmath.OneInt())
+		carryCoin := sdk.NewCoin(ext.IntegerDenom, sdkmath.OneInt())
 
 		// SendCoinsFromModuleToModule allows for sending coins even if the
 		// recipient module account is blocked.
@@ -147,7 +143,7 @@ func (k Keeper) mintExtendedCoin(
 	// Case #4 - Integer carry, remainder is insufficient
 	// This is the optimization path where the integer mint amount is increased
 	// by 1, instead of doing both a reserve -> account transfer and reserve mint.
-	if newFractionalBalance.GTE(types.ConversionFactor()) && newRemainder.IsNegative() {
+	if newFractionalBalance.GTE(ext.ConversionFactor) && newRemainder.IsNegative() {
 		integerMintAmount = integerMintAmount.AddRaw(1)
 	}
 
@@ -156,16 +152,16 @@ func (k Keeper) mintExtendedCoin(
 	// fractional amounts x and y where both x and y < ConversionFactor
 	// x + y < (2 * ConversionFactor) - 2
 	// x + y < 1 integer amount + fractional amount
-	if newFractionalBalance.GTE(types.ConversionFactor()) {
+	if newFractionalBalance.GTE(ext.ConversionFactor) {
 		// Subtract 1 integer equivalent amount of fractional balance. Same
 		// behavior as using .Mod() in this case.
-		newFractionalBalance = newFractionalBalance.Sub(types.ConversionFactor())
+		newFractionalBalance = newFractionalBalance.Sub(ext.ConversionFactor)
 	}
 
 	// Mint new integer amounts in x/bank - including carry over from fractional
 	// amount if any.
 	if integerMintAmount.IsPositive() {
-		integerMintCoin := sdk.NewCoin(types.IntegerCoinDenom, integerMintAmount)
+		integerMintCoin := sdk.NewCoin(ext.IntegerDenom, integerMintAmount)
 
 		if err := k.bk.MintCoins(
 			ctx,
@@ -177,7 +173,7 @@ func (k Keeper) mintExtendedCoin(
 	}
 
 	// Assign new fractional balance in x/precisebank
-	k.SetFractionalBalance(ctx, moduleAddr, newFractionalBalance)
+	k.SetDenomFractionalBalance(ctx, ext, moduleAddr, newFractionalBalance)
 
 	// ----------------------------------------
 	// Update remainder & reserves to back minted fractional coins
@@ -190,10 +186,10 @@ func (k Keeper) mintExtendedCoin(
 	// Optimization: This is only done when the integer amount does NOT carry,
 	// as a direct account mint is done instead of integer carry transfer +
 	// insufficient remainder reserve mint.
-	wasCarried := fractionalAmount.Add(fractionalMintAmount).GTE(types.ConversionFactor())
+	wasCarried := fractionalAmount.Add(fractionalMintAmount).GTE(ext.ConversionFactor)
 	if prevRemainder.LT(fractionalMintAmount) && !wasCarried {
 		// Always only 1 integer coin, as fractionalMintAmount < ConversionFactor
-		reserveMintCoins := sdk.NewCoins(sdk.NewCoin(types.IntegerCoinDenom, sdkmath.OneInt()))
+		reserveMintCoins := sdk.NewCoins(sdk.NewCoin(ext.IntegerDenom, sdkmath.OneInt()))
 		if err := k.bk.MintCoins(ctx, types.ModuleName, reserveMintCoins); err != nil {
 			return fmt.Errorf("failed to mint %s for reserve: %w", reserveMintCoins, err)
 		}
@@ -203,10 +199,10 @@ func (k Keeper) mintExtendedCoin(
 	// This needs to be adjusted back to the corresponding positive value. The
 	// remainder will be always < conversionFactor after add if it is negative.
 	if newRemainder.IsNegative() {
-		newRemainder = newRemainder.Add(types.ConversionFactor())
+		newRemainder = newRemainder.Add(ext.ConversionFactor)
 	}
 
-	k.SetRemainderAmount(ctx, newRemainder)
+	k.SetDenomRemainderAmount(ctx, ext, newRemainder)
 
 	return nil
 }
@@ -0,0 +1,140 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/precisebank/types"
+)
+
+// GetParams returns the params from the store. Default params are returned if
+// none have been set, as the module originally had no params.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return types.DefaultParams()
+	}
+
+	var params types.Params
+	k.cdc.MustUnmarshal(bz, &params)
+
+	return params
+}
+
+// SetParams sets params on the store
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	if err := params.Validate(); err != nil {
+		panic(fmt.Sprintf("invalid params: %s", err))
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&params)
+	store.Set(types.ParamsKey, bz)
+}
+
+// denomExtensionsCache holds the denom extensions decoded from the last params
+// bytes read from the store. The cache is keyed by the raw params bytes rather
+// than invalidated in SetParams, so it stays correct when a context that set
+// params is discarded, and for queries against other heights.
+type denomExtensionsCache struct {
+	mu   sync.RWMutex
+	bz   []byte
+	exts types.DenomExtensions
+}
+
+// get returns the cached denom extensions if they were decoded from bz.
+func (c *denomExtensionsCache) get(bz []byte) (types.DenomExtensions, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.exts == nil || !bytes.Equal(c.bz, bz) {
+		return nil, false
+	}
+
+	return c.exts, true
+}
+
+// set caches the denom extensions decoded from bz.
+func (c *denomExtensionsCache) set(bz []byte, exts types.DenomExtensions) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.bz = bz
+	c.exts = exts
+}
+
+// GetDenomExtensions returns all denom extensions managed by the module, with
+// the built-in ExtendedCoinDenom extension first. The returned slice is shared
+// and must not be modified.
+func (k Keeper) GetDenomExtensions(ctx sdk.Context) types.DenomExtensions {
+	bz := ctx.KVStore(k.storeKey).Get(types.ParamsKey)
+	if bz == nil {
+		return types.DefaultParams().AllDenomExtensions()
+	}
+
+	// Keepers built without NewKeeper have no cache.
+	if k.extensions == nil {
+		return k.GetParams(ctx).AllDenomExtensions()
+	}
+
+	// Decoding params on every send and balance query is expensive, so only
+	// decode when the stored params change.
+	if exts, found := k.extensions.get(bz); found {
+		return exts
+	}
+
+	var params types.Params
+	k.cdc.MustUnmarshal(bz, &params)
+
+	exts := params.AllDenomExtensions()
+	k.extensions.set(bytes.Clone(bz), exts)
+
+	return exts
+}
+
+// GetDenomExtension returns the denom extension of an extended denom, and
+// false if the denom is not an extended denom.
+func (k Keeper) GetDenomExtension(ctx sdk.Context, extendedDenom string) (types.DenomExtension, bool) {
+	// Skip reading params for the most common denom.
+	if extendedDenom == types.ExtendedCoinDenom {
+		return types.DefaultDenomExtension(), true
+	}
+
+	return k.GetDenomExtensions(ctx).Find(extendedDenom)
+}
+
+// validateDenomExtensionsUpdate returns an error if the new params remove or
+// change a denom extension that still has fractional balances or a remainder,
+// or add a denom extension whose extended denom is already held in x/bank.
+func (k Keeper) validateDenomExtensionsUpdate(ctx sdk.Context, params types.Params) error {
+	current := k.GetParams(ctx).DenomExtensions
+
+	for _, ext := range current {
+		newExt, found := params.DenomExtensions.Find(ext.ExtendedDenom)
+		if found && newExt.IntegerDenom == ext.IntegerDenom && newExt.ConversionFactor.Equal(ext.ConversionFactor) {
+			continue
+		}
+
+		if k.GetTotalSumDenomFractionalBalances(ctx, ext).IsPositive() ||
+			k.GetDenomRemainderAmount(ctx, ext).IsPositive() {
+			return fmt.Errorf("denom extension %s has fractional balances and cannot be removed or changed", ext.ExtendedDenom)
+		}
+	}
+
+	for _, ext := range params.DenomExtensions {
+		if _, found := current.Find(ext.ExtendedDenom); found {
+			continue
+		}
+
+		if supply := k.bk.GetSupply(ctx, ext.ExtendedDenom); !supply.IsZero() {
+			return fmt.Errorf("extended denom %s already has x/bank supply of %s", ext.ExtendedDenom, supply)
+		}
+	}
+
+	return nil
+}
@@ -12,10 +12,34 @@ import (
 // GetRemainderAmount returns the internal remainder amount.
 func (k *Keeper) GetRemainderAmount(
 	ctx sdk.Context,
+) sdkmath.Int {
+	return k.GetDenomRemainderAmount(ctx, types.DefaultDenomExtension())
+}
+
+// SetRemainderAmount sets the internal remainder amount.
+func (k *Keeper) SetRemainderAmount(
+	ctx sdk.Context,
+	amount sdkmath.Int,
+) {
+	k.SetDenomRemainderAmount(ctx, types.DefaultDenomExtension(), amount)
+}
+
+// DeleteRemainderAmount deletes the internal remainder amount.
+func (k *Keeper) DeleteRemainderAmount(
+	ctx sdk.Context,
+) {
+	k.DeleteDenomRemainderAmount(ctx, types.DefaultDenomExtension())
+}
+
+// GetDenomRemainderAmount returns the internal remainder amount of the
+// extended denom.
+func (k *Keeper) GetDenomRemainderAmount(
+	ctx sdk.Context,
+	ext types.DenomExtension,
 ) sdkmath.Int {
 	store := ctx.KVStore(k.storeKey)
 
-	bz := store.Get(types.RemainderBalanceKey)
+	bz := store.Get(types.DenomRemainderKey(ext.ExtendedDenom))
 	if bz == nil {
 		return sdkmath.ZeroInt()
 	}
@@ -28,22 +52,24 @@ func (k *Keeper) GetRemainderAmount(
 	return bal
 }
 
-// SetRemainderAmount sets the internal remainder amount.
-func (k *Keeper) SetRemainderAmount(
+// SetDenomRemainderAmount sets the internal remainder amount of the extended
+// denom.
+func (k *Keeper) SetDenomRemainderAmount(
 	ctx sdk.Context,
+	ext types.DenomExtension,
 	amount sdkmath.Int,
 ) {
 	// Prevent storing zero amounts. In practice, the remainder amount should
 	// only be non-zero during transactions as mint and burns should net zero
 	// due to only being used for EVM transfers.
 	if amount.IsZero() {
-		k.DeleteRemainderAmount(ctx)
+		k.DeleteDenomRemainderAmount(ctx, ext)
 		return
 	}
 
 	// Ensure the remainder is valid before setting it. Follows the same
 	// validation as FractionalBalance with the same value range.
-	if err := types.ValidateFractionalAmount(amount); err != nil {
+	if err := ext.ValidateFractionalAmount(amount); err != nil {
 		panic(fmt.Errorf("remainder amount is invalid: %w", err))
 	}
 
@@ -54,13 +80,15 @@ func (k *Keeper) SetRemainderAmount(
 		panic(fmt.Errorf("failed to marshal remainder amount: %w", err))
 	}
 
-	store.Set(types.RemainderBalanceKey, amountBytes)
+	store.Set(types.DenomRemainderKey(ext.ExtendedDenom), amountBytes)
 }
 
-// DeleteRemainderAmount deletes the internal remainder amount.
-func (k *Keeper) DeleteRemainderAmount(
+// DeleteDenomRemainderAmount deletes the internal remainder amount of the
+// extended denom.
+func (k *Keeper) DeleteDenomRemainderAmount(
 	ctx sdk.Context,
+	ext types.DenomExtension,
 ) {
 	store := ctx.KVStore(k.storeKey)
-	store.Delete(types.RemainderBalanceKey)
+	store.Delete(types.DenomRemainderKey(ext.ExtendedDenom))
 }
@@ -25,8 +25,7 @@ func (k Keeper) IsSendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) error {
 
 // SendCoins transfers amt coins from a sending account to a receiving account.
 // An error is returned upon failure. This handles transfers including
-// ExtendedCoinDenom and supports non-ExtendedCoinDenom transfers by passing
-// through to x/bank.
+// extended denoms and supports other transfers by passing through to x/bank.
 func (k Keeper) SendCoins(
 	ctx sdk.Context,
 	from, to sdk.AccAddress,
@@ -39,14 +38,9 @@ func (k Keeper) SendCoins(
 		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
 	}
 
-	passthroughCoins := amt
-	extendedCoinAmount := amt.AmountOf(types.ExtendedCoinDenom)
-
-	// Remove the extended coin amount from the passthrough coins
-	if extendedCoinAmount.IsPositive() {
-		subCoin := sdk.NewCoin(types.ExtendedCoinDenom, extendedCoinAmount)
-		passthroughCoins = amt.Sub(subCoin)
-	}
+	// Separate the extended coins from the passthrough coins
+	exts := k.GetDenomExtensions(ctx)
+	extendedCoins, passthroughCoins := exts.SplitExtendedCoins(amt)
 
 	// Send the passthrough coins through x/bank
 	if passthroughCoins.IsAllPositive() {
@@ -55,16 +49,17 @@ func (k Keeper) SendCoins(
 		}
 	}
 
-	// Send the extended coin amount through x/precisebank
-	if extendedCoinAmount.IsPositive() {
-		if err := k.sendExtendedCoins(ctx, from, to, extendedCoinAmount); err != nil {
+	// Send the extended coin amounts through x/precisebank
+	for _, coin := range extendedCoins {
+		ext, _ := exts.Find(coin.Denom)
+		if err := k.sendExtendedCoins(ctx, ext, from, to, coin.Amount); err != nil {
 			return err
 		}
 	}
 
 	// Get a full extended coin amount (passthrough integer + fractional) ONLY
 	// for event attributes.
-	fullEmissionCoins := sdk.NewCoins(types.SumExtendedCoin(amt))
+	fullEmissionCoins := exts.SumExtendedCoins(amt)
 
 	// If no passthrough integer nor fractional coins, then no event emission.
 	// We also want to emit the event with the whole equivalent extended coin
@@ -119,6 +114,7 @@ func (k Keeper) SendCoins(
 // | F             | F              | F               |
 func (k Keeper) sendExtendedCoins(
 	ctx sdk.Context,
+	ext types.DenomExtension,
 	from, to sdk.AccAddress,
 	amt sdkmath.Int,
 ) error {
@@ -128,17 +124,17 @@ func (k Keeper) sendExtendedCoins(
 	// sender does not have sufficient integer balance.
 
 	// Load required state: Account old balances
-	senderFracBal := k.GetFractionalBalance(ctx, from)
-	recipientFracBal := k.GetFractionalBalance(ctx, to)
+	senderFracBal := k.GetDenomFractionalBalance(ctx, ext, from)
+	recipientFracBal := k.GetDenomFractionalBalance(ctx, ext, to)
 
 	// -------------------------------------------------------------------------
 	// Pure stateless calculations
-	integerAmt := amt.Quo(types.ConversionFactor())
-	fractionalAmt := amt.Mod(types.ConversionFactor())
+	integerAmt := amt.Quo(ext.ConversionFactor)
+	fractionalAmt := amt.Mod(ext.ConversionFactor)
 
 	// Account new fractional balances
-	senderNewFracBal, senderNeedsBorrow := subFromFractionalBalance(senderFracBal, fractionalAmt)
-	recipientNewFracBal, recipientNeedsCarry := addToFractionalBalance(recipientFracBal, fractionalAmt)
+	senderNewFracBal, senderNeedsBorrow := subFromFractionalBalance(ext.ConversionFactor, senderFracBal, fractionalAmt)
+	recipientNewFracBal, recipientNeedsCarry := addToFractionalBalance(ext.ConversionFactor, recipientFracBal, fractionalAmt)
 
 	// Case #1: Sender borrow, recipient carry
 	if senderNeedsBorrow && recipientNeedsCarry {
@@ -153,9 +149,9 @@ func (k Keeper) sendExtendedCoins(
 	// Full integer amount transfer, including direct transfer of borrow/carry
 	// if any.
 	if integerAmt.IsPositive() {
-		transferCoin := sdk.NewCoin(types.IntegerCoinDenom, integerAmt)
+		transferCoin := sdk.NewCoin(ext.IntegerDenom, integerAmt)
 		if err := k.bk.SendCoins(ctx, from, to, sdk.NewCoins(transferCoin)); err != nil {
-			return k.updateInsufficientFundsError(ctx, from, amt, err)
+			return k.updateInsufficientFundsError(ctx, ext, from, amt, err)
 		}
 	}
 
@@ -163,14 +159,14 @@ func (k Keeper) sendExtendedCoins(
 	// Sender borrows by transferring 1 integer amount to reserve to account for
 	// lack of fractional balance.
 	if senderNeedsBorrow && !recipientNeedsCarry {
-		borrowCoin := sdk.NewCoin(types.IntegerCoinDenom, sdk.NewInt(1))
+		borrowCoin := sdk.NewCoin(ext.IntegerDenom, sdk.NewInt(1))
 		if err := k.bk.SendCoinsFromAccountToModule(
 			ctx,
 			from, // sender borrowing
 			types.ModuleName,
 			sdk.NewCoins(borrowCoin),
 		); err != nil {
-			return k.updateInsufficientFundsError(ctx, from, amt, err)
+			return k.updateInsufficientFundsError(ctx, ext, from, amt, err)
 		}
 	}
 
@@ -186,7 +182,7 @@ func (k Keeper) sendExtendedCoins(
 		// a SendCoins operation. Only SendCoinsFromModuleToAccount should check
 		// blocked addrs which is done by the parent SendCoinsFromModuleToAccount
 		// method.
-		carryCoin := sdk.NewCoin(types.IntegerCoinDenom, sdk.NewInt(1))
+		carryCoin := sdk.NewCoin(ext.IntegerDenom, sdk.NewInt(1))
 		if err := k.bk.SendCoins(
 			ctx,
 			reserveAddr,
@@ -206,8 +202,8 @@ func (k Keeper) sendExtendedCoins(
 	// already calculated and just need to be set.
 
 	// Persist new fractional balances to store.
-	k.SetFractionalBalance(ctx, from, senderNewFracBal)
-	k.SetFractionalBalance(ctx, to, recipientNewFracBal)
+	k.SetDenomFractionalBalance(ctx, ext, from, senderNewFracBal)
+	k.SetDenomFractionalBalance(ctx, ext, to, recipientNewFracBal)
 
 	return nil
 }
@@ -216,15 +212,16 @@ func (k Keeper) sendExtendedCoins(
 // current fractional balance, returning the new fractional balance and true if
 // an integer borrow is required.
 func subFromFractionalBalance(
+	conversionFactor sdkmath.Int,
 	currentFractionalBalance sdkmath.Int,
 	amountToSub sdkmath.Int,
 ) (sdkmath.Int, bool) {
 	// Enforce that currentFractionalBalance is not a full balance.
-	if currentFractionalBalance.GTE(types.ConversionFactor()) {
+	if currentFractionalBalance.GTE(conversionFactor) {
 		panic("currentFractionalBalance must be less than ConversionFactor")
 	}
 
-	if amountToSub.GTE(types.ConversionFactor()) {
+	if amountToSub.GTE(conversionFactor) {
 		panic("amountToSub must be less than ConversionFactor")
 	}
 
@@ -237,7 +234,7 @@ func subFromFractionalBalance(
 		// Borrowing 1 integer equivalent amount of fractional coins. We need to
 		// add 1 integer equivalent amount to the fractional balance otherwise
 		// the new fractional balance will be negative.
-		newFractionalBalance = newFractionalBalance.Add(types.ConversionFactor())
+		newFractionalBalance = newFractionalBalance.Add(conversionFactor)
 	}
 
 	return newFractionalBalance, borrowRequired
@@ -247,15 +244,16 @@ func subFromFractionalBalance(
 // fractional balance, returning the new fractional balance and true if a carry
 // is required.
 func addToFractionalBalance(
+	conversionFactor sdkmath.Int,
 	currentFractionalBalance sdkmath.Int,
 	amountToAdd sdkmath.Int,
 ) (sdkmath.Int, bool) {
 	// Enforce that currentFractionalBalance is not a full balance.
-	if currentFractionalBalance.GTE(types.ConversionFactor()) {
+	if currentFractionalBalance.GTE(conversionFactor) {
 		panic("currentFractionalBalance must be less than ConversionFactor")
 	}
 
-	if amountToAdd.GTE(types.ConversionFactor()) {
+	if amountToAdd.GTE(conversionFactor) {
 		panic("amountToAdd must be less than ConversionFactor")
 	}
 
@@ -263,11 +261,11 @@ func addToFractionalBalance(
 
 	// New balance exceeds max fractional balance, so we need to carry it over
 	// to the integer balance.
-	carryRequired := newFractionalBalance.GTE(types.ConversionFactor())
+	carryRequired := newFractionalBalance.GTE(conversionFactor)
 
 	if carryRequired {
 		// Carry over to integer amount
-		newFractionalBalance = newFractionalBalance.Sub(types.ConversionFactor())
+		newFractionalBalance = newFractionalBalance.Sub(conversionFactor)
 	}
 
 	return newFractionalBalance, carryRequired
@@ -334,6 +332,7 @@ func (k Keeper) SendCoinsFromModuleToAccount(
 // contains the full extended coin balance and send amounts.
 func (k Keeper) updateInsufficientFundsError(
 	ctx sdk.Context,
+	ext types.DenomExtension,
 	addr sdk.AccAddress,
 	amt sdkmath.Int,
 	err error,
@@ -343,8 +342,8 @@ func (k Keeper) updateInsufficientFundsError(
 	}
 
 	// Check balance is sufficient
-	bal := k.GetBalance(ctx, addr, types.ExtendedCoinDenom)
-	coin := sdk.NewCoin(types.ExtendedCoinDenom, amt)
+	bal := k.GetBalance(ctx, addr, ext.ExtendedDenom)
+	coin := sdk.NewCoin(ext.ExtendedDenom, amt)
 
 	// TODO: This checks spendable coins and returns error with spendable
 	// coins, not full balance. If GetBalance() is modified to return the
@@ -7,39 +7,40 @@ import (
 )
 
 // GetBalance returns the balance of a specific denom for an address. This will
-// return the extended balance for the ExtendedCoinDenom, and the regular
-// balance for all other denoms.
+// return the extended balance for extended denoms, and the regular balance for
+// all other denoms.
 func (k Keeper) GetBalance(
 	ctx sdk.Context,
 	addr sdk.AccAddress,
 	denom string,
 ) sdk.Coin {
+	// Pass through to x/bank for denoms that are not extended denoms
+	ext, found := k.GetDenomExtension(ctx, denom)
+	if !found {
+		return k.bk.GetBalance(ctx, addr, denom)
+	}
+
 	// Module balance should display as empty for extended denom. Module
 	// balances are **only** for the reserve which backs the fractional
 	// balances. Returning the backing balances if querying extended denom would
 	// result in a double counting of the fractional balances.
-	if denom == types.ExtendedCoinDenom && addr.Equals(k.ak.GetModuleAddress(types.ModuleName)) {
+	if addr.Equals(k.ak.GetModuleAddress(types.ModuleName)) {
 		return sdk.NewCoin(denom, sdkmath.ZeroInt())
 	}
 
-	// Pass through to x/bank for denoms except ExtendedCoinDenom
-	if denom != types.ExtendedCoinDenom {
-		return k.bk.GetBalance(ctx, addr, denom)
-	}
-
 	// x/bank for integer balance - full balance, including locked
-	integerCoins := k.bk.GetBalance(ctx, addr, types.IntegerCoinDenom)
+	integerCoins := k.bk.GetBalance(ctx, addr, ext.IntegerDenom)
 
 	// x/precisebank for fractional balance
-	fractionalAmount := k.GetFractionalBalance(ctx, addr)
+	fractionalAmount := k.GetDenomFractionalBalance(ctx, ext, addr)
 
 	// (Integer * ConversionFactor) + Fractional
 	fullAmount := integerCoins.
 		Amount.
-		Mul(types.ConversionFactor()).
+		Mul(ext.ConversionFactor).
 		Add(fractionalAmount)
 
-	return sdk.NewCoin(types.ExtendedCoinDenom, fullAmount)
+	return sdk.NewCoin(ext.ExtendedDenom, fullAmount)
 }
 
 // SpendableCoins returns the total balances of spendable coins for an account
@@ -50,26 +51,37 @@ func (k Keeper) SpendableCoin(
 	addr sdk.AccAddress,
 	denom string,
 ) sdk.Coin {
-	// Same as GetBalance, extended denom balances are transparent to consumers.
-	if denom == types.ExtendedCoinDenom && addr.Equals(k.ak.GetModuleAddress(types.ModuleName)) {
-		return sdk.NewCoin(denom, sdkmath.ZeroInt())
+	// Pass through to x/bank for denoms that are not extended denoms
+	ext, found := k.GetDenomExtension(ctx, denom)
+	if !found {
+		return k.bk.SpendableCoin(ctx, addr, denom)
 	}
 
-	// Pass through to x/bank for denoms except ExtendedCoinDenom
-	if denom != types.ExtendedCoinDenom {
-		return k.bk.SpendableCoin(ctx, addr, denom)
+	// Same as GetBalance, extended denom balances are transparent to consumers.
+	if addr.Equals(k.ak.GetModuleAddress(types.ModuleName)) {
+		return sdk.NewCoin(denom, sdkmath.ZeroInt())
 	}
 
 	// x/bank for integer balance - excluding locked
-	integerCoin := k.bk.SpendableCoin(ctx, addr, types.IntegerCoinDenom)
+	integerCoin := k.bk.SpendableCoin(ctx, addr, ext.IntegerDenom)
 
 	// x/precisebank for fractional balance
-	fractionalAmount := k.GetFractionalBalance(ctx, addr)
+	fractionalAmount := k.GetDenomFractionalBalance(ctx, ext, addr)
 
 	// Spendable = (Integer * ConversionFactor) + Fractional
 	fullAmount := integerCoin.Amount.
-		Mul(types.ConversionFactor()).
+		Mul(ext.ConversionFactor).
 		Add(fractionalAmount)
 
-	return sdk.NewCoin(types.ExtendedCoinDenom, fullAmount)
+	return sdk.NewCoin(ext.ExtendedDenom, fullAmount)
+}
+
+// GetReserveExtendedBalance returns the integer balance of the module reserve
+// of the denom extension, which backs all its fractional balances and the
+// remainder, converted to the extended denom.
+func (k Keeper) GetReserveExtendedBalance(ctx sdk.Context, ext types.DenomExtension) sdkmath.Int {
+	moduleAddr := k.ak.GetModuleAddress(types.ModuleName)
+	reserveIntegerBalance := k.bk.GetBalance(ctx, moduleAddr, ext.IntegerDenom)
+
+	return reserveIntegerBalance.Amount.Mul(ext.ConversionFactor)
 }
@@ -0,0 +1,167 @@
+package types
+
+import (
+	fmt "fmt"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewDenomExtension returns a new DenomExtension of an integer denom to an
+// extended denom with the given conversion factor.
+func NewDenomExtension(
+	integerDenom string,
+	extendedDenom string,
+	conversionFactor sdkmath.Int,
+) DenomExtension {
+	return DenomExtension{
+		IntegerDenom:     integerDenom,
+		ExtendedDenom:    extendedDenom,
+		ConversionFactor: conversionFactor,
+	}
+}
+
+// DefaultDenomExtension returns the built-in extension of IntegerCoinDenom to
+// ExtendedCoinDenom. It is always managed by x/precisebank and is not part of
+// the module params.
+func DefaultDenomExtension() DenomExtension {
+	return NewDenomExtension(IntegerCoinDenom, ExtendedCoinDenom, ConversionFactor())
+}
+
+// IsDefault returns true if the DenomExtension is for the built-in
+// ExtendedCoinDenom.
+func (ext DenomExtension) IsDefault() bool {
+	return ext.ExtendedDenom == ExtendedCoinDenom
+}
+
+// Validate returns an error if the DenomExtension has invalid denoms or a
+// conversion factor that is not a power of ten greater than one.
+func (ext DenomExtension) Validate() error {
+	if err := sdk.ValidateDenom(ext.IntegerDenom); err != nil {
+		return fmt.Errorf("invalid integer denom: %w", err)
+	}
+
+	if err := sdk.ValidateDenom(ext.ExtendedDenom); err != nil {
+		return fmt.Errorf("invalid extended denom: %w", err)
+	}
+
+	if ext.IntegerDenom == ext.ExtendedDenom {
+		return fmt.Errorf("integer and extended denoms must be different, got %s", ext.IntegerDenom)
+	}
+
+	if ext.ConversionFactor.IsNil() {
+		return fmt.Errorf("nil conversion factor for %s", ext.ExtendedDenom)
+	}
+
+	if ext.ConversionFactor.LTE(sdkmath.OneInt()) {
+		return fmt.Errorf("conversion factor %v for %s must be greater than 1", ext.ConversionFactor, ext.ExtendedDenom)
+	}
+
+	// Reduce by powers of ten, a valid factor results in exactly 1.
+	factor := ext.ConversionFactor
+	ten := sdkmath.NewInt(10)
+	for factor.Mod(ten).IsZero() {
+		factor = factor.Quo(ten)
+	}
+
+	if !factor.Equal(sdkmath.OneInt()) {
+		return fmt.Errorf("conversion factor %v for %s must be a power of 10", ext.ConversionFactor, ext.ExtendedDenom)
+	}
+
+	return nil
+}
+
+// ValidateFractionalAmount checks if an sdkmath.Int is a valid fractional
+// amount of the extended denom, ensuring it is positive and less than the
+// conversion factor.
+func (ext DenomExtension) ValidateFractionalAmount(amt sdkmath.Int) error {
+	if amt.IsNil() {
+		return fmt.Errorf("nil amount")
+	}
+
+	if !amt.IsPositive() {
+		return fmt.Errorf("non-positive amount %v", amt)
+	}
+
+	if amt.GTE(ext.ConversionFactor) {
+		return fmt.Errorf("amount %v exceeds max of %v", amt, ext.ConversionFactor.SubRaw(1))
+	}
+
+	return nil
+}
+
+// SumExtendedCoin returns a sdk.Coin of the extended denom with all integer
+// and extended amounts in amt combined.
+func (ext DenomExtension) SumExtendedCoin(amt sdk.Coins) sdk.Coin {
+	integerAmount := amt.AmountOf(ext.IntegerDenom).Mul(ext.ConversionFactor)
+	extendedAmount := amt.AmountOf(ext.ExtendedDenom)
+
+	return sdk.NewCoin(
+		ext.ExtendedDenom,
+		integerAmount.Add(extendedAmount),
+	)
+}
+
+// DenomExtensions is a slice of DenomExtension
+type DenomExtensions []DenomExtension
+
+// Validate returns an error if any DenomExtension is invalid or if any denom
+// is used more than once, as either an integer or extended denom.
+func (exts DenomExtensions) Validate() error {
+	seenDenoms := make(map[string]struct{})
+
+	for _, ext := range exts {
+		if err := ext.Validate(); err != nil {
+			return err
+		}
+
+		for _, denom := range []string{ext.IntegerDenom, ext.ExtendedDenom} {
+			if _, found := seenDenoms[denom]; found {
+				return fmt.Errorf("duplicate denom %s", denom)
+			}
+
+			seenDenoms[denom] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
+// Find returns the DenomExtension with the given extended denom.
+func (exts DenomExtensions) Find(extendedDenom string) (DenomExtension, bool) {
+	for _, ext := range exts {
+		if ext.ExtendedDenom == extendedDenom {
+			return ext, true
+		}
+	}
+
+	return DenomExtension{}, false
+}
+
+// SplitExtendedCoins returns the coins in amt that are of an extended denom,
+// and the remaining coins that are passed through to x/bank.
+func (exts DenomExtensions) SplitExtendedCoins(amt sdk.Coins) (extended sdk.Coins, passthrough sdk.Coins) {
+	for _, coin := range amt {
+		if _, found := exts.Find(coin.Denom); found {
+			extended = append(extended, coin)
+			continue
+		}
+
+		passthrough = append(passthrough, coin)
+	}
+
+	return extended, passthrough
+}
+
+// SumExtendedCoins returns the full amount of each extended denom in amt, with
+// integer and extended amounts combined. This is intended to get the full
+// value to emit in events.
+func (exts DenomExtensions) SumExtendedCoins(amt sdk.Coins) sdk.Coins {
+	sum := sdk.NewCoins()
+
+	for _, ext := range exts {
+		sum = sum.Add(ext.SumExtendedCoin(amt))
+	}
+
+	return sum
+}
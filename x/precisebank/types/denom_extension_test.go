@@ -0,0 +1,225 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kava-labs/kava/x/precisebank/types"
+)
+
+func TestDefaultDenomExtension(t *testing.T) {
+	ext := types.DefaultDenomExtension()
+
+	require.Equal(t, types.IntegerCoinDenom, ext.IntegerDenom)
+	require.Equal(t, types.ExtendedCoinDenom, ext.ExtendedDenom)
+	require.Equal(t, types.ConversionFactor(), ext.ConversionFactor)
+	require.True(t, ext.IsDefault())
+	require.NoError(t, ext.Validate())
+}
+
+func TestDenomExtension_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		ext     types.DenomExtension
+		wantErr string
+	}{
+		{
+			"valid",
+			types.NewDenomExtension("ucat", "acat", sdkmath.NewInt(1_000_000_000_000)),
+			"",
+		},
+		{
+			"valid - factor of 10",
+			types.NewDenomExtension("ucat", "dcat", sdkmath.NewInt(10)),
+			"",
+		},
+		{
+			"invalid - integer denom",
+			types.NewDenomExtension("", "acat", sdkmath.NewInt(10)),
+			"invalid integer denom: invalid denom: ",
+		},
+		{
+			"invalid - extended denom",
+			types.NewDenomExtension("ucat", "a", sdkmath.NewInt(10)),
+			"invalid extended denom: invalid denom: a",
+		},
+		{
+			"invalid - same denoms",
+			types.NewDenomExtension("ucat", "ucat", sdkmath.NewInt(10)),
+			"integer and extended denoms must be different, got ucat",
+		},
+		{
+			"invalid - nil factor",
+			types.NewDenomExtension("ucat", "acat", sdkmath.Int{}),
+			"nil conversion factor for acat",
+		},
+		{
+			"invalid - factor of 1",
+			types.NewDenomExtension("ucat", "acat", sdkmath.OneInt()),
+			"conversion factor 1 for acat must be greater than 1",
+		},
+		{
+			"invalid - negative factor",
+			types.NewDenomExtension("ucat", "acat", sdkmath.NewInt(-10)),
+			"conversion factor -10 for acat must be greater than 1",
+		},
+		{
+			"invalid - factor not a power of 10",
+			types.NewDenomExtension("ucat", "acat", sdkmath.NewInt(1_000_000_000_001)),
+			"conversion factor 1000000000001 for acat must be a power of 10",
+		},
+		{
+			"invalid - factor multiple of 10 but not a power of 10",
+			types.NewDenomExtension("ucat", "acat", sdkmath.NewInt(2_000)),
+			"conversion factor 2000 for acat must be a power of 10",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.ext.Validate()
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestDenomExtension_ValidateFractionalAmount(t *testing.T) {
+	ext := types.NewDenomExtension("ucat", "acat", sdkmath.NewInt(1_000))
+
+	require.NoError(t, ext.ValidateFractionalAmount(sdkmath.NewInt(1)))
+	require.NoError(t, ext.ValidateFractionalAmount(sdkmath.NewInt(999)))
+	require.EqualError(t, ext.ValidateFractionalAmount(sdkmath.NewInt(1_000)), "amount 1000 exceeds max of 999")
+	require.EqualError(t, ext.ValidateFractionalAmount(sdkmath.ZeroInt()), "non-positive amount 0")
+	require.EqualError(t, ext.ValidateFractionalAmount(sdkmath.Int{}), "nil amount")
+}
+
+func TestDenomExtensions_Validate(t *testing.T) {
+	catExt := types.NewDenomExtension("ucat", "acat", sdkmath.NewInt(1_000))
+
+	tests := []struct {
+		name    string
+		exts    types.DenomExtensions
+		wantErr string
+	}{
+		{
+			"valid - empty",
+			types.DenomExtensions{},
+			"",
+		},
+		{
+			"valid - multiple",
+			types.DenomExtensions{types.DefaultDenomExtension(), catExt},
+			"",
+		},
+		{
+			"invalid - duplicate extension",
+			types.DenomExtensions{catExt, catExt},
+			"duplicate denom ucat",
+		},
+		{
+			"invalid - shared integer denom",
+			types.DenomExtensions{
+				catExt,
+				types.NewDenomExtension("ucat", "ncat", sdkmath.NewInt(1_000)),
+			},
+			"duplicate denom ucat",
+		},
+		{
+			"invalid - extended denom is another integer denom",
+			types.DenomExtensions{
+				catExt,
+				types.NewDenomExtension("mcat", "ucat", sdkmath.NewInt(1_000)),
+			},
+			"duplicate denom ucat",
+		},
+		{
+			"invalid - invalid extension",
+			types.DenomExtensions{
+				catExt,
+				types.NewDenomExtension("udog", "adog", sdkmath.NewInt(5)),
+			},
+			"conversion factor 5 for adog must be a power of 10",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.exts.Validate()
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestDenomExtensions_SplitAndSum(t *testing.T) {
+	exts := types.DenomExtensions{
+		types.DefaultDenomExtension(),
+		types.NewDenomExtension("ucat", "acat", sdkmath.NewInt(1_000)),
+	}
+
+	amt := sdk.NewCoins(
+		sdk.NewInt64Coin(types.ExtendedCoinDenom, 5),
+		sdk.NewInt64Coin(types.IntegerCoinDenom, 2),
+		sdk.NewInt64Coin("acat", 1_500),
+		sdk.NewInt64Coin("ucat", 3),
+		sdk.NewInt64Coin("usdc", 7),
+	)
+
+	extended, passthrough := exts.SplitExtendedCoins(amt)
+	require.Equal(t, sdk.NewCoins(
+		sdk.NewInt64Coin(types.ExtendedCoinDenom, 5),
+		sdk.NewInt64Coin("acat", 1_500),
+	), extended)
+	require.Equal(t, sdk.NewCoins(
+		sdk.NewInt64Coin(types.IntegerCoinDenom, 2),
+		sdk.NewInt64Coin("ucat", 3),
+		sdk.NewInt64Coin("usdc", 7),
+	), passthrough)
+
+	require.Equal(t, sdk.NewCoins(
+		sdk.NewInt64Coin(types.ExtendedCoinDenom, 2_000_000_000_005),
+		sdk.NewInt64Coin("acat", 4_500),
+	), exts.SumExtendedCoins(amt))
+
+	ext, found := exts.Find("acat")
+	require.True(t, found)
+	require.Equal(t, "ucat", ext.IntegerDenom)
+
+	_, found = exts.Find("ucat")
+	require.False(t, found, "integer denoms should not be found")
+}
+
+func TestParams_Validate(t *testing.T) {
+	require.NoError(t, types.DefaultParams().Validate())
+
+	require.NoError(t, types.NewParams(types.DenomExtensions{
+		types.NewDenomExtension("ucat", "acat", sdkmath.NewInt(1_000)),
+	}).Validate())
+
+	require.EqualError(
+		t,
+		types.NewParams(types.DenomExtensions{types.DefaultDenomExtension()}).Validate(),
+		"invalid denom extensions: duplicate denom ukava",
+		"params should not include the built-in extension",
+	)
+
+	require.EqualError(
+		t,
+		types.NewParams(types.DenomExtensions{
+			types.NewDenomExtension("ucat", types.ExtendedCoinDenom, sdkmath.NewInt(1_000)),
+		}).Validate(),
+		"invalid denom extensions: duplicate denom akava",
+	)
+}
@@ -10,16 +10,5 @@ import (
 // amount in extended coins. This is intended to get the full value to emit in
 // events.
 func SumExtendedCoin(amt sdk.Coins) sdk.Coin {
-	// ukava converted to akava
-	integerAmount := amt.AmountOf(IntegerCoinDenom).Mul(conversionFactor)
-	// akava as is
-	extendedAmount := amt.AmountOf(ExtendedCoinDenom)
-
-	// total of ukava and akava amounts
-	fullEmissionAmount := integerAmount.Add(extendedAmount)
-
-	return sdk.NewCoin(
-		ExtendedCoinDenom,
-		fullEmissionAmount,
-	)
+	return DefaultDenomExtension().SumExtendedCoin(amt)
 }
@@ -1,8 +1,6 @@
 package types
 
 import (
-	fmt "fmt"
-
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -31,29 +29,22 @@ func NewFractionalBalance(address string, amount sdkmath.Int) FractionalBalance
 // Validate returns an error if the FractionalBalance has an invalid address or
 // negative amount.
 func (fb FractionalBalance) Validate() error {
+	return fb.ValidateForExtension(DefaultDenomExtension())
+}
+
+// ValidateForExtension returns an error if the FractionalBalance has an invalid
+// address or an invalid fractional amount for the given DenomExtension.
+func (fb FractionalBalance) ValidateForExtension(ext DenomExtension) error {
 	if _, err := sdk.AccAddressFromBech32(fb.Address); err != nil {
 		return err
 	}
 
-	// Validate the amount with the FractionalAmount wrapper
-	return ValidateFractionalAmount(fb.Amount)
+	return ext.ValidateFractionalAmount(fb.Amount)
 }
 
 // ValidateFractionalAmount checks if an sdkmath.Int is a valid fractional
-// amount, ensuring it is positive and less than or equal to the maximum
+// amount of ExtendedCoinDenom, ensuring it is positive and less than or equal to the maximum
 // fractional amount.
 func ValidateFractionalAmount(amt sdkmath.Int) error {
-	if amt.IsNil() {
-		return fmt.Errorf("nil amount")
-	}
-
-	if !amt.IsPositive() {
-		return fmt.Errorf("non-positive amount %v", amt)
-	}
-
-	if amt.GTE(conversionFactor) {
-		return fmt.Errorf("amount %v exceeds max of %v", amt, conversionFactor.SubRaw(1))
-	}
-
-	return nil
+	return DefaultDenomExtension().ValidateFractionalAmount(amt)
 }
@@ -12,11 +12,17 @@ type FractionalBalances []FractionalBalance
 
 // Validate returns an error if any FractionalBalance in the slice is invalid.
 func (fbs FractionalBalances) Validate() error {
+	return fbs.ValidateForExtension(DefaultDenomExtension())
+}
+
+// ValidateForExtension returns an error if any FractionalBalance in the slice
+// is invalid for the given DenomExtension.
+func (fbs FractionalBalances) ValidateForExtension(ext DenomExtension) error {
 	seenAddresses := make(map[string]struct{})
 
 	for _, fb := range fbs {
 		// Individual FractionalBalance validation
-		if err := fb.Validate(); err != nil {
+		if err := fb.ValidateForExtension(ext); err != nil {
 			return fmt.Errorf("invalid fractional balance for %s: %w", fb.Address, err)
 		}
 
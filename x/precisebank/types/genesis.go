@@ -12,8 +12,39 @@ func NewGenesisState(
 	remainder sdkmath.Int,
 ) *GenesisState {
 	return &GenesisState{
-		Balances:  balances,
-		Remainder: remainder,
+		Balances:      balances,
+		Remainder:     remainder,
+		Params:        DefaultParams(),
+		DenomBalances: []DenomBalances{},
+	}
+}
+
+// NewGenesisStateWithDenoms creates a new genesis state with denom extensions
+// in params and their balances.
+func NewGenesisStateWithDenoms(
+	balances FractionalBalances,
+	remainder sdkmath.Int,
+	params Params,
+	denomBalances []DenomBalances,
+) *GenesisState {
+	return &GenesisState{
+		Balances:      balances,
+		Remainder:     remainder,
+		Params:        params,
+		DenomBalances: denomBalances,
+	}
+}
+
+// NewDenomBalances returns a new DenomBalances for the given extended denom.
+func NewDenomBalances(
+	extendedDenom string,
+	balances FractionalBalances,
+	remainder sdkmath.Int,
+) DenomBalances {
+	return DenomBalances{
+		ExtendedDenom: extendedDenom,
+		Balances:      balances,
+		Remainder:     remainder,
 	}
 }
 
@@ -25,37 +56,74 @@ func DefaultGenesisState() *GenesisState {
 // Validate performs basic validation of genesis data returning an  error for
 // any failed validation criteria.
 func (gs *GenesisState) Validate() error {
+	if err := validateBalancesAndRemainder(DefaultDenomExtension(), gs.Balances, gs.Remainder); err != nil {
+		return err
+	}
+
+	if err := gs.Params.Validate(); err != nil {
+		return fmt.Errorf("invalid params: %w", err)
+	}
+
+	seenDenoms := make(map[string]struct{})
+	for _, db := range gs.DenomBalances {
+		// Only denoms in params are stored separately, the built-in
+		// extension uses Balances and Remainder.
+		ext, found := gs.Params.DenomExtensions.Find(db.ExtendedDenom)
+		if !found {
+			return fmt.Errorf("denom balances for %s has no denom extension in params", db.ExtendedDenom)
+		}
+
+		if _, found := seenDenoms[db.ExtendedDenom]; found {
+			return fmt.Errorf("duplicate denom balances for %s", db.ExtendedDenom)
+		}
+		seenDenoms[db.ExtendedDenom] = struct{}{}
+
+		if err := validateBalancesAndRemainder(ext, db.Balances, db.Remainder); err != nil {
+			return fmt.Errorf("invalid denom balances for %s: %w", db.ExtendedDenom, err)
+		}
+	}
+
+	return nil
+}
+
+// validateBalancesAndRemainder validates the fractional balances and remainder
+// of a single DenomExtension.
+func validateBalancesAndRemainder(
+	ext DenomExtension,
+	balances FractionalBalances,
+	remainder sdkmath.Int,
+) error {
 	// Validate all FractionalBalances
-	if err := gs.Balances.Validate(); err != nil {
+	if err := balances.ValidateForExtension(ext); err != nil {
 		return fmt.Errorf("invalid balances: %w", err)
 	}
 
-	if gs.Remainder.IsNil() {
+	if remainder.IsNil() {
 		return fmt.Errorf("nil remainder amount")
 	}
 
 	// Validate remainder, 0 <= remainder <= maxFractionalAmount
-	if gs.Remainder.IsNegative() {
-		return fmt.Errorf("negative remainder amount %s", gs.Remainder)
+	if remainder.IsNegative() {
+		return fmt.Errorf("negative remainder amount %s", remainder)
 	}
 
-	if gs.Remainder.GTE(conversionFactor) {
-		return fmt.Errorf("remainder %v exceeds max of %v", gs.Remainder, conversionFactor.SubRaw(1))
+	if remainder.GTE(ext.ConversionFactor) {
+		return fmt.Errorf("remainder %v exceeds max of %v", remainder, ext.ConversionFactor.SubRaw(1))
 	}
 
 	// Determine if sum(fractionalBalances) + remainder = whole integer value
 	// i.e total of all fractional balances + remainder == 0 fractional digits
-	sum := gs.Balances.SumAmount()
-	sumWithRemainder := sum.Add(gs.Remainder)
+	sum := balances.SumAmount()
+	sumWithRemainder := sum.Add(remainder)
 
-	offBy := sumWithRemainder.Mod(conversionFactor)
+	offBy := sumWithRemainder.Mod(ext.ConversionFactor)
 
 	if !offBy.IsZero() {
 		return fmt.Errorf(
 			"sum of fractional balances %v + remainder %v is not a multiple of %v",
 			sum,
-			gs.Remainder,
-			conversionFactor,
+			remainder,
+			ext.ConversionFactor,
 		)
 	}
 
@@ -32,6 +32,12 @@ type GenesisState struct {
 	// remainder is an internal value of how much extra fractional digits are
 	// still backed by the reserve, but not assigned to any account.
 	Remainder cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=remainder,proto3,customtype=cosmossdk.io/math.Int" json:"remainder"`
+	// params defines the denom extensions managed by the module in addition to
+	// the built-in ukava extension.
+	Params Params `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	// denom_balances are the fractional balances and remainders of each denom
+	// extension in params. The ukava extension uses balances and remainder.
+	DenomBalances []DenomBalances `protobuf:"bytes,4,rep,name=denom_balances,json=denomBalances,proto3" json:"denom_balances"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -74,12 +80,85 @@ func (m *GenesisState) GetBalances() FractionalBalances {
 	return nil
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetDenomBalances() []DenomBalances {
+	if m != nil {
+		return m.DenomBalances
+	}
+	return nil
+}
+
+// DenomBalances defines the fractional balances and remainder of a single
+// denom extension.
+type DenomBalances struct {
+	// extended_denom is the extended denom of the denom extension.
+	ExtendedDenom string `protobuf:"bytes,1,opt,name=extended_denom,json=extendedDenom,proto3" json:"extended_denom,omitempty"`
+	// balances is a list of all the fractional balances of the extended denom.
+	Balances FractionalBalances `protobuf:"bytes,2,rep,name=balances,proto3,castrepeated=FractionalBalances" json:"balances"`
+	// remainder is the amount of fractional digits of the extended denom still
+	// backed by the reserve, but not assigned to any account.
+	Remainder cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=remainder,proto3,customtype=cosmossdk.io/math.Int" json:"remainder"`
+}
+
+func (m *DenomBalances) Reset()         { *m = DenomBalances{} }
+func (m *DenomBalances) String() string { return proto.CompactTextString(m) }
+func (*DenomBalances) ProtoMessage()    {}
+func (*DenomBalances) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7f1c47a86fb0d2e0, []int{1}
+}
+func (m *DenomBalances) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomBalances) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomBalances.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomBalances) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomBalances.Merge(m, src)
+}
+func (m *DenomBalances) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomBalances) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomBalances.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomBalances proto.InternalMessageInfo
+
+func (m *DenomBalances) GetExtendedDenom() string {
+	if m != nil {
+		return m.ExtendedDenom
+	}
+	return ""
+}
+
+func (m *DenomBalances) GetBalances() FractionalBalances {
+	if m != nil {
+		return m.Balances
+	}
+	return nil
+}
+
 // FractionalBalance defines the fractional portion of an account balance
 type FractionalBalance struct {
 	// address is the address of the balance holder.
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	// amount indicates amount of only the fractional balance owned by the
-	// address. FractionalBalance currently only supports tracking 1 single asset,
+	// address. The denom of the balance is determined by where it is stored,
 	// e.g. fractional balances of ukava.
 	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
 }
@@ -88,7 +167,7 @@ func (m *FractionalBalance) Reset()         { *m = FractionalBalance{} }
 func (m *FractionalBalance) String() string { return proto.CompactTextString(m) }
 func (*FractionalBalance) ProtoMessage()    {}
 func (*FractionalBalance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7f1c47a86fb0d2e0, []int{1}
+	return fileDescriptor_7f1c47a86fb0d2e0, []int{2}
 }
 func (m *FractionalBalance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -119,35 +198,43 @@ var xxx_messageInfo_FractionalBalance proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "kava.precisebank.v1.GenesisState")
+	proto.RegisterType((*DenomBalances)(nil), "kava.precisebank.v1.DenomBalances")
 	proto.RegisterType((*FractionalBalance)(nil), "kava.precisebank.v1.FractionalBalance")
 }
 
 func init() { proto.RegisterFile("kava/precisebank/v1/genesis.proto", fileDescriptor_7f1c47a86fb0d2e0) }
 
 var fileDescriptor_7f1c47a86fb0d2e0 = []byte{
-	// 351 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x91, 0x3f, 0x4f, 0xc2, 0x40,
-	0x18, 0xc6, 0x7b, 0x9a, 0x20, 0x9c, 0x2e, 0x56, 0x4c, 0x90, 0xa1, 0x45, 0x06, 0x43, 0x62, 0x7a,
-	0x17, 0x70, 0x73, 0xb3, 0x26, 0x12, 0x56, 0xd8, 0x1c, 0x34, 0xd7, 0xf6, 0x52, 0x2e, 0xd0, 0x3b,
-	0x72, 0x77, 0x10, 0xfd, 0x06, 0x8e, 0x4e, 0xce, 0xcc, 0xce, 0x2c, 0x7e, 0x03, 0x46, 0xc2, 0x64,
-	0x1c, 0xd0, 0xc0, 0xe2, 0xc7, 0x30, 0xe5, 0xf0, 0x5f, 0x70, 0x72, 0x7b, 0xfb, 0xbe, 0xbf, 0xe7,
-	0x79, 0x9f, 0xde, 0x0b, 0x0f, 0x3b, 0x64, 0x40, 0x70, 0x4f, 0xd2, 0x90, 0x29, 0x1a, 0x10, 0xde,
-	0xc1, 0x83, 0x2a, 0x8e, 0x29, 0xa7, 0x8a, 0x29, 0xd4, 0x93, 0x42, 0x0b, 0x7b, 0x2f, 0x45, 0xd0,
-	0x0f, 0x04, 0x0d, 0xaa, 0xc5, 0x83, 0x50, 0xa8, 0x44, 0xa8, 0xeb, 0x25, 0x82, 0xcd, 0x87, 0xe1,
-	0x8b, 0xf9, 0x58, 0xc4, 0xc2, 0xf4, 0xd3, 0xca, 0x74, 0xcb, 0x4f, 0x00, 0xee, 0xd4, 0x8d, 0x6f,
-	0x4b, 0x13, 0x4d, 0xed, 0x2b, 0x98, 0x0d, 0x48, 0x97, 0xf0, 0x90, 0xaa, 0x02, 0x28, 0x6d, 0x56,
-	0xb6, 0x6b, 0x47, 0xe8, 0x8f, 0x4d, 0xe8, 0x42, 0x92, 0x50, 0x33, 0xc1, 0x49, 0xd7, 0x37, 0xb8,
-	0x5f, 0x1c, 0xcf, 0x5c, 0xeb, 0xf1, 0xd5, 0xb5, 0xd7, 0x46, 0xaa, 0xf9, 0xe5, 0x69, 0x37, 0x60,
-	0x4e, 0xd2, 0x84, 0x30, 0x1e, 0x51, 0x59, 0xd8, 0x28, 0x81, 0x4a, 0xce, 0x3f, 0x4e, 0x85, 0x2f,
-	0x33, 0x77, 0xdf, 0xe4, 0x55, 0x51, 0x07, 0x31, 0x81, 0x13, 0xa2, 0xdb, 0xa8, 0xc1, 0xf5, 0x74,
-	0xe4, 0xc1, 0xd5, 0x8f, 0x34, 0xb8, 0x6e, 0x7e, 0xab, 0xcb, 0x0f, 0x00, 0xee, 0xae, 0xed, 0xb2,
-	0x6b, 0x70, 0x8b, 0x44, 0x91, 0xa4, 0x2a, 0xcd, 0x9f, 0xda, 0x17, 0xa6, 0x23, 0x2f, 0xbf, 0x72,
-	0x38, 0x33, 0x93, 0x96, 0x96, 0x8c, 0xc7, 0xcd, 0x4f, 0xd0, 0x3e, 0x87, 0x19, 0x92, 0x88, 0x3e,
-	0xd7, 0xff, 0x49, 0xb4, 0x92, 0x9e, 0x66, 0xef, 0x86, 0xae, 0xf5, 0x3e, 0x74, 0x2d, 0xbf, 0x3e,
-	0x9e, 0x3b, 0x60, 0x32, 0x77, 0xc0, 0xdb, 0xdc, 0x01, 0xf7, 0x0b, 0xc7, 0x9a, 0x2c, 0x1c, 0xeb,
-	0x79, 0xe1, 0x58, 0x97, 0x5e, 0xcc, 0x74, 0xbb, 0x1f, 0xa0, 0x50, 0x24, 0x38, 0x7d, 0x55, 0xaf,
-	0x4b, 0x02, 0xb5, 0xac, 0xf0, 0xcd, 0xaf, 0x73, 0xeb, 0xdb, 0x1e, 0x55, 0x41, 0x66, 0x79, 0xa4,
-	0x93, 0x8f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xbd, 0xf6, 0xf2, 0x8d, 0x0f, 0x02, 0x00, 0x00,
+	// 453 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x93, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x7d, 0x49, 0x15, 0xda, 0x2b, 0xa9, 0xc4, 0x51, 0x24, 0x13, 0x24, 0x3b, 0x58, 0x02,
+	0x45, 0x42, 0x3e, 0xab, 0x61, 0x82, 0x0d, 0x83, 0xa8, 0x32, 0x81, 0xdc, 0x8d, 0x81, 0xe8, 0xec,
+	0x3b, 0xb9, 0x56, 0xe2, 0x3b, 0xeb, 0xee, 0x1a, 0x95, 0x6f, 0xc0, 0xc8, 0xc4, 0xdc, 0x99, 0xb9,
+	0x5f, 0x01, 0xa9, 0x63, 0xd5, 0x09, 0x31, 0x14, 0x48, 0x16, 0x3e, 0x06, 0xb2, 0xcf, 0x29, 0x8d,
+	0xe2, 0x09, 0xd4, 0xed, 0xfc, 0xee, 0xf7, 0x7f, 0xef, 0xfe, 0x7f, 0xf9, 0xc1, 0x87, 0x13, 0x32,
+	0x23, 0x41, 0x21, 0x59, 0x92, 0x29, 0x16, 0x13, 0x3e, 0x09, 0x66, 0x7b, 0x41, 0xca, 0x38, 0x53,
+	0x99, 0xc2, 0x85, 0x14, 0x5a, 0xa0, 0xbb, 0x25, 0x82, 0xaf, 0x21, 0x78, 0xb6, 0xd7, 0xbb, 0x9f,
+	0x08, 0x95, 0x0b, 0x35, 0xae, 0x90, 0xc0, 0x7c, 0x18, 0xbe, 0xb7, 0x9b, 0x8a, 0x54, 0x98, 0x7a,
+	0x79, 0xaa, 0xab, 0xfd, 0xa6, 0x41, 0x05, 0x91, 0x24, 0xaf, 0x75, 0xde, 0xd7, 0x16, 0xbc, 0xbd,
+	0x6f, 0x26, 0x1f, 0x68, 0xa2, 0x19, 0x7a, 0x0f, 0x37, 0x63, 0x32, 0x25, 0x3c, 0x61, 0xca, 0x06,
+	0xfd, 0xf6, 0x60, 0x7b, 0xf8, 0x18, 0x37, 0xbc, 0x05, 0xbf, 0x96, 0x24, 0xd1, 0x99, 0xe0, 0x64,
+	0x1a, 0x1a, 0x3c, 0xec, 0x9d, 0x5d, 0xba, 0xd6, 0x97, 0x1f, 0x2e, 0x5a, 0xbb, 0x52, 0xd1, 0x55,
+	0x4f, 0x34, 0x82, 0x5b, 0x92, 0xe5, 0x24, 0xe3, 0x94, 0x49, 0xbb, 0xd5, 0x07, 0x83, 0xad, 0xf0,
+	0x49, 0x29, 0xfc, 0x7e, 0xe9, 0xde, 0x33, 0x8e, 0x14, 0x9d, 0xe0, 0x4c, 0x04, 0x39, 0xd1, 0x87,
+	0x78, 0xc4, 0xf5, 0xc5, 0xa9, 0x0f, 0x6b, 0xab, 0x23, 0xae, 0xa3, 0xbf, 0x6a, 0xf4, 0x0c, 0x76,
+	0x8c, 0x17, 0xbb, 0xdd, 0x07, 0x83, 0xed, 0xe1, 0x83, 0xc6, 0x87, 0xbe, 0xad, 0x90, 0x70, 0xa3,
+	0x1c, 0x12, 0xd5, 0x02, 0xf4, 0x06, 0xee, 0x50, 0xc6, 0x45, 0x3e, 0xbe, 0xf2, 0xba, 0x51, 0x79,
+	0xf5, 0x1a, 0x5b, 0xbc, 0x2a, 0xd1, 0xa5, 0x97, 0xba, 0x53, 0x97, 0x5e, 0x2f, 0x7a, 0xbf, 0x00,
+	0xec, 0xae, 0x60, 0xe8, 0x11, 0xdc, 0x61, 0xc7, 0x9a, 0x71, 0xca, 0xe8, 0xb8, 0x62, 0x6d, 0x50,
+	0xba, 0x8d, 0xba, 0xcb, 0x6a, 0x85, 0xaf, 0xe4, 0xdd, 0xba, 0xe9, 0xbc, 0xdb, 0xff, 0x93, 0xb7,
+	0xf7, 0x19, 0xc0, 0x3b, 0x6b, 0xb3, 0xd0, 0x10, 0xde, 0x22, 0x94, 0x4a, 0xa6, 0x94, 0x31, 0x18,
+	0xda, 0x17, 0xa7, 0xfe, 0x6e, 0xdd, 0xe1, 0x85, 0xb9, 0x39, 0xd0, 0x32, 0xe3, 0x69, 0xb4, 0x04,
+	0xd1, 0x4b, 0xd8, 0x21, 0xb9, 0x38, 0xe2, 0xfa, 0x5f, 0xfe, 0x80, 0x5a, 0xfa, 0x7c, 0xf3, 0xe3,
+	0x89, 0x6b, 0xfd, 0x3e, 0x71, 0xad, 0x70, 0xff, 0x6c, 0xee, 0x80, 0xf3, 0xb9, 0x03, 0x7e, 0xce,
+	0x1d, 0xf0, 0x69, 0xe1, 0x58, 0xe7, 0x0b, 0xc7, 0xfa, 0xb6, 0x70, 0xac, 0x77, 0x7e, 0x9a, 0xe9,
+	0xc3, 0xa3, 0x18, 0x27, 0x22, 0x0f, 0xca, 0x54, 0xfd, 0x29, 0x89, 0x55, 0x75, 0x0a, 0x8e, 0x57,
+	0xf6, 0x42, 0x7f, 0x28, 0x98, 0x8a, 0x3b, 0xd5, 0x52, 0x3c, 0xfd, 0x33, 0x00, 0x2d, 0x23, 0x68,
+	0x71, 0xa1, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -170,6 +257,30 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DenomBalances) > 0 {
+		for iNdEx := len(m.DenomBalances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomBalances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size := m.Remainder.Size()
 		i -= size
@@ -197,6 +308,60 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DenomBalances) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomBalances) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomBalances) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Remainder.Size()
+		i -= size
+		if _, err := m.Remainder.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ExtendedDenom) > 0 {
+		i -= len(m.ExtendedDenom)
+		copy(dAtA[i:], m.ExtendedDenom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ExtendedDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *FractionalBalance) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -262,6 +427,35 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Remainder.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.DenomBalances) > 0 {
+		for _, e := range m.DenomBalances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenomBalances) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ExtendedDenom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.Remainder.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -383,6 +577,223 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomBalances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomBalances = append(m.DenomBalances, DenomBalances{})
+			if err := m.DenomBalances[len(m.DenomBalances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomBalances) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomBalances: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomBalances: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtendedDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, FractionalBalance{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remainder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Remainder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
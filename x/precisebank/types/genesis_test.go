@@ -123,6 +123,128 @@ func TestGenesisStateValidate_Basic(t *testing.T) {
 	}
 }
 
+func TestGenesisStateValidate_DenomBalances(t *testing.T) {
+	app.SetSDKConfig()
+
+	catExt := types.NewDenomExtension("ucat", "acat", sdkmath.NewInt(1_000))
+	params := types.NewParams(types.DenomExtensions{catExt})
+
+	testCases := []struct {
+		name         string
+		genesisState *types.GenesisState
+		wantErr      string
+	}{
+		{
+			"valid - denom balances add up",
+			types.NewGenesisStateWithDenoms(
+				nil,
+				sdkmath.ZeroInt(),
+				params,
+				[]types.DenomBalances{
+					types.NewDenomBalances("acat", types.FractionalBalances{
+						types.NewFractionalBalance(sdk.AccAddress{1}.String(), sdkmath.NewInt(600)),
+						types.NewFractionalBalance(sdk.AccAddress{2}.String(), sdkmath.NewInt(300)),
+					}, sdkmath.NewInt(100)),
+				},
+			),
+			"",
+		},
+		{
+			"valid - params without denom balances",
+			types.NewGenesisStateWithDenoms(nil, sdkmath.ZeroInt(), params, nil),
+			"",
+		},
+		{
+			"invalid - params",
+			types.NewGenesisStateWithDenoms(
+				nil,
+				sdkmath.ZeroInt(),
+				types.NewParams(types.DenomExtensions{types.DefaultDenomExtension()}),
+				nil,
+			),
+			"invalid params: invalid denom extensions: duplicate denom ukava",
+		},
+		{
+			"invalid - denom not in params",
+			types.NewGenesisStateWithDenoms(
+				nil,
+				sdkmath.ZeroInt(),
+				params,
+				[]types.DenomBalances{
+					types.NewDenomBalances("adog", nil, sdkmath.ZeroInt()),
+				},
+			),
+			"denom balances for adog has no denom extension in params",
+		},
+		{
+			"invalid - built-in denom in denom balances",
+			types.NewGenesisStateWithDenoms(
+				nil,
+				sdkmath.ZeroInt(),
+				params,
+				[]types.DenomBalances{
+					types.NewDenomBalances(types.ExtendedCoinDenom, nil, sdkmath.ZeroInt()),
+				},
+			),
+			"denom balances for akava has no denom extension in params",
+		},
+		{
+			"invalid - duplicate denom balances",
+			types.NewGenesisStateWithDenoms(
+				nil,
+				sdkmath.ZeroInt(),
+				params,
+				[]types.DenomBalances{
+					types.NewDenomBalances("acat", nil, sdkmath.ZeroInt()),
+					types.NewDenomBalances("acat", nil, sdkmath.ZeroInt()),
+				},
+			),
+			"duplicate denom balances for acat",
+		},
+		{
+			"invalid - balance exceeds denom conversion factor",
+			types.NewGenesisStateWithDenoms(
+				nil,
+				sdkmath.ZeroInt(),
+				params,
+				[]types.DenomBalances{
+					types.NewDenomBalances("acat", types.FractionalBalances{
+						types.NewFractionalBalance(sdk.AccAddress{1}.String(), sdkmath.NewInt(1_000)),
+					}, sdkmath.ZeroInt()),
+				},
+			),
+			"invalid denom balances for acat: invalid balances: invalid fractional balance for kava1qy0xn7za: amount 1000 exceeds max of 999",
+		},
+		{
+			"invalid - denom balances do not add up",
+			types.NewGenesisStateWithDenoms(
+				nil,
+				sdkmath.ZeroInt(),
+				params,
+				[]types.DenomBalances{
+					types.NewDenomBalances("acat", types.FractionalBalances{
+						types.NewFractionalBalance(sdk.AccAddress{1}.String(), sdkmath.NewInt(600)),
+					}, sdkmath.NewInt(100)),
+				},
+			),
+			"invalid denom balances for acat: sum of fractional balances 600 + remainder 100 is not a multiple of 1000",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(tt *testing.T) {
+			err := tc.genesisState.Validate()
+
+			if tc.wantErr == "" {
+				require.NoError(tt, err)
+			} else {
+				require.EqualError(tt, err, tc.wantErr)
+			}
+		})
+	}
+}
+
 func TestGenesisStateValidate_Total(t *testing.T) {
 	testCases := []struct {
 		name              string
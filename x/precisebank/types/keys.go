@@ -1,6 +1,9 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
 
 const (
 	// ModuleName name that will be used throughout the module
@@ -14,15 +17,42 @@ const (
 
 // key prefixes for store
 var (
-	FractionalBalancePrefix = []byte{0x01} // address -> fractional balance
+	FractionalBalancePrefix      = []byte{0x01} // address -> fractional balance
+	DenomFractionalBalancePrefix = []byte{0x04} // extended denom -> address -> fractional balance
+	DenomRemainderPrefix         = []byte{0x05} // extended denom -> fractional balance remainder
 )
 
 // Keys for store that are not prefixed
 var (
 	RemainderBalanceKey = []byte{0x02} // fractional balance remainder
+	ParamsKey           = []byte{0x03}
 )
 
 // FractionalBalanceKey returns a key from an address
 func FractionalBalanceKey(address sdk.AccAddress) []byte {
 	return address.Bytes()
 }
+
+// DenomFractionalBalancePrefixKey returns the prefix of the fractional
+// balances of an extended denom. ExtendedCoinDenom balances use
+// FractionalBalancePrefix.
+func DenomFractionalBalancePrefixKey(extendedDenom string) []byte {
+	if extendedDenom == ExtendedCoinDenom {
+		return FractionalBalancePrefix
+	}
+
+	return append(
+		append([]byte{}, DenomFractionalBalancePrefix...),
+		address.MustLengthPrefix([]byte(extendedDenom))...,
+	)
+}
+
+// DenomRemainderKey returns the key of the remainder of an extended denom.
+// The ExtendedCoinDenom remainder uses RemainderBalanceKey.
+func DenomRemainderKey(extendedDenom string) []byte {
+	if extendedDenom == ExtendedCoinDenom {
+		return RemainderBalanceKey
+	}
+
+	return append(append([]byte{}, DenomRemainderPrefix...), []byte(extendedDenom)...)
+}
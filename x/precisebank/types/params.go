@@ -0,0 +1,33 @@
+package types
+
+import (
+	fmt "fmt"
+)
+
+// NewParams returns a new params object
+func NewParams(denomExtensions DenomExtensions) Params {
+	return Params{
+		DenomExtensions: denomExtensions,
+	}
+}
+
+// DefaultParams returns default params for the precisebank module, with no
+// denom extensions in addition to the built-in one.
+func DefaultParams() Params {
+	return NewParams(nil)
+}
+
+// AllDenomExtensions returns the built-in denom extension followed by the
+// denom extensions in params.
+func (p Params) AllDenomExtensions() DenomExtensions {
+	return append(DenomExtensions{DefaultDenomExtension()}, p.DenomExtensions...)
+}
+
+// Validate checks the params are valid
+func (p Params) Validate() error {
+	if err := p.AllDenomExtensions().Validate(); err != nil {
+		return fmt.Errorf("invalid denom extensions: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,605 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: kava/precisebank/v1/params.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters of the precisebank module.
+type Params struct {
+	// denom_extensions are the integer denoms extended with additional precision
+	// by x/precisebank, in addition to the built-in ukava extension to akava.
+	DenomExtensions DenomExtensions `protobuf:"bytes,1,rep,name=denom_extensions,json=denomExtensions,proto3,castrepeated=DenomExtensions" json:"denom_extensions"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d59563bce86b5ccc, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetDenomExtensions() DenomExtensions {
+	if m != nil {
+		return m.DenomExtensions
+	}
+	return nil
+}
+
+// DenomExtension defines an integer denom managed by x/bank and the extended
+// denom that represents it with additional precision in x/precisebank.
+type DenomExtension struct {
+	// integer_denom is the denom of the integer coins held in x/bank, which are
+	// also held by the reserve to back fractional balances.
+	IntegerDenom string `protobuf:"bytes,1,opt,name=integer_denom,json=integerDenom,proto3" json:"integer_denom,omitempty"`
+	// extended_denom is the denom of the extended coins, representing the full
+	// balance of integer and fractional amounts.
+	ExtendedDenom string `protobuf:"bytes,2,opt,name=extended_denom,json=extendedDenom,proto3" json:"extended_denom,omitempty"`
+	// conversion_factor is the amount of extended coins equal to one integer
+	// coin. It must be a power of ten greater than one.
+	ConversionFactor cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=conversion_factor,json=conversionFactor,proto3,customtype=cosmossdk.io/math.Int" json:"conversion_factor"`
+}
+
+func (m *DenomExtension) Reset()         { *m = DenomExtension{} }
+func (m *DenomExtension) String() string { return proto.CompactTextString(m) }
+func (*DenomExtension) ProtoMessage()    {}
+func (*DenomExtension) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d59563bce86b5ccc, []int{1}
+}
+func (m *DenomExtension) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomExtension) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomExtension.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomExtension) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomExtension.Merge(m, src)
+}
+func (m *DenomExtension) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomExtension) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomExtension.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomExtension proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*Params)(nil), "kava.precisebank.v1.Params")
+	proto.RegisterType((*DenomExtension)(nil), "kava.precisebank.v1.DenomExtension")
+}
+
+func init() { proto.RegisterFile("kava/precisebank/v1/params.proto", fileDescriptor_d59563bce86b5ccc) }
+
+var fileDescriptor_d59563bce86b5ccc = []byte{
+	// 344 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0xd1, 0x3f, 0x4b, 0xfb, 0x40,
+	0x18, 0x07, 0xf0, 0xdc, 0xaf, 0x3f, 0x0a, 0x9e, 0xf6, 0x8f, 0x51, 0xb1, 0x76, 0x48, 0x4a, 0x8b,
+	0x50, 0x90, 0xde, 0x51, 0xdd, 0x1c, 0x8b, 0x7f, 0xe8, 0x26, 0x9d, 0xc4, 0x25, 0x5c, 0x92, 0x33,
+	0x0d, 0x35, 0x77, 0x31, 0x77, 0x86, 0xfa, 0x0e, 0x1c, 0x7d, 0x09, 0xce, 0xce, 0x8e, 0xbe, 0x80,
+	0x8e, 0xc5, 0x49, 0x1c, 0xaa, 0xb4, 0x6f, 0x44, 0xee, 0xd2, 0xa2, 0x01, 0xb7, 0xcb, 0x97, 0x0f,
+	0xdf, 0x3c, 0x3c, 0x0f, 0x6c, 0x8c, 0x48, 0x4a, 0x70, 0x9c, 0x50, 0x2f, 0x14, 0xd4, 0x25, 0x6c,
+	0x84, 0xd3, 0x2e, 0x8e, 0x49, 0x42, 0x22, 0x81, 0xe2, 0x84, 0x4b, 0x6e, 0x6e, 0x29, 0x81, 0x7e,
+	0x09, 0x94, 0x76, 0xeb, 0x7b, 0x1e, 0x17, 0x11, 0x17, 0x8e, 0x26, 0x38, 0xfb, 0xc8, 0x7c, 0x7d,
+	0x3b, 0xe0, 0x01, 0xcf, 0x72, 0xf5, 0xca, 0xd2, 0xe6, 0x2d, 0x2c, 0x5e, 0xe8, 0x56, 0x33, 0x80,
+	0x55, 0x9f, 0x32, 0x1e, 0x39, 0x74, 0x2c, 0x29, 0x13, 0x21, 0x67, 0xa2, 0x06, 0x1a, 0x85, 0xf6,
+	0xfa, 0x61, 0x0b, 0xfd, 0xf1, 0x2b, 0x74, 0xa2, 0xf0, 0xe9, 0xca, 0xf6, 0x76, 0x27, 0x33, 0xdb,
+	0x78, 0xfe, 0xb4, 0x2b, 0xf9, 0x5c, 0x0c, 0x2a, 0x7e, 0x3e, 0x68, 0xbe, 0x02, 0x58, 0xce, 0x23,
+	0xb3, 0x05, 0x4b, 0x21, 0x93, 0x34, 0xa0, 0x89, 0xa3, 0x75, 0x0d, 0x34, 0x40, 0x7b, 0x6d, 0xb0,
+	0xb1, 0x0c, 0xb5, 0x36, 0xf7, 0x61, 0x59, 0x8f, 0xe6, 0x53, 0x7f, 0xa9, 0xfe, 0x69, 0x55, 0x5a,
+	0xa5, 0x19, 0xbb, 0x84, 0x9b, 0x1e, 0x67, 0x29, 0x4d, 0x54, 0xb3, 0x73, 0x4d, 0x3c, 0xc9, 0x93,
+	0x5a, 0x41, 0xc9, 0xde, 0x81, 0x9a, 0xf1, 0x63, 0x66, 0xef, 0x64, 0x8b, 0x11, 0xfe, 0x08, 0x85,
+	0x1c, 0x47, 0x44, 0x0e, 0x51, 0x9f, 0xc9, 0xb7, 0x97, 0x0e, 0x5c, 0x6e, 0xac, 0xcf, 0xe4, 0xa0,
+	0xfa, 0xd3, 0x72, 0xa6, 0x4b, 0x8e, 0xff, 0x3f, 0x3c, 0xd9, 0x46, 0xef, 0x7c, 0x32, 0xb7, 0xc0,
+	0x74, 0x6e, 0x81, 0xaf, 0xb9, 0x05, 0x1e, 0x17, 0x96, 0x31, 0x5d, 0x58, 0xc6, 0xfb, 0xc2, 0x32,
+	0xae, 0x3a, 0x41, 0x28, 0x87, 0x77, 0x2e, 0xf2, 0x78, 0x84, 0xd5, 0xc6, 0x3a, 0x37, 0xc4, 0x15,
+	0xfa, 0x85, 0xc7, 0xb9, 0x53, 0xca, 0xfb, 0x98, 0x0a, 0xb7, 0xa8, 0x2f, 0x70, 0xf4, 0x3d, 0x00,
+	0xb8, 0xe2, 0x8a, 0xbc, 0xeb, 0x01, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DenomExtensions) > 0 {
+		for iNdEx := len(m.DenomExtensions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomExtensions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomExtension) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomExtension) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomExtension) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ConversionFactor.Size()
+		i -= size
+		if _, err := m.ConversionFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ExtendedDenom) > 0 {
+		i -= len(m.ExtendedDenom)
+		copy(dAtA[i:], m.ExtendedDenom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.ExtendedDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.IntegerDenom) > 0 {
+		i -= len(m.IntegerDenom)
+		copy(dAtA[i:], m.IntegerDenom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.IntegerDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DenomExtensions) > 0 {
+		for _, e := range m.DenomExtensions {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenomExtension) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IntegerDenom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.ExtendedDenom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.ConversionFactor.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomExtensions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomExtensions = append(m.DenomExtensions, DenomExtension{})
+			if err := m.DenomExtensions[len(m.DenomExtensions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomExtension) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomExtension: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomExtension: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntegerDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IntegerDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtendedDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConversionFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
@@ -5,8 +5,11 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -32,6 +35,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // QueryTotalFractionalBalancesRequest defines the request type for Query/TotalFractionalBalances method.
 type QueryTotalFractionalBalancesRequest struct {
+	// denom is the extended denom to query. Defaults to akava if empty.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *QueryTotalFractionalBalancesRequest) Reset()         { *m = QueryTotalFractionalBalancesRequest{} }
@@ -109,6 +114,8 @@ var xxx_messageInfo_QueryTotalFractionalBalancesResponse proto.InternalMessageIn
 
 // QueryRemainderRequest defines the request type for Query/Remainder method.
 type QueryRemainderRequest struct {
+	// denom is the extended denom to query. Defaults to akava if empty.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *QueryRemainderRequest) Reset()         { *m = QueryRemainderRequest{} }
@@ -188,6 +195,8 @@ var xxx_messageInfo_QueryRemainderResponse proto.InternalMessageInfo
 type QueryFractionalBalanceRequest struct {
 	// address is the account address to query  fractional balance for.
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// denom is the extended denom to query. Defaults to akava if empty.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *QueryFractionalBalanceRequest) Reset()         { *m = QueryFractionalBalanceRequest{} }
@@ -262,6 +271,301 @@ func (m *QueryFractionalBalanceResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryFractionalBalanceResponse proto.InternalMessageInfo
 
+// QueryFractionalBalancesRequest defines the request type for Query/FractionalBalances method.
+type QueryFractionalBalancesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// denom is the extended denom to query. Defaults to akava if empty.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryFractionalBalancesRequest) Reset()         { *m = QueryFractionalBalancesRequest{} }
+func (m *QueryFractionalBalancesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFractionalBalancesRequest) ProtoMessage()    {}
+func (*QueryFractionalBalancesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a91a8caa7551030, []int{6}
+}
+func (m *QueryFractionalBalancesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFractionalBalancesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFractionalBalancesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFractionalBalancesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFractionalBalancesRequest.Merge(m, src)
+}
+func (m *QueryFractionalBalancesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFractionalBalancesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFractionalBalancesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFractionalBalancesRequest proto.InternalMessageInfo
+
+// QueryFractionalBalancesResponse defines the response type for Query/FractionalBalances method.
+type QueryFractionalBalancesResponse struct {
+	// balances is a page of the fractional balances of all accounts.
+	Balances FractionalBalances `protobuf:"bytes,1,rep,name=balances,proto3,castrepeated=FractionalBalances" json:"balances"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFractionalBalancesResponse) Reset()         { *m = QueryFractionalBalancesResponse{} }
+func (m *QueryFractionalBalancesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFractionalBalancesResponse) ProtoMessage()    {}
+func (*QueryFractionalBalancesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a91a8caa7551030, []int{7}
+}
+func (m *QueryFractionalBalancesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFractionalBalancesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFractionalBalancesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFractionalBalancesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFractionalBalancesResponse.Merge(m, src)
+}
+func (m *QueryFractionalBalancesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFractionalBalancesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFractionalBalancesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFractionalBalancesResponse proto.InternalMessageInfo
+
+// QueryReconciliationRequest defines the request type for Query/Reconciliation method.
+type QueryReconciliationRequest struct {
+	// denom is the extended denom to query. Defaults to akava if empty.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryReconciliationRequest) Reset()         { *m = QueryReconciliationRequest{} }
+func (m *QueryReconciliationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReconciliationRequest) ProtoMessage()    {}
+func (*QueryReconciliationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a91a8caa7551030, []int{8}
+}
+func (m *QueryReconciliationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReconciliationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReconciliationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReconciliationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReconciliationRequest.Merge(m, src)
+}
+func (m *QueryReconciliationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReconciliationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReconciliationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReconciliationRequest proto.InternalMessageInfo
+
+// QueryReconciliationResponse defines the response type for Query/Reconciliation method.
+type QueryReconciliationResponse struct {
+	// total_fractional_balances is the sum of all fractional balances.
+	TotalFractionalBalances types.Coin `protobuf:"bytes,1,opt,name=total_fractional_balances,json=totalFractionalBalances,proto3" json:"total_fractional_balances"`
+	// remainder is the amount backed by the reserve, but not yet owned by any
+	// account.
+	Remainder types.Coin `protobuf:"bytes,2,opt,name=remainder,proto3" json:"remainder"`
+	// reserve is the balance of the module reserve in the extended denom.
+	Reserve types.Coin `protobuf:"bytes,3,opt,name=reserve,proto3" json:"reserve"`
+	// discrepancy is the reserve balance minus the sum of fractional balances
+	// and the remainder, in the extended denom. It is zero when the reserve
+	// exactly backs all fractional balances.
+	Discrepancy cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=discrepancy,proto3,customtype=cosmossdk.io/math.Int" json:"discrepancy"`
+	// invariants are the results of each registered invariant.
+	Invariants []InvariantResult `protobuf:"bytes,5,rep,name=invariants,proto3" json:"invariants"`
+	// broken is true if any invariant is broken.
+	Broken bool `protobuf:"varint,6,opt,name=broken,proto3" json:"broken,omitempty"`
+}
+
+func (m *QueryReconciliationResponse) Reset()         { *m = QueryReconciliationResponse{} }
+func (m *QueryReconciliationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReconciliationResponse) ProtoMessage()    {}
+func (*QueryReconciliationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a91a8caa7551030, []int{9}
+}
+func (m *QueryReconciliationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReconciliationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReconciliationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReconciliationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReconciliationResponse.Merge(m, src)
+}
+func (m *QueryReconciliationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReconciliationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReconciliationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReconciliationResponse proto.InternalMessageInfo
+
+// QueryParamsRequest defines the request type for Query/Params method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a91a8caa7551030, []int{10}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse defines the response type for Query/Params method.
+type QueryParamsResponse struct {
+	// params defines the parameters of the module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// denom_extensions are all denom extensions managed by the module,
+	// including the built-in ukava extension.
+	DenomExtensions DenomExtensions `protobuf:"bytes,2,rep,name=denom_extensions,json=denomExtensions,proto3,castrepeated=DenomExtensions" json:"denom_extensions"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a91a8caa7551030, []int{11}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+// InvariantResult defines the result of a single invariant.
+type InvariantResult struct {
+	// route is the route the invariant is registered under.
+	Route string `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"`
+	// broken is true if the invariant is broken.
+	Broken bool `protobuf:"varint,2,opt,name=broken,proto3" json:"broken,omitempty"`
+	// message describes the invariant and any violation found.
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (m *InvariantResult) Reset()         { *m = InvariantResult{} }
+func (m *InvariantResult) String() string { return proto.CompactTextString(m) }
+func (*InvariantResult) ProtoMessage()    {}
+func (*InvariantResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a91a8caa7551030, []int{12}
+}
+func (m *InvariantResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InvariantResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InvariantResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InvariantResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvariantResult.Merge(m, src)
+}
+func (m *InvariantResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *InvariantResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvariantResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvariantResult proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*QueryTotalFractionalBalancesRequest)(nil), "kava.precisebank.v1.QueryTotalFractionalBalancesRequest")
 	proto.RegisterType((*QueryTotalFractionalBalancesResponse)(nil), "kava.precisebank.v1.QueryTotalFractionalBalancesResponse")
@@ -269,43 +573,80 @@ func init() {
 	proto.RegisterType((*QueryRemainderResponse)(nil), "kava.precisebank.v1.QueryRemainderResponse")
 	proto.RegisterType((*QueryFractionalBalanceRequest)(nil), "kava.precisebank.v1.QueryFractionalBalanceRequest")
 	proto.RegisterType((*QueryFractionalBalanceResponse)(nil), "kava.precisebank.v1.QueryFractionalBalanceResponse")
+	proto.RegisterType((*QueryFractionalBalancesRequest)(nil), "kava.precisebank.v1.QueryFractionalBalancesRequest")
+	proto.RegisterType((*QueryFractionalBalancesResponse)(nil), "kava.precisebank.v1.QueryFractionalBalancesResponse")
+	proto.RegisterType((*QueryReconciliationRequest)(nil), "kava.precisebank.v1.QueryReconciliationRequest")
+	proto.RegisterType((*QueryReconciliationResponse)(nil), "kava.precisebank.v1.QueryReconciliationResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "kava.precisebank.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "kava.precisebank.v1.QueryParamsResponse")
+	proto.RegisterType((*InvariantResult)(nil), "kava.precisebank.v1.InvariantResult")
 }
 
 func init() { proto.RegisterFile("kava/precisebank/v1/query.proto", fileDescriptor_8a91a8caa7551030) }
 
 var fileDescriptor_8a91a8caa7551030 = []byte{
-	// 486 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x4d, 0x8b, 0xd3, 0x40,
-	0x18, 0xc7, 0x33, 0xb2, 0xab, 0x74, 0x3c, 0xed, 0xf8, 0xb2, 0x6b, 0xd0, 0xd9, 0x25, 0xbe, 0x20,
-	0xca, 0xce, 0x98, 0x16, 0xc5, 0x1e, 0xbc, 0x54, 0xf0, 0x22, 0x08, 0x16, 0x41, 0x10, 0x64, 0x79,
-	0x92, 0xce, 0xc6, 0xb0, 0xe9, 0x4c, 0x36, 0x33, 0x2d, 0x2e, 0xe2, 0xc5, 0xb3, 0x07, 0xc1, 0x93,
-	0x9f, 0xc4, 0x6f, 0x20, 0x3d, 0x2e, 0x78, 0xf1, 0x24, 0xda, 0xfa, 0x41, 0x24, 0x93, 0x69, 0xd5,
-	0xb6, 0x91, 0xea, 0x6d, 0x32, 0xcf, 0xcb, 0xff, 0xf7, 0xcc, 0xf3, 0x27, 0x78, 0xfb, 0x00, 0x86,
-	0xc0, 0xf3, 0x42, 0xc4, 0xa9, 0x16, 0x11, 0xc8, 0x03, 0x3e, 0x0c, 0xf9, 0xe1, 0x40, 0x14, 0x47,
-	0x2c, 0x2f, 0x94, 0x51, 0xe4, 0x4c, 0x99, 0xc0, 0x7e, 0x4b, 0x60, 0xc3, 0xd0, 0xa7, 0xb1, 0xd2,
-	0x7d, 0xa5, 0x79, 0x04, 0x5a, 0xf0, 0x61, 0x18, 0x09, 0x03, 0x21, 0x8f, 0x55, 0x2a, 0xab, 0x22,
-	0xff, 0x6c, 0xa2, 0x12, 0x65, 0x8f, 0xbc, 0x3c, 0xb9, 0xdb, 0x8b, 0x89, 0x52, 0x49, 0x26, 0x38,
-	0xe4, 0x29, 0x07, 0x29, 0x95, 0x01, 0x93, 0x2a, 0xa9, 0xab, 0x68, 0x70, 0x15, 0x5f, 0x7e, 0x5c,
-	0xea, 0x3e, 0x51, 0x06, 0xb2, 0x07, 0x05, 0xc4, 0x65, 0x10, 0xb2, 0x0e, 0x64, 0x20, 0x63, 0xa1,
-	0xbb, 0xe2, 0x70, 0x20, 0xb4, 0x09, 0x9e, 0xe3, 0x2b, 0x7f, 0x4f, 0xd3, 0xb9, 0x92, 0x5a, 0x90,
-	0xdb, 0x78, 0xdd, 0x94, 0x29, 0x5b, 0x68, 0x07, 0x5d, 0x3f, 0xdd, 0xbc, 0xc0, 0x2a, 0x64, 0x56,
-	0x22, 0x33, 0x87, 0xcc, 0xee, 0xab, 0x54, 0x76, 0xd6, 0x46, 0x5f, 0xb7, 0xbd, 0x6e, 0x95, 0x1d,
-	0x6c, 0xe2, 0x73, 0xb6, 0x7d, 0x57, 0xf4, 0x21, 0x95, 0x3d, 0x51, 0x4c, 0x75, 0x9f, 0xe2, 0xf3,
-	0xf3, 0x01, 0xa7, 0x74, 0x0f, 0x37, 0x8a, 0xe9, 0xe5, 0xaa, 0x6a, 0xbf, 0x2a, 0x82, 0x36, 0xbe,
-	0x64, 0x1b, 0x2f, 0xcc, 0xe2, 0x94, 0xc9, 0x16, 0x3e, 0x05, 0xbd, 0x5e, 0x21, 0xb4, 0xb6, 0xdd,
-	0x1b, 0xdd, 0xe9, 0x67, 0x90, 0x63, 0x5a, 0x57, 0xea, 0xd8, 0x1e, 0x61, 0xb2, 0x3f, 0x0b, 0xee,
-	0x45, 0x55, 0x74, 0x55, 0xc8, 0x8d, 0xfd, 0xf9, 0xbe, 0xcd, 0x0f, 0x6b, 0x78, 0xdd, 0x4a, 0x92,
-	0x4f, 0x08, 0x6f, 0xd6, 0xec, 0x80, 0xdc, 0x65, 0x4b, 0x4c, 0xc3, 0x56, 0xd8, 0xae, 0xdf, 0xfe,
-	0x8f, 0xca, 0x6a, 0xd4, 0xe0, 0xce, 0x9b, 0xcf, 0x3f, 0xde, 0x9f, 0xb8, 0x45, 0x18, 0x5f, 0x66,
-	0x69, 0xbb, 0xdd, 0xbd, 0xc5, 0xb7, 0xd0, 0xe4, 0x2d, 0xc2, 0x8d, 0xd9, 0x52, 0xc9, 0x8d, 0x7a,
-	0x80, 0x79, 0x4b, 0xf8, 0x37, 0x57, 0xca, 0x75, 0x78, 0xd7, 0x2c, 0xde, 0x0e, 0xa1, 0x4b, 0xf1,
-	0x66, 0x76, 0x20, 0x1f, 0x11, 0xde, 0x58, 0x98, 0x92, 0x34, 0xeb, 0xa5, 0xea, 0x7c, 0xe3, 0xb7,
-	0xfe, 0xa9, 0xc6, 0x61, 0xb6, 0x2d, 0x66, 0x8b, 0x84, 0x4b, 0x31, 0x17, 0xdf, 0x8f, 0xbf, 0x72,
-	0x66, 0x7c, 0xdd, 0x79, 0x38, 0xfa, 0x4e, 0xbd, 0xd1, 0x98, 0xa2, 0xe3, 0x31, 0x45, 0xdf, 0xc6,
-	0x14, 0xbd, 0x9b, 0x50, 0xef, 0x78, 0x42, 0xbd, 0x2f, 0x13, 0xea, 0x3d, 0xdb, 0x4d, 0x52, 0xf3,
-	0x62, 0x10, 0xb1, 0x58, 0xf5, 0x6d, 0xeb, 0xdd, 0x0c, 0x22, 0x5d, 0x89, 0xbc, 0xfc, 0x43, 0xc6,
-	0x1c, 0xe5, 0x42, 0x47, 0x27, 0xed, 0x4f, 0xa1, 0xf5, 0x33, 0x00, 0x00, 0xff, 0xff, 0xf1, 0x25,
-	0x13, 0x91, 0xa0, 0x04, 0x00, 0x00,
+	// 971 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x41, 0x6f, 0xdc, 0x44,
+	0x14, 0x5e, 0x6f, 0x9a, 0x4d, 0xf3, 0x2a, 0x11, 0x3a, 0x4d, 0x9b, 0x8d, 0x43, 0xbd, 0xc1, 0x29,
+	0x6d, 0x94, 0x28, 0x76, 0x36, 0x01, 0x44, 0x84, 0xb8, 0x2c, 0x50, 0x14, 0x10, 0x50, 0x2c, 0x24,
+	0x04, 0x08, 0xa2, 0x59, 0xef, 0xd4, 0xb5, 0xb2, 0x3b, 0xe3, 0x7a, 0x66, 0x57, 0x8d, 0x10, 0x08,
+	0x71, 0xe6, 0x80, 0xc4, 0x1f, 0xe0, 0x86, 0xc4, 0x0d, 0x09, 0x09, 0xf1, 0x07, 0x50, 0xc4, 0xa9,
+	0x82, 0x0b, 0xe2, 0x50, 0x20, 0xe1, 0x1f, 0xf0, 0x07, 0x90, 0x67, 0xc6, 0x1b, 0x3b, 0x6b, 0xa7,
+	0x0e, 0xb7, 0x9d, 0xf1, 0xf7, 0xde, 0xfb, 0xbe, 0xf7, 0xde, 0xbc, 0xb7, 0xd0, 0xda, 0xc7, 0x23,
+	0xec, 0x46, 0x31, 0xf1, 0x43, 0x4e, 0xba, 0x98, 0xee, 0xbb, 0xa3, 0xb6, 0x7b, 0x7f, 0x48, 0xe2,
+	0x03, 0x27, 0x8a, 0x99, 0x60, 0xe8, 0x4a, 0x02, 0x70, 0x32, 0x00, 0x67, 0xd4, 0x36, 0xd7, 0x7c,
+	0xc6, 0x07, 0x8c, 0xbb, 0x5d, 0xcc, 0x89, 0x42, 0xbb, 0xa3, 0x76, 0x97, 0x08, 0xdc, 0x76, 0x23,
+	0x1c, 0x84, 0x14, 0x8b, 0x90, 0x51, 0xe5, 0xc0, 0xb4, 0xb2, 0xd8, 0x14, 0xe5, 0xb3, 0x30, 0xfd,
+	0xbe, 0xa8, 0xbe, 0xef, 0xc9, 0x93, 0xab, 0x0e, 0xfa, 0xd3, 0x7c, 0xc0, 0x02, 0xa6, 0xee, 0x93,
+	0x5f, 0xfa, 0xf6, 0xa9, 0x80, 0xb1, 0xa0, 0x4f, 0x5c, 0x1c, 0x85, 0x2e, 0xa6, 0x94, 0x09, 0x19,
+	0x2d, 0xb5, 0x79, 0xba, 0x48, 0x50, 0x40, 0x28, 0xe1, 0x61, 0x0a, 0x59, 0x2e, 0x82, 0x44, 0x38,
+	0xc6, 0x03, 0x8d, 0xb0, 0x5f, 0x84, 0x95, 0x77, 0x12, 0x55, 0xef, 0x32, 0x81, 0xfb, 0xb7, 0x63,
+	0xec, 0x27, 0x11, 0x70, 0xbf, 0x83, 0xfb, 0x98, 0xfa, 0x84, 0x7b, 0xe4, 0xfe, 0x90, 0x70, 0x81,
+	0xe6, 0x61, 0xba, 0x47, 0x28, 0x1b, 0x34, 0x8d, 0x65, 0x63, 0x75, 0xd6, 0x53, 0x07, 0xfb, 0x23,
+	0xb8, 0x71, 0xb6, 0x31, 0x8f, 0x18, 0xe5, 0x04, 0x3d, 0x07, 0xd3, 0x22, 0x81, 0x48, 0xeb, 0x4b,
+	0x5b, 0x8b, 0x8e, 0xd6, 0x9e, 0x24, 0xca, 0xd1, 0x89, 0x72, 0x5e, 0x66, 0x21, 0xed, 0x5c, 0x38,
+	0x7c, 0xd4, 0xaa, 0x79, 0x0a, 0x6d, 0x6f, 0xc0, 0x55, 0xe9, 0xde, 0x23, 0x03, 0x1c, 0xd2, 0x1e,
+	0x89, 0xcf, 0x66, 0xf3, 0x1e, 0x5c, 0x3b, 0x0d, 0xd7, 0xf1, 0x5f, 0x82, 0xd9, 0x38, 0xbd, 0xac,
+	0xca, 0xe1, 0xc4, 0xc2, 0x7e, 0x1b, 0xae, 0x4b, 0xc7, 0x13, 0x0a, 0x53, 0x3e, 0x4d, 0x98, 0xc1,
+	0xbd, 0x5e, 0x4c, 0x38, 0xd7, 0x8c, 0xd2, 0xe3, 0x09, 0xd3, 0x7a, 0x96, 0x69, 0x04, 0x56, 0x99,
+	0x43, 0xcd, 0xf8, 0x2d, 0x40, 0x77, 0xc7, 0x1f, 0xf7, 0xba, 0xea, 0x6b, 0x55, 0xea, 0x97, 0xef,
+	0x9e, 0xf6, 0x6b, 0x7f, 0x56, 0x16, 0x71, 0x5c, 0xe1, 0xdb, 0x00, 0x27, 0x0d, 0xad, 0x23, 0xdd,
+	0xcc, 0x45, 0x52, 0x6f, 0x25, 0x8d, 0x77, 0x07, 0x07, 0xa9, 0x7e, 0x2f, 0x63, 0x59, 0xa2, 0xf8,
+	0x17, 0x03, 0x5a, 0xa5, 0x04, 0xb4, 0xe6, 0x8f, 0xe1, 0xa2, 0x16, 0x9a, 0xa4, 0x71, 0x4a, 0xc6,
+	0x2f, 0x78, 0x92, 0xce, 0x84, 0x8b, 0x8e, 0x99, 0xc8, 0xfe, 0xee, 0xcf, 0x16, 0x2a, 0xf0, 0x3e,
+	0xf6, 0x89, 0x5e, 0xcb, 0x29, 0xac, 0x4b, 0x85, 0xb7, 0x1e, 0xab, 0x50, 0x91, 0xcb, 0x4a, 0xb4,
+	0xb7, 0xc0, 0xd4, 0x8d, 0xe6, 0x33, 0xea, 0x87, 0xfd, 0x50, 0x5e, 0x9f, 0xdd, 0x9c, 0xdf, 0x4e,
+	0xc1, 0x52, 0xa1, 0x91, 0x16, 0xff, 0x21, 0x2c, 0xca, 0xa6, 0xdf, 0x9b, 0x2c, 0x3b, 0xaf, 0x5a,
+	0xf7, 0x05, 0x51, 0xfc, 0x0e, 0xf3, 0xfd, 0x5f, 0x3f, 0x6f, 0xff, 0xa3, 0x1d, 0x98, 0x89, 0x09,
+	0x27, 0xf1, 0x88, 0x34, 0xa7, 0xaa, 0x19, 0xa7, 0x78, 0xf4, 0x26, 0x5c, 0xea, 0x85, 0xdc, 0x8f,
+	0x49, 0x84, 0xa9, 0x7f, 0xd0, 0xbc, 0x90, 0xa4, 0xa4, 0xb3, 0x9e, 0x60, 0xfe, 0x78, 0xd4, 0xba,
+	0xaa, 0xbc, 0xf0, 0xde, 0xbe, 0x13, 0x32, 0x77, 0x80, 0xc5, 0x3d, 0x67, 0x97, 0x8a, 0x5f, 0x7f,
+	0xd8, 0x00, 0xed, 0x7e, 0x97, 0x0a, 0x2f, 0x6b, 0x8f, 0x5e, 0x07, 0x08, 0xe9, 0x08, 0xc7, 0x21,
+	0xa6, 0x82, 0x37, 0xa7, 0x65, 0x93, 0xdc, 0x28, 0x6c, 0x92, 0xdd, 0x14, 0xe6, 0x11, 0x3e, 0xec,
+	0x0b, 0xcd, 0x2b, 0x63, 0x8d, 0xae, 0x41, 0xa3, 0x1b, 0xb3, 0x7d, 0x42, 0x9b, 0x8d, 0x65, 0x63,
+	0xf5, 0xa2, 0xa7, 0x4f, 0xf6, 0x3c, 0x20, 0x59, 0xa8, 0x3b, 0x72, 0x4c, 0xea, 0xaa, 0xda, 0x3f,
+	0x19, 0x70, 0x25, 0x77, 0xad, 0xeb, 0xb6, 0x03, 0x0d, 0x35, 0x4f, 0x75, 0x91, 0x96, 0x0a, 0xd9,
+	0x28, 0x23, 0x4d, 0x42, 0x1b, 0xa0, 0x00, 0x9e, 0x94, 0xbd, 0xb1, 0x47, 0x1e, 0x08, 0x42, 0x79,
+	0x32, 0xd9, 0x9b, 0x75, 0x29, 0x69, 0xa5, 0xd0, 0xc9, 0x2b, 0x09, 0xf8, 0xd5, 0x14, 0xdb, 0x59,
+	0xd0, 0x4d, 0x3f, 0x97, 0xbf, 0xe7, 0xde, 0x5c, 0x2f, 0x7f, 0x61, 0xbf, 0x0f, 0x73, 0xa7, 0xd2,
+	0x91, 0x34, 0x69, 0xcc, 0x86, 0x82, 0xa4, 0x4d, 0x2a, 0x0f, 0x99, 0x94, 0xd4, 0xb3, 0x29, 0x49,
+	0xe6, 0xdb, 0x80, 0x70, 0x8e, 0x03, 0xd5, 0x00, 0xb3, 0x5e, 0x7a, 0xdc, 0xfa, 0x77, 0x06, 0xa6,
+	0x65, 0x5a, 0xd0, 0xcf, 0x06, 0x2c, 0x94, 0xec, 0x01, 0xf4, 0x42, 0xa1, 0x9e, 0x0a, 0x7b, 0xc7,
+	0xdc, 0xf9, 0x1f, 0x96, 0xaa, 0x32, 0xf6, 0xf3, 0x5f, 0xfc, 0xf6, 0xcf, 0xd7, 0xf5, 0x4d, 0xe4,
+	0xb8, 0x45, 0x4b, 0xb0, 0xf4, 0xb1, 0xa1, 0x2f, 0x0d, 0x98, 0x1d, 0xaf, 0x10, 0xb4, 0x56, 0x4e,
+	0xe0, 0xf4, 0x5a, 0x32, 0xd7, 0x2b, 0x61, 0x35, 0xbd, 0x9b, 0x92, 0xde, 0x32, 0xb2, 0x0a, 0xe9,
+	0x9d, 0x3c, 0xbe, 0x1f, 0x0d, 0xb8, 0x3c, 0xa1, 0x12, 0x6d, 0x95, 0x87, 0x2a, 0xdb, 0x52, 0xe6,
+	0xf6, 0xb9, 0x6c, 0x34, 0xcd, 0x1d, 0x49, 0x73, 0x1b, 0xb5, 0x0b, 0x69, 0x4e, 0xe6, 0xcf, 0xfd,
+	0x44, 0xaf, 0xbe, 0x4f, 0xd1, 0xf7, 0x06, 0x14, 0x0c, 0x64, 0x74, 0x1e, 0x1a, 0xe3, 0x3e, 0x78,
+	0xf6, 0x7c, 0x46, 0x9a, 0xfc, 0xa6, 0x24, 0xbf, 0x86, 0x56, 0x2b, 0x92, 0xe7, 0xe8, 0x1b, 0x03,
+	0x9e, 0xc8, 0x4f, 0x68, 0xe4, 0x9e, 0x55, 0xd5, 0x82, 0x05, 0x60, 0x6e, 0x56, 0x37, 0xd0, 0x3c,
+	0xd7, 0x25, 0xcf, 0x67, 0xd0, 0x4a, 0x49, 0x2f, 0xe4, 0xf8, 0x7c, 0x6e, 0x40, 0x43, 0xcd, 0x13,
+	0x74, 0xab, 0x3c, 0x52, 0x6e, 0x7a, 0x99, 0xab, 0x8f, 0x07, 0x6a, 0x2a, 0x2b, 0x92, 0xca, 0x75,
+	0xb4, 0xe4, 0x96, 0xff, 0x75, 0xec, 0xbc, 0x71, 0xf8, 0xb7, 0x55, 0x3b, 0x3c, 0xb2, 0x8c, 0x87,
+	0x47, 0x96, 0xf1, 0xd7, 0x91, 0x65, 0x7c, 0x75, 0x6c, 0xd5, 0x1e, 0x1e, 0x5b, 0xb5, 0xdf, 0x8f,
+	0xad, 0xda, 0x07, 0x1b, 0x41, 0x28, 0xee, 0x0d, 0xbb, 0x8e, 0xcf, 0x06, 0xd2, 0xc9, 0x46, 0x1f,
+	0x77, 0xb9, 0x72, 0xf7, 0x20, 0xe7, 0x50, 0x1c, 0x44, 0x84, 0x77, 0x1b, 0xf2, 0x8f, 0xe8, 0xf6,
+	0x7f, 0x03, 0x00, 0x96, 0xe9, 0x22, 0x05, 0xa0, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -329,6 +670,14 @@ type QueryClient interface {
 	// FractionalBalance returns only the fractional balance of an address. This
 	// does not include any integer balance.
 	FractionalBalance(ctx context.Context, in *QueryFractionalBalanceRequest, opts ...grpc.CallOption) (*QueryFractionalBalanceResponse, error)
+	// FractionalBalances returns the fractional balances of all accounts.
+	FractionalBalances(ctx context.Context, in *QueryFractionalBalancesRequest, opts ...grpc.CallOption) (*QueryFractionalBalancesResponse, error)
+	// Reconciliation runs the precisebank module invariants and returns their
+	// results, along with the reserve backing and its discrepancy with the
+	// fractional balances and remainder.
+	Reconciliation(ctx context.Context, in *QueryReconciliationRequest, opts ...grpc.CallOption) (*QueryReconciliationResponse, error)
+	// Params returns the parameters of the precisebank module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
 
 type queryClient struct {
@@ -366,6 +715,33 @@ func (c *queryClient) FractionalBalance(ctx context.Context, in *QueryFractional
 	return out, nil
 }
 
+func (c *queryClient) FractionalBalances(ctx context.Context, in *QueryFractionalBalancesRequest, opts ...grpc.CallOption) (*QueryFractionalBalancesResponse, error) {
+	out := new(QueryFractionalBalancesResponse)
+	err := c.cc.Invoke(ctx, "/kava.precisebank.v1.Query/FractionalBalances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Reconciliation(ctx context.Context, in *QueryReconciliationRequest, opts ...grpc.CallOption) (*QueryReconciliationResponse, error) {
+	out := new(QueryReconciliationResponse)
+	err := c.cc.Invoke(ctx, "/kava.precisebank.v1.Query/Reconciliation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/kava.precisebank.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// TotalFractionalBalances returns the total sum of all fractional balances
@@ -377,6 +753,14 @@ type QueryServer interface {
 	// FractionalBalance returns only the fractional balance of an address. This
 	// does not include any integer balance.
 	FractionalBalance(context.Context, *QueryFractionalBalanceRequest) (*QueryFractionalBalanceResponse, error)
+	// FractionalBalances returns the fractional balances of all accounts.
+	FractionalBalances(context.Context, *QueryFractionalBalancesRequest) (*QueryFractionalBalancesResponse, error)
+	// Reconciliation runs the precisebank module invariants and returns their
+	// results, along with the reserve backing and its discrepancy with the
+	// fractional balances and remainder.
+	Reconciliation(context.Context, *QueryReconciliationRequest) (*QueryReconciliationResponse, error)
+	// Params returns the parameters of the precisebank module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -392,6 +776,15 @@ func (*UnimplementedQueryServer) Remainder(ctx context.Context, req *QueryRemain
 func (*UnimplementedQueryServer) FractionalBalance(ctx context.Context, req *QueryFractionalBalanceRequest) (*QueryFractionalBalanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FractionalBalance not implemented")
 }
+func (*UnimplementedQueryServer) FractionalBalances(ctx context.Context, req *QueryFractionalBalancesRequest) (*QueryFractionalBalancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FractionalBalances not implemented")
+}
+func (*UnimplementedQueryServer) Reconciliation(ctx context.Context, req *QueryReconciliationRequest) (*QueryReconciliationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reconciliation not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -451,6 +844,60 @@ func _Query_FractionalBalance_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FractionalBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFractionalBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FractionalBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.precisebank.v1.Query/FractionalBalances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FractionalBalances(ctx, req.(*QueryFractionalBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Reconciliation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReconciliationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Reconciliation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.precisebank.v1.Query/Reconciliation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Reconciliation(ctx, req.(*QueryReconciliationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.precisebank.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kava.precisebank.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -467,6 +914,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FractionalBalance",
 			Handler:    _Query_FractionalBalance_Handler,
 		},
+		{
+			MethodName: "FractionalBalances",
+			Handler:    _Query_FractionalBalances_Handler,
+		},
+		{
+			MethodName: "Reconciliation",
+			Handler:    _Query_Reconciliation_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kava/precisebank/v1/query.proto",
@@ -492,6 +951,13 @@ func (m *QueryTotalFractionalBalancesRequest) MarshalToSizedBuffer(dAtA []byte)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -548,6 +1014,13 @@ func (m *QueryRemainderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -604,6 +1077,13 @@ func (m *QueryFractionalBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Address) > 0 {
 		i -= len(m.Address)
 		copy(dAtA[i:], m.Address)
@@ -647,43 +1127,376 @@ func (m *QueryFractionalBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryFractionalBalancesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryTotalFractionalBalancesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryFractionalBalancesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTotalFractionalBalancesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryFractionalBalancesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Total.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryRemainderRequest) Size() (n int) {
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFractionalBalancesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFractionalBalancesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFractionalBalancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReconciliationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReconciliationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReconciliationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReconciliationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReconciliationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReconciliationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Broken {
+		i--
+		if m.Broken {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Invariants) > 0 {
+		for iNdEx := len(m.Invariants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Invariants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size := m.Discrepancy.Size()
+		i -= size
+		if _, err := m.Discrepancy.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Reserve.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Remainder.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.TotalFractionalBalances.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DenomExtensions) > 0 {
+		for iNdEx := len(m.DenomExtensions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomExtensions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *InvariantResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InvariantResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InvariantResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Broken {
+		i--
+		if m.Broken {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Route) > 0 {
+		i -= len(m.Route)
+		copy(dAtA[i:], m.Route)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Route)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryTotalFractionalBalancesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTotalFractionalBalancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Total.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRemainderRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -708,6 +1521,10 @@ func (m *QueryFractionalBalanceRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -722,13 +1539,981 @@ func (m *QueryFractionalBalanceResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *QueryTotalFractionalBalancesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFractionalBalancesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFractionalBalancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReconciliationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReconciliationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalFractionalBalances.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Remainder.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Reserve.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Discrepancy.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Invariants) > 0 {
+		for _, e := range m.Invariants {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Broken {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.DenomExtensions) > 0 {
+		for _, e := range m.DenomExtensions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *InvariantResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Route)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Broken {
+		n += 2
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryTotalFractionalBalancesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalFractionalBalancesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalFractionalBalancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalFractionalBalancesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalFractionalBalancesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalFractionalBalancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRemainderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRemainderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRemainderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRemainderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRemainderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRemainderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remainder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Remainder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFractionalBalanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFractionalBalanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFractionalBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFractionalBalanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFractionalBalanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFractionalBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FractionalBalance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FractionalBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFractionalBalancesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFractionalBalancesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFractionalBalancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFractionalBalancesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFractionalBalancesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFractionalBalancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, FractionalBalance{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReconciliationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReconciliationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReconciliationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReconciliationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -738,78 +2523,161 @@ func (m *QueryTotalFractionalBalancesRequest) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReconciliationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReconciliationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFractionalBalances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalFractionalBalances.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remainder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalFractionalBalancesRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalFractionalBalancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Remainder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserve", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryTotalFractionalBalancesResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if err := m.Reserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Discrepancy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Discrepancy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalFractionalBalancesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalFractionalBalancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Invariants", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -836,10 +2704,31 @@ func (m *QueryTotalFractionalBalancesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Invariants = append(m.Invariants, InvariantResult{})
+			if err := m.Invariants[len(m.Invariants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Broken", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Broken = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -861,7 +2750,7 @@ func (m *QueryTotalFractionalBalancesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRemainderRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -884,10 +2773,10 @@ func (m *QueryRemainderRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRemainderRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRemainderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -911,7 +2800,7 @@ func (m *QueryRemainderRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRemainderResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -934,15 +2823,15 @@ func (m *QueryRemainderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRemainderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRemainderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Remainder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -969,7 +2858,41 @@ func (m *QueryRemainderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Remainder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomExtensions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomExtensions = append(m.DenomExtensions, DenomExtension{})
+			if err := m.DenomExtensions[len(m.DenomExtensions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -994,7 +2917,7 @@ func (m *QueryRemainderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFractionalBalanceRequest) Unmarshal(dAtA []byte) error {
+func (m *InvariantResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1017,15 +2940,15 @@ func (m *QueryFractionalBalanceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFractionalBalanceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: InvariantResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFractionalBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InvariantResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1053,63 +2976,33 @@ func (m *QueryFractionalBalanceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Route = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryFractionalBalanceResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Broken", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFractionalBalanceResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFractionalBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Broken = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FractionalBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1119,24 +3012,23 @@ func (m *QueryFractionalBalanceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FractionalBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
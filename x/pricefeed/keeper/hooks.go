@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// Implements PricefeedHooks interface
+var _ types.PricefeedHooks = Keeper{}
+
+// AfterPricePosted - call hook if registered
+func (k Keeper) AfterPricePosted(ctx sdk.Context, postedPrice types.PostedPrice) {
+	if k.hooks != nil {
+		k.hooks.AfterPricePosted(ctx, postedPrice)
+	}
+}
+
+// AfterCurrentPriceUpdated - call hook if registered
+func (k Keeper) AfterCurrentPriceUpdated(ctx sdk.Context, marketID string, currentPrice types.CurrentPrice) {
+	if k.hooks != nil {
+		k.hooks.AfterCurrentPriceUpdated(ctx, marketID, currentPrice)
+	}
+}
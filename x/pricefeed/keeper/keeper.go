@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"fmt"
 	"sort"
 	"time"
@@ -24,6 +25,7 @@ type Keeper struct {
 	cdc codec.Codec
 	// The reference to the Paramstore to get and set pricefeed specific params
 	paramSubspace paramtypes.Subspace
+	hooks         types.PricefeedHooks
 }
 
 // NewKeeper returns a new keeper for the pricefeed module.
@@ -38,9 +40,19 @@ func NewKeeper(
 		cdc:           cdc,
 		key:           key,
 		paramSubspace: paramstore,
+		hooks:         nil,
 	}
 }
 
+// SetHooks adds hooks to the keeper.
+func (k *Keeper) SetHooks(hooks types.PricefeedHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set pricefeed hooks twice")
+	}
+	k.hooks = hooks
+	return k
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
@@ -76,6 +88,7 @@ func (k Keeper) SetPrice(
 
 	// Sets the raw price for a single oracle instead of an array of all oracle's raw prices
 	store.Set(types.RawPriceKey(marketID, oracle), k.cdc.MustMarshal(&newRawPrice))
+	k.AfterPricePosted(ctx, newRawPrice)
 	return newRawPrice, nil
 }
 
@@ -199,9 +212,15 @@ func (k Keeper) SetCurrentPricesForAllMarkets(ctx sdk.Context) {
 	}
 }
 
+// setCurrentPrice stores the current price of a market, calling the hooks only when the price changes.
 func (k Keeper) setCurrentPrice(ctx sdk.Context, marketID string, currentPrice types.CurrentPrice) {
 	store := ctx.KVStore(k.key)
-	store.Set(types.CurrentPriceKey(marketID), k.cdc.MustMarshal(&currentPrice))
+	bz := k.cdc.MustMarshal(&currentPrice)
+	if bytes.Equal(store.Get(types.CurrentPriceKey(marketID)), bz) {
+		return
+	}
+	store.Set(types.CurrentPriceKey(marketID), bz)
+	k.AfterCurrentPriceUpdated(ctx, marketID, currentPrice)
 }
 
 // CalculateMedianPrice calculates the median prices for the input prices.
@@ -0,0 +1,31 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// PricefeedHooks event hooks for other keepers to run code in response to price updates
+type PricefeedHooks interface {
+	AfterPricePosted(ctx sdk.Context, postedPrice PostedPrice)
+	AfterCurrentPriceUpdated(ctx sdk.Context, marketID string, currentPrice CurrentPrice)
+}
+
+// MultiPricefeedHooks combine multiple pricefeed hooks, all hook functions are run in array sequence
+type MultiPricefeedHooks []PricefeedHooks
+
+// NewMultiPricefeedHooks returns a new MultiPricefeedHooks
+func NewMultiPricefeedHooks(hooks ...PricefeedHooks) MultiPricefeedHooks {
+	return hooks
+}
+
+// AfterPricePosted runs after an oracle posts a price
+func (h MultiPricefeedHooks) AfterPricePosted(ctx sdk.Context, postedPrice PostedPrice) {
+	for i := range h {
+		h[i].AfterPricePosted(ctx, postedPrice)
+	}
+}
+
+// AfterCurrentPriceUpdated runs after the current price of a market is updated
+func (h MultiPricefeedHooks) AfterCurrentPriceUpdated(ctx sdk.Context, marketID string, currentPrice CurrentPrice) {
+	for i := range h {
+		h[i].AfterCurrentPriceUpdated(ctx, marketID, currentPrice)
+	}
+}
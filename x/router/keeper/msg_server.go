@@ -175,12 +175,7 @@ func (m msgServer) WithdrawBurnUndelegate(goCtx context.Context, msg *types.MsgW
 		return nil, err
 	}
 
-	sharesReturned, err := m.keeper.liquidKeeper.BurnDerivative(ctx, depositor, val, withdrawnAmount)
-	if err != nil {
-		return nil, err
-	}
-
-	completionTime, err := m.keeper.stakingKeeper.Undelegate(ctx, depositor, val, sharesReturned)
+	unbonding, err := m.keeper.liquidKeeper.UndelegateDerivative(ctx, depositor, val, withdrawnAmount)
 	if err != nil {
 		return nil, err
 	}
@@ -190,7 +185,7 @@ func (m msgServer) WithdrawBurnUndelegate(goCtx context.Context, msg *types.MsgW
 			stakingtypes.EventTypeUnbond,
 			sdk.NewAttribute(stakingtypes.AttributeKeyValidator, val.String()),
 			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
-			sdk.NewAttribute(stakingtypes.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
+			sdk.NewAttribute(stakingtypes.AttributeKeyCompletionTime, unbonding.CompletionTime.Format(time.RFC3339)),
 		),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
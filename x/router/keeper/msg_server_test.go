@@ -135,6 +135,11 @@ func (suite *msgServerTestSuite) TestWithdrawBurnUndelegate_Events() {
 			sdk.NewAttribute(stakingtypes.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
 		),
 	)
+
+	records := suite.App.GetLiquidKeeper().GetAllUnbondingRecords(suite.Ctx)
+	suite.Require().Len(records, 1)
+	suite.Equal(user.String(), records[0].Delegator)
+	suite.Equal(completionTime, records[0].CompletionTime)
 }
 
 func (suite *msgServerTestSuite) TestMintDepositAndWithdrawBurn_TransferEntireBalance() {
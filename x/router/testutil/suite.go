@@ -323,7 +323,7 @@ func (suite *Suite) CreateVault(
 // SetSavingsSupportedDenoms overwrites the list of supported denoms in the savings module params.
 func (suite *Suite) SetSavingsSupportedDenoms(denoms []string) {
 	sk := suite.App.GetSavingsKeeper()
-	sk.SetParams(suite.Ctx, savingstypes.NewParams(denoms))
+	sk.SetParams(suite.Ctx, savingstypes.NewParams(denoms, nil, nil))
 }
 
 // VaultAccountValueEqual asserts that the vault account value matches the provided coin amount.
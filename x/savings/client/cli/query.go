@@ -34,6 +34,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryParams(),
 		queryDepositsCmd(),
 		GetCmdTotalSupply(),
+		GetCmdQueryPools(),
+		queryLockupsCmd(),
 	}
 
 	for _, cmd := range cmds {
@@ -156,3 +158,89 @@ func GetCmdTotalSupply() *cobra.Command {
 		},
 	}
 }
+
+// GetCmdQueryPools returns the command that queries the share pools of the savings module
+func GetCmdQueryPools() *cobra.Command {
+	return &cobra.Command{
+		Use:   "pools [denom]",
+		Short: "get savings pools",
+		Long:  "Get the total shares, total value, and value allocated to yield strategies of each denom, or of a single denom.",
+		Example: fmt.Sprintf(`%[1]s q %[2]s pools
+%[1]s q %[2]s pools busd`, version.AppName, types.ModuleName),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryPoolsRequest{}
+			if len(args) > 0 {
+				req.Denom = args[0]
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Pools(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+func queryLockupsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lockups",
+		Short: "query savings module lockups with optional filters",
+		Long:  "query for all savings module lockups or the lockups of an owner using flags. Lockup amounts are shares.",
+		Example: fmt.Sprintf(`%[1]s q %[2]s lockups
+%[1]s q %[2]s lockups --owner kava1l0xsq2z7gqd7yly0g40y5836g0appumark77ny`, version.AppName, types.ModuleName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			ownerBech, err := cmd.Flags().GetString(flagOwner)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryLockupsRequest{
+				Pagination: pageReq,
+			}
+
+			if len(ownerBech) != 0 {
+				owner, err := sdk.AccAddressFromBech32(ownerBech)
+				if err != nil {
+					return err
+				}
+				req.Owner = owner.String()
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Lockups(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "lockups")
+
+	cmd.Flags().String(flagOwner, "", "(optional) filter for lockups by owner address")
+
+	return cmd
+}
@@ -2,6 +2,8 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -27,6 +29,8 @@ func GetTxCmd() *cobra.Command {
 	cmds := []*cobra.Command{
 		getCmdDeposit(),
 		getCmdWithdraw(),
+		getCmdLockDeposit(),
+		getCmdWithdrawLockup(),
 	}
 
 	for _, cmd := range cmds {
@@ -89,3 +93,61 @@ func getCmdWithdraw() *cobra.Command {
 		},
 	}
 }
+
+func getCmdLockDeposit() *cobra.Command {
+	return &cobra.Command{
+		Use:   "lock-deposit [amount] [duration]",
+		Short: "deposit coins to savings and lock them for the duration of a lockup tier",
+		Example: fmt.Sprintf(
+			`%s tx %s lock-deposit 10000000ukava 720h --from <key>`, version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return err
+			}
+			duration, err := time.ParseDuration(args[1])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgLockDeposit(clientCtx.GetFromAddress(), amount, duration)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+func getCmdWithdrawLockup() *cobra.Command {
+	return &cobra.Command{
+		Use:   "withdraw-lockup [lockup-id]",
+		Short: "withdraw locked coins from savings before the end of the lockup, paying the early withdrawal penalty",
+		Example: fmt.Sprintf(
+			`%s tx %s withdraw-lockup 1 --from <key>`, version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgWithdrawLockup(clientCtx.GetFromAddress(), id)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
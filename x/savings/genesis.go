@@ -21,6 +21,11 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, ak types.AccountKeeper, gs ty
 		k.SetDeposit(ctx, deposit)
 	}
 
+	for _, lockup := range gs.Lockups {
+		k.SetLockup(ctx, lockup)
+	}
+	k.SetNextLockupID(ctx, gs.NextLockupID)
+
 	// check if the module account exists
 	SavingsModuleAccount := ak.GetModuleAccount(ctx, types.ModuleAccountName)
 	if SavingsModuleAccount == nil {
@@ -32,5 +37,6 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, ak types.AccountKeeper, gs ty
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 	params := k.GetParams(ctx)
 	deposits := k.GetAllDeposits(ctx)
-	return types.NewGenesisState(params, deposits)
+	lockups := k.GetAllLockups(ctx)
+	return types.NewGenesisState(params, deposits, lockups, k.GetNextLockupID(ctx))
 }
@@ -39,8 +39,11 @@ func (suite *GenesisTestSuite) SetupTest() {
 }
 
 func (suite *GenesisTestSuite) TestInitExportGenesis() {
+	tier := types.NewLockupTier(time.Hour, sdk.MustNewDecFromStr("1.5"), sdk.MustNewDecFromStr("0.1"))
 	params := types.NewParams(
 		[]string{"btc", "ukava", "bnb"},
+		nil,
+		types.LockupTiers{tier},
 	)
 
 	depositAmt := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(1e8)))
@@ -51,7 +54,10 @@ func (suite *GenesisTestSuite) TestInitExportGenesis() {
 			depositAmt, // 100 ukava
 		),
 	}
-	savingsGenesis := types.NewGenesisState(params, deposits)
+	lockups := types.Lockups{
+		types.NewLockup(3, suite.addrs[0], sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(5e7))), tier, suite.genTime),
+	}
+	savingsGenesis := types.NewGenesisState(params, deposits, lockups, 4)
 
 	authBuilder := app.NewAuthBankGenesisBuilder().
 		WithSimpleModuleAccount(types.ModuleAccountName, depositAmt)
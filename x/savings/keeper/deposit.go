@@ -8,25 +8,42 @@ import (
 	"github.com/kava-labs/kava/x/savings/types"
 )
 
-// Deposit deposit
+// Deposit deposits coins into the savings module account in exchange for shares of each denom's deposits. Coins are
+// then rebalanced into the yield strategies set by the params.
 func (k Keeper) Deposit(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coins) error {
+	_, err := k.deposit(ctx, depositor, coins)
+	return err
+}
+
+// deposit deposits coins into the savings module account and returns the shares issued to the depositor
+func (k Keeper) deposit(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coins) (sdk.Coins, error) {
 	err := k.ValidateDeposit(ctx, coins)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	// shares are valued before the deposit is added to the module's assets
+	shares := sdk.NewCoins()
+	for _, coin := range coins {
+		issued, err := k.calculateIssuedShares(ctx, coin)
+		if err != nil {
+			return nil, err
+		}
+		shares = shares.Add(sdk.NewCoin(coin.Denom, issued))
 	}
 
 	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, depositor, types.ModuleAccountName, coins)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	currDeposit, foundDeposit := k.GetDeposit(ctx, depositor)
 
-	deposit := types.NewDeposit(depositor, coins)
+	deposit := types.NewDeposit(depositor, shares)
 	if foundDeposit {
+		// rewards are synced with the shares held before the deposit
+		k.BeforeSavingsDepositModified(ctx, currDeposit, setDifference(getDenoms(coins), getDenoms(currDeposit.Amount)))
 		deposit.Amount = deposit.Amount.Add(currDeposit.Amount...)
-		k.BeforeSavingsDepositModified(ctx, deposit, setDifference(getDenoms(coins), getDenoms(deposit.Amount)))
-
 	}
 
 	k.SetDeposit(ctx, deposit)
@@ -35,15 +52,18 @@ func (k Keeper) Deposit(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coi
 		k.AfterSavingsDepositCreated(ctx, deposit)
 	}
 
+	k.rebalance(ctx, getDenoms(coins))
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeSavingsDeposit,
 			sdk.NewAttribute(sdk.AttributeKeyAmount, coins.String()),
+			sdk.NewAttribute(types.AttributeKeyShares, shares.String()),
 			sdk.NewAttribute(types.AttributeKeyDepositor, deposit.Depositor.String()),
 		),
 	)
 
-	return nil
+	return shares, nil
 }
 
 // ValidateDeposit validates a deposit
@@ -8,7 +8,9 @@ import (
 	"google.golang.org/grpc/status"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -100,6 +102,11 @@ func (s queryServer) Deposits(ctx context.Context, req *types.QueryDepositsReque
 		deposits = deposits[start:end]
 	}
 
+	// deposits are stored as shares, so return the current value of the shares
+	for i, deposit := range deposits {
+		deposits[i] = s.keeper.syncDeposit(sdkCtx, deposit)
+	}
+
 	return &types.QueryDepositsResponse{
 		Deposits:   deposits,
 		Pagination: nil,
@@ -112,7 +119,7 @@ func (s queryServer) TotalSupply(ctx context.Context, req *types.QueryTotalSuppl
 	liquidStakedDerivatives := sdk.NewCoins()
 
 	s.keeper.IterateDeposits(sdkCtx, func(deposit types.Deposit) (stop bool) {
-		for _, c := range deposit.Amount {
+		for _, c := range s.keeper.syncDeposit(sdkCtx, deposit).Amount {
 			// separate out bkava denoms
 			if strings.HasPrefix(c.Denom, bkavaPrefix) {
 				liquidStakedDerivatives = liquidStakedDerivatives.Add(c)
@@ -140,3 +147,89 @@ func (s queryServer) TotalSupply(ctx context.Context, req *types.QueryTotalSuppl
 		Result: totalSupply,
 	}, nil
 }
+
+// Pools returns the total shares and value of the deposits of each denom.
+func (s queryServer) Pools(ctx context.Context, req *types.QueryPoolsRequest) (*types.QueryPoolsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	pools := []types.PoolResponse{}
+	s.keeper.IterateTotalShares(sdkCtx, func(denom string, shares sdkmath.Int) (stop bool) {
+		if req.Denom != "" && req.Denom != denom {
+			return false
+		}
+
+		strategyValue := sdkmath.ZeroInt()
+		for _, strategy := range s.keeper.getStrategies() {
+			strategyValue = strategyValue.Add(strategy.GetEstimatedTotalAssets(sdkCtx, denom).Amount)
+		}
+
+		pools = append(pools, types.PoolResponse{
+			Denom:         denom,
+			TotalShares:   shares,
+			TotalValue:    s.keeper.GetTotalValue(sdkCtx, denom),
+			StrategyValue: strategyValue,
+		})
+		return false
+	})
+
+	return &types.QueryPoolsResponse{
+		Pools: pools,
+	}, nil
+}
+
+// Lockups returns savings lockups, optionally filtered by depositor. Lockup amounts are the locked shares.
+func (s queryServer) Lockups(ctx context.Context, req *types.QueryLockupsRequest) (*types.QueryLockupsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	var lockups types.Lockups
+	var pageRes *query.PageResponse
+	var err error
+	if len(req.Owner) > 0 {
+		owner, err := sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+		}
+
+		depositorStore := prefix.NewStore(
+			sdkCtx.KVStore(s.keeper.key),
+			append(types.LockupsByDepositorPrefix, types.LockupsByDepositorKey(owner)...),
+		)
+		pageRes, err = query.Paginate(depositorStore, req.Pagination, func(_ []byte, value []byte) error {
+			lockup, found := s.keeper.GetLockup(sdkCtx, sdk.BigEndianToUint64(value))
+			if !found {
+				return types.ErrLockupNotFound
+			}
+			lockups = append(lockups, lockup)
+			return nil
+		})
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	} else {
+		lockupStore := prefix.NewStore(sdkCtx.KVStore(s.keeper.key), types.LockupsKeyPrefix)
+		pageRes, err = query.Paginate(lockupStore, req.Pagination, func(_ []byte, value []byte) error {
+			var lockup types.Lockup
+			if err := s.keeper.cdc.Unmarshal(value, &lockup); err != nil {
+				return err
+			}
+			lockups = append(lockups, lockup)
+			return nil
+		})
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &types.QueryLockupsResponse{
+		Lockups:    lockups,
+		Pagination: pageRes,
+	}, nil
+}
@@ -10,6 +10,7 @@ import (
 	tmprototypes "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
@@ -48,18 +49,8 @@ func (suite *grpcQueryTestSuite) SetupTest() {
 	suite.keeper = suite.tApp.GetSavingsKeeper()
 	suite.queryServer = keeper.NewQueryServerImpl(suite.keeper)
 
-	err := suite.tApp.FundModuleAccount(
-		suite.ctx,
-		types.ModuleAccountName,
-		cs(
-			c("usdx", 10000000000),
-			c("busd", 10000000000),
-		),
-	)
-	suite.Require().NoError(err)
-
 	savingsGenesis := types.GenesisState{
-		Params: types.NewParams([]string{"bnb", "busd", bkava1, bkava2}),
+		Params: types.NewParams([]string{"bnb", "busd", bkava1, bkava2}, nil, nil),
 	}
 	savingsGenState := app.GenesisState{types.ModuleName: suite.tApp.AppCodec().MustMarshalJSON(&savingsGenesis)}
 
@@ -80,14 +71,10 @@ func (suite *grpcQueryTestSuite) TestGrpcQueryParams() {
 	res, err := suite.queryServer.Params(sdk.WrapSDKContext(suite.ctx), &types.QueryParamsRequest{})
 	suite.Require().NoError(err)
 
-	var expected types.GenesisState
-	savingsGenesis := types.GenesisState{
-		Params: types.NewParams([]string{"bnb", "busd", bkava1, bkava2}),
-	}
-	savingsGenState := app.GenesisState{types.ModuleName: suite.tApp.AppCodec().MustMarshalJSON(&savingsGenesis)}
-	suite.tApp.AppCodec().MustUnmarshalJSON(savingsGenState[types.ModuleName], &expected)
+	// the param store does not distinguish empty and nil strategies
+	expected := types.NewParams([]string{"bnb", "busd", bkava1, bkava2}, nil, nil)
 
-	suite.Equal(expected.Params, res.Params, "params should equal test genesis state")
+	suite.Equal(expected, res.Params, "params should equal test genesis state")
 }
 
 func (suite *grpcQueryTestSuite) TestGrpcQueryDeposits() {
@@ -265,6 +252,46 @@ func (suite *grpcQueryTestSuite) TestGrpcQueryTotalSupply() {
 	})
 }
 
+func (suite *grpcQueryTestSuite) TestGrpcQueryLockups() {
+	tier := types.NewLockupTier(time.Hour, sdk.MustNewDecFromStr("1.5"), sdk.MustNewDecFromStr("0.1"))
+	suite.keeper.SetParams(suite.ctx, types.NewParams([]string{"bnb", "busd"}, nil, types.LockupTiers{tier}))
+
+	var lockups types.Lockups
+	for _, lock := range []struct {
+		depositor sdk.AccAddress
+		amount    sdk.Coins
+	}{
+		{suite.addrs[0], cs(c("bnb", 100000000))},
+		{suite.addrs[1], cs(c("busd", 200000000))},
+		{suite.addrs[0], cs(c("busd", 300000000))},
+	} {
+		lockup, err := suite.keeper.LockDeposit(suite.ctx, lock.depositor, lock.amount, tier.Duration)
+		suite.Require().NoError(err)
+		lockups = append(lockups, lockup)
+	}
+
+	res, err := suite.queryServer.Lockups(sdk.WrapSDKContext(suite.ctx), &types.QueryLockupsRequest{})
+	suite.Require().NoError(err)
+	suite.Equal(lockups, res.Lockups)
+
+	res, err = suite.queryServer.Lockups(sdk.WrapSDKContext(suite.ctx), &types.QueryLockupsRequest{
+		Owner: suite.addrs[0].String(),
+	})
+	suite.Require().NoError(err)
+	suite.Equal(types.Lockups{lockups[0], lockups[2]}, res.Lockups)
+
+	res, err = suite.queryServer.Lockups(sdk.WrapSDKContext(suite.ctx), &types.QueryLockupsRequest{
+		Owner:      suite.addrs[0].String(),
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	suite.Require().NoError(err)
+	suite.Equal(types.Lockups{lockups[0]}, res.Lockups)
+	suite.NotNil(res.Pagination.NextKey)
+
+	_, err = suite.queryServer.Lockups(sdk.WrapSDKContext(suite.ctx), &types.QueryLockupsRequest{Owner: "invalid"})
+	suite.Error(err)
+}
+
 func (suite *grpcQueryTestSuite) addDeposits(deposits types.Deposits) {
 	for _, dep := range deposits {
 		suite.NotPanics(func() {
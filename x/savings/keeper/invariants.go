@@ -1,15 +1,18 @@
 package keeper
 
 import (
-	"github.com/kava-labs/kava/x/savings/types"
-
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/savings/types"
 )
 
 // RegisterInvariants registers the savings module invariants
 func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 	ir.RegisterRoute(types.ModuleName, "deposits", DepositsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "shares", SharesInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "solvency", SolvencyInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "lockups", LockupsInvariant(k))
 }
 
 // AllInvariants runs all invariants of the savings module
@@ -19,7 +22,15 @@ func AllInvariants(k Keeper) sdk.Invariant {
 			return res, stop
 		}
 
-		res, stop := SolvencyInvariant(k)(ctx)
+		if res, stop := SolvencyInvariant(k)(ctx); stop {
+			return res, stop
+		}
+
+		if res, stop := SharesInvariant(k)(ctx); stop {
+			return res, stop
+		}
+
+		res, stop := LockupsInvariant(k)(ctx)
 		return res, stop
 	}
 }
@@ -46,22 +57,90 @@ func DepositsInvariant(k Keeper) sdk.Invariant {
 	}
 }
 
-// SolvencyInvariant iterates all deposits and ensures the total amount matches the module account coins
-func SolvencyInvariant(k Keeper) sdk.Invariant {
-	message := sdk.FormatInvariant(types.ModuleName, "module solvency broken", "total deposited amount does not match module account")
+// SharesInvariant iterates all deposits and ensures the shares of each denom sum to the total shares of the denom
+func SharesInvariant(k Keeper) sdk.Invariant {
+	message := sdk.FormatInvariant(types.ModuleName, "total shares broken", "total shares do not match deposit shares")
 
 	return func(ctx sdk.Context) (string, bool) {
-		balance := k.GetSavingsModuleAccountBalances(ctx)
+		deposited := sdk.NewCoins()
+		k.IterateDeposits(ctx, func(deposit types.Deposit) bool {
+			deposited = deposited.Add(deposit.Amount...)
+			return false
+		})
+
+		totalShares := sdk.NewCoins()
+		k.IterateTotalShares(ctx, func(denom string, shares sdkmath.Int) bool {
+			totalShares = totalShares.Add(sdk.NewCoin(denom, shares))
+			return false
+		})
+
+		broken := !deposited.IsEqual(totalShares)
+		return message, broken
+	}
+}
 
-		deposited := sdk.Coins{}
+// SolvencyInvariant iterates all deposits and ensures the value of the deposits does not exceed the coins held by
+// the module account and its yield strategies
+func SolvencyInvariant(k Keeper) sdk.Invariant {
+	message := sdk.FormatInvariant(types.ModuleName, "module solvency broken", "total deposit value exceeds module assets")
+
+	return func(ctx sdk.Context) (string, bool) {
+		deposited := sdk.NewCoins()
 		k.IterateDeposits(ctx, func(deposit types.Deposit) bool {
-			for _, coin := range deposit.Amount {
-				deposited = deposited.Add(coin)
+			deposited = deposited.Add(k.syncDeposit(ctx, deposit).Amount...)
+			return false
+		})
+
+		broken := false
+		for _, coin := range deposited {
+			if coin.Amount.GT(k.GetTotalValue(ctx, coin.Denom)) {
+				broken = true
+				break
 			}
+		}
+
+		return message, broken
+	}
+}
+
+// LockupsInvariant iterates all lockups and ensures they are valid, do not lock more shares than the depositor holds,
+// and sum to the total lockup boost of each denom
+func LockupsInvariant(k Keeper) sdk.Invariant {
+	message := sdk.FormatInvariant(types.ModuleName, "lockups broken", "lockups invalid or do not match deposits")
+
+	return func(ctx sdk.Context) (string, bool) {
+		broken := false
+		locked := make(map[string]sdk.Coins)
+		boosts := sdk.NewDecCoins()
+		k.IterateLockups(ctx, func(lockup types.Lockup) bool {
+			if err := lockup.Validate(); err != nil {
+				broken = true
+				return true
+			}
+
+			depositor := lockup.Depositor.String()
+			locked[depositor] = locked[depositor].Add(lockup.Amount...)
+			boosts = boosts.Add(lockup.GetBoost()...)
+			return false
+		})
+		if broken {
+			return message, broken
+		}
+
+		for depositor, shares := range locked {
+			deposit, found := k.GetDeposit(ctx, sdk.MustAccAddressFromBech32(depositor))
+			if !found || !deposit.Amount.IsAllGTE(shares) {
+				return message, true
+			}
+		}
+
+		totalBoosts := sdk.NewDecCoins()
+		k.IterateTotalLockupBoosts(ctx, func(denom string, boost sdk.Dec) bool {
+			totalBoosts = totalBoosts.Add(sdk.NewDecCoinFromDec(denom, boost))
 			return false
 		})
 
-		broken := !deposited.IsEqual(balance)
+		broken = !boosts.IsEqual(totalBoosts)
 		return message, broken
 	}
 }
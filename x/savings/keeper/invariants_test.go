@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	tmtime "github.com/cometbft/cometbft/types/time"
@@ -114,37 +115,86 @@ func (suite *invariantTestSuite) TestDepositsInvariant() {
 	suite.Equal(true, broken)
 }
 
+func (suite *invariantTestSuite) TestSharesInvariant() {
+	message, broken := suite.runInvariant("shares", keeper.SharesInvariant)
+	suite.Equal("savings: total shares broken invariant\ntotal shares do not match deposit shares\n", message)
+	suite.Equal(false, broken)
+
+	suite.SetupValidState()
+	message, broken = suite.runInvariant("shares", keeper.SharesInvariant)
+	suite.Equal("savings: total shares broken invariant\ntotal shares do not match deposit shares\n", message)
+	suite.Equal(false, broken)
+
+	// broken when total shares are greater than deposit shares
+	suite.keeper.SetTotalShares(suite.ctx, "ukava", sdkmath.NewInt(3e8))
+
+	message, broken = suite.runInvariant("shares", keeper.SharesInvariant)
+	suite.Equal("savings: total shares broken invariant\ntotal shares do not match deposit shares\n", message)
+	suite.Equal(true, broken)
+}
+
 func (suite *invariantTestSuite) TestSolvencyInvariant() {
 	message, broken := suite.runInvariant("solvency", keeper.SolvencyInvariant)
-	suite.Equal("savings: module solvency broken invariant\ntotal deposited amount does not match module account\n", message)
+	suite.Equal("savings: module solvency broken invariant\ntotal deposit value exceeds module assets\n", message)
 	suite.Equal(false, broken)
 
 	suite.SetupValidState()
 	message, broken = suite.runInvariant("solvency", keeper.SolvencyInvariant)
-	suite.Equal("savings: module solvency broken invariant\ntotal deposited amount does not match module account\n", message)
+	suite.Equal("savings: module solvency broken invariant\ntotal deposit value exceeds module assets\n", message)
 	suite.Equal(false, broken)
 
-	// broken when deposits are greater than module balance
-	suite.keeper.SetDeposit(suite.ctx, types.NewDeposit(
-		suite.addrs[0],
-		sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(3e8))),
-	))
+	// not broken when the module holds more than the deposits, as the excess is yield owed to depositors
+	err := suite.tApp.FundModuleAccount(suite.ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(1e8))))
+	suite.Require().NoError(err)
 
 	message, broken = suite.runInvariant("solvency", keeper.SolvencyInvariant)
-	suite.Equal("savings: module solvency broken invariant\ntotal deposited amount does not match module account\n", message)
-	suite.Equal(true, broken)
+	suite.Equal("savings: module solvency broken invariant\ntotal deposit value exceeds module assets\n", message)
+	suite.Equal(false, broken)
 
-	// broken when deposits are less than the module balance
-	suite.keeper.SetDeposit(suite.ctx, types.NewDeposit(
-		suite.addrs[0],
-		sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(1e8))),
-	))
+	// broken when deposits hold more shares than exist
+	suite.keeper.SetTotalShares(suite.ctx, "ukava", sdkmath.NewInt(1e8))
 
 	message, broken = suite.runInvariant("solvency", keeper.SolvencyInvariant)
-	suite.Equal("savings: module solvency broken invariant\ntotal deposited amount does not match module account\n", message)
+	suite.Equal("savings: module solvency broken invariant\ntotal deposit value exceeds module assets\n", message)
 	suite.Equal(true, broken)
 }
 
 func TestInvariantTestSuite(t *testing.T) {
 	suite.Run(t, new(invariantTestSuite))
 }
+
+func (suite *invariantTestSuite) TestLockupsInvariant() {
+	message, broken := suite.runInvariant("lockups", keeper.LockupsInvariant)
+	suite.Equal("savings: lockups broken invariant\nlockups invalid or do not match deposits\n", message)
+	suite.Equal(false, broken)
+
+	suite.SetupValidState()
+	lockup := types.NewLockup(
+		1, suite.addrs[0], sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(5e7))),
+		types.NewLockupTier(time.Hour, sdk.MustNewDecFromStr("1.5"), sdk.MustNewDecFromStr("0.1")),
+		suite.ctx.BlockTime(),
+	)
+	suite.keeper.SetLockup(suite.ctx, lockup)
+	message, broken = suite.runInvariant("lockups", keeper.LockupsInvariant)
+	suite.Equal("savings: lockups broken invariant\nlockups invalid or do not match deposits\n", message)
+	suite.Equal(false, broken)
+
+	// broken when the total lockup boost does not match the lockups
+	suite.keeper.SetTotalLockupBoost(suite.ctx, "ukava", sdk.NewDec(1))
+
+	message, broken = suite.runInvariant("lockups", keeper.LockupsInvariant)
+	suite.Equal("savings: lockups broken invariant\nlockups invalid or do not match deposits\n", message)
+	suite.Equal(true, broken)
+
+	// broken when lockups lock more shares than the deposit
+	suite.keeper.SetTotalLockupBoost(suite.ctx, "ukava", sdk.NewDec(25e6))
+	suite.keeper.SetLockup(suite.ctx, types.NewLockup(
+		2, suite.addrs[0], sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(2e8))),
+		types.NewLockupTier(time.Hour, sdk.OneDec(), sdk.ZeroDec()),
+		suite.ctx.BlockTime(),
+	))
+
+	message, broken = suite.runInvariant("lockups", keeper.LockupsInvariant)
+	suite.Equal("savings: lockups broken invariant\nlockups invalid or do not match deposits\n", message)
+	suite.Equal(true, broken)
+}
@@ -21,13 +21,14 @@ type Keeper struct {
 	accountKeeper types.AccountKeeper
 	bankKeeper    types.BankKeeper
 	liquidKeeper  types.LiquidKeeper
+	hardKeeper    types.HardKeeper
 	hooks         types.SavingsHooks
 }
 
 // NewKeeper returns a new keeper for the savings module.
 func NewKeeper(
 	cdc codec.Codec, key storetypes.StoreKey, paramstore paramtypes.Subspace,
-	ak types.AccountKeeper, bk types.BankKeeper, lk types.LiquidKeeper,
+	ak types.AccountKeeper, bk types.BankKeeper, lk types.LiquidKeeper, hk types.HardKeeper,
 ) Keeper {
 	if !paramstore.HasKeyTable() {
 		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
@@ -40,6 +41,7 @@ func NewKeeper(
 		accountKeeper: ak,
 		bankKeeper:    bk,
 		liquidKeeper:  lk,
+		hardKeeper:    hk,
 		hooks:         nil,
 	}
 }
@@ -71,15 +73,21 @@ func (k Keeper) GetDeposit(ctx sdk.Context, depositor sdk.AccAddress) (types.Dep
 	return deposit, true
 }
 
-// SetDeposit sets the input deposit in the store
+// SetDeposit sets the input deposit in the store, and updates the total shares of each denom
 func (k Keeper) SetDeposit(ctx sdk.Context, deposit types.Deposit) {
+	previous, _ := k.GetDeposit(ctx, deposit.Depositor)
+	k.updateTotalShares(ctx, previous.Amount, deposit.Amount)
+
 	store := prefix.NewStore(ctx.KVStore(k.key), types.DepositsKeyPrefix)
 	bz := k.cdc.MustMarshal(&deposit)
 	store.Set(deposit.Depositor.Bytes(), bz)
 }
 
-// DeleteDeposit deletes a deposit from the store
+// DeleteDeposit deletes a deposit from the store, and removes its shares from the total shares of each denom
 func (k Keeper) DeleteDeposit(ctx sdk.Context, deposit types.Deposit) {
+	previous, _ := k.GetDeposit(ctx, deposit.Depositor)
+	k.updateTotalShares(ctx, previous.Amount, nil)
+
 	store := prefix.NewStore(ctx.KVStore(k.key), types.DepositsKeyPrefix)
 	store.Delete(deposit.Depositor.Bytes())
 }
@@ -0,0 +1,352 @@
+package keeper
+
+import (
+	"strconv"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	communitytypes "github.com/kava-labs/kava/x/community/types"
+	"github.com/kava-labs/kava/x/savings/types"
+)
+
+// LockDeposit deposits coins into the savings module account and locks the issued shares until the end of the lockup
+// tier with the duration. Locked shares earn savings rewards weighted by the tier's reward multiplier.
+func (k Keeper) LockDeposit(
+	ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coins, duration time.Duration,
+) (types.Lockup, error) {
+	tier, found := k.GetParams(ctx).LockupTiers.Get(duration)
+	if !found {
+		return types.Lockup{}, errorsmod.Wrapf(types.ErrInvalidLockupDuration, "no lockup tier with duration %s", duration)
+	}
+
+	shares, err := k.deposit(ctx, depositor, coins)
+	if err != nil {
+		return types.Lockup{}, err
+	}
+
+	id := k.GetNextLockupID(ctx)
+	lockup := types.NewLockup(id, depositor, shares, tier, ctx.BlockTime())
+	k.SetLockup(ctx, lockup)
+	k.SetNextLockupID(ctx, id+1)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSavingsLockup,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, coins.String()),
+			sdk.NewAttribute(types.AttributeKeyShares, shares.String()),
+			sdk.NewAttribute(types.AttributeKeyDepositor, depositor.String()),
+			sdk.NewAttribute(types.AttributeKeyLockupID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyEndTime, lockup.EndTime.Format(time.RFC3339)),
+		),
+	)
+
+	return lockup, nil
+}
+
+// WithdrawLockup withdraws the value of a lockup before the end of its term, redeeming the locked shares. The early
+// withdrawal penalty is deducted from the value and sent to the community pool.
+func (k Keeper) WithdrawLockup(ctx sdk.Context, depositor sdk.AccAddress, id uint64) error {
+	lockup, found := k.GetLockup(ctx, id)
+	if !found || !lockup.Depositor.Equals(depositor) {
+		return errorsmod.Wrapf(types.ErrLockupNotFound, "id %d for address: %s", id, depositor)
+	}
+
+	deposit, found := k.GetDeposit(ctx, depositor)
+	if !found {
+		return errorsmod.Wrapf(types.ErrNoDepositFound, " for address: %s", depositor)
+	}
+
+	// rewards are synced before the weighted shares change
+	k.BeforeSavingsDepositModified(ctx, deposit, []string{})
+
+	// shares are valued before the withdrawal is removed from the module's assets
+	value := k.syncDeposit(ctx, types.NewDeposit(depositor, lockup.Amount)).Amount
+	penalty := sdk.NewCoins()
+	for _, coin := range value {
+		penaltyAmount := sdk.NewDecFromInt(coin.Amount).Mul(lockup.EarlyWithdrawalPenalty).TruncateInt()
+		penalty = penalty.Add(sdk.NewCoin(coin.Denom, penaltyAmount))
+	}
+	amount := value.Sub(penalty...)
+
+	for _, coin := range value {
+		if err := k.withdrawFromStrategies(ctx, coin); err != nil {
+			return err
+		}
+	}
+
+	if !amount.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, depositor, amount); err != nil {
+			return err
+		}
+	}
+
+	if !penalty.IsZero() {
+		err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleAccountName, communitytypes.ModuleAccountName, penalty)
+		if err != nil {
+			return err
+		}
+	}
+
+	k.DeleteLockup(ctx, id)
+
+	deposit.Amount = deposit.Amount.Sub(lockup.Amount...)
+	if deposit.Amount.Empty() {
+		k.DeleteDeposit(ctx, deposit)
+	} else {
+		k.SetDeposit(ctx, deposit)
+	}
+
+	k.rebalance(ctx, getDenoms(value))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSavingsWithdrawLockup,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyPenalty, penalty.String()),
+			sdk.NewAttribute(types.AttributeKeyShares, lockup.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyDepositor, depositor.String()),
+			sdk.NewAttribute(types.AttributeKeyLockupID, strconv.FormatUint(id, 10)),
+		),
+	)
+	return nil
+}
+
+// UnlockMaturedLockups removes the lockups that end at or before the block time, so their shares can be withdrawn
+// without penalty.
+func (k Keeper) UnlockMaturedLockups(ctx sdk.Context) {
+	var lockups types.Lockups
+	k.IterateLockupQueue(ctx, ctx.BlockTime(), func(lockup types.Lockup) bool {
+		lockups = append(lockups, lockup)
+		return false
+	})
+
+	for _, lockup := range lockups {
+		if deposit, found := k.GetDeposit(ctx, lockup.Depositor); found {
+			// rewards are synced before the weighted shares change
+			k.BeforeSavingsDepositModified(ctx, deposit, []string{})
+		}
+		k.DeleteLockup(ctx, lockup.ID)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSavingsUnlock,
+				sdk.NewAttribute(types.AttributeKeyShares, lockup.Amount.String()),
+				sdk.NewAttribute(types.AttributeKeyDepositor, lockup.Depositor.String()),
+				sdk.NewAttribute(types.AttributeKeyLockupID, strconv.FormatUint(lockup.ID, 10)),
+			),
+		)
+	}
+}
+
+// GetLockup returns a lockup from the store by id
+func (k Keeper) GetLockup(ctx sdk.Context, id uint64) (types.Lockup, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.LockupsKeyPrefix)
+	bz := store.Get(types.LockupKey(id))
+	if len(bz) == 0 {
+		return types.Lockup{}, false
+	}
+	var lockup types.Lockup
+	k.cdc.MustUnmarshal(bz, &lockup)
+	return lockup, true
+}
+
+// SetLockup sets a lockup in the store, updating the depositor and end time indexes and the total lockup boosts
+func (k Keeper) SetLockup(ctx sdk.Context, lockup types.Lockup) {
+	k.DeleteLockup(ctx, lockup.ID)
+
+	store := prefix.NewStore(ctx.KVStore(k.key), types.LockupsKeyPrefix)
+	store.Set(types.LockupKey(lockup.ID), k.cdc.MustMarshal(&lockup))
+
+	idBz := sdk.Uint64ToBigEndian(lockup.ID)
+
+	depositorStore := prefix.NewStore(ctx.KVStore(k.key), types.LockupsByDepositorPrefix)
+	depositorStore.Set(types.LockupByDepositorKey(lockup.Depositor, lockup.ID), idBz)
+
+	queueStore := prefix.NewStore(ctx.KVStore(k.key), types.LockupQueueKeyPrefix)
+	queueStore.Set(types.LockupQueueKey(lockup.EndTime, lockup.ID), idBz)
+
+	for _, boost := range lockup.GetBoost() {
+		k.SetTotalLockupBoost(ctx, boost.Denom, k.GetTotalLockupBoost(ctx, boost.Denom).Add(boost.Amount))
+	}
+}
+
+// DeleteLockup deletes a lockup from the store, its indexes, and the total lockup boosts
+func (k Keeper) DeleteLockup(ctx sdk.Context, id uint64) {
+	lockup, found := k.GetLockup(ctx, id)
+	if !found {
+		return
+	}
+
+	for _, boost := range lockup.GetBoost() {
+		k.SetTotalLockupBoost(ctx, boost.Denom, k.GetTotalLockupBoost(ctx, boost.Denom).Sub(boost.Amount))
+	}
+
+	depositorStore := prefix.NewStore(ctx.KVStore(k.key), types.LockupsByDepositorPrefix)
+	depositorStore.Delete(types.LockupByDepositorKey(lockup.Depositor, id))
+
+	queueStore := prefix.NewStore(ctx.KVStore(k.key), types.LockupQueueKeyPrefix)
+	queueStore.Delete(types.LockupQueueKey(lockup.EndTime, id))
+
+	store := prefix.NewStore(ctx.KVStore(k.key), types.LockupsKeyPrefix)
+	store.Delete(types.LockupKey(id))
+}
+
+// IterateLockups iterates over all lockups in the store in order of id and performs a callback function
+func (k Keeper) IterateLockups(ctx sdk.Context, cb func(lockup types.Lockup) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.LockupsKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var lockup types.Lockup
+		k.cdc.MustUnmarshal(iterator.Value(), &lockup)
+		if cb(lockup) {
+			break
+		}
+	}
+}
+
+// IterateLockupsByDepositor iterates over the lockups of a depositor in order of id and performs a callback function
+func (k Keeper) IterateLockupsByDepositor(
+	ctx sdk.Context, depositor sdk.AccAddress, cb func(lockup types.Lockup) (stop bool),
+) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.LockupsByDepositorPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, types.LockupsByDepositorKey(depositor))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		lockup, found := k.GetLockup(ctx, sdk.BigEndianToUint64(iterator.Value()))
+		if !found {
+			panic(errorsmod.Wrapf(types.ErrLockupNotFound, "depositor index references missing lockup %x", iterator.Value()))
+		}
+		if cb(lockup) {
+			break
+		}
+	}
+}
+
+// IterateLockupQueue iterates over the lockups that end at or before the cutoff time in order of end time and
+// performs a callback function
+func (k Keeper) IterateLockupQueue(ctx sdk.Context, inclusiveCutoffTime time.Time, cb func(lockup types.Lockup) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.LockupQueueKeyPrefix)
+	iterator := store.Iterator(
+		nil, // start at the very start of the prefix store
+		sdk.PrefixEndBytes(sdk.FormatTimeBytes(inclusiveCutoffTime)), // include any keys with times equal to inclusiveCutoffTime
+	)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		lockup, found := k.GetLockup(ctx, sdk.BigEndianToUint64(iterator.Value()))
+		if !found {
+			panic(errorsmod.Wrapf(types.ErrLockupNotFound, "queue references missing lockup %x", iterator.Value()))
+		}
+		if cb(lockup) {
+			break
+		}
+	}
+}
+
+// GetAllLockups returns all lockups from the store in order of id
+func (k Keeper) GetAllLockups(ctx sdk.Context) (lockups types.Lockups) {
+	k.IterateLockups(ctx, func(lockup types.Lockup) bool {
+		lockups = append(lockups, lockup)
+		return false
+	})
+	return
+}
+
+// GetLockedShares returns the sum of the shares locked by a depositor's lockups
+func (k Keeper) GetLockedShares(ctx sdk.Context, depositor sdk.AccAddress) sdk.Coins {
+	locked := sdk.NewCoins()
+	k.IterateLockupsByDepositor(ctx, depositor, func(lockup types.Lockup) bool {
+		locked = locked.Add(lockup.Amount...)
+		return false
+	})
+	return locked
+}
+
+// GetNextLockupID returns the id of the next lockup
+func (k Keeper) GetNextLockupID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.key).Get(types.NextLockupIDKey)
+	if bz == nil {
+		return types.DefaultNextLockupID
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextLockupID sets the id of the next lockup
+func (k Keeper) SetNextLockupID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.key).Set(types.NextLockupIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetTotalLockupBoost returns the shares of a denom added by weighting locked shares by their reward multipliers
+func (k Keeper) GetTotalLockupBoost(ctx sdk.Context, denom string) sdk.Dec {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.TotalLockupBoostsKeyPrefix)
+	bz := store.Get([]byte(denom))
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+
+	var boost sdk.Dec
+	if err := boost.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return boost
+}
+
+// SetTotalLockupBoost sets the total lockup boost of a denom, removing it from the store if zero
+func (k Keeper) SetTotalLockupBoost(ctx sdk.Context, denom string, boost sdk.Dec) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.TotalLockupBoostsKeyPrefix)
+	if boost.IsZero() {
+		store.Delete([]byte(denom))
+		return
+	}
+
+	bz, err := boost.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set([]byte(denom), bz)
+}
+
+// IterateTotalLockupBoosts iterates over the total lockup boost of each denom and performs a callback function
+func (k Keeper) IterateTotalLockupBoosts(ctx sdk.Context, cb func(denom string, boost sdk.Dec) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.TotalLockupBoostsKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var boost sdk.Dec
+		if err := boost.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		if cb(string(iterator.Key()), boost) {
+			break
+		}
+	}
+}
+
+// GetLockupBoost returns the shares added to a depositor's shares by weighting their locked shares by the reward
+// multipliers of their lockups
+func (k Keeper) GetLockupBoost(ctx sdk.Context, depositor sdk.AccAddress) sdk.DecCoins {
+	boost := sdk.NewDecCoins()
+	k.IterateLockupsByDepositor(ctx, depositor, func(lockup types.Lockup) bool {
+		boost = boost.Add(lockup.GetBoost()...)
+		return false
+	})
+	return boost
+}
+
+// GetWeightedShares returns a depositor's shares with locked shares weighted by their reward multipliers
+func (k Keeper) GetWeightedShares(ctx sdk.Context, depositor sdk.AccAddress) sdk.DecCoins {
+	deposit, found := k.GetDeposit(ctx, depositor)
+	if !found {
+		return sdk.DecCoins{}
+	}
+
+	return sdk.NewDecCoinsFromCoins(deposit.Amount...).Add(k.GetLockupBoost(ctx, depositor)...)
+}
+
+// GetTotalWeightedShares returns the total shares of a denom with locked shares weighted by their reward multipliers
+func (k Keeper) GetTotalWeightedShares(ctx sdk.Context, denom string) sdk.Dec {
+	return sdk.NewDecFromInt(k.GetTotalShares(ctx, denom)).Add(k.GetTotalLockupBoost(ctx, denom))
+}
@@ -0,0 +1,142 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/app"
+	communitytypes "github.com/kava-labs/kava/x/community/types"
+	"github.com/kava-labs/kava/x/savings/types"
+)
+
+const lockupDuration = 30 * 24 * time.Hour
+
+func (suite *KeeperTestSuite) setupLockupTiers() {
+	suite.keeper.SetParams(suite.ctx, types.NewParams(
+		[]string{"busd"},
+		nil,
+		types.LockupTiers{
+			types.NewLockupTier(lockupDuration, sdk.MustNewDecFromStr("1.5"), sdk.MustNewDecFromStr("0.1")),
+		},
+	))
+}
+
+func (suite *KeeperTestSuite) TestLockDeposit() {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	user := addrs[0]
+	suite.CreateAccountWithAddress(user, cs(c("busd", 1e9)))
+	suite.setupLockupTiers()
+
+	lockup, err := suite.keeper.LockDeposit(suite.ctx, user, cs(c("busd", 100e6)), lockupDuration)
+	suite.Require().NoError(err)
+
+	expected := types.NewLockup(
+		types.DefaultNextLockupID, user, cs(c("busd", 100e6)),
+		types.NewLockupTier(lockupDuration, sdk.MustNewDecFromStr("1.5"), sdk.MustNewDecFromStr("0.1")),
+		suite.ctx.BlockTime(),
+	)
+	suite.Equal(expected, lockup)
+
+	stored, found := suite.keeper.GetLockup(suite.ctx, lockup.ID)
+	suite.Require().True(found)
+	suite.Equal(expected, stored)
+	suite.Equal(types.DefaultNextLockupID+1, suite.keeper.GetNextLockupID(suite.ctx))
+
+	deposit, found := suite.keeper.GetDeposit(suite.ctx, user)
+	suite.Require().True(found)
+	suite.Equal(cs(c("busd", 100e6)), deposit.Amount)
+	suite.Equal(cs(c("busd", 100e6)), suite.keeper.GetLockedShares(suite.ctx, user))
+
+	// locked shares are weighted by the reward multiplier
+	suite.Equal(sdk.NewDecCoins(sdk.NewDecCoin("busd", sdkmath.NewInt(150e6))), suite.keeper.GetWeightedShares(suite.ctx, user))
+	suite.Equal(sdk.NewDec(150e6), suite.keeper.GetTotalWeightedShares(suite.ctx, "busd"))
+
+	// locked shares cannot be withdrawn
+	err = suite.keeper.Withdraw(suite.ctx, user, cs(c("busd", 1)))
+	suite.ErrorIs(err, types.ErrInvalidWithdrawDenom)
+
+	// unlocked shares can be withdrawn
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, user, cs(c("busd", 10e6))))
+	suite.Require().NoError(suite.keeper.Withdraw(suite.ctx, user, cs(c("busd", 50e6))))
+	suite.Equal(cs(c("busd", 1e9-100e6)), suite.getAccountCoins(suite.getAccount(user)))
+
+	deposit, found = suite.keeper.GetDeposit(suite.ctx, user)
+	suite.Require().True(found)
+	suite.Equal(cs(c("busd", 100e6)), deposit.Amount)
+
+	suite.Run("fails for durations without a lockup tier", func() {
+		_, err := suite.keeper.LockDeposit(suite.ctx, user, cs(c("busd", 100e6)), time.Hour)
+		suite.ErrorIs(err, types.ErrInvalidLockupDuration)
+	})
+}
+
+func (suite *KeeperTestSuite) TestWithdrawLockup() {
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	user, other := addrs[0], addrs[1]
+	suite.CreateAccountWithAddress(user, cs(c("busd", 1e9)))
+	suite.setupLockupTiers()
+
+	lockup, err := suite.keeper.LockDeposit(suite.ctx, user, cs(c("busd", 100e6)), lockupDuration)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, user, cs(c("busd", 10e6))))
+
+	suite.Run("fails for other depositors", func() {
+		err := suite.keeper.WithdrawLockup(suite.ctx, other, lockup.ID)
+		suite.ErrorIs(err, types.ErrLockupNotFound)
+	})
+
+	communityBalance := suite.getAccountCoins(suite.getModuleAccount(communitytypes.ModuleAccountName))
+
+	err = suite.keeper.WithdrawLockup(suite.ctx, user, lockup.ID)
+	suite.Require().NoError(err)
+
+	// the early withdrawal penalty is sent to the community pool
+	suite.Equal(cs(c("busd", 1e9-10e6-10e6)), suite.getAccountCoins(suite.getAccount(user)))
+	suite.Equal(
+		communityBalance.Add(c("busd", 10e6)),
+		suite.getAccountCoins(suite.getModuleAccount(communitytypes.ModuleAccountName)),
+	)
+
+	_, found := suite.keeper.GetLockup(suite.ctx, lockup.ID)
+	suite.False(found)
+	suite.Equal(sdk.ZeroDec(), suite.keeper.GetTotalLockupBoost(suite.ctx, "busd"))
+
+	deposit, found := suite.keeper.GetDeposit(suite.ctx, user)
+	suite.Require().True(found)
+	suite.Equal(cs(c("busd", 10e6)), deposit.Amount)
+
+}
+
+func (suite *KeeperTestSuite) TestUnlockMaturedLockups() {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	user := addrs[0]
+	suite.CreateAccountWithAddress(user, cs(c("busd", 1e9)))
+	suite.setupLockupTiers()
+
+	first, err := suite.keeper.LockDeposit(suite.ctx, user, cs(c("busd", 100e6)), lockupDuration)
+	suite.Require().NoError(err)
+
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Hour))
+	second, err := suite.keeper.LockDeposit(suite.ctx, user, cs(c("busd", 20e6)), lockupDuration)
+	suite.Require().NoError(err)
+
+	// lockups are kept until they end
+	suite.ctx = suite.ctx.WithBlockTime(first.EndTime.Add(-time.Second))
+	suite.keeper.UnlockMaturedLockups(suite.ctx)
+	suite.Len(suite.keeper.GetAllLockups(suite.ctx), 2)
+
+	suite.ctx = suite.ctx.WithBlockTime(first.EndTime)
+	suite.keeper.UnlockMaturedLockups(suite.ctx)
+	suite.Equal(types.Lockups{second}, suite.keeper.GetAllLockups(suite.ctx))
+	suite.Equal(sdk.NewDec(10e6), suite.keeper.GetTotalLockupBoost(suite.ctx, "busd"))
+
+	// unlocked shares can be withdrawn without penalty
+	suite.Require().NoError(suite.keeper.Withdraw(suite.ctx, user, cs(c("busd", 1e9))))
+	suite.Equal(cs(c("busd", 1e9-20e6)), suite.getAccountCoins(suite.getAccount(user)))
+
+	deposit, found := suite.keeper.GetDeposit(suite.ctx, user)
+	suite.Require().True(found)
+	suite.Equal(cs(c("busd", 20e6)), deposit.Amount)
+}
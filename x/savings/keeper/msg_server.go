@@ -65,3 +65,49 @@ func (k msgServer) Withdraw(goCtx context.Context, msg *types.MsgWithdraw) (*typ
 	)
 	return &types.MsgWithdrawResponse{}, nil
 }
+
+func (k msgServer) LockDeposit(goCtx context.Context, msg *types.MsgLockDeposit) (*types.MsgLockDepositResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	depositor, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		return nil, err
+	}
+
+	lockup, err := k.keeper.LockDeposit(ctx, depositor, msg.Amount, msg.Duration)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Depositor),
+		),
+	)
+	return &types.MsgLockDepositResponse{LockupID: lockup.ID}, nil
+}
+
+func (k msgServer) WithdrawLockup(goCtx context.Context, msg *types.MsgWithdrawLockup) (*types.MsgWithdrawLockupResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	depositor, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.keeper.WithdrawLockup(ctx, depositor, msg.LockupID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Depositor),
+		),
+	)
+	return &types.MsgWithdrawLockupResponse{}, nil
+}
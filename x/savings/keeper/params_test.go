@@ -11,7 +11,7 @@ func (suite *KeeperTestSuite) TestGetSetParams() {
 		params,
 	)
 
-	newParams := types.NewParams([]string{"btc", "test"})
+	newParams := types.NewParams([]string{"btc", "test"}, nil, nil)
 	suite.keeper.SetParams(suite.ctx, newParams)
 
 	fetchedParams := suite.keeper.GetParams(suite.ctx)
@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/savings/types"
+)
+
+// GetTotalShares returns the sum of all depositors' shares of a denom
+func (k Keeper) GetTotalShares(ctx sdk.Context, denom string) sdkmath.Int {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.TotalSharesKeyPrefix)
+	bz := store.Get([]byte(denom))
+	if bz == nil {
+		return sdkmath.ZeroInt()
+	}
+
+	var shares sdkmath.Int
+	if err := shares.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return shares
+}
+
+// SetTotalShares sets the total shares of a denom, removing it from the store if zero
+func (k Keeper) SetTotalShares(ctx sdk.Context, denom string, shares sdkmath.Int) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.TotalSharesKeyPrefix)
+	if shares.IsZero() {
+		store.Delete([]byte(denom))
+		return
+	}
+
+	bz, err := shares.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set([]byte(denom), bz)
+}
+
+// IterateTotalShares iterates over the total shares of each denom and performs a callback function
+func (k Keeper) IterateTotalShares(ctx sdk.Context, cb func(denom string, shares sdkmath.Int) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.TotalSharesKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var shares sdkmath.Int
+		if err := shares.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		if cb(string(iterator.Key()), shares) {
+			break
+		}
+	}
+}
+
+// updateTotalShares replaces the previous shares of a deposit with the current shares in the total shares of each denom
+func (k Keeper) updateTotalShares(ctx sdk.Context, previous, current sdk.Coins) {
+	for _, denom := range getDenoms(previous.Add(current...)) {
+		total := k.GetTotalShares(ctx, denom).
+			Sub(previous.AmountOf(denom)).
+			Add(current.AmountOf(denom))
+		k.SetTotalShares(ctx, denom, total)
+	}
+}
+
+// GetTotalValue returns the amount of a denom held by the module account and its yield strategies, which is the
+// amount all depositors' shares of the denom are worth
+func (k Keeper) GetTotalValue(ctx sdk.Context, denom string) sdkmath.Int {
+	total := k.bankKeeper.GetBalance(ctx, k.accountKeeper.GetModuleAddress(types.ModuleAccountName), denom).Amount
+	for _, strategy := range k.getStrategies() {
+		total = total.Add(strategy.GetEstimatedTotalAssets(ctx, denom).Amount)
+	}
+	return total
+}
+
+// GetSyncedDeposit returns a deposit with its amount converted from shares to the current value of the shares
+func (k Keeper) GetSyncedDeposit(ctx sdk.Context, depositor sdk.AccAddress) (types.Deposit, bool) {
+	deposit, found := k.GetDeposit(ctx, depositor)
+	if !found {
+		return types.Deposit{}, false
+	}
+
+	return k.syncDeposit(ctx, deposit), true
+}
+
+// syncDeposit converts the amount of a deposit from shares to the current value of the shares
+func (k Keeper) syncDeposit(ctx sdk.Context, deposit types.Deposit) types.Deposit {
+	amount := sdk.NewCoins()
+	for _, shares := range deposit.Amount {
+		amount = amount.Add(sdk.NewCoin(shares.Denom, k.convertSharesToValue(ctx, shares.Denom, shares.Amount)))
+	}
+
+	return types.NewDeposit(deposit.Depositor, amount)
+}
+
+// convertSharesToValue returns the amount of a denom the shares are worth, rounded down
+func (k Keeper) convertSharesToValue(ctx sdk.Context, denom string, shares sdkmath.Int) sdkmath.Int {
+	totalShares := k.GetTotalShares(ctx, denom)
+	if totalShares.IsZero() {
+		return sdkmath.ZeroInt()
+	}
+
+	return shares.Mul(k.GetTotalValue(ctx, denom)).Quo(totalShares)
+}
+
+// calculateIssuedShares returns the shares issued for depositing an amount of a denom, rounded down so existing
+// depositors are never diluted. Shares are issued 1:1 with the amount when there are no existing shares.
+func (k Keeper) calculateIssuedShares(ctx sdk.Context, coin sdk.Coin) (sdkmath.Int, error) {
+	totalShares := k.GetTotalShares(ctx, coin.Denom)
+	if totalShares.IsZero() {
+		return coin.Amount, nil
+	}
+
+	totalValue := k.GetTotalValue(ctx, coin.Denom)
+	if totalValue.IsZero() {
+		return sdkmath.Int{}, errorsmod.Wrapf(types.ErrInsufficientShares, "%s deposits have no value", coin.Denom)
+	}
+
+	shares := coin.Amount.Mul(totalShares).Quo(totalValue)
+	if !shares.IsPositive() {
+		return sdkmath.Int{}, errorsmod.Wrapf(types.ErrInsufficientShares, "%s", coin)
+	}
+	return shares, nil
+}
+
+// calculateRedeemedShares returns the shares redeemed for withdrawing an amount of a denom, rounded up so remaining
+// depositors are never diluted. The result is capped at the available shares.
+func (k Keeper) calculateRedeemedShares(ctx sdk.Context, coin sdk.Coin, available sdkmath.Int) sdkmath.Int {
+	totalShares := k.GetTotalShares(ctx, coin.Denom)
+	totalValue := k.GetTotalValue(ctx, coin.Denom)
+	if totalValue.IsZero() {
+		return available
+	}
+
+	numerator := coin.Amount.Mul(totalShares)
+	shares := numerator.Quo(totalValue)
+	if !numerator.Mod(totalValue).IsZero() {
+		shares = shares.AddRaw(1)
+	}
+
+	return sdkmath.MinInt(shares, available)
+}
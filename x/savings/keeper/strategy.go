@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/savings/types"
+)
+
+// Strategy is the interface that must be implemented by a strategy.
+type Strategy interface {
+	// GetStrategyType returns the strategy type
+	GetStrategyType() types.StrategyType
+
+	// GetEstimatedTotalAssets returns the value of the module's assets in the
+	// strategy with the specified denom, including any accrued yield.
+	GetEstimatedTotalAssets(ctx sdk.Context, denom string) sdk.Coin
+
+	// Deposit the specified amount of coins from the module account into this strategy.
+	Deposit(ctx sdk.Context, amount sdk.Coin) error
+
+	// Withdraw the specified amount of coins from this strategy to the module account.
+	Withdraw(ctx sdk.Context, amount sdk.Coin) error
+}
+
+// GetStrategy returns the strategy for the given strategy type.
+func (k *Keeper) GetStrategy(strategyType types.StrategyType) (Strategy, error) {
+	switch strategyType {
+	case types.STRATEGY_TYPE_HARD:
+		return (*HardStrategy)(k), nil
+	default:
+		return nil, fmt.Errorf("unknown strategy type: %s", strategyType)
+	}
+}
+
+// getStrategies returns all strategies deposits can be allocated to. Assets in a strategy are counted towards the
+// value of deposits even if the denom is no longer allocated to it.
+func (k Keeper) getStrategies() []Strategy {
+	return []Strategy{(*HardStrategy)(&k)}
+}
+
+// Rebalance moves deposits of a denom between the module account and the yield strategies, so each strategy holds
+// the portion of deposits set by the params. Strategies the denom is not allocated to are emptied.
+// Coins are only deposited into a strategy from the module account's available balance.
+func (k Keeper) Rebalance(ctx sdk.Context, denom string) error {
+	allocation, hasAllocation := k.GetParams(ctx).Strategies.Get(denom)
+	totalValue := k.GetTotalValue(ctx, denom)
+	moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleAccountName)
+
+	for _, strategy := range k.getStrategies() {
+		target := sdkmath.ZeroInt()
+		if hasAllocation && allocation.Strategy == strategy.GetStrategyType() {
+			target = allocation.Allocation.MulInt(totalValue).TruncateInt()
+		}
+
+		current := strategy.GetEstimatedTotalAssets(ctx, denom).Amount
+		switch {
+		case current.GT(target):
+			if err := strategy.Withdraw(ctx, sdk.NewCoin(denom, current.Sub(target))); err != nil {
+				return err
+			}
+		case current.LT(target):
+			available := k.bankKeeper.GetBalance(ctx, moduleAddr, denom).Amount
+			amount := sdkmath.MinInt(target.Sub(current), available)
+			if !amount.IsPositive() {
+				continue
+			}
+			if err := strategy.Deposit(ctx, sdk.NewCoin(denom, amount)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// rebalance rebalances the deposits of each denom. A failure leaves the denom's deposits where they are until the
+// next rebalance, so deposits and withdrawals do not fail when a strategy cannot accept or return coins.
+func (k Keeper) rebalance(ctx sdk.Context, denoms []string) {
+	for _, denom := range denoms {
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.Rebalance(cacheCtx, denom); err != nil {
+			k.Logger(ctx).Error("failed to rebalance savings deposits", "denom", denom, "error", err)
+			continue
+		}
+		writeCache()
+	}
+}
+
+// withdrawFromStrategies ensures the module account holds at least the amount, withdrawing any shortfall from
+// the yield strategies.
+func (k Keeper) withdrawFromStrategies(ctx sdk.Context, amount sdk.Coin) error {
+	moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleAccountName)
+	shortfall := amount.Amount.Sub(k.bankKeeper.GetBalance(ctx, moduleAddr, amount.Denom).Amount)
+
+	for _, strategy := range k.getStrategies() {
+		if !shortfall.IsPositive() {
+			return nil
+		}
+
+		withdrawAmount := sdkmath.MinInt(shortfall, strategy.GetEstimatedTotalAssets(ctx, amount.Denom).Amount)
+		if !withdrawAmount.IsPositive() {
+			continue
+		}
+		if err := strategy.Withdraw(ctx, sdk.NewCoin(amount.Denom, withdrawAmount)); err != nil {
+			return err
+		}
+		shortfall = shortfall.Sub(withdrawAmount)
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/savings/types"
+)
+
+// HardStrategy defines the strategy that supplies assets to Hard
+type HardStrategy Keeper
+
+var _ Strategy = (*HardStrategy)(nil)
+
+// GetStrategyType returns the strategy type
+func (s *HardStrategy) GetStrategyType() types.StrategyType {
+	return types.STRATEGY_TYPE_HARD
+}
+
+// GetEstimatedTotalAssets returns the current value of all assets of the
+// denom supplied to hard, including interest.
+func (s *HardStrategy) GetEstimatedTotalAssets(ctx sdk.Context, denom string) sdk.Coin {
+	deposit, found := s.hardKeeper.GetSyncedDeposit(ctx, s.accountKeeper.GetModuleAddress(types.ModuleAccountName))
+	if !found {
+		// Return 0 if no deposit exists for module account
+		return sdk.NewCoin(denom, sdk.ZeroInt())
+	}
+
+	return sdk.NewCoin(denom, deposit.Amount.AmountOf(denom))
+}
+
+// Deposit supplies the specified amount of coins to hard.
+func (s *HardStrategy) Deposit(ctx sdk.Context, amount sdk.Coin) error {
+	return s.hardKeeper.Deposit(ctx, s.accountKeeper.GetModuleAddress(types.ModuleAccountName), sdk.NewCoins(amount))
+}
+
+// Withdraw withdraws the specified amount of coins from hard.
+func (s *HardStrategy) Withdraw(ctx sdk.Context, amount sdk.Coin) error {
+	return s.hardKeeper.Withdraw(ctx, s.accountKeeper.GetModuleAddress(types.ModuleAccountName), sdk.NewCoins(amount))
+}
@@ -0,0 +1,161 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/app"
+	hardtypes "github.com/kava-labs/kava/x/hard/types"
+	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
+	"github.com/kava-labs/kava/x/savings/types"
+)
+
+// setupHardStrategy adds a busd hard money market and allocates a portion of busd savings deposits to hard.
+func (suite *KeeperTestSuite) setupHardStrategy(allocation sdk.Dec) {
+	// pricefeed is required for withdrawing from hard
+	pricefeedKeeper := suite.app.GetPriceFeedKeeper()
+	pricefeedKeeper.SetParams(suite.ctx, pricefeedtypes.NewParams([]pricefeedtypes.Market{
+		{MarketID: "busd:usd", BaseAsset: "busd", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+	}))
+	_, err := pricefeedKeeper.SetPrice(
+		suite.ctx, sdk.AccAddress{}, "busd:usd", sdk.OneDec(), suite.ctx.BlockTime().Add(100*time.Hour),
+	)
+	suite.Require().NoError(err)
+	suite.Require().NoError(pricefeedKeeper.SetCurrentPrices(suite.ctx, "busd:usd"))
+
+	moneyMarket := hardtypes.NewMoneyMarket(
+		"busd",
+		hardtypes.NewBorrowLimit(false, sdk.ZeroDec(), sdk.MustNewDecFromStr("0.8")),
+		"busd:usd",
+		sdkmath.NewInt(1e6),
+		hardtypes.NewInterestRateModel(
+			sdk.MustNewDecFromStr("0.05"),
+			sdk.MustNewDecFromStr("2"),
+			sdk.MustNewDecFromStr("0.8"),
+			sdk.MustNewDecFromStr("10"),
+		),
+		sdk.MustNewDecFromStr("0.05"),
+		sdk.ZeroDec(),
+	)
+	hardKeeper := suite.app.GetHardKeeper()
+	hardKeeper.SetParams(suite.ctx, hardtypes.NewParams(hardtypes.MoneyMarkets{moneyMarket}, sdk.NewDec(10)))
+	hardKeeper.SetMoneyMarket(suite.ctx, "busd", moneyMarket)
+
+	suite.keeper.SetParams(suite.ctx, types.NewParams(
+		[]string{"busd"},
+		types.StrategyAllocations{types.NewStrategyAllocation("busd", types.STRATEGY_TYPE_HARD, allocation)},
+		nil,
+	))
+}
+
+func (suite *KeeperTestSuite) hardStrategyValue() sdkmath.Int {
+	macc := suite.getModuleAccount(types.ModuleAccountName)
+	deposit, found := suite.app.GetHardKeeper().GetSyncedDeposit(suite.ctx, macc.GetAddress())
+	if !found {
+		return sdkmath.ZeroInt()
+	}
+	return deposit.Amount.AmountOf("busd")
+}
+
+func (suite *KeeperTestSuite) TestDeposit_AllocatesToStrategy() {
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	user1, user2 := addrs[0], addrs[1]
+	suite.CreateAccountWithAddress(user1, cs(c("busd", 1e9)))
+	suite.CreateAccountWithAddress(user2, cs(c("busd", 1e9)))
+	suite.setupHardStrategy(sdk.MustNewDecFromStr("0.8"))
+
+	err := suite.keeper.Deposit(suite.ctx, user1, cs(c("busd", 100e6)))
+	suite.Require().NoError(err)
+
+	suite.Equal(sdkmath.NewInt(80e6), suite.hardStrategyValue())
+	suite.Equal(cs(c("busd", 20e6)), suite.getAccountCoins(suite.getModuleAccount(types.ModuleAccountName)))
+	suite.Equal(sdkmath.NewInt(100e6), suite.keeper.GetTotalValue(suite.ctx, "busd"))
+
+	deposit, found := suite.keeper.GetDeposit(suite.ctx, user1)
+	suite.Require().True(found)
+	suite.Equal(cs(c("busd", 100e6)), deposit.Amount, "first deposit should be issued shares 1:1")
+
+	// protocol revenue sent to the module account is shared between existing depositors
+	err = suite.app.FundModuleAccount(suite.ctx, types.ModuleAccountName, cs(c("busd", 25e6)))
+	suite.Require().NoError(err)
+
+	synced, found := suite.keeper.GetSyncedDeposit(suite.ctx, user1)
+	suite.Require().True(found)
+	suite.Equal(cs(c("busd", 125e6)), synced.Amount)
+
+	// later depositors receive fewer shares as each share is worth more
+	err = suite.keeper.Deposit(suite.ctx, user2, cs(c("busd", 50e6)))
+	suite.Require().NoError(err)
+
+	deposit, found = suite.keeper.GetDeposit(suite.ctx, user2)
+	suite.Require().True(found)
+	suite.Equal(cs(c("busd", 40e6)), deposit.Amount)
+	suite.Equal(sdkmath.NewInt(140e6), suite.keeper.GetTotalShares(suite.ctx, "busd"))
+	suite.Equal(sdkmath.NewInt(140e6), suite.hardStrategyValue())
+}
+
+func (suite *KeeperTestSuite) TestWithdraw_FromStrategy() {
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	user1, user2 := addrs[0], addrs[1]
+	suite.CreateAccountWithAddress(user1, cs(c("busd", 1e9)))
+	suite.CreateAccountWithAddress(user2, cs(c("busd", 1e9)))
+	suite.setupHardStrategy(sdk.MustNewDecFromStr("0.5"))
+
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, user1, cs(c("busd", 100e6))))
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, user2, cs(c("busd", 100e6))))
+	suite.Require().NoError(suite.app.FundModuleAccount(suite.ctx, types.ModuleAccountName, cs(c("busd", 20e6))))
+
+	// withdrawing more than the module account holds withdraws the shortfall from hard
+	err := suite.keeper.Withdraw(suite.ctx, user1, cs(c("busd", 110e6)))
+	suite.Require().NoError(err)
+
+	suite.Equal(cs(c("busd", 1e9+10e6)), suite.getAccountCoins(suite.getAccount(user1)))
+	_, found := suite.keeper.GetDeposit(suite.ctx, user1)
+	suite.False(found, "withdrawing the full value should redeem all shares")
+
+	suite.Equal(sdkmath.NewInt(110e6), suite.keeper.GetTotalValue(suite.ctx, "busd"))
+	suite.Equal(sdkmath.NewInt(55e6), suite.hardStrategyValue())
+
+	// partial withdrawals redeem shares rounded up
+	err = suite.keeper.Withdraw(suite.ctx, user2, cs(c("busd", 11)))
+	suite.Require().NoError(err)
+
+	deposit, found := suite.keeper.GetDeposit(suite.ctx, user2)
+	suite.Require().True(found)
+	suite.Equal(cs(c("busd", 100e6-10)), deposit.Amount)
+}
+
+func (suite *KeeperTestSuite) TestRebalance_RemovedAllocation() {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	user := addrs[0]
+	suite.CreateAccountWithAddress(user, cs(c("busd", 1e9)))
+	suite.setupHardStrategy(sdk.MustNewDecFromStr("1"))
+
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, user, cs(c("busd", 100e6))))
+	suite.Equal(sdkmath.NewInt(100e6), suite.hardStrategyValue())
+
+	suite.keeper.SetParams(suite.ctx, types.NewParams([]string{"busd"}, nil, nil))
+	suite.Require().NoError(suite.keeper.Rebalance(suite.ctx, "busd"))
+
+	suite.Equal(sdkmath.ZeroInt(), suite.hardStrategyValue())
+	suite.Equal(cs(c("busd", 100e6)), suite.getAccountCoins(suite.getModuleAccount(types.ModuleAccountName)))
+}
+
+func (suite *KeeperTestSuite) TestDeposit_StrategyFailure() {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	user := addrs[0]
+	suite.CreateAccountWithAddress(user, cs(c("bnb", 1e9)))
+
+	// bnb has no hard money market, so deposits stay in the module account
+	suite.keeper.SetParams(suite.ctx, types.NewParams(
+		[]string{"bnb"},
+		types.StrategyAllocations{types.NewStrategyAllocation("bnb", types.STRATEGY_TYPE_HARD, sdk.OneDec())},
+		nil,
+	))
+
+	err := suite.keeper.Deposit(suite.ctx, user, cs(c("bnb", 100e6)))
+	suite.Require().NoError(err)
+	suite.Equal(cs(c("bnb", 100e6)), suite.getAccountCoins(suite.getModuleAccount(types.ModuleAccountName)))
+}
@@ -9,34 +9,60 @@ import (
 	"github.com/kava-labs/kava/x/savings/types"
 )
 
-// Withdraw returns some or all of a deposit back to original depositor
+// Withdraw returns some or all of the value of a deposit back to original depositor, redeeming the depositor's
+// shares. Coins are withdrawn from the yield strategies if the module account does not hold enough. Shares locked by
+// lockups cannot be withdrawn.
 func (k Keeper) Withdraw(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coins) error {
 	deposit, found := k.GetDeposit(ctx, depositor)
 	if !found {
 		return errorsmod.Wrap(types.ErrNoDepositFound, fmt.Sprintf(" for address: %s", depositor.String()))
 	}
 
-	amount, err := k.CalculateWithdrawAmount(deposit.Amount, coins)
+	unlocked := deposit.Amount.Sub(k.GetLockedShares(ctx, depositor)...)
+	available := k.syncDeposit(ctx, types.NewDeposit(depositor, unlocked)).Amount
+	amount, err := k.CalculateWithdrawAmount(available, coins)
 	if err != nil {
 		return err
 	}
 
+	// shares are valued before the withdrawal is removed from the module's assets
+	shares := sdk.NewCoins()
+	for _, coin := range amount {
+		redeemed := unlocked.AmountOf(coin.Denom)
+		if coin.Amount.LT(available.AmountOf(coin.Denom)) {
+			redeemed = k.calculateRedeemedShares(ctx, coin, redeemed)
+		}
+		shares = shares.Add(sdk.NewCoin(coin.Denom, redeemed))
+	}
+
+	for _, coin := range amount {
+		if err := k.withdrawFromStrategies(ctx, coin); err != nil {
+			return err
+		}
+	}
+
 	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, depositor, amount)
 	if err != nil {
 		return err
 	}
 
-	deposit.Amount = deposit.Amount.Sub(amount...)
+	// rewards are synced before the shares change
+	k.BeforeSavingsDepositModified(ctx, deposit, []string{})
+
+	deposit.Amount = deposit.Amount.Sub(shares...)
 	if deposit.Amount.Empty() {
 		k.DeleteDeposit(ctx, deposit)
 	} else {
 		k.SetDeposit(ctx, deposit)
 	}
 
+	k.rebalance(ctx, getDenoms(amount))
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeSavingsWithdrawal,
 			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyShares, shares.String()),
 			sdk.NewAttribute(types.AttributeKeyDepositor, depositor.String()),
 		),
 	)
@@ -148,8 +148,10 @@ func (suite *KeeperTestSuite) TestWithdraw() {
 				[]sdk.AccAddress{tc.args.depositor},
 			)
 			savingsGS := types.NewGenesisState(
-				types.NewParams(tc.args.allowedDenoms),
+				types.NewParams(tc.args.allowedDenoms, nil, nil),
 				types.Deposits{},
+				types.Lockups{},
+				types.DefaultNextLockupID,
 			)
 
 			stakingParams := stakingtypes.DefaultParams()
@@ -0,0 +1,55 @@
+package v2
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/kava-labs/kava/x/savings/types"
+)
+
+// MigrateStore performs in-place store migrations for consensus version 2
+// V2 adds the strategies and lockup tiers params, and stores the total shares of each denom.
+// Deposits are converted to shares 1:1, as no yield has accrued before v2.
+func MigrateStore(ctx sdk.Context, store storetypes.KVStore, cdc codec.BinaryCodec, paramstore paramtypes.Subspace) error {
+	migrateParamsStore(ctx, paramstore)
+	return migrateTotalShares(store, cdc)
+}
+
+// migrateParamsStore ensures the param key table exists and has the strategies and lockup tiers properties
+func migrateParamsStore(ctx sdk.Context, paramstore paramtypes.Subspace) {
+	if !paramstore.HasKeyTable() {
+		paramstore.WithKeyTable(types.ParamKeyTable())
+	}
+	paramstore.Set(ctx, types.KeyStrategies, types.DefaultStrategies)
+	paramstore.Set(ctx, types.KeyLockupTiers, types.DefaultLockupTiers)
+}
+
+// migrateTotalShares sums the deposits of each denom into the total shares of the denom
+func migrateTotalShares(store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	depositStore := prefix.NewStore(store, types.DepositsKeyPrefix)
+	iterator := depositStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	totalShares := sdk.NewCoins()
+	for ; iterator.Valid(); iterator.Next() {
+		var deposit types.Deposit
+		if err := cdc.Unmarshal(iterator.Value(), &deposit); err != nil {
+			return err
+		}
+		totalShares = totalShares.Add(deposit.Amount...)
+	}
+
+	sharesStore := prefix.NewStore(store, types.TotalSharesKeyPrefix)
+	for _, coin := range totalShares {
+		bz, err := coin.Amount.Marshal()
+		if err != nil {
+			return err
+		}
+		sharesStore.Set([]byte(coin.Denom), bz)
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	v2savings "github.com/kava-labs/kava/x/savings/migrations/v2"
+	"github.com/kava-labs/kava/x/savings/types"
+)
+
+func TestStoreMigrationSetsNewParams(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	savingsKey := sdk.NewKVStoreKey(types.ModuleName)
+	tsavingsKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(savingsKey, tsavingsKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, savingsKey, tsavingsKey, types.ModuleName)
+
+	// Check params don't exist before
+	require.False(t, paramstore.Has(ctx, types.KeyStrategies))
+	require.False(t, paramstore.Has(ctx, types.KeyLockupTiers))
+
+	// Run migrations.
+	err := v2savings.MigrateStore(ctx, ctx.KVStore(savingsKey), encCfg.Codec, paramstore)
+	require.NoError(t, err)
+
+	// Make sure the new params are set.
+	require.True(t, paramstore.Has(ctx, types.KeyStrategies))
+	var strategies types.StrategyAllocations
+	paramstore.Get(ctx, types.KeyStrategies, &strategies)
+	require.Empty(t, strategies)
+
+	require.True(t, paramstore.Has(ctx, types.KeyLockupTiers))
+	var lockupTiers types.LockupTiers
+	paramstore.Get(ctx, types.KeyLockupTiers, &lockupTiers)
+	require.Empty(t, lockupTiers)
+}
+
+func TestStoreMigrationSetsTotalShares(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	savingsKey := sdk.NewKVStoreKey(types.ModuleName)
+	tsavingsKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(savingsKey, tsavingsKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, savingsKey, tsavingsKey, types.ModuleName)
+	store := ctx.KVStore(savingsKey)
+
+	deposits := types.Deposits{
+		types.NewDeposit(sdk.AccAddress("depositor1"), sdk.NewCoins(sdk.NewInt64Coin("busd", 100), sdk.NewInt64Coin("ukava", 50))),
+		types.NewDeposit(sdk.AccAddress("depositor2"), sdk.NewCoins(sdk.NewInt64Coin("busd", 20))),
+	}
+	depositStore := prefix.NewStore(store, types.DepositsKeyPrefix)
+	for _, deposit := range deposits {
+		depositStore.Set(deposit.Depositor.Bytes(), encCfg.Codec.MustMarshal(&deposit))
+	}
+
+	// Run migrations.
+	err := v2savings.MigrateStore(ctx, store, encCfg.Codec, paramstore)
+	require.NoError(t, err)
+
+	// Deposits are converted to shares 1:1
+	sharesStore := prefix.NewStore(store, types.TotalSharesKeyPrefix)
+	for denom, expected := range map[string]int64{"busd": 120, "ukava": 50} {
+		var shares sdkmath.Int
+		require.NoError(t, shares.Unmarshal(sharesStore.Get([]byte(denom))))
+		require.Equal(t, sdkmath.NewInt(expected), shares)
+	}
+}
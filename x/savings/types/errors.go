@@ -13,4 +13,10 @@ var (
 	ErrInvalidDepositDenom = errorsmod.Register(ModuleName, 4, "invalid deposit denom")
 	// ErrInvalidWithdrawDenom error for invalid withdraw denoms
 	ErrInvalidWithdrawDenom = errorsmod.Register(ModuleName, 5, "invalid withdraw denom")
+	// ErrInsufficientShares error for a deposit too small to be issued any shares
+	ErrInsufficientShares = errorsmod.Register(ModuleName, 6, "deposit too small to issue shares")
+	// ErrInvalidLockupDuration error for a lockup duration that does not match a lockup tier
+	ErrInvalidLockupDuration = errorsmod.Register(ModuleName, 7, "invalid lockup duration")
+	// ErrLockupNotFound error when no lockup is found for an id and depositor
+	ErrLockupNotFound = errorsmod.Register(ModuleName, 8, "lockup not found")
 )
@@ -1,10 +1,17 @@
 package types
 
 const (
-	EventTypeSavingsDeposit    = "deposit_savings"
-	EventTypeSavingsWithdrawal = "withdraw_savings"
+	EventTypeSavingsDeposit        = "deposit_savings"
+	EventTypeSavingsWithdrawal     = "withdraw_savings"
+	EventTypeSavingsLockup         = "lock_savings"
+	EventTypeSavingsUnlock         = "unlock_savings"
+	EventTypeSavingsWithdrawLockup = "withdraw_savings_lockup"
 
 	AttributeValueCategory = ModuleName
 	AttributeKeyAmount     = "amount"
 	AttributeKeyDepositor  = "depositor"
+	AttributeKeyShares     = "shares"
+	AttributeKeyLockupID   = "lockup_id"
+	AttributeKeyEndTime    = "end_time"
+	AttributeKeyPenalty    = "penalty"
 )
@@ -3,6 +3,8 @@ package types // noalias
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	hardtypes "github.com/kava-labs/kava/x/hard/types"
 )
 
 // BankKeeper defines the expected bank keeper
@@ -36,3 +38,11 @@ type LiquidKeeper interface {
 	GetStakedTokensForDerivatives(ctx sdk.Context, derivatives sdk.Coins) (sdk.Coin, error)
 	IsDerivativeDenom(ctx sdk.Context, denom string) bool
 }
+
+// HardKeeper defines the expected interface needed for the hard strategy.
+type HardKeeper interface {
+	Deposit(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coins) error
+	Withdraw(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coins) error
+
+	GetSyncedDeposit(ctx sdk.Context, depositor sdk.AccAddress) (hardtypes.Deposit, bool)
+}
@@ -1,10 +1,21 @@
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultNextLockupID is the id of the first lockup
+const DefaultNextLockupID uint64 = 1
+
 // NewGenesisState creates a new genesis state for the savings module
-func NewGenesisState(p Params, deposits Deposits) GenesisState {
+func NewGenesisState(p Params, deposits Deposits, lockups Lockups, nextLockupID uint64) GenesisState {
 	return GenesisState{
-		Params:   p,
-		Deposits: deposits,
+		Params:       p,
+		Deposits:     deposits,
+		Lockups:      lockups,
+		NextLockupID: nextLockupID,
 	}
 }
 
@@ -13,6 +24,8 @@ func DefaultGenesisState() GenesisState {
 	return NewGenesisState(
 		DefaultParams(),
 		Deposits{},
+		Lockups{},
+		DefaultNextLockupID,
 	)
 }
 
@@ -23,5 +36,31 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
-	return gs.Deposits.Validate()
+	if err := gs.Deposits.Validate(); err != nil {
+		return err
+	}
+
+	if err := gs.Lockups.Validate(); err != nil {
+		return err
+	}
+
+	depositShares := make(map[string]sdk.Coins)
+	for _, deposit := range gs.Deposits {
+		depositShares[deposit.Depositor.String()] = deposit.Amount
+	}
+
+	lockedShares := make(map[string]sdk.Coins)
+	for _, lockup := range gs.Lockups {
+		if lockup.ID >= gs.NextLockupID {
+			return fmt.Errorf("lockup id %d must be less than the next lockup id %d", lockup.ID, gs.NextLockupID)
+		}
+
+		depositor := lockup.Depositor.String()
+		lockedShares[depositor] = lockedShares[depositor].Add(lockup.Amount...)
+		if !depositShares[depositor].IsAllGTE(lockedShares[depositor]) {
+			return fmt.Errorf("lockups of %s exceed the deposit of %s", lockedShares[depositor], depositor)
+		}
+	}
+
+	return nil
 }
@@ -26,8 +26,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the savings module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of the module.
-	Params   Params   `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	Deposits Deposits `protobuf:"bytes,2,rep,name=deposits,proto3,castrepeated=Deposits" json:"deposits"`
+	Params       Params   `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Deposits     Deposits `protobuf:"bytes,2,rep,name=deposits,proto3,castrepeated=Deposits" json:"deposits"`
+	Lockups      Lockups  `protobuf:"bytes,3,rep,name=lockups,proto3,castrepeated=Lockups" json:"lockups"`
+	NextLockupID uint64   `protobuf:"varint,4,opt,name=next_lockup_id,json=nextLockupId,proto3" json:"next_lockup_id,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -77,6 +79,20 @@ func (m *GenesisState) GetDeposits() Deposits {
 	return nil
 }
 
+func (m *GenesisState) GetLockups() Lockups {
+	if m != nil {
+		return m.Lockups
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextLockupID() uint64 {
+	if m != nil {
+		return m.NextLockupID
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "kava.savings.v1beta1.GenesisState")
 }
@@ -86,23 +102,28 @@ func init() {
 }
 
 var fileDescriptor_f5dcde4d417fcec8 = []byte{
-	// 245 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xca, 0x4e, 0x2c, 0x4b,
-	0xd4, 0x2f, 0x4e, 0x2c, 0xcb, 0xcc, 0x4b, 0x2f, 0xd6, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34,
-	0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12,
-	0x01, 0xa9, 0xd1, 0x83, 0xaa, 0xd1, 0x83, 0xaa, 0x91, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b,
-	0xd0, 0x07, 0xb1, 0x20, 0x6a, 0xa5, 0x14, 0xb0, 0x9a, 0x57, 0x5c, 0x92, 0x5f, 0x94, 0x0a, 0x51,
-	0xa1, 0x34, 0x9d, 0x91, 0x8b, 0xc7, 0x1d, 0x62, 0x7e, 0x70, 0x49, 0x62, 0x49, 0xaa, 0x90, 0x15,
-	0x17, 0x5b, 0x41, 0x62, 0x51, 0x62, 0x6e, 0xb1, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0x8c,
-	0x1e, 0x36, 0xfb, 0xf4, 0x02, 0xc0, 0x6a, 0x9c, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08, 0x82, 0xea,
-	0x10, 0xf2, 0xe6, 0xe2, 0x48, 0x49, 0x2d, 0xc8, 0x2f, 0xce, 0x2c, 0x29, 0x96, 0x60, 0x52, 0x60,
-	0xd6, 0xe0, 0x36, 0x92, 0xc5, 0xae, 0xdb, 0x05, 0xa2, 0xca, 0x49, 0x00, 0xa4, 0x7d, 0xd5, 0x7d,
-	0x79, 0x0e, 0xa8, 0x40, 0x71, 0x10, 0xdc, 0x00, 0x27, 0xe7, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c,
-	0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e,
-	0x3c, 0x96, 0x63, 0x88, 0xd2, 0x4c, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5,
-	0x07, 0x19, 0xaf, 0x9b, 0x93, 0x98, 0x54, 0x0c, 0x66, 0xe9, 0x57, 0xc0, 0x3d, 0x5b, 0x52, 0x59,
-	0x90, 0x5a, 0x9c, 0xc4, 0x06, 0xf6, 0xa5, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xb9, 0x68, 0xf5,
-	0x36, 0x59, 0x01, 0x00, 0x00,
+	// 321 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0xb1, 0x4e, 0xc2, 0x40,
+	0x18, 0xc7, 0x5b, 0x20, 0x40, 0x0e, 0xa2, 0xa4, 0x61, 0x68, 0x88, 0x1e, 0x95, 0x09, 0x07, 0xef,
+	0x02, 0x26, 0x0e, 0x8e, 0x95, 0x84, 0x18, 0x8d, 0x31, 0x75, 0x73, 0x21, 0x57, 0xb8, 0xd4, 0x06,
+	0xe8, 0x35, 0x7c, 0x07, 0xc1, 0xb7, 0x70, 0xf4, 0x19, 0x7c, 0x12, 0x46, 0x46, 0x27, 0x34, 0xe5,
+	0x45, 0x4c, 0x7b, 0x27, 0x2e, 0x75, 0xfb, 0xbe, 0xcb, 0xef, 0xf7, 0xcf, 0xff, 0x3e, 0xd4, 0x99,
+	0xb2, 0x15, 0xa3, 0xc0, 0x56, 0x61, 0x14, 0x00, 0x5d, 0xf5, 0x7c, 0x2e, 0x59, 0x8f, 0x06, 0x3c,
+	0xe2, 0x10, 0x02, 0x89, 0x17, 0x42, 0x0a, 0xab, 0x99, 0x32, 0x44, 0x33, 0x44, 0x33, 0xad, 0x66,
+	0x20, 0x02, 0x91, 0x01, 0x34, 0x9d, 0x14, 0xdb, 0x3a, 0xcb, 0xcd, 0x9b, 0x89, 0xf1, 0x74, 0x19,
+	0x6b, 0xc4, 0xc9, 0x45, 0x40, 0x8a, 0x05, 0x57, 0x44, 0xe7, 0xbd, 0x80, 0xea, 0x43, 0x55, 0xe1,
+	0x49, 0x32, 0xc9, 0xad, 0x6b, 0x54, 0x8e, 0xd9, 0x82, 0xcd, 0xc1, 0x36, 0x1d, 0xb3, 0x5b, 0xeb,
+	0x9f, 0x90, 0xbc, 0x4a, 0xe4, 0x31, 0x63, 0xdc, 0xd2, 0x66, 0xd7, 0x36, 0x3c, 0x6d, 0x58, 0x77,
+	0xa8, 0x3a, 0xe1, 0xb1, 0x80, 0x50, 0x82, 0x5d, 0x70, 0x8a, 0xdd, 0x5a, 0xff, 0x34, 0xdf, 0x1e,
+	0x28, 0xca, 0x6d, 0xa4, 0xfa, 0xc7, 0x57, 0xbb, 0xaa, 0x1f, 0xc0, 0x3b, 0x04, 0x58, 0x43, 0x54,
+	0x51, 0x7f, 0x01, 0xbb, 0xe8, 0x14, 0xff, 0x6f, 0x72, 0x9f, 0x41, 0xee, 0xb1, 0x8e, 0xaa, 0xa8,
+	0x1d, 0xbc, 0x5f, 0xdb, 0xba, 0x42, 0x47, 0x11, 0x5f, 0xcb, 0x91, 0xda, 0x47, 0xe1, 0xc4, 0x2e,
+	0x39, 0x66, 0xb7, 0xe4, 0x36, 0x92, 0x5d, 0xbb, 0xfe, 0xc0, 0xd7, 0x52, 0x19, 0xb7, 0x03, 0xaf,
+	0x1e, 0xfd, 0x6d, 0x13, 0xf7, 0x66, 0x93, 0x60, 0x73, 0x9b, 0x60, 0xf3, 0x3b, 0xc1, 0xe6, 0xdb,
+	0x1e, 0x1b, 0xdb, 0x3d, 0x36, 0x3e, 0xf7, 0xd8, 0x78, 0x3e, 0x0f, 0x42, 0xf9, 0xb2, 0xf4, 0xc9,
+	0x58, 0xcc, 0x69, 0xda, 0xe9, 0x62, 0xc6, 0x7c, 0xc8, 0x26, 0xba, 0x3e, 0x5c, 0x5b, 0xbe, 0xc6,
+	0x1c, 0xfc, 0x72, 0x76, 0xe6, 0xcb, 0x9f, 0x01, 0x00, 0xbe, 0x5e, 0xb7, 0x9e, 0xfd, 0x01, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -125,6 +146,25 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NextLockupID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextLockupID))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Lockups) > 0 {
+		for iNdEx := len(m.Lockups) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Lockups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Deposits) > 0 {
 		for iNdEx := len(m.Deposits) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -177,6 +217,15 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Lockups) > 0 {
+		for _, e := range m.Lockups {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextLockupID != 0 {
+		n += 1 + sovGenesis(uint64(m.NextLockupID))
+	}
 	return n
 }
 
@@ -282,6 +331,59 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lockups", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lockups = append(m.Lockups, Lockup{})
+			if err := m.Lockups[len(m.Lockups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextLockupID", wireType)
+			}
+			m.NextLockupID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextLockupID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,5 +1,12 @@
 package types
 
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	// ModuleName The name that will be used throughout the module
 	ModuleName = "savings"
@@ -17,4 +24,32 @@ const (
 	ModuleAccountName = ModuleName
 )
 
-var DepositsKeyPrefix = []byte{0x01}
+var (
+	DepositsKeyPrefix          = []byte{0x01}
+	TotalSharesKeyPrefix       = []byte{0x02}
+	LockupsKeyPrefix           = []byte{0x03}
+	LockupsByDepositorPrefix   = []byte{0x04}
+	LockupQueueKeyPrefix       = []byte{0x05}
+	TotalLockupBoostsKeyPrefix = []byte{0x06}
+	NextLockupIDKey            = []byte{0x07}
+)
+
+// LockupKey returns the key for a lockup
+func LockupKey(id uint64) []byte {
+	return sdk.Uint64ToBigEndian(id)
+}
+
+// LockupsByDepositorKey returns the key prefix for the lockups of a depositor
+func LockupsByDepositorKey(depositor sdk.AccAddress) []byte {
+	return address.MustLengthPrefix(depositor)
+}
+
+// LockupByDepositorKey returns the key for a lockup in the depositor index
+func LockupByDepositorKey(depositor sdk.AccAddress, id uint64) []byte {
+	return append(LockupsByDepositorKey(depositor), sdk.Uint64ToBigEndian(id)...)
+}
+
+// LockupQueueKey returns the key for a lockup in the end time index
+func LockupQueueKey(endTime time.Time, id uint64) []byte {
+	return append(sdk.FormatTimeBytes(endTime), sdk.Uint64ToBigEndian(id)...)
+}
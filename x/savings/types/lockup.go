@@ -0,0 +1,122 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewLockupTier returns a new LockupTier
+func NewLockupTier(duration time.Duration, rewardMultiplier, earlyWithdrawalPenalty sdk.Dec) LockupTier {
+	return LockupTier{
+		Duration:               duration,
+		RewardMultiplier:       rewardMultiplier,
+		EarlyWithdrawalPenalty: earlyWithdrawalPenalty,
+	}
+}
+
+// Validate checks the lockup tier has a positive duration, a reward multiplier of at least 1, and a penalty
+// between 0 and 1.
+func (t LockupTier) Validate() error {
+	if t.Duration <= 0 {
+		return fmt.Errorf("lockup duration must be positive, got %s", t.Duration)
+	}
+
+	return validateLockupTerms(t.RewardMultiplier, t.EarlyWithdrawalPenalty)
+}
+
+// LockupTiers is a slice of LockupTier
+type LockupTiers []LockupTier
+
+// Validate checks each lockup tier is valid and each duration has at most one tier.
+func (ts LockupTiers) Validate() error {
+	seenDurations := make(map[time.Duration]bool)
+	for _, t := range ts {
+		if err := t.Validate(); err != nil {
+			return err
+		}
+
+		if seenDurations[t.Duration] {
+			return fmt.Errorf("duplicated lockup duration %s", t.Duration)
+		}
+		seenDurations[t.Duration] = true
+	}
+
+	return nil
+}
+
+// Get returns the lockup tier with a duration.
+func (ts LockupTiers) Get(duration time.Duration) (LockupTier, bool) {
+	for _, t := range ts {
+		if t.Duration == duration {
+			return t, true
+		}
+	}
+
+	return LockupTier{}, false
+}
+
+// NewLockup returns a new Lockup with the terms of a lockup tier, ending after the tier's duration.
+func NewLockup(id uint64, depositor sdk.AccAddress, amount sdk.Coins, tier LockupTier, startTime time.Time) Lockup {
+	return Lockup{
+		ID:                     id,
+		Depositor:              depositor,
+		Amount:                 amount,
+		RewardMultiplier:       tier.RewardMultiplier,
+		EarlyWithdrawalPenalty: tier.EarlyWithdrawalPenalty,
+		EndTime:                startTime.Add(tier.Duration),
+	}
+}
+
+// Validate lockup validation
+func (l Lockup) Validate() error {
+	if l.Depositor.Empty() {
+		return fmt.Errorf("lockup %d depositor cannot be empty", l.ID)
+	}
+	if !l.Amount.IsValid() || l.Amount.IsZero() {
+		return fmt.Errorf("invalid lockup %d coins: %s", l.ID, l.Amount)
+	}
+
+	return validateLockupTerms(l.RewardMultiplier, l.EarlyWithdrawalPenalty)
+}
+
+// GetBoost returns the shares added to the locked shares when weighting them by the reward multiplier.
+func (l Lockup) GetBoost() sdk.DecCoins {
+	boost := sdk.NewDecCoins()
+	for _, coin := range l.Amount {
+		boost = boost.Add(sdk.NewDecCoinFromDec(coin.Denom, sdk.NewDecFromInt(coin.Amount).Mul(l.RewardMultiplier.Sub(sdk.OneDec()))))
+	}
+	return boost
+}
+
+// Lockups is a slice of Lockup
+type Lockups []Lockup
+
+// Validate validates Lockups
+func (ls Lockups) Validate() error {
+	seenIDs := make(map[uint64]bool)
+	for _, l := range ls {
+		if err := l.Validate(); err != nil {
+			return err
+		}
+
+		if seenIDs[l.ID] {
+			return fmt.Errorf("duplicated lockup id %d", l.ID)
+		}
+		seenIDs[l.ID] = true
+	}
+	return nil
+}
+
+func validateLockupTerms(rewardMultiplier, earlyWithdrawalPenalty sdk.Dec) error {
+	if rewardMultiplier.IsNil() || rewardMultiplier.LT(sdk.OneDec()) {
+		return fmt.Errorf("reward multiplier must be at least 1, got %s", rewardMultiplier)
+	}
+
+	if earlyWithdrawalPenalty.IsNil() || earlyWithdrawalPenalty.IsNegative() || earlyWithdrawalPenalty.GT(sdk.OneDec()) {
+		return fmt.Errorf("early withdrawal penalty must be between 0 and 1, got %s", earlyWithdrawalPenalty)
+	}
+
+	return nil
+}
@@ -0,0 +1,826 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: kava/savings/v1beta1/lockup.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// LockupTier defines a fixed term savings deposits can be locked for.
+type LockupTier struct {
+	// duration is the length of the term.
+	Duration time.Duration `protobuf:"bytes,1,opt,name=duration,proto3,stdduration" json:"duration"`
+	// reward_multiplier weights the locked shares when distributing savings rewards, at least 1.
+	RewardMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=reward_multiplier,json=rewardMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_multiplier"`
+	// early_withdrawal_penalty is the fraction of the locked value sent to the community pool when withdrawing
+	// before the end of the term, between 0 and 1.
+	EarlyWithdrawalPenalty github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=early_withdrawal_penalty,json=earlyWithdrawalPenalty,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"early_withdrawal_penalty"`
+}
+
+func (m *LockupTier) Reset()         { *m = LockupTier{} }
+func (m *LockupTier) String() string { return proto.CompactTextString(m) }
+func (*LockupTier) ProtoMessage()    {}
+func (*LockupTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92f0b8901ffbac07, []int{0}
+}
+func (m *LockupTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockupTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockupTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockupTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockupTier.Merge(m, src)
+}
+func (m *LockupTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockupTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockupTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockupTier proto.InternalMessageInfo
+
+// Lockup locks some of a depositor's savings shares until the end of a fixed term.
+// The terms of the lockup tier are copied into the lockup when it is created.
+type Lockup struct {
+	ID        uint64                                        `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Depositor github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=depositor,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"depositor,omitempty"`
+	// amount is the locked shares of each denom.
+	Amount                 github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	RewardMultiplier       github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,4,opt,name=reward_multiplier,json=rewardMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_multiplier"`
+	EarlyWithdrawalPenalty github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,5,opt,name=early_withdrawal_penalty,json=earlyWithdrawalPenalty,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"early_withdrawal_penalty"`
+	// end_time is the time the shares are unlocked.
+	EndTime time.Time `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+}
+
+func (m *Lockup) Reset()         { *m = Lockup{} }
+func (m *Lockup) String() string { return proto.CompactTextString(m) }
+func (*Lockup) ProtoMessage()    {}
+func (*Lockup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92f0b8901ffbac07, []int{1}
+}
+func (m *Lockup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Lockup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Lockup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Lockup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Lockup.Merge(m, src)
+}
+func (m *Lockup) XXX_Size() int {
+	return m.Size()
+}
+func (m *Lockup) XXX_DiscardUnknown() {
+	xxx_messageInfo_Lockup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Lockup proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*LockupTier)(nil), "kava.savings.v1beta1.LockupTier")
+	proto.RegisterType((*Lockup)(nil), "kava.savings.v1beta1.Lockup")
+}
+
+func init() { proto.RegisterFile("kava/savings/v1beta1/lockup.proto", fileDescriptor_92f0b8901ffbac07) }
+
+var fileDescriptor_92f0b8901ffbac07 = []byte{
+	// 519 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0xbf, 0x6e, 0xd4, 0x30,
+	0x18, 0xbf, 0xe4, 0x8e, 0xd0, 0x9a, 0x05, 0x42, 0x55, 0xa5, 0x37, 0x24, 0x47, 0x07, 0x74, 0x0c,
+	0x49, 0x28, 0xac, 0x48, 0xa8, 0xe1, 0x24, 0x40, 0x02, 0x09, 0x45, 0x95, 0x90, 0x58, 0x22, 0x27,
+	0x76, 0x53, 0xeb, 0x92, 0x38, 0x8a, 0x9d, 0x3b, 0xee, 0x2d, 0xca, 0xc6, 0x33, 0x30, 0x31, 0xf4,
+	0x21, 0x6e, 0xac, 0x3a, 0x21, 0x86, 0x2b, 0xdc, 0xbd, 0x05, 0x13, 0xb2, 0xe3, 0x04, 0x04, 0x0c,
+	0x1d, 0x80, 0xc9, 0xfe, 0xfe, 0xfd, 0xfc, 0xf3, 0xf7, 0xfd, 0x6c, 0x70, 0x67, 0x0a, 0x67, 0xd0,
+	0x67, 0x70, 0x46, 0x8a, 0x94, 0xf9, 0xb3, 0x83, 0x18, 0x73, 0x78, 0xe0, 0x67, 0x34, 0x99, 0xd6,
+	0xa5, 0x57, 0x56, 0x94, 0x53, 0x73, 0x47, 0xa4, 0x78, 0x2a, 0xc5, 0x53, 0x29, 0x43, 0x3b, 0xa1,
+	0x2c, 0xa7, 0xcc, 0x8f, 0x21, 0xc3, 0x5d, 0x5d, 0x42, 0x49, 0xd1, 0x54, 0x0d, 0xf7, 0x9a, 0x78,
+	0x24, 0x2d, 0xbf, 0x31, 0x54, 0x68, 0x27, 0xa5, 0x29, 0x6d, 0xfc, 0x62, 0xa7, 0xbc, 0x76, 0x4a,
+	0x69, 0x9a, 0x61, 0x5f, 0x5a, 0x71, 0x7d, 0xec, 0xa3, 0xba, 0x82, 0x9c, 0xd0, 0x16, 0xd0, 0xf9,
+	0x35, 0xce, 0x49, 0x8e, 0x19, 0x87, 0xb9, 0xe2, 0xb9, 0xff, 0x51, 0x07, 0xe0, 0x85, 0x24, 0x7e,
+	0x44, 0x70, 0x65, 0x3e, 0x06, 0x5b, 0x2d, 0x82, 0xa5, 0x8d, 0xb4, 0xf1, 0x8d, 0x07, 0x7b, 0x5e,
+	0x03, 0xe1, 0xb5, 0x10, 0xde, 0x44, 0x25, 0x04, 0x5b, 0xcb, 0x95, 0xd3, 0x7b, 0x7f, 0xe9, 0x68,
+	0x61, 0x57, 0x64, 0x12, 0x70, 0xab, 0xc2, 0x73, 0x58, 0xa1, 0x28, 0xaf, 0x33, 0x4e, 0xca, 0x8c,
+	0xe0, 0xca, 0xd2, 0x47, 0xda, 0x78, 0x3b, 0x78, 0x24, 0xd2, 0x3f, 0xaf, 0x9c, 0xbb, 0x29, 0xe1,
+	0x27, 0x75, 0xec, 0x25, 0x34, 0x57, 0x57, 0x54, 0x8b, 0xcb, 0xd0, 0xd4, 0xe7, 0x8b, 0x12, 0x33,
+	0x6f, 0x82, 0x93, 0x8b, 0x33, 0x17, 0xa8, 0x0e, 0x4c, 0x70, 0x12, 0xde, 0x6c, 0x60, 0x5f, 0x76,
+	0xa8, 0xe6, 0x0c, 0x58, 0x18, 0x56, 0xd9, 0x22, 0x9a, 0x13, 0x7e, 0x82, 0x2a, 0x38, 0x87, 0x59,
+	0x54, 0xe2, 0x02, 0x66, 0x7c, 0x61, 0xf5, 0xff, 0xc2, 0x89, 0xbb, 0x12, 0xfd, 0x75, 0x07, 0xfe,
+	0xaa, 0xc1, 0xde, 0x7f, 0x37, 0x00, 0x46, 0xd3, 0x32, 0x73, 0x17, 0xe8, 0x04, 0xc9, 0x46, 0x0d,
+	0x02, 0x63, 0xbd, 0x72, 0xf4, 0xe7, 0x93, 0x50, 0x27, 0xc8, 0x3c, 0x06, 0xdb, 0x08, 0x97, 0x94,
+	0x11, 0x4e, 0xdb, 0xdb, 0x3f, 0xfb, 0xb6, 0x72, 0xdc, 0x2b, 0xf0, 0x38, 0x4c, 0x92, 0x43, 0x84,
+	0x2a, 0xcc, 0xd8, 0xc5, 0x99, 0x7b, 0x5b, 0xd1, 0x51, 0x9e, 0x60, 0xc1, 0x31, 0x0b, 0x7f, 0x40,
+	0x9b, 0x09, 0x30, 0x60, 0x4e, 0xeb, 0x82, 0x5b, 0xfd, 0x51, 0x5f, 0x0e, 0x4b, 0x15, 0x08, 0x81,
+	0xb5, 0xaa, 0xf3, 0x9e, 0x50, 0x52, 0x04, 0xf7, 0x45, 0x2f, 0x3e, 0x5c, 0x3a, 0xe3, 0x2b, 0x70,
+	0x10, 0x05, 0x2c, 0x54, 0xd0, 0x7f, 0x1e, 0xe9, 0xe0, 0xbf, 0x8f, 0xf4, 0xda, 0xbf, 0x1b, 0xa9,
+	0x90, 0x3d, 0x2e, 0x50, 0x24, 0x1e, 0x87, 0x65, 0x48, 0xd9, 0x0f, 0x7f, 0x93, 0xfd, 0x51, 0xfb,
+	0x72, 0x1a, 0xdd, 0x9f, 0x0a, 0xdd, 0x5f, 0xc7, 0x05, 0x12, 0xfe, 0xe0, 0xe9, 0xf2, 0xab, 0xdd,
+	0x5b, 0xae, 0x6d, 0xed, 0x7c, 0x6d, 0x6b, 0x5f, 0xd6, 0xb6, 0x76, 0xba, 0xb1, 0x7b, 0xe7, 0x1b,
+	0xbb, 0xf7, 0x69, 0x63, 0xf7, 0xde, 0xdc, 0xfb, 0x89, 0xac, 0xf8, 0x17, 0xdc, 0x0c, 0xc6, 0x4c,
+	0xee, 0xfc, 0xb7, 0xdd, 0x37, 0x22, 0x39, 0xc7, 0x86, 0x3c, 0xef, 0xe1, 0xf7, 0x01, 0x00, 0xfe,
+	0x2a, 0xd3, 0x1d, 0x63, 0x04, 0x00, 0x00,
+}
+
+func (m *LockupTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockupTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockupTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.EarlyWithdrawalPenalty.Size()
+		i -= size
+		if _, err := m.EarlyWithdrawalPenalty.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLockup(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.RewardMultiplier.Size()
+		i -= size
+		if _, err := m.RewardMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLockup(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintLockup(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Lockup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Lockup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Lockup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintLockup(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.EarlyWithdrawalPenalty.Size()
+		i -= size
+		if _, err := m.EarlyWithdrawalPenalty.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLockup(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.RewardMultiplier.Size()
+		i -= size
+		if _, err := m.RewardMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLockup(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLockup(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintLockup(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintLockup(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLockup(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLockup(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *LockupTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovLockup(uint64(l))
+	l = m.RewardMultiplier.Size()
+	n += 1 + l + sovLockup(uint64(l))
+	l = m.EarlyWithdrawalPenalty.Size()
+	n += 1 + l + sovLockup(uint64(l))
+	return n
+}
+
+func (m *Lockup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovLockup(uint64(m.ID))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovLockup(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovLockup(uint64(l))
+		}
+	}
+	l = m.RewardMultiplier.Size()
+	n += 1 + l + sovLockup(uint64(l))
+	l = m.EarlyWithdrawalPenalty.Size()
+	n += 1 + l + sovLockup(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovLockup(uint64(l))
+	return n
+}
+
+func sovLockup(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLockup(x uint64) (n int) {
+	return sovLockup(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *LockupTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLockup
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockupTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockupTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLockup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLockup
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLockup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLockup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLockup
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLockup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarlyWithdrawalPenalty", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLockup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLockup
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLockup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EarlyWithdrawalPenalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLockup(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLockup
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Lockup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLockup
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Lockup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Lockup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLockup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLockup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLockup
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLockup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = github_com_cosmos_cosmos_sdk_types.AccAddress(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLockup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLockup
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLockup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLockup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLockup
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLockup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarlyWithdrawalPenalty", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLockup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLockup
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLockup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EarlyWithdrawalPenalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLockup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLockup
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLockup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLockup(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLockup
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipLockup(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowLockup
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLockup
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLockup
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthLockup
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupLockup
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthLockup
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthLockup        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowLockup          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupLockup = fmt.Errorf("proto: unexpected end of group")
+)
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -10,6 +12,8 @@ import (
 var (
 	_ sdk.Msg = &MsgDeposit{}
 	_ sdk.Msg = &MsgWithdraw{}
+	_ sdk.Msg = &MsgLockDeposit{}
+	_ sdk.Msg = &MsgWithdrawLockup{}
 )
 
 // NewMsgDeposit returns a new MsgDeposit
@@ -95,3 +99,88 @@ func (msg MsgWithdraw) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{depositor}
 }
+
+// NewMsgLockDeposit returns a new MsgLockDeposit
+func NewMsgLockDeposit(depositor sdk.AccAddress, amount sdk.Coins, duration time.Duration) MsgLockDeposit {
+	return MsgLockDeposit{
+		Depositor: depositor.String(),
+		Amount:    amount,
+		Duration:  duration,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgLockDeposit) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgLockDeposit) Type() string { return "savings_lock_deposit" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgLockDeposit) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "deposit amount %s", msg.Amount)
+	}
+
+	if msg.Duration <= 0 {
+		return errorsmod.Wrapf(ErrInvalidLockupDuration, "duration must be positive, got %s", msg.Duration)
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgLockDeposit) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgLockDeposit) GetSigners() []sdk.AccAddress {
+	depositor, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{depositor}
+}
+
+// NewMsgWithdrawLockup returns a new MsgWithdrawLockup
+func NewMsgWithdrawLockup(depositor sdk.AccAddress, lockupID uint64) MsgWithdrawLockup {
+	return MsgWithdrawLockup{
+		Depositor: depositor.String(),
+		LockupID:  lockupID,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgWithdrawLockup) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgWithdrawLockup) Type() string { return "savings_withdraw_lockup" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgWithdrawLockup) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgWithdrawLockup) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgWithdrawLockup) GetSigners() []sdk.AccAddress {
+	depositor, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{depositor}
+}
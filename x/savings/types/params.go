@@ -9,19 +9,25 @@ import (
 // Parameter keys
 var (
 	KeySupportedDenoms     = []byte("SupportedDenoms")
+	KeyStrategies          = []byte("Strategies")
+	KeyLockupTiers         = []byte("LockupTiers")
 	DefaultSupportedDenoms = []string{}
+	DefaultStrategies      = StrategyAllocations{}
+	DefaultLockupTiers     = LockupTiers{}
 )
 
 // NewParams creates a new Params object
-func NewParams(supportedDenoms []string) Params {
+func NewParams(supportedDenoms []string, strategies StrategyAllocations, lockupTiers LockupTiers) Params {
 	return Params{
 		SupportedDenoms: supportedDenoms,
+		Strategies:      strategies,
+		LockupTiers:     lockupTiers,
 	}
 }
 
 // DefaultParams default params for savings
 func DefaultParams() Params {
-	return NewParams(DefaultSupportedDenoms)
+	return NewParams(DefaultSupportedDenoms, DefaultStrategies, DefaultLockupTiers)
 }
 
 // ParamKeyTable Key declaration for parameters
@@ -34,12 +40,22 @@ func ParamKeyTable() paramtypes.KeyTable {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeySupportedDenoms, &p.SupportedDenoms, validateSupportedDenoms),
+		paramtypes.NewParamSetPair(KeyStrategies, &p.Strategies, validateStrategies),
+		paramtypes.NewParamSetPair(KeyLockupTiers, &p.LockupTiers, validateLockupTiers),
 	}
 }
 
 // Validate ensure that params have valid values
 func (p Params) Validate() error {
-	return validateSupportedDenoms(p.SupportedDenoms)
+	if err := validateSupportedDenoms(p.SupportedDenoms); err != nil {
+		return err
+	}
+
+	if err := validateStrategies(p.Strategies); err != nil {
+		return err
+	}
+
+	return validateLockupTiers(p.LockupTiers)
 }
 
 func validateSupportedDenoms(i interface{}) error {
@@ -57,3 +73,21 @@ func validateSupportedDenoms(i interface{}) error {
 	}
 	return nil
 }
+
+func validateStrategies(i interface{}) error {
+	strategies, ok := i.(StrategyAllocations)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return strategies.Validate()
+}
+
+func validateLockupTiers(i interface{}) error {
+	lockupTiers, ok := i.(LockupTiers)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return lockupTiers.Validate()
+}
@@ -5,6 +5,7 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
@@ -318,6 +319,256 @@ func (m *QueryTotalSupplyResponse) GetResult() github_com_cosmos_cosmos_sdk_type
 	return nil
 }
 
+// QueryPoolsRequest defines the request type for the Query/Pools method.
+type QueryPoolsRequest struct {
+	// denom filters the pools by deposit denom, optional.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryPoolsRequest) Reset()         { *m = QueryPoolsRequest{} }
+func (m *QueryPoolsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolsRequest) ProtoMessage()    {}
+func (*QueryPoolsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f78c91efc5db144f, []int{6}
+}
+func (m *QueryPoolsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolsRequest.Merge(m, src)
+}
+func (m *QueryPoolsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolsRequest proto.InternalMessageInfo
+
+func (m *QueryPoolsRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// PoolResponse defines the shares and value of the deposits of a denom.
+type PoolResponse struct {
+	// denom is the deposit denom of the pool.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// total_shares is the sum of all depositors' shares.
+	TotalShares cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=total_shares,json=totalShares,proto3,customtype=cosmossdk.io/math.Int" json:"total_shares"`
+	// total_value is the amount of coins the shares are worth, including yield.
+	TotalValue cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=total_value,json=totalValue,proto3,customtype=cosmossdk.io/math.Int" json:"total_value"`
+	// strategy_value is the portion of the total value held in a yield strategy.
+	StrategyValue cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=strategy_value,json=strategyValue,proto3,customtype=cosmossdk.io/math.Int" json:"strategy_value"`
+}
+
+func (m *PoolResponse) Reset()         { *m = PoolResponse{} }
+func (m *PoolResponse) String() string { return proto.CompactTextString(m) }
+func (*PoolResponse) ProtoMessage()    {}
+func (*PoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f78c91efc5db144f, []int{7}
+}
+func (m *PoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolResponse.Merge(m, src)
+}
+func (m *PoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolResponse proto.InternalMessageInfo
+
+func (m *PoolResponse) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryPoolsResponse defines the response type for the Query/Pools method.
+type QueryPoolsResponse struct {
+	Pools []PoolResponse `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools"`
+}
+
+func (m *QueryPoolsResponse) Reset()         { *m = QueryPoolsResponse{} }
+func (m *QueryPoolsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolsResponse) ProtoMessage()    {}
+func (*QueryPoolsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f78c91efc5db144f, []int{8}
+}
+func (m *QueryPoolsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolsResponse.Merge(m, src)
+}
+func (m *QueryPoolsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolsResponse proto.InternalMessageInfo
+
+func (m *QueryPoolsResponse) GetPools() []PoolResponse {
+	if m != nil {
+		return m.Pools
+	}
+	return nil
+}
+
+// QueryLockupsRequest defines the request type for the Query/Lockups method.
+type QueryLockupsRequest struct {
+	// owner filters the lockups by depositor, optional.
+	Owner      string             `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryLockupsRequest) Reset()         { *m = QueryLockupsRequest{} }
+func (m *QueryLockupsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLockupsRequest) ProtoMessage()    {}
+func (*QueryLockupsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f78c91efc5db144f, []int{9}
+}
+func (m *QueryLockupsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLockupsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLockupsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLockupsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLockupsRequest.Merge(m, src)
+}
+func (m *QueryLockupsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLockupsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLockupsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLockupsRequest proto.InternalMessageInfo
+
+func (m *QueryLockupsRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QueryLockupsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryLockupsResponse defines the response type for the Query/Lockups method.
+type QueryLockupsResponse struct {
+	Lockups    Lockups             `protobuf:"bytes,1,rep,name=lockups,proto3,castrepeated=Lockups" json:"lockups"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryLockupsResponse) Reset()         { *m = QueryLockupsResponse{} }
+func (m *QueryLockupsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLockupsResponse) ProtoMessage()    {}
+func (*QueryLockupsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f78c91efc5db144f, []int{10}
+}
+func (m *QueryLockupsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLockupsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLockupsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLockupsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLockupsResponse.Merge(m, src)
+}
+func (m *QueryLockupsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLockupsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLockupsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLockupsResponse proto.InternalMessageInfo
+
+func (m *QueryLockupsResponse) GetLockups() Lockups {
+	if m != nil {
+		return m.Lockups
+	}
+	return nil
+}
+
+func (m *QueryLockupsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "kava.savings.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "kava.savings.v1beta1.QueryParamsResponse")
@@ -325,51 +576,72 @@ func init() {
 	proto.RegisterType((*QueryDepositsResponse)(nil), "kava.savings.v1beta1.QueryDepositsResponse")
 	proto.RegisterType((*QueryTotalSupplyRequest)(nil), "kava.savings.v1beta1.QueryTotalSupplyRequest")
 	proto.RegisterType((*QueryTotalSupplyResponse)(nil), "kava.savings.v1beta1.QueryTotalSupplyResponse")
+	proto.RegisterType((*QueryPoolsRequest)(nil), "kava.savings.v1beta1.QueryPoolsRequest")
+	proto.RegisterType((*PoolResponse)(nil), "kava.savings.v1beta1.PoolResponse")
+	proto.RegisterType((*QueryPoolsResponse)(nil), "kava.savings.v1beta1.QueryPoolsResponse")
+	proto.RegisterType((*QueryLockupsRequest)(nil), "kava.savings.v1beta1.QueryLockupsRequest")
+	proto.RegisterType((*QueryLockupsResponse)(nil), "kava.savings.v1beta1.QueryLockupsResponse")
 }
 
 func init() { proto.RegisterFile("kava/savings/v1beta1/query.proto", fileDescriptor_f78c91efc5db144f) }
 
 var fileDescriptor_f78c91efc5db144f = []byte{
-	// 619 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xb1, 0x8f, 0x12, 0x41,
-	0x14, 0xc6, 0x19, 0x38, 0xc8, 0x39, 0x34, 0x66, 0x5c, 0x75, 0x21, 0xb8, 0x90, 0xcd, 0xe5, 0xe4,
-	0x30, 0xec, 0x7a, 0xd8, 0x5d, 0x27, 0x67, 0xb4, 0xb0, 0xd1, 0x3d, 0x13, 0x13, 0x9b, 0xcb, 0x00,
-	0x93, 0x65, 0x73, 0xb0, 0xb3, 0xb7, 0x33, 0x8b, 0xd2, 0x6a, 0x63, 0x62, 0x63, 0x62, 0xa1, 0xa5,
-	0x85, 0x95, 0x89, 0x9d, 0xf1, 0x6f, 0xb8, 0xf2, 0xa2, 0x8d, 0x95, 0x1a, 0xf0, 0x0f, 0x31, 0x3b,
-	0x33, 0xbb, 0x07, 0xc7, 0xe6, 0xa4, 0x82, 0x79, 0xf3, 0x7d, 0xdf, 0xfc, 0xe6, 0xcd, 0x03, 0xd8,
-	0x38, 0xc2, 0x13, 0x6c, 0x33, 0x3c, 0xf1, 0x7c, 0x97, 0xd9, 0x93, 0xdd, 0x1e, 0xe1, 0x78, 0xd7,
-	0x3e, 0x8e, 0x48, 0x38, 0xb5, 0x82, 0x90, 0x72, 0x8a, 0xb4, 0x58, 0x61, 0x29, 0x85, 0xa5, 0x14,
-	0xd5, 0x56, 0x9f, 0xb2, 0x31, 0x65, 0x76, 0x0f, 0x33, 0x22, 0xe5, 0xa9, 0x39, 0xc0, 0xae, 0xe7,
-	0x63, 0xee, 0x51, 0x5f, 0x26, 0x54, 0x8d, 0x45, 0x6d, 0xa2, 0xea, 0x53, 0x2f, 0xd9, 0xaf, 0xc8,
-	0xfd, 0x43, 0xb1, 0xb2, 0xe5, 0x42, 0x6d, 0x69, 0x2e, 0x75, 0xa9, 0xac, 0xc7, 0xdf, 0x54, 0xb5,
-	0xe6, 0x52, 0xea, 0x8e, 0x88, 0x8d, 0x03, 0xcf, 0xc6, 0xbe, 0x4f, 0xb9, 0x38, 0x2d, 0xf1, 0x64,
-	0x5f, 0x89, 0x71, 0x1a, 0x12, 0xa9, 0x30, 0x35, 0x88, 0x1e, 0xc7, 0xc8, 0x8f, 0x70, 0x88, 0xc7,
-	0xcc, 0x21, 0xc7, 0x11, 0x61, 0xdc, 0x7c, 0x0a, 0xaf, 0x2c, 0x55, 0x59, 0x40, 0x7d, 0x46, 0xd0,
-	0x1e, 0x2c, 0x05, 0xa2, 0xa2, 0x83, 0x06, 0x68, 0x96, 0x3b, 0x35, 0x2b, 0xab, 0x21, 0x96, 0x74,
-	0x75, 0x37, 0x4e, 0x7e, 0xd5, 0x73, 0x8e, 0x72, 0xec, 0x6d, 0xbc, 0xfe, 0x58, 0xcf, 0x99, 0x9f,
-	0x00, 0xd4, 0x44, 0xf2, 0x3d, 0x12, 0x50, 0xe6, 0xf1, 0xe4, 0x44, 0xa4, 0xc1, 0xe2, 0x80, 0xf8,
-	0x74, 0x2c, 0x92, 0x2f, 0x39, 0x72, 0x81, 0x2c, 0x58, 0xa4, 0xcf, 0x7d, 0x12, 0xea, 0xf9, 0xb8,
-	0xda, 0xd5, 0xbf, 0x7f, 0x6d, 0x6b, 0xaa, 0x29, 0x77, 0x07, 0x83, 0x90, 0x30, 0x76, 0xc0, 0x43,
-	0xcf, 0x77, 0x1d, 0x29, 0x43, 0xf7, 0x21, 0x3c, 0x6b, 0xb9, 0x5e, 0x10, 0x90, 0xdb, 0x96, 0x72,
-	0xc4, 0x3d, 0xb7, 0xe4, 0x73, 0x9e, 0x91, 0xba, 0x44, 0x11, 0x38, 0x0b, 0x4e, 0xf3, 0x0b, 0x80,
-	0x57, 0xcf, 0x61, 0xaa, 0x16, 0x3c, 0x84, 0x9b, 0x03, 0x55, 0xd3, 0x41, 0xa3, 0xd0, 0x2c, 0x77,
-	0x6e, 0x64, 0x37, 0x41, 0x39, 0xbb, 0x97, 0xe3, 0x2e, 0x7c, 0xfe, 0x5d, 0xdf, 0x4c, 0xa3, 0xd2,
-	0x00, 0xf4, 0x60, 0x09, 0x37, 0x2f, 0x70, 0x6f, 0xfe, 0x17, 0x57, 0x92, 0x2c, 0xf1, 0x56, 0xe0,
-	0x75, 0x81, 0xfb, 0x84, 0x72, 0x3c, 0x3a, 0x88, 0x82, 0x60, 0x34, 0x4d, 0x9e, 0xf2, 0x3d, 0x80,
-	0xfa, 0xea, 0x9e, 0xba, 0xcd, 0x35, 0x58, 0x1a, 0x12, 0xcf, 0x1d, 0x72, 0xd1, 0xf6, 0x82, 0xa3,
-	0x56, 0xa8, 0x0f, 0x4b, 0x21, 0x61, 0xd1, 0x88, 0xeb, 0x79, 0x71, 0xc7, 0xca, 0x12, 0x54, 0x82,
-	0xb3, 0x4f, 0x3d, 0xbf, 0x7b, 0x5b, 0xdd, 0xaf, 0xe9, 0x7a, 0x7c, 0x18, 0xf5, 0xac, 0x3e, 0x1d,
-	0xab, 0xb9, 0x55, 0x1f, 0x6d, 0x36, 0x38, 0xb2, 0xf9, 0x34, 0x20, 0x4c, 0x18, 0x98, 0xa3, 0xa2,
-	0x3b, 0xdf, 0x0a, 0xb0, 0x28, 0xc8, 0xd0, 0x2b, 0x00, 0x4b, 0x72, 0x68, 0x50, 0x33, 0xbb, 0x9b,
-	0xab, 0x33, 0x5a, 0xdd, 0x59, 0x43, 0x29, 0xaf, 0x69, 0x6e, 0xbd, 0xfc, 0xf1, 0xf7, 0x5d, 0xde,
-	0x40, 0x35, 0x3b, 0xf3, 0xf7, 0x20, 0x27, 0x14, 0xbd, 0x01, 0x30, 0x7d, 0x24, 0xd4, 0xba, 0x20,
-	0xfd, 0xdc, 0xec, 0x56, 0x6f, 0xad, 0xa5, 0x55, 0x2c, 0xdb, 0x82, 0xa5, 0x81, 0x8c, 0x6c, 0x96,
-	0x74, 0x36, 0x3e, 0x00, 0x58, 0x5e, 0x78, 0x32, 0xd4, 0xbe, 0xe0, 0x90, 0xd5, 0x67, 0xaf, 0x5a,
-	0xeb, 0xca, 0x15, 0x56, 0x4b, 0x60, 0x6d, 0x21, 0x33, 0x1b, 0x8b, 0xc7, 0x96, 0x43, 0x26, 0x3c,
-	0xdd, 0xfd, 0x93, 0x99, 0x01, 0x4e, 0x67, 0x06, 0xf8, 0x33, 0x33, 0xc0, 0xdb, 0xb9, 0x91, 0x3b,
-	0x9d, 0x1b, 0xb9, 0x9f, 0x73, 0x23, 0xf7, 0x6c, 0x67, 0x61, 0x08, 0xe2, 0x9c, 0xf6, 0x08, 0xf7,
-	0x98, 0x4c, 0x7c, 0x91, 0x66, 0x8a, 0x59, 0xe8, 0x95, 0xc4, 0xff, 0xcf, 0x9d, 0x7f, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0xa9, 0x76, 0x1d, 0x1f, 0x76, 0x05, 0x00, 0x00,
+	// 877 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0x8e, 0x93, 0x26, 0xbb, 0x4c, 0x96, 0x5f, 0x43, 0x16, 0xdc, 0xd0, 0x3a, 0xc1, 0x2c, 0xbb,
+	0x69, 0x56, 0xb1, 0xd9, 0x72, 0xdb, 0x03, 0x12, 0x59, 0x44, 0x55, 0x51, 0x21, 0x70, 0x2b, 0x90,
+	0xb8, 0x54, 0x93, 0x64, 0xe4, 0x58, 0x71, 0x3c, 0xae, 0x67, 0x12, 0xc8, 0x09, 0x09, 0x0e, 0x20,
+	0x21, 0x24, 0x24, 0x24, 0xe0, 0xc8, 0x81, 0x03, 0x42, 0xe2, 0xd6, 0x7f, 0x80, 0x5b, 0x8f, 0x55,
+	0xb9, 0x20, 0x0e, 0x05, 0xb5, 0xfc, 0x21, 0xc8, 0x33, 0xcf, 0x6e, 0xd2, 0xba, 0x69, 0x5a, 0x71,
+	0x4a, 0x66, 0xe6, 0x7b, 0xdf, 0x7c, 0xef, 0xcd, 0x7b, 0x9f, 0x8c, 0xea, 0x03, 0x32, 0x26, 0x36,
+	0x27, 0x63, 0x2f, 0x70, 0xb9, 0x3d, 0x7e, 0xd4, 0xa1, 0x82, 0x3c, 0xb2, 0xf7, 0x46, 0x34, 0x9a,
+	0x58, 0x61, 0xc4, 0x04, 0xc3, 0x95, 0x18, 0x61, 0x01, 0xc2, 0x02, 0x44, 0xb5, 0xd9, 0x65, 0x7c,
+	0xc8, 0xb8, 0xdd, 0x21, 0x9c, 0x2a, 0x78, 0x1a, 0x1c, 0x12, 0xd7, 0x0b, 0x88, 0xf0, 0x58, 0xa0,
+	0x18, 0xaa, 0xc6, 0x34, 0x36, 0x41, 0x75, 0x99, 0x97, 0x9c, 0x2f, 0xab, 0xf3, 0x5d, 0xb9, 0xb2,
+	0xd5, 0x02, 0x8e, 0x2a, 0x2e, 0x73, 0x99, 0xda, 0x8f, 0xff, 0xc1, 0xee, 0x8a, 0xcb, 0x98, 0xeb,
+	0x53, 0x9b, 0x84, 0x9e, 0x4d, 0x82, 0x80, 0x09, 0x79, 0x5b, 0x12, 0xf3, 0x4a, 0x66, 0x4a, 0x3e,
+	0xeb, 0x0e, 0x46, 0x21, 0x40, 0xb2, 0xb3, 0xe6, 0x82, 0x45, 0x54, 0x21, 0xcc, 0x0a, 0xc2, 0x1f,
+	0xc4, 0x59, 0xbd, 0x4f, 0x22, 0x32, 0xe4, 0x0e, 0xdd, 0x1b, 0x51, 0x2e, 0xcc, 0x8f, 0xd0, 0x0b,
+	0x33, 0xbb, 0x3c, 0x64, 0x01, 0xa7, 0xf8, 0x31, 0x2a, 0x85, 0x72, 0x47, 0xd7, 0xea, 0x5a, 0xa3,
+	0xbc, 0xbe, 0x62, 0x65, 0xd5, 0xcc, 0x52, 0x51, 0xed, 0xa5, 0x83, 0xe3, 0x5a, 0xce, 0x81, 0x88,
+	0xc7, 0x4b, 0x5f, 0xfd, 0x54, 0xcb, 0x99, 0x3f, 0x6b, 0xa8, 0x22, 0x99, 0xdf, 0xa6, 0x21, 0xe3,
+	0x9e, 0x48, 0x6e, 0xc4, 0x15, 0x54, 0xec, 0xd1, 0x80, 0x0d, 0x25, 0xf3, 0x53, 0x8e, 0x5a, 0x60,
+	0x0b, 0x15, 0xd9, 0x27, 0x01, 0x8d, 0xf4, 0x7c, 0xbc, 0xdb, 0xd6, 0x8f, 0xf6, 0x5b, 0x15, 0xa8,
+	0xdb, 0x5b, 0xbd, 0x5e, 0x44, 0x39, 0xdf, 0x16, 0x91, 0x17, 0xb8, 0x8e, 0x82, 0xe1, 0x77, 0x10,
+	0x3a, 0x7b, 0x15, 0xbd, 0x20, 0x45, 0xde, 0xb7, 0x20, 0x22, 0x7e, 0x16, 0x4b, 0xbd, 0xf8, 0x99,
+	0x52, 0x97, 0x82, 0x02, 0x67, 0x2a, 0xd2, 0xfc, 0x4d, 0x43, 0x77, 0xcf, 0xc9, 0x84, 0x12, 0xbc,
+	0x8b, 0x6e, 0xf7, 0x60, 0x4f, 0xd7, 0xea, 0x85, 0x46, 0x79, 0x7d, 0x35, 0xbb, 0x08, 0x10, 0xd9,
+	0x7e, 0x2e, 0xae, 0xc2, 0xaf, 0x7f, 0xd7, 0x6e, 0xa7, 0x54, 0x29, 0x01, 0xde, 0x98, 0x91, 0x9b,
+	0x97, 0x72, 0x1f, 0x5c, 0x29, 0x57, 0x29, 0x99, 0xd1, 0xbb, 0x8c, 0x5e, 0x92, 0x72, 0x77, 0x98,
+	0x20, 0xfe, 0xf6, 0x28, 0x0c, 0xfd, 0x49, 0xf2, 0x94, 0x3f, 0x68, 0x48, 0xbf, 0x78, 0x06, 0xd9,
+	0xbc, 0x88, 0x4a, 0x7d, 0xea, 0xb9, 0x7d, 0x21, 0xcb, 0x5e, 0x70, 0x60, 0x85, 0xbb, 0xa8, 0x14,
+	0x51, 0x3e, 0xf2, 0x85, 0x9e, 0x97, 0x39, 0x2e, 0xcf, 0x88, 0x4a, 0xe4, 0x3c, 0x61, 0x5e, 0xd0,
+	0x7e, 0x1d, 0xf2, 0x6b, 0xb8, 0x9e, 0xe8, 0x8f, 0x3a, 0x56, 0x97, 0x0d, 0xa1, 0xb5, 0xe1, 0xa7,
+	0xc5, 0x7b, 0x03, 0x5b, 0x4c, 0x42, 0xca, 0x65, 0x00, 0x77, 0x80, 0xda, 0x5c, 0x43, 0xcf, 0xab,
+	0x26, 0x63, 0xcc, 0x9f, 0xdf, 0x07, 0xe6, 0xf7, 0x79, 0x74, 0x27, 0x86, 0xa5, 0xc2, 0xb3, 0xdb,
+	0xe5, 0x3d, 0x74, 0x47, 0xc4, 0x59, 0xee, 0xf2, 0x3e, 0x89, 0x28, 0x87, 0xae, 0x79, 0x18, 0x2b,
+	0xfc, 0xeb, 0xb8, 0x76, 0x57, 0xe9, 0xe1, 0xbd, 0x81, 0xe5, 0x31, 0x7b, 0x48, 0x44, 0xdf, 0xda,
+	0x0c, 0xc4, 0xd1, 0x7e, 0x0b, 0x41, 0x72, 0x9b, 0x81, 0x70, 0xca, 0x92, 0x60, 0x5b, 0xc6, 0xe3,
+	0x2d, 0xa4, 0x96, 0xbb, 0x63, 0xe2, 0x8f, 0xa8, 0x5e, 0xb8, 0x3e, 0x1d, 0x92, 0xf1, 0x1f, 0xc6,
+	0xe1, 0xd8, 0x41, 0xcf, 0x70, 0x11, 0x11, 0x41, 0xdd, 0x09, 0x10, 0x2e, 0x5d, 0x9f, 0xf0, 0xe9,
+	0x84, 0x42, 0x72, 0x9a, 0x3b, 0x08, 0x4f, 0xd7, 0x10, 0xaa, 0xf3, 0x26, 0x2a, 0x86, 0xf1, 0x06,
+	0x74, 0xa8, 0x79, 0xc9, 0x98, 0x4e, 0x15, 0x14, 0x86, 0x55, 0x85, 0x99, 0xdf, 0x68, 0x30, 0xff,
+	0x5b, 0xd2, 0x4c, 0xd2, 0xc7, 0x49, 0xc7, 0x51, 0xbb, 0xc9, 0x38, 0xe6, 0x6f, 0x3c, 0x8e, 0xbf,
+	0x24, 0xae, 0x91, 0xea, 0x81, 0x44, 0x37, 0xd0, 0x2d, 0xe5, 0x77, 0x49, 0xaa, 0x97, 0x38, 0x92,
+	0x8a, 0x6b, 0x3f, 0x0b, 0xbd, 0x7a, 0x2b, 0xe1, 0x49, 0xa2, 0xff, 0xb7, 0x49, 0x5c, 0xff, 0xbd,
+	0x88, 0x8a, 0x52, 0x2a, 0xfe, 0x42, 0x43, 0x25, 0xe5, 0x84, 0xb8, 0x91, 0xad, 0xea, 0xa2, 0xf1,
+	0x56, 0xd7, 0x16, 0x40, 0xaa, 0x5b, 0xcd, 0x7b, 0x9f, 0xff, 0xf1, 0xef, 0x77, 0x79, 0x03, 0xaf,
+	0xd8, 0x99, 0x26, 0xaf, 0x6c, 0x17, 0x7f, 0xad, 0xa1, 0xd4, 0x79, 0x70, 0x73, 0x0e, 0xfb, 0x39,
+	0x43, 0xae, 0x3e, 0x5c, 0x08, 0x0b, 0x5a, 0xee, 0x4b, 0x2d, 0x75, 0x6c, 0x64, 0x6b, 0x49, 0x0d,
+	0xef, 0x47, 0x0d, 0x95, 0xa7, 0x7c, 0x08, 0xb7, 0xe6, 0x5c, 0x72, 0xd1, 0xcb, 0xaa, 0xd6, 0xa2,
+	0x70, 0x90, 0xd5, 0x94, 0xb2, 0xee, 0x61, 0x33, 0x5b, 0x16, 0x78, 0x85, 0x92, 0xf2, 0x19, 0x2a,
+	0xca, 0x21, 0xc2, 0x0f, 0xe6, 0x3d, 0xc1, 0x94, 0x55, 0x55, 0x1b, 0x57, 0x03, 0x41, 0xc7, 0xab,
+	0x52, 0xc7, 0x2a, 0x7e, 0xf9, 0x92, 0xa7, 0x92, 0xf7, 0x7e, 0xa9, 0xa1, 0xa4, 0x2f, 0xf1, 0xbc,
+	0x36, 0x98, 0x9d, 0xc9, 0x6a, 0x73, 0x11, 0x28, 0xe8, 0x78, 0x4d, 0xea, 0xa8, 0xe1, 0x55, 0x7b,
+	0xce, 0xa7, 0x03, 0x6f, 0x3f, 0x39, 0x38, 0x31, 0xb4, 0xc3, 0x13, 0x43, 0xfb, 0xe7, 0xc4, 0xd0,
+	0xbe, 0x3d, 0x35, 0x72, 0x87, 0xa7, 0x46, 0xee, 0xcf, 0x53, 0x23, 0xf7, 0xf1, 0xda, 0x94, 0xc9,
+	0xc7, 0x14, 0x2d, 0x9f, 0x74, 0xb8, 0x22, 0xfb, 0x34, 0xa5, 0x93, 0x5e, 0xdf, 0x29, 0xc9, 0xef,
+	0x8b, 0x37, 0xfe, 0x1b, 0x00, 0x2e, 0x90, 0x5f, 0xee, 0x79, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -390,6 +662,10 @@ type QueryClient interface {
 	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
 	// TotalSupply returns the total sum of all coins currently locked into the savings module.
 	TotalSupply(ctx context.Context, in *QueryTotalSupplyRequest, opts ...grpc.CallOption) (*QueryTotalSupplyResponse, error)
+	// Pools queries the total shares and value of deposits of each denom, and the value held in yield strategies.
+	Pools(ctx context.Context, in *QueryPoolsRequest, opts ...grpc.CallOption) (*QueryPoolsResponse, error)
+	// Lockups queries savings lockups.
+	Lockups(ctx context.Context, in *QueryLockupsRequest, opts ...grpc.CallOption) (*QueryLockupsResponse, error)
 }
 
 type queryClient struct {
@@ -427,6 +703,24 @@ func (c *queryClient) TotalSupply(ctx context.Context, in *QueryTotalSupplyReque
 	return out, nil
 }
 
+func (c *queryClient) Pools(ctx context.Context, in *QueryPoolsRequest, opts ...grpc.CallOption) (*QueryPoolsResponse, error) {
+	out := new(QueryPoolsResponse)
+	err := c.cc.Invoke(ctx, "/kava.savings.v1beta1.Query/Pools", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Lockups(ctx context.Context, in *QueryLockupsRequest, opts ...grpc.CallOption) (*QueryLockupsResponse, error) {
+	out := new(QueryLockupsResponse)
+	err := c.cc.Invoke(ctx, "/kava.savings.v1beta1.Query/Lockups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries all parameters of the savings module.
@@ -435,6 +729,10 @@ type QueryServer interface {
 	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
 	// TotalSupply returns the total sum of all coins currently locked into the savings module.
 	TotalSupply(context.Context, *QueryTotalSupplyRequest) (*QueryTotalSupplyResponse, error)
+	// Pools queries the total shares and value of deposits of each denom, and the value held in yield strategies.
+	Pools(context.Context, *QueryPoolsRequest) (*QueryPoolsResponse, error)
+	// Lockups queries savings lockups.
+	Lockups(context.Context, *QueryLockupsRequest) (*QueryLockupsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -450,6 +748,12 @@ func (*UnimplementedQueryServer) Deposits(ctx context.Context, req *QueryDeposit
 func (*UnimplementedQueryServer) TotalSupply(ctx context.Context, req *QueryTotalSupplyRequest) (*QueryTotalSupplyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TotalSupply not implemented")
 }
+func (*UnimplementedQueryServer) Pools(ctx context.Context, req *QueryPoolsRequest) (*QueryPoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pools not implemented")
+}
+func (*UnimplementedQueryServer) Lockups(ctx context.Context, req *QueryLockupsRequest) (*QueryLockupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Lockups not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -509,6 +813,42 @@ func _Query_TotalSupply_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Pools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Pools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.savings.v1beta1.Query/Pools",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Pools(ctx, req.(*QueryPoolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Lockups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLockupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Lockups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.savings.v1beta1.Query/Lockups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Lockups(ctx, req.(*QueryLockupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kava.savings.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -525,6 +865,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TotalSupply",
 			Handler:    _Query_TotalSupply_Handler,
 		},
+		{
+			MethodName: "Pools",
+			Handler:    _Query_Pools_Handler,
+		},
+		{
+			MethodName: "Lockups",
+			Handler:    _Query_Lockups_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kava/savings/v1beta1/query.proto",
@@ -749,56 +1097,274 @@ func (m *QueryTotalSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPoolsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDepositsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
+}
+
+func (m *PoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.StrategyValue.Size()
+		i -= size
+		if _, err := m.StrategyValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.TotalValue.Size()
+		i -= size
+		if _, err := m.TotalValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TotalShares.Size()
+		i -= size
+		if _, err := m.TotalShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLockupsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLockupsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLockupsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLockupsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLockupsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLockupsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Lockups) > 0 {
+		for iNdEx := len(m.Lockups) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Lockups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDepositsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
 
 func (m *QueryDepositsResponse) Size() (n int) {
@@ -847,41 +1413,630 @@ func (m *QueryTotalSupplyResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryPoolsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *PoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.TotalShares.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalValue.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.StrategyValue.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+
+func (m *QueryPoolsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryLockupsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLockupsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Lockups) > 0 {
+		for _, e := range m.Lockups {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDepositsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDepositsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDepositsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDepositsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDepositsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposits = append(m.Deposits, Deposit{})
+			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalSupplyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalSupplyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalSupplyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalSupplyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
-			if iNdEx >= l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Result = append(m.Result, types.Coin{})
+			if err := m.Result[len(m.Result)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -903,7 +2058,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -926,17 +2081,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -946,24 +2101,23 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -986,7 +2140,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDepositsRequest) Unmarshal(dAtA []byte) error {
+func (m *PoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1009,10 +2163,10 @@ func (m *QueryDepositsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDepositsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDepositsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1049,7 +2203,7 @@ func (m *QueryDepositsRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalShares", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1077,13 +2231,15 @@ func (m *QueryDepositsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			if err := m.TotalShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalValue", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1093,25 +2249,57 @@ func (m *QueryDepositsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if err := m.TotalValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrategyValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StrategyValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1136,7 +2324,7 @@ func (m *QueryDepositsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1159,49 +2347,15 @@ func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDepositsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDepositsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Deposits = append(m.Deposits, Deposit{})
-			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1228,10 +2382,8 @@ func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Pools = append(m.Pools, PoolResponse{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1256,7 +2408,7 @@ func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalSupplyRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLockupsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1279,12 +2431,80 @@ func (m *QueryTotalSupplyRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalSupplyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLockupsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLockupsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1306,7 +2526,7 @@ func (m *QueryTotalSupplyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalSupplyResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLockupsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1329,17 +2549,17 @@ func (m *QueryTotalSupplyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalSupplyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLockupsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLockupsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lockups", wireType)
 			}
-			m.Height = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1349,14 +2569,29 @@ func (m *QueryTotalSupplyResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lockups = append(m.Lockups, Lockup{})
+			if err := m.Lockups[len(m.Lockups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1383,8 +2618,10 @@ func (m *QueryTotalSupplyResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Result = append(m.Result, types.Coin{})
-			if err := m.Result[len(m.Result)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
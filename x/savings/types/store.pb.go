@@ -29,6 +29,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // Params defines the parameters for the savings module.
 type Params struct {
 	SupportedDenoms []string `protobuf:"bytes,1,rep,name=supported_denoms,json=supportedDenoms,proto3" json:"supported_denoms,omitempty"`
+	// strategies are the portions of deposits of each denom allocated to a yield strategy.
+	Strategies StrategyAllocations `protobuf:"bytes,2,rep,name=strategies,proto3,castrepeated=StrategyAllocations" json:"strategies"`
+	// lockup_tiers are the fixed terms deposits can be locked for. Lockups are disabled when empty.
+	LockupTiers LockupTiers `protobuf:"bytes,3,rep,name=lockup_tiers,json=lockupTiers,proto3,castrepeated=LockupTiers" json:"lockup_tiers"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -64,7 +68,50 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+// StrategyAllocation defines the portion of a denom's deposits that is allocated to a yield strategy.
+type StrategyAllocation struct {
+	Denom    string       `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Strategy StrategyType `protobuf:"varint,2,opt,name=strategy,proto3,enum=kava.savings.v1beta1.StrategyType" json:"strategy,omitempty"`
+	// allocation is the fraction of the denom's deposits held in the strategy, between 0 and 1.
+	Allocation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=allocation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"allocation"`
+}
+
+func (m *StrategyAllocation) Reset()         { *m = StrategyAllocation{} }
+func (m *StrategyAllocation) String() string { return proto.CompactTextString(m) }
+func (*StrategyAllocation) ProtoMessage()    {}
+func (*StrategyAllocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7110366fa182786, []int{1}
+}
+func (m *StrategyAllocation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StrategyAllocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StrategyAllocation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StrategyAllocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StrategyAllocation.Merge(m, src)
+}
+func (m *StrategyAllocation) XXX_Size() int {
+	return m.Size()
+}
+func (m *StrategyAllocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_StrategyAllocation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StrategyAllocation proto.InternalMessageInfo
+
 // Deposit defines an amount of coins deposited into a savings module account.
+// The amount is stored as the depositor's shares of each denom, which are worth
+// an increasing amount of coins as yield accrues.
 type Deposit struct {
 	Depositor github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=depositor,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"depositor,omitempty"`
 	Amount    github_com_cosmos_cosmos_sdk_types.Coins      `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
@@ -74,7 +121,7 @@ func (m *Deposit) Reset()         { *m = Deposit{} }
 func (m *Deposit) String() string { return proto.CompactTextString(m) }
 func (*Deposit) ProtoMessage()    {}
 func (*Deposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7110366fa182786, []int{1}
+	return fileDescriptor_f7110366fa182786, []int{2}
 }
 func (m *Deposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -105,34 +152,46 @@ var xxx_messageInfo_Deposit proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*Params)(nil), "kava.savings.v1beta1.Params")
+	proto.RegisterType((*StrategyAllocation)(nil), "kava.savings.v1beta1.StrategyAllocation")
 	proto.RegisterType((*Deposit)(nil), "kava.savings.v1beta1.Deposit")
 }
 
 func init() { proto.RegisterFile("kava/savings/v1beta1/store.proto", fileDescriptor_f7110366fa182786) }
 
 var fileDescriptor_f7110366fa182786 = []byte{
-	// 335 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x51, 0xc1, 0x4e, 0xc2, 0x40,
-	0x14, 0xec, 0x4a, 0x82, 0xa1, 0x1e, 0x34, 0x95, 0x03, 0x70, 0x58, 0x1a, 0x4e, 0xe5, 0xd0, 0x5d,
-	0x91, 0x2f, 0xa0, 0x92, 0xe8, 0xd1, 0x70, 0xf4, 0x42, 0xb6, 0xed, 0x5a, 0x1b, 0x6c, 0x5f, 0xd3,
-	0xb7, 0x10, 0xf9, 0x0b, 0xbf, 0xc3, 0xb3, 0x1f, 0xc1, 0x91, 0x78, 0x30, 0x9e, 0x50, 0xe1, 0x2f,
-	0x3c, 0x99, 0xb6, 0x2b, 0x7a, 0xf4, 0xb4, 0x6f, 0xe7, 0xcd, 0x4c, 0x26, 0xf3, 0x4c, 0x7b, 0x26,
-	0x16, 0x82, 0xa3, 0x58, 0xc4, 0x69, 0x84, 0x7c, 0x31, 0xf0, 0xa5, 0x12, 0x03, 0x8e, 0x0a, 0x72,
-	0xc9, 0xb2, 0x1c, 0x14, 0x58, 0xcd, 0x82, 0xc1, 0x34, 0x83, 0x69, 0x46, 0x87, 0x06, 0x80, 0x09,
-	0x20, 0xf7, 0x05, 0xca, 0xbd, 0x2c, 0x80, 0x38, 0xad, 0x54, 0x9d, 0x76, 0xb5, 0x9f, 0x96, 0x3f,
-	0x5e, 0x7d, 0xf4, 0xaa, 0x19, 0x41, 0x04, 0x15, 0x5e, 0x4c, 0x15, 0xda, 0x1b, 0x9a, 0xf5, 0x6b,
-	0x91, 0x8b, 0x04, 0xad, 0xbe, 0x79, 0x82, 0xf3, 0x2c, 0x83, 0x5c, 0xc9, 0x70, 0x1a, 0xca, 0x14,
-	0x12, 0x6c, 0x11, 0xbb, 0xe6, 0x34, 0x26, 0xc7, 0x7b, 0x7c, 0x5c, 0xc2, 0xbd, 0x57, 0x62, 0x1e,
-	0x8e, 0x65, 0x06, 0x18, 0x2b, 0xeb, 0xd6, 0x6c, 0x84, 0xd5, 0x08, 0x79, 0x8b, 0xd8, 0xc4, 0x69,
-	0x78, 0x57, 0x5f, 0x9b, 0xae, 0x1b, 0xc5, 0xea, 0x6e, 0xee, 0xb3, 0x00, 0x12, 0x1d, 0x43, 0x3f,
-	0x2e, 0x86, 0x33, 0xae, 0x96, 0x99, 0x44, 0x36, 0x0a, 0x82, 0x51, 0x18, 0xe6, 0x12, 0xf1, 0xe5,
-	0xd9, 0x3d, 0xd5, 0x61, 0x35, 0xe2, 0x2d, 0x95, 0xc4, 0xc9, 0xaf, 0xb5, 0x15, 0x98, 0x75, 0x91,
-	0xc0, 0x3c, 0x55, 0xad, 0x03, 0xbb, 0xe6, 0x1c, 0x9d, 0xb7, 0x99, 0x16, 0x14, 0x55, 0xfc, 0xf4,
-	0xc3, 0x2e, 0x20, 0x4e, 0xbd, 0xb3, 0xd5, 0xa6, 0x6b, 0x3c, 0xbd, 0x77, 0x9d, 0x7f, 0x64, 0x28,
-	0x04, 0x38, 0xd1, 0xd6, 0xde, 0xe5, 0xea, 0x93, 0x1a, 0xab, 0x2d, 0x25, 0xeb, 0x2d, 0x25, 0x1f,
-	0x5b, 0x4a, 0x1e, 0x77, 0xd4, 0x58, 0xef, 0xa8, 0xf1, 0xb6, 0xa3, 0xc6, 0x4d, 0xff, 0x8f, 0x5f,
-	0x71, 0x1d, 0xf7, 0x5e, 0xf8, 0x58, 0x4e, 0xfc, 0x61, 0x7f, 0xcb, 0xd2, 0xd6, 0xaf, 0x97, 0xed,
-	0x0e, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0xef, 0x42, 0x46, 0x9e, 0xe8, 0x01, 0x00, 0x00,
+	// 503 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xb1, 0x8e, 0xd3, 0x4c,
+	0x10, 0xf6, 0x5e, 0xf4, 0xe7, 0x27, 0x1b, 0x04, 0x68, 0x93, 0xc2, 0x77, 0x48, 0x8e, 0x09, 0x12,
+	0xf2, 0x15, 0xb6, 0xb9, 0xa3, 0x45, 0x48, 0x31, 0x91, 0xa0, 0xa0, 0x40, 0xe6, 0x0a, 0x84, 0x90,
+	0xa2, 0xb5, 0xbd, 0x18, 0x2b, 0xb6, 0xd7, 0xf2, 0x6c, 0x22, 0xf2, 0x16, 0x3c, 0x07, 0xf5, 0x3d,
+	0x44, 0xca, 0xd3, 0x15, 0x08, 0x51, 0x04, 0x48, 0x5e, 0x81, 0x8a, 0x0a, 0xd9, 0xbb, 0x38, 0x91,
+	0x2e, 0xa0, 0xab, 0xbc, 0xf3, 0xcd, 0x37, 0xdf, 0x37, 0xe3, 0x19, 0x6c, 0x4e, 0xe9, 0x9c, 0xba,
+	0x40, 0xe7, 0x49, 0x1e, 0x83, 0x3b, 0x3f, 0x09, 0x98, 0xa0, 0x27, 0x2e, 0x08, 0x5e, 0x32, 0xa7,
+	0x28, 0xb9, 0xe0, 0xa4, 0x5f, 0x31, 0x1c, 0xc5, 0x70, 0x14, 0xe3, 0xc8, 0x08, 0x39, 0x64, 0x1c,
+	0xdc, 0x80, 0x02, 0x6b, 0xca, 0x42, 0x9e, 0xe4, 0xb2, 0xea, 0xe8, 0x50, 0xe6, 0x27, 0x75, 0xe4,
+	0xca, 0x40, 0xa5, 0xfa, 0x31, 0x8f, 0xb9, 0xc4, 0xab, 0x97, 0x42, 0xef, 0xed, 0x6d, 0x24, 0xe5,
+	0xe1, 0x74, 0x56, 0x28, 0xca, 0xfd, 0xbf, 0xf4, 0x5a, 0x52, 0xc1, 0xe2, 0x85, 0x24, 0x0d, 0x7f,
+	0x22, 0xdc, 0x7e, 0x49, 0x4b, 0x9a, 0x01, 0x39, 0xc6, 0x77, 0x60, 0x56, 0x14, 0xbc, 0x14, 0x2c,
+	0x9a, 0x44, 0x2c, 0xe7, 0x19, 0xe8, 0xc8, 0x6c, 0x59, 0x1d, 0xff, 0x76, 0x83, 0x8f, 0x6b, 0x98,
+	0x30, 0x8c, 0x95, 0x4e, 0xc2, 0x40, 0x3f, 0x30, 0x5b, 0x56, 0xf7, 0xd4, 0x72, 0xf6, 0x4d, 0xee,
+	0xbc, 0x52, 0x7e, 0xa3, 0x34, 0xe5, 0x21, 0x15, 0x09, 0xcf, 0xbd, 0xbb, 0xcb, 0xd5, 0x40, 0xfb,
+	0xf4, 0x6d, 0xd0, 0xbb, 0x9a, 0x03, 0x7f, 0x47, 0x98, 0xbc, 0xc6, 0x37, 0xe5, 0x44, 0x13, 0x91,
+	0xb0, 0x12, 0xf4, 0x56, 0x6d, 0x64, 0xee, 0x37, 0x7a, 0x51, 0x33, 0xcf, 0x12, 0x56, 0x7a, 0x3d,
+	0x65, 0xd0, 0xdd, 0x62, 0xe0, 0x77, 0xd3, 0x6d, 0x30, 0x5c, 0x22, 0x4c, 0xae, 0xba, 0x93, 0x3e,
+	0xfe, 0xaf, 0x1e, 0x5c, 0x47, 0x26, 0xb2, 0x3a, 0xbe, 0x0c, 0xc8, 0x13, 0x7c, 0xe3, 0xcf, 0x5f,
+	0xd3, 0x0f, 0x4c, 0x64, 0xdd, 0x3a, 0x1d, 0xfe, 0x7b, 0xd6, 0xb3, 0x45, 0xc1, 0xfc, 0xa6, 0x86,
+	0xbc, 0xc5, 0x98, 0x36, 0x1e, 0x7a, 0xab, 0x92, 0xf6, 0x1e, 0x57, 0x2d, 0x7e, 0x5d, 0x0d, 0x1e,
+	0xc4, 0x89, 0x78, 0x3f, 0x0b, 0x9c, 0x90, 0x67, 0x6a, 0xed, 0xea, 0x63, 0x43, 0x34, 0x75, 0xc5,
+	0xa2, 0x60, 0xe0, 0x8c, 0x59, 0x78, 0x79, 0x6e, 0x63, 0x89, 0x57, 0x91, 0xbf, 0xa3, 0x37, 0xfc,
+	0x8c, 0xf0, 0xff, 0x63, 0x56, 0x70, 0x48, 0x04, 0x79, 0x87, 0x3b, 0x91, 0x7c, 0xf2, 0x52, 0xce,
+	0xe0, 0x3d, 0xff, 0xb5, 0x1a, 0xd8, 0xd7, 0x30, 0x19, 0x85, 0xe1, 0x28, 0x8a, 0x4a, 0x06, 0x70,
+	0x79, 0x6e, 0xf7, 0x94, 0x97, 0x42, 0xbc, 0x85, 0x60, 0xe0, 0x6f, 0xa5, 0x49, 0x88, 0xdb, 0x34,
+	0xe3, 0xb3, 0x5c, 0xa8, 0xdd, 0x1f, 0x3a, 0xaa, 0xa0, 0xba, 0xef, 0xe6, 0x77, 0x3c, 0xe5, 0x49,
+	0xee, 0x3d, 0x54, 0xbb, 0xb0, 0xae, 0xd1, 0x43, 0x55, 0x00, 0xbe, 0x92, 0xf6, 0x9e, 0x2d, 0x7f,
+	0x18, 0xda, 0x72, 0x6d, 0xa0, 0x8b, 0xb5, 0x81, 0xbe, 0xaf, 0x0d, 0xf4, 0x71, 0x63, 0x68, 0x17,
+	0x1b, 0x43, 0xfb, 0xb2, 0x31, 0xb4, 0x37, 0xc7, 0x3b, 0x7a, 0xd5, 0x32, 0xec, 0x94, 0x06, 0x50,
+	0xbf, 0xdc, 0x0f, 0xcd, 0xd1, 0xd7, 0xb2, 0x41, 0xbb, 0x3e, 0xf5, 0x47, 0xbf, 0x07, 0x00, 0xe2,
+	0x14, 0xd7, 0xa5, 0xbd, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -155,6 +214,34 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.LockupTiers) > 0 {
+		for iNdEx := len(m.LockupTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockupTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStore(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Strategies) > 0 {
+		for iNdEx := len(m.Strategies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Strategies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStore(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.SupportedDenoms) > 0 {
 		for iNdEx := len(m.SupportedDenoms) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.SupportedDenoms[iNdEx])
@@ -167,6 +254,51 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *StrategyAllocation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StrategyAllocation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StrategyAllocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Allocation.Size()
+		i -= size
+		if _, err := m.Allocation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Strategy != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.Strategy))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Deposit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -234,6 +366,36 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovStore(uint64(l))
 		}
 	}
+	if len(m.Strategies) > 0 {
+		for _, e := range m.Strategies {
+			l = e.Size()
+			n += 1 + l + sovStore(uint64(l))
+		}
+	}
+	if len(m.LockupTiers) > 0 {
+		for _, e := range m.LockupTiers {
+			l = e.Size()
+			n += 1 + l + sovStore(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StrategyAllocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	if m.Strategy != 0 {
+		n += 1 + sovStore(uint64(m.Strategy))
+	}
+	l = m.Allocation.Size()
+	n += 1 + l + sovStore(uint64(l))
 	return n
 }
 
@@ -323,6 +485,209 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.SupportedDenoms = append(m.SupportedDenoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Strategies = append(m.Strategies, StrategyAllocation{})
+			if err := m.Strategies[len(m.Strategies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockupTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockupTiers = append(m.LockupTiers, LockupTier{})
+			if err := m.LockupTiers[len(m.LockupTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StrategyAllocation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StrategyAllocation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StrategyAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+			}
+			m.Strategy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Strategy |= StrategyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Allocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStore(dAtA[iNdEx:])
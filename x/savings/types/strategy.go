@@ -0,0 +1,69 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// IsValid returns true if the StrategyType status is valid and false otherwise.
+func (s StrategyType) IsValid() bool {
+	return s == STRATEGY_TYPE_HARD
+}
+
+// NewStrategyAllocation returns a new StrategyAllocation
+func NewStrategyAllocation(denom string, strategy StrategyType, allocation sdk.Dec) StrategyAllocation {
+	return StrategyAllocation{
+		Denom:      denom,
+		Strategy:   strategy,
+		Allocation: allocation,
+	}
+}
+
+// Validate checks the strategy allocation has a valid denom, strategy type, and allocation.
+func (a StrategyAllocation) Validate() error {
+	if err := sdk.ValidateDenom(a.Denom); err != nil {
+		return err
+	}
+
+	if !a.Strategy.IsValid() {
+		return fmt.Errorf("invalid strategy %s for denom %s", a.Strategy, a.Denom)
+	}
+
+	if a.Allocation.IsNil() || a.Allocation.IsNegative() || a.Allocation.GT(sdk.OneDec()) {
+		return fmt.Errorf("allocation for denom %s must be between 0 and 1, got %s", a.Denom, a.Allocation)
+	}
+
+	return nil
+}
+
+// StrategyAllocations is a slice of StrategyAllocation
+type StrategyAllocations []StrategyAllocation
+
+// Validate checks each strategy allocation is valid and each denom has at most one allocation.
+func (as StrategyAllocations) Validate() error {
+	seenDenoms := make(map[string]bool)
+	for _, a := range as {
+		if err := a.Validate(); err != nil {
+			return err
+		}
+
+		if seenDenoms[a.Denom] {
+			return fmt.Errorf("duplicated strategy denom %s", a.Denom)
+		}
+		seenDenoms[a.Denom] = true
+	}
+
+	return nil
+}
+
+// Get returns the strategy allocation of a denom.
+func (as StrategyAllocations) Get(denom string) (StrategyAllocation, bool) {
+	for _, a := range as {
+		if a.Denom == denom {
+			return a, true
+		}
+	}
+
+	return StrategyAllocation{}, false
+}
@@ -0,0 +1,76 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: kava/savings/v1beta1/strategy.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// StrategyType is the type of strategy that savings deposits are allocated to for yield.
+type StrategyType int32
+
+const (
+	// STRATEGY_TYPE_UNSPECIFIED represents an unspecified or invalid strategy type.
+	STRATEGY_TYPE_UNSPECIFIED StrategyType = 0
+	// STRATEGY_TYPE_HARD represents the strategy that supplies assets to the Hard
+	// module.
+	STRATEGY_TYPE_HARD StrategyType = 1
+)
+
+var StrategyType_name = map[int32]string{
+	0: "STRATEGY_TYPE_UNSPECIFIED",
+	1: "STRATEGY_TYPE_HARD",
+}
+
+var StrategyType_value = map[string]int32{
+	"STRATEGY_TYPE_UNSPECIFIED": 0,
+	"STRATEGY_TYPE_HARD":        1,
+}
+
+func (x StrategyType) String() string {
+	return proto.EnumName(StrategyType_name, int32(x))
+}
+
+func (StrategyType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_64de4f54ce22e55d, []int{0}
+}
+
+func init() {
+	proto.RegisterEnum("kava.savings.v1beta1.StrategyType", StrategyType_name, StrategyType_value)
+}
+
+func init() {
+	proto.RegisterFile("kava/savings/v1beta1/strategy.proto", fileDescriptor_64de4f54ce22e55d)
+}
+
+var fileDescriptor_64de4f54ce22e55d = []byte{
+	// 205 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xce, 0x4e, 0x2c, 0x4b,
+	0xd4, 0x2f, 0x4e, 0x2c, 0xcb, 0xcc, 0x4b, 0x2f, 0xd6, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34,
+	0xd4, 0x2f, 0x2e, 0x29, 0x4a, 0x2c, 0x49, 0x4d, 0xaf, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
+	0x12, 0x01, 0x29, 0xd2, 0x83, 0x2a, 0xd2, 0x83, 0x2a, 0x92, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07,
+	0x2b, 0xd0, 0x07, 0xb1, 0x20, 0x6a, 0xb5, 0xbc, 0xb9, 0x78, 0x82, 0xa1, 0xba, 0x43, 0x2a, 0x0b,
+	0x52, 0x85, 0x64, 0xb9, 0x24, 0x83, 0x43, 0x82, 0x1c, 0x43, 0x5c, 0xdd, 0x23, 0xe3, 0x43, 0x22,
+	0x03, 0x5c, 0xe3, 0x43, 0xfd, 0x82, 0x03, 0x5c, 0x9d, 0x3d, 0xdd, 0x3c, 0x5d, 0x5d, 0x04, 0x18,
+	0x84, 0xc4, 0xb8, 0x84, 0x50, 0xa5, 0x3d, 0x1c, 0x83, 0x5c, 0x04, 0x18, 0xa5, 0x58, 0x3a, 0x16,
+	0xcb, 0x31, 0x38, 0x39, 0x9f, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72,
+	0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x66,
+	0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0xc8, 0x75, 0xba, 0x39, 0x89,
+	0x49, 0xc5, 0x60, 0x96, 0x7e, 0x05, 0xdc, 0x3b, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60,
+	0x87, 0x19, 0x03, 0x06, 0x00, 0x79, 0x59, 0x2a, 0x5c, 0xeb, 0x00, 0x00, 0x00,
+}
@@ -12,18 +12,22 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -211,40 +215,252 @@ func (m *MsgWithdrawResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgWithdrawResponse proto.InternalMessageInfo
 
+// MsgLockDeposit defines the Msg/LockDeposit request type.
+type MsgLockDeposit struct {
+	Depositor string                                   `protobuf:"bytes,1,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	Amount    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// duration must match the duration of a lockup tier
+	Duration time.Duration `protobuf:"bytes,3,opt,name=duration,proto3,stdduration" json:"duration"`
+}
+
+func (m *MsgLockDeposit) Reset()         { *m = MsgLockDeposit{} }
+func (m *MsgLockDeposit) String() string { return proto.CompactTextString(m) }
+func (*MsgLockDeposit) ProtoMessage()    {}
+func (*MsgLockDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0bf8679b144267a, []int{4}
+}
+func (m *MsgLockDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLockDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLockDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgLockDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLockDeposit.Merge(m, src)
+}
+func (m *MsgLockDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLockDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLockDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgLockDeposit proto.InternalMessageInfo
+
+func (m *MsgLockDeposit) GetDepositor() string {
+	if m != nil {
+		return m.Depositor
+	}
+	return ""
+}
+
+func (m *MsgLockDeposit) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *MsgLockDeposit) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+// MsgLockDepositResponse defines the Msg/LockDeposit response type.
+type MsgLockDepositResponse struct {
+	LockupID uint64 `protobuf:"varint,1,opt,name=lockup_id,json=lockupId,proto3" json:"lockup_id,omitempty"`
+}
+
+func (m *MsgLockDepositResponse) Reset()         { *m = MsgLockDepositResponse{} }
+func (m *MsgLockDepositResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgLockDepositResponse) ProtoMessage()    {}
+func (*MsgLockDepositResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0bf8679b144267a, []int{5}
+}
+func (m *MsgLockDepositResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLockDepositResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLockDepositResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgLockDepositResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLockDepositResponse.Merge(m, src)
+}
+func (m *MsgLockDepositResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLockDepositResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLockDepositResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgLockDepositResponse proto.InternalMessageInfo
+
+func (m *MsgLockDepositResponse) GetLockupID() uint64 {
+	if m != nil {
+		return m.LockupID
+	}
+	return 0
+}
+
+// MsgWithdrawLockup defines the Msg/WithdrawLockup request type.
+type MsgWithdrawLockup struct {
+	Depositor string `protobuf:"bytes,1,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	LockupID  uint64 `protobuf:"varint,2,opt,name=lockup_id,json=lockupId,proto3" json:"lockup_id,omitempty"`
+}
+
+func (m *MsgWithdrawLockup) Reset()         { *m = MsgWithdrawLockup{} }
+func (m *MsgWithdrawLockup) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawLockup) ProtoMessage()    {}
+func (*MsgWithdrawLockup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0bf8679b144267a, []int{6}
+}
+func (m *MsgWithdrawLockup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawLockup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawLockup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawLockup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawLockup.Merge(m, src)
+}
+func (m *MsgWithdrawLockup) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawLockup) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawLockup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawLockup proto.InternalMessageInfo
+
+func (m *MsgWithdrawLockup) GetDepositor() string {
+	if m != nil {
+		return m.Depositor
+	}
+	return ""
+}
+
+func (m *MsgWithdrawLockup) GetLockupID() uint64 {
+	if m != nil {
+		return m.LockupID
+	}
+	return 0
+}
+
+// MsgWithdrawLockupResponse defines the Msg/WithdrawLockup response type.
+type MsgWithdrawLockupResponse struct {
+}
+
+func (m *MsgWithdrawLockupResponse) Reset()         { *m = MsgWithdrawLockupResponse{} }
+func (m *MsgWithdrawLockupResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawLockupResponse) ProtoMessage()    {}
+func (*MsgWithdrawLockupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0bf8679b144267a, []int{7}
+}
+func (m *MsgWithdrawLockupResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawLockupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawLockupResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawLockupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawLockupResponse.Merge(m, src)
+}
+func (m *MsgWithdrawLockupResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawLockupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawLockupResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawLockupResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgDeposit)(nil), "kava.savings.v1beta1.MsgDeposit")
 	proto.RegisterType((*MsgDepositResponse)(nil), "kava.savings.v1beta1.MsgDepositResponse")
 	proto.RegisterType((*MsgWithdraw)(nil), "kava.savings.v1beta1.MsgWithdraw")
 	proto.RegisterType((*MsgWithdrawResponse)(nil), "kava.savings.v1beta1.MsgWithdrawResponse")
+	proto.RegisterType((*MsgLockDeposit)(nil), "kava.savings.v1beta1.MsgLockDeposit")
+	proto.RegisterType((*MsgLockDepositResponse)(nil), "kava.savings.v1beta1.MsgLockDepositResponse")
+	proto.RegisterType((*MsgWithdrawLockup)(nil), "kava.savings.v1beta1.MsgWithdrawLockup")
+	proto.RegisterType((*MsgWithdrawLockupResponse)(nil), "kava.savings.v1beta1.MsgWithdrawLockupResponse")
 }
 
 func init() { proto.RegisterFile("kava/savings/v1beta1/tx.proto", fileDescriptor_c0bf8679b144267a) }
 
 var fileDescriptor_c0bf8679b144267a = []byte{
-	// 368 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x52, 0xcd, 0x4e, 0xea, 0x40,
-	0x14, 0xee, 0x5c, 0x12, 0xee, 0x65, 0xd8, 0xf5, 0xd6, 0x04, 0x9a, 0x58, 0x90, 0x55, 0x59, 0x30,
-	0x15, 0x4c, 0xdc, 0x0b, 0x6e, 0xd9, 0x60, 0x8c, 0xc6, 0x8d, 0x99, 0xfe, 0x64, 0x98, 0x20, 0x9d,
-	0xa6, 0x67, 0x40, 0x7c, 0x0b, 0x5f, 0x43, 0xd6, 0xc6, 0x67, 0x60, 0x49, 0x5c, 0xb9, 0x52, 0x03,
-	0x2f, 0x62, 0xda, 0x4e, 0x81, 0x85, 0x86, 0xad, 0xab, 0x9e, 0x99, 0xef, 0x27, 0xdf, 0x7c, 0x3d,
-	0xf8, 0x70, 0x44, 0xa7, 0xd4, 0x01, 0x3a, 0xe5, 0x21, 0x03, 0x67, 0xda, 0x76, 0x03, 0x49, 0xdb,
-	0x8e, 0x9c, 0x91, 0x28, 0x16, 0x52, 0xe8, 0x46, 0x02, 0x13, 0x05, 0x13, 0x05, 0x9b, 0x96, 0x27,
-	0x60, 0x2c, 0xc0, 0x71, 0x29, 0x04, 0x1b, 0x8d, 0x27, 0x78, 0x98, 0xa9, 0xcc, 0x6a, 0x86, 0xdf,
-	0xa6, 0x27, 0x27, 0x3b, 0x28, 0xc8, 0x60, 0x82, 0x89, 0xec, 0x3e, 0x99, 0xb2, 0xdb, 0xc6, 0x13,
-	0xc2, 0xb8, 0x0f, 0xec, 0x3c, 0x88, 0x04, 0x70, 0xa9, 0x9f, 0xe2, 0x92, 0x9f, 0x8d, 0x22, 0xae,
-	0xa0, 0x3a, 0xb2, 0x4b, 0xdd, 0xca, 0xeb, 0x73, 0xcb, 0x50, 0x4e, 0x67, 0xbe, 0x1f, 0x07, 0x00,
-	0x17, 0x32, 0xe6, 0x21, 0x1b, 0x6c, 0xa9, 0xba, 0x87, 0x8b, 0x74, 0x2c, 0x26, 0xa1, 0xac, 0xfc,
-	0xa9, 0x17, 0xec, 0x72, 0xa7, 0x4a, 0x94, 0x22, 0x09, 0x9a, 0xa7, 0x27, 0x3d, 0xc1, 0xc3, 0xee,
-	0xf1, 0xe2, 0xbd, 0xa6, 0xcd, 0x3f, 0x6a, 0x36, 0xe3, 0x72, 0x38, 0x71, 0x89, 0x27, 0xc6, 0x2a,
-	0xa8, 0xfa, 0xb4, 0xc0, 0x1f, 0x39, 0xf2, 0x21, 0x0a, 0x20, 0x15, 0xc0, 0x40, 0x59, 0x37, 0x0c,
-	0xac, 0x6f, 0xa3, 0x0e, 0x02, 0x88, 0x44, 0x08, 0x41, 0x63, 0x8e, 0x70, 0xb9, 0x0f, 0xec, 0x8a,
-	0xcb, 0xa1, 0x1f, 0xd3, 0xfb, 0xdf, 0xfd, 0x84, 0x03, 0xfc, 0x7f, 0x27, 0x6b, 0xfe, 0x86, 0xce,
-	0x0b, 0xc2, 0x85, 0x3e, 0x30, 0xfd, 0x12, 0xff, 0xcd, 0xff, 0x44, 0x9d, 0x7c, 0xb7, 0x00, 0x64,
-	0x5b, 0x80, 0x69, 0xef, 0x63, 0xe4, 0xf6, 0xfa, 0x35, 0xfe, 0xb7, 0xa9, 0xe7, 0xe8, 0x47, 0x55,
-	0x4e, 0x31, 0x9b, 0x7b, 0x29, 0xb9, 0x73, 0xb7, 0xb7, 0x58, 0x59, 0x68, 0xb9, 0xb2, 0xd0, 0xe7,
-	0xca, 0x42, 0x8f, 0x6b, 0x4b, 0x5b, 0xae, 0x2d, 0xed, 0x6d, 0x6d, 0x69, 0x37, 0xcd, 0x9d, 0x6e,
-	0x12, 0xbb, 0xd6, 0x1d, 0x75, 0x21, 0x9d, 0x9c, 0xd9, 0x66, 0xeb, 0xd3, 0x8a, 0xdc, 0x62, 0xba,
-	0x8a, 0x27, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x4e, 0x17, 0x24, 0x24, 0x12, 0x03, 0x00, 0x00,
+	// 531 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0x4f, 0x6f, 0xd3, 0x30,
+	0x1c, 0xad, 0x57, 0x34, 0xda, 0x5f, 0xd1, 0x24, 0x42, 0x41, 0x6d, 0x10, 0x69, 0xa9, 0x90, 0x68,
+	0x25, 0x6a, 0xb3, 0x21, 0x71, 0x45, 0xb4, 0xbd, 0x4c, 0x5a, 0x2f, 0x41, 0x08, 0xc4, 0x65, 0x72,
+	0xfe, 0xe0, 0x85, 0xb6, 0x71, 0x14, 0x3b, 0x65, 0x7c, 0x00, 0xee, 0x1c, 0xf9, 0x0a, 0xec, 0xcc,
+	0x87, 0xd8, 0x71, 0xe2, 0xc4, 0x69, 0x43, 0xed, 0x9d, 0xcf, 0x80, 0x92, 0x38, 0x69, 0x06, 0x8c,
+	0x4e, 0x9c, 0xe0, 0x54, 0xdb, 0xbf, 0xf7, 0xfc, 0x7e, 0xef, 0xe7, 0xd7, 0xc0, 0x9d, 0x09, 0x9d,
+	0x53, 0x22, 0xe8, 0xdc, 0xf3, 0x99, 0x20, 0xf3, 0x6d, 0xcb, 0x95, 0x74, 0x9b, 0xc8, 0x43, 0x1c,
+	0x84, 0x5c, 0x72, 0xad, 0x1e, 0x97, 0xb1, 0x2a, 0x63, 0x55, 0xd6, 0x0d, 0x9b, 0x8b, 0x19, 0x17,
+	0xc4, 0xa2, 0xc2, 0xcd, 0x39, 0x36, 0xf7, 0xfc, 0x94, 0xa5, 0x37, 0xd3, 0xfa, 0x7e, 0xb2, 0x23,
+	0xe9, 0x46, 0x95, 0xea, 0x8c, 0x33, 0x9e, 0x9e, 0xc7, 0x2b, 0x75, 0x6a, 0x30, 0xce, 0xd9, 0xd4,
+	0x25, 0xc9, 0xce, 0x8a, 0x5e, 0x13, 0x27, 0x0a, 0xa9, 0xf4, 0xb8, 0xba, 0xb0, 0xf3, 0x09, 0x01,
+	0x8c, 0x05, 0x1b, 0xb9, 0x01, 0x17, 0x9e, 0xd4, 0x1e, 0x43, 0xd5, 0x49, 0x97, 0x3c, 0x6c, 0xa0,
+	0x36, 0xea, 0x56, 0x07, 0x8d, 0x2f, 0x9f, 0xfb, 0x75, 0xa5, 0xf4, 0xd4, 0x71, 0x42, 0x57, 0x88,
+	0x67, 0x32, 0xf4, 0x7c, 0x66, 0xae, 0xa0, 0x9a, 0x0d, 0x9b, 0x74, 0xc6, 0x23, 0x5f, 0x36, 0x36,
+	0xda, 0xe5, 0x6e, 0x6d, 0xa7, 0x89, 0x15, 0x23, 0x36, 0x92, 0xb9, 0xc3, 0x43, 0xee, 0xf9, 0x83,
+	0x87, 0xc7, 0xa7, 0xad, 0xd2, 0xd1, 0x59, 0xab, 0xcb, 0x3c, 0x79, 0x10, 0x59, 0xd8, 0xe6, 0x33,
+	0x65, 0x44, 0xfd, 0xf4, 0x85, 0x33, 0x21, 0xf2, 0x5d, 0xe0, 0x8a, 0x84, 0x20, 0x4c, 0x75, 0x75,
+	0xa7, 0x0e, 0xda, 0xaa, 0x55, 0xd3, 0x15, 0x01, 0xf7, 0x85, 0xdb, 0x39, 0x42, 0x50, 0x1b, 0x0b,
+	0xf6, 0xc2, 0x93, 0x07, 0x4e, 0x48, 0xdf, 0xfe, 0xdb, 0x16, 0x6e, 0xc2, 0x8d, 0x42, 0xaf, 0xb9,
+	0x87, 0xef, 0x08, 0xb6, 0xc6, 0x82, 0xed, 0x71, 0x7b, 0xf2, 0x3f, 0xbc, 0x84, 0xf6, 0x04, 0x2a,
+	0x59, 0x8e, 0x1a, 0xe5, 0x36, 0x4a, 0x64, 0xd2, 0xa0, 0xe1, 0x2c, 0x68, 0x78, 0xa4, 0x00, 0x83,
+	0x4a, 0x2c, 0xf3, 0xf1, 0xac, 0x85, 0xcc, 0x9c, 0xd4, 0x19, 0xc2, 0xad, 0xf3, 0x7e, 0xb3, 0x51,
+	0x68, 0x3d, 0xa8, 0x4e, 0xb9, 0x3d, 0x89, 0x82, 0x7d, 0xcf, 0x49, 0x7c, 0x5f, 0x19, 0x5c, 0x5b,
+	0x9c, 0xb6, 0x2a, 0x7b, 0xc9, 0xe1, 0xee, 0xc8, 0xac, 0xa4, 0xe5, 0x5d, 0xa7, 0x33, 0x87, 0xeb,
+	0x85, 0x61, 0xa6, 0x80, 0xbf, 0x9e, 0xdb, 0x39, 0xdd, 0x8d, 0x3f, 0xea, 0xde, 0x86, 0xe6, 0x2f,
+	0xba, 0x59, 0xff, 0x3b, 0xef, 0xcb, 0x50, 0x1e, 0x0b, 0xa6, 0x3d, 0x87, 0xab, 0xd9, 0x53, 0xb6,
+	0xf1, 0xef, 0xfe, 0xeb, 0x78, 0x95, 0x65, 0xbd, 0xbb, 0x0e, 0x91, 0x8f, 0xe7, 0x25, 0x54, 0xf2,
+	0xa4, 0xdf, 0xbd, 0x90, 0x95, 0x41, 0xf4, 0xde, 0x5a, 0x48, 0x7e, 0x33, 0x85, 0x5a, 0x31, 0x7f,
+	0xf7, 0x2e, 0x64, 0x16, 0x50, 0xfa, 0x83, 0xcb, 0xa0, 0x72, 0x89, 0x37, 0xb0, 0xf5, 0xd3, 0x6b,
+	0xdd, 0x5f, 0xdb, 0x5f, 0x0a, 0xd4, 0xc9, 0x25, 0x81, 0x99, 0xd6, 0x60, 0x78, 0xbc, 0x30, 0xd0,
+	0xc9, 0xc2, 0x40, 0xdf, 0x16, 0x06, 0xfa, 0xb0, 0x34, 0x4a, 0x27, 0x4b, 0xa3, 0xf4, 0x75, 0x69,
+	0x94, 0x5e, 0xf5, 0x0a, 0x71, 0x8f, 0x2f, 0xed, 0x4f, 0xa9, 0x25, 0x92, 0x15, 0x39, 0xcc, 0xbf,
+	0xd7, 0x49, 0xea, 0xad, 0xcd, 0x24, 0xcd, 0x8f, 0x7e, 0x0c, 0x00, 0xfe, 0x82, 0xfc, 0xec, 0xcc,
+	0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -263,6 +479,10 @@ type MsgClient interface {
 	Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error)
 	// Withdraw defines a method for withdrawing funds to the savings module account
 	Withdraw(ctx context.Context, in *MsgWithdraw, opts ...grpc.CallOption) (*MsgWithdrawResponse, error)
+	// LockDeposit defines a method for depositing funds to the savings module account and locking them for a fixed term
+	LockDeposit(ctx context.Context, in *MsgLockDeposit, opts ...grpc.CallOption) (*MsgLockDepositResponse, error)
+	// WithdrawLockup defines a method for withdrawing locked funds before the end of their term, paying a penalty
+	WithdrawLockup(ctx context.Context, in *MsgWithdrawLockup, opts ...grpc.CallOption) (*MsgWithdrawLockupResponse, error)
 }
 
 type msgClient struct {
@@ -291,12 +511,34 @@ func (c *msgClient) Withdraw(ctx context.Context, in *MsgWithdraw, opts ...grpc.
 	return out, nil
 }
 
+func (c *msgClient) LockDeposit(ctx context.Context, in *MsgLockDeposit, opts ...grpc.CallOption) (*MsgLockDepositResponse, error) {
+	out := new(MsgLockDepositResponse)
+	err := c.cc.Invoke(ctx, "/kava.savings.v1beta1.Msg/LockDeposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawLockup(ctx context.Context, in *MsgWithdrawLockup, opts ...grpc.CallOption) (*MsgWithdrawLockupResponse, error) {
+	out := new(MsgWithdrawLockupResponse)
+	err := c.cc.Invoke(ctx, "/kava.savings.v1beta1.Msg/WithdrawLockup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Deposit defines a method for depositing funds to the savings module account
 	Deposit(context.Context, *MsgDeposit) (*MsgDepositResponse, error)
 	// Withdraw defines a method for withdrawing funds to the savings module account
 	Withdraw(context.Context, *MsgWithdraw) (*MsgWithdrawResponse, error)
+	// LockDeposit defines a method for depositing funds to the savings module account and locking them for a fixed term
+	LockDeposit(context.Context, *MsgLockDeposit) (*MsgLockDepositResponse, error)
+	// WithdrawLockup defines a method for withdrawing locked funds before the end of their term, paying a penalty
+	WithdrawLockup(context.Context, *MsgWithdrawLockup) (*MsgWithdrawLockupResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -309,6 +551,12 @@ func (*UnimplementedMsgServer) Deposit(ctx context.Context, req *MsgDeposit) (*M
 func (*UnimplementedMsgServer) Withdraw(ctx context.Context, req *MsgWithdraw) (*MsgWithdrawResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Withdraw not implemented")
 }
+func (*UnimplementedMsgServer) LockDeposit(ctx context.Context, req *MsgLockDeposit) (*MsgLockDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockDeposit not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawLockup(ctx context.Context, req *MsgWithdrawLockup) (*MsgWithdrawLockupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawLockup not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -350,6 +598,42 @@ func _Msg_Withdraw_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_LockDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLockDeposit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).LockDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.savings.v1beta1.Msg/LockDeposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LockDeposit(ctx, req.(*MsgLockDeposit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawLockup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawLockup)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawLockup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.savings.v1beta1.Msg/WithdrawLockup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawLockup(ctx, req.(*MsgWithdrawLockup))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kava.savings.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -362,6 +646,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Withdraw",
 			Handler:    _Msg_Withdraw_Handler,
 		},
+		{
+			MethodName: "LockDeposit",
+			Handler:    _Msg_LockDeposit_Handler,
+		},
+		{
+			MethodName: "WithdrawLockup",
+			Handler:    _Msg_WithdrawLockup_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kava/savings/v1beta1/tx.proto",
@@ -501,6 +793,144 @@ func (m *MsgWithdrawResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgLockDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLockDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLockDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTx(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLockDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLockDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLockDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LockupID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LockupID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawLockup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawLockup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawLockup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LockupID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LockupID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawLockupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawLockupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawLockupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -556,25 +986,249 @@ func (m *MsgWithdraw) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func (m *MsgWithdrawResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgLockDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgLockDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LockupID != 0 {
+		n += 1 + sovTx(uint64(m.LockupID))
+	}
+	return n
+}
+
+func (m *MsgWithdrawLockup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LockupID != 0 {
+		n += 1 + sovTx(uint64(m.LockupID))
+	}
+	return n
+}
+
+func (m *MsgWithdrawLockupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgWithdrawResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -597,10 +1251,10 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdraw: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -690,7 +1344,7 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -713,10 +1367,10 @@ func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -740,7 +1394,7 @@ func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
+func (m *MsgLockDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -763,10 +1417,10 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdraw: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLockDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLockDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -835,6 +1489,39 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -856,7 +1543,7 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgLockDepositResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -879,10 +1566,180 @@ func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLockDepositResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLockDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockupID", wireType)
+			}
+			m.LockupID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockupID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawLockup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawLockup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawLockup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockupID", wireType)
+			}
+			m.LockupID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockupID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawLockupResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawLockupResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawLockupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default: